@@ -0,0 +1,55 @@
+package app
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+
+	campaignante "github.com/tendermint/spn/x/campaign/ante"
+)
+
+// HandlerOptions are the options required for constructing the app AnteHandler
+type HandlerOptions struct {
+	ante.HandlerOptions
+
+	VoucherTransferChecker campaignante.VoucherTransferChecker
+	GroupKeeper            campaignante.GroupKeeper
+}
+
+// NewAnteHandler returns the default SDK AnteHandler extended with the check of the voucher transfer policies
+func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
+	if options.AccountKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrortypes.ErrLogic, "account keeper is required for ante builder")
+	}
+	if options.BankKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrortypes.ErrLogic, "bank keeper is required for ante builder")
+	}
+	if options.SignModeHandler == nil {
+		return nil, sdkerrors.Wrap(sdkerrortypes.ErrLogic, "sign mode handler is required for ante builder")
+	}
+	if options.VoucherTransferChecker == nil {
+		return nil, sdkerrors.Wrap(sdkerrortypes.ErrLogic, "voucher transfer checker is required for ante builder")
+	}
+	if options.GroupKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrortypes.ErrLogic, "group keeper is required for ante builder")
+	}
+
+	anteDecorators := []sdk.AnteDecorator{
+		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
+		ante.NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
+		ante.NewValidateBasicDecorator(),
+		ante.NewTxTimeoutHeightDecorator(),
+		ante.NewValidateMemoDecorator(options.AccountKeeper),
+		campaignante.NewVoucherTransferDecorator(options.VoucherTransferChecker, options.GroupKeeper),
+		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
+		ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
+		ante.NewSetPubKeyDecorator(options.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
+		ante.NewValidateSigCountDecorator(options.AccountKeeper),
+		ante.NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer),
+		ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
+		ante.NewIncrementSequenceDecorator(options.AccountKeeper),
+	}
+
+	return sdk.ChainAnteDecorators(anteDecorators...), nil
+}
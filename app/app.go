@@ -58,6 +58,9 @@ import (
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	groupkeeper "github.com/cosmos/cosmos-sdk/x/group/keeper"
+	groupmodule "github.com/cosmos/cosmos-sdk/x/group/module"
 	"github.com/cosmos/cosmos-sdk/x/params"
 	paramsclient "github.com/cosmos/cosmos-sdk/x/params/client"
 	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
@@ -125,14 +128,6 @@ import (
 	// this line is used by starport scaffolding # stargate/app/moduleImport
 )
 
-const (
-	// missionIDStaking is the mission ID for staking mission to claim airdrop
-	missionIDStaking = 1
-
-	// missionIDVoting is the mission ID for voting mission to claim airdrop
-	missionIDVoting = 2
-)
-
 // this line is used by starport scaffolding # stargate/wasm/app/enabledProposals
 
 func getGovProposalHandlers() []govclient.ProposalHandler {
@@ -171,6 +166,7 @@ var (
 		slashing.AppModuleBasic{},
 		feegrantmodule.AppModuleBasic{},
 		authzmodule.AppModuleBasic{},
+		groupmodule.AppModuleBasic{},
 		ibc.AppModuleBasic{},
 		upgrade.AppModuleBasic{},
 		evidence.AppModuleBasic{},
@@ -256,6 +252,7 @@ type App struct {
 	TransferKeeper    ibctransferkeeper.Keeper
 	FeeGrantKeeper    feegrantkeeper.Keeper
 	AuthzKeeper       authzkeeper.Keeper
+	GroupKeeper       groupkeeper.Keeper
 	FundraisingKeeper fundraisingkeeper.Keeper
 
 	// make scoped keepers public for test purposes
@@ -279,6 +276,9 @@ type App struct {
 	// the module manager
 	mm *module.Manager
 
+	// the module configurator used to register the store migrations
+	configurator module.Configurator
+
 	// simulation manager
 	sm *module.SimulationManager
 }
@@ -318,6 +318,7 @@ func New(
 		upgradetypes.StoreKey,
 		feegrant.StoreKey,
 		authzkeeper.StoreKey,
+		group.StoreKey,
 		evidencetypes.StoreKey,
 		ibctransfertypes.StoreKey,
 		capabilitytypes.StoreKey,
@@ -385,6 +386,16 @@ func New(
 		app.AuthKeeper,
 	)
 
+	// group policy accounts can be registered as coordinators to manage
+	// chains and campaigns through group proposals
+	app.GroupKeeper = groupkeeper.NewKeeper(
+		keys[group.StoreKey],
+		appCodec,
+		app.BaseApp.MsgServiceRouter(),
+		app.AuthKeeper,
+		group.DefaultConfig(),
+	)
+
 	app.BankKeeper = bankkeeper.NewBaseKeeper(
 		appCodec,
 		keys[banktypes.StoreKey],
@@ -463,7 +474,7 @@ func New(
 	// register the proposal types
 	govRouter := govv1beta1.NewRouter()
 	govRouter.AddRoute(govtypes.RouterKey, govv1beta1.ProposalHandler).
-		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
+		AddRoute(paramproposal.RouterKey, newParamChangeProposalHandler(app.ParamsKeeper)).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
 		AddRoute(ibchost.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper))
@@ -529,6 +540,7 @@ func New(
 		app.GetSubspace(launchtypes.ModuleName),
 		app.DistrKeeper,
 		app.ProfileKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	app.RewardKeeper = *rewardkeeper.NewKeeper(
@@ -540,6 +552,7 @@ func New(
 		app.BankKeeper,
 		app.ProfileKeeper,
 		app.LaunchKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	campaignKeeper := campaignkeeper.NewKeeper(
@@ -551,9 +564,13 @@ func New(
 		app.BankKeeper,
 		app.DistrKeeper,
 		app.ProfileKeeper,
+		app.RewardKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	app.CampaignKeeper = *campaignKeeper
 	app.LaunchKeeper.SetCampaignKeeper(campaignKeeper)
+	app.ProfileKeeper.SetLaunchKeeper(app.LaunchKeeper)
+	app.ProfileKeeper.SetCampaignKeeper(app.CampaignKeeper)
 
 	scopedMonitoringcKeeper := app.CapabilityKeeper.ScopeToModule(monitoringctypes.ModuleName)
 	app.ScopedMonitoringcKeeper = scopedMonitoringcKeeper
@@ -568,7 +585,9 @@ func New(
 		&app.IBCKeeper.PortKeeper,
 		scopedMonitoringcKeeper,
 		app.LaunchKeeper,
+		app.ProfileKeeper,
 		app.RewardKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	app.LaunchKeeper.SetMonitoringcKeeper(app.MonitoringcKeeper)
 	monitoringcModule := monitoringc.NewAppModule(appCodec, app.MonitoringcKeeper, app.AuthKeeper, app.BankKeeper)
@@ -585,6 +604,7 @@ func New(
 		app.IBCKeeper.ChannelKeeper,
 		&app.IBCKeeper.PortKeeper,
 		scopedMonitoringKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	monitoringpModule := monitoringp.NewAppModule(appCodec, app.MonitoringpKeeper)
 
@@ -595,6 +615,7 @@ func New(
 		app.GetSubspace(participationtypes.ModuleName),
 		app.FundraisingKeeper,
 		stakingKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	app.ClaimKeeper = *claimkeeper.NewKeeper(
@@ -619,14 +640,14 @@ func New(
 		stakingtypes.NewMultiStakingHooks(
 			app.DistrKeeper.Hooks(),
 			app.SlashingKeeper.Hooks(),
-			app.ClaimKeeper.NewMissionDelegationHooks(missionIDStaking),
+			newMissionDelegationHooks(app.ClaimKeeper, app.CampaignKeeper),
 		),
 	)
 
 	// register the gov hooks
 	app.GovKeeper = *app.GovKeeper.SetHooks(
 		govtypes.NewMultiGovHooks(
-			app.ClaimKeeper.NewMissionVoteHooks(missionIDVoting),
+			newMissionVoteHooks(app.ClaimKeeper, app.CampaignKeeper),
 		),
 	)
 
@@ -658,6 +679,7 @@ func New(
 		capability.NewAppModule(appCodec, *app.CapabilityKeeper),
 		feegrantmodule.NewAppModule(appCodec, app.AuthKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
 		authzmodule.NewAppModule(appCodec, app.AuthzKeeper, app.AuthKeeper, app.BankKeeper, app.interfaceRegistry),
+		groupmodule.NewAppModule(appCodec, app.GroupKeeper, app.AuthKeeper, app.BankKeeper, app.interfaceRegistry),
 		crisis.NewAppModule(&app.CrisisKeeper, skipGenesisInvariants),
 		gov.NewAppModule(appCodec, app.GovKeeper, app.AuthKeeper, app.BankKeeper),
 		mint.NewAppModule(appCodec, app.MintKeeper, app.AuthKeeper),
@@ -703,6 +725,7 @@ func New(
 		crisistypes.ModuleName,
 		genutiltypes.ModuleName,
 		authz.ModuleName,
+		group.ModuleName,
 		feegrant.ModuleName,
 		paramstypes.ModuleName,
 		profiletypes.ModuleName,
@@ -731,6 +754,7 @@ func New(
 		genutiltypes.ModuleName,
 		evidencetypes.ModuleName,
 		authz.ModuleName,
+		group.ModuleName,
 		feegrant.ModuleName,
 		paramstypes.ModuleName,
 		upgradetypes.ModuleName,
@@ -769,6 +793,7 @@ func New(
 		upgradetypes.ModuleName,
 		ibctransfertypes.ModuleName,
 		authz.ModuleName,
+		group.ModuleName,
 		feegrant.ModuleName,
 		profiletypes.ModuleName,
 		launchtypes.ModuleName,
@@ -783,7 +808,8 @@ func New(
 
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter(), encodingConfig.Amino)
-	app.mm.RegisterServices(module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter()))
+	app.configurator = module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
+	app.mm.RegisterServices(app.configurator)
 
 	// create the simulation manager and define the order of the modules for deterministic simulations
 	app.sm = module.NewSimulationManager(
@@ -821,13 +847,17 @@ func New(
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
 
-	anteHandler, err := ante.NewAnteHandler(
-		ante.HandlerOptions{
-			AccountKeeper:   app.AuthKeeper,
-			BankKeeper:      app.BankKeeper,
-			SignModeHandler: encodingConfig.TxConfig.SignModeHandler(),
-			FeegrantKeeper:  app.FeeGrantKeeper,
-			SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+	anteHandler, err := NewAnteHandler(
+		HandlerOptions{
+			HandlerOptions: ante.HandlerOptions{
+				AccountKeeper:   app.AuthKeeper,
+				BankKeeper:      app.BankKeeper,
+				SignModeHandler: encodingConfig.TxConfig.SignModeHandler(),
+				FeegrantKeeper:  app.FeeGrantKeeper,
+				SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+			},
+			VoucherTransferChecker: app.CampaignKeeper,
+			GroupKeeper:            app.GroupKeeper,
 		},
 	)
 	if err != nil {
@@ -837,6 +867,9 @@ func New(
 	app.SetAnteHandler(anteHandler)
 	app.SetEndBlocker(app.EndBlocker)
 
+	app.setupUpgradeHandlers()
+	app.setupUpgradeStoreLoaders()
+
 	if loadLatest {
 		if err := app.LoadLatestVersion(); err != nil {
 			tmos.Exit(err.Error())
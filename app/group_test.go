@@ -0,0 +1,224 @@
+package app_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/tendermint/spn/app"
+	"github.com/tendermint/spn/testutil"
+	"github.com/tendermint/spn/testutil/sample"
+	campaignkeeper "github.com/tendermint/spn/x/campaign/keeper"
+	campaigntypes "github.com/tendermint/spn/x/campaign/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+)
+
+// setupGroupApp initializes an app from a genesis with a single validator
+func setupGroupApp(t *testing.T, blockTime time.Time) (*app.App, sdk.Context) {
+	spnApp, genesisState := testutil.GenApp(true, 5)
+	stateBytes, err := json.MarshalIndent(genesisState, "", " ")
+	require.NoError(t, err)
+
+	spnApp.InitChain(abci.RequestInitChain{
+		Validators:      []abci.ValidatorUpdate{},
+		ConsensusParams: simapp.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+
+	ctx := spnApp.BaseApp.NewContext(false, tmproto.Header{
+		Height: 1,
+		Time:   blockTime,
+	})
+	return spnApp, ctx
+}
+
+// groupCoordinator is a group whose policy account acts as a coordinator
+type groupCoordinator struct {
+	t       *testing.T
+	app     *app.App
+	members []string
+	policy  string
+}
+
+// newGroupCoordinator creates a group administered by its own policy that requires all the members to approve
+// a proposal before it can be executed
+func newGroupCoordinator(t *testing.T, spnApp *app.App, ctx sdk.Context, members ...string) groupCoordinator {
+	memberRequests := make([]group.MemberRequest, 0, len(members))
+	for _, member := range members {
+		memberRequests = append(memberRequests, group.MemberRequest{
+			Address: member,
+			Weight:  "1",
+		})
+	}
+	msg, err := group.NewMsgCreateGroupWithPolicy(
+		members[0],
+		memberRequests,
+		"",
+		"",
+		true,
+		group.NewThresholdDecisionPolicy("2", time.Hour, 0),
+	)
+	require.NoError(t, err)
+
+	res, err := spnApp.GroupKeeper.CreateGroupWithPolicy(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+
+	return groupCoordinator{
+		t:       t,
+		app:     spnApp,
+		members: members,
+		policy:  res.GroupPolicyAddress,
+	}
+}
+
+// submitProposal submits a proposal from the first member of the group and returns its ID
+func (gc groupCoordinator) submitProposal(ctx sdk.Context, msgs ...sdk.Msg) uint64 {
+	msg, err := group.NewMsgSubmitProposal(gc.policy, gc.members[:1], msgs, "", group.Exec_EXEC_UNSPECIFIED)
+	require.NoError(gc.t, err)
+
+	res, err := gc.app.GroupKeeper.SubmitProposal(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(gc.t, err)
+	return res.ProposalId
+}
+
+// vote approves the proposal with the provided members
+func (gc groupCoordinator) vote(ctx sdk.Context, proposalID uint64, voters ...string) {
+	for _, voter := range voters {
+		_, err := gc.app.GroupKeeper.Vote(sdk.WrapSDKContext(ctx), &group.MsgVote{
+			ProposalId: proposalID,
+			Voter:      voter,
+			Option:     group.VOTE_OPTION_YES,
+		})
+		require.NoError(gc.t, err)
+	}
+}
+
+// exec executes the proposal and returns the result of the execution
+func (gc groupCoordinator) exec(ctx sdk.Context, proposalID uint64) (group.ProposalExecutorResult, error) {
+	res, err := gc.app.GroupKeeper.Exec(sdk.WrapSDKContext(ctx), &group.MsgExec{
+		ProposalId: proposalID,
+		Executor:   gc.members[0],
+	})
+	if err != nil {
+		return group.PROPOSAL_EXECUTOR_RESULT_NOT_RUN, err
+	}
+	return res.Result, nil
+}
+
+// approveAndExec submits a proposal with the messages, approves it with all the members and executes it
+func (gc groupCoordinator) approveAndExec(ctx sdk.Context, msgs ...sdk.Msg) {
+	proposalID := gc.submitProposal(ctx, msgs...)
+	gc.vote(ctx, proposalID, gc.members...)
+	result, err := gc.exec(ctx, proposalID)
+	require.NoError(gc.t, err)
+	require.Equal(gc.t, group.PROPOSAL_EXECUTOR_RESULT_SUCCESS, result)
+}
+
+func TestGroupPolicyCoordinator(t *testing.T) {
+	var (
+		r            = sample.Rand()
+		blockTime    = time.Now().UTC()
+		spnApp, ctx  = setupGroupApp(t, blockTime)
+		campaignSrv  = campaignkeeper.NewMsgServerImpl(spnApp.CampaignKeeper)
+		member1      = sample.Address(r)
+		member2      = sample.Address(r)
+		gc           = newGroupCoordinator(t, spnApp, ctx, member1, member2)
+		policyAddr   = sdk.MustAccAddressFromBech32(gc.policy)
+		coordID      uint64
+		campaignID   uint64
+		launchID     uint64
+		mintedShares = sample.Shares(r)
+	)
+
+	t.Run("should allow a group policy to register as a coordinator", func(t *testing.T) {
+		gc.approveAndExec(ctx, profiletypes.NewMsgCreateCoordinator(gc.policy, "", "", ""))
+
+		var err error
+		coordID, err = spnApp.ProfileKeeper.CoordinatorIDFromAddress(ctx, gc.policy)
+		require.NoError(t, err)
+		coord, found := spnApp.ProfileKeeper.GetCoordinator(ctx, coordID)
+		require.True(t, found)
+		require.Equal(t, gc.policy, coord.Address)
+		require.True(t, coord.Active)
+	})
+
+	t.Run("should allow the group coordinator to create a campaign and a chain", func(t *testing.T) {
+		msgCreateChain := sample.MsgCreateChain(r, gc.policy, "", false, 0)
+		gc.approveAndExec(
+			ctx,
+			campaigntypes.NewMsgCreateCampaign(gc.policy, sample.CampaignName(r), sample.TotalSupply(r), sample.Metadata(r, 20)),
+			&msgCreateChain,
+		)
+
+		campaigns := spnApp.CampaignKeeper.GetAllCampaign(ctx)
+		require.Len(t, campaigns, 1)
+		require.EqualValues(t, coordID, campaigns[0].CoordinatorID)
+		campaignID = campaigns[0].CampaignID
+
+		chains := spnApp.LaunchKeeper.GetAllChain(ctx)
+		require.Len(t, chains, 1)
+		require.EqualValues(t, coordID, chains[0].CoordinatorID)
+		launchID = chains[0].LaunchID
+	})
+
+	t.Run("should prevent a group member from acting as the coordinator", func(t *testing.T) {
+		_, err := campaignSrv.MintVouchers(
+			sdk.WrapSDKContext(ctx),
+			campaigntypes.NewMsgMintVouchers(member1, campaignID, mintedShares),
+		)
+		require.ErrorIs(t, err, profiletypes.ErrCoordAddressNotFound)
+	})
+
+	t.Run("should prevent executing a proposal not approved by the group", func(t *testing.T) {
+		proposalID := gc.submitProposal(ctx, campaigntypes.NewMsgMintVouchers(gc.policy, campaignID, mintedShares))
+		gc.vote(ctx, proposalID, member1)
+		result, err := gc.exec(ctx, proposalID)
+		require.NoError(t, err)
+		require.Equal(t, group.PROPOSAL_EXECUTOR_RESULT_NOT_RUN, result)
+
+		campaign, found := spnApp.CampaignKeeper.GetCampaign(ctx, campaignID)
+		require.True(t, found)
+		require.True(t, campaign.AllocatedShares.Empty())
+	})
+
+	t.Run("should allow minting vouchers through a group proposal", func(t *testing.T) {
+		gc.approveAndExec(ctx, campaigntypes.NewMsgMintVouchers(gc.policy, campaignID, mintedShares))
+
+		vouchers, err := campaigntypes.SharesToVouchers(mintedShares, campaignID)
+		require.NoError(t, err)
+		balance := spnApp.BankKeeper.GetAllBalances(ctx, policyAddr)
+		require.True(t, balance.IsEqual(vouchers))
+
+		campaign, found := spnApp.CampaignKeeper.GetCampaign(ctx, campaignID)
+		require.True(t, found)
+		require.True(t, campaigntypes.IsEqualShares(campaign.AllocatedShares, mintedShares))
+	})
+
+	t.Run("should allow updating the total supply through a group proposal", func(t *testing.T) {
+		totalSupplyUpdate := sample.TotalSupply(r)
+		gc.approveAndExec(ctx, campaigntypes.NewMsgUpdateTotalSupply(gc.policy, campaignID, totalSupplyUpdate))
+
+		campaign, found := spnApp.CampaignKeeper.GetCampaign(ctx, campaignID)
+		require.True(t, found)
+		for _, coin := range totalSupplyUpdate {
+			require.True(t, campaign.TotalSupply.AmountOf(coin.Denom).Equal(coin.Amount))
+		}
+	})
+
+	t.Run("should allow triggering the launch through a group proposal", func(t *testing.T) {
+		msgTriggerLaunch := sample.MsgTriggerLaunch(r, gc.policy, launchID, blockTime)
+		gc.approveAndExec(ctx, &msgTriggerLaunch)
+
+		chain, found := spnApp.LaunchKeeper.GetChain(ctx, launchID)
+		require.True(t, found)
+		require.True(t, chain.LaunchTriggered)
+		require.True(t, chain.LaunchTime.Equal(msgTriggerLaunch.LaunchTime))
+	})
+}
@@ -0,0 +1,62 @@
+package app
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	claimkeeper "github.com/ignite/modules/x/claim/keeper"
+
+	campaignkeeper "github.com/tendermint/spn/x/campaign/keeper"
+)
+
+// missionDelegationHooks completes the staking mission of the airdrop on delegation
+// The ID of the mission is read from the campaign params when the hook is triggered
+type missionDelegationHooks struct {
+	claimkeeper.MissionDelegationHooks
+
+	claimKeeper    claimkeeper.Keeper
+	campaignKeeper campaignkeeper.Keeper
+}
+
+// newMissionDelegationHooks returns staking hooks completing the staking mission set in the campaign params
+func newMissionDelegationHooks(
+	claimKeeper claimkeeper.Keeper,
+	campaignKeeper campaignkeeper.Keeper,
+) missionDelegationHooks {
+	return missionDelegationHooks{
+		MissionDelegationHooks: claimKeeper.NewMissionDelegationHooks(0),
+		claimKeeper:            claimKeeper,
+		campaignKeeper:         campaignKeeper,
+	}
+}
+
+// BeforeDelegationCreated completes the staking mission when a delegation is performed
+func (h missionDelegationHooks) BeforeDelegationCreated(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	missionID := h.campaignKeeper.StakingMissionID(ctx)
+	return h.claimKeeper.NewMissionDelegationHooks(missionID).BeforeDelegationCreated(ctx, delAddr, valAddr)
+}
+
+// missionVoteHooks completes the voting mission of the airdrop when a vote is cast
+// The ID of the mission is read from the campaign params when the hook is triggered
+type missionVoteHooks struct {
+	claimkeeper.MissionVoteHooks
+
+	claimKeeper    claimkeeper.Keeper
+	campaignKeeper campaignkeeper.Keeper
+}
+
+// newMissionVoteHooks returns gov hooks completing the voting mission set in the campaign params
+func newMissionVoteHooks(
+	claimKeeper claimkeeper.Keeper,
+	campaignKeeper campaignkeeper.Keeper,
+) missionVoteHooks {
+	return missionVoteHooks{
+		MissionVoteHooks: claimKeeper.NewMissionVoteHooks(0),
+		claimKeeper:      claimKeeper,
+		campaignKeeper:   campaignKeeper,
+	}
+}
+
+// AfterProposalVote completes the voting mission when a vote is cast
+func (h missionVoteHooks) AfterProposalVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress) {
+	missionID := h.campaignKeeper.VotingMissionID(ctx)
+	h.claimKeeper.NewMissionVoteHooks(missionID).AfterProposalVote(ctx, proposalID, voterAddr)
+}
@@ -0,0 +1,48 @@
+package app
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+
+	campaigntypes "github.com/tendermint/spn/x/campaign/types"
+)
+
+// newParamChangeProposalHandler returns the param change proposal handler validating the campaign params
+// as a whole once changed, the params subspace only validates each changed param on its own and can't
+// check the staking and voting mission IDs are different
+func newParamChangeProposalHandler(k paramskeeper.Keeper) govv1beta1.Handler {
+	handler := params.NewParamChangeProposalHandler(k)
+
+	return func(ctx sdk.Context, content govv1beta1.Content) error {
+		if err := handler(ctx, content); err != nil {
+			return err
+		}
+
+		proposal, ok := content.(*paramproposal.ParameterChangeProposal)
+		if !ok {
+			return nil
+		}
+		for _, change := range proposal.Changes {
+			if change.Subspace != campaigntypes.ModuleName {
+				continue
+			}
+
+			subspace, found := k.GetSubspace(campaigntypes.ModuleName)
+			if !found {
+				return sdkerrors.Wrapf(paramproposal.ErrUnknownSubspace, "%s", campaigntypes.ModuleName)
+			}
+			var campaignParams campaigntypes.Params
+			subspace.GetParamSet(ctx, &campaignParams)
+			if err := campaignParams.ValidateBasic(); err != nil {
+				return sdkerrors.Wrap(sdkerrortypes.ErrInvalidRequest, err.Error())
+			}
+			return nil
+		}
+		return nil
+	}
+}
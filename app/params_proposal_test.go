@@ -0,0 +1,44 @@
+package app_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	sdkerrortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	"github.com/stretchr/testify/require"
+
+	campaigntypes "github.com/tendermint/spn/x/campaign/types"
+)
+
+func TestParamChangeProposalHandler(t *testing.T) {
+	spnApp, ctx := setupGroupApp(t, time.Now())
+	handler := spnApp.GovKeeper.LegacyRouter().GetRoute(paramproposal.RouterKey)
+	params := spnApp.CampaignKeeper.GetParams(ctx)
+
+	newProposal := func(key string, value uint64) *paramproposal.ParameterChangeProposal {
+		return paramproposal.NewParameterChangeProposal("title", "description", []paramproposal.ParamChange{
+			paramproposal.NewParamChange(campaigntypes.ModuleName, key, fmt.Sprintf(`"%d"`, value)),
+		})
+	}
+
+	t.Run("should prevent setting the voting mission ID to the staking mission ID", func(t *testing.T) {
+		cacheCtx, _ := ctx.CacheContext()
+		err := handler(cacheCtx, newProposal(string(campaigntypes.KeyVotingMissionID), params.StakingMissionID))
+		require.ErrorIs(t, err, sdkerrortypes.ErrInvalidRequest)
+	})
+
+	t.Run("should prevent setting the staking mission ID to the voting mission ID", func(t *testing.T) {
+		cacheCtx, _ := ctx.CacheContext()
+		err := handler(cacheCtx, newProposal(string(campaigntypes.KeyStakingMissionID), params.VotingMissionID))
+		require.ErrorIs(t, err, sdkerrortypes.ErrInvalidRequest)
+	})
+
+	t.Run("should allow changing a mission ID to a different value", func(t *testing.T) {
+		missionID := params.StakingMissionID + params.VotingMissionID
+		err := handler(ctx, newProposal(string(campaigntypes.KeyVotingMissionID), missionID))
+		require.NoError(t, err)
+		require.EqualValues(t, missionID, spnApp.CampaignKeeper.VotingMissionID(ctx))
+	})
+}
@@ -0,0 +1,45 @@
+package app
+
+import (
+	"fmt"
+
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	"github.com/tendermint/spn/app/upgrades"
+	v2 "github.com/tendermint/spn/app/upgrades/v2"
+)
+
+// Upgrades defines the list of the chain upgrades handled by the app
+var Upgrades = []upgrades.Upgrade{
+	v2.Upgrade,
+}
+
+// setupUpgradeHandlers registers the upgrade handlers of the chain upgrades
+func (app *App) setupUpgradeHandlers() {
+	for _, upgrade := range Upgrades {
+		app.UpgradeKeeper.SetUpgradeHandler(
+			upgrade.UpgradeName,
+			upgrade.CreateUpgradeHandler(app.mm, app.configurator),
+		)
+	}
+}
+
+// setupUpgradeStoreLoaders sets the store loader of the upcoming upgrade
+// to add, rename or delete the stores of the upgrade at the upgrade height
+func (app *App) setupUpgradeStoreLoaders() {
+	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
+	if err != nil {
+		panic(fmt.Sprintf("failed to read upgrade info from disk: %s", err))
+	}
+
+	if app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		return
+	}
+
+	for _, upgrade := range Upgrades {
+		if upgradeInfo.Name == upgrade.UpgradeName {
+			storeUpgrades := upgrade.StoreUpgrades
+			app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
+		}
+	}
+}
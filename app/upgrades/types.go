@@ -0,0 +1,22 @@
+package upgrades
+
+import (
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// Upgrade defines a struct containing necessary fields that a chain-upgrade proposal
+// must have written in order for the state migration to go smoothly
+// An upgrade must implement this struct, and then set it in the app.go
+// The app.go will then define the handler
+type Upgrade struct {
+	// UpgradeName is the name of the upgrade as set in the software upgrade proposal
+	UpgradeName string
+
+	// CreateUpgradeHandler defines the function that creates an upgrade handler
+	CreateUpgradeHandler func(*module.Manager, module.Configurator) upgradetypes.UpgradeHandler
+
+	// StoreUpgrades defines the stores added, renamed or deleted in the upgrade
+	StoreUpgrades storetypes.StoreUpgrades
+}
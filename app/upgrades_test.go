@@ -0,0 +1,20 @@
+package app_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/spn/app"
+)
+
+func TestUpgrades(t *testing.T) {
+	names := make(map[string]struct{})
+	for _, upgrade := range app.Upgrades {
+		require.NotEmpty(t, upgrade.UpgradeName)
+		require.NotNil(t, upgrade.CreateUpgradeHandler)
+		_, found := names[upgrade.UpgradeName]
+		require.False(t, found, "duplicated upgrade name %s", upgrade.UpgradeName)
+		names[upgrade.UpgradeName] = struct{}{}
+	}
+}
@@ -0,0 +1,84 @@
+package app_test
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/tendermint/spn/app"
+	"github.com/tendermint/spn/cmd"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+)
+
+func TestUpdateValidatorAddressSignatures(t *testing.T) {
+	var (
+		blockTime      = time.Now()
+		spnApp, _      = setupGroupApp(t, blockTime)
+		txConfig       = cmd.MakeEncodingConfig(app.ModuleBasics).TxConfig
+		privKey        = secp256k1.GenPrivKey()
+		newPrivKey     = secp256k1.GenPrivKey()
+		address        = sdk.AccAddress(privKey.PubKey().Address())
+		newAddress     = sdk.AccAddress(newPrivKey.PubKey().Address())
+		accountNumbers []uint64
+	)
+
+	// commit a first block to check the transactions against the genesis state
+	header := tmproto.Header{Height: 1, Time: blockTime}
+	spnApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	spnApp.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	spnApp.Commit()
+	ctx := spnApp.BaseApp.NewContext(true, header)
+	for _, addr := range []sdk.AccAddress{address, newAddress} {
+		account := spnApp.AuthKeeper.NewAccountWithAddress(ctx, addr)
+		spnApp.AuthKeeper.SetAccount(ctx, account)
+		accountNumbers = append(accountNumbers, account.GetAccountNumber())
+	}
+	msg := profiletypes.NewMsgUpdateValidatorAddress(address.String(), newAddress.String())
+
+	for _, tc := range []struct {
+		name     string
+		privKeys []cryptotypes.PrivKey
+		err      error
+	}{
+		{
+			name:     "should prevent updating the validator address without the signature of the new address",
+			privKeys: []cryptotypes.PrivKey{privKey},
+			err:      sdkerrortypes.ErrUnauthorized,
+		},
+		{
+			name:     "should allow updating the validator address with the signatures of both addresses",
+			privKeys: []cryptotypes.PrivKey{privKey, newPrivKey},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			tx, err := helpers.GenSignedMockTx(
+				rand.New(rand.NewSource(time.Now().UnixNano())),
+				txConfig,
+				[]sdk.Msg{msg},
+				sdk.NewCoins(),
+				helpers.DefaultGenTxGas,
+				"",
+				accountNumbers[:len(tc.privKeys)],
+				make([]uint64, len(tc.privKeys)),
+				tc.privKeys...,
+			)
+			require.NoError(t, err)
+
+			_, _, err = spnApp.SimCheck(txConfig.TxEncoder(), tx)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,387 @@
+// Package genesis provides methods to build the genesis of a chain from its launch information
+package genesis
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	authvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	tmjson "github.com/tendermint/tendermint/libs/json"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+)
+
+// LaunchInformation contains the approved launch information of a chain to include in the genesis
+type LaunchInformation struct {
+	GenesisAccounts   []launchtypes.GenesisAccount
+	VestingAccounts   []launchtypes.VestingAccount
+	GenesisValidators []launchtypes.GenesisValidator
+}
+
+// Option configures the genesis builder
+type Option func(*builder)
+
+type builder struct {
+	addressPrefix string
+}
+
+// WithAddressPrefix converts the addresses of the accounts to the provided bech32 prefix
+func WithAddressPrefix(prefix string) Option {
+	return func(b *builder) {
+		b.addressPrefix = prefix
+	}
+}
+
+// Hash returns the hash of a genesis
+// The hash function is sha256
+func Hash(genesis []byte) string {
+	hash := sha256.Sum256(genesis)
+	return hex.EncodeToString(hash[:])
+}
+
+// CheckInitialGenesis verifies the initial genesis matches the hash defined in the chain if any
+func CheckInitialGenesis(chain launchtypes.Chain, initialGenesis []byte) error {
+	genesisURL, ok := chain.InitialGenesis.Source.(*launchtypes.InitialGenesis_GenesisURL)
+	if !ok {
+		return nil
+	}
+	hash := launchtypes.GenesisURLHash(string(initialGenesis))
+	if hash != genesisURL.GenesisURL.Hash {
+		return fmt.Errorf(
+			"initial genesis hash %s doesn't match the expected hash %s",
+			hash,
+			genesisURL.GenesisURL.Hash,
+		)
+	}
+	return nil
+}
+
+// Build returns the genesis of a chain from its initial genesis and its launch information
+// The accounts and the gentxs are sorted by address so the resulting genesis is reproducible
+func Build(
+	cdc codec.Codec,
+	chain launchtypes.Chain,
+	initialGenesis []byte,
+	info LaunchInformation,
+	options ...Option,
+) ([]byte, error) {
+	var b builder
+	for _, apply := range options {
+		apply(&b)
+	}
+
+	if err := CheckInitialGenesis(chain, initialGenesis); err != nil {
+		return nil, err
+	}
+
+	genDoc, err := tmtypes.GenesisDocFromJSON(initialGenesis)
+	if err != nil {
+		return nil, fmt.Errorf("invalid initial genesis: %w", err)
+	}
+	genDoc.ChainID = chain.GenesisChainID
+	if chain.LaunchTriggered {
+		genDoc.GenesisTime = chain.LaunchTime.UTC()
+	}
+
+	var appState map[string]json.RawMessage
+	if err := json.Unmarshal(genDoc.AppState, &appState); err != nil {
+		return nil, fmt.Errorf("invalid initial genesis app state: %w", err)
+	}
+	if appState == nil {
+		appState = make(map[string]json.RawMessage)
+	}
+
+	if err := b.addAccounts(cdc, appState, chain, info); err != nil {
+		return nil, err
+	}
+	if err := addGentxs(cdc, appState, info.GenesisValidators); err != nil {
+		return nil, err
+	}
+
+	genDoc.AppState, err = json.Marshal(appState)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal application genesis state: %w", err)
+	}
+	if err := genDoc.ValidateAndComplete(); err != nil {
+		return nil, fmt.Errorf("invalid genesis: %w", err)
+	}
+
+	return tmjson.MarshalIndent(genDoc, "", "  ")
+}
+
+// addAccounts adds the genesis and vesting accounts into the auth and bank genesis states
+// If the chain defines an account balance, the genesis accounts get this balance instead of their requested coins
+func (b builder) addAccounts(
+	cdc codec.Codec,
+	appState map[string]json.RawMessage,
+	chain launchtypes.Chain,
+	info LaunchInformation,
+) error {
+	authGenState := authtypes.GetGenesisStateFromAppState(cdc, appState)
+	bankGenState := banktypes.GetGenesisStateFromAppState(cdc, appState)
+
+	// the accounts of the initial genesis include the module accounts that may have no balance
+	initialAccounts, err := authtypes.UnpackAccounts(authGenState.Accounts)
+	if err != nil {
+		return fmt.Errorf("invalid accounts in the initial genesis: %w", err)
+	}
+	inInitialGenesis := make(map[string]struct{})
+	for _, acc := range initialAccounts {
+		inInitialGenesis[acc.GetAddress().String()] = struct{}{}
+	}
+	for _, balance := range bankGenState.Balances {
+		inInitialGenesis[balance.Address] = struct{}{}
+	}
+
+	type account struct {
+		address string
+		account authtypes.GenesisAccount
+	}
+	var (
+		accounts []account
+		balances []banktypes.Balance
+	)
+	added := make(map[string]struct{})
+	addAccount := func(address string) (string, error) {
+		address, err := b.convertAddress(address)
+		if err != nil {
+			return "", err
+		}
+		if _, ok := inInitialGenesis[address]; ok {
+			return "", fmt.Errorf("account %s already exists in the initial genesis", address)
+		}
+		if _, ok := added[address]; ok {
+			return "", fmt.Errorf("account %s is duplicated in the launch information", address)
+		}
+		added[address] = struct{}{}
+		return address, nil
+	}
+
+	for _, acc := range info.GenesisAccounts {
+		address, err := addAccount(acc.Address)
+		if err != nil {
+			return err
+		}
+		coins := acc.Coins
+		if !chain.AccountBalance.Empty() {
+			coins = chain.AccountBalance
+		}
+		accounts = append(accounts, account{
+			address: address,
+			account: &authtypes.BaseAccount{Address: address},
+		})
+		balances = append(balances, banktypes.Balance{Address: address, Coins: coins.Sort()})
+	}
+
+	for _, acc := range info.VestingAccounts {
+		address, err := addAccount(acc.Address)
+		if err != nil {
+			return err
+		}
+		switch options := acc.VestingOptions.Options.(type) {
+		case *launchtypes.VestingOptions_DelayedVesting:
+			dv := options.DelayedVesting
+			baseVestingAccount := authvesting.NewBaseVestingAccount(
+				&authtypes.BaseAccount{Address: address},
+				dv.Vesting.Sort(),
+				dv.EndTime,
+			)
+			accounts = append(accounts, account{
+				address: address,
+				account: authvesting.NewDelayedVestingAccountRaw(baseVestingAccount),
+			})
+			balances = append(balances, banktypes.Balance{Address: address, Coins: dv.TotalBalance.Sort()})
+		case *launchtypes.VestingOptions_ContinuousVesting:
+			cv := options.ContinuousVesting
+			baseVestingAccount := authvesting.NewBaseVestingAccount(
+				&authtypes.BaseAccount{Address: address},
+				cv.Vesting.Sort(),
+				cv.EndTime,
+			)
+			accounts = append(accounts, account{
+				address: address,
+				account: authvesting.NewContinuousVestingAccountRaw(baseVestingAccount, cv.StartTime),
+			})
+			balances = append(balances, banktypes.Balance{Address: address, Coins: cv.TotalBalance.Sort()})
+		case *launchtypes.VestingOptions_PeriodicVesting:
+			pv := options.PeriodicVesting
+			baseVestingAccount := authvesting.NewBaseVestingAccount(
+				&authtypes.BaseAccount{Address: address},
+				pv.Vesting().Sort(),
+				pv.EndTime(),
+			)
+			periods := make(authvesting.Periods, 0, len(pv.Periods))
+			for _, period := range pv.Periods {
+				periods = append(periods, authvesting.Period{
+					Length: period.Length,
+					Amount: period.Amount.Sort(),
+				})
+			}
+			accounts = append(accounts, account{
+				address: address,
+				account: authvesting.NewPeriodicVestingAccountRaw(baseVestingAccount, pv.StartTime, periods),
+			})
+			balances = append(balances, banktypes.Balance{Address: address, Coins: pv.TotalBalance.Sort()})
+		default:
+			return fmt.Errorf("unrecognized vesting options for account %s", acc.Address)
+		}
+	}
+
+	// new accounts are appended after the accounts of the initial genesis
+	sort.Slice(accounts, func(i, j int) bool {
+		return accounts[i].address < accounts[j].address
+	})
+	for _, acc := range accounts {
+		anyAcc, err := codectypes.NewAnyWithValue(acc.account)
+		if err != nil {
+			return fmt.Errorf("failed to convert account %s into any: %w", acc.address, err)
+		}
+		authGenState.Accounts = append(authGenState.Accounts, anyAcc)
+	}
+
+	bankGenState.Balances = append(bankGenState.Balances, balances...)
+	sort.SliceStable(bankGenState.Balances, func(i, j int) bool {
+		return bankGenState.Balances[i].Address < bankGenState.Balances[j].Address
+	})
+
+	// the supply is computed from all the balances since the supply of the initial genesis may be omitted
+	bankGenState.Supply = sdk.NewCoins()
+	for _, balance := range bankGenState.Balances {
+		bankGenState.Supply = bankGenState.Supply.Add(balance.Coins...)
+	}
+
+	authGenStateBz, err := cdc.MarshalJSON(&authGenState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal auth genesis state: %w", err)
+	}
+	appState[authtypes.ModuleName] = authGenStateBz
+
+	bankGenStateBz, err := cdc.MarshalJSON(bankGenState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal bank genesis state: %w", err)
+	}
+	appState[banktypes.ModuleName] = bankGenStateBz
+
+	return nil
+}
+
+// addGentxs adds the gentxs of the genesis validators into the genutil genesis state
+func addGentxs(
+	cdc codec.Codec,
+	appState map[string]json.RawMessage,
+	validators []launchtypes.GenesisValidator,
+) error {
+	protoCdc, ok := cdc.(codec.ProtoCodecMarshaler)
+	if !ok {
+		return fmt.Errorf("codec must be a protobuf codec to decode the gentxs")
+	}
+	txConfig := authtx.NewTxConfig(protoCdc, authtx.DefaultSignModes)
+
+	validators = append([]launchtypes.GenesisValidator(nil), validators...)
+	sort.Slice(validators, func(i, j int) bool {
+		return validators[i].Address < validators[j].Address
+	})
+
+	genutilGenState := genutiltypes.GetGenesisStateFromAppState(cdc, appState)
+	for _, validator := range validators {
+		if err := checkGentx(protoCdc, txConfig.TxJSONDecoder(), validator); err != nil {
+			return fmt.Errorf("invalid gentx for validator %s: %w", validator.Address, err)
+		}
+
+		var gentx bytes.Buffer
+		if err := json.Compact(&gentx, validator.GenTx); err != nil {
+			return fmt.Errorf("invalid gentx for validator %s: %w", validator.Address, err)
+		}
+		genutilGenState.GenTxs = append(genutilGenState.GenTxs, gentx.Bytes())
+	}
+
+	genutiltypes.SetGenesisStateInAppState(cdc, appState, genutilGenState)
+	return nil
+}
+
+// checkGentx decodes the gentx of a genesis validator and checks it creates the validator approved in the
+// launch information: the gentx must contain a single MsgCreateValidator from the validator account with its
+// self-delegation and consensus key. The signature is verified by the chain when the gentx is delivered at genesis
+func checkGentx(
+	cdc codec.ProtoCodecMarshaler,
+	txDecoder sdk.TxDecoder,
+	validator launchtypes.GenesisValidator,
+) error {
+	tx, err := txDecoder(validator.GenTx)
+	if err != nil {
+		return fmt.Errorf("gentx can't be decoded: %w", err)
+	}
+	msgs := tx.GetMsgs()
+	if len(msgs) != 1 {
+		return fmt.Errorf("gentx must contain a single message, got %d", len(msgs))
+	}
+	msg, ok := msgs[0].(*stakingtypes.MsgCreateValidator)
+	if !ok {
+		return fmt.Errorf("gentx must contain a MsgCreateValidator message, got %T", msgs[0])
+	}
+
+	// addresses are compared without their prefix since the chain may use a different prefix than spn
+	_, validatorAddr, err := bech32.DecodeAndConvert(validator.Address)
+	if err != nil {
+		return fmt.Errorf("invalid validator address: %w", err)
+	}
+	_, delegatorAddr, err := bech32.DecodeAndConvert(msg.DelegatorAddress)
+	if err != nil {
+		return fmt.Errorf("invalid delegator address: %w", err)
+	}
+	_, operatorAddr, err := bech32.DecodeAndConvert(msg.ValidatorAddress)
+	if err != nil {
+		return fmt.Errorf("invalid operator address: %w", err)
+	}
+	if !bytes.Equal(validatorAddr, delegatorAddr) || !bytes.Equal(validatorAddr, operatorAddr) {
+		return fmt.Errorf("gentx signer %s is not the validator", msg.DelegatorAddress)
+	}
+
+	if !msg.Value.IsValid() || !msg.Value.IsPositive() {
+		return fmt.Errorf("invalid gentx self-delegation %s", msg.Value.String())
+	}
+	if msg.Value.Denom != validator.SelfDelegation.Denom || !msg.Value.Amount.Equal(validator.SelfDelegation.Amount) {
+		return fmt.Errorf(
+			"gentx self-delegation %s doesn't match the validator self-delegation %s",
+			msg.Value.String(),
+			validator.SelfDelegation.String(),
+		)
+	}
+
+	var pubKey cryptotypes.PubKey
+	if err := cdc.UnpackAny(msg.Pubkey, &pubKey); err != nil {
+		return fmt.Errorf("invalid gentx consensus key: %w", err)
+	}
+	if !bytes.Equal(pubKey.Bytes(), validator.ConsPubKey) {
+		return fmt.Errorf("gentx consensus key doesn't match the validator consensus key")
+	}
+
+	return nil
+}
+
+// convertAddress converts the address into the bech32 prefix of the builder if set
+func (b builder) convertAddress(address string) (string, error) {
+	if b.addressPrefix == "" {
+		return address, nil
+	}
+	_, bz, err := bech32.DecodeAndConvert(address)
+	if err != nil {
+		return "", fmt.Errorf("invalid address %s: %w", address, err)
+	}
+	return bech32.ConvertAndEncode(b.addressPrefix, bz)
+}
@@ -0,0 +1,390 @@
+package genesis_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"strconv"
+	"strings"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	authvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+	tmjson "github.com/tendermint/tendermint/libs/json"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/tendermint/spn/pkg/genesis"
+	"github.com/tendermint/spn/testutil/sample"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+)
+
+var r *rand.Rand
+
+// initialize random generator
+func init() {
+	s := rand.NewSource(1)
+	r = rand.New(s)
+}
+
+const initialGenesis = `{
+  "genesis_time": "2022-01-01T00:00:00Z",
+  "chain_id": "foo-1",
+  "initial_height": "1",
+  "app_hash": "",
+  "app_state": {
+    "foo": {"bar": "baz"}
+  }
+}`
+
+// gentx returns the gentx creating the validator with the provided consensus key and self-delegation
+func gentx(
+	t *testing.T,
+	cdc codec.Codec,
+	address string,
+	consPubKey *ed25519.PubKey,
+	selfDelegation sdk.Coin,
+) []byte {
+	accAddr, err := sdk.AccAddressFromBech32(address)
+	require.NoError(t, err)
+	msg, err := stakingtypes.NewMsgCreateValidator(
+		sdk.ValAddress(accAddr),
+		consPubKey,
+		selfDelegation,
+		stakingtypes.NewDescription("foo", "", "", "", ""),
+		stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
+		sdkmath.OneInt(),
+	)
+	require.NoError(t, err)
+
+	txConfig := authtx.NewTxConfig(cdc.(codec.ProtoCodecMarshaler), authtx.DefaultSignModes)
+	txBuilder := txConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(msg))
+	bz, err := txConfig.TxJSONEncoder()(txBuilder.GetTx())
+	require.NoError(t, err)
+	return bz
+}
+
+// sampleGenesisValidator returns a genesis validator with a valid gentx
+func sampleGenesisValidator(t *testing.T, r *rand.Rand, launchID uint64) launchtypes.GenesisValidator {
+	consPubKey := ed25519.GenPrivKeyFromSecret([]byte(strconv.Itoa(r.Int()))).PubKey().(*ed25519.PubKey)
+	validator := sample.GenesisValidator(r, launchID, sample.Address(r))
+	validator.ConsPubKey = consPubKey.Bytes()
+	validator.GenTx = gentx(t, sample.Codec(), validator.Address, consPubKey, validator.SelfDelegation)
+	return validator
+}
+
+func sampleLaunchInformation(t *testing.T, r *rand.Rand, launchID uint64) genesis.LaunchInformation {
+	validator1 := sampleGenesisValidator(t, r, launchID)
+	validator2 := sampleGenesisValidator(t, r, launchID)
+
+	return genesis.LaunchInformation{
+		GenesisAccounts: []launchtypes.GenesisAccount{
+			sample.GenesisAccount(r, launchID, sample.Address(r)),
+			sample.GenesisAccount(r, launchID, sample.Address(r)),
+			sample.GenesisAccount(r, launchID, sample.Address(r)),
+		},
+		VestingAccounts: []launchtypes.VestingAccount{
+			sample.VestingAccount(r, launchID, sample.Address(r)),
+			sample.VestingAccount(r, launchID, sample.Address(r)),
+			{
+				LaunchID:       launchID,
+				Address:        sample.Address(r),
+				VestingOptions: sample.ContinuousVestingOptions(r),
+			},
+			{
+				LaunchID:       launchID,
+				Address:        sample.Address(r),
+				VestingOptions: sample.PeriodicVestingOptions(r),
+			},
+		},
+		GenesisValidators: []launchtypes.GenesisValidator{validator1, validator2},
+	}
+}
+
+func reverse(info genesis.LaunchInformation) genesis.LaunchInformation {
+	var reversed genesis.LaunchInformation
+	for i := len(info.GenesisAccounts) - 1; i >= 0; i-- {
+		reversed.GenesisAccounts = append(reversed.GenesisAccounts, info.GenesisAccounts[i])
+	}
+	for i := len(info.VestingAccounts) - 1; i >= 0; i-- {
+		reversed.VestingAccounts = append(reversed.VestingAccounts, info.VestingAccounts[i])
+	}
+	for i := len(info.GenesisValidators) - 1; i >= 0; i-- {
+		reversed.GenesisValidators = append(reversed.GenesisValidators, info.GenesisValidators[i])
+	}
+	return reversed
+}
+
+func TestBuild(t *testing.T) {
+	cdc := sample.Codec()
+	chain := sample.Chain(r, 0, 0)
+	chain.InitialGenesis = launchtypes.NewDefaultInitialGenesis()
+	chain.LaunchTriggered = true
+	chain.LaunchTime = sample.Time(r)
+	chain.AccountBalance = nil
+	info := sampleLaunchInformation(t, r, chain.LaunchID)
+
+	t.Run("should build the genesis from the launch information", func(t *testing.T) {
+		genBz, err := genesis.Build(cdc, chain, []byte(initialGenesis), info)
+		require.NoError(t, err)
+
+		genDoc, err := tmtypes.GenesisDocFromJSON(genBz)
+		require.NoError(t, err)
+		require.EqualValues(t, chain.GenesisChainID, genDoc.ChainID)
+		require.True(t, chain.LaunchTime.Equal(genDoc.GenesisTime))
+
+		var appState map[string]json.RawMessage
+		require.NoError(t, json.Unmarshal(genDoc.AppState, &appState))
+		require.JSONEq(t, `{"bar": "baz"}`, string(appState["foo"]))
+
+		authGenState := authtypes.GetGenesisStateFromAppState(cdc, appState)
+		require.Len(t, authGenState.Accounts, len(info.GenesisAccounts)+len(info.VestingAccounts))
+		accounts, err := authtypes.UnpackAccounts(authGenState.Accounts)
+		require.NoError(t, err)
+		vestingAccounts := make(map[string]authtypes.GenesisAccount)
+		for _, acc := range accounts {
+			vestingAccounts[acc.GetAddress().String()] = acc
+		}
+		for _, acc := range info.VestingAccounts {
+			switch acc.VestingOptions.Options.(type) {
+			case *launchtypes.VestingOptions_DelayedVesting:
+				require.IsType(t, &authvesting.DelayedVestingAccount{}, vestingAccounts[acc.Address])
+			case *launchtypes.VestingOptions_ContinuousVesting:
+				require.IsType(t, &authvesting.ContinuousVestingAccount{}, vestingAccounts[acc.Address])
+			case *launchtypes.VestingOptions_PeriodicVesting:
+				require.IsType(t, &authvesting.PeriodicVestingAccount{}, vestingAccounts[acc.Address])
+			}
+		}
+
+		bankGenState := banktypes.GetGenesisStateFromAppState(cdc, appState)
+		require.Len(t, bankGenState.Balances, len(info.GenesisAccounts)+len(info.VestingAccounts))
+		for _, acc := range info.GenesisAccounts {
+			require.Contains(t, bankGenState.Balances, banktypes.Balance{Address: acc.Address, Coins: acc.Coins})
+		}
+		for _, acc := range info.VestingAccounts {
+			require.Contains(t, bankGenState.Balances, banktypes.Balance{
+				Address: acc.Address,
+				Coins:   acc.VestingOptions.TotalBalance(),
+			})
+		}
+
+		genutilGenState := genutiltypes.GetGenesisStateFromAppState(cdc, appState)
+		require.Len(t, genutilGenState.GenTxs, len(info.GenesisValidators))
+	})
+
+	t.Run("should build the same genesis regardless of the order of the launch information", func(t *testing.T) {
+		genBz, err := genesis.Build(cdc, chain, []byte(initialGenesis), info)
+		require.NoError(t, err)
+		reversedGenBz, err := genesis.Build(cdc, chain, []byte(initialGenesis), reverse(info))
+		require.NoError(t, err)
+		require.Equal(t, genesis.Hash(genBz), genesis.Hash(reversedGenBz))
+	})
+
+	t.Run("should convert the addresses to the provided prefix", func(t *testing.T) {
+		genBz, err := genesis.Build(cdc, chain, []byte(initialGenesis), info, genesis.WithAddressPrefix("foo"))
+		require.NoError(t, err)
+
+		genDoc, err := tmtypes.GenesisDocFromJSON(genBz)
+		require.NoError(t, err)
+		var appState map[string]json.RawMessage
+		require.NoError(t, json.Unmarshal(genDoc.AppState, &appState))
+
+		bankGenState := banktypes.GetGenesisStateFromAppState(cdc, appState)
+		for _, balance := range bankGenState.Balances {
+			require.True(t, strings.HasPrefix(balance.Address, "foo1"))
+		}
+
+		_, bz, err := bech32.DecodeAndConvert(info.GenesisAccounts[0].Address)
+		require.NoError(t, err)
+		address, err := bech32.ConvertAndEncode("foo", bz)
+		require.NoError(t, err)
+		require.Contains(t, bankGenState.Balances, banktypes.Balance{
+			Address: address,
+			Coins:   info.GenesisAccounts[0].Coins,
+		})
+	})
+
+	t.Run("should prevent building a genesis with an account already in the initial genesis", func(t *testing.T) {
+		genBz, err := genesis.Build(cdc, chain, []byte(initialGenesis), info)
+		require.NoError(t, err)
+
+		_, err = genesis.Build(cdc, chain, genBz, genesis.LaunchInformation{
+			GenesisAccounts: info.GenesisAccounts[:1],
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("should give the chain account balance to the genesis accounts", func(t *testing.T) {
+		chainWithBalance := chain
+		chainWithBalance.AccountBalance = sample.Coins(r)
+
+		genBz, err := genesis.Build(cdc, chainWithBalance, []byte(initialGenesis), info)
+		require.NoError(t, err)
+
+		genDoc, err := tmtypes.GenesisDocFromJSON(genBz)
+		require.NoError(t, err)
+		var appState map[string]json.RawMessage
+		require.NoError(t, json.Unmarshal(genDoc.AppState, &appState))
+
+		bankGenState := banktypes.GetGenesisStateFromAppState(cdc, appState)
+		for _, acc := range info.GenesisAccounts {
+			require.Contains(t, bankGenState.Balances, banktypes.Balance{
+				Address: acc.Address,
+				Coins:   chainWithBalance.AccountBalance,
+			})
+		}
+		for _, acc := range info.VestingAccounts {
+			require.Contains(t, bankGenState.Balances, banktypes.Balance{
+				Address: acc.Address,
+				Coins:   acc.VestingOptions.TotalBalance(),
+			})
+		}
+	})
+
+	t.Run("should prevent building a genesis with an account duplicated in the launch information", func(t *testing.T) {
+		duplicatedInfo := sampleLaunchInformation(t, r, chain.LaunchID)
+		duplicatedInfo.VestingAccounts[0].Address = duplicatedInfo.GenesisAccounts[0].Address
+
+		_, err := genesis.Build(cdc, chain, []byte(initialGenesis), duplicatedInfo)
+		require.ErrorContains(t, err, "is duplicated in the launch information")
+	})
+
+	t.Run("should prevent building a genesis with a module account of the initial genesis", func(t *testing.T) {
+		moduleAcc := authtypes.NewEmptyModuleAccount("foo")
+		anyAcc, err := codectypes.NewAnyWithValue(moduleAcc)
+		require.NoError(t, err)
+		authGenState := authtypes.DefaultGenesisState()
+		authGenState.Accounts = append(authGenState.Accounts, anyAcc)
+		authGenStateBz, err := cdc.MarshalJSON(authGenState)
+		require.NoError(t, err)
+		genDoc, err := tmtypes.GenesisDocFromJSON([]byte(initialGenesis))
+		require.NoError(t, err)
+		genDoc.AppState, err = json.Marshal(map[string]json.RawMessage{authtypes.ModuleName: authGenStateBz})
+		require.NoError(t, err)
+		initialGenesisWithModule, err := tmjson.Marshal(genDoc)
+		require.NoError(t, err)
+
+		_, err = genesis.Build(cdc, chain, initialGenesisWithModule, genesis.LaunchInformation{
+			GenesisAccounts: []launchtypes.GenesisAccount{
+				sample.GenesisAccount(r, chain.LaunchID, moduleAcc.Address),
+			},
+		})
+		require.ErrorContains(t, err, "already exists in the initial genesis")
+	})
+
+	t.Run("should compute the supply from the balances of the initial genesis and the launch information", func(t *testing.T) {
+		initialBalance := banktypes.Balance{Address: sample.Address(r), Coins: sample.Coins(r)}
+		bankGenState := banktypes.DefaultGenesisState()
+		bankGenState.Balances = []banktypes.Balance{initialBalance}
+		bankGenStateBz, err := cdc.MarshalJSON(bankGenState)
+		require.NoError(t, err)
+		genDoc, err := tmtypes.GenesisDocFromJSON([]byte(initialGenesis))
+		require.NoError(t, err)
+		genDoc.AppState, err = json.Marshal(map[string]json.RawMessage{banktypes.ModuleName: bankGenStateBz})
+		require.NoError(t, err)
+		initialGenesisWithBalance, err := tmjson.Marshal(genDoc)
+		require.NoError(t, err)
+
+		genBz, err := genesis.Build(cdc, chain, initialGenesisWithBalance, info)
+		require.NoError(t, err)
+
+		genDoc, err = tmtypes.GenesisDocFromJSON(genBz)
+		require.NoError(t, err)
+		var appState map[string]json.RawMessage
+		require.NoError(t, json.Unmarshal(genDoc.AppState, &appState))
+		bankGenState = banktypes.GetGenesisStateFromAppState(cdc, appState)
+
+		supply := initialBalance.Coins
+		for _, acc := range info.GenesisAccounts {
+			supply = supply.Add(acc.Coins...)
+		}
+		for _, acc := range info.VestingAccounts {
+			supply = supply.Add(acc.VestingOptions.TotalBalance()...)
+		}
+		require.True(t, supply.IsEqual(bankGenState.Supply), bankGenState.Supply.String())
+		require.NoError(t, bankGenState.Validate())
+	})
+
+	for _, tc := range []struct {
+		desc   string
+		update func(*launchtypes.GenesisValidator)
+	}{
+		{
+			desc: "should prevent building a genesis with an invalid gentx",
+			update: func(validator *launchtypes.GenesisValidator) {
+				validator.GenTx = []byte("foo")
+			},
+		},
+		{
+			desc: "should prevent building a genesis with a gentx without message",
+			update: func(validator *launchtypes.GenesisValidator) {
+				validator.GenTx = []byte(`{"body": {"messages": []}, "auth_info": {}, "signatures": []}`)
+			},
+		},
+		{
+			desc: "should prevent building a genesis with a gentx signed by another account",
+			update: func(validator *launchtypes.GenesisValidator) {
+				other := sampleGenesisValidator(t, r, chain.LaunchID)
+				other.Address = validator.Address
+				*validator = other
+			},
+		},
+		{
+			desc: "should prevent building a genesis with a gentx with another self-delegation",
+			update: func(validator *launchtypes.GenesisValidator) {
+				validator.SelfDelegation = validator.SelfDelegation.AddAmount(sdkmath.OneInt())
+			},
+		},
+		{
+			desc: "should prevent building a genesis with a gentx with another consensus key",
+			update: func(validator *launchtypes.GenesisValidator) {
+				validator.ConsPubKey = sample.PubKey(r).Bytes()
+			},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			invalidInfo := sampleLaunchInformation(t, r, chain.LaunchID)
+			tc.update(&invalidInfo.GenesisValidators[0])
+
+			_, err := genesis.Build(cdc, chain, []byte(initialGenesis), invalidInfo)
+			require.ErrorContains(t, err, "invalid gentx")
+		})
+	}
+
+	t.Run("should prevent building a genesis from an invalid initial genesis", func(t *testing.T) {
+		_, err := genesis.Build(cdc, chain, []byte("foo"), info)
+		require.Error(t, err)
+	})
+}
+
+func TestCheckInitialGenesis(t *testing.T) {
+	chain := sample.Chain(r, 0, 0)
+
+	t.Run("should allow any initial genesis for a default initial genesis", func(t *testing.T) {
+		chain.InitialGenesis = launchtypes.NewDefaultInitialGenesis()
+		require.NoError(t, genesis.CheckInitialGenesis(chain, []byte(initialGenesis)))
+	})
+
+	t.Run("should allow an initial genesis matching the genesis URL hash", func(t *testing.T) {
+		chain.InitialGenesis = launchtypes.NewGenesisURL(
+			"foo.com",
+			launchtypes.GenesisURLHash(initialGenesis),
+		)
+		require.NoError(t, genesis.CheckInitialGenesis(chain, []byte(initialGenesis)))
+	})
+
+	t.Run("should prevent an initial genesis not matching the genesis URL hash", func(t *testing.T) {
+		chain.InitialGenesis = launchtypes.NewGenesisURL("foo.com", sample.GenesisHash(r))
+		require.Error(t, genesis.CheckInitialGenesis(chain, []byte(initialGenesis)))
+	})
+}
@@ -139,6 +139,8 @@ var xxx_messageInfo_MonitoringPacketAck proto.InternalMessageInfo
 type MonitoringPacket struct {
 	BlockHeight     int64           `protobuf:"varint,1,opt,name=blockHeight,proto3" json:"blockHeight,omitempty"`
 	SignatureCounts SignatureCounts `protobuf:"bytes,2,opt,name=signatureCounts,proto3" json:"signatureCounts"`
+	// lastRound is set when the packet contains the signatures of the last round of the monitoring
+	LastRound bool `protobuf:"varint,3,opt,name=lastRound,proto3" json:"lastRound,omitempty"`
 }
 
 func (m *MonitoringPacket) Reset()         { *m = MonitoringPacket{} }
@@ -188,6 +190,13 @@ func (m *MonitoringPacket) GetSignatureCounts() SignatureCounts {
 	return SignatureCounts{}
 }
 
+func (m *MonitoringPacket) GetLastRound() bool {
+	if m != nil {
+		return m.LastRound
+	}
+	return false
+}
+
 // SignatureCounts contains information about signature reporting for a number of blocks
 type SignatureCounts struct {
 	BlockCount uint64           `protobuf:"varint,1,opt,name=blockCount,proto3" json:"blockCount,omitempty"`
@@ -299,32 +308,33 @@ func init() {
 func init() { proto.RegisterFile("types/monitoring.proto", fileDescriptor_4a0d1b50e3af2385) }
 
 var fileDescriptor_4a0d1b50e3af2385 = []byte{
-	// 397 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xbd, 0x8e, 0xda, 0x40,
-	0x10, 0xb6, 0x03, 0x42, 0x61, 0x90, 0x02, 0xda, 0x90, 0x88, 0xa0, 0xc8, 0x20, 0x27, 0x41, 0x34,
-	0xd8, 0x12, 0x69, 0x93, 0x02, 0x87, 0x82, 0x26, 0x52, 0xe4, 0x24, 0x4d, 0x9a, 0xc8, 0xac, 0x57,
-	0xc6, 0x32, 0xde, 0xb5, 0xbc, 0x6b, 0xa4, 0x7b, 0x86, 0x6b, 0xee, 0x01, 0xee, 0x31, 0xee, 0x21,
-	0x28, 0xd1, 0x55, 0xa7, 0x2b, 0xd0, 0x09, 0x5e, 0xe4, 0xc4, 0xae, 0x8f, 0x1f, 0x1f, 0xc5, 0x55,
-	0x9e, 0xf9, 0xe6, 0x9b, 0xef, 0xfb, 0xbc, 0x1a, 0x78, 0x2f, 0x2e, 0x12, 0xc2, 0xed, 0x98, 0xd1,
-	0x50, 0xb0, 0x34, 0xa4, 0x81, 0x95, 0xa4, 0x4c, 0x30, 0xd4, 0x14, 0x84, 0xfa, 0x24, 0x8d, 0x43,
-	0x2a, 0x2c, 0x9e, 0x50, 0x4b, 0xd2, 0xda, 0xcd, 0x80, 0x05, 0x4c, 0x12, 0xec, 0x5d, 0xa5, 0xb8,
-	0xed, 0x0f, 0x98, 0xf1, 0x98, 0xf1, 0xff, 0x6a, 0xa0, 0x1a, 0x35, 0x32, 0x17, 0xd0, 0xfc, 0xb9,
-	0x97, 0xfe, 0xe5, 0xe1, 0x88, 0x88, 0xb1, 0x27, 0x3c, 0xf4, 0x07, 0x1a, 0x71, 0x01, 0x6f, 0xe9,
-	0x5d, 0xbd, 0x5f, 0x1b, 0xf6, 0xac, 0x73, 0xce, 0x56, 0x51, 0x65, 0xa2, 0xb9, 0xcf, 0x14, 0x9c,
-	0xd7, 0x50, 0x49, 0x64, 0x65, 0xbe, 0x83, 0xb7, 0xc5, 0x8d, 0x11, 0x8e, 0xcc, 0x4b, 0x1d, 0x1a,
-	0x45, 0x1c, 0x75, 0xa1, 0x36, 0x9d, 0x33, 0x1c, 0x4d, 0x48, 0x18, 0xcc, 0x54, 0x8c, 0x92, 0x7b,
-	0x0c, 0xa1, 0xbf, 0x50, 0xe7, 0x61, 0x40, 0x3d, 0x91, 0xa5, 0xe4, 0x07, 0xcb, 0xa8, 0xe0, 0xad,
-	0x57, 0x32, 0xec, 0x97, 0xf3, 0x61, 0x7f, 0x9f, 0x92, 0x9d, 0xf2, 0x72, 0xdd, 0xd1, 0xdc, 0xa2,
-	0x86, 0x99, 0x41, 0xbd, 0xc0, 0x44, 0x06, 0x80, 0x34, 0x96, 0xad, 0x8c, 0x52, 0x76, 0x8f, 0x10,
-	0xe4, 0x40, 0x05, 0x3f, 0x05, 0x28, 0xf5, 0x6b, 0xc3, 0xcf, 0x2f, 0x09, 0x90, 0xfb, 0xe7, 0x9b,
-	0xe6, 0xb5, 0x0e, 0x6f, 0x4e, 0x09, 0xe8, 0x23, 0x54, 0x59, 0x32, 0xf2, 0xfd, 0x94, 0x70, 0x2e,
-	0x5d, 0xab, 0xee, 0x01, 0x40, 0x73, 0x40, 0x2e, 0x99, 0x7b, 0x22, 0x5c, 0x90, 0xfd, 0x9e, 0x7a,
-	0x81, 0xaa, 0xf3, 0x6d, 0x27, 0x7d, 0xbf, 0xee, 0xf4, 0x82, 0x50, 0xcc, 0xb2, 0xa9, 0x85, 0x59,
-	0x9c, 0x5f, 0x40, 0xfe, 0x19, 0x70, 0x3f, 0xb2, 0x55, 0xaa, 0x31, 0xc1, 0xb7, 0x37, 0x03, 0xc8,
-	0x0f, 0x64, 0x4c, 0xb0, 0x7b, 0x46, 0xd7, 0xf9, 0xbe, 0xdc, 0x18, 0xfa, 0x6a, 0x63, 0xe8, 0x0f,
-	0x1b, 0x43, 0xbf, 0xda, 0x1a, 0xda, 0x6a, 0x6b, 0x68, 0x77, 0x5b, 0x43, 0xfb, 0xf7, 0xe9, 0xc8,
-	0xe3, 0xf0, 0xdb, 0x36, 0x4f, 0xa8, 0x9d, 0x44, 0x81, 0x32, 0x99, 0x56, 0xe4, 0xe1, 0x7d, 0x7d,
-	0x0c, 0x00, 0x00, 0xff, 0xff, 0xbf, 0xfa, 0xff, 0x2f, 0xd9, 0x02, 0x00, 0x00,
+	// 407 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcf, 0xae, 0xd2, 0x40,
+	0x14, 0xc6, 0x3b, 0x72, 0x43, 0x2e, 0x87, 0xc4, 0x4b, 0x46, 0x34, 0x48, 0x4c, 0x21, 0x55, 0x09,
+	0x1b, 0xda, 0x04, 0xb7, 0xba, 0xa0, 0xb2, 0x60, 0x63, 0x62, 0x46, 0xdd, 0xb8, 0x31, 0xa5, 0x9d,
+	0x94, 0xa6, 0xed, 0x4c, 0xd3, 0x99, 0x92, 0xf8, 0x16, 0x3e, 0x80, 0x2f, 0xe0, 0xde, 0x87, 0x60,
+	0x49, 0x5c, 0x19, 0x17, 0xc4, 0xc0, 0x8b, 0x98, 0xce, 0x54, 0xfe, 0x54, 0x16, 0xae, 0x3a, 0xe7,
+	0x9b, 0xef, 0x7c, 0xe7, 0xd7, 0xc9, 0x81, 0x47, 0xf2, 0x73, 0x46, 0x85, 0x93, 0x72, 0x16, 0x49,
+	0x9e, 0x47, 0x2c, 0xb4, 0xb3, 0x9c, 0x4b, 0x8e, 0xbb, 0x92, 0xb2, 0x80, 0xe6, 0x69, 0xc4, 0xa4,
+	0x2d, 0x32, 0x66, 0x2b, 0x5b, 0xbf, 0x1b, 0xf2, 0x90, 0x2b, 0x83, 0x53, 0x9e, 0xb4, 0xb7, 0xff,
+	0xd8, 0xe7, 0x22, 0xe5, 0xe2, 0x93, 0xbe, 0xd0, 0x85, 0xbe, 0xb2, 0xd6, 0xd0, 0x7d, 0x73, 0x8c,
+	0x7e, 0xeb, 0xf9, 0x31, 0x95, 0x73, 0x4f, 0x7a, 0xf8, 0x3d, 0x74, 0xd2, 0x9a, 0xde, 0x43, 0x43,
+	0x34, 0x6e, 0x4f, 0x47, 0xf6, 0xb5, 0xc9, 0x76, 0x3d, 0x65, 0x61, 0x90, 0x7f, 0x12, 0xdc, 0x5b,
+	0x68, 0x66, 0xea, 0x64, 0x3d, 0x84, 0x07, 0xf5, 0x8e, 0x99, 0x1f, 0x5b, 0xdf, 0x10, 0x74, 0xea,
+	0x3a, 0x1e, 0x42, 0x7b, 0x99, 0x70, 0x3f, 0x5e, 0xd0, 0x28, 0x5c, 0x69, 0x8c, 0x06, 0x39, 0x97,
+	0xf0, 0x07, 0xb8, 0x13, 0x51, 0xc8, 0x3c, 0x59, 0xe4, 0xf4, 0x35, 0x2f, 0x98, 0x14, 0xbd, 0x7b,
+	0x0a, 0xf6, 0xf9, 0x75, 0xd8, 0x77, 0x97, 0x66, 0xf7, 0x66, 0xb3, 0x1b, 0x18, 0xa4, 0x9e, 0x81,
+	0x9f, 0x40, 0x2b, 0xf1, 0x84, 0x24, 0xbc, 0x60, 0x41, 0xaf, 0x31, 0x44, 0xe3, 0x5b, 0x72, 0x12,
+	0xac, 0x02, 0xee, 0x6a, 0x39, 0xd8, 0x04, 0x50, 0x58, 0xaa, 0x54, 0xa0, 0x37, 0xe4, 0x4c, 0xc1,
+	0x2e, 0x34, 0xfd, 0xbf, 0x78, 0x8d, 0x71, 0x7b, 0xfa, 0xec, 0x7f, 0xf0, 0x2a, 0xba, 0xaa, 0xd3,
+	0xfa, 0x8a, 0xe0, 0xfe, 0xa5, 0xa1, 0xe4, 0xe4, 0xd9, 0x2c, 0x08, 0x72, 0x2a, 0x84, 0x9a, 0xda,
+	0x22, 0x27, 0x01, 0x27, 0x80, 0x09, 0x4d, 0x3c, 0x19, 0xad, 0xe9, 0xb1, 0x4f, 0xbf, 0x4f, 0xcb,
+	0x7d, 0x59, 0x46, 0xff, 0xda, 0x0d, 0x46, 0x61, 0x24, 0x57, 0xc5, 0xd2, 0xf6, 0x79, 0x5a, 0xed,
+	0x47, 0xf5, 0x99, 0x88, 0x20, 0x76, 0x34, 0xd5, 0x9c, 0xfa, 0x3f, 0xbe, 0x4f, 0x40, 0xeb, 0x65,
+	0x45, 0xae, 0xe4, 0xba, 0xaf, 0x36, 0x7b, 0x13, 0x6d, 0xf7, 0x26, 0xfa, 0xbd, 0x37, 0xd1, 0x97,
+	0x83, 0x69, 0x6c, 0x0f, 0xa6, 0xf1, 0xf3, 0x60, 0x1a, 0x1f, 0x9f, 0x9e, 0xcd, 0x38, 0xfd, 0xb6,
+	0x23, 0x32, 0xe6, 0x64, 0x71, 0xa8, 0x87, 0x2c, 0x9b, 0x6a, 0x2d, 0x5f, 0xfc, 0x19, 0x00, 0x08,
+	0x67, 0x28, 0x2d, 0xf7, 0x02, 0x00, 0x00,
 }
 
 func (m *MonitoringPacketData) Marshal() (dAtA []byte, err error) {
@@ -423,6 +433,16 @@ func (m *MonitoringPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LastRound {
+		i--
+		if m.LastRound {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	{
 		size, err := m.SignatureCounts.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -578,6 +598,9 @@ func (m *MonitoringPacket) Size() (n int) {
 	}
 	l = m.SignatureCounts.Size()
 	n += 1 + l + sovMonitoring(uint64(l))
+	if m.LastRound {
+		n += 2
+	}
 	return n
 }
 
@@ -836,6 +859,26 @@ func (m *MonitoringPacket) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRound", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMonitoring
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LastRound = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMonitoring(dAtA[iNdEx:])
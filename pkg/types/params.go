@@ -0,0 +1,23 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdkerrortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// CheckParamsUpdate checks a params update of a module is sent by the module authority with valid params
+// The params are validated before being set since the params subspace panics on invalid params
+func CheckParamsUpdate(authority, sender string, validate func() error) error {
+	if sender != authority {
+		return sdkerrors.Wrapf(govtypes.ErrInvalidSigner,
+			"invalid authority; expected %s, got %s",
+			authority,
+			sender,
+		)
+	}
+	if err := validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrortypes.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package types_test
+
+import (
+	"errors"
+	"testing"
+
+	sdkerrortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/spn/pkg/types"
+)
+
+func TestCheckParamsUpdate(t *testing.T) {
+	var (
+		authority = "authority"
+		valid     = func() error { return nil }
+		invalid   = func() error { return errors.New("invalid params") }
+	)
+
+	t.Run("should allow an update from the authority with valid params", func(t *testing.T) {
+		require.NoError(t, types.CheckParamsUpdate(authority, authority, valid))
+	})
+
+	t.Run("should prevent an update from another account than the authority", func(t *testing.T) {
+		err := types.CheckParamsUpdate(authority, "foo", valid)
+		require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+	})
+
+	t.Run("should prevent an update with invalid params", func(t *testing.T) {
+		err := types.CheckParamsUpdate(authority, authority, invalid)
+		require.ErrorIs(t, err, sdkerrortypes.ErrInvalidRequest)
+		require.ErrorContains(t, err, "invalid params")
+	})
+}
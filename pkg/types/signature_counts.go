@@ -36,6 +36,25 @@ func (m *SignatureCounts) AddSignature(opAddress string, validatorSetSize int64)
 	})
 }
 
+// Merge adds the block count and the relative signatures of the provided signature counts
+func (m *SignatureCounts) Merge(sc SignatureCounts) {
+	m.BlockCount += sc.BlockCount
+
+	for _, c := range sc.Counts {
+		found := false
+		for i := range m.Counts {
+			if m.Counts[i].OpAddress == c.OpAddress {
+				m.Counts[i].RelativeSignatures = m.Counts[i].RelativeSignatures.Add(c.RelativeSignatures)
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.Counts = append(m.Counts, c)
+		}
+	}
+}
+
 // Validate checks if the signature counts object is valid
 // the sum of all relative signatures should not exceed the number of block
 func (m SignatureCounts) Validate() error {
@@ -112,6 +112,61 @@ func TestSignatureCounts_AddSignature(t *testing.T) {
 	}
 }
 
+func TestSignatureCounts_Merge(t *testing.T) {
+	var (
+		opAddrFoo = sample.OperatorAddress(r)
+		opAddrBar = sample.OperatorAddress(r)
+		opAddrBaz = sample.OperatorAddress(r)
+	)
+
+	tests := []struct {
+		name     string
+		sc       types.SignatureCounts
+		merged   types.SignatureCounts
+		expected types.SignatureCounts
+	}{
+		{
+			name:     "merging into empty signature counts should return the merged signature counts",
+			sc:       types.NewSignatureCounts(),
+			merged:   tc.SignatureCounts(2, tc.SignatureCount(t, opAddrFoo, "1")),
+			expected: tc.SignatureCounts(2, tc.SignatureCount(t, opAddrFoo, "1")),
+		},
+		{
+			name:     "merging empty signature counts should not change the signature counts",
+			sc:       tc.SignatureCounts(2, tc.SignatureCount(t, opAddrFoo, "1")),
+			merged:   types.NewSignatureCounts(),
+			expected: tc.SignatureCounts(2, tc.SignatureCount(t, opAddrFoo, "1")),
+		},
+		{
+			name: "merging should add the block counts and the relative signatures",
+			sc: tc.SignatureCounts(2,
+				tc.SignatureCount(t, opAddrFoo, "1"),
+				tc.SignatureCount(t, opAddrBar, "0.5"),
+			),
+			merged: tc.SignatureCounts(3,
+				tc.SignatureCount(t, opAddrBar, "1.5"),
+				tc.SignatureCount(t, opAddrBaz, "0.5"),
+			),
+			expected: tc.SignatureCounts(5,
+				tc.SignatureCount(t, opAddrFoo, "1"),
+				tc.SignatureCount(t, opAddrBar, "2"),
+				tc.SignatureCount(t, opAddrBaz, "0.5"),
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.sc.Merge(tt.merged)
+			require.EqualValues(t, tt.expected.BlockCount, tt.sc.BlockCount)
+			require.Len(t, tt.sc.Counts, len(tt.expected.Counts))
+			for i, c := range tt.expected.Counts {
+				require.Equal(t, c.OpAddress, tt.sc.Counts[i].OpAddress)
+				require.True(t, c.RelativeSignatures.Equal(tt.sc.Counts[i].RelativeSignatures))
+			}
+		})
+	}
+}
+
 func TestSignatureCounts_Validate(t *testing.T) {
 	var (
 		opAddrFoo    = sample.OperatorAddress(r)
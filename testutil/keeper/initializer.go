@@ -269,6 +269,7 @@ func (i initializer) Launch(
 		launchSubspace,
 		distrKeeper,
 		profileKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 }
 
@@ -299,6 +300,8 @@ func (i initializer) Campaign(
 		bankKeeper,
 		distrKeeper,
 		profileKeeper,
+		rewardKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 }
 
@@ -327,6 +330,7 @@ func (i initializer) Reward(
 		bankKeeper,
 		profileKeeper,
 		launchKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 }
 
@@ -334,6 +338,7 @@ func (i initializer) Monitoringc(
 	ibcKeeper ibckeeper.Keeper,
 	capabilityKeeper capabilitykeeper.Keeper,
 	launchKeeper *launchkeeper.Keeper,
+	profileKeeper *profilekeeper.Keeper,
 	rewardKeeper *rewardkeeper.Keeper,
 	paramKeeper paramskeeper.Keeper,
 	connectionMock []Connection,
@@ -372,7 +377,9 @@ func (i initializer) Monitoringc(
 		&ibcKeeper.PortKeeper,
 		scopedMonitoringKeeper,
 		launchKeeper,
+		profileKeeper,
 		rewardKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 }
 
@@ -417,6 +424,7 @@ func (i initializer) Monitoringp(
 		channelKeeper,
 		&ibcKeeper.PortKeeper,
 		scopedMonitoringKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 }
 
@@ -467,6 +475,7 @@ func (i initializer) Participation(
 		subspace,
 		fundraisingKeeper,
 		stakingKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 }
 
@@ -30,6 +30,7 @@ import (
 	monitoringckeeper "github.com/tendermint/spn/x/monitoringc/keeper"
 	monitoringctypes "github.com/tendermint/spn/x/monitoringc/types"
 	monitoringpkeeper "github.com/tendermint/spn/x/monitoringp/keeper"
+	monitoringptypes "github.com/tendermint/spn/x/monitoringp/types"
 	participationkeeper "github.com/tendermint/spn/x/participation/keeper"
 	participationtypes "github.com/tendermint/spn/x/participation/types"
 	profilekeeper "github.com/tendermint/spn/x/profile/keeper"
@@ -72,6 +73,7 @@ type TestMsgServers struct {
 	CampaignSrv      campaigntypes.MsgServer
 	RewardSrv        rewardtypes.MsgServer
 	MonitoringcSrv   monitoringctypes.MsgServer
+	MonitoringpSrv   monitoringptypes.MsgServer
 	ParticipationSrv participationtypes.MsgServer
 	ClaimSrv         claimtypes.MsgServer
 }
@@ -99,6 +101,7 @@ func NewTestSetup(t testing.TB) (sdk.Context, TestKeepers, TestMsgServers) {
 		*ibcKeeper,
 		*capabilityKeeper,
 		launchKeeper,
+		profileKeeper,
 		rewardKeeper,
 		paramKeeper,
 		[]Connection{},
@@ -143,29 +146,29 @@ func NewTestSetup(t testing.TB) (sdk.Context, TestKeepers, TestMsgServers) {
 	campaignKeeper.SetTotalShares(ctx, spntypes.TotalShareNumber)
 
 	return ctx, TestKeepers{
-			T:                        t,
-			CampaignKeeper:           campaignKeeper,
-			LaunchKeeper:             launchKeeper,
-			ProfileKeeper:            profileKeeper,
-			RewardKeeper:             rewardKeeper,
-			MonitoringConsumerKeeper: monitoringConsumerKeeper,
-			AccountKeeper:            authKeeper,
-			BankKeeper:               bankKeeper,
-			IBCKeeper:                ibcKeeper,
-			StakingKeeper:            stakingKeeper,
-			FundraisingKeeper:        fundraisingKeeper,
-			ParticipationKeeper:      participationKeeper,
-			ClaimKeeper:              claimKeeper,
-		}, TestMsgServers{
-			T:                t,
-			ProfileSrv:       profileSrv,
-			LaunchSrv:        launchSrv,
-			CampaignSrv:      campaignSrv,
-			RewardSrv:        rewardSrv,
-			MonitoringcSrv:   monitoringcSrv,
-			ParticipationSrv: participationSrv,
-			ClaimSrv:         claimSrv,
-		}
+		T:                        t,
+		CampaignKeeper:           campaignKeeper,
+		LaunchKeeper:             launchKeeper,
+		ProfileKeeper:            profileKeeper,
+		RewardKeeper:             rewardKeeper,
+		MonitoringConsumerKeeper: monitoringConsumerKeeper,
+		AccountKeeper:            authKeeper,
+		BankKeeper:               bankKeeper,
+		IBCKeeper:                ibcKeeper,
+		StakingKeeper:            stakingKeeper,
+		FundraisingKeeper:        fundraisingKeeper,
+		ParticipationKeeper:      participationKeeper,
+		ClaimKeeper:              claimKeeper,
+	}, TestMsgServers{
+		T:                t,
+		ProfileSrv:       profileSrv,
+		LaunchSrv:        launchSrv,
+		CampaignSrv:      campaignSrv,
+		RewardSrv:        rewardSrv,
+		MonitoringcSrv:   monitoringcSrv,
+		ParticipationSrv: participationSrv,
+		ClaimSrv:         claimSrv,
+	}
 }
 
 // NewTestSetupWithIBCMocks returns a keeper of the monitoring consumer module for testing purpose with mocks for IBC keepers
@@ -195,6 +198,7 @@ func NewTestSetupWithIBCMocks(
 		*ibcKeeper,
 		*capabilityKeeper,
 		launchKeeper,
+		profileKeeper,
 		rewardKeeper,
 		paramKeeper,
 		connectionMock,
@@ -236,28 +240,28 @@ func NewTestSetupWithIBCMocks(
 	campaignKeeper.SetTotalShares(ctx, spntypes.TotalShareNumber)
 
 	return ctx, TestKeepers{
-			T:                        t,
-			CampaignKeeper:           campaignKeeper,
-			LaunchKeeper:             launchKeeper,
-			ProfileKeeper:            profileKeeper,
-			RewardKeeper:             rewardKeeper,
-			MonitoringConsumerKeeper: monitoringConsumerKeeper,
-			AccountKeeper:            authKeeper,
-			BankKeeper:               bankKeeper,
-			IBCKeeper:                ibcKeeper,
-			StakingKeeper:            stakingKeeper,
-			FundraisingKeeper:        fundraisingKeeper,
-			ParticipationKeeper:      participationKeeper,
-			ClaimKeeper:              claimKeeper,
-		}, TestMsgServers{
-			T:                t,
-			ProfileSrv:       profileSrv,
-			LaunchSrv:        launchSrv,
-			CampaignSrv:      campaignSrv,
-			RewardSrv:        rewardSrv,
-			MonitoringcSrv:   monitoringcSrv,
-			ParticipationSrv: participationSrv,
-		}
+		T:                        t,
+		CampaignKeeper:           campaignKeeper,
+		LaunchKeeper:             launchKeeper,
+		ProfileKeeper:            profileKeeper,
+		RewardKeeper:             rewardKeeper,
+		MonitoringConsumerKeeper: monitoringConsumerKeeper,
+		AccountKeeper:            authKeeper,
+		BankKeeper:               bankKeeper,
+		IBCKeeper:                ibcKeeper,
+		StakingKeeper:            stakingKeeper,
+		FundraisingKeeper:        fundraisingKeeper,
+		ParticipationKeeper:      participationKeeper,
+		ClaimKeeper:              claimKeeper,
+	}, TestMsgServers{
+		T:                t,
+		ProfileSrv:       profileSrv,
+		LaunchSrv:        launchSrv,
+		CampaignSrv:      campaignSrv,
+		RewardSrv:        rewardSrv,
+		MonitoringcSrv:   monitoringcSrv,
+		ParticipationSrv: participationSrv,
+	}
 }
 
 // setIBCDefaultParams set default params for IBC client and connection keepers
@@ -16,6 +16,7 @@ import (
 	campaigntypes "github.com/tendermint/spn/x/campaign/types"
 	launchkeeper "github.com/tendermint/spn/x/launch/keeper"
 	launchtypes "github.com/tendermint/spn/x/launch/types"
+	monitoringpkeeper "github.com/tendermint/spn/x/monitoringp/keeper"
 	monitoringptypes "github.com/tendermint/spn/x/monitoringp/types"
 	participationkeeper "github.com/tendermint/spn/x/participation/keeper"
 	participationtypes "github.com/tendermint/spn/x/participation/types"
@@ -88,28 +89,30 @@ func NewTestSetupWithIBCMocksMonitoringp(
 	campaignSrv := campaignkeeper.NewMsgServerImpl(*campaignKeeper)
 	rewardSrv := rewardkeeper.NewMsgServerImpl(*rewardKeeper)
 	participationSrv := participationkeeper.NewMsgServerImpl(*participationKeeper)
+	monitoringpSrv := monitoringpkeeper.NewMsgServerImpl(*monitoringProviderKeeper)
 
 	// set max shares - only set during app InitGenesis
 	campaignKeeper.SetTotalShares(ctx, spntypes.TotalShareNumber)
 
 	return ctx, TestKeepers{
-			T:                        t,
-			CampaignKeeper:           campaignKeeper,
-			LaunchKeeper:             launchKeeper,
-			ProfileKeeper:            profileKeeper,
-			RewardKeeper:             rewardKeeper,
-			MonitoringProviderKeeper: monitoringProviderKeeper,
-			BankKeeper:               bankKeeper,
-			IBCKeeper:                ibcKeeper,
-			StakingKeeper:            stakingKeeper,
-			FundraisingKeeper:        fundraisingKeeper,
-			ParticipationKeeper:      participationKeeper,
-		}, TestMsgServers{
-			T:                t,
-			ProfileSrv:       profileSrv,
-			LaunchSrv:        launchSrv,
-			CampaignSrv:      campaignSrv,
-			RewardSrv:        rewardSrv,
-			ParticipationSrv: participationSrv,
-		}
+		T:                        t,
+		CampaignKeeper:           campaignKeeper,
+		LaunchKeeper:             launchKeeper,
+		ProfileKeeper:            profileKeeper,
+		RewardKeeper:             rewardKeeper,
+		MonitoringProviderKeeper: monitoringProviderKeeper,
+		BankKeeper:               bankKeeper,
+		IBCKeeper:                ibcKeeper,
+		StakingKeeper:            stakingKeeper,
+		FundraisingKeeper:        fundraisingKeeper,
+		ParticipationKeeper:      participationKeeper,
+	}, TestMsgServers{
+		T:                t,
+		ProfileSrv:       profileSrv,
+		LaunchSrv:        launchSrv,
+		CampaignSrv:      campaignSrv,
+		RewardSrv:        rewardSrv,
+		ParticipationSrv: participationSrv,
+		MonitoringpSrv:   monitoringpSrv,
+	}
 }
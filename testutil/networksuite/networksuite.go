@@ -261,9 +261,16 @@ func populateParticipation(r *rand.Rand, participationState participation.Genesi
 func populateProfile(r *rand.Rand, profileState profile.GenesisState) profile.GenesisState {
 	// add coordinators
 	for i := 0; i < 5; i++ {
-		profileState.CoordinatorList = append(
-			profileState.CoordinatorList,
-			profile.Coordinator{CoordinatorID: uint64(i)},
+		coordinator := profile.Coordinator{CoordinatorID: uint64(i)}
+		nullify.Fill(&coordinator)
+		profileState.CoordinatorList = append(profileState.CoordinatorList, coordinator)
+	}
+
+	// add coordinator stats
+	for i := 0; i < 5; i++ {
+		profileState.CoordinatorStatsList = append(
+			profileState.CoordinatorStatsList,
+			sample.CoordinatorStats(r, uint64(i)),
 		)
 	}
 
@@ -27,6 +27,26 @@ func ShareVestingOptions(r *rand.Rand) campaign.ShareVestingOptions {
 	return *campaign.NewShareDelayedVesting(vestingShares, vestingShares, Duration(r).Microseconds())
 }
 
+// ShareContinuousVestingOptions returns a sample ShareVestingOptions for a continuous vesting
+func ShareContinuousVestingOptions(r *rand.Rand) campaign.ShareVestingOptions {
+	vestingShares := Shares(r)
+	startTime := Duration(r).Microseconds()
+	return *campaign.NewShareContinuousVesting(vestingShares, vestingShares, startTime, startTime+Duration(r).Microseconds())
+}
+
+// SharePeriodicVestingOptions returns a sample ShareVestingOptions for a periodic vesting
+func SharePeriodicVestingOptions(r *rand.Rand) campaign.ShareVestingOptions {
+	periods := []campaign.ShareVestingPeriod{
+		{Length: Duration(r).Microseconds(), Shares: Shares(r)},
+		{Length: Duration(r).Microseconds(), Shares: Shares(r)},
+	}
+	totalShares := campaign.EmptyShares()
+	for _, period := range periods {
+		totalShares = campaign.IncreaseShares(totalShares, period.Shares)
+	}
+	return *campaign.NewSharePeriodicVesting(totalShares, Duration(r).Microseconds(), periods)
+}
+
 // Voucher returns a sample voucher structure
 func Voucher(r *rand.Rand, campaignID uint64) sdk.Coin {
 	denom := campaign.VoucherDenom(campaignID, AlphaString(r, 5))
@@ -80,6 +100,66 @@ func MainnetAccount(r *rand.Rand, campaignID uint64, address string) campaign.Ma
 	}
 }
 
+// MainnetVestingAccount returns a sample MainnetVestingAccount
+func MainnetVestingAccount(r *rand.Rand, campaignID uint64, address string) campaign.MainnetVestingAccount {
+	return campaign.MainnetVestingAccount{
+		CampaignID:     campaignID,
+		Address:        address,
+		VestingOptions: ShareVestingOptions(r),
+	}
+}
+
+// CampaignAirdrop returns a sample CampaignAirdrop with two missions and a few claim records
+func CampaignAirdrop(r *rand.Rand, campaignID uint64) campaign.CampaignAirdrop {
+	missionWeight := sdk.NewDecWithPrec(r.Int63n(99)+1, 2)
+	missions := []campaign.AirdropMission{
+		{
+			MissionID:   0,
+			Description: String(r, 20),
+			Weight:      missionWeight,
+		},
+		{
+			MissionID:   1,
+			Description: String(r, 20),
+			Weight:      sdk.OneDec().Sub(missionWeight),
+		},
+	}
+
+	claimRecords := make([]campaign.AirdropClaimRecord, r.Intn(5)+1)
+	for i := range claimRecords {
+		claimRecords[i] = campaign.AirdropClaimRecord{
+			Address: Address(r),
+			Weight:  uint64(r.Int63n(1000) + 1),
+		}
+	}
+
+	return campaign.NewCampaignAirdrop(campaignID, AlphaString(r, 5), missions, claimRecords)
+}
+
+// VoucherTransferPolicy returns a sample voucher transfer policy restricting the transfers to an allowlist
+func VoucherTransferPolicy(r *rand.Rand, campaignID uint64) campaign.VoucherTransferPolicy {
+	allowlist := make([]string, r.Intn(5)+1)
+	for i := range allowlist {
+		allowlist[i] = Address(r)
+	}
+	return campaign.NewVoucherTransferPolicy(campaignID, campaign.VoucherTransferPolicy_ALLOWLIST, allowlist)
+}
+
+// ShareLedgerEntry returns a sample share ledger entry
+func ShareLedgerEntry(r *rand.Rand, campaignID, ledgerID uint64) campaign.ShareLedgerEntry {
+	address := Address(r)
+	return campaign.ShareLedgerEntry{
+		CampaignID: campaignID,
+		LedgerID:   ledgerID,
+		Height:     r.Int63n(1000),
+		Timestamp:  Time(r).Unix(),
+		Operation:  campaign.ShareLedgerEntry_Operation(r.Intn(len(campaign.ShareLedgerEntry_Operation_name))),
+		Sender:     address,
+		Address:    address,
+		Shares:     Shares(r),
+	}
+}
+
 // MsgCreateCampaign returns a sample MsgCreateCampaign
 func MsgCreateCampaign(r *rand.Rand, coordAddr string) campaign.MsgCreateCampaign {
 	return campaign.MsgCreateCampaign{
@@ -98,7 +178,11 @@ func CampaignParams(r *rand.Rand) campaign.Params {
 	// assign random small amount of staking denom
 	campaignCreationFee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, r.Int63n(100)+1))
 
-	return campaign.NewParams(minTotalSupply, maxTotalSupply, campaignCreationFee)
+	// assign distinct random mission IDs
+	stakingMissionID := r.Uint64()
+	votingMissionID := stakingMissionID + 1
+
+	return campaign.NewParams(minTotalSupply, maxTotalSupply, campaignCreationFee, stakingMissionID, votingMissionID)
 }
 
 // CampaignGenesisState returns a sample genesis state for the campaign module
@@ -117,6 +201,16 @@ func CampaignGenesisState(r *rand.Rand) campaign.GenesisState {
 				Chains:     []uint64{0, 1},
 			},
 		},
+		CampaignAirdropList: []campaign.CampaignAirdrop{
+			CampaignAirdrop(r, 0),
+		},
+		VoucherTransferPolicyList: []campaign.VoucherTransferPolicy{
+			VoucherTransferPolicy(r, 0),
+		},
+		ShareLedgerList: []campaign.ShareLedgerEntry{
+			ShareLedgerEntry(r, 0, 0),
+			ShareLedgerEntry(r, 0, 1),
+		},
 		TotalShares: spntypes.TotalShareNumber,
 		Params:      CampaignParams(r),
 	}
@@ -126,6 +220,7 @@ func CampaignGenesisState(r *rand.Rand) campaign.GenesisState {
 func CampaignGenesisStateWithAccounts(r *rand.Rand) campaign.GenesisState {
 	genState := CampaignGenesisState(r)
 	genState.MainnetAccountList = make([]campaign.MainnetAccount, 0)
+	genState.MainnetVestingAccountList = make([]campaign.MainnetVestingAccount, 0)
 
 	for i, c := range genState.CampaignList {
 		for j := 0; j < 5; j++ {
@@ -135,6 +230,11 @@ func CampaignGenesisStateWithAccounts(r *rand.Rand) campaign.GenesisState {
 			// increase campaign allocated shares accordingly
 			c.AllocatedShares = campaign.IncreaseShares(c.AllocatedShares, mainnetAccount.Shares)
 		}
+		for j := 0; j < 2; j++ {
+			mainnetVestingAccount := MainnetVestingAccount(r, c.CampaignID, Address(r))
+			genState.MainnetVestingAccountList = append(genState.MainnetVestingAccountList, mainnetVestingAccount)
+			c.AllocatedShares = campaign.IncreaseShares(c.AllocatedShares, mainnetVestingAccount.VestingOptions.TotalShares())
+		}
 		genState.CampaignList[i] = c
 	}
 
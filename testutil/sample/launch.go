@@ -57,6 +57,26 @@ func VestingOptions(r *rand.Rand) launch.VestingOptions {
 	return *launch.NewDelayedVesting(balance, balance, Duration(r).Milliseconds())
 }
 
+// ContinuousVestingOptions returns a sample VestingOptions for a continuous vesting
+func ContinuousVestingOptions(r *rand.Rand) launch.VestingOptions {
+	balance := Coins(r)
+	startTime := Duration(r).Milliseconds()
+	return *launch.NewContinuousVesting(balance, balance, startTime, startTime+Duration(r).Milliseconds())
+}
+
+// PeriodicVestingOptions returns a sample VestingOptions for a periodic vesting
+func PeriodicVestingOptions(r *rand.Rand) launch.VestingOptions {
+	periods := []launch.VestingPeriod{
+		{Length: Duration(r).Milliseconds(), Amount: Coins(r)},
+		{Length: Duration(r).Milliseconds(), Amount: Coins(r)},
+	}
+	balance := sdk.NewCoins()
+	for _, period := range periods {
+		balance = balance.Add(period.Amount...)
+	}
+	return *launch.NewPeriodicVesting(balance, Duration(r).Milliseconds(), periods)
+}
+
 // VestingAccount returns a sample VestingAccount
 func VestingAccount(r *rand.Rand, launchID uint64, address string) launch.VestingAccount {
 	return launch.VestingAccount{
@@ -101,6 +121,12 @@ func ValidatorRemoval(address string) launch.ValidatorRemoval {
 	}
 }
 
+// ApprovalPolicy returns a sample ApprovalPolicy
+func ApprovalPolicy(r *rand.Rand, launchID uint64) launch.ApprovalPolicy {
+	minSelfDelegation := Coin(r)
+	return launch.NewApprovalPolicy(launchID, Coins(r), &minSelfDelegation, []string{Address(r)})
+}
+
 // RequestWithContent creates a launch request object with launch id and content
 func RequestWithContent(r *rand.Rand, launchID uint64, content launch.RequestContent) launch.Request {
 	return launch.Request{
@@ -132,6 +158,8 @@ func AllRequestContents(r *rand.Rand, launchID uint64, genesis, vesting, validat
 		launch.NewAccountRemoval(vesting),
 		launch.NewGenesisValidator(launchID, validator, Bytes(r, 300), Bytes(r, 30), Coin(r), GenesisValidatorPeer(r)),
 		launch.NewValidatorRemoval(validator),
+		launch.NewGenesisAccountUpdate(launchID, genesis, Coins(r), nil),
+		launch.NewGenesisValidatorPeerUpdate(launchID, validator, GenesisValidatorPeer(r)),
 	}
 }
 
@@ -140,6 +168,16 @@ func GenesisAccountContent(r *rand.Rand, launchID uint64, address string) launch
 	return launch.NewGenesisAccount(launchID, address, Coins(r))
 }
 
+// GenesisAccountUpdateContent returns a sample GenesisAccountUpdate request content
+func GenesisAccountUpdateContent(r *rand.Rand, launchID uint64, address string) launch.RequestContent {
+	return launch.NewGenesisAccountUpdate(launchID, address, Coins(r), nil)
+}
+
+// GenesisValidatorContent returns a sample GenesisValidator request content
+func GenesisValidatorContent(r *rand.Rand, launchID uint64, address string) launch.RequestContent {
+	return launch.NewGenesisValidator(launchID, address, Bytes(r, 300), Bytes(r, 30), Coin(r), GenesisValidatorPeer(r))
+}
+
 // Request returns a sample Request
 func Request(r *rand.Rand, launchID uint64, address string) launch.Request {
 	content := GenesisAccountContent(r, launchID, address)
@@ -251,6 +289,16 @@ func MsgRequestAddVestingAccount(r *rand.Rand, creator, address string, launchID
 	)
 }
 
+// MsgRequestUpdateAccount returns a sample MsgRequestUpdateAccount
+// the account is updated either with a new balance or to a vesting account
+func MsgRequestUpdateAccount(r *rand.Rand, creator, address string, launchID uint64) launch.MsgRequestUpdateAccount {
+	if r.Intn(2) == 0 {
+		return *launch.NewMsgRequestUpdateAccount(creator, launchID, address, Coins(r), nil)
+	}
+	vestingOptions := VestingOptions(r)
+	return *launch.NewMsgRequestUpdateAccount(creator, launchID, address, nil, &vestingOptions)
+}
+
 // MsgRequestRemoveAccount returns a sample MsgRequestRemoveAccount
 func MsgRequestRemoveAccount(creator, address string, launchID uint64) launch.MsgRequestRemoveAccount {
 	return *launch.NewMsgRequestRemoveAccount(
@@ -327,7 +375,24 @@ func LaunchParams(r *rand.Rand) launch.Params {
 	// assign random small amount of staking denom
 	chainCreationFee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, r.Int63n(100)+1))
 
-	return launch.NewParams(minLaunchTime, maxLaunchTime, launch.DefaultRevertDelay, chainCreationFee)
+	requestExpiration := launch.DefaultRequestExpiration - time.Second*time.Duration(r.Int63n(10))
+
+	// validator set threshold between the minimum and 1
+	validatorSetThreshold := launch.MinValidatorSetThreshold.Add(
+		sdk.OneDec().Sub(launch.MinValidatorSetThreshold).MulInt64(r.Int63n(101)).QuoInt64(100),
+	)
+
+	maxNonGenesisValidators := uint64(r.Intn(3))
+
+	return launch.NewParams(
+		minLaunchTime,
+		maxLaunchTime,
+		launch.DefaultRevertDelay,
+		chainCreationFee,
+		requestExpiration,
+		validatorSetThreshold,
+		maxNonGenesisValidators,
+	)
 }
 
 // LaunchGenesisState returns a sample genesis state for the launch module
@@ -370,6 +435,9 @@ func LaunchGenesisState(r *rand.Rand, addresses ...string) launch.GenesisState {
 				Counter:  2,
 			},
 		},
+		ApprovalPolicyList: []launch.ApprovalPolicy{
+			ApprovalPolicy(r, 0),
+		},
 		Params: LaunchParams(r),
 	}
 }
@@ -57,6 +57,7 @@ func MonitoringpParams(r *rand.Rand) monitoringp.Params {
 	consumerRevisionHeight := types.DefaultRevisionHeight + r.Uint64()
 	consumerChainID := monitoringp.DefaultConsumerChainID
 	consensusState := ConsensusState(0)
+	rewardPeriod := r.Int63n(lastBlockHeight)
 
 	return monitoringp.NewParams(
 		lastBlockHeight,
@@ -64,6 +65,7 @@ func MonitoringpParams(r *rand.Rand) monitoringp.Params {
 		consensusState,
 		consumerUnbondingpPeriod,
 		consumerRevisionHeight,
+		rewardPeriod,
 	)
 }
 
@@ -53,6 +53,19 @@ func Coordinator(r *rand.Rand, address string) profile.Coordinator {
 	}
 }
 
+// CoordinatorStats returns a sample CoordinatorStats
+func CoordinatorStats(r *rand.Rand, coordinatorID uint64) profile.CoordinatorStats {
+	return profile.CoordinatorStats{
+		CoordinatorID:       coordinatorID,
+		ChainsCreated:       uint64(r.Intn(100)),
+		ChainsLaunched:      uint64(r.Intn(100)),
+		LaunchesReverted:    uint64(r.Intn(100)),
+		CampaignsCreated:    uint64(r.Intn(100)),
+		MainnetsInitialized: uint64(r.Intn(100)),
+		RewardsDistributed:  Coins(r),
+	}
+}
+
 // CoordinatorDescription returns a sample CoordinatorDescription
 func CoordinatorDescription(r *rand.Rand) profile.CoordinatorDescription {
 	return profile.CoordinatorDescription{
@@ -124,6 +137,10 @@ func ProfileGenesisState(r *rand.Rand, addresses ...string) profile.GenesisState
 			},
 		},
 		CoordinatorCounter: 5,
+		CoordinatorStatsList: []profile.CoordinatorStats{
+			CoordinatorStats(r, 0),
+			CoordinatorStats(r, 1),
+		},
 		ValidatorList: []profile.Validator{
 			{
 				Address:           addresses[5],
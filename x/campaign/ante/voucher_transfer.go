@@ -0,0 +1,142 @@
+package ante
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	ibctransfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+
+	rewardtypes "github.com/tendermint/spn/x/reward/types"
+)
+
+// VoucherTransferChecker checks the transfers of vouchers against the transfer policies of the campaigns
+type VoucherTransferChecker interface {
+	CheckVoucherTransfer(ctx sdk.Context, recipient string, coins sdk.Coins) error
+	CheckVoucherIBCTransfer(ctx sdk.Context, coin sdk.Coin) error
+	CheckVoucherRewards(ctx sdk.Context, coins sdk.Coins) error
+}
+
+// GroupKeeper retrieves the group proposals to check their messages at execution
+type GroupKeeper interface {
+	Proposal(goCtx context.Context, request *group.QueryProposalRequest) (*group.QueryProposalResponse, error)
+}
+
+// VoucherTransferDecorator rejects the transactions transferring vouchers in violation of the transfer policy
+// of their campaign. The messages executed through authz are checked recursively, the messages of group
+// proposals are checked when submitted and checked again at execution since the policy may have changed
+type VoucherTransferDecorator struct {
+	checker     VoucherTransferChecker
+	groupKeeper GroupKeeper
+}
+
+// NewVoucherTransferDecorator returns a new VoucherTransferDecorator
+func NewVoucherTransferDecorator(checker VoucherTransferChecker, groupKeeper GroupKeeper) VoucherTransferDecorator {
+	return VoucherTransferDecorator{
+		checker:     checker,
+		groupKeeper: groupKeeper,
+	}
+}
+
+func (d VoucherTransferDecorator) AnteHandle(
+	ctx sdk.Context,
+	tx sdk.Tx,
+	simulate bool,
+	next sdk.AnteHandler,
+) (sdk.Context, error) {
+	if err := d.checkMsgs(ctx, tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+	return next(ctx, tx, simulate)
+}
+
+// checkMsgs checks the vouchers transferred by the messages, nested messages are checked recursively
+func (d VoucherTransferDecorator) checkMsgs(ctx sdk.Context, msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		switch msg := msg.(type) {
+		case *banktypes.MsgSend:
+			if err := d.checker.CheckVoucherTransfer(ctx, msg.ToAddress, msg.Amount); err != nil {
+				return err
+			}
+		case *banktypes.MsgMultiSend:
+			for _, output := range msg.Outputs {
+				if err := d.checker.CheckVoucherTransfer(ctx, output.Address, output.Coins); err != nil {
+					return err
+				}
+			}
+		case *vestingtypes.MsgCreateVestingAccount:
+			if err := d.checker.CheckVoucherTransfer(ctx, msg.ToAddress, msg.Amount); err != nil {
+				return err
+			}
+		case *vestingtypes.MsgCreatePermanentLockedAccount:
+			if err := d.checker.CheckVoucherTransfer(ctx, msg.ToAddress, msg.Amount); err != nil {
+				return err
+			}
+		case *vestingtypes.MsgCreatePeriodicVestingAccount:
+			for _, period := range msg.VestingPeriods {
+				if err := d.checker.CheckVoucherTransfer(ctx, msg.ToAddress, period.Amount); err != nil {
+					return err
+				}
+			}
+		case *ibctransfertypes.MsgTransfer:
+			if err := d.checker.CheckVoucherIBCTransfer(ctx, msg.Token); err != nil {
+				return err
+			}
+		case *rewardtypes.MsgSetRewards:
+			if err := d.checker.CheckVoucherRewards(ctx, msg.Coins); err != nil {
+				return err
+			}
+		case *rewardtypes.MsgAddRewards:
+			if err := d.checker.CheckVoucherRewards(ctx, msg.Coins); err != nil {
+				return err
+			}
+		case *authz.MsgExec:
+			nested, err := msg.GetMessages()
+			if err != nil {
+				return err
+			}
+			if err := d.checkMsgs(ctx, nested); err != nil {
+				return err
+			}
+		case *group.MsgSubmitProposal:
+			nested, err := msg.GetMsgs()
+			if err != nil {
+				return err
+			}
+			if err := d.checkMsgs(ctx, nested); err != nil {
+				return err
+			}
+		case *group.MsgExec:
+			if err := d.checkProposal(ctx, msg.ProposalId); err != nil {
+				return err
+			}
+		case *group.MsgVote:
+			// the proposal is executed with the vote if requested
+			if msg.Exec == group.Exec_EXEC_TRY {
+				if err := d.checkProposal(ctx, msg.ProposalId); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// checkProposal checks the messages of a stored group proposal before its execution
+func (d VoucherTransferDecorator) checkProposal(ctx sdk.Context, proposalID uint64) error {
+	res, err := d.groupKeeper.Proposal(sdk.WrapSDKContext(ctx), &group.QueryProposalRequest{
+		ProposalId: proposalID,
+	})
+	if err != nil {
+		// the proposal doesn't exist, the execution is rejected by the group module
+		return nil
+	}
+	nested, err := res.Proposal.GetMsgs()
+	if err != nil {
+		return err
+	}
+	return d.checkMsgs(ctx, nested)
+}
@@ -0,0 +1,310 @@
+package ante_test
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	ibctransfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/campaign/ante"
+	"github.com/tendermint/spn/x/campaign/types"
+	rewardtypes "github.com/tendermint/spn/x/reward/types"
+)
+
+// mockTx is a transaction only containing messages
+type mockTx struct {
+	msgs []sdk.Msg
+}
+
+func (tx mockTx) GetMsgs() []sdk.Msg {
+	return tx.msgs
+}
+
+func (tx mockTx) ValidateBasic() error {
+	return nil
+}
+
+// mockGroupKeeper is a group keeper only storing proposals
+type mockGroupKeeper struct {
+	proposals map[uint64]*group.Proposal
+}
+
+func (k mockGroupKeeper) Proposal(
+	_ context.Context,
+	request *group.QueryProposalRequest,
+) (*group.QueryProposalResponse, error) {
+	proposal, ok := k.proposals[request.ProposalId]
+	if !ok {
+		return nil, sdkerrortypes.ErrNotFound
+	}
+	return &group.QueryProposalResponse{Proposal: proposal}, nil
+}
+
+func TestVoucherTransferDecorator(t *testing.T) {
+	var (
+		r           = sample.Rand()
+		ctx, tk, _  = testkeeper.NewTestSetup(t)
+		groupKeeper = mockGroupKeeper{proposals: make(map[uint64]*group.Proposal)}
+		decorator   = ante.NewVoucherTransferDecorator(tk.CampaignKeeper, groupKeeper)
+
+		campaignNoIBC      = uint64(1)
+		campaignAllowlist  = uint64(2)
+		sender             = sample.Address(r)
+		allowed            = sample.Address(r)
+		notAllowed         = sample.Address(r)
+		proposalAllowed    = uint64(1)
+		proposalRestricted = uint64(2)
+	)
+
+	tk.CampaignKeeper.SetVoucherTransferPolicy(ctx, types.NewVoucherTransferPolicy(
+		campaignNoIBC,
+		types.VoucherTransferPolicy_NO_IBC,
+		nil,
+	))
+	tk.CampaignKeeper.SetVoucherTransferPolicy(ctx, types.NewVoucherTransferPolicy(
+		campaignAllowlist,
+		types.VoucherTransferPolicy_ALLOWLIST,
+		[]string{allowed},
+	))
+	vouchers := func(campaignID uint64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(types.VoucherDenom(campaignID, "foo"), 100))
+	}
+	msgSend := func(recipient string, coins sdk.Coins) sdk.Msg {
+		return &banktypes.MsgSend{
+			FromAddress: sender,
+			ToAddress:   recipient,
+			Amount:      coins,
+		}
+	}
+	msgTransfer := func(coins sdk.Coins) sdk.Msg {
+		return ibctransfertypes.NewMsgTransfer(
+			ibctransfertypes.PortID,
+			"channel-0",
+			coins[0],
+			sender,
+			notAllowed,
+			clienttypes.NewHeight(0, 100),
+			0,
+		)
+	}
+	msgExec := func(msgs ...sdk.Msg) sdk.Msg {
+		msg := authz.NewMsgExec(sdk.MustAccAddressFromBech32(sender), msgs)
+		return &msg
+	}
+	msgSubmitProposal := func(msgs ...sdk.Msg) sdk.Msg {
+		msg, err := group.NewMsgSubmitProposal(sender, []string{sender}, msgs, "", group.Exec_EXEC_UNSPECIFIED)
+		require.NoError(t, err)
+		return msg
+	}
+
+	setProposal := func(proposalID uint64, msgs ...sdk.Msg) {
+		proposal := &group.Proposal{Id: proposalID}
+		require.NoError(t, proposal.SetMsgs(msgs))
+		groupKeeper.proposals[proposalID] = proposal
+	}
+	setProposal(proposalAllowed, msgSend(allowed, vouchers(campaignAllowlist)))
+	setProposal(proposalRestricted, msgTransfer(vouchers(campaignNoIBC)))
+	msgGroupExec := func(proposalID uint64) sdk.Msg {
+		return &group.MsgExec{ProposalId: proposalID, Executor: sender}
+	}
+	msgVote := func(proposalID uint64, exec group.Exec) sdk.Msg {
+		return &group.MsgVote{
+			ProposalId: proposalID,
+			Voter:      sender,
+			Option:     group.VOTE_OPTION_YES,
+			Exec:       exec,
+		}
+	}
+	msgPeriodicVesting := func(recipient string, coins ...sdk.Coins) sdk.Msg {
+		periods := make(vestingtypes.Periods, 0, len(coins))
+		for _, c := range coins {
+			periods = append(periods, vestingtypes.Period{Length: 100, Amount: c})
+		}
+		return vestingtypes.NewMsgCreatePeriodicVestingAccount(
+			sdk.MustAccAddressFromBech32(sender),
+			sdk.MustAccAddressFromBech32(recipient),
+			0,
+			periods,
+		)
+	}
+
+	for _, tc := range []struct {
+		desc string
+		msgs []sdk.Msg
+		err  error
+	}{
+		{
+			desc: "should allow transactions without vouchers",
+			msgs: []sdk.Msg{
+				msgSend(notAllowed, sdk.NewCoins(sdk.NewInt64Coin("foo", 100))),
+				msgTransfer(sdk.NewCoins(sdk.NewInt64Coin("foo", 100))),
+				types.NewMsgRedeemVouchers(sender, notAllowed, campaignAllowlist, vouchers(campaignAllowlist)),
+			},
+		},
+		{
+			desc: "should allow sending vouchers without transfer restriction",
+			msgs: []sdk.Msg{
+				msgSend(notAllowed, vouchers(0)),
+				msgSend(notAllowed, vouchers(campaignNoIBC)),
+				msgTransfer(vouchers(0)),
+			},
+		},
+		{
+			desc: "should allow sending vouchers to the allowlist",
+			msgs: []sdk.Msg{
+				msgSend(allowed, vouchers(campaignAllowlist)),
+				&banktypes.MsgMultiSend{
+					Inputs: []banktypes.Input{banktypes.NewInput(
+						sdk.MustAccAddressFromBech32(sender),
+						vouchers(campaignAllowlist),
+					)},
+					Outputs: []banktypes.Output{banktypes.NewOutput(
+						sdk.MustAccAddressFromBech32(allowed),
+						vouchers(campaignAllowlist),
+					)},
+				},
+				msgExec(msgSend(allowed, vouchers(campaignAllowlist))),
+				vestingtypes.NewMsgCreateVestingAccount(
+					sdk.MustAccAddressFromBech32(sender),
+					sdk.MustAccAddressFromBech32(allowed),
+					vouchers(campaignAllowlist),
+					100,
+					false,
+				),
+				vestingtypes.NewMsgCreatePermanentLockedAccount(
+					sdk.MustAccAddressFromBech32(sender),
+					sdk.MustAccAddressFromBech32(allowed),
+					vouchers(campaignAllowlist),
+				),
+				msgPeriodicVesting(allowed, vouchers(0), vouchers(campaignAllowlist)),
+			},
+		},
+		{
+			desc: "should allow depositing vouchers without transfer restriction in a reward pool",
+			msgs: []sdk.Msg{
+				rewardtypes.NewMsgSetRewards(sender, 0, 100, vouchers(0)),
+				rewardtypes.NewMsgAddRewards(sender, 0, vouchers(0)),
+			},
+		},
+		{
+			desc: "should allow executing group proposals sending vouchers to the allowlist",
+			msgs: []sdk.Msg{
+				msgGroupExec(proposalAllowed),
+				msgVote(proposalAllowed, group.Exec_EXEC_TRY),
+				msgVote(proposalRestricted, group.Exec_EXEC_UNSPECIFIED),
+				msgGroupExec(100),
+			},
+		},
+		{
+			desc: "should prevent sending vouchers outside the allowlist",
+			msgs: []sdk.Msg{msgSend(notAllowed, vouchers(campaignAllowlist))},
+			err:  types.ErrVoucherTransferRestricted,
+		},
+		{
+			desc: "should prevent multi sending vouchers outside the allowlist",
+			msgs: []sdk.Msg{&banktypes.MsgMultiSend{
+				Inputs: []banktypes.Input{banktypes.NewInput(
+					sdk.MustAccAddressFromBech32(sender),
+					vouchers(campaignAllowlist).Add(vouchers(campaignAllowlist)...),
+				)},
+				Outputs: []banktypes.Output{
+					banktypes.NewOutput(sdk.MustAccAddressFromBech32(allowed), vouchers(campaignAllowlist)),
+					banktypes.NewOutput(sdk.MustAccAddressFromBech32(notAllowed), vouchers(campaignAllowlist)),
+				},
+			}},
+			err: types.ErrVoucherTransferRestricted,
+		},
+		{
+			desc: "should prevent transferring vouchers through IBC",
+			msgs: []sdk.Msg{msgTransfer(vouchers(campaignNoIBC))},
+			err:  types.ErrVoucherTransferRestricted,
+		},
+		{
+			desc: "should prevent transferring vouchers of an allowlist through IBC",
+			msgs: []sdk.Msg{msgTransfer(vouchers(campaignAllowlist))},
+			err:  types.ErrVoucherTransferRestricted,
+		},
+		{
+			desc: "should prevent sending vouchers through authz",
+			msgs: []sdk.Msg{msgExec(msgExec(msgSend(notAllowed, vouchers(campaignAllowlist))))},
+			err:  types.ErrVoucherTransferRestricted,
+		},
+		{
+			desc: "should prevent creating a vesting account outside the allowlist",
+			msgs: []sdk.Msg{vestingtypes.NewMsgCreateVestingAccount(
+				sdk.MustAccAddressFromBech32(sender),
+				sdk.MustAccAddressFromBech32(notAllowed),
+				vouchers(campaignAllowlist),
+				100,
+				false,
+			)},
+			err: types.ErrVoucherTransferRestricted,
+		},
+		{
+			desc: "should prevent creating a permanent locked account outside the allowlist",
+			msgs: []sdk.Msg{vestingtypes.NewMsgCreatePermanentLockedAccount(
+				sdk.MustAccAddressFromBech32(sender),
+				sdk.MustAccAddressFromBech32(notAllowed),
+				vouchers(campaignAllowlist),
+			)},
+			err: types.ErrVoucherTransferRestricted,
+		},
+		{
+			desc: "should prevent creating a periodic vesting account outside the allowlist",
+			msgs: []sdk.Msg{msgPeriodicVesting(notAllowed, vouchers(0), vouchers(campaignAllowlist))},
+			err:  types.ErrVoucherTransferRestricted,
+		},
+		{
+			desc: "should prevent setting restricted vouchers as rewards",
+			msgs: []sdk.Msg{rewardtypes.NewMsgSetRewards(sender, 0, 100, vouchers(campaignNoIBC))},
+			err:  types.ErrVoucherTransferRestricted,
+		},
+		{
+			desc: "should prevent adding restricted vouchers as rewards",
+			msgs: []sdk.Msg{rewardtypes.NewMsgAddRewards(sender, 0, vouchers(campaignAllowlist))},
+			err:  types.ErrVoucherTransferRestricted,
+		},
+		{
+			desc: "should prevent executing a group proposal transferring restricted vouchers",
+			msgs: []sdk.Msg{msgGroupExec(proposalRestricted)},
+			err:  types.ErrVoucherTransferRestricted,
+		},
+		{
+			desc: "should prevent executing with a vote a group proposal transferring restricted vouchers",
+			msgs: []sdk.Msg{msgVote(proposalRestricted, group.Exec_EXEC_TRY)},
+			err:  types.ErrVoucherTransferRestricted,
+		},
+		{
+			desc: "should prevent sending vouchers through a group proposal",
+			msgs: []sdk.Msg{msgSubmitProposal(msgTransfer(vouchers(campaignNoIBC)))},
+			err:  types.ErrVoucherTransferRestricted,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			nextCalled := false
+			next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+				nextCalled = true
+				return ctx, nil
+			}
+
+			_, err := decorator.AnteHandle(ctx, mockTx{msgs: tc.msgs}, false, next)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				require.False(t, nextCalled)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, nextCalled)
+		})
+	}
+}
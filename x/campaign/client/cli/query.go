@@ -29,6 +29,13 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		CmdListMainnetAccount(),
 		CmdShowMainnetAccountBalance(),
 		CmdListMainnetAccountBalance(),
+		CmdMainnetGenesisAccounts(),
+		CmdExportMainnetGenesisAccounts(),
+		CmdShowCampaignAirdrop(),
+		CmdExportCampaignAirdrop(),
+		CmdShowVoucherTransferPolicy(),
+		CmdListShareLedger(),
+		CmdShowCapTable(),
 		CmdQueryParams(),
 		CmdQueryTotalShares(),
 	)
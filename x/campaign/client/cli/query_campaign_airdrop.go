@@ -0,0 +1,81 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+
+	"github.com/tendermint/spn/x/campaign/types"
+)
+
+func CmdShowCampaignAirdrop() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-campaign-airdrop [campaign-id]",
+		Short: "shows the airdrop of a campaign",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			res, clientCtx, err := queryCampaignAirdrop(cmd, args[0])
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdExportCampaignAirdrop() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-campaign-airdrop [campaign-id]",
+		Short: "export the airdrop of a campaign as the genesis of the claim module of the mainnet",
+		Long: `Export the airdrop of a campaign as the genesis of the claim module of the mainnet.
+The claim records are derived from the claimable airdrop of the campaign in proportion to their weights`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			res, clientCtx, err := queryCampaignAirdrop(cmd, args[0])
+			if err != nil {
+				return err
+			}
+
+			genesis := res.CampaignAirdrop.ClaimGenesis(res.AirdropSupply.Amount)
+			if err := genesis.Validate(); err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&genesis)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// queryCampaignAirdrop queries the airdrop of the campaign
+func queryCampaignAirdrop(cmd *cobra.Command, arg string) (
+	*types.QueryGetCampaignAirdropResponse,
+	client.Context,
+	error,
+) {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return nil, clientCtx, err
+	}
+
+	campaignID, err := cast.ToUint64E(arg)
+	if err != nil {
+		return nil, clientCtx, err
+	}
+
+	queryClient := types.NewQueryClient(clientCtx)
+
+	res, err := queryClient.CampaignAirdrop(cmd.Context(), &types.QueryGetCampaignAirdropRequest{
+		CampaignID: campaignID,
+	})
+	return res, clientCtx, err
+}
@@ -0,0 +1,131 @@
+package cli
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+
+	"github.com/tendermint/spn/x/campaign/types"
+)
+
+// MainnetGenesisExport is the genesis-ready representation of the mainnet genesis accounts of a campaign
+// The balances include the total balances of the vesting accounts, which are also listed separately
+type MainnetGenesisExport struct {
+	CampaignID          uint64              `json:"campaign_id"`
+	Balances            []banktypes.Balance `json:"balances"`
+	VestingBalances     []banktypes.Balance `json:"vesting_balances"`
+	GenesisDistribution sdk.Coins           `json:"genesis_distribution"`
+	ClaimableAirdrop    sdk.Coins           `json:"claimable_airdrop"`
+}
+
+func CmdMainnetGenesisAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mainnet-genesis-accounts [campaign-id]",
+		Short: "query the balances of the mainnet genesis accounts of a campaign",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			res, clientCtx, err := queryMainnetGenesisAccounts(cmd, args[0])
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdExportMainnetGenesisAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-mainnet-genesis-accounts [campaign-id]",
+		Short: "export the mainnet genesis accounts of a campaign as bank genesis balances",
+		Long: `Export the mainnet genesis accounts of a campaign as JSON.
+The balances can be used as is in the balances of the bank module genesis, the genesis distribution
+and the claimable airdrop must be allocated by the coordinator of the mainnet.
+The balances include the total balances of the vesting accounts, also listed in the vesting balances,
+the vesting schedules are given by the vesting accounts of the mainnet chain`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			res, clientCtx, err := queryMainnetGenesisAccounts(cmd, args[0])
+			if err != nil {
+				return err
+			}
+
+			genesisAccounts := res.MainnetGenesisAccounts
+			export := MainnetGenesisExport{
+				CampaignID:          genesisAccounts.CampaignID,
+				Balances:            make([]banktypes.Balance, 0, len(genesisAccounts.Accounts)),
+				VestingBalances:     make([]banktypes.Balance, 0, len(genesisAccounts.VestingAccounts)),
+				GenesisDistribution: genesisAccounts.GenesisDistribution,
+				ClaimableAirdrop:    genesisAccounts.ClaimableAirdrop,
+			}
+
+			// an address can have both a mainnet account and a mainnet vesting account,
+			// its bank balance is the sum of both
+			balances := make(map[string]sdk.Coins)
+			for _, acc := range genesisAccounts.Accounts {
+				balances[acc.Address] = balances[acc.Address].Add(acc.Coins...)
+			}
+			for _, acc := range genesisAccounts.VestingAccounts {
+				balances[acc.Address] = balances[acc.Address].Add(acc.Coins...)
+				export.VestingBalances = append(export.VestingBalances, banktypes.Balance{
+					Address: acc.Address,
+					Coins:   acc.Coins,
+				})
+			}
+			for address, coins := range balances {
+				export.Balances = append(export.Balances, banktypes.Balance{
+					Address: address,
+					Coins:   coins,
+				})
+			}
+			sort.Slice(export.Balances, func(i, j int) bool {
+				return export.Balances[i].Address < export.Balances[j].Address
+			})
+
+			out, err := json.MarshalIndent(export, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintBytes(out)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// queryMainnetGenesisAccounts queries the mainnet genesis accounts of the campaign
+func queryMainnetGenesisAccounts(cmd *cobra.Command, arg string) (
+	*types.QueryMainnetGenesisAccountsResponse,
+	client.Context,
+	error,
+) {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return nil, clientCtx, err
+	}
+
+	campaignID, err := cast.ToUint64E(arg)
+	if err != nil {
+		return nil, clientCtx, err
+	}
+
+	queryClient := types.NewQueryClient(clientCtx)
+
+	res, err := queryClient.MainnetGenesisAccounts(cmd.Context(), &types.QueryMainnetGenesisAccountsRequest{
+		CampaignID: campaignID,
+	})
+	return res, clientCtx, err
+}
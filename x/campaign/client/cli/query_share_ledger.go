@@ -0,0 +1,92 @@
+package cli
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/tendermint/spn/x/campaign/types"
+)
+
+func CmdListShareLedger() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-share-ledger [campaign-id]",
+		Short: "List the share movements of a campaign sorted by height",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			campaignID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			address, err := cmd.Flags().GetString(flagAccount)
+			if err != nil {
+				return err
+			}
+
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryShareLedgerRequest{
+				CampaignID: campaignID,
+				Address:    address,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ShareLedger(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagAccount, "", "Only list the share movements involving the account address")
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowCapTable() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-cap-table [campaign-id]",
+		Short: "Show the holders of the shares and vouchers of a campaign with their ownership",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			campaignID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryCapTableRequest{
+				CampaignID: campaignID,
+			}
+
+			res, err := queryClient.CapTable(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,45 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+
+	"github.com/tendermint/spn/x/campaign/types"
+)
+
+func CmdShowVoucherTransferPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-voucher-transfer-policy [campaign-id]",
+		Short: "shows the voucher transfer policy of a campaign",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argsCampaignID, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryGetVoucherTransferPolicyRequest{
+				CampaignID: argsCampaignID,
+			}
+
+			res, err := queryClient.VoucherTransferPolicy(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
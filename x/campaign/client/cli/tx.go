@@ -29,6 +29,9 @@ func GetTxCmd() *cobra.Command {
 		CmdBurnVouchers(),
 		CmdUnredeemVouchers(),
 		CmdRedeemVouchers(),
+		CmdSetCampaignAirdrop(),
+		CmdResetMainnet(),
+		CmdSetVoucherTransferPolicy(),
 	)
 
 	// this line is used by starport scaffolding # 1
@@ -0,0 +1,64 @@
+package cli
+
+import (
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+
+	"github.com/tendermint/spn/x/campaign/types"
+)
+
+func CmdSetCampaignAirdrop() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-campaign-airdrop [campaign-id] [airdrop-file]",
+		Short: "Set the missions and the claim records of the airdrop of the campaign",
+		Long: `Set the missions and the claim records of the airdrop of the campaign.
+The airdrop file is a JSON file containing the denom, the missions and the claim records of the airdrop:
+{
+  "denom": "foo",
+  "missions": [{"missionID": "1", "description": "staking", "weight": "1.0"}],
+  "claimRecords": [{"address": "spn1...", "weight": "10"}]
+}`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			campaignID, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+			var airdrop types.CampaignAirdrop
+			if err := clientCtx.Codec.UnmarshalJSON(bz, &airdrop); err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetCampaignAirdrop(
+				clientCtx.GetFromAddress().String(),
+				campaignID,
+				airdrop.Denom,
+				airdrop.Missions,
+				airdrop.ClaimRecords,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
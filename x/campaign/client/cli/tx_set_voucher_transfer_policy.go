@@ -0,0 +1,61 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+
+	"github.com/tendermint/spn/x/campaign/types"
+)
+
+func CmdSetVoucherTransferPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-voucher-transfer-policy [campaign-id] [free|allowlist|no-ibc] [allowlist]",
+		Short: "Set the restrictions applied to the transfers of the vouchers of a campaign",
+		Long: `Set the restrictions applied to the transfers of the vouchers of a campaign.
+The mode free doesn't restrict transfers, no-ibc prevents the transfers through IBC and allowlist only allows
+the transfers to the comma separated list of addresses provided as the last argument`,
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			campaignID, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			mode, ok := types.VoucherTransferPolicy_Mode_value[strings.ToUpper(strings.ReplaceAll(args[1], "-", "_"))]
+			if !ok {
+				return fmt.Errorf("invalid voucher transfer policy mode %s", args[1])
+			}
+
+			allowlist := []string{}
+			if len(args) == 3 && args[2] != "" {
+				allowlist = strings.Split(args[2], ",")
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetVoucherTransferPolicy(
+				clientCtx.GetFromAddress().String(),
+				campaignID,
+				types.VoucherTransferPolicy_Mode(mode),
+				allowlist,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
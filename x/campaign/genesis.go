@@ -27,6 +27,29 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		k.SetMainnetAccount(ctx, elem)
 	}
 
+	// Set all the mainnetVestingAccount
+	for _, elem := range genState.MainnetVestingAccountList {
+		k.SetMainnetVestingAccount(ctx, elem)
+	}
+
+	// Set all the campaignAirdrop
+	for _, elem := range genState.CampaignAirdropList {
+		k.SetCampaignAirdrop(ctx, elem)
+	}
+
+	// Set all the voucherTransferPolicy
+	for _, elem := range genState.VoucherTransferPolicyList {
+		k.SetVoucherTransferPolicy(ctx, elem)
+	}
+
+	// Set all the shareLedger entries and the ledger counters
+	for _, elem := range genState.ShareLedgerList {
+		k.SetShareLedgerEntry(ctx, elem)
+		if elem.LedgerID >= k.GetShareLedgerCounter(ctx, elem.CampaignID) {
+			k.SetShareLedgerCounter(ctx, elem.CampaignID, elem.LedgerID+1)
+		}
+	}
+
 	k.SetParams(ctx, genState.Params)
 
 	// set maximum shares constant value
@@ -43,6 +66,10 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.CampaignCounter = k.GetCampaignCounter(ctx)
 	genesis.CampaignChainsList = k.GetAllCampaignChains(ctx)
 	genesis.MainnetAccountList = k.GetAllMainnetAccount(ctx)
+	genesis.MainnetVestingAccountList = k.GetAllMainnetVestingAccount(ctx)
+	genesis.CampaignAirdropList = k.GetAllCampaignAirdrop(ctx)
+	genesis.VoucherTransferPolicyList = k.GetAllVoucherTransferPolicy(ctx)
+	genesis.ShareLedgerList = k.GetAllShareLedgerEntry(ctx)
 	genesis.Params = k.GetParams(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
@@ -31,6 +31,14 @@ func TestGenesis(t *testing.T) {
 
 	require.ElementsMatch(t, genesisState.MainnetAccountList, got.MainnetAccountList)
 
+	require.ElementsMatch(t, genesisState.MainnetVestingAccountList, got.MainnetVestingAccountList)
+
+	require.ElementsMatch(t, genesisState.CampaignAirdropList, got.CampaignAirdropList)
+
+	require.ElementsMatch(t, genesisState.VoucherTransferPolicyList, got.VoucherTransferPolicyList)
+
+	require.ElementsMatch(t, genesisState.ShareLedgerList, got.ShareLedgerList)
+
 	require.Equal(t, genesisState.Params, got.Params)
 
 	maxShares := tk.CampaignKeeper.GetTotalShares(ctx)
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tendermint/spn/x/campaign/types"
+)
+
+// SetCampaignAirdrop set a specific campaignAirdrop in the store from its index
+func (k Keeper) SetCampaignAirdrop(ctx sdk.Context, campaignAirdrop types.CampaignAirdrop) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CampaignAirdropKeyPrefix))
+	b := k.cdc.MustMarshal(&campaignAirdrop)
+	store.Set(types.CampaignAirdropKey(
+		campaignAirdrop.CampaignID,
+	), b)
+}
+
+// GetCampaignAirdrop returns a campaignAirdrop from its index
+func (k Keeper) GetCampaignAirdrop(ctx sdk.Context, campaignID uint64) (val types.CampaignAirdrop, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CampaignAirdropKeyPrefix))
+
+	b := store.Get(types.CampaignAirdropKey(
+		campaignID,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllCampaignAirdrop returns all campaignAirdrop
+func (k Keeper) GetAllCampaignAirdrop(ctx sdk.Context) (list []types.CampaignAirdrop) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CampaignAirdropKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.CampaignAirdrop
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// CampaignAirdropSupply returns the airdrop supply of a campaign computed from the claimable airdrop
+// in the denom of the airdrop
+func (k Keeper) CampaignAirdropSupply(ctx sdk.Context, campaignID uint64) (sdk.Coin, error) {
+	campaign, found := k.GetCampaign(ctx, campaignID)
+	if !found {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrCampaignNotFound, "%d", campaignID)
+	}
+	airdrop, found := k.GetCampaignAirdrop(ctx, campaignID)
+	if !found {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrAirdropNotFound, "%d", campaignID)
+	}
+
+	claimableAirdrop, err := campaign.SpecialAllocations.ClaimableAirdrop.CoinsFromTotalSupply(
+		campaign.TotalSupply,
+		k.GetTotalShares(ctx),
+	)
+	if err != nil {
+		return sdk.Coin{}, sdkerrors.Wrap(types.ErrInvalidShares, err.Error())
+	}
+
+	return sdk.NewCoin(airdrop.Denom, claimableAirdrop.AmountOf(airdrop.Denom)), nil
+}
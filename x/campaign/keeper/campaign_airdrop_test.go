@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	tc "github.com/tendermint/spn/testutil/constructor"
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/campaign/keeper"
+	"github.com/tendermint/spn/x/campaign/types"
+)
+
+func createNCampaignAirdrop(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.CampaignAirdrop {
+	items := make([]types.CampaignAirdrop, n)
+	for i := range items {
+		items[i] = sample.CampaignAirdrop(r, uint64(i))
+		keeper.SetCampaignAirdrop(ctx, items[i])
+	}
+	return items
+}
+
+func TestCampaignAirdropGet(t *testing.T) {
+	ctx, tk, _ := testkeeper.NewTestSetup(t)
+	items := createNCampaignAirdrop(tk.CampaignKeeper, ctx, 10)
+	for _, item := range items {
+		rst, found := tk.CampaignKeeper.GetCampaignAirdrop(ctx, item.CampaignID)
+		require.True(t, found)
+		require.Equal(t, item, rst)
+	}
+}
+
+func TestCampaignAirdropGetAll(t *testing.T) {
+	ctx, tk, _ := testkeeper.NewTestSetup(t)
+	items := createNCampaignAirdrop(tk.CampaignKeeper, ctx, 10)
+	require.ElementsMatch(t, items, tk.CampaignKeeper.GetAllCampaignAirdrop(ctx))
+}
+
+func TestKeeper_CampaignAirdropSupply(t *testing.T) {
+	var (
+		ctx, tk, _ = testkeeper.NewTestSetup(t)
+
+		campaignID              = uint64(1)
+		campaignIDNoAirdrop     = uint64(2)
+		campaignIDInvalidShares = uint64(3)
+	)
+
+	tk.CampaignKeeper.SetTotalShares(ctx, 100)
+
+	for _, id := range []uint64{campaignID, campaignIDNoAirdrop, campaignIDInvalidShares} {
+		campaign := sample.Campaign(r, id)
+		campaign.TotalSupply = tc.Coins(t, "1000foo,1000bar")
+		campaign.SpecialAllocations = types.NewSpecialAllocations(types.EmptyShares(), tc.Shares(t, "30foo,50bar"))
+		if id == campaignIDInvalidShares {
+			campaign.SpecialAllocations.ClaimableAirdrop = tc.Shares(t, "101foo")
+		}
+		tk.CampaignKeeper.SetCampaign(ctx, campaign)
+
+		if id != campaignIDNoAirdrop {
+			airdrop := sample.CampaignAirdrop(r, id)
+			airdrop.Denom = "foo"
+			tk.CampaignKeeper.SetCampaignAirdrop(ctx, airdrop)
+		}
+	}
+
+	t.Run("should compute the airdrop supply in the denom of the airdrop", func(t *testing.T) {
+		airdropSupply, err := tk.CampaignKeeper.CampaignAirdropSupply(ctx, campaignID)
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewInt64Coin("foo", 300), airdropSupply)
+	})
+
+	t.Run("should fail if the campaign doesn't exist", func(t *testing.T) {
+		_, err := tk.CampaignKeeper.CampaignAirdropSupply(ctx, 1000)
+		require.ErrorIs(t, err, types.ErrCampaignNotFound)
+	})
+
+	t.Run("should fail if the campaign has no airdrop", func(t *testing.T) {
+		_, err := tk.CampaignKeeper.CampaignAirdropSupply(ctx, campaignIDNoAirdrop)
+		require.ErrorIs(t, err, types.ErrAirdropNotFound)
+	})
+
+	t.Run("should fail if the claimable airdrop is invalid", func(t *testing.T) {
+		_, err := tk.CampaignKeeper.CampaignAirdropSupply(ctx, campaignIDInvalidShares)
+		require.ErrorIs(t, err, types.ErrInvalidShares)
+	})
+}
@@ -28,3 +28,19 @@ func (k Keeper) IsCampaignMainnetLaunchTriggered(ctx sdk.Context, campaignID uin
 	}
 	return false, nil
 }
+
+// HasArchivedMainnet returns true if a mainnet of the campaign has been archived
+// this is the case when the campaign mainnet has been initialized and reset before
+func (k Keeper) HasArchivedMainnet(ctx sdk.Context, campaignID uint64) bool {
+	campaignChains, found := k.GetCampaignChains(ctx, campaignID)
+	if !found {
+		return false
+	}
+	for _, launchID := range campaignChains.Chains {
+		chain, found := k.launchKeeper.GetChain(ctx, launchID)
+		if found && chain.IsMainnet && chain.Archived {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"sort"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ignterrors "github.com/ignite/modules/errors"
+
+	"github.com/tendermint/spn/x/campaign/types"
+)
+
+// GetCapTable returns the holders of the shares and vouchers of a campaign sorted by address
+// The ownership of a holder is the percentage of the total shares held through shares and vouchers for each denom
+func (k Keeper) GetCapTable(ctx sdk.Context, campaignID uint64) ([]types.CapTableHolder, error) {
+	campaign, found := k.GetCampaign(ctx, campaignID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrCampaignNotFound, "%d", campaignID)
+	}
+
+	holders := make(map[string]*types.CapTableHolder)
+	holder := func(address string) *types.CapTableHolder {
+		if _, ok := holders[address]; !ok {
+			holders[address] = &types.CapTableHolder{
+				Address:  address,
+				Shares:   types.EmptyShares(),
+				Vouchers: sdk.NewCoins(),
+			}
+		}
+		return holders[address]
+	}
+
+	// the shares are held by the mainnet accounts
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MainnetAccountAllKey(campaignID))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var acc types.MainnetAccount
+		k.cdc.MustUnmarshal(iterator.Value(), &acc)
+		holder(acc.Address).Shares = acc.Shares
+	}
+
+	// the vouchers can only be minted from the allocated shares
+	voucherDenoms, err := types.SharesToVouchers(campaign.AllocatedShares, campaignID)
+	if err != nil {
+		return nil, ignterrors.Criticalf("campaign %d allocated shares are invalid %s", campaignID, err.Error())
+	}
+	for _, voucherDenom := range voucherDenoms {
+		req := &banktypes.QueryDenomOwnersRequest{
+			Denom:      voucherDenom.Denom,
+			Pagination: &query.PageRequest{},
+		}
+		for {
+			res, err := k.bankKeeper.DenomOwners(sdk.WrapSDKContext(ctx), req)
+			if err != nil {
+				return nil, err
+			}
+			for _, owner := range res.DenomOwners {
+				h := holder(owner.Address)
+				h.Vouchers = h.Vouchers.Add(owner.Balance)
+			}
+			if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+				break
+			}
+			req.Pagination.Key = res.Pagination.NextKey
+		}
+	}
+
+	// compute the ownership of the holders
+	totalShares := sdk.NewDecFromInt(sdkmath.NewIntFromUint64(k.GetTotalShares(ctx)))
+	capTable := make([]types.CapTableHolder, 0, len(holders))
+	for _, h := range holders {
+		shares, err := types.VouchersToShares(h.Vouchers, campaignID)
+		if err != nil {
+			return nil, ignterrors.Criticalf("campaign %d vouchers are invalid %s", campaignID, err.Error())
+		}
+		shares = types.IncreaseShares(shares, h.Shares)
+
+		h.Ownership = sdk.NewDecCoins()
+		for _, share := range shares {
+			h.Ownership = h.Ownership.Add(sdk.NewDecCoinFromDec(
+				share.Denom,
+				sdk.NewDecFromInt(share.Amount).MulInt64(100).Quo(totalShares),
+			))
+		}
+		capTable = append(capTable, *h)
+	}
+	sort.Slice(capTable, func(i, j int) bool {
+		return capTable[i].Address < capTable[j].Address
+	})
+
+	return capTable, nil
+}
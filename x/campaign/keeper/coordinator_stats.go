@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+)
+
+// CountCoordinatorCampaigns returns the number of campaigns created and mainnets initialized
+// by each coordinator from the campaigns in the store
+func (k Keeper) CountCoordinatorCampaigns(ctx sdk.Context) []profiletypes.CoordinatorStats {
+	var stats []profiletypes.CoordinatorStats
+	indexes := make(map[uint64]int)
+
+	for _, campaign := range k.GetAllCampaign(ctx) {
+		i, ok := indexes[campaign.CoordinatorID]
+		if !ok {
+			i = len(stats)
+			indexes[campaign.CoordinatorID] = i
+			stats = append(stats, profiletypes.NewCoordinatorStats(campaign.CoordinatorID))
+		}
+
+		stats[i].CampaignsCreated++
+		if campaign.MainnetInitialized || k.HasArchivedMainnet(ctx, campaign.CampaignID) {
+			stats[i].MainnetsInitialized++
+		}
+	}
+
+	return stats
+}
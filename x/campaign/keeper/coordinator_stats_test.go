@@ -0,0 +1,56 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/sample"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+)
+
+func TestKeeper_CountCoordinatorCampaigns(t *testing.T) {
+	ctx, tk, _ := testkeeper.NewTestSetup(t)
+
+	t.Run("should count the campaigns created and mainnets initialized by the coordinators", func(t *testing.T) {
+		// campaign 0 has an initialized mainnet
+		campaign := sample.Campaign(r, 0)
+		campaign.CoordinatorID = 0
+		campaign.MainnetInitialized = true
+		tk.CampaignKeeper.SetCampaign(ctx, campaign)
+
+		// campaign 1 had its mainnet reset
+		campaign = sample.Campaign(r, 1)
+		campaign.CoordinatorID = 0
+		tk.CampaignKeeper.SetCampaign(ctx, campaign)
+		archivedMainnet := sample.Chain(r, 0, 0)
+		archivedMainnet.HasCampaign = true
+		archivedMainnet.CampaignID = 1
+		archivedMainnet.IsMainnet = true
+		archivedMainnet.Archived = true
+		tk.LaunchKeeper.SetChain(ctx, archivedMainnet)
+		require.NoError(t, tk.CampaignKeeper.AddChainToCampaign(ctx, 1, archivedMainnet.LaunchID))
+
+		// campaign 2 has no mainnet
+		campaign = sample.Campaign(r, 2)
+		campaign.CoordinatorID = 0
+		tk.CampaignKeeper.SetCampaign(ctx, campaign)
+
+		campaign = sample.Campaign(r, 3)
+		campaign.CoordinatorID = 1
+		tk.CampaignKeeper.SetCampaign(ctx, campaign)
+
+		require.Equal(t, []profiletypes.CoordinatorStats{
+			{
+				CoordinatorID:       0,
+				CampaignsCreated:    3,
+				MainnetsInitialized: 2,
+			},
+			{
+				CoordinatorID:    1,
+				CampaignsCreated: 1,
+			},
+		}, tk.CampaignKeeper.CountCoordinatorCampaigns(ctx))
+	})
+}
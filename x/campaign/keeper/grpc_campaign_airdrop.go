@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/tendermint/spn/x/campaign/types"
+)
+
+func (k Keeper) CampaignAirdrop(
+	goCtx context.Context,
+	req *types.QueryGetCampaignAirdropRequest,
+) (*types.QueryGetCampaignAirdropResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	campaignAirdrop, found := k.GetCampaignAirdrop(ctx, req.CampaignID)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	airdropSupply, err := k.CampaignAirdropSupply(ctx, req.CampaignID)
+	if sdkerrors.IsOf(err, types.ErrCampaignNotFound) {
+		return nil, status.Error(codes.NotFound, "campaign not found")
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "airdrop supply can't be calculated: %s", err.Error())
+	}
+
+	return &types.QueryGetCampaignAirdropResponse{
+		CampaignAirdrop: campaignAirdrop,
+		AirdropSupply:   airdropSupply,
+	}, nil
+}
@@ -0,0 +1,91 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	tc "github.com/tendermint/spn/testutil/constructor"
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/campaign/types"
+)
+
+func TestCampaignAirdropQuery(t *testing.T) {
+	var (
+		ctx, tk, _ = testkeeper.NewTestSetup(t)
+		wctx       = sdk.WrapSDKContext(ctx)
+
+		campaignID              = uint64(1)
+		campaignIDInvalidShares = uint64(2)
+		airdrops                = make(map[uint64]types.CampaignAirdrop)
+	)
+
+	tk.CampaignKeeper.SetTotalShares(ctx, 100)
+
+	for _, id := range []uint64{campaignID, campaignIDInvalidShares} {
+		campaign := sample.Campaign(r, id)
+		campaign.TotalSupply = tc.Coins(t, "1000foo")
+		campaign.SpecialAllocations = types.NewSpecialAllocations(types.EmptyShares(), tc.Shares(t, "50foo"))
+		if id == campaignIDInvalidShares {
+			campaign.SpecialAllocations.ClaimableAirdrop = tc.Shares(t, "101foo")
+		}
+		tk.CampaignKeeper.SetCampaign(ctx, campaign)
+
+		airdrop := sample.CampaignAirdrop(r, id)
+		airdrop.Denom = "foo"
+		tk.CampaignKeeper.SetCampaignAirdrop(ctx, airdrop)
+		airdrops[id] = airdrop
+	}
+
+	// an airdrop without campaign
+	tk.CampaignKeeper.SetCampaignAirdrop(ctx, sample.CampaignAirdrop(r, 1000))
+
+	for _, tc := range []struct {
+		desc          string
+		request       *types.QueryGetCampaignAirdropRequest
+		response      *types.QueryGetCampaignAirdropResponse
+		errStatusCode codes.Code
+	}{
+		{
+			desc:    "should fetch the airdrop of the campaign",
+			request: &types.QueryGetCampaignAirdropRequest{CampaignID: campaignID},
+			response: &types.QueryGetCampaignAirdropResponse{
+				CampaignAirdrop: airdrops[campaignID],
+				AirdropSupply:   sdk.NewInt64Coin("foo", 500),
+			},
+		},
+		{
+			desc:          "should fail if the airdrop doesn't exist",
+			request:       &types.QueryGetCampaignAirdropRequest{CampaignID: 10000},
+			errStatusCode: codes.NotFound,
+		},
+		{
+			desc:          "should fail if the campaign doesn't exist",
+			request:       &types.QueryGetCampaignAirdropRequest{CampaignID: 1000},
+			errStatusCode: codes.NotFound,
+		},
+		{
+			desc:          "should fail if the airdrop supply can't be calculated",
+			request:       &types.QueryGetCampaignAirdropRequest{CampaignID: campaignIDInvalidShares},
+			errStatusCode: codes.Internal,
+		},
+		{
+			desc:          "should fail if the request is nil",
+			errStatusCode: codes.InvalidArgument,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := tk.CampaignKeeper.CampaignAirdrop(wctx, tc.request)
+			if tc.errStatusCode != codes.OK {
+				require.EqualValues(t, tc.errStatusCode, status.Code(err))
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.response, response)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"context"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/tendermint/spn/x/campaign/types"
+)
+
+func (k Keeper) CapTable(goCtx context.Context, req *types.QueryCapTableRequest) (*types.QueryCapTableResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	holders, err := k.GetCapTable(ctx, req.CampaignID)
+	if sdkerrors.IsOf(err, types.ErrCampaignNotFound) {
+		return nil, status.Error(codes.NotFound, "campaign not found")
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "cap table can't be computed: %s", err.Error())
+	}
+
+	return &types.QueryCapTableResponse{
+		CampaignID:  req.CampaignID,
+		TotalShares: k.GetTotalShares(ctx),
+		Holders:     holders,
+	}, nil
+}
@@ -0,0 +1,124 @@
+package keeper_test
+
+import (
+	"sort"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	spntypes "github.com/tendermint/spn/pkg/types"
+	tc "github.com/tendermint/spn/testutil/constructor"
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/campaign/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+)
+
+func TestCapTableQuery(t *testing.T) {
+	var (
+		sdkCtx, tk, ts = testkeeper.NewTestSetup(t)
+		ctx            = sdk.WrapSDKContext(sdkCtx)
+
+		coord   = sample.Address(r)
+		account = sample.Address(r)
+	)
+
+	res, err := ts.ProfileSrv.CreateCoordinator(ctx, &profiletypes.MsgCreateCoordinator{
+		Address:     coord,
+		Description: sample.CoordinatorDescription(r),
+	})
+	require.NoError(t, err)
+
+	campaign := sample.Campaign(r, 0)
+	campaign.CoordinatorID = res.CoordinatorID
+	campaign.AllocatedShares = types.EmptyShares()
+	campaignID := tk.CampaignKeeper.AppendCampaign(sdkCtx, campaign)
+
+	emptyCampaign := sample.Campaign(r, 0)
+	emptyCampaign.AllocatedShares = types.EmptyShares()
+	emptyCampaignID := tk.CampaignKeeper.AppendCampaign(sdkCtx, emptyCampaign)
+
+	vouchers := func(shares string) sdk.Coins {
+		return tc.Vouchers(t, shares, campaignID)
+	}
+	ownership := func(str string) sdk.DecCoins {
+		decCoins, err := sdk.ParseDecCoins(str)
+		require.NoError(t, err)
+		return decCoins
+	}
+
+	// the coordinator mints vouchers and redeems part of them to the account
+	_, err = ts.CampaignSrv.MintVouchers(ctx, types.NewMsgMintVouchers(coord, campaignID, tc.Shares(t, "1000foo,500bar")))
+	require.NoError(t, err)
+	_, err = ts.CampaignSrv.RedeemVouchers(ctx, types.NewMsgRedeemVouchers(coord, account, campaignID, vouchers("400foo")))
+	require.NoError(t, err)
+	_, err = ts.CampaignSrv.UnredeemVouchers(ctx, types.NewMsgUnredeemVouchers(account, campaignID, tc.Shares(t, "100foo")))
+	require.NoError(t, err)
+
+	holders := []types.CapTableHolder{
+		{
+			Address:   coord,
+			Shares:    types.EmptyShares(),
+			Vouchers:  vouchers("600foo,500bar"),
+			Ownership: ownership("0.6s/foo,0.5s/bar"),
+		},
+		{
+			Address:   account,
+			Shares:    tc.Shares(t, "300foo"),
+			Vouchers:  vouchers("100foo"),
+			Ownership: ownership("0.4s/foo"),
+		},
+	}
+	sort.Slice(holders, func(i, j int) bool {
+		return holders[i].Address < holders[j].Address
+	})
+
+	for _, tc := range []struct {
+		desc          string
+		request       *types.QueryCapTableRequest
+		response      *types.QueryCapTableResponse
+		errStatusCode codes.Code
+	}{
+		{
+			desc:    "should fetch the cap table of the campaign",
+			request: &types.QueryCapTableRequest{CampaignID: campaignID},
+			response: &types.QueryCapTableResponse{
+				CampaignID:  campaignID,
+				TotalShares: spntypes.TotalShareNumber,
+				Holders:     holders,
+			},
+		},
+		{
+			desc:    "should fetch an empty cap table for a campaign without holders",
+			request: &types.QueryCapTableRequest{CampaignID: emptyCampaignID},
+			response: &types.QueryCapTableResponse{
+				CampaignID:  emptyCampaignID,
+				TotalShares: spntypes.TotalShareNumber,
+				Holders:     []types.CapTableHolder{},
+			},
+		},
+		{
+			desc:          "should fail if the campaign doesn't exist",
+			request:       &types.QueryCapTableRequest{CampaignID: 1000},
+			errStatusCode: codes.NotFound,
+		},
+		{
+			desc:          "should fail if the request is nil",
+			errStatusCode: codes.InvalidArgument,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := tk.CampaignKeeper.CapTable(ctx, tc.request)
+			if tc.errStatusCode != codes.OK {
+				require.Error(t, err)
+				require.Equal(t, tc.errStatusCode, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.response, response)
+		})
+	}
+}
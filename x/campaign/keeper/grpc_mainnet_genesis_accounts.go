@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"context"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/tendermint/spn/x/campaign/types"
+)
+
+func (k Keeper) MainnetGenesisAccounts(
+	goCtx context.Context,
+	req *types.QueryMainnetGenesisAccountsRequest,
+) (*types.QueryMainnetGenesisAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	genesisAccounts, err := k.GetMainnetGenesisAccounts(ctx, req.CampaignID)
+	if sdkerrors.IsOf(err, types.ErrCampaignNotFound) {
+		return nil, status.Error(codes.NotFound, "campaign not found")
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "mainnet genesis accounts can't be calculated: %s", err.Error())
+	}
+
+	return &types.QueryMainnetGenesisAccountsResponse{MainnetGenesisAccounts: genesisAccounts}, nil
+}
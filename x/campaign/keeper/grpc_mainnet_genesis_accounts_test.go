@@ -0,0 +1,91 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	tc "github.com/tendermint/spn/testutil/constructor"
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/campaign/types"
+)
+
+func TestMainnetGenesisAccounts(t *testing.T) {
+	var (
+		ctx, tk, _ = testkeeper.NewTestSetup(t)
+		wctx       = sdk.WrapSDKContext(ctx)
+		coordAddr  = sample.Address(r)
+		addr       = sample.Address(r)
+		coordID    = tk.ProfileKeeper.AppendCoordinator(ctx, sample.Coordinator(r, coordAddr))
+
+		campaignID              = uint64(1)
+		campaignIDInvalidShares = uint64(2)
+	)
+
+	tk.CampaignKeeper.SetTotalShares(ctx, 100)
+
+	setCampaign := func(campaignID uint64, genesisDistribution types.Shares) {
+		campaign := sample.Campaign(r, campaignID)
+		campaign.CoordinatorID = coordID
+		campaign.TotalSupply = tc.Coins(t, "1000foo")
+		campaign.SpecialAllocations = types.NewSpecialAllocations(genesisDistribution, types.EmptyShares())
+		tk.CampaignKeeper.SetCampaign(ctx, campaign)
+	}
+	setCampaign(campaignID, tc.Shares(t, "50foo"))
+	setCampaign(campaignIDInvalidShares, tc.Shares(t, "101foo"))
+	tk.CampaignKeeper.SetMainnetAccount(ctx, types.MainnetAccount{
+		CampaignID: campaignID,
+		Address:    addr,
+		Shares:     tc.Shares(t, "50foo"),
+	})
+
+	for _, tc := range []struct {
+		desc          string
+		request       *types.QueryMainnetGenesisAccountsRequest
+		response      *types.QueryMainnetGenesisAccountsResponse
+		errStatusCode codes.Code
+	}{
+		{
+			desc:    "should fetch the mainnet genesis accounts",
+			request: &types.QueryMainnetGenesisAccountsRequest{CampaignID: campaignID},
+			response: &types.QueryMainnetGenesisAccountsResponse{
+				MainnetGenesisAccounts: types.MainnetGenesisAccounts{
+					CampaignID: campaignID,
+					Accounts: []types.MainnetAccountBalance{
+						{CampaignID: campaignID, Address: addr, Coins: tc.Coins(t, "500foo")},
+					},
+					GenesisDistribution: tc.Coins(t, "500foo"),
+					DustRecipient:       coordAddr,
+				},
+			},
+		},
+		{
+			desc:          "should fail if campaign not found",
+			request:       &types.QueryMainnetGenesisAccountsRequest{CampaignID: 10000},
+			errStatusCode: codes.NotFound,
+		},
+		{
+			desc:          "should fail if the balances can't be calculated",
+			request:       &types.QueryMainnetGenesisAccountsRequest{CampaignID: campaignIDInvalidShares},
+			errStatusCode: codes.Internal,
+		},
+		{
+			desc:          "should fail if the request is nil",
+			errStatusCode: codes.InvalidArgument,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := tk.CampaignKeeper.MainnetGenesisAccounts(wctx, tc.request)
+			if tc.errStatusCode != codes.OK {
+				require.EqualValues(t, tc.errStatusCode, status.Code(err))
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.response, response)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/tendermint/spn/x/campaign/types"
+)
+
+func (k Keeper) ShareLedger(c context.Context, req *types.QueryShareLedgerRequest) (*types.QueryShareLedgerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var entries []types.ShareLedgerEntry
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	shareLedgerStore := prefix.NewStore(store, types.ShareLedgerAllKey(req.CampaignID))
+
+	pageRes, err := query.FilteredPaginate(
+		shareLedgerStore,
+		req.Pagination,
+		func(key []byte, value []byte, accumulate bool) (bool, error) {
+			var entry types.ShareLedgerEntry
+			if err := k.cdc.Unmarshal(value, &entry); err != nil {
+				return false, err
+			}
+
+			if req.Address != "" && !entry.Involves(req.Address) {
+				return false, nil
+			}
+			if accumulate {
+				entries = append(entries, entry)
+			}
+			return true, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryShareLedgerResponse{ShareLedgerEntry: entries, Pagination: pageRes}, nil
+}
@@ -0,0 +1,83 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/campaign/types"
+)
+
+func TestShareLedgerQueryPaginated(t *testing.T) {
+	var (
+		campaignID = uint64(5)
+		ctx, tk, _ = testkeeper.NewTestSetup(t)
+		wctx       = sdk.WrapSDKContext(ctx)
+		msgs       = createNShareLedgerEntry(tk.CampaignKeeper, ctx, campaignID, 5)
+	)
+
+	// entries of another campaign must not be returned
+	createNShareLedgerEntry(tk.CampaignKeeper, ctx, campaignID+1, 5)
+
+	request := func(campaignID uint64, address string, next []byte, offset, limit uint64, total bool) *types.QueryShareLedgerRequest {
+		return &types.QueryShareLedgerRequest{
+			CampaignID: campaignID,
+			Address:    address,
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(msgs); i += step {
+			resp, err := tk.CampaignKeeper.ShareLedger(wctx, request(campaignID, "", nil, uint64(i), uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.ShareLedgerEntry), step)
+			require.Subset(t, msgs, resp.ShareLedgerEntry)
+		}
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		for i := 0; i < len(msgs); i += step {
+			resp, err := tk.CampaignKeeper.ShareLedger(wctx, request(campaignID, "", next, 0, uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.ShareLedgerEntry), step)
+			require.Subset(t, msgs, resp.ShareLedgerEntry)
+			next = resp.Pagination.NextKey
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := tk.CampaignKeeper.ShareLedger(wctx, request(campaignID, "", nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+		require.ElementsMatch(t, msgs, resp.ShareLedgerEntry)
+	})
+	t.Run("ByAddress", func(t *testing.T) {
+		resp, err := tk.CampaignKeeper.ShareLedger(wctx, request(campaignID, msgs[1].Address, nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, []types.ShareLedgerEntry{msgs[1]}, resp.ShareLedgerEntry)
+
+		resp, err = tk.CampaignKeeper.ShareLedger(wctx, request(campaignID, msgs[2].Sender, nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, []types.ShareLedgerEntry{msgs[2]}, resp.ShareLedgerEntry)
+
+		resp, err = tk.CampaignKeeper.ShareLedger(wctx, request(campaignID, sample.Address(r), nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Empty(t, resp.ShareLedgerEntry)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := tk.CampaignKeeper.ShareLedger(wctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
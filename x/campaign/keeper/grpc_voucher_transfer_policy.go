@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/tendermint/spn/x/campaign/types"
+)
+
+func (k Keeper) VoucherTransferPolicy(
+	goCtx context.Context,
+	req *types.QueryGetVoucherTransferPolicyRequest,
+) (*types.QueryGetVoucherTransferPolicyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetCampaign(ctx, req.CampaignID); !found {
+		return nil, status.Error(codes.NotFound, "campaign not found")
+	}
+
+	return &types.QueryGetVoucherTransferPolicyResponse{
+		VoucherTransferPolicy: k.VoucherTransferPolicyOf(ctx, req.CampaignID),
+	}, nil
+}
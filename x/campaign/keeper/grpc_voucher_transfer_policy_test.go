@@ -0,0 +1,70 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/campaign/types"
+)
+
+func TestVoucherTransferPolicyQuery(t *testing.T) {
+	var (
+		ctx, tk, _ = testkeeper.NewTestSetup(t)
+		wctx       = sdk.WrapSDKContext(ctx)
+
+		campaignID            = uint64(1)
+		campaignIDNoPolicy    = uint64(2)
+		voucherTransferPolicy = sample.VoucherTransferPolicy(r, campaignID)
+	)
+
+	tk.CampaignKeeper.SetCampaign(ctx, sample.Campaign(r, campaignID))
+	tk.CampaignKeeper.SetCampaign(ctx, sample.Campaign(r, campaignIDNoPolicy))
+	tk.CampaignKeeper.SetVoucherTransferPolicy(ctx, voucherTransferPolicy)
+
+	for _, tc := range []struct {
+		desc          string
+		request       *types.QueryGetVoucherTransferPolicyRequest
+		response      *types.QueryGetVoucherTransferPolicyResponse
+		errStatusCode codes.Code
+	}{
+		{
+			desc:    "should fetch the policy of the campaign",
+			request: &types.QueryGetVoucherTransferPolicyRequest{CampaignID: campaignID},
+			response: &types.QueryGetVoucherTransferPolicyResponse{
+				VoucherTransferPolicy: voucherTransferPolicy,
+			},
+		},
+		{
+			desc:    "should fetch the default policy if the campaign has no policy",
+			request: &types.QueryGetVoucherTransferPolicyRequest{CampaignID: campaignIDNoPolicy},
+			response: &types.QueryGetVoucherTransferPolicyResponse{
+				VoucherTransferPolicy: types.DefaultVoucherTransferPolicy(campaignIDNoPolicy),
+			},
+		},
+		{
+			desc:          "should fail if the campaign doesn't exist",
+			request:       &types.QueryGetVoucherTransferPolicyRequest{CampaignID: 1000},
+			errStatusCode: codes.NotFound,
+		},
+		{
+			desc:          "should fail if the request is nil",
+			errStatusCode: codes.InvalidArgument,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := tk.CampaignKeeper.VoucherTransferPolicy(wctx, tc.request)
+			if tc.errStatusCode != codes.OK {
+				require.EqualValues(t, tc.errStatusCode, status.Code(err))
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.response, response)
+			}
+		})
+	}
+}
@@ -33,7 +33,7 @@ func AllInvariants(k Keeper) sdk.Invariant {
 }
 
 // AccountWithoutCampaignInvariant invariant that checks if
-// the `MainnetAccount` campaign exist.
+// the `MainnetAccount` and `MainnetVestingAccount` campaign exist.
 func AccountWithoutCampaignInvariant(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
 		all := k.GetAllMainnetAccount(ctx)
@@ -45,6 +45,14 @@ func AccountWithoutCampaignInvariant(k Keeper) sdk.Invariant {
 				), true
 			}
 		}
+		for _, acc := range k.GetAllMainnetVestingAccount(ctx) {
+			if _, found := k.GetCampaign(ctx, acc.CampaignID); !found {
+				return sdk.FormatInvariant(
+					types.ModuleName, accountWithoutCampaignRoute,
+					fmt.Sprintf("%s: %d", types.ErrCampaignNotFound, acc.CampaignID),
+				), true
+			}
+		}
 		return "", false
 	}
 }
@@ -69,6 +77,18 @@ func CampaignSharesInvariant(k Keeper) sdk.Invariant {
 			)
 		}
 
+		// get all mainnet vesting account shares
+		vestingAccounts := k.GetAllMainnetVestingAccount(ctx)
+		for _, acc := range vestingAccounts {
+			if _, ok := accountSharesByCampaign[acc.CampaignID]; !ok {
+				accountSharesByCampaign[acc.CampaignID] = types.EmptyShares()
+			}
+			accountSharesByCampaign[acc.CampaignID] = types.IncreaseShares(
+				accountSharesByCampaign[acc.CampaignID],
+				acc.VestingOptions.TotalShares(),
+			)
+		}
+
 		for _, campaign := range k.GetAllCampaign(ctx) {
 			campaignID := campaign.CampaignID
 			expectedAllocatedSharesShares := accountSharesByCampaign[campaignID]
@@ -30,6 +30,7 @@ type LaunchKeeper interface {
 		accountBalance sdk.Coins,
 		metadata []byte,
 	) (uint64, error)
+	ArchiveChain(ctx sdk.Context, launchID uint64) error
 }
 
 type (
@@ -41,7 +42,9 @@ type (
 		bankKeeper    types.BankKeeper
 		distrKeeper   types.DistributionKeeper
 		profileKeeper types.ProfileKeeper
+		rewardKeeper  types.RewardKeeper
 		paramSpace    paramtypes.Subspace
+		authority     string
 	}
 )
 
@@ -54,6 +57,8 @@ func NewKeeper(
 	bankKeeper types.BankKeeper,
 	distrKeeper types.DistributionKeeper,
 	profileKeeper types.ProfileKeeper,
+	rewardKeeper types.RewardKeeper,
+	authority string,
 ) *Keeper {
 	// set KeyTable if it has not already been set
 	if !paramSpace.HasKeyTable() {
@@ -65,13 +70,20 @@ func NewKeeper(
 		storeKey:      storeKey,
 		memKey:        memKey,
 		paramSpace:    paramSpace,
+		authority:     authority,
 		launchKeeper:  launchKeeper,
 		bankKeeper:    bankKeeper,
 		distrKeeper:   distrKeeper,
 		profileKeeper: profileKeeper,
+		rewardKeeper:  rewardKeeper,
 	}
 }
 
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
+
+// GetAuthority returns the address allowed to update the module parameters
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
@@ -0,0 +1,160 @@
+package keeper
+
+import (
+	"sort"
+
+	sdkerrors "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tendermint/spn/x/campaign/types"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+)
+
+// GetMainnetGenesisAccounts computes the balances of the mainnet genesis from the shares of the campaign
+// The balances of the mainnet vesting accounts are the total balances of their vesting options
+// Each balance is rounded down, the rounding dust is added to the balance of the campaign coordinator
+func (k Keeper) GetMainnetGenesisAccounts(ctx sdk.Context, campaignID uint64) (types.MainnetGenesisAccounts, error) {
+	totalShareNumber := k.GetTotalShares(ctx)
+	campaign, found := k.GetCampaign(ctx, campaignID)
+	if !found {
+		return types.MainnetGenesisAccounts{}, sdkerrors.Wrapf(types.ErrCampaignNotFound, "%d", campaignID)
+	}
+	coord, found := k.profileKeeper.GetCoordinator(ctx, campaign.CoordinatorID)
+	if !found {
+		return types.MainnetGenesisAccounts{}, sdkerrors.Wrapf(profiletypes.ErrCoordInvalid,
+			"campaign %d coordinator doesn't exist %d",
+			campaignID,
+			campaign.CoordinatorID,
+		)
+	}
+
+	coinsFromShares := func(shares types.Shares) (sdk.Coins, error) {
+		coins, err := shares.CoinsFromTotalSupply(campaign.TotalSupply, totalShareNumber)
+		if err != nil {
+			return nil, sdkerrors.Wrap(types.ErrInvalidShares, err.Error())
+		}
+		return coins, nil
+	}
+
+	// the sum of the shares is used to compute the rounding dust
+	totalShares := types.EmptyShares()
+	distributed := sdk.NewCoins()
+
+	// compute the balances of the mainnet accounts, the iteration follows the store order and is sorted by address
+	var accounts []types.MainnetAccountBalance
+	coordAccountIndex := -1
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MainnetAccountAllKey(campaignID))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var acc types.MainnetAccount
+		k.cdc.MustUnmarshal(iterator.Value(), &acc)
+
+		balance, err := coinsFromShares(acc.Shares)
+		if err != nil {
+			return types.MainnetGenesisAccounts{}, sdkerrors.Wrapf(err, "account %s", acc.Address)
+		}
+		totalShares = types.IncreaseShares(totalShares, acc.Shares)
+		distributed = distributed.Add(balance...)
+
+		if acc.Address == coord.Address {
+			coordAccountIndex = len(accounts)
+		} else if balance.IsZero() {
+			continue
+		}
+		accounts = append(accounts, types.MainnetAccountBalance{
+			CampaignID: campaignID,
+			Address:    acc.Address,
+			Coins:      balance,
+		})
+	}
+
+	// compute the total balances of the mainnet vesting accounts
+	var vestingAccounts []types.MainnetAccountBalance
+	for _, acc := range k.GetCampaignMainnetVestingAccounts(ctx, campaignID) {
+		vestingOptions, err := launchtypes.NewVestingOptionsFromShares(
+			acc.VestingOptions,
+			campaign.TotalSupply,
+			totalShareNumber,
+		)
+		if err != nil {
+			return types.MainnetGenesisAccounts{}, sdkerrors.Wrapf(types.ErrInvalidShares,
+				"vesting account %s: %s",
+				acc.Address,
+				err.Error(),
+			)
+		}
+		balance := vestingOptions.TotalBalance()
+		totalShares = types.IncreaseShares(totalShares, acc.VestingOptions.TotalShares())
+		distributed = distributed.Add(balance...)
+
+		if balance.IsZero() {
+			continue
+		}
+		vestingAccounts = append(vestingAccounts, types.MainnetAccountBalance{
+			CampaignID: campaignID,
+			Address:    acc.Address,
+			Coins:      balance,
+		})
+	}
+
+	// compute the special allocations
+	genesisDistribution, err := coinsFromShares(campaign.SpecialAllocations.GenesisDistribution)
+	if err != nil {
+		return types.MainnetGenesisAccounts{}, sdkerrors.Wrap(err, "genesis distribution")
+	}
+	claimableAirdrop, err := coinsFromShares(campaign.SpecialAllocations.ClaimableAirdrop)
+	if err != nil {
+		return types.MainnetGenesisAccounts{}, sdkerrors.Wrap(err, "claimable airdrop")
+	}
+	totalShares = types.IncreaseShares(totalShares, campaign.SpecialAllocations.GenesisDistribution)
+	totalShares = types.IncreaseShares(totalShares, campaign.SpecialAllocations.ClaimableAirdrop)
+	distributed = distributed.Add(genesisDistribution...).Add(claimableAirdrop...)
+
+	// the dust is the difference between the coins of the total shares and the sum of the rounded balances
+	totalCoins, err := coinsFromShares(totalShares)
+	if err != nil {
+		return types.MainnetGenesisAccounts{}, sdkerrors.Wrap(err, "total allocated shares")
+	}
+	dust, isNegative := totalCoins.SafeSub(distributed...)
+	if isNegative {
+		return types.MainnetGenesisAccounts{}, sdkerrors.Wrapf(types.ErrInvalidShares,
+			"distributed coins %s greater than allocated coins %s",
+			distributed.String(),
+			totalCoins.String(),
+		)
+	}
+
+	// add the dust to the coordinator account
+	switch {
+	case coordAccountIndex >= 0:
+		accounts[coordAccountIndex].Coins = accounts[coordAccountIndex].Coins.Add(dust...)
+	case !dust.IsZero():
+		accounts = append(accounts, types.MainnetAccountBalance{
+			CampaignID: campaignID,
+			Address:    coord.Address,
+			Coins:      dust,
+		})
+		sort.Slice(accounts, func(i, j int) bool {
+			return accounts[i].Address < accounts[j].Address
+		})
+	}
+
+	// remove the coordinator account if it doesn't hold any balance
+	if coordAccountIndex >= 0 && accounts[coordAccountIndex].Coins.IsZero() {
+		accounts = append(accounts[:coordAccountIndex], accounts[coordAccountIndex+1:]...)
+	}
+
+	return types.MainnetGenesisAccounts{
+		CampaignID:          campaignID,
+		Accounts:            accounts,
+		GenesisDistribution: genesisDistribution,
+		ClaimableAirdrop:    claimableAirdrop,
+		DustRecipient:       coord.Address,
+		Dust:                dust,
+		VestingAccounts:     vestingAccounts,
+	}, nil
+}
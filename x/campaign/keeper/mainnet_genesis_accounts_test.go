@@ -0,0 +1,209 @@
+package keeper_test
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tc "github.com/tendermint/spn/testutil/constructor"
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/campaign/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+)
+
+// sortedBalances sorts the mainnet account balances by address
+func sortedBalances(balances ...types.MainnetAccountBalance) []types.MainnetAccountBalance {
+	sort.Slice(balances, func(i, j int) bool {
+		return balances[i].Address < balances[j].Address
+	})
+	return balances
+}
+
+func TestKeeper_GetMainnetGenesisAccounts(t *testing.T) {
+	var (
+		ctx, tk, _ = testkeeper.NewTestSetup(t)
+		coordAddr  = sample.Address(r)
+		addr1      = sample.Address(r)
+		addr2      = sample.Address(r)
+		coordID    = tk.ProfileKeeper.AppendCoordinator(ctx, sample.Coordinator(r, coordAddr))
+
+		campaignDust           = uint64(1)
+		campaignCoordAccount   = uint64(2)
+		campaignNoDust         = uint64(3)
+		campaignNoCoordinator  = uint64(4)
+		campaignInvalidShares  = uint64(5)
+		campaignInvalidAirdrop = uint64(6)
+		campaignVesting        = uint64(7)
+		campaignInvalidVesting = uint64(8)
+	)
+
+	// 3 total shares result in rounding dust for a total supply of 10foo
+	tk.CampaignKeeper.SetTotalShares(ctx, 3)
+
+	setCampaign := func(campaignID, coordID uint64, genesisDistribution, claimableAirdrop types.Shares) {
+		campaign := sample.Campaign(r, campaignID)
+		campaign.CoordinatorID = coordID
+		campaign.TotalSupply = tc.Coins(t, "10foo,100bar")
+		campaign.SpecialAllocations = types.NewSpecialAllocations(genesisDistribution, claimableAirdrop)
+		tk.CampaignKeeper.SetCampaign(ctx, campaign)
+	}
+	setMainnetAccount := func(campaignID uint64, address string, shares types.Shares) {
+		tk.CampaignKeeper.SetMainnetAccount(ctx, types.MainnetAccount{
+			CampaignID: campaignID,
+			Address:    address,
+			Shares:     shares,
+		})
+	}
+
+	setMainnetVestingAccount := func(campaignID uint64, address string, shares types.Shares) {
+		tk.CampaignKeeper.SetMainnetVestingAccount(ctx, types.MainnetVestingAccount{
+			CampaignID:     campaignID,
+			Address:        address,
+			VestingOptions: *types.NewShareDelayedVesting(shares, shares, 1000),
+		})
+	}
+
+	setCampaign(campaignDust, coordID, tc.Shares(t, "1foo"), types.EmptyShares())
+	setMainnetAccount(campaignDust, addr1, tc.Shares(t, "1foo,1bar"))
+	setMainnetAccount(campaignDust, addr2, tc.Shares(t, "1foo"))
+
+	setCampaign(campaignCoordAccount, coordID, types.EmptyShares(), tc.Shares(t, "1foo"))
+	setMainnetAccount(campaignCoordAccount, addr1, tc.Shares(t, "1foo,1bar"))
+	setMainnetAccount(campaignCoordAccount, coordAddr, tc.Shares(t, "1foo"))
+
+	setCampaign(campaignNoDust, coordID, tc.Shares(t, "1bar"), types.EmptyShares())
+	setMainnetAccount(campaignNoDust, addr1, tc.Shares(t, "3foo"))
+	setMainnetAccount(campaignNoDust, coordAddr, tc.Shares(t, "1baz"))
+
+	setCampaign(campaignNoCoordinator, 1000, types.EmptyShares(), types.EmptyShares())
+
+	setCampaign(campaignInvalidShares, coordID, types.EmptyShares(), types.EmptyShares())
+	setMainnetAccount(campaignInvalidShares, addr1, tc.Shares(t, "4foo"))
+
+	setCampaign(campaignInvalidAirdrop, coordID, types.EmptyShares(), tc.Shares(t, "4foo"))
+
+	setCampaign(campaignVesting, coordID, types.EmptyShares(), types.EmptyShares())
+	setMainnetAccount(campaignVesting, addr1, tc.Shares(t, "1foo"))
+	setMainnetVestingAccount(campaignVesting, addr1, tc.Shares(t, "1foo"))
+	setMainnetVestingAccount(campaignVesting, addr2, tc.Shares(t, "1foo,1bar"))
+
+	setCampaign(campaignInvalidVesting, coordID, types.EmptyShares(), types.EmptyShares())
+	setMainnetVestingAccount(campaignInvalidVesting, addr1, tc.Shares(t, "4foo"))
+
+	for _, tt := range []struct {
+		name       string
+		campaignID uint64
+		expected   types.MainnetGenesisAccounts
+		err        error
+	}{
+		{
+			name:       "should assign the rounding dust to the coordinator",
+			campaignID: campaignDust,
+			expected: types.MainnetGenesisAccounts{
+				CampaignID: campaignDust,
+				Accounts: sortedBalances(
+					types.MainnetAccountBalance{CampaignID: campaignDust, Address: addr1, Coins: tc.Coins(t, "3foo,33bar")},
+					types.MainnetAccountBalance{CampaignID: campaignDust, Address: addr2, Coins: tc.Coins(t, "3foo")},
+					types.MainnetAccountBalance{CampaignID: campaignDust, Address: coordAddr, Coins: tc.Coins(t, "1foo")},
+				),
+				GenesisDistribution: tc.Coins(t, "3foo"),
+				DustRecipient:       coordAddr,
+				Dust:                tc.Coins(t, "1foo"),
+			},
+		},
+		{
+			name:       "should add the rounding dust to the mainnet account of the coordinator",
+			campaignID: campaignCoordAccount,
+			expected: types.MainnetGenesisAccounts{
+				CampaignID: campaignCoordAccount,
+				Accounts: sortedBalances(
+					types.MainnetAccountBalance{CampaignID: campaignCoordAccount, Address: addr1, Coins: tc.Coins(t, "3foo,33bar")},
+					types.MainnetAccountBalance{CampaignID: campaignCoordAccount, Address: coordAddr, Coins: tc.Coins(t, "4foo")},
+				),
+				ClaimableAirdrop: tc.Coins(t, "3foo"),
+				DustRecipient:    coordAddr,
+				Dust:             tc.Coins(t, "1foo"),
+			},
+		},
+		{
+			name:       "should omit accounts without balance when there is no rounding dust",
+			campaignID: campaignNoDust,
+			expected: types.MainnetGenesisAccounts{
+				CampaignID: campaignNoDust,
+				Accounts: []types.MainnetAccountBalance{
+					{CampaignID: campaignNoDust, Address: addr1, Coins: tc.Coins(t, "10foo")},
+				},
+				GenesisDistribution: tc.Coins(t, "33bar"),
+				DustRecipient:       coordAddr,
+			},
+		},
+		{
+			name:       "should include the balances of the mainnet vesting accounts",
+			campaignID: campaignVesting,
+			expected: types.MainnetGenesisAccounts{
+				CampaignID: campaignVesting,
+				Accounts: sortedBalances(
+					types.MainnetAccountBalance{CampaignID: campaignVesting, Address: addr1, Coins: tc.Coins(t, "3foo")},
+					types.MainnetAccountBalance{CampaignID: campaignVesting, Address: coordAddr, Coins: tc.Coins(t, "1foo")},
+				),
+				DustRecipient: coordAddr,
+				Dust:          tc.Coins(t, "1foo"),
+				VestingAccounts: sortedBalances(
+					types.MainnetAccountBalance{CampaignID: campaignVesting, Address: addr1, Coins: tc.Coins(t, "3foo")},
+					types.MainnetAccountBalance{CampaignID: campaignVesting, Address: addr2, Coins: tc.Coins(t, "3foo,33bar")},
+				),
+			},
+		},
+		{
+			name:       "should prevent computing accounts for a non-existent campaign",
+			campaignID: 1000,
+			err:        types.ErrCampaignNotFound,
+		},
+		{
+			name:       "should prevent computing accounts for a campaign with a non-existent coordinator",
+			campaignID: campaignNoCoordinator,
+			err:        profiletypes.ErrCoordInvalid,
+		},
+		{
+			name:       "should prevent computing accounts with invalid account shares",
+			campaignID: campaignInvalidShares,
+			err:        types.ErrInvalidShares,
+		},
+		{
+			name:       "should prevent computing accounts with invalid claimable airdrop",
+			campaignID: campaignInvalidAirdrop,
+			err:        types.ErrInvalidShares,
+		},
+		{
+			name:       "should prevent computing accounts with invalid vesting account shares",
+			campaignID: campaignInvalidVesting,
+			err:        types.ErrInvalidShares,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			genesisAccounts, err := tk.CampaignKeeper.GetMainnetGenesisAccounts(ctx, tt.campaignID)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected.CampaignID, genesisAccounts.CampaignID)
+			require.Equal(t, tt.expected.DustRecipient, genesisAccounts.DustRecipient)
+			require.True(t, tt.expected.GenesisDistribution.IsEqual(genesisAccounts.GenesisDistribution))
+			require.True(t, tt.expected.ClaimableAirdrop.IsEqual(genesisAccounts.ClaimableAirdrop))
+			require.True(t, tt.expected.Dust.IsEqual(genesisAccounts.Dust))
+			require.Len(t, genesisAccounts.Accounts, len(tt.expected.Accounts))
+			for i, acc := range tt.expected.Accounts {
+				require.Equal(t, acc.Address, genesisAccounts.Accounts[i].Address)
+				require.True(t, acc.Coins.IsEqual(genesisAccounts.Accounts[i].Coins))
+			}
+			require.Len(t, genesisAccounts.VestingAccounts, len(tt.expected.VestingAccounts))
+			for i, acc := range tt.expected.VestingAccounts {
+				require.Equal(t, acc.Address, genesisAccounts.VestingAccounts[i].Address)
+				require.True(t, acc.Coins.IsEqual(genesisAccounts.VestingAccounts[i].Coins))
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/campaign/keeper"
+	"github.com/tendermint/spn/x/campaign/types"
+)
+
+func createNMainnetVestingAccount(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.MainnetVestingAccount {
+	items := make([]types.MainnetVestingAccount, n)
+	for i := range items {
+		items[i] = sample.MainnetVestingAccount(r, uint64(i%2), sample.Address(r))
+		keeper.SetMainnetVestingAccount(ctx, items[i])
+	}
+	return items
+}
+
+func TestMainnetVestingAccountGet(t *testing.T) {
+	ctx, tk, _ := testkeeper.NewTestSetup(t)
+	items := createNMainnetVestingAccount(tk.CampaignKeeper, ctx, 10)
+	for _, item := range items {
+		rst, found := tk.CampaignKeeper.GetMainnetVestingAccount(ctx,
+			item.CampaignID,
+			item.Address,
+		)
+		require.True(t, found)
+		require.Equal(t, item, rst)
+	}
+}
+
+func TestMainnetVestingAccountRemove(t *testing.T) {
+	ctx, tk, _ := testkeeper.NewTestSetup(t)
+	items := createNMainnetVestingAccount(tk.CampaignKeeper, ctx, 10)
+	for _, item := range items {
+		tk.CampaignKeeper.RemoveMainnetVestingAccount(ctx,
+			item.CampaignID,
+			item.Address,
+		)
+		_, found := tk.CampaignKeeper.GetMainnetVestingAccount(ctx,
+			item.CampaignID,
+			item.Address,
+		)
+		require.False(t, found)
+	}
+}
+
+func TestMainnetVestingAccountGetAll(t *testing.T) {
+	ctx, tk, _ := testkeeper.NewTestSetup(t)
+	items := createNMainnetVestingAccount(tk.CampaignKeeper, ctx, 10)
+	require.ElementsMatch(t, items, tk.CampaignKeeper.GetAllMainnetVestingAccount(ctx))
+}
+
+func TestGetCampaignMainnetVestingAccounts(t *testing.T) {
+	ctx, tk, _ := testkeeper.NewTestSetup(t)
+	items := createNMainnetVestingAccount(tk.CampaignKeeper, ctx, 10)
+
+	var expected []types.MainnetVestingAccount
+	for _, item := range items {
+		if item.CampaignID == 1 {
+			expected = append(expected, item)
+		}
+	}
+	require.ElementsMatch(t, expected, tk.CampaignKeeper.GetCampaignMainnetVestingAccounts(ctx, 1))
+	require.Empty(t, tk.CampaignKeeper.GetCampaignMainnetVestingAccounts(ctx, 2))
+}
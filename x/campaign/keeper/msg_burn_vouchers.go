@@ -42,6 +42,14 @@ func (k msgServer) BurnVouchers(goCtx context.Context, msg *types.MsgBurnVoucher
 		return nil, ignterrors.Criticalf("invalid allocated share amount %s", err.Error())
 	}
 	k.SetCampaign(ctx, campaign)
+	k.AppendShareLedgerEntry(
+		ctx,
+		campaign.CampaignID,
+		types.ShareLedgerEntry_BURN_VOUCHERS,
+		msg.Sender,
+		msg.Sender,
+		shares,
+	)
 
 	err = ctx.EventManager().EmitTypedEvent(&types.EventCampaignSharesUpdated{
 		CampaignID:      campaign.CampaignID,
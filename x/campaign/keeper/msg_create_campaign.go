@@ -52,6 +52,7 @@ func (k msgServer) CreateCampaign(goCtx context.Context, msg *types.MsgCreateCam
 		ctx.BlockTime().Unix(),
 	)
 	campaignID := k.AppendCampaign(ctx, campaign)
+	k.profileKeeper.IncrementCampaignsCreated(ctx, coordID)
 
 	// Initialize the list of campaign chains
 	k.SetCampaignChains(ctx, types.CampaignChains{
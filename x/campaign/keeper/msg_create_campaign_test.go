@@ -131,6 +131,7 @@ func TestMsgCreateCampaign(t *testing.T) {
 			accAddr, err := sdk.AccAddressFromBech32(tc.msg.Coordinator)
 			require.NoError(t, err)
 			preBalance := tk.BankKeeper.SpendableCoins(sdkCtx, accAddr)
+			statsBefore, _ := tk.ProfileKeeper.GetCoordinatorStats(sdkCtx, coordMap[tc.msg.Coordinator])
 
 			got, err := ts.CampaignSrv.CreateCampaign(ctx, &tc.msg)
 			if tc.err != nil {
@@ -157,6 +158,11 @@ func TestMsgCreateCampaign(t *testing.T) {
 			// check fee deduction
 			postBalance := tk.BankKeeper.SpendableCoins(sdkCtx, accAddr)
 			require.True(t, preBalance.Sub(campaignCreationFee...).IsEqual(postBalance))
+
+			// check the campaign is recorded in the coordinator stats
+			stats, found := tk.ProfileKeeper.GetCoordinatorStats(sdkCtx, campaign.CoordinatorID)
+			require.True(t, found)
+			require.EqualValues(t, statsBefore.CampaignsCreated+1, stats.CampaignsCreated)
 		})
 	}
 }
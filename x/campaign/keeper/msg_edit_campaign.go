@@ -2,7 +2,6 @@ package keeper
 
 import (
 	"context"
-	"fmt"
 
 	sdkerrors "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -19,19 +18,17 @@ func (k msgServer) EditCampaign(goCtx context.Context, msg *types.MsgEditCampaig
 		return nil, sdkerrors.Wrapf(types.ErrCampaignNotFound, "%d", msg.CampaignID)
 	}
 
-	// Get the coordinator ID associated to the sender address
-	coordID, err := k.profileKeeper.CoordinatorIDFromAddress(ctx, msg.Coordinator)
+	// Check the sender is allowed to act on behalf of the campaign coordinator
+	err := k.profileKeeper.CheckCoordinatorPermission(
+		ctx,
+		msg.Coordinator,
+		campaign.CoordinatorID,
+		profiletypes.CoordinatorPermission_EDIT_CAMPAIGN,
+	)
 	if err != nil {
 		return nil, err
 	}
 
-	if campaign.CoordinatorID != coordID {
-		return nil, sdkerrors.Wrap(profiletypes.ErrCoordInvalid, fmt.Sprintf(
-			"coordinator of the campaign is %d",
-			campaign.CoordinatorID,
-		))
-	}
-
 	if len(msg.Name) > 0 {
 		campaign.CampaignName = msg.Name
 	}
@@ -2,7 +2,6 @@ package keeper
 
 import (
 	"context"
-	"fmt"
 
 	sdkerrors "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -28,23 +27,24 @@ func (k msgServer) InitializeMainnet(goCtx context.Context, msg *types.MsgInitia
 		return nil, sdkerrors.Wrap(types.ErrInvalidTotalSupply, "total supply is empty")
 	}
 
-	// Get the coordinator ID associated to the sender address
-	coordID, err := k.profileKeeper.CoordinatorIDFromAddress(ctx, msg.Coordinator)
+	// Check the sender is allowed to act on behalf of the campaign coordinator
+	err := k.profileKeeper.CheckCoordinatorPermission(
+		ctx,
+		msg.Coordinator,
+		campaign.CoordinatorID,
+		profiletypes.CoordinatorPermission_EDIT_CAMPAIGN,
+	)
 	if err != nil {
 		return nil, err
 	}
 
-	if campaign.CoordinatorID != coordID {
-		return nil, sdkerrors.Wrap(profiletypes.ErrCoordInvalid, fmt.Sprintf(
-			"coordinator of the campaign is %d",
-			campaign.CoordinatorID,
-		))
-	}
+	// a campaign is counted once in the coordinator stats, even if its mainnet is reset and initialized again
+	firstInitialization := !k.HasArchivedMainnet(ctx, campaign.CampaignID)
 
 	// Create the mainnet chain for launch
 	mainnetID, err := k.launchKeeper.CreateNewChain(
 		ctx,
-		coordID,
+		campaign.CoordinatorID,
 		msg.MainnetChainID,
 		msg.SourceURL,
 		msg.SourceHash,
@@ -64,6 +64,9 @@ func (k msgServer) InitializeMainnet(goCtx context.Context, msg *types.MsgInitia
 	campaign.MainnetID = mainnetID
 	campaign.MainnetInitialized = true
 	k.SetCampaign(ctx, campaign)
+	if firstInitialization {
+		k.profileKeeper.IncrementMainnetsInitialized(ctx, campaign.CoordinatorID)
+	}
 
 	err = ctx.EventManager().EmitTypedEvent(&types.EventCampaignMainnetInitialized{
 		CampaignID:         campaign.CampaignID,
@@ -130,6 +130,9 @@ func TestMsgInitializeMainnet(t *testing.T) {
 		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
+			campaignBefore, _ := tk.CampaignKeeper.GetCampaign(sdkCtx, tc.msg.CampaignID)
+			statsBefore, _ := tk.ProfileKeeper.GetCoordinatorStats(sdkCtx, campaignBefore.CoordinatorID)
+
 			res, err := ts.CampaignSrv.InitializeMainnet(ctx, &tc.msg)
 			if tc.err != nil {
 				require.ErrorIs(t, err, tc.err)
@@ -152,6 +155,52 @@ func TestMsgInitializeMainnet(t *testing.T) {
 			campaignChains, found := tk.CampaignKeeper.GetCampaignChains(sdkCtx, tc.msg.CampaignID)
 			require.True(t, found)
 			require.Contains(t, campaignChains.Chains, campaign.MainnetID)
+
+			// Mainnet is recorded in the coordinator stats
+			stats, found := tk.ProfileKeeper.GetCoordinatorStats(sdkCtx, campaign.CoordinatorID)
+			require.True(t, found)
+			require.EqualValues(t, statsBefore.MainnetsInitialized+1, stats.MainnetsInitialized)
+			require.EqualValues(t, statsBefore.ChainsCreated, stats.ChainsCreated)
 		})
 	}
 }
+
+func TestMsgInitializeMainnetAfterReset(t *testing.T) {
+	var (
+		coordAddr      = sample.Address(r)
+		sdkCtx, tk, ts = testkeeper.NewTestSetup(t)
+		ctx            = sdk.WrapSDKContext(sdkCtx)
+	)
+
+	res, err := ts.ProfileSrv.CreateCoordinator(ctx, &profiletypes.MsgCreateCoordinator{
+		Address:     coordAddr,
+		Description: sample.CoordinatorDescription(r),
+	})
+	require.NoError(t, err)
+	campaign := sample.Campaign(r, 0)
+	campaign.CoordinatorID = res.CoordinatorID
+	tk.CampaignKeeper.SetCampaign(sdkCtx, campaign)
+
+	initializeMainnet := func() {
+		_, err := ts.CampaignSrv.InitializeMainnet(ctx, types.NewMsgInitializeMainnet(
+			coordAddr,
+			campaign.CampaignID,
+			sample.String(r, 20),
+			sample.String(r, 30),
+			sample.GenesisChainID(r),
+		))
+		require.NoError(t, err)
+	}
+
+	t.Run("should not count a mainnet initialized again after a reset in the coordinator stats", func(t *testing.T) {
+		initializeMainnet()
+		_, err := ts.CampaignSrv.ResetMainnet(ctx, types.NewMsgResetMainnet(coordAddr, campaign.CampaignID))
+		require.NoError(t, err)
+		require.True(t, tk.CampaignKeeper.HasArchivedMainnet(sdkCtx, campaign.CampaignID))
+		initializeMainnet()
+
+		stats, found := tk.ProfileKeeper.GetCoordinatorStats(sdkCtx, campaign.CoordinatorID)
+		require.True(t, found)
+		require.EqualValues(t, 1, stats.MainnetsInitialized)
+	})
+}
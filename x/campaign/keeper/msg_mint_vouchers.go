@@ -2,7 +2,6 @@ package keeper
 
 import (
 	"context"
-	"fmt"
 
 	sdkerrors "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -20,19 +19,17 @@ func (k msgServer) MintVouchers(goCtx context.Context, msg *types.MsgMintVoucher
 		return nil, sdkerrors.Wrapf(types.ErrCampaignNotFound, "%d", msg.CampaignID)
 	}
 
-	// Get the coordinator ID associated to the sender address
-	coordID, err := k.profileKeeper.CoordinatorIDFromAddress(ctx, msg.Coordinator)
+	// Check the sender is allowed to act on behalf of the campaign coordinator
+	err := k.profileKeeper.CheckCoordinatorPermission(
+		ctx,
+		msg.Coordinator,
+		campaign.CoordinatorID,
+		profiletypes.CoordinatorPermission_MINT_VOUCHERS,
+	)
 	if err != nil {
 		return nil, err
 	}
 
-	if campaign.CoordinatorID != coordID {
-		return nil, sdkerrors.Wrap(profiletypes.ErrCoordInvalid, fmt.Sprintf(
-			"coordinator of the campaign is %d",
-			campaign.CoordinatorID,
-		))
-	}
-
 	// Increase the campaign shares
 	campaign.AllocatedShares = types.IncreaseShares(campaign.AllocatedShares, msg.Shares)
 	reached, err := types.IsTotalSharesReached(campaign.AllocatedShares, k.GetTotalShares(ctx))
@@ -52,7 +49,12 @@ func (k msgServer) MintVouchers(goCtx context.Context, msg *types.MsgMintVoucher
 		return nil, sdkerrors.Wrap(types.ErrVouchersMinting, err.Error())
 	}
 
-	receiver, err := sdk.AccAddressFromBech32(msg.Coordinator)
+	// vouchers minted by an operator are sent to the coordinator account
+	coord, found := k.profileKeeper.GetCoordinator(ctx, campaign.CoordinatorID)
+	if !found {
+		return nil, ignterrors.Criticalf("campaign coordinator %d not found", campaign.CoordinatorID)
+	}
+	receiver, err := sdk.AccAddressFromBech32(coord.Address)
 	if err != nil {
 		return nil, ignterrors.Criticalf("can't parse coordinator address %s", err.Error())
 	}
@@ -62,6 +64,14 @@ func (k msgServer) MintVouchers(goCtx context.Context, msg *types.MsgMintVoucher
 	}
 
 	k.SetCampaign(ctx, campaign)
+	k.AppendShareLedgerEntry(
+		ctx,
+		campaign.CampaignID,
+		types.ShareLedgerEntry_MINT_VOUCHERS,
+		msg.Coordinator,
+		coord.Address,
+		msg.Shares,
+	)
 
 	err = ctx.EventManager().EmitTypedEvent(
 		&types.EventCampaignSharesUpdated{
@@ -21,6 +21,7 @@ func TestMsgMintVouchers(t *testing.T) {
 
 		coord           = sample.Address(r)
 		coordNoCampaign = sample.Address(r)
+		operator        = sample.Address(r)
 
 		shares, _    = types.NewShares("1000foo,500bar,300foobar")
 		sharesTooBig = types.NewSharesFromCoins(sdk.NewCoins(
@@ -41,6 +42,13 @@ func TestMsgMintVouchers(t *testing.T) {
 	})
 	require.NoError(t, err)
 
+	_, err = ts.ProfileSrv.AddCoordinatorOperator(ctx, profiletypes.NewMsgAddCoordinatorOperator(
+		coord,
+		operator,
+		[]profiletypes.CoordinatorPermission{profiletypes.CoordinatorPermission_MINT_VOUCHERS},
+	))
+	require.NoError(t, err)
+
 	// Set campaign
 	campaign := sample.Campaign(r, 0)
 	campaign.CoordinatorID = coordID
@@ -75,6 +83,14 @@ func TestMsgMintVouchers(t *testing.T) {
 				Shares:      sample.Shares(r),
 			},
 		},
+		{
+			name: "mint vouchers to the coordinator from an operator",
+			msg: types.MsgMintVouchers{
+				Coordinator: operator,
+				CampaignID:  0,
+				Shares:      shares,
+			},
+		},
 		{
 			name: "should not mint more than total shares",
 			msg: types.MsgMintVouchers{
@@ -116,7 +132,8 @@ func TestMsgMintVouchers(t *testing.T) {
 			var previousCampaign types.Campaign
 			var previousBalance sdk.Coins
 
-			coordAddr, err := sdk.AccAddressFromBech32(tc.msg.Coordinator)
+			// vouchers are always minted to the coordinator account
+			coordAddr, err := sdk.AccAddressFromBech32(coord)
 			require.NoError(t, err)
 
 			// Get values before message execution
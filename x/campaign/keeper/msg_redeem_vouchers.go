@@ -62,6 +62,14 @@ func (k msgServer) RedeemVouchers(goCtx context.Context, msg *types.MsgRedeemVou
 	// Increase the account shares
 	account.Shares = types.IncreaseShares(account.Shares, shares)
 	k.SetMainnetAccount(ctx, account)
+	k.AppendShareLedgerEntry(
+		ctx,
+		campaign.CampaignID,
+		types.ShareLedgerEntry_REDEEM_VOUCHERS,
+		msg.Sender,
+		msg.Account,
+		shares,
+	)
 
 	if !found {
 		err = ctx.EventManager().EmitTypedEvent(&types.EventMainnetAccountCreated{
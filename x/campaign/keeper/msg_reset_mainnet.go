@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ignterrors "github.com/ignite/modules/errors"
+
+	"github.com/tendermint/spn/x/campaign/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+)
+
+func (k msgServer) ResetMainnet(goCtx context.Context, msg *types.MsgResetMainnet) (*types.MsgResetMainnetResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	campaign, found := k.GetCampaign(ctx, msg.CampaignID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrCampaignNotFound, "%d", msg.CampaignID)
+	}
+
+	if !campaign.MainnetInitialized {
+		return nil, sdkerrors.Wrapf(types.ErrMainnetNotInitialized, "%d", msg.CampaignID)
+	}
+
+	// Check the sender is allowed to act on behalf of the campaign coordinator
+	err := k.profileKeeper.CheckCoordinatorPermission(
+		ctx,
+		msg.Coordinator,
+		campaign.CoordinatorID,
+		profiletypes.CoordinatorPermission_EDIT_CAMPAIGN,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// the mainnet can be reset if its launch has not been triggered or has been reverted
+	isLaunchTriggered, err := k.IsCampaignMainnetLaunchTriggered(ctx, campaign.CampaignID)
+	if err != nil {
+		return nil, ignterrors.Critical(err.Error())
+	}
+	if isLaunchTriggered {
+		return nil, sdkerrors.Wrap(types.ErrMainnetLaunchTriggered, "mainnet launch has been triggered")
+	}
+
+	// Archive the mainnet chain, it remains associated to the campaign
+	archivedMainnetID := campaign.MainnetID
+	if err := k.launchKeeper.ArchiveChain(ctx, archivedMainnetID); err != nil {
+		return nil, ignterrors.Criticalf("cannot archive the mainnet %d: %s", archivedMainnetID, err.Error())
+	}
+
+	// The archived mainnet will not be monitored, its reward pool is refunded to the contributors
+	if err := k.rewardKeeper.RefundRewardPool(ctx, archivedMainnetID); err != nil {
+		return nil, ignterrors.Criticalf("cannot refund the reward pool of the mainnet %d: %s", archivedMainnetID, err.Error())
+	}
+
+	// Reset the mainnet to allow the total supply to be updated and a new mainnet to be initialized
+	campaign.MainnetID = 0
+	campaign.MainnetInitialized = false
+	k.SetCampaign(ctx, campaign)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventCampaignMainnetReset{
+		CampaignID:         campaign.CampaignID,
+		CoordinatorAddress: msg.Coordinator,
+		ArchivedMainnetID:  archivedMainnetID,
+	})
+
+	return &types.MsgResetMainnetResponse{}, err
+}
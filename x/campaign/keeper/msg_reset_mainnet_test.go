@@ -0,0 +1,169 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/campaign/types"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+	rewardtypes "github.com/tendermint/spn/x/reward/types"
+)
+
+func TestMsgResetMainnet(t *testing.T) {
+	var (
+		campaignID                uint64 = 0
+		campaignNoMainnetID       uint64 = 1
+		campaignLaunchTriggeredID uint64 = 2
+		coordAddr                        = sample.Address(r)
+		coordAddrNoCampaign              = sample.Address(r)
+
+		sdkCtx, tk, ts = testkeeper.NewTestSetup(t)
+		ctx            = sdk.WrapSDKContext(sdkCtx)
+	)
+
+	// Create coordinators
+	res, err := ts.ProfileSrv.CreateCoordinator(ctx, &profiletypes.MsgCreateCoordinator{
+		Address:     coordAddr,
+		Description: sample.CoordinatorDescription(r),
+	})
+	require.NoError(t, err)
+	coordID := res.CoordinatorID
+	_, err = ts.ProfileSrv.CreateCoordinator(ctx, &profiletypes.MsgCreateCoordinator{
+		Address:     coordAddrNoCampaign,
+		Description: sample.CoordinatorDescription(r),
+	})
+	require.NoError(t, err)
+
+	// Set the campaigns and initialize their mainnet
+	initializeMainnet := func(campaignID uint64) uint64 {
+		res, err := ts.CampaignSrv.InitializeMainnet(ctx, types.NewMsgInitializeMainnet(
+			coordAddr,
+			campaignID,
+			sample.String(r, 20),
+			sample.String(r, 30),
+			sample.GenesisChainID(r),
+		))
+		require.NoError(t, err)
+		return res.MainnetID
+	}
+	for _, id := range []uint64{campaignID, campaignNoMainnetID, campaignLaunchTriggeredID} {
+		campaign := sample.Campaign(r, id)
+		campaign.CoordinatorID = coordID
+		tk.CampaignKeeper.SetCampaign(sdkCtx, campaign)
+	}
+	mainnetID := initializeMainnet(campaignID)
+
+	// the reward pool of the mainnet is refunded when the mainnet is archived
+	rewardProvider := sample.Address(r)
+	rewardCoins := sample.Coins(r)
+	tk.RewardKeeper.SetRewardPool(sdkCtx, rewardtypes.RewardPool{
+		LaunchID:         mainnetID,
+		Provider:         rewardProvider,
+		InitialCoins:     rewardCoins,
+		RemainingCoins:   rewardCoins,
+		LastRewardHeight: 100,
+		Contributions: []rewardtypes.RewardContribution{
+			{Contributor: rewardProvider, Coins: rewardCoins},
+		},
+	})
+	require.NoError(t, tk.BankKeeper.MintCoins(sdkCtx, rewardtypes.ModuleName, rewardCoins))
+	launchedMainnetID := initializeMainnet(campaignLaunchTriggeredID)
+	launchedMainnet, found := tk.LaunchKeeper.GetChain(sdkCtx, launchedMainnetID)
+	require.True(t, found)
+	launchedMainnet.LaunchTriggered = true
+	tk.LaunchKeeper.SetChain(sdkCtx, launchedMainnet)
+
+	for _, tc := range []struct {
+		name string
+		msg  *types.MsgResetMainnet
+		err  error
+	}{
+		{
+			name: "should prevent resetting the mainnet of a non existing campaign",
+			msg:  types.NewMsgResetMainnet(coordAddr, 1000),
+			err:  types.ErrCampaignNotFound,
+		},
+		{
+			name: "should prevent resetting a mainnet not initialized",
+			msg:  types.NewMsgResetMainnet(coordAddr, campaignNoMainnetID),
+			err:  types.ErrMainnetNotInitialized,
+		},
+		{
+			name: "should prevent resetting the mainnet from a non existing coordinator",
+			msg:  types.NewMsgResetMainnet(sample.Address(r), campaignID),
+			err:  profiletypes.ErrCoordAddressNotFound,
+		},
+		{
+			name: "should prevent resetting the mainnet from another coordinator",
+			msg:  types.NewMsgResetMainnet(coordAddrNoCampaign, campaignID),
+			err:  profiletypes.ErrCoordInvalid,
+		},
+		{
+			name: "should prevent resetting a mainnet with launch triggered",
+			msg:  types.NewMsgResetMainnet(coordAddr, campaignLaunchTriggeredID),
+			err:  types.ErrMainnetLaunchTriggered,
+		},
+		{
+			name: "should reset the mainnet",
+			msg:  types.NewMsgResetMainnet(coordAddr, campaignID),
+		},
+		{
+			name: "should prevent resetting the mainnet twice",
+			msg:  types.NewMsgResetMainnet(coordAddr, campaignID),
+			err:  types.ErrMainnetNotInitialized,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ts.CampaignSrv.ResetMainnet(ctx, tc.msg)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+
+			campaign, found := tk.CampaignKeeper.GetCampaign(sdkCtx, tc.msg.CampaignID)
+			require.True(t, found)
+			require.False(t, campaign.MainnetInitialized)
+			require.EqualValues(t, 0, campaign.MainnetID)
+
+			chain, found := tk.LaunchKeeper.GetChain(sdkCtx, mainnetID)
+			require.True(t, found)
+			require.True(t, chain.Archived)
+
+			rewardPool, found := tk.RewardKeeper.GetRewardPool(sdkCtx, mainnetID)
+			require.True(t, found)
+			require.True(t, rewardPool.Closed)
+			balance := tk.BankKeeper.GetAllBalances(sdkCtx, sdk.MustAccAddressFromBech32(rewardProvider))
+			require.True(t, balance.IsEqual(rewardCoins), balance.String())
+		})
+	}
+
+	t.Run("should allow updating the total supply and initializing a new mainnet after reset", func(t *testing.T) {
+		_, err := ts.CampaignSrv.UpdateTotalSupply(ctx, types.NewMsgUpdateTotalSupply(
+			coordAddr,
+			campaignID,
+			sample.TotalSupply(r),
+		))
+		require.NoError(t, err)
+
+		newMainnetID := initializeMainnet(campaignID)
+		require.NotEqual(t, mainnetID, newMainnetID)
+		campaign, found := tk.CampaignKeeper.GetCampaign(sdkCtx, campaignID)
+		require.True(t, found)
+		require.True(t, campaign.MainnetInitialized)
+		require.Equal(t, newMainnetID, campaign.MainnetID)
+
+		// the archived mainnet can no longer be launched
+		_, err = ts.LaunchSrv.TriggerLaunch(ctx, launchtypes.NewMsgTriggerLaunch(
+			coordAddr,
+			mainnetID,
+			sdkCtx.BlockTime().Add(launchtypes.DefaultMinLaunchTime),
+		))
+		require.ErrorIs(t, err, launchtypes.ErrChainArchived)
+	})
+}
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ignterrors "github.com/ignite/modules/errors"
+
+	"github.com/tendermint/spn/x/campaign/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+)
+
+func (k msgServer) SetCampaignAirdrop(goCtx context.Context, msg *types.MsgSetCampaignAirdrop) (*types.MsgSetCampaignAirdropResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	campaign, found := k.GetCampaign(ctx, msg.CampaignID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrCampaignNotFound, "%d", msg.CampaignID)
+	}
+
+	// Check the sender is allowed to act on behalf of the campaign coordinator
+	err := k.profileKeeper.CheckCoordinatorPermission(
+		ctx,
+		msg.Coordinator,
+		campaign.CoordinatorID,
+		profiletypes.CoordinatorPermission_EDIT_CAMPAIGN,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// verify mainnet launch is not triggered
+	mainnetLaunched, err := k.IsCampaignMainnetLaunchTriggered(ctx, campaign.CampaignID)
+	if err != nil {
+		return nil, ignterrors.Critical(err.Error())
+	}
+	if mainnetLaunched {
+		return nil, sdkerrors.Wrapf(types.ErrMainnetLaunchTriggered,
+			"mainnet %d launch is already triggered",
+			campaign.MainnetID,
+		)
+	}
+
+	// the airdrop is distributed from the total supply of the mainnet
+	if !campaign.TotalSupply.AmountOf(msg.Denom).IsPositive() {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAirdrop,
+			"denom %s is not in the total supply of the campaign",
+			msg.Denom,
+		)
+	}
+
+	k.Keeper.SetCampaignAirdrop(ctx, msg.CampaignAirdrop())
+
+	return &types.MsgSetCampaignAirdropResponse{}, ctx.EventManager().EmitTypedEvent(
+		&types.EventCampaignAirdropSet{
+			CampaignID:         msg.CampaignID,
+			CoordinatorAddress: msg.Coordinator,
+			Denom:              msg.Denom,
+		})
+}
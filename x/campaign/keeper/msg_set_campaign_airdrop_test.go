@@ -0,0 +1,114 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	tc "github.com/tendermint/spn/testutil/constructor"
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/campaign/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+)
+
+func Test_msgServer_SetCampaignAirdrop(t *testing.T) {
+	var (
+		coordAddr           = sample.Address(r)
+		coordAddrNoCampaign = sample.Address(r)
+		sdkCtx, tk, ts      = testkeeper.NewTestSetup(t)
+		ctx                 = sdk.WrapSDKContext(sdkCtx)
+
+		campaignID         = uint64(0)
+		campaignIDLaunched = uint64(1)
+	)
+
+	// create the coordinators
+	res, err := ts.ProfileSrv.CreateCoordinator(ctx, &profiletypes.MsgCreateCoordinator{
+		Address:     coordAddr,
+		Description: sample.CoordinatorDescription(r),
+	})
+	require.NoError(t, err)
+	coordID := res.CoordinatorID
+	_, err = ts.ProfileSrv.CreateCoordinator(ctx, &profiletypes.MsgCreateCoordinator{
+		Address:     coordAddrNoCampaign,
+		Description: sample.CoordinatorDescription(r),
+	})
+	require.NoError(t, err)
+
+	// create the campaigns, the mainnet of the second campaign is launched
+	for _, id := range []uint64{campaignID, campaignIDLaunched} {
+		campaign := sample.Campaign(r, id)
+		campaign.CoordinatorID = coordID
+		campaign.TotalSupply = tc.Coins(t, "1000foo,1000bar")
+		if id == campaignIDLaunched {
+			mainnet := sample.Chain(r, 0, coordID)
+			mainnet.IsMainnet = true
+			mainnet.CampaignID = id
+			mainnet.LaunchTriggered = true
+			tk.LaunchKeeper.SetChain(sdkCtx, mainnet)
+			campaign.MainnetInitialized = true
+			campaign.MainnetID = mainnet.LaunchID
+		}
+		tk.CampaignKeeper.SetCampaign(sdkCtx, campaign)
+	}
+
+	newMsg := func(coordinator string, campaignID uint64, denom string) *types.MsgSetCampaignAirdrop {
+		airdrop := sample.CampaignAirdrop(r, campaignID)
+		return types.NewMsgSetCampaignAirdrop(coordinator, campaignID, denom, airdrop.Missions, airdrop.ClaimRecords)
+	}
+
+	for _, tt := range []struct {
+		name string
+		msg  *types.MsgSetCampaignAirdrop
+		err  error
+	}{
+		{
+			name: "should set the airdrop of the campaign",
+			msg:  newMsg(coordAddr, campaignID, "foo"),
+		},
+		{
+			name: "should replace the airdrop of the campaign",
+			msg:  newMsg(coordAddr, campaignID, "bar"),
+		},
+		{
+			name: "should fail if campaign doesn't exist",
+			msg:  newMsg(coordAddr, 1000, "foo"),
+			err:  types.ErrCampaignNotFound,
+		},
+		{
+			name: "should fail if the coordinator doesn't exist",
+			msg:  newMsg(sample.Address(r), campaignID, "foo"),
+			err:  profiletypes.ErrCoordAddressNotFound,
+		},
+		{
+			name: "should fail if the signer is not the coordinator of the campaign",
+			msg:  newMsg(coordAddrNoCampaign, campaignID, "foo"),
+			err:  profiletypes.ErrCoordInvalid,
+		},
+		{
+			name: "should fail if mainnet launch is triggered",
+			msg:  newMsg(coordAddr, campaignIDLaunched, "foo"),
+			err:  types.ErrMainnetLaunchTriggered,
+		},
+		{
+			name: "should fail if the denom is not in the total supply",
+			msg:  newMsg(coordAddr, campaignID, "baz"),
+			err:  types.ErrInvalidAirdrop,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ts.CampaignSrv.SetCampaignAirdrop(ctx, tt.msg)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+
+			airdrop, found := tk.CampaignKeeper.GetCampaignAirdrop(sdkCtx, tt.msg.CampaignID)
+			require.True(t, found)
+			require.Equal(t, tt.msg.CampaignAirdrop(), airdrop)
+		})
+	}
+}
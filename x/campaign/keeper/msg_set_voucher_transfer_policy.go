@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"context"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tendermint/spn/x/campaign/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+)
+
+func (k msgServer) SetVoucherTransferPolicy(
+	goCtx context.Context,
+	msg *types.MsgSetVoucherTransferPolicy,
+) (*types.MsgSetVoucherTransferPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	campaign, found := k.GetCampaign(ctx, msg.CampaignID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrCampaignNotFound, "%d", msg.CampaignID)
+	}
+
+	// Check the sender is allowed to act on behalf of the campaign coordinator
+	err := k.profileKeeper.CheckCoordinatorPermission(
+		ctx,
+		msg.Coordinator,
+		campaign.CoordinatorID,
+		profiletypes.CoordinatorPermission_EDIT_CAMPAIGN,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	k.Keeper.SetVoucherTransferPolicy(ctx, msg.VoucherTransferPolicy())
+
+	return &types.MsgSetVoucherTransferPolicyResponse{}, ctx.EventManager().EmitTypedEvent(
+		&types.EventVoucherTransferPolicySet{
+			CampaignID:         msg.CampaignID,
+			CoordinatorAddress: msg.Coordinator,
+			Mode:               msg.Mode,
+		})
+}
@@ -0,0 +1,109 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/campaign/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+)
+
+func Test_msgServer_SetVoucherTransferPolicy(t *testing.T) {
+	var (
+		coordAddr           = sample.Address(r)
+		coordAddrNoCampaign = sample.Address(r)
+		sdkCtx, tk, ts      = testkeeper.NewTestSetup(t)
+		ctx                 = sdk.WrapSDKContext(sdkCtx)
+
+		campaignID = uint64(0)
+	)
+
+	// create the coordinators
+	res, err := ts.ProfileSrv.CreateCoordinator(ctx, &profiletypes.MsgCreateCoordinator{
+		Address:     coordAddr,
+		Description: sample.CoordinatorDescription(r),
+	})
+	require.NoError(t, err)
+	coordID := res.CoordinatorID
+	_, err = ts.ProfileSrv.CreateCoordinator(ctx, &profiletypes.MsgCreateCoordinator{
+		Address:     coordAddrNoCampaign,
+		Description: sample.CoordinatorDescription(r),
+	})
+	require.NoError(t, err)
+
+	campaign := sample.Campaign(r, campaignID)
+	campaign.CoordinatorID = coordID
+	tk.CampaignKeeper.SetCampaign(sdkCtx, campaign)
+
+	for _, tt := range []struct {
+		name string
+		msg  *types.MsgSetVoucherTransferPolicy
+		err  error
+	}{
+		{
+			name: "should set the voucher transfer policy of the campaign",
+			msg: types.NewMsgSetVoucherTransferPolicy(
+				coordAddr,
+				campaignID,
+				types.VoucherTransferPolicy_ALLOWLIST,
+				[]string{sample.Address(r)},
+			),
+		},
+		{
+			name: "should replace the voucher transfer policy of the campaign",
+			msg: types.NewMsgSetVoucherTransferPolicy(
+				coordAddr,
+				campaignID,
+				types.VoucherTransferPolicy_NO_IBC,
+				nil,
+			),
+		},
+		{
+			name: "should fail if campaign doesn't exist",
+			msg: types.NewMsgSetVoucherTransferPolicy(
+				coordAddr,
+				1000,
+				types.VoucherTransferPolicy_NO_IBC,
+				nil,
+			),
+			err: types.ErrCampaignNotFound,
+		},
+		{
+			name: "should fail if the coordinator doesn't exist",
+			msg: types.NewMsgSetVoucherTransferPolicy(
+				sample.Address(r),
+				campaignID,
+				types.VoucherTransferPolicy_NO_IBC,
+				nil,
+			),
+			err: profiletypes.ErrCoordAddressNotFound,
+		},
+		{
+			name: "should fail if the signer is not the coordinator of the campaign",
+			msg: types.NewMsgSetVoucherTransferPolicy(
+				coordAddrNoCampaign,
+				campaignID,
+				types.VoucherTransferPolicy_NO_IBC,
+				nil,
+			),
+			err: profiletypes.ErrCoordInvalid,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ts.CampaignSrv.SetVoucherTransferPolicy(ctx, tt.msg)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+
+			policy, found := tk.CampaignKeeper.GetVoucherTransferPolicy(sdkCtx, tt.msg.CampaignID)
+			require.True(t, found)
+			require.Equal(t, tt.msg.VoucherTransferPolicy(), policy)
+		})
+	}
+}
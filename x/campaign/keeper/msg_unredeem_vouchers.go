@@ -79,5 +79,14 @@ func (k msgServer) UnredeemVouchers(goCtx context.Context, msg *types.MsgUnredee
 		return nil, ignterrors.Criticalf("can't send minted coins %s", err.Error())
 	}
 
+	k.AppendShareLedgerEntry(
+		ctx,
+		campaign.CampaignID,
+		types.ShareLedgerEntry_UNREDEEM_VOUCHERS,
+		msg.Sender,
+		msg.Sender,
+		msg.Shares,
+	)
+
 	return &types.MsgUnredeemVouchersResponse{}, nil
 }
@@ -0,0 +1,84 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/campaign/types"
+)
+
+func TestMsgUpdateParams(t *testing.T) {
+	var (
+		sdkCtx, tk, ts = testkeeper.NewTestSetup(t)
+		ctx            = sdk.WrapSDKContext(sdkCtx)
+		authority      = tk.CampaignKeeper.GetAuthority()
+	)
+
+	t.Run("should prevent updating params from an account other than authority", func(t *testing.T) {
+		_, err := ts.CampaignSrv.UpdateParams(ctx, &types.MsgUpdateParams{
+			Authority: sample.Address(r),
+			Params:    sample.CampaignParams(r),
+		})
+		require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+	})
+
+	for _, tt := range []struct {
+		name   string
+		update func(*types.Params)
+	}{
+		{
+			name: "should prevent updating params with a minimum total supply greater than the maximum",
+			update: func(params *types.Params) {
+				params.TotalSupplyRange = types.NewTotalSupplyRange(sdkmath.NewInt(1000), sdkmath.NewInt(10))
+			},
+		},
+		{
+			name: "should prevent updating params with a minimum total supply lower than one",
+			update: func(params *types.Params) {
+				params.TotalSupplyRange = types.NewTotalSupplyRange(sdkmath.ZeroInt(), sdkmath.NewInt(10))
+			},
+		},
+		{
+			name: "should prevent updating params with an invalid campaign creation fee",
+			update: func(params *types.Params) {
+				params.CampaignCreationFee = sdk.Coins{sdk.Coin{Denom: "foo", Amount: sdkmath.NewInt(-1)}}
+			},
+		},
+		{
+			name: "should prevent updating params with the same staking and voting mission IDs",
+			update: func(params *types.Params) {
+				params.VotingMissionID = params.StakingMissionID
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			current := tk.CampaignKeeper.GetParams(sdkCtx)
+			invalidParams := sample.CampaignParams(r)
+			tt.update(&invalidParams)
+
+			_, err := ts.CampaignSrv.UpdateParams(ctx, &types.MsgUpdateParams{
+				Authority: authority,
+				Params:    invalidParams,
+			})
+			require.ErrorIs(t, err, sdkerrortypes.ErrInvalidRequest)
+			require.EqualValues(t, current, tk.CampaignKeeper.GetParams(sdkCtx))
+		})
+	}
+
+	t.Run("should allow updating params from authority", func(t *testing.T) {
+		params := sample.CampaignParams(r)
+		_, err := ts.CampaignSrv.UpdateParams(ctx, &types.MsgUpdateParams{
+			Authority: authority,
+			Params:    params,
+		})
+		require.NoError(t, err)
+		require.EqualValues(t, params, tk.CampaignKeeper.GetParams(sdkCtx))
+	})
+}
@@ -20,19 +20,17 @@ func (k msgServer) UpdateSpecialAllocations(goCtx context.Context, msg *types.Ms
 		return nil, sdkerrors.Wrapf(types.ErrCampaignNotFound, "%d", msg.CampaignID)
 	}
 
-	// get the coordinator ID associated to the sender address
-	coordID, err := k.profileKeeper.CoordinatorIDFromAddress(ctx, msg.Coordinator)
+	// Check the sender is allowed to act on behalf of the campaign coordinator
+	err := k.profileKeeper.CheckCoordinatorPermission(
+		ctx,
+		msg.Coordinator,
+		campaign.CoordinatorID,
+		profiletypes.CoordinatorPermission_EDIT_CAMPAIGN,
+	)
 	if err != nil {
 		return nil, err
 	}
 
-	if campaign.CoordinatorID != coordID {
-		return nil, sdkerrors.Wrap(profiletypes.ErrCoordInvalid, fmt.Sprintf(
-			"coordinator of the campaign is %d",
-			campaign.CoordinatorID,
-		))
-	}
-
 	// verify mainnet launch is not triggered
 	mainnetLaunched, err := k.IsCampaignMainnetLaunchTriggered(ctx, campaign.CampaignID)
 	if err != nil {
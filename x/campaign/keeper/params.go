@@ -28,3 +28,20 @@ func (k Keeper) CampaignCreationFee(ctx sdk.Context) (campaignCreationFee sdk.Co
 	k.paramSpace.Get(ctx, types.KeyCampaignCreationFee, &campaignCreationFee)
 	return
 }
+
+// StakingMissionID returns the ID of the claim mission completed by delegating
+// The default value is returned if the param is not set since the genesis delegations are performed before
+// the initialization of the module genesis
+func (k Keeper) StakingMissionID(ctx sdk.Context) (stakingMissionID uint64) {
+	stakingMissionID = types.DefaultStakingMissionID
+	k.paramSpace.GetIfExists(ctx, types.KeyStakingMissionID, &stakingMissionID)
+	return
+}
+
+// VotingMissionID returns the ID of the claim mission completed by voting on a proposal
+// The default value is returned if the param is not set
+func (k Keeper) VotingMissionID(ctx sdk.Context) (votingMissionID uint64) {
+	votingMissionID = types.DefaultVotingMissionID
+	k.paramSpace.GetIfExists(ctx, types.KeyVotingMissionID, &votingMissionID)
+	return
+}
@@ -19,4 +19,6 @@ func Test_GetParams(t *testing.T) {
 	require.EqualValues(t, params.TotalSupplyRange.MinTotalSupply, tk.CampaignKeeper.TotalSupplyRange(ctx).MinTotalSupply)
 	require.EqualValues(t, params.TotalSupplyRange.MaxTotalSupply, tk.CampaignKeeper.TotalSupplyRange(ctx).MaxTotalSupply)
 	require.EqualValues(t, params.CampaignCreationFee, tk.CampaignKeeper.CampaignCreationFee(ctx))
+	require.EqualValues(t, params.StakingMissionID, tk.CampaignKeeper.StakingMissionID(ctx))
+	require.EqualValues(t, params.VotingMissionID, tk.CampaignKeeper.VotingMissionID(ctx))
 }
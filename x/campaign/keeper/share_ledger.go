@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tendermint/spn/x/campaign/types"
+)
+
+// GetShareLedgerCounter returns the counter of the share ledger entries of a campaign
+func (k Keeper) GetShareLedgerCounter(ctx sdk.Context, campaignID uint64) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ShareLedgerCounterKeyPrefix))
+	bz := store.Get(types.ShareLedgerCounterKey(campaignID))
+
+	// Counter doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+
+	// Parse bytes
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetShareLedgerCounter sets the counter of the share ledger entries of a campaign
+func (k Keeper) SetShareLedgerCounter(ctx sdk.Context, campaignID, counter uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ShareLedgerCounterKeyPrefix))
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, counter)
+	store.Set(types.ShareLedgerCounterKey(campaignID), bz)
+}
+
+// SetShareLedgerEntry set a specific shareLedgerEntry in the store from its index
+func (k Keeper) SetShareLedgerEntry(ctx sdk.Context, entry types.ShareLedgerEntry) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ShareLedgerKeyPrefix))
+	b := k.cdc.MustMarshal(&entry)
+	store.Set(types.ShareLedgerKeyPath(
+		entry.CampaignID,
+		entry.Height,
+		entry.LedgerID,
+	), b)
+}
+
+// AppendShareLedgerEntry records a movement of shares at the current block in the ledger of the campaign
+func (k Keeper) AppendShareLedgerEntry(
+	ctx sdk.Context,
+	campaignID uint64,
+	operation types.ShareLedgerEntry_Operation,
+	sender,
+	address string,
+	shares types.Shares,
+) types.ShareLedgerEntry {
+	counter := k.GetShareLedgerCounter(ctx, campaignID)
+	entry := types.ShareLedgerEntry{
+		CampaignID: campaignID,
+		LedgerID:   counter,
+		Height:     ctx.BlockHeight(),
+		Timestamp:  ctx.BlockTime().Unix(),
+		Operation:  operation,
+		Sender:     sender,
+		Address:    address,
+		Shares:     shares,
+	}
+	k.SetShareLedgerEntry(ctx, entry)
+	k.SetShareLedgerCounter(ctx, campaignID, counter+1)
+	return entry
+}
+
+// GetAllShareLedgerEntry returns all shareLedgerEntry
+func (k Keeper) GetAllShareLedgerEntry(ctx sdk.Context) (list []types.ShareLedgerEntry) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ShareLedgerKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ShareLedgerEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
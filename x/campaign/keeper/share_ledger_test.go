@@ -0,0 +1,150 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	tc "github.com/tendermint/spn/testutil/constructor"
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/campaign/keeper"
+	"github.com/tendermint/spn/x/campaign/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+)
+
+func createNShareLedgerEntry(keeper *keeper.Keeper, ctx sdk.Context, campaignID uint64, n int) []types.ShareLedgerEntry {
+	items := make([]types.ShareLedgerEntry, n)
+	for i := range items {
+		items[i] = keeper.AppendShareLedgerEntry(
+			ctx,
+			campaignID,
+			types.ShareLedgerEntry_MINT_VOUCHERS,
+			sample.Address(r),
+			sample.Address(r),
+			sample.Shares(r),
+		)
+	}
+	return items
+}
+
+func TestShareLedgerEntryGetAll(t *testing.T) {
+	ctx, tk, _ := testkeeper.NewTestSetup(t)
+	items := createNShareLedgerEntry(tk.CampaignKeeper, ctx, 0, 10)
+	items = append(items, createNShareLedgerEntry(tk.CampaignKeeper, ctx, 1, 10)...)
+	require.ElementsMatch(t, items, tk.CampaignKeeper.GetAllShareLedgerEntry(ctx))
+}
+
+func TestShareLedgerCounter(t *testing.T) {
+	ctx, tk, _ := testkeeper.NewTestSetup(t)
+
+	t.Run("should append entries with an incremented ledger id", func(t *testing.T) {
+		items := createNShareLedgerEntry(tk.CampaignKeeper, ctx, 0, 10)
+		for i, item := range items {
+			require.EqualValues(t, i, item.LedgerID)
+		}
+		require.EqualValues(t, 10, tk.CampaignKeeper.GetShareLedgerCounter(ctx, 0))
+	})
+
+	t.Run("should maintain a separate counter for each campaign", func(t *testing.T) {
+		require.EqualValues(t, 0, tk.CampaignKeeper.GetShareLedgerCounter(ctx, 1))
+		item := createNShareLedgerEntry(tk.CampaignKeeper, ctx, 1, 1)[0]
+		require.EqualValues(t, 0, item.LedgerID)
+		require.EqualValues(t, 1, tk.CampaignKeeper.GetShareLedgerCounter(ctx, 1))
+	})
+
+	t.Run("should set the counter", func(t *testing.T) {
+		tk.CampaignKeeper.SetShareLedgerCounter(ctx, 2, 100)
+		require.EqualValues(t, 100, tk.CampaignKeeper.GetShareLedgerCounter(ctx, 2))
+		item := createNShareLedgerEntry(tk.CampaignKeeper, ctx, 2, 1)[0]
+		require.EqualValues(t, 100, item.LedgerID)
+	})
+}
+
+func TestAppendShareLedgerEntry(t *testing.T) {
+	var (
+		ctx, tk, _ = testkeeper.NewTestSetup(t)
+		blockTime  = time.Unix(1000, 0)
+		sender     = sample.Address(r)
+		account    = sample.Address(r)
+		shares     = tc.Shares(t, "1000foo")
+	)
+
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(blockTime)
+	entry := tk.CampaignKeeper.AppendShareLedgerEntry(
+		ctx,
+		0,
+		types.ShareLedgerEntry_REDEEM_VOUCHERS,
+		sender,
+		account,
+		shares,
+	)
+	require.Equal(t, types.ShareLedgerEntry{
+		CampaignID: 0,
+		LedgerID:   0,
+		Height:     10,
+		Timestamp:  blockTime.Unix(),
+		Operation:  types.ShareLedgerEntry_REDEEM_VOUCHERS,
+		Sender:     sender,
+		Address:    account,
+		Shares:     shares,
+	}, entry)
+	require.Equal(t, []types.ShareLedgerEntry{entry}, tk.CampaignKeeper.GetAllShareLedgerEntry(ctx))
+}
+
+func TestShareLedgerVouchersOperations(t *testing.T) {
+	var (
+		sdkCtx, tk, ts = testkeeper.NewTestSetup(t)
+		ctx            = sdk.WrapSDKContext(sdkCtx)
+
+		coord   = sample.Address(r)
+		account = sample.Address(r)
+	)
+
+	res, err := ts.ProfileSrv.CreateCoordinator(ctx, &profiletypes.MsgCreateCoordinator{
+		Address:     coord,
+		Description: sample.CoordinatorDescription(r),
+	})
+	require.NoError(t, err)
+
+	campaign := sample.Campaign(r, 0)
+	campaign.CoordinatorID = res.CoordinatorID
+	campaign.AllocatedShares = types.EmptyShares()
+	campaignID := tk.CampaignKeeper.AppendCampaign(sdkCtx, campaign)
+
+	vouchers := func(shares string) sdk.Coins {
+		return tc.Vouchers(t, shares, campaignID)
+	}
+
+	_, err = ts.CampaignSrv.MintVouchers(ctx, types.NewMsgMintVouchers(coord, campaignID, tc.Shares(t, "1000foo,500bar")))
+	require.NoError(t, err)
+	_, err = ts.CampaignSrv.RedeemVouchers(ctx, types.NewMsgRedeemVouchers(coord, account, campaignID, vouchers("400foo")))
+	require.NoError(t, err)
+	_, err = ts.CampaignSrv.BurnVouchers(ctx, types.NewMsgBurnVouchers(coord, campaignID, vouchers("100bar")))
+	require.NoError(t, err)
+	_, err = ts.CampaignSrv.UnredeemVouchers(ctx, types.NewMsgUnredeemVouchers(account, campaignID, tc.Shares(t, "100foo")))
+	require.NoError(t, err)
+
+	entries := tk.CampaignKeeper.GetAllShareLedgerEntry(sdkCtx)
+	require.Len(t, entries, 4)
+	for i, expected := range []struct {
+		operation types.ShareLedgerEntry_Operation
+		sender    string
+		address   string
+		shares    types.Shares
+	}{
+		{types.ShareLedgerEntry_MINT_VOUCHERS, coord, coord, tc.Shares(t, "1000foo,500bar")},
+		{types.ShareLedgerEntry_REDEEM_VOUCHERS, coord, account, tc.Shares(t, "400foo")},
+		{types.ShareLedgerEntry_BURN_VOUCHERS, coord, coord, tc.Shares(t, "100bar")},
+		{types.ShareLedgerEntry_UNREDEEM_VOUCHERS, account, account, tc.Shares(t, "100foo")},
+	} {
+		require.EqualValues(t, i, entries[i].LedgerID)
+		require.Equal(t, campaignID, entries[i].CampaignID)
+		require.Equal(t, expected.operation, entries[i].Operation)
+		require.Equal(t, expected.sender, entries[i].Sender)
+		require.Equal(t, expected.address, entries[i].Address)
+		require.True(t, types.IsEqualShares(expected.shares, entries[i].Shares))
+	}
+}
@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tendermint/spn/x/campaign/types"
+)
+
+// SetVoucherTransferPolicy set a specific voucherTransferPolicy in the store from its index
+func (k Keeper) SetVoucherTransferPolicy(ctx sdk.Context, policy types.VoucherTransferPolicy) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.VoucherTransferPolicyKeyPrefix))
+	b := k.cdc.MustMarshal(&policy)
+	store.Set(types.VoucherTransferPolicyKey(
+		policy.CampaignID,
+	), b)
+}
+
+// GetVoucherTransferPolicy returns a voucherTransferPolicy from its index
+func (k Keeper) GetVoucherTransferPolicy(ctx sdk.Context, campaignID uint64) (val types.VoucherTransferPolicy, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.VoucherTransferPolicyKeyPrefix))
+
+	b := store.Get(types.VoucherTransferPolicyKey(
+		campaignID,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllVoucherTransferPolicy returns all voucherTransferPolicy
+func (k Keeper) GetAllVoucherTransferPolicy(ctx sdk.Context) (list []types.VoucherTransferPolicy) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.VoucherTransferPolicyKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.VoucherTransferPolicy
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// VoucherTransferPolicyOf returns the voucher transfer policy of a campaign
+// The vouchers of a campaign without policy can be transferred freely
+func (k Keeper) VoucherTransferPolicyOf(ctx sdk.Context, campaignID uint64) types.VoucherTransferPolicy {
+	policy, found := k.GetVoucherTransferPolicy(ctx, campaignID)
+	if !found {
+		return types.DefaultVoucherTransferPolicy(campaignID)
+	}
+	return policy
+}
+
+// CheckVoucherTransfer checks the vouchers contained in the coins can be sent to the recipient
+func (k Keeper) CheckVoucherTransfer(ctx sdk.Context, recipient string, coins sdk.Coins) error {
+	for _, coin := range coins {
+		campaignID, err := types.VoucherCampaign(coin.Denom)
+		if err != nil {
+			// not a voucher
+			continue
+		}
+		if !k.VoucherTransferPolicyOf(ctx, campaignID).AllowsTransfer(recipient) {
+			return sdkerrors.Wrapf(types.ErrVoucherTransferRestricted,
+				"%s can't receive vouchers %s of campaign %d",
+				recipient,
+				coin.Denom,
+				campaignID,
+			)
+		}
+	}
+	return nil
+}
+
+// CheckVoucherIBCTransfer checks the coin can be transferred through IBC if it is a voucher
+func (k Keeper) CheckVoucherIBCTransfer(ctx sdk.Context, coin sdk.Coin) error {
+	campaignID, err := types.VoucherCampaign(coin.Denom)
+	if err != nil {
+		// not a voucher
+		return nil
+	}
+	if !k.VoucherTransferPolicyOf(ctx, campaignID).AllowsIBCTransfer() {
+		return sdkerrors.Wrapf(types.ErrVoucherTransferRestricted,
+			"vouchers %s of campaign %d can't be transferred through IBC",
+			coin.Denom,
+			campaignID,
+		)
+	}
+	return nil
+}
+
+// CheckVoucherRewards checks the vouchers contained in the coins can be deposited in a reward pool
+func (k Keeper) CheckVoucherRewards(ctx sdk.Context, coins sdk.Coins) error {
+	for _, coin := range coins {
+		campaignID, err := types.VoucherCampaign(coin.Denom)
+		if err != nil {
+			// not a voucher
+			continue
+		}
+		if !k.VoucherTransferPolicyOf(ctx, campaignID).AllowsRewardPool() {
+			return sdkerrors.Wrapf(types.ErrVoucherTransferRestricted,
+				"vouchers %s of campaign %d can't be deposited in a reward pool",
+				coin.Denom,
+				campaignID,
+			)
+		}
+	}
+	return nil
+}
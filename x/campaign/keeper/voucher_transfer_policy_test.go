@@ -0,0 +1,126 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/campaign/keeper"
+	"github.com/tendermint/spn/x/campaign/types"
+)
+
+func createNVoucherTransferPolicy(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.VoucherTransferPolicy {
+	items := make([]types.VoucherTransferPolicy, n)
+	for i := range items {
+		items[i] = sample.VoucherTransferPolicy(r, uint64(i))
+		keeper.SetVoucherTransferPolicy(ctx, items[i])
+	}
+	return items
+}
+
+func TestVoucherTransferPolicyGet(t *testing.T) {
+	ctx, tk, _ := testkeeper.NewTestSetup(t)
+	items := createNVoucherTransferPolicy(tk.CampaignKeeper, ctx, 10)
+	for _, item := range items {
+		rst, found := tk.CampaignKeeper.GetVoucherTransferPolicy(ctx, item.CampaignID)
+		require.True(t, found)
+		require.Equal(t, item, rst)
+		require.Equal(t, item, tk.CampaignKeeper.VoucherTransferPolicyOf(ctx, item.CampaignID))
+	}
+
+	t.Run("should return the default policy if no policy is set", func(t *testing.T) {
+		require.Equal(t, types.DefaultVoucherTransferPolicy(1000), tk.CampaignKeeper.VoucherTransferPolicyOf(ctx, 1000))
+	})
+}
+
+func TestVoucherTransferPolicyGetAll(t *testing.T) {
+	ctx, tk, _ := testkeeper.NewTestSetup(t)
+	items := createNVoucherTransferPolicy(tk.CampaignKeeper, ctx, 10)
+	require.ElementsMatch(t, items, tk.CampaignKeeper.GetAllVoucherTransferPolicy(ctx))
+}
+
+func TestKeeper_CheckVoucherTransfer(t *testing.T) {
+	var (
+		ctx, tk, _ = testkeeper.NewTestSetup(t)
+
+		campaignFree      = uint64(0)
+		campaignNoIBC     = uint64(1)
+		campaignAllowlist = uint64(2)
+		allowed           = sample.Address(r)
+		notAllowed        = sample.Address(r)
+	)
+
+	tk.CampaignKeeper.SetVoucherTransferPolicy(ctx, types.NewVoucherTransferPolicy(
+		campaignNoIBC,
+		types.VoucherTransferPolicy_NO_IBC,
+		nil,
+	))
+	tk.CampaignKeeper.SetVoucherTransferPolicy(ctx, types.NewVoucherTransferPolicy(
+		campaignAllowlist,
+		types.VoucherTransferPolicy_ALLOWLIST,
+		[]string{allowed},
+	))
+	voucher := func(campaignID uint64) sdk.Coin {
+		return sdk.NewInt64Coin(types.VoucherDenom(campaignID, "foo"), 100)
+	}
+
+	t.Run("should check the transfer of vouchers", func(t *testing.T) {
+		for _, tc := range []struct {
+			desc      string
+			recipient string
+			coins     sdk.Coins
+			err       error
+		}{
+			{
+				desc:      "should allow transferring coins that are not vouchers",
+				recipient: notAllowed,
+				coins:     sdk.NewCoins(sdk.NewInt64Coin("foo", 100), sdk.NewInt64Coin("v/foo", 100)),
+			},
+			{
+				desc:      "should allow transferring vouchers without restriction",
+				recipient: notAllowed,
+				coins:     sdk.NewCoins(voucher(campaignFree), voucher(campaignNoIBC)),
+			},
+			{
+				desc:      "should allow transferring vouchers to an address of the allowlist",
+				recipient: allowed,
+				coins:     sdk.NewCoins(voucher(campaignFree), voucher(campaignAllowlist)),
+			},
+			{
+				desc:      "should prevent transferring vouchers to an address not in the allowlist",
+				recipient: notAllowed,
+				coins:     sdk.NewCoins(voucher(campaignFree), voucher(campaignAllowlist)),
+				err:       types.ErrVoucherTransferRestricted,
+			},
+		} {
+			t.Run(tc.desc, func(t *testing.T) {
+				err := tk.CampaignKeeper.CheckVoucherTransfer(ctx, tc.recipient, tc.coins)
+				if tc.err != nil {
+					require.ErrorIs(t, err, tc.err)
+					return
+				}
+				require.NoError(t, err)
+			})
+		}
+	})
+
+	t.Run("should check the IBC transfer of vouchers", func(t *testing.T) {
+		require.NoError(t, tk.CampaignKeeper.CheckVoucherIBCTransfer(ctx, sdk.NewInt64Coin("foo", 100)))
+		require.NoError(t, tk.CampaignKeeper.CheckVoucherIBCTransfer(ctx, voucher(campaignFree)))
+		err := tk.CampaignKeeper.CheckVoucherIBCTransfer(ctx, voucher(campaignNoIBC))
+		require.ErrorIs(t, err, types.ErrVoucherTransferRestricted)
+		err = tk.CampaignKeeper.CheckVoucherIBCTransfer(ctx, voucher(campaignAllowlist))
+		require.ErrorIs(t, err, types.ErrVoucherTransferRestricted)
+	})
+	t.Run("should check the deposit of vouchers in a reward pool", func(t *testing.T) {
+		coins := sdk.NewCoins(sdk.NewInt64Coin("foo", 100), voucher(campaignFree))
+		require.NoError(t, tk.CampaignKeeper.CheckVoucherRewards(ctx, coins))
+		err := tk.CampaignKeeper.CheckVoucherRewards(ctx, coins.Add(voucher(campaignNoIBC)))
+		require.ErrorIs(t, err, types.ErrVoucherTransferRestricted)
+		err = tk.CampaignKeeper.CheckVoucherRewards(ctx, coins.Add(voucher(campaignAllowlist)))
+		require.ErrorIs(t, err, types.ErrVoucherTransferRestricted)
+	})
+}
@@ -0,0 +1,20 @@
+package v2
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/tendermint/spn/x/campaign/types"
+)
+
+// MigrateStore performs in-place store migrations from v1 to v2 of the campaign module:
+//   - the claim mission ID parameters are set to their default values
+func MigrateStore(ctx sdk.Context, paramSpace paramtypes.Subspace) error {
+	if !paramSpace.Has(ctx, types.KeyStakingMissionID) {
+		paramSpace.Set(ctx, types.KeyStakingMissionID, types.DefaultStakingMissionID)
+	}
+	if !paramSpace.Has(ctx, types.KeyVotingMissionID) {
+		paramSpace.Set(ctx, types.KeyVotingMissionID, types.DefaultVotingMissionID)
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmdb "github.com/tendermint/tm-db"
+
+	v2 "github.com/tendermint/spn/x/campaign/migrations/v2"
+	"github.com/tendermint/spn/x/campaign/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	var (
+		cdc        = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+		paramsKey  = sdk.NewKVStoreKey(paramtypes.StoreKey)
+		paramsTKey = sdk.NewTransientStoreKey(paramtypes.TStoreKey)
+	)
+
+	db := tmdb.NewMemDB()
+	cms := store.NewCommitMultiStore(db)
+	cms.MountStoreWithDB(paramsKey, storetypes.StoreTypeIAVL, db)
+	cms.MountStoreWithDB(paramsTKey, storetypes.StoreTypeTransient, db)
+	require.NoError(t, cms.LoadLatestVersion())
+	ctx := sdk.NewContext(cms, tmproto.Header{}, false, log.NewNopLogger())
+
+	paramSpace := paramtypes.NewSubspace(cdc, codec.NewLegacyAmino(), paramsKey, paramsTKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+
+	// v1 fixture: the claim mission ID params are not set
+	params := types.DefaultParams()
+	paramSpace.Set(ctx, types.KeyTotalSupplyRange, params.TotalSupplyRange)
+	paramSpace.Set(ctx, types.KeyCampaignCreationFee, params.CampaignCreationFee)
+
+	require.NoError(t, v2.MigrateStore(ctx, paramSpace))
+
+	var migrated types.Params
+	paramSpace.GetParamSet(ctx, &migrated)
+	require.EqualValues(t, types.DefaultStakingMissionID, migrated.StakingMissionID)
+	require.EqualValues(t, types.DefaultVotingMissionID, migrated.VotingMissionID)
+	require.NoError(t, migrated.ValidateBasic())
+}
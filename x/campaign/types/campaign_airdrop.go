@@ -0,0 +1,124 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	claimtypes "github.com/ignite/modules/x/claim/types"
+)
+
+// NewCampaignAirdrop returns a new campaign airdrop
+func NewCampaignAirdrop(
+	campaignID uint64,
+	denom string,
+	missions []AirdropMission,
+	claimRecords []AirdropClaimRecord,
+) CampaignAirdrop {
+	return CampaignAirdrop{
+		CampaignID:   campaignID,
+		Denom:        denom,
+		Missions:     missions,
+		ClaimRecords: claimRecords,
+	}
+}
+
+// Validate checks the campaign airdrop is valid
+func (ca CampaignAirdrop) Validate() error {
+	if err := sdk.ValidateDenom(ca.Denom); err != nil {
+		return err
+	}
+
+	// the weights of the missions must sum to one to release the whole claimable amount
+	if len(ca.Missions) == 0 {
+		return errors.New("no mission")
+	}
+	weightSum := sdk.ZeroDec()
+	missionIDMap := make(map[uint64]struct{})
+	for _, mission := range ca.Missions {
+		if _, ok := missionIDMap[mission.MissionID]; ok {
+			return fmt.Errorf("duplicated mission %d", mission.MissionID)
+		}
+		missionIDMap[mission.MissionID] = struct{}{}
+
+		if mission.Weight.IsNil() || mission.Weight.IsNegative() || mission.Weight.GT(sdk.OneDec()) {
+			return fmt.Errorf("mission %d weight must be in range [0:1]", mission.MissionID)
+		}
+		weightSum = weightSum.Add(mission.Weight)
+	}
+	if !weightSum.Equal(sdk.OneDec()) {
+		return errors.New("sum of mission weights must be 1")
+	}
+
+	if len(ca.ClaimRecords) == 0 {
+		return errors.New("no claim record")
+	}
+	addressMap := make(map[string]struct{})
+	for _, claimRecord := range ca.ClaimRecords {
+		if _, err := sdk.AccAddressFromBech32(claimRecord.Address); err != nil {
+			return fmt.Errorf("invalid claim record address %s: %s", claimRecord.Address, err.Error())
+		}
+		if _, ok := addressMap[claimRecord.Address]; ok {
+			return fmt.Errorf("duplicated claim record for address %s", claimRecord.Address)
+		}
+		addressMap[claimRecord.Address] = struct{}{}
+
+		if claimRecord.Weight == 0 {
+			return fmt.Errorf("claim record weight for address %s must be positive", claimRecord.Address)
+		}
+	}
+
+	return nil
+}
+
+// ClaimModuleRecords returns the claim records of the claim module distributing the airdrop supply
+// Each record receives the airdrop supply in proportion to its weight rounded down, the rounding dust is added to
+// the first record of the highest weight. Records without claimable amount are omitted
+func (ca CampaignAirdrop) ClaimModuleRecords(airdropSupply sdkmath.Int) []claimtypes.ClaimRecord {
+	totalWeight := sdkmath.ZeroInt()
+	highestWeight := 0
+	for i, claimRecord := range ca.ClaimRecords {
+		totalWeight = totalWeight.Add(sdkmath.NewIntFromUint64(claimRecord.Weight))
+		if claimRecord.Weight > ca.ClaimRecords[highestWeight].Weight {
+			highestWeight = i
+		}
+	}
+	if totalWeight.IsZero() || !airdropSupply.IsPositive() {
+		return []claimtypes.ClaimRecord{}
+	}
+
+	claimables := make([]sdkmath.Int, len(ca.ClaimRecords))
+	dust := airdropSupply
+	for i, claimRecord := range ca.ClaimRecords {
+		claimables[i] = airdropSupply.Mul(sdkmath.NewIntFromUint64(claimRecord.Weight)).Quo(totalWeight)
+		dust = dust.Sub(claimables[i])
+	}
+	claimables[highestWeight] = claimables[highestWeight].Add(dust)
+
+	claimRecords := make([]claimtypes.ClaimRecord, 0, len(ca.ClaimRecords))
+	for i, claimRecord := range ca.ClaimRecords {
+		if claimables[i].IsPositive() {
+			claimRecords = append(claimRecords, claimtypes.ClaimRecord{
+				Address:   claimRecord.Address,
+				Claimable: claimables[i],
+			})
+		}
+	}
+	return claimRecords
+}
+
+// ClaimGenesis returns the genesis state of the claim module of the mainnet distributing the airdrop supply
+func (ca CampaignAirdrop) ClaimGenesis(airdropSupply sdkmath.Int) claimtypes.GenesisState {
+	genesis := claimtypes.DefaultGenesis()
+	genesis.AirdropSupply = sdk.NewCoin(ca.Denom, airdropSupply)
+	genesis.ClaimRecords = ca.ClaimModuleRecords(airdropSupply)
+	for _, mission := range ca.Missions {
+		genesis.Missions = append(genesis.Missions, claimtypes.Mission{
+			MissionID:   mission.MissionID,
+			Description: mission.Description,
+			Weight:      mission.Weight,
+		})
+	}
+	return *genesis
+}
@@ -0,0 +1,954 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: campaign/campaign_airdrop.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// CampaignAirdrop defines the airdrop of the claimable airdrop of a campaign distributed on mainnet by the claim module
+type CampaignAirdrop struct {
+	CampaignID uint64 `protobuf:"varint,1,opt,name=campaignID,proto3" json:"campaignID,omitempty"`
+	// denom is the denom of the claimable airdrop distributed to the claim records
+	Denom        string               `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Missions     []AirdropMission     `protobuf:"bytes,3,rep,name=missions,proto3" json:"missions"`
+	ClaimRecords []AirdropClaimRecord `protobuf:"bytes,4,rep,name=claimRecords,proto3" json:"claimRecords"`
+}
+
+func (m *CampaignAirdrop) Reset()         { *m = CampaignAirdrop{} }
+func (m *CampaignAirdrop) String() string { return proto.CompactTextString(m) }
+func (*CampaignAirdrop) ProtoMessage()    {}
+func (*CampaignAirdrop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82f13b91c075669e, []int{0}
+}
+func (m *CampaignAirdrop) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CampaignAirdrop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CampaignAirdrop.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CampaignAirdrop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CampaignAirdrop.Merge(m, src)
+}
+func (m *CampaignAirdrop) XXX_Size() int {
+	return m.Size()
+}
+func (m *CampaignAirdrop) XXX_DiscardUnknown() {
+	xxx_messageInfo_CampaignAirdrop.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CampaignAirdrop proto.InternalMessageInfo
+
+func (m *CampaignAirdrop) GetCampaignID() uint64 {
+	if m != nil {
+		return m.CampaignID
+	}
+	return 0
+}
+
+func (m *CampaignAirdrop) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *CampaignAirdrop) GetMissions() []AirdropMission {
+	if m != nil {
+		return m.Missions
+	}
+	return nil
+}
+
+func (m *CampaignAirdrop) GetClaimRecords() []AirdropClaimRecord {
+	if m != nil {
+		return m.ClaimRecords
+	}
+	return nil
+}
+
+// AirdropMission defines a mission to complete to claim a portion of the airdrop
+type AirdropMission struct {
+	MissionID   uint64 `protobuf:"varint,1,opt,name=missionID,proto3" json:"missionID,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// weight is the portion of the claimable amount released by the mission
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
+}
+
+func (m *AirdropMission) Reset()         { *m = AirdropMission{} }
+func (m *AirdropMission) String() string { return proto.CompactTextString(m) }
+func (*AirdropMission) ProtoMessage()    {}
+func (*AirdropMission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82f13b91c075669e, []int{1}
+}
+func (m *AirdropMission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AirdropMission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AirdropMission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AirdropMission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AirdropMission.Merge(m, src)
+}
+func (m *AirdropMission) XXX_Size() int {
+	return m.Size()
+}
+func (m *AirdropMission) XXX_DiscardUnknown() {
+	xxx_messageInfo_AirdropMission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AirdropMission proto.InternalMessageInfo
+
+func (m *AirdropMission) GetMissionID() uint64 {
+	if m != nil {
+		return m.MissionID
+	}
+	return 0
+}
+
+func (m *AirdropMission) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+// AirdropClaimRecord defines the portion of the airdrop allocated to an address
+type AirdropClaimRecord struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// weight is the relative weight of the address in the airdrop supply
+	Weight uint64 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (m *AirdropClaimRecord) Reset()         { *m = AirdropClaimRecord{} }
+func (m *AirdropClaimRecord) String() string { return proto.CompactTextString(m) }
+func (*AirdropClaimRecord) ProtoMessage()    {}
+func (*AirdropClaimRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82f13b91c075669e, []int{2}
+}
+func (m *AirdropClaimRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AirdropClaimRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AirdropClaimRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AirdropClaimRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AirdropClaimRecord.Merge(m, src)
+}
+func (m *AirdropClaimRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *AirdropClaimRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_AirdropClaimRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AirdropClaimRecord proto.InternalMessageInfo
+
+func (m *AirdropClaimRecord) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AirdropClaimRecord) GetWeight() uint64 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*CampaignAirdrop)(nil), "tendermint.spn.campaign.CampaignAirdrop")
+	proto.RegisterType((*AirdropMission)(nil), "tendermint.spn.campaign.AirdropMission")
+	proto.RegisterType((*AirdropClaimRecord)(nil), "tendermint.spn.campaign.AirdropClaimRecord")
+}
+
+func init() { proto.RegisterFile("campaign/campaign_airdrop.proto", fileDescriptor_82f13b91c075669e) }
+
+var fileDescriptor_82f13b91c075669e = []byte{
+	// 400 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0x4f, 0xcf, 0xd2, 0x30,
+	0x18, 0x5f, 0x01, 0x51, 0x8a, 0xd1, 0xa4, 0x21, 0x3a, 0x89, 0x19, 0x0b, 0x07, 0x25, 0x1a, 0xb6,
+	0x04, 0xaf, 0x5e, 0x18, 0xbb, 0x70, 0xf0, 0x32, 0xf5, 0xe2, 0x05, 0x47, 0xdb, 0x8c, 0x46, 0xd7,
+	0x2e, 0x6d, 0x8d, 0xfa, 0x2d, 0xfc, 0x14, 0x7e, 0x02, 0x3e, 0x04, 0x47, 0xc2, 0xc9, 0x78, 0x20,
+	0x06, 0x4e, 0x7e, 0x0b, 0xc3, 0xda, 0x0d, 0x88, 0x79, 0xf3, 0x9e, 0xd6, 0xe7, 0x79, 0x7e, 0xcf,
+	0xef, 0x4f, 0x57, 0x38, 0xc0, 0x69, 0x5e, 0xa4, 0x2c, 0xe3, 0x61, 0x75, 0x58, 0xa4, 0x4c, 0x12,
+	0x29, 0x8a, 0xa0, 0x90, 0x42, 0x0b, 0xf4, 0x58, 0x53, 0x4e, 0xa8, 0xcc, 0x19, 0xd7, 0x81, 0x2a,
+	0x78, 0x50, 0xc1, 0xfa, 0xbd, 0x4c, 0x64, 0xa2, 0xc4, 0x84, 0xa7, 0x93, 0x81, 0xf7, 0x9f, 0x60,
+	0xa1, 0x72, 0xa1, 0x16, 0x66, 0x60, 0x0a, 0x33, 0x1a, 0xfe, 0x05, 0xf0, 0xe1, 0xcc, 0x6e, 0x4f,
+	0x8d, 0x06, 0xf2, 0x20, 0xac, 0x08, 0xe7, 0xb1, 0x0b, 0x7c, 0x30, 0x6a, 0x25, 0x17, 0x1d, 0xd4,
+	0x83, 0x77, 0x08, 0xe5, 0x22, 0x77, 0x1b, 0x3e, 0x18, 0x75, 0x12, 0x53, 0xa0, 0x39, 0xbc, 0x97,
+	0x33, 0xa5, 0x98, 0xe0, 0xca, 0x6d, 0xfa, 0xcd, 0x51, 0x77, 0xf2, 0x3c, 0xb8, 0xc1, 0x66, 0x60,
+	0x95, 0xde, 0x18, 0x7c, 0xd4, 0xda, 0xec, 0x07, 0x4e, 0x52, 0xaf, 0xa3, 0xf7, 0xf0, 0x3e, 0xfe,
+	0x9c, 0xb2, 0x3c, 0xa1, 0x58, 0x48, 0xa2, 0xdc, 0x56, 0x49, 0xf7, 0xf2, 0x36, 0xba, 0xd9, 0x79,
+	0xc7, 0x52, 0x5e, 0xd1, 0x0c, 0x7f, 0x02, 0xf8, 0xe0, 0x5a, 0x19, 0x3d, 0x85, 0x1d, 0xab, 0x5a,
+	0x27, 0x3d, 0x37, 0x90, 0x0f, 0xbb, 0x84, 0x2a, 0x2c, 0x59, 0xa1, 0x99, 0xe0, 0x36, 0xee, 0x65,
+	0x0b, 0xbd, 0x83, 0xed, 0xaf, 0x94, 0x65, 0x2b, 0xed, 0x36, 0x4f, 0xc3, 0xe8, 0xf5, 0x49, 0xf6,
+	0xf7, 0x7e, 0xf0, 0x2c, 0x63, 0x7a, 0xf5, 0x65, 0x19, 0x60, 0x91, 0xdb, 0xfb, 0xb6, 0x9f, 0xb1,
+	0x22, 0x9f, 0x42, 0xfd, 0xbd, 0xa0, 0x2a, 0x88, 0x29, 0xde, 0xad, 0xc7, 0xd0, 0xfe, 0x8e, 0x98,
+	0xe2, 0xc4, 0x72, 0x0d, 0x3f, 0x42, 0xf4, 0x7f, 0x24, 0x34, 0x81, 0x77, 0x53, 0x42, 0x24, 0x55,
+	0xaa, 0x74, 0xda, 0x89, 0xdc, 0xdd, 0x7a, 0xdc, 0xb3, 0xeb, 0x53, 0x33, 0x79, 0xab, 0x25, 0xe3,
+	0x59, 0x52, 0x01, 0xd1, 0xa3, 0xda, 0x5f, 0xa3, 0x0c, 0x67, 0xab, 0x28, 0xde, 0x1c, 0x3c, 0xb0,
+	0x3d, 0x78, 0xe0, 0xcf, 0xc1, 0x03, 0x3f, 0x8e, 0x9e, 0xb3, 0x3d, 0x7a, 0xce, 0xaf, 0xa3, 0xe7,
+	0x7c, 0x78, 0x71, 0xe1, 0xfc, 0x7c, 0xdf, 0xa1, 0x2a, 0x78, 0xf8, 0xad, 0x7e, 0x8e, 0x26, 0xc1,
+	0xb2, 0x5d, 0xbe, 0xa1, 0x57, 0xff, 0x06, 0x00, 0x8a, 0x5d, 0xae, 0xe6, 0xb0, 0x02, 0x00, 0x00,
+}
+
+func (m *CampaignAirdrop) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CampaignAirdrop) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CampaignAirdrop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClaimRecords) > 0 {
+		for iNdEx := len(m.ClaimRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCampaignAirdrop(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Missions) > 0 {
+		for iNdEx := len(m.Missions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Missions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCampaignAirdrop(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintCampaignAirdrop(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CampaignID != 0 {
+		i = encodeVarintCampaignAirdrop(dAtA, i, uint64(m.CampaignID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AirdropMission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AirdropMission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AirdropMission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCampaignAirdrop(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintCampaignAirdrop(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MissionID != 0 {
+		i = encodeVarintCampaignAirdrop(dAtA, i, uint64(m.MissionID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AirdropClaimRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AirdropClaimRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AirdropClaimRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Weight != 0 {
+		i = encodeVarintCampaignAirdrop(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintCampaignAirdrop(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCampaignAirdrop(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCampaignAirdrop(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CampaignAirdrop) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CampaignID != 0 {
+		n += 1 + sovCampaignAirdrop(uint64(m.CampaignID))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovCampaignAirdrop(uint64(l))
+	}
+	if len(m.Missions) > 0 {
+		for _, e := range m.Missions {
+			l = e.Size()
+			n += 1 + l + sovCampaignAirdrop(uint64(l))
+		}
+	}
+	if len(m.ClaimRecords) > 0 {
+		for _, e := range m.ClaimRecords {
+			l = e.Size()
+			n += 1 + l + sovCampaignAirdrop(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AirdropMission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MissionID != 0 {
+		n += 1 + sovCampaignAirdrop(uint64(m.MissionID))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovCampaignAirdrop(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovCampaignAirdrop(uint64(l))
+	return n
+}
+
+func (m *AirdropClaimRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovCampaignAirdrop(uint64(l))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovCampaignAirdrop(uint64(m.Weight))
+	}
+	return n
+}
+
+func sovCampaignAirdrop(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCampaignAirdrop(x uint64) (n int) {
+	return sovCampaignAirdrop(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CampaignAirdrop) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCampaignAirdrop
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CampaignAirdrop: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CampaignAirdrop: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignID", wireType)
+			}
+			m.CampaignID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCampaignAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCampaignAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCampaignAirdrop
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCampaignAirdrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Missions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCampaignAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCampaignAirdrop
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCampaignAirdrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Missions = append(m.Missions, AirdropMission{})
+			if err := m.Missions[len(m.Missions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCampaignAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCampaignAirdrop
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCampaignAirdrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimRecords = append(m.ClaimRecords, AirdropClaimRecord{})
+			if err := m.ClaimRecords[len(m.ClaimRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCampaignAirdrop(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCampaignAirdrop
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AirdropMission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCampaignAirdrop
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AirdropMission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AirdropMission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissionID", wireType)
+			}
+			m.MissionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCampaignAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissionID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCampaignAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCampaignAirdrop
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCampaignAirdrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCampaignAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCampaignAirdrop
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCampaignAirdrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCampaignAirdrop(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCampaignAirdrop
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AirdropClaimRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCampaignAirdrop
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AirdropClaimRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AirdropClaimRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCampaignAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCampaignAirdrop
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCampaignAirdrop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCampaignAirdrop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCampaignAirdrop(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCampaignAirdrop
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCampaignAirdrop(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCampaignAirdrop
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCampaignAirdrop
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCampaignAirdrop
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCampaignAirdrop
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCampaignAirdrop
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCampaignAirdrop
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCampaignAirdrop        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCampaignAirdrop          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCampaignAirdrop = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,167 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/campaign/types"
+)
+
+func TestCampaignAirdrop_Validate(t *testing.T) {
+	var (
+		addr    = sample.Address(r)
+		mission = func(id uint64, weight string) types.AirdropMission {
+			return types.AirdropMission{
+				MissionID: id,
+				Weight:    sdk.MustNewDecFromStr(weight),
+			}
+		}
+		claimRecord = func(address string, weight uint64) types.AirdropClaimRecord {
+			return types.AirdropClaimRecord{
+				Address: address,
+				Weight:  weight,
+			}
+		}
+	)
+
+	for _, tc := range []struct {
+		desc    string
+		airdrop types.CampaignAirdrop
+		valid   bool
+	}{
+		{
+			desc:    "should validate valid airdrop",
+			airdrop: sample.CampaignAirdrop(r, 0),
+			valid:   true,
+		},
+		{
+			desc: "should prevent invalid denom",
+			airdrop: types.NewCampaignAirdrop(0, "", []types.AirdropMission{mission(0, "1")},
+				[]types.AirdropClaimRecord{claimRecord(addr, 1)},
+			),
+		},
+		{
+			desc:    "should prevent no mission",
+			airdrop: types.NewCampaignAirdrop(0, "foo", nil, []types.AirdropClaimRecord{claimRecord(addr, 1)}),
+		},
+		{
+			desc: "should prevent duplicated mission",
+			airdrop: types.NewCampaignAirdrop(0, "foo", []types.AirdropMission{mission(0, "0.5"), mission(0, "0.5")},
+				[]types.AirdropClaimRecord{claimRecord(addr, 1)},
+			),
+		},
+		{
+			desc: "should prevent negative mission weight",
+			airdrop: types.NewCampaignAirdrop(0, "foo", []types.AirdropMission{mission(0, "1.5"), mission(1, "-0.5")},
+				[]types.AirdropClaimRecord{claimRecord(addr, 1)},
+			),
+		},
+		{
+			desc: "should prevent mission weights not summing to one",
+			airdrop: types.NewCampaignAirdrop(0, "foo", []types.AirdropMission{mission(0, "0.5"), mission(1, "0.4")},
+				[]types.AirdropClaimRecord{claimRecord(addr, 1)},
+			),
+		},
+		{
+			desc:    "should prevent no claim record",
+			airdrop: types.NewCampaignAirdrop(0, "foo", []types.AirdropMission{mission(0, "1")}, nil),
+		},
+		{
+			desc: "should prevent invalid claim record address",
+			airdrop: types.NewCampaignAirdrop(0, "foo", []types.AirdropMission{mission(0, "1")},
+				[]types.AirdropClaimRecord{claimRecord("invalid", 1)},
+			),
+		},
+		{
+			desc: "should prevent duplicated claim record",
+			airdrop: types.NewCampaignAirdrop(0, "foo", []types.AirdropMission{mission(0, "1")},
+				[]types.AirdropClaimRecord{claimRecord(addr, 1), claimRecord(addr, 2)},
+			),
+		},
+		{
+			desc: "should prevent claim record without weight",
+			airdrop: types.NewCampaignAirdrop(0, "foo", []types.AirdropMission{mission(0, "1")},
+				[]types.AirdropClaimRecord{claimRecord(addr, 0)},
+			),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.airdrop.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestCampaignAirdrop_ClaimGenesis(t *testing.T) {
+	var (
+		addr1    = sample.Address(r)
+		addr2    = sample.Address(r)
+		addr3    = sample.Address(r)
+		missions = []types.AirdropMission{
+			{MissionID: 5, Description: "staking", Weight: sdk.NewDecWithPrec(4, 1)},
+			{MissionID: 8, Description: "voting", Weight: sdk.NewDecWithPrec(6, 1)},
+		}
+		airdrop = types.NewCampaignAirdrop(0, "foo", missions, []types.AirdropClaimRecord{
+			{Address: addr1, Weight: 1},
+			{Address: addr2, Weight: 3},
+			{Address: addr3, Weight: 3},
+		})
+	)
+
+	for _, tc := range []struct {
+		desc          string
+		airdropSupply sdkmath.Int
+		claimables    map[string]int64
+	}{
+		{
+			desc:          "should assign the rounding dust to the first record of the highest weight",
+			airdropSupply: sdkmath.NewInt(100),
+			claimables: map[string]int64{
+				addr1: 14,
+				addr2: 44,
+				addr3: 42,
+			},
+		},
+		{
+			desc:          "should omit records without claimable amount",
+			airdropSupply: sdkmath.NewInt(3),
+			claimables: map[string]int64{
+				addr2: 2,
+				addr3: 1,
+			},
+		},
+		{
+			desc:          "should return no record for an empty airdrop supply",
+			airdropSupply: sdkmath.ZeroInt(),
+			claimables:    map[string]int64{},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			genesis := airdrop.ClaimGenesis(tc.airdropSupply)
+			require.NoError(t, genesis.Validate())
+			require.Equal(t, sdk.NewCoin("foo", tc.airdropSupply), genesis.AirdropSupply)
+
+			require.Len(t, genesis.Missions, len(missions))
+			for i, mission := range missions {
+				require.EqualValues(t, mission.MissionID, genesis.Missions[i].MissionID)
+				require.EqualValues(t, mission.Description, genesis.Missions[i].Description)
+				require.True(t, mission.Weight.Equal(genesis.Missions[i].Weight))
+			}
+
+			require.Len(t, genesis.ClaimRecords, len(tc.claimables))
+			for _, claimRecord := range genesis.ClaimRecords {
+				claimable, ok := tc.claimables[claimRecord.Address]
+				require.True(t, ok)
+				require.True(t, claimRecord.Claimable.Equal(sdkmath.NewInt(claimable)))
+			}
+		})
+	}
+}
@@ -17,6 +17,10 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgBurnVouchers{}, "campaign/BurnVouchers", nil)
 	cdc.RegisterConcrete(&MsgRedeemVouchers{}, "campaign/RedeemVouchers", nil)
 	cdc.RegisterConcrete(&MsgUnredeemVouchers{}, "campaign/UnredeemVouchers", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "campaign/UpdateParams", nil)
+	cdc.RegisterConcrete(&MsgSetCampaignAirdrop{}, "campaign/SetCampaignAirdrop", nil)
+	cdc.RegisterConcrete(&MsgResetMainnet{}, "campaign/ResetMainnet", nil)
+	cdc.RegisterConcrete(&MsgSetVoucherTransferPolicy{}, "campaign/SetVoucherTransferPolicy", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -32,6 +36,10 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgBurnVouchers{},
 		&MsgRedeemVouchers{},
 		&MsgUnredeemVouchers{},
+		&MsgUpdateParams{},
+		&MsgSetCampaignAirdrop{},
+		&MsgResetMainnet{},
+		&MsgSetVoucherTransferPolicy{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
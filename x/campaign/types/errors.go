@@ -24,4 +24,9 @@ var (
 	ErrInvalidMetadataLength     = sdkerrors.Register(ModuleName, 15, "metadata field too long")
 	ErrMainnetLaunchTriggered    = sdkerrors.Register(ModuleName, 16, "mainnet launch already triggered")
 	ErrInvalidSpecialAllocations = sdkerrors.Register(ModuleName, 17, "invalid special allocations")
+	ErrInvalidAirdrop            = sdkerrors.Register(ModuleName, 18, "invalid airdrop")
+	ErrAirdropNotFound           = sdkerrors.Register(ModuleName, 19, "airdrop not found")
+	ErrMainnetNotInitialized     = sdkerrors.Register(ModuleName, 20, "mainnet not initialized")
+	ErrInvalidTransferPolicy     = sdkerrors.Register(ModuleName, 21, "invalid voucher transfer policy")
+	ErrVoucherTransferRestricted = sdkerrors.Register(ModuleName, 22, "voucher transfer restricted")
 )
@@ -386,6 +386,66 @@ func (m *EventCampaignMainnetInitialized) GetMainnetID() uint64 {
 	return 0
 }
 
+type EventCampaignMainnetReset struct {
+	CampaignID         uint64 `protobuf:"varint,1,opt,name=campaignID,proto3" json:"campaignID,omitempty"`
+	CoordinatorAddress string `protobuf:"bytes,2,opt,name=coordinatorAddress,proto3" json:"coordinatorAddress,omitempty"`
+	ArchivedMainnetID  uint64 `protobuf:"varint,3,opt,name=archivedMainnetID,proto3" json:"archivedMainnetID,omitempty"`
+}
+
+func (m *EventCampaignMainnetReset) Reset()         { *m = EventCampaignMainnetReset{} }
+func (m *EventCampaignMainnetReset) String() string { return proto.CompactTextString(m) }
+func (*EventCampaignMainnetReset) ProtoMessage()    {}
+func (*EventCampaignMainnetReset) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d53837db7ef8e0f4, []int{6}
+}
+func (m *EventCampaignMainnetReset) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCampaignMainnetReset) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCampaignMainnetReset.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCampaignMainnetReset) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCampaignMainnetReset.Merge(m, src)
+}
+func (m *EventCampaignMainnetReset) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCampaignMainnetReset) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCampaignMainnetReset.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCampaignMainnetReset proto.InternalMessageInfo
+
+func (m *EventCampaignMainnetReset) GetCampaignID() uint64 {
+	if m != nil {
+		return m.CampaignID
+	}
+	return 0
+}
+
+func (m *EventCampaignMainnetReset) GetCoordinatorAddress() string {
+	if m != nil {
+		return m.CoordinatorAddress
+	}
+	return ""
+}
+
+func (m *EventCampaignMainnetReset) GetArchivedMainnetID() uint64 {
+	if m != nil {
+		return m.ArchivedMainnetID
+	}
+	return 0
+}
+
 type EventMainnetAccountCreated struct {
 	CampaignID uint64 `protobuf:"varint,1,opt,name=campaignID,proto3" json:"campaignID,omitempty"`
 	Address    string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
@@ -396,7 +456,7 @@ func (m *EventMainnetAccountCreated) Reset()         { *m = EventMainnetAccountC
 func (m *EventMainnetAccountCreated) String() string { return proto.CompactTextString(m) }
 func (*EventMainnetAccountCreated) ProtoMessage()    {}
 func (*EventMainnetAccountCreated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d53837db7ef8e0f4, []int{6}
+	return fileDescriptor_d53837db7ef8e0f4, []int{7}
 }
 func (m *EventMainnetAccountCreated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -456,7 +516,7 @@ func (m *EventMainnetAccountUpdated) Reset()         { *m = EventMainnetAccountU
 func (m *EventMainnetAccountUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventMainnetAccountUpdated) ProtoMessage()    {}
 func (*EventMainnetAccountUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d53837db7ef8e0f4, []int{7}
+	return fileDescriptor_d53837db7ef8e0f4, []int{8}
 }
 func (m *EventMainnetAccountUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -515,7 +575,7 @@ func (m *EventMainnetAccountRemoved) Reset()         { *m = EventMainnetAccountR
 func (m *EventMainnetAccountRemoved) String() string { return proto.CompactTextString(m) }
 func (*EventMainnetAccountRemoved) ProtoMessage()    {}
 func (*EventMainnetAccountRemoved) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d53837db7ef8e0f4, []int{8}
+	return fileDescriptor_d53837db7ef8e0f4, []int{9}
 }
 func (m *EventMainnetAccountRemoved) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -568,7 +628,7 @@ func (m *EventMainnetVestingAccountCreated) Reset()         { *m = EventMainnetV
 func (m *EventMainnetVestingAccountCreated) String() string { return proto.CompactTextString(m) }
 func (*EventMainnetVestingAccountCreated) ProtoMessage()    {}
 func (*EventMainnetVestingAccountCreated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d53837db7ef8e0f4, []int{9}
+	return fileDescriptor_d53837db7ef8e0f4, []int{10}
 }
 func (m *EventMainnetVestingAccountCreated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -628,7 +688,7 @@ func (m *EventMainnetVestingAccountUpdated) Reset()         { *m = EventMainnetV
 func (m *EventMainnetVestingAccountUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventMainnetVestingAccountUpdated) ProtoMessage()    {}
 func (*EventMainnetVestingAccountUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d53837db7ef8e0f4, []int{10}
+	return fileDescriptor_d53837db7ef8e0f4, []int{11}
 }
 func (m *EventMainnetVestingAccountUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -687,7 +747,7 @@ func (m *EventCampaignAuctionCreated) Reset()         { *m = EventCampaignAuctio
 func (m *EventCampaignAuctionCreated) String() string { return proto.CompactTextString(m) }
 func (*EventCampaignAuctionCreated) ProtoMessage()    {}
 func (*EventCampaignAuctionCreated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d53837db7ef8e0f4, []int{11}
+	return fileDescriptor_d53837db7ef8e0f4, []int{12}
 }
 func (m *EventCampaignAuctionCreated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -730,6 +790,126 @@ func (m *EventCampaignAuctionCreated) GetAuctionID() uint64 {
 	return 0
 }
 
+type EventVoucherTransferPolicySet struct {
+	CampaignID         uint64                     `protobuf:"varint,1,opt,name=campaignID,proto3" json:"campaignID,omitempty"`
+	CoordinatorAddress string                     `protobuf:"bytes,2,opt,name=coordinatorAddress,proto3" json:"coordinatorAddress,omitempty"`
+	Mode               VoucherTransferPolicy_Mode `protobuf:"varint,3,opt,name=mode,proto3,enum=tendermint.spn.campaign.VoucherTransferPolicy_Mode" json:"mode,omitempty"`
+}
+
+func (m *EventVoucherTransferPolicySet) Reset()         { *m = EventVoucherTransferPolicySet{} }
+func (m *EventVoucherTransferPolicySet) String() string { return proto.CompactTextString(m) }
+func (*EventVoucherTransferPolicySet) ProtoMessage()    {}
+func (*EventVoucherTransferPolicySet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d53837db7ef8e0f4, []int{13}
+}
+func (m *EventVoucherTransferPolicySet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventVoucherTransferPolicySet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventVoucherTransferPolicySet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventVoucherTransferPolicySet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventVoucherTransferPolicySet.Merge(m, src)
+}
+func (m *EventVoucherTransferPolicySet) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventVoucherTransferPolicySet) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventVoucherTransferPolicySet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventVoucherTransferPolicySet proto.InternalMessageInfo
+
+func (m *EventVoucherTransferPolicySet) GetCampaignID() uint64 {
+	if m != nil {
+		return m.CampaignID
+	}
+	return 0
+}
+
+func (m *EventVoucherTransferPolicySet) GetCoordinatorAddress() string {
+	if m != nil {
+		return m.CoordinatorAddress
+	}
+	return ""
+}
+
+func (m *EventVoucherTransferPolicySet) GetMode() VoucherTransferPolicy_Mode {
+	if m != nil {
+		return m.Mode
+	}
+	return VoucherTransferPolicy_FREE
+}
+
+type EventCampaignAirdropSet struct {
+	CampaignID         uint64 `protobuf:"varint,1,opt,name=campaignID,proto3" json:"campaignID,omitempty"`
+	CoordinatorAddress string `protobuf:"bytes,2,opt,name=coordinatorAddress,proto3" json:"coordinatorAddress,omitempty"`
+	Denom              string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *EventCampaignAirdropSet) Reset()         { *m = EventCampaignAirdropSet{} }
+func (m *EventCampaignAirdropSet) String() string { return proto.CompactTextString(m) }
+func (*EventCampaignAirdropSet) ProtoMessage()    {}
+func (*EventCampaignAirdropSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d53837db7ef8e0f4, []int{14}
+}
+func (m *EventCampaignAirdropSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCampaignAirdropSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCampaignAirdropSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCampaignAirdropSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCampaignAirdropSet.Merge(m, src)
+}
+func (m *EventCampaignAirdropSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCampaignAirdropSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCampaignAirdropSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCampaignAirdropSet proto.InternalMessageInfo
+
+func (m *EventCampaignAirdropSet) GetCampaignID() uint64 {
+	if m != nil {
+		return m.CampaignID
+	}
+	return 0
+}
+
+func (m *EventCampaignAirdropSet) GetCoordinatorAddress() string {
+	if m != nil {
+		return m.CoordinatorAddress
+	}
+	return ""
+}
+
+func (m *EventCampaignAirdropSet) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventCampaignCreated)(nil), "tendermint.spn.campaign.EventCampaignCreated")
 	proto.RegisterType((*EventCampaignChainAdded)(nil), "tendermint.spn.campaign.EventCampaignChainAdded")
@@ -737,59 +917,69 @@ func init() {
 	proto.RegisterType((*EventCampaignSharesUpdated)(nil), "tendermint.spn.campaign.EventCampaignSharesUpdated")
 	proto.RegisterType((*EventCampaignTotalSupplyUpdated)(nil), "tendermint.spn.campaign.EventCampaignTotalSupplyUpdated")
 	proto.RegisterType((*EventCampaignMainnetInitialized)(nil), "tendermint.spn.campaign.EventCampaignMainnetInitialized")
+	proto.RegisterType((*EventCampaignMainnetReset)(nil), "tendermint.spn.campaign.EventCampaignMainnetReset")
 	proto.RegisterType((*EventMainnetAccountCreated)(nil), "tendermint.spn.campaign.EventMainnetAccountCreated")
 	proto.RegisterType((*EventMainnetAccountUpdated)(nil), "tendermint.spn.campaign.EventMainnetAccountUpdated")
 	proto.RegisterType((*EventMainnetAccountRemoved)(nil), "tendermint.spn.campaign.EventMainnetAccountRemoved")
 	proto.RegisterType((*EventMainnetVestingAccountCreated)(nil), "tendermint.spn.campaign.EventMainnetVestingAccountCreated")
 	proto.RegisterType((*EventMainnetVestingAccountUpdated)(nil), "tendermint.spn.campaign.EventMainnetVestingAccountUpdated")
 	proto.RegisterType((*EventCampaignAuctionCreated)(nil), "tendermint.spn.campaign.EventCampaignAuctionCreated")
+	proto.RegisterType((*EventVoucherTransferPolicySet)(nil), "tendermint.spn.campaign.EventVoucherTransferPolicySet")
+	proto.RegisterType((*EventCampaignAirdropSet)(nil), "tendermint.spn.campaign.EventCampaignAirdropSet")
 }
 
 func init() { proto.RegisterFile("campaign/events.proto", fileDescriptor_d53837db7ef8e0f4) }
 
 var fileDescriptor_d53837db7ef8e0f4 = []byte{
-	// 646 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x3d, 0x6f, 0xd3, 0x4e,
-	0x1c, 0xce, 0xb5, 0x51, 0xff, 0xff, 0x5c, 0x0b, 0x48, 0x56, 0xa0, 0x6e, 0xa8, 0x9c, 0x60, 0x21,
-	0x11, 0x21, 0x6a, 0xab, 0x61, 0x62, 0xcc, 0x0b, 0x12, 0x19, 0x00, 0xc9, 0xa1, 0x1d, 0xda, 0x01,
-	0x5d, 0xec, 0xc3, 0x39, 0x61, 0xdf, 0x59, 0xbe, 0x4b, 0x44, 0x19, 0x18, 0x99, 0x19, 0xf9, 0x04,
-	0x95, 0x60, 0xe6, 0x23, 0x30, 0x74, 0xac, 0x60, 0x61, 0x0a, 0x28, 0xf9, 0x16, 0x4c, 0xc8, 0xf6,
-	0xe5, 0xc5, 0x51, 0xab, 0x9a, 0x21, 0x11, 0x53, 0x72, 0xbf, 0xd7, 0xe7, 0x79, 0xf2, 0xc4, 0x67,
-	0x78, 0xd3, 0x46, 0x7e, 0x80, 0x88, 0x4b, 0x4d, 0x3c, 0xc0, 0x54, 0x70, 0x23, 0x08, 0x99, 0x60,
-	0xca, 0xb6, 0xc0, 0xd4, 0xc1, 0xa1, 0x4f, 0xa8, 0x30, 0x78, 0x40, 0x8d, 0x49, 0x55, 0xa9, 0xe8,
-	0x32, 0x97, 0xc5, 0x35, 0x66, 0xf4, 0x2d, 0x29, 0x2f, 0x69, 0x36, 0xe3, 0x3e, 0xe3, 0x66, 0x17,
-	0x71, 0x6c, 0x0e, 0xf6, 0xbb, 0x58, 0xa0, 0x7d, 0xd3, 0x66, 0x84, 0xca, 0xfc, 0x4e, 0x92, 0x7f,
-	0x99, 0x34, 0x26, 0x07, 0x99, 0xba, 0x35, 0x05, 0x30, 0xc0, 0x5c, 0x10, 0xea, 0x26, 0x71, 0xfd,
-	0x14, 0xc0, 0xe2, 0xe3, 0x08, 0x52, 0x53, 0xe6, 0x9b, 0x21, 0x46, 0x02, 0x3b, 0x8a, 0x06, 0xe1,
-	0xa4, 0xa5, 0xdd, 0x52, 0x41, 0x05, 0x54, 0xf3, 0xd6, 0x5c, 0x44, 0x79, 0x02, 0x15, 0x9b, 0xb1,
-	0xd0, 0x21, 0x14, 0x09, 0x16, 0xd6, 0x1d, 0x27, 0xc4, 0x9c, 0xab, 0x6b, 0x15, 0x50, 0x2d, 0x34,
-	0xd4, 0x6f, 0x5f, 0xf6, 0x8a, 0x72, 0xbd, 0xcc, 0x74, 0x44, 0x48, 0xa8, 0x6b, 0x5d, 0xd0, 0xa3,
-	0xdc, 0x85, 0xd7, 0xe6, 0xa2, 0xed, 0x96, 0xba, 0x1e, 0x2f, 0x4b, 0x07, 0xf5, 0x03, 0xb8, 0x9d,
-	0xc6, 0xd9, 0x43, 0x84, 0xd6, 0x1d, 0x27, 0x03, 0xd4, 0x12, 0xfc, 0xdf, 0x43, 0x7d, 0x6a, 0xf7,
-	0xda, 0xad, 0x18, 0x60, 0xde, 0x9a, 0x9e, 0xf5, 0xaf, 0x00, 0xaa, 0xa9, 0xb9, 0x6d, 0xfa, 0x8a,
-	0x1d, 0x04, 0xce, 0x8a, 0x35, 0xd0, 0xe1, 0xd6, 0x64, 0xee, 0x33, 0xe4, 0xe3, 0x58, 0x82, 0x82,
-	0x95, 0x8a, 0x45, 0x34, 0x7c, 0x2c, 0x90, 0x83, 0x04, 0x52, 0xf3, 0x15, 0x50, 0xdd, 0xb2, 0xa6,
-	0x67, 0xfd, 0xfd, 0x1a, 0x2c, 0xa5, 0x68, 0x74, 0x7a, 0x28, 0xc4, 0x7c, 0xf5, 0x44, 0xde, 0xc1,
-	0x1b, 0xc8, 0xf3, 0x98, 0x1d, 0xad, 0x4d, 0x30, 0xa8, 0xeb, 0x95, 0xf5, 0xea, 0x66, 0x6d, 0xc7,
-	0x90, 0x33, 0x22, 0xf3, 0x1a, 0xd2, 0xbc, 0x46, 0x93, 0x11, 0xda, 0x78, 0x74, 0x36, 0x2c, 0xe7,
-	0x7e, 0x0f, 0xcb, 0xf7, 0x5c, 0x22, 0x7a, 0xfd, 0xae, 0x61, 0x33, 0x5f, 0x9a, 0x57, 0x7e, 0xec,
-	0x71, 0xe7, 0xb5, 0x29, 0x4e, 0x02, 0xcc, 0xe3, 0x86, 0xcf, 0x3f, 0xcb, 0x1b, 0xc9, 0x6c, 0x6b,
-	0x71, 0x99, 0x7e, 0xba, 0x06, 0xcb, 0x29, 0x21, 0x5e, 0x30, 0x81, 0xbc, 0x4e, 0x3f, 0x08, 0xbc,
-	0x93, 0xd5, 0xab, 0xf1, 0x11, 0xc0, 0x4d, 0x31, 0x03, 0x70, 0xb5, 0x14, 0xc7, 0x7f, 0x2f, 0x45,
-	0x35, 0x63, 0x29, 0xb7, 0xe6, 0xa1, 0xe8, 0x9f, 0xc0, 0x82, 0x50, 0x4f, 0x11, 0xa1, 0x14, 0x8b,
-	0x36, 0x25, 0x82, 0x20, 0x8f, 0xbc, 0x5d, 0xa9, 0x50, 0xbb, 0xb0, 0xe0, 0xcb, 0xfd, 0x93, 0xff,
-	0xff, 0x2c, 0xa0, 0x0f, 0x81, 0x74, 0xb7, 0xc4, 0x58, 0xb7, 0x6d, 0xd6, 0xa7, 0x22, 0xeb, 0xa3,
-	0xaa, 0x06, 0xff, 0x43, 0x19, 0xb1, 0x4d, 0x0a, 0x15, 0x0f, 0x6e, 0xf0, 0xe5, 0xdb, 0x57, 0xee,
-	0xb8, 0x8c, 0x60, 0x56, 0xc3, 0xfe, 0xfb, 0x04, 0x83, 0x0b, 0xf9, 0x59, 0xd8, 0x67, 0x83, 0xe5,
-	0xf0, 0xd3, 0xbf, 0x03, 0x78, 0x67, 0x7e, 0xe5, 0x61, 0x72, 0xed, 0xad, 0xc0, 0x3a, 0x47, 0xf0,
-	0xba, 0xbc, 0x63, 0x9f, 0x07, 0x82, 0x30, 0xca, 0x63, 0x43, 0x6f, 0xd6, 0x1e, 0x18, 0x97, 0xdc,
-	0xf6, 0x46, 0x2c, 0xd6, 0x61, 0xaa, 0xa7, 0x91, 0x8f, 0x44, 0xb7, 0x16, 0x26, 0x5d, 0xc1, 0x6a,
-	0x99, 0x7e, 0x59, 0x26, 0xab, 0x63, 0x78, 0x3b, 0xf5, 0x28, 0xaa, 0xf7, 0xed, 0x28, 0x91, 0xf5,
-	0x47, 0xda, 0x85, 0x05, 0x94, 0x74, 0x4c, 0x2f, 0xf8, 0x59, 0xa0, 0xd1, 0x3a, 0x1b, 0x69, 0xe0,
-	0x7c, 0xa4, 0x81, 0x5f, 0x23, 0x0d, 0x7c, 0x18, 0x6b, 0xb9, 0xf3, 0xb1, 0x96, 0xfb, 0x31, 0xd6,
-	0x72, 0x47, 0xf7, 0xe7, 0xfc, 0x3c, 0x23, 0x61, 0xf2, 0x80, 0x9a, 0x6f, 0xcc, 0xe9, 0xfb, 0x52,
-	0xec, 0xeb, 0xee, 0x46, 0xfc, 0xba, 0xf4, 0xf0, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf3, 0xd9,
-	0x4f, 0x8e, 0xc9, 0x09, 0x00, 0x00,
+	// 764 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xbf, 0x6f, 0x13, 0x49,
+	0x14, 0xf6, 0x24, 0xbe, 0xdc, 0x79, 0x92, 0xcb, 0xe9, 0x2c, 0xdf, 0xc5, 0x31, 0x61, 0x6d, 0x56,
+	0x08, 0x2c, 0x94, 0xec, 0x2a, 0x4e, 0x45, 0x69, 0xc7, 0x08, 0x5c, 0x04, 0xd0, 0x3a, 0x49, 0x91,
+	0x14, 0xd1, 0x78, 0x67, 0x62, 0x8f, 0xd8, 0x9d, 0x59, 0xed, 0x8c, 0x2d, 0x42, 0x41, 0x49, 0x4d,
+	0x09, 0xff, 0x40, 0x24, 0x68, 0xe1, 0x4f, 0xa0, 0x48, 0x19, 0x85, 0x86, 0x2a, 0xa0, 0xe4, 0xbf,
+	0xa0, 0x42, 0xde, 0x1d, 0xff, 0x58, 0xe3, 0x28, 0xa6, 0xf0, 0x8a, 0xca, 0x9e, 0x37, 0x6f, 0xde,
+	0xfb, 0xbe, 0xcf, 0x6f, 0xc6, 0x1f, 0xfc, 0xcf, 0x46, 0xae, 0x87, 0x68, 0x93, 0x99, 0xa4, 0x43,
+	0x98, 0x14, 0x86, 0xe7, 0x73, 0xc9, 0xd3, 0x4b, 0x92, 0x30, 0x4c, 0x7c, 0x97, 0x32, 0x69, 0x08,
+	0x8f, 0x19, 0xbd, 0xac, 0x5c, 0xa6, 0xc9, 0x9b, 0x3c, 0xc8, 0x31, 0xbb, 0xdf, 0xc2, 0xf4, 0x9c,
+	0x66, 0x73, 0xe1, 0x72, 0x61, 0x36, 0x90, 0x20, 0x66, 0x67, 0xbd, 0x41, 0x24, 0x5a, 0x37, 0x6d,
+	0x4e, 0x99, 0xda, 0x5f, 0x0e, 0xf7, 0x0f, 0xc2, 0x83, 0xe1, 0x42, 0x6d, 0xfd, 0xdf, 0x07, 0xd0,
+	0x21, 0x42, 0x52, 0xd6, 0x54, 0xf1, 0x3b, 0x83, 0x38, 0x6f, 0xdb, 0x2d, 0xe2, 0x1f, 0x48, 0x1f,
+	0x31, 0x71, 0x48, 0xfc, 0x03, 0x8f, 0x3b, 0xd4, 0x3e, 0x0a, 0xf3, 0xf4, 0x63, 0x00, 0x33, 0x0f,
+	0xba, 0xd0, 0x37, 0x55, 0xfe, 0xa6, 0x4f, 0x90, 0x24, 0x38, 0xad, 0x41, 0xd8, 0x2b, 0x51, 0xab,
+	0x66, 0x41, 0x01, 0x14, 0x93, 0xd6, 0x50, 0x24, 0xfd, 0x08, 0xa6, 0x6d, 0xce, 0x7d, 0x4c, 0x19,
+	0x92, 0xdc, 0x2f, 0x63, 0xec, 0x13, 0x21, 0xb2, 0x33, 0x05, 0x50, 0x4c, 0x55, 0xb2, 0x67, 0x1f,
+	0xd7, 0x32, 0x0a, 0xa6, 0xda, 0xa9, 0x4b, 0x9f, 0xb2, 0xa6, 0x35, 0xe6, 0x4c, 0xfa, 0x36, 0xfc,
+	0x7b, 0x28, 0x5a, 0xab, 0x66, 0x67, 0x83, 0x66, 0xd1, 0xa0, 0xbe, 0x03, 0x97, 0xa2, 0x38, 0x5b,
+	0x88, 0xb2, 0x32, 0xc6, 0x13, 0x40, 0xcd, 0xc1, 0xbf, 0x1c, 0xd4, 0x66, 0x76, 0xab, 0x56, 0x0d,
+	0x00, 0x26, 0xad, 0xfe, 0x5a, 0xff, 0x04, 0x60, 0x36, 0x52, 0xb7, 0xc6, 0x0e, 0xf9, 0x8e, 0x87,
+	0x63, 0xd6, 0x40, 0x87, 0x0b, 0xbd, 0xba, 0x8f, 0x91, 0x4b, 0x02, 0x09, 0x52, 0x56, 0x24, 0xd6,
+	0xa5, 0xe1, 0x12, 0x89, 0x30, 0x92, 0x28, 0x9b, 0x2c, 0x80, 0xe2, 0x82, 0xd5, 0x5f, 0xeb, 0xaf,
+	0x66, 0x60, 0x2e, 0x42, 0xa3, 0xde, 0x42, 0x3e, 0x11, 0xf1, 0x13, 0x79, 0x09, 0xff, 0x41, 0x8e,
+	0xc3, 0xed, 0x6e, 0xdb, 0x10, 0x43, 0x76, 0xb6, 0x30, 0x5b, 0x9c, 0x2f, 0x2d, 0x1b, 0xaa, 0x46,
+	0x77, 0xc8, 0x0d, 0x35, 0xe4, 0xc6, 0x26, 0xa7, 0xac, 0x72, 0xff, 0xe4, 0x3c, 0x9f, 0xf8, 0x7e,
+	0x9e, 0xbf, 0xdb, 0xa4, 0xb2, 0xd5, 0x6e, 0x18, 0x36, 0x77, 0xd5, 0x90, 0xab, 0x8f, 0x35, 0x81,
+	0x9f, 0x99, 0xf2, 0xc8, 0x23, 0x22, 0x38, 0xf0, 0xfe, 0x6b, 0x7e, 0x2e, 0xac, 0x6d, 0x8d, 0x36,
+	0xd3, 0x8f, 0x67, 0x60, 0x3e, 0x22, 0xc4, 0x36, 0x97, 0xc8, 0xa9, 0xb7, 0x3d, 0xcf, 0x39, 0x8a,
+	0x5f, 0x8d, 0x37, 0x00, 0xce, 0xcb, 0x01, 0x80, 0xeb, 0xa5, 0xd8, 0xff, 0x75, 0x29, 0x8a, 0x13,
+	0xa6, 0x0a, 0x6b, 0x18, 0x8a, 0xfe, 0x0e, 0x8c, 0x08, 0xb5, 0x85, 0x28, 0x63, 0x44, 0xd6, 0x18,
+	0x95, 0x14, 0x39, 0xf4, 0x45, 0xac, 0x42, 0xad, 0xc0, 0x94, 0xab, 0xfa, 0xf7, 0xee, 0xff, 0x20,
+	0xa0, 0x7f, 0x00, 0x70, 0x79, 0x1c, 0x56, 0x8b, 0x08, 0x22, 0x63, 0x44, 0xb9, 0x0a, 0xff, 0x45,
+	0xbe, 0xdd, 0xa2, 0x1d, 0x82, 0xb7, 0x46, 0xd0, 0xfe, 0xbc, 0xa1, 0x9f, 0x03, 0x75, 0x27, 0x55,
+	0xa8, 0x6c, 0xdb, 0xbc, 0xcd, 0xe4, 0xa4, 0x0f, 0x6c, 0x09, 0xfe, 0x89, 0x26, 0xc4, 0xda, 0x4b,
+	0x4c, 0x3b, 0x70, 0x4e, 0x4c, 0xff, 0xd2, 0xa9, 0x1e, 0x57, 0x11, 0x9c, 0xf4, 0x9a, 0xfd, 0xfe,
+	0x04, 0xbd, 0xb1, 0xfc, 0x2c, 0xe2, 0xf2, 0xce, 0x74, 0xf8, 0xe9, 0x9f, 0x01, 0xbc, 0x35, 0xdc,
+	0x72, 0x37, 0xfc, 0x53, 0x8f, 0x61, 0x74, 0xf6, 0xe0, 0xa2, 0x72, 0x10, 0x4f, 0x3c, 0x49, 0x39,
+	0x13, 0xc1, 0x60, 0xcf, 0x97, 0x56, 0x8d, 0x2b, 0xbc, 0x8c, 0x11, 0x88, 0xb5, 0x1b, 0x39, 0x53,
+	0x49, 0x76, 0x45, 0xb7, 0x46, 0x2a, 0x5d, 0xc3, 0x6a, 0x9a, 0xf3, 0x32, 0x4d, 0x56, 0xfb, 0xf0,
+	0x46, 0xe4, 0x51, 0x2a, 0xb7, 0xed, 0xee, 0xc6, 0xa4, 0x3f, 0xd2, 0x0a, 0x4c, 0xa1, 0xf0, 0x44,
+	0xdf, 0x96, 0x0c, 0x02, 0xfa, 0x19, 0x80, 0x37, 0x83, 0xea, 0xbb, 0xa1, 0x7d, 0xdb, 0x56, 0xee,
+	0xed, 0x69, 0x60, 0xde, 0xea, 0xb1, 0x3e, 0x7b, 0x0f, 0x61, 0xd2, 0xe5, 0x38, 0x34, 0x25, 0x8b,
+	0xa5, 0x8d, 0x2b, 0xa5, 0x1b, 0x0b, 0xd5, 0xd8, 0xe2, 0x98, 0x58, 0x41, 0x01, 0xfd, 0x2d, 0x18,
+	0x31, 0x71, 0x65, 0xea, 0x63, 0x9f, 0x7b, 0xf1, 0xd2, 0xc9, 0xc0, 0x3f, 0x30, 0x61, 0xdc, 0x55,
+	0x26, 0x2b, 0x5c, 0x54, 0xaa, 0x27, 0x17, 0x1a, 0x38, 0xbd, 0xd0, 0xc0, 0xb7, 0x0b, 0x0d, 0xbc,
+	0xbe, 0xd4, 0x12, 0xa7, 0x97, 0x5a, 0xe2, 0xcb, 0xa5, 0x96, 0xd8, 0xbb, 0x37, 0xf4, 0x80, 0x0c,
+	0xa8, 0x9b, 0xc2, 0x63, 0xe6, 0x73, 0xb3, 0x6f, 0xb3, 0x83, 0x87, 0xa4, 0x31, 0x17, 0xb8, 0xea,
+	0x8d, 0x1f, 0x03, 0x00, 0x47, 0xf0, 0x4f, 0xf5, 0x18, 0x0c, 0x00, 0x00,
 }
 
 func (m *EventCampaignCreated) Marshal() (dAtA []byte, err error) {
@@ -1052,6 +1242,46 @@ func (m *EventCampaignMainnetInitialized) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
+func (m *EventCampaignMainnetReset) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCampaignMainnetReset) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCampaignMainnetReset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ArchivedMainnetID != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ArchivedMainnetID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CoordinatorAddress) > 0 {
+		i -= len(m.CoordinatorAddress)
+		copy(dAtA[i:], m.CoordinatorAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CoordinatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CampaignID != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.CampaignID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventMainnetAccountCreated) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1308,6 +1538,88 @@ func (m *EventCampaignAuctionCreated) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *EventVoucherTransferPolicySet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventVoucherTransferPolicySet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventVoucherTransferPolicySet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Mode != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CoordinatorAddress) > 0 {
+		i -= len(m.CoordinatorAddress)
+		copy(dAtA[i:], m.CoordinatorAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CoordinatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CampaignID != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.CampaignID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCampaignAirdropSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCampaignAirdropSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCampaignAirdropSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CoordinatorAddress) > 0 {
+		i -= len(m.CoordinatorAddress)
+		copy(dAtA[i:], m.CoordinatorAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CoordinatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CampaignID != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.CampaignID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -1440,6 +1752,25 @@ func (m *EventCampaignMainnetInitialized) Size() (n int) {
 	return n
 }
 
+func (m *EventCampaignMainnetReset) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CampaignID != 0 {
+		n += 1 + sovEvents(uint64(m.CampaignID))
+	}
+	l = len(m.CoordinatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ArchivedMainnetID != 0 {
+		n += 1 + sovEvents(uint64(m.ArchivedMainnetID))
+	}
+	return n
+}
+
 func (m *EventMainnetAccountCreated) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1551,12 +1882,51 @@ func (m *EventCampaignAuctionCreated) Size() (n int) {
 	return n
 }
 
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
+func (m *EventVoucherTransferPolicySet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CampaignID != 0 {
+		n += 1 + sovEvents(uint64(m.CampaignID))
+	}
+	l = len(m.CoordinatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Mode != 0 {
+		n += 1 + sovEvents(uint64(m.Mode))
+	}
+	return n
+}
+
+func (m *EventCampaignAirdropSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CampaignID != 0 {
+		n += 1 + sovEvents(uint64(m.CampaignID))
+	}
+	l = len(m.CoordinatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
 func (m *EventCampaignCreated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2322,6 +2692,126 @@ func (m *EventCampaignMainnetInitialized) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventCampaignMainnetReset) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCampaignMainnetReset: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCampaignMainnetReset: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignID", wireType)
+			}
+			m.CampaignID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoordinatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CoordinatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchivedMainnetID", wireType)
+			}
+			m.ArchivedMainnetID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ArchivedMainnetID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EventMainnetAccountCreated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3049,6 +3539,259 @@ func (m *EventCampaignAuctionCreated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventVoucherTransferPolicySet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventVoucherTransferPolicySet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventVoucherTransferPolicySet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignID", wireType)
+			}
+			m.CampaignID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoordinatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CoordinatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= VoucherTransferPolicy_Mode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCampaignAirdropSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCampaignAirdropSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCampaignAirdropSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignID", wireType)
+			}
+			m.CampaignID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoordinatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CoordinatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
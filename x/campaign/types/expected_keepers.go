@@ -1,8 +1,11 @@
 package types
 
 import (
+	"context"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	profiletypes "github.com/tendermint/spn/x/profile/types"
 )
@@ -16,12 +19,21 @@ type BankKeeper interface {
 	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 	IterateAllBalances(ctx sdk.Context, cb func(sdk.AccAddress, sdk.Coin) bool)
 	IterateAccountBalances(ctx sdk.Context, addr sdk.AccAddress, cb func(sdk.Coin) bool)
+	DenomOwners(goCtx context.Context, req *banktypes.QueryDenomOwnersRequest) (*banktypes.QueryDenomOwnersResponse, error)
 }
 
 type ProfileKeeper interface {
 	GetAllCoordinator(ctx sdk.Context) []profiletypes.Coordinator
 	GetCoordinator(ctx sdk.Context, id uint64) (val profiletypes.Coordinator, found bool)
 	CoordinatorIDFromAddress(ctx sdk.Context, address string) (id uint64, err error)
+	CheckCoordinatorPermission(
+		ctx sdk.Context,
+		address string,
+		coordinatorID uint64,
+		permission profiletypes.CoordinatorPermission,
+	) error
+	IncrementCampaignsCreated(ctx sdk.Context, coordinatorID uint64)
+	IncrementMainnetsInitialized(ctx sdk.Context, coordinatorID uint64)
 }
 
 type AccountKeeper interface {
@@ -31,3 +43,7 @@ type AccountKeeper interface {
 type DistributionKeeper interface {
 	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
 }
+
+type RewardKeeper interface {
+	RefundRewardPool(ctx sdk.Context, launchID uint64) error
+}
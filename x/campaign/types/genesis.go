@@ -9,12 +9,16 @@ import (
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		CampaignList:       []Campaign{},
-		CampaignCounter:    1,
-		CampaignChainsList: []CampaignChains{},
-		MainnetAccountList: []MainnetAccount{},
-		Params:             DefaultParams(),
-		TotalShares:        spntypes.TotalShareNumber,
+		CampaignList:              []Campaign{},
+		CampaignCounter:           1,
+		CampaignChainsList:        []CampaignChains{},
+		MainnetAccountList:        []MainnetAccount{},
+		MainnetVestingAccountList: []MainnetVestingAccount{},
+		CampaignAirdropList:       []CampaignAirdrop{},
+		VoucherTransferPolicyList: []VoucherTransferPolicy{},
+		ShareLedgerList:           []ShareLedgerEntry{},
+		Params:                    DefaultParams(),
+		TotalShares:               spntypes.TotalShareNumber,
 		// this line is used by starport scaffolding # genesis/types/default
 	}
 }
@@ -65,6 +69,77 @@ func (gs GenesisState) Validate() error {
 		mainnetAccountIndexMap[index] = struct{}{}
 	}
 
+	// Check for duplicated index in mainnetVestingAccount
+	// an address can't be both a mainnet account and a mainnet vesting account of a campaign
+	mainnetVestingAccountIndexMap := make(map[string]struct{})
+	for _, elem := range gs.MainnetVestingAccountList {
+		if _, ok := campaignIDMap[elem.CampaignID]; !ok {
+			return fmt.Errorf("campaign id %d doesn't exist for mainnet vesting account %s",
+				elem.CampaignID, elem.Address)
+		}
+		index := string(AccountKeyPath(elem.CampaignID, elem.Address))
+		if _, ok := mainnetVestingAccountIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for mainnetVestingAccount")
+		}
+		if _, ok := mainnetAccountIndexMap[index]; ok {
+			return fmt.Errorf("mainnet vesting account %s is also a mainnet account of the campaign %d",
+				elem.Address, elem.CampaignID)
+		}
+		mainnetVestingAccountIndexMap[index] = struct{}{}
+		if err := elem.VestingOptions.Validate(); err != nil {
+			return fmt.Errorf("invalid vesting options for mainnet vesting account %s: %s", elem.Address, err.Error())
+		}
+	}
+
+	// Check for duplicated index in campaignAirdrop
+	campaignAirdropIndexMap := make(map[string]struct{})
+	for _, elem := range gs.CampaignAirdropList {
+		if _, ok := campaignIDMap[elem.CampaignID]; !ok {
+			return fmt.Errorf("campaign id %d doesn't exist for airdrop", elem.CampaignID)
+		}
+		index := string(CampaignAirdropKey(elem.CampaignID))
+		if _, ok := campaignAirdropIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for campaignAirdrop")
+		}
+		campaignAirdropIndexMap[index] = struct{}{}
+		if err := elem.Validate(); err != nil {
+			return fmt.Errorf("invalid airdrop for campaign %d: %s", elem.CampaignID, err.Error())
+		}
+	}
+
+	// Check for duplicated index in voucherTransferPolicy
+	voucherTransferPolicyIndexMap := make(map[string]struct{})
+	for _, elem := range gs.VoucherTransferPolicyList {
+		if _, ok := campaignIDMap[elem.CampaignID]; !ok {
+			return fmt.Errorf("campaign id %d doesn't exist for voucher transfer policy", elem.CampaignID)
+		}
+		index := string(VoucherTransferPolicyKey(elem.CampaignID))
+		if _, ok := voucherTransferPolicyIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for voucherTransferPolicy")
+		}
+		voucherTransferPolicyIndexMap[index] = struct{}{}
+		if err := elem.Validate(); err != nil {
+			return fmt.Errorf("invalid voucher transfer policy for campaign %d: %s", elem.CampaignID, err.Error())
+		}
+	}
+
+	// Check for duplicated index in shareLedger
+	shareLedgerIndexMap := make(map[string]struct{})
+	for _, elem := range gs.ShareLedgerList {
+		if _, ok := campaignIDMap[elem.CampaignID]; !ok {
+			return fmt.Errorf("campaign id %d doesn't exist for share ledger entry %d", elem.CampaignID, elem.LedgerID)
+		}
+		// the ledger ID is unique within the ledger of a campaign
+		index := fmt.Sprintf("%d/%d", elem.CampaignID, elem.LedgerID)
+		if _, ok := shareLedgerIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for shareLedger")
+		}
+		shareLedgerIndexMap[index] = struct{}{}
+		if err := elem.Validate(); err != nil {
+			return fmt.Errorf("invalid share ledger entry %d for campaign %d: %s", elem.LedgerID, elem.CampaignID, err.Error())
+		}
+	}
+
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.ValidateBasic()
@@ -25,12 +25,16 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the campaign module's genesis state.
 type GenesisState struct {
-	CampaignList       []Campaign       `protobuf:"bytes,1,rep,name=campaignList,proto3" json:"campaignList"`
-	CampaignCounter    uint64           `protobuf:"varint,2,opt,name=campaignCounter,proto3" json:"campaignCounter,omitempty"`
-	CampaignChainsList []CampaignChains `protobuf:"bytes,3,rep,name=campaignChainsList,proto3" json:"campaignChainsList"`
-	MainnetAccountList []MainnetAccount `protobuf:"bytes,4,rep,name=mainnetAccountList,proto3" json:"mainnetAccountList"`
-	TotalShares        uint64           `protobuf:"varint,5,opt,name=totalShares,proto3" json:"totalShares,omitempty"`
-	Params             Params           `protobuf:"bytes,6,opt,name=params,proto3" json:"params"`
+	CampaignList              []Campaign              `protobuf:"bytes,1,rep,name=campaignList,proto3" json:"campaignList"`
+	CampaignCounter           uint64                  `protobuf:"varint,2,opt,name=campaignCounter,proto3" json:"campaignCounter,omitempty"`
+	CampaignChainsList        []CampaignChains        `protobuf:"bytes,3,rep,name=campaignChainsList,proto3" json:"campaignChainsList"`
+	MainnetAccountList        []MainnetAccount        `protobuf:"bytes,4,rep,name=mainnetAccountList,proto3" json:"mainnetAccountList"`
+	TotalShares               uint64                  `protobuf:"varint,5,opt,name=totalShares,proto3" json:"totalShares,omitempty"`
+	Params                    Params                  `protobuf:"bytes,6,opt,name=params,proto3" json:"params"`
+	CampaignAirdropList       []CampaignAirdrop       `protobuf:"bytes,7,rep,name=campaignAirdropList,proto3" json:"campaignAirdropList"`
+	VoucherTransferPolicyList []VoucherTransferPolicy `protobuf:"bytes,8,rep,name=voucherTransferPolicyList,proto3" json:"voucherTransferPolicyList"`
+	ShareLedgerList           []ShareLedgerEntry      `protobuf:"bytes,9,rep,name=shareLedgerList,proto3" json:"shareLedgerList"`
+	MainnetVestingAccountList []MainnetVestingAccount `protobuf:"bytes,10,rep,name=mainnetVestingAccountList,proto3" json:"mainnetVestingAccountList"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -108,6 +112,34 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetCampaignAirdropList() []CampaignAirdrop {
+	if m != nil {
+		return m.CampaignAirdropList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVoucherTransferPolicyList() []VoucherTransferPolicy {
+	if m != nil {
+		return m.VoucherTransferPolicyList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetShareLedgerList() []ShareLedgerEntry {
+	if m != nil {
+		return m.ShareLedgerList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMainnetVestingAccountList() []MainnetVestingAccount {
+	if m != nil {
+		return m.MainnetVestingAccountList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "tendermint.spn.campaign.GenesisState")
 }
@@ -115,30 +147,38 @@ func init() {
 func init() { proto.RegisterFile("campaign/genesis.proto", fileDescriptor_34fad1c9ee281f6a) }
 
 var fileDescriptor_34fad1c9ee281f6a = []byte{
-	// 355 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x41, 0x4f, 0xfa, 0x30,
-	0x18, 0xc6, 0xb7, 0x3f, 0xfc, 0x39, 0x14, 0x12, 0x93, 0x46, 0x65, 0xe1, 0x50, 0xa6, 0x17, 0x17,
-	0x0f, 0x5b, 0x82, 0x67, 0x0f, 0x82, 0x89, 0x07, 0x35, 0x31, 0x70, 0x33, 0x31, 0xa4, 0xcc, 0x66,
-	0x34, 0x71, 0x6d, 0xb3, 0xbe, 0x18, 0xfd, 0x16, 0x7e, 0x08, 0x3f, 0x0c, 0x47, 0x8e, 0x9e, 0x8c,
-	0x81, 0x2f, 0x62, 0xe8, 0xba, 0x21, 0x22, 0xe1, 0xf6, 0xee, 0xed, 0xf3, 0xfc, 0x9e, 0xbe, 0xef,
-	0x8a, 0x0e, 0x63, 0x9a, 0x2a, 0xca, 0x13, 0x11, 0x25, 0x4c, 0x30, 0xcd, 0x75, 0xa8, 0x32, 0x09,
-	0x12, 0x37, 0x81, 0x89, 0x47, 0x96, 0xa5, 0x5c, 0x40, 0xa8, 0x95, 0x08, 0x0b, 0x59, 0x6b, 0x3f,
-	0x91, 0x89, 0x34, 0x9a, 0x68, 0x59, 0xe5, 0xf2, 0x16, 0x29, 0x31, 0x45, 0x31, 0x8c, 0xc7, 0x94,
-	0x0b, 0x8b, 0x6b, 0xad, 0x62, 0x9e, 0x99, 0x06, 0x2e, 0x12, 0xdb, 0x6f, 0x6e, 0xf8, 0x36, 0x80,
-	0x29, 0xe5, 0x42, 0x30, 0x18, 0xd2, 0x38, 0x96, 0x13, 0x01, 0xf6, 0xfc, 0xa0, 0x3c, 0x57, 0x34,
-	0xa3, 0xa9, 0xcd, 0x39, 0x7e, 0xaf, 0xa0, 0xc6, 0x55, 0x3e, 0xc8, 0x00, 0x28, 0x30, 0x7c, 0x8d,
-	0x1a, 0x85, 0xf2, 0x86, 0x6b, 0xf0, 0x5c, 0xbf, 0x12, 0xd4, 0x3b, 0x47, 0xe1, 0x96, 0xf1, 0xc2,
-	0x9e, 0x2d, 0xba, 0xd5, 0xe9, 0x67, 0xdb, 0xe9, 0xaf, 0x99, 0x71, 0x80, 0xf6, 0x8a, 0xef, 0xde,
-	0xf2, 0x2e, 0x2c, 0xf3, 0xfe, 0xf9, 0x6e, 0x50, 0xed, 0xff, 0x6e, 0xe3, 0x07, 0x84, 0xcb, 0x96,
-	0xd9, 0x83, 0x09, 0xaf, 0x98, 0xf0, 0x93, 0x9d, 0xe1, 0xb9, 0xc5, 0x5e, 0xe1, 0x0f, 0xd0, 0x12,
-	0x6f, 0xd7, 0x72, 0x91, 0x6f, 0xc5, 0xe0, 0xab, 0x3b, 0xf0, 0xb7, 0x6b, 0x96, 0x02, 0xbf, 0x09,
-	0xc2, 0x3e, 0xaa, 0x83, 0x04, 0xfa, 0x34, 0x18, 0xd3, 0x8c, 0x69, 0xef, 0xbf, 0x99, 0xf1, 0x67,
-	0x0b, 0x9f, 0xa3, 0x5a, 0xbe, 0x77, 0xaf, 0xe6, 0xbb, 0x41, 0xbd, 0xd3, 0xde, 0x1a, 0x7a, 0x67,
-	0x64, 0x36, 0xcc, 0x9a, 0xba, 0x97, 0xd3, 0x39, 0x71, 0x67, 0x73, 0xe2, 0x7e, 0xcd, 0x89, 0xfb,
-	0xb6, 0x20, 0xce, 0x6c, 0x41, 0x9c, 0x8f, 0x05, 0x71, 0xee, 0x4f, 0x13, 0x0e, 0xe3, 0xc9, 0x28,
-	0x8c, 0x65, 0x1a, 0xad, 0x90, 0x91, 0x56, 0x22, 0x7a, 0x29, 0xdf, 0x48, 0x04, 0xaf, 0x8a, 0xe9,
-	0x51, 0xcd, 0xfc, 0xf3, 0xb3, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x76, 0xa2, 0x55, 0x28, 0xc4,
-	0x02, 0x00, 0x00,
+	// 494 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0x1b, 0x56, 0x0a, 0xb8, 0x93, 0x26, 0x99, 0x3f, 0x2b, 0x45, 0x4a, 0x0b, 0x07, 0x08,
+	0x1c, 0x12, 0x69, 0x9c, 0x39, 0x6c, 0x03, 0x71, 0x60, 0x93, 0xa6, 0x15, 0x4d, 0x02, 0x09, 0x05,
+	0x2f, 0x35, 0xa9, 0xa5, 0xc6, 0x8e, 0x6c, 0x17, 0xb1, 0x6f, 0xc1, 0xc7, 0x1a, 0xb7, 0x1d, 0x39,
+	0x21, 0xd4, 0x7e, 0x91, 0x29, 0x6f, 0x5e, 0xa7, 0x5b, 0xdb, 0x28, 0xb7, 0xc4, 0x7e, 0x9e, 0xe7,
+	0xe7, 0xf7, 0xf5, 0x6b, 0xf2, 0x24, 0x61, 0x59, 0xce, 0x44, 0x2a, 0xa3, 0x94, 0x4b, 0x6e, 0x84,
+	0x09, 0x73, 0xad, 0xac, 0xa2, 0xbb, 0x96, 0xcb, 0x31, 0xd7, 0x99, 0x90, 0x36, 0x34, 0xb9, 0x0c,
+	0x9d, 0xac, 0xff, 0x28, 0x55, 0xa9, 0x02, 0x4d, 0x54, 0x7c, 0x95, 0xf2, 0xbe, 0x5f, 0xc5, 0xb8,
+	0x8f, 0x38, 0x99, 0x30, 0x21, 0x31, 0xae, 0xbf, 0xc4, 0xfc, 0xe4, 0xc6, 0x0a, 0x99, 0xe2, 0xfa,
+	0xee, 0x9a, 0x6f, 0x2d, 0x30, 0x63, 0x42, 0x4a, 0x6e, 0x63, 0x96, 0x24, 0x6a, 0x26, 0x2d, 0xee,
+	0x3f, 0xae, 0xf6, 0x73, 0xa6, 0x59, 0xe6, 0x38, 0x83, 0xf5, 0x73, 0x30, 0xa1, 0xc7, 0x5a, 0xe5,
+	0x28, 0x78, 0xb9, 0x3c, 0x88, 0x9a, 0x25, 0x13, 0xae, 0x63, 0xab, 0x99, 0x34, 0x3f, 0xb8, 0x8e,
+	0x73, 0x35, 0x15, 0xc9, 0x05, 0xea, 0x9e, 0x55, 0x3a, 0x33, 0x61, 0x9a, 0xc7, 0x53, 0x3e, 0x4e,
+	0xb9, 0x2e, 0x37, 0x5f, 0xfc, 0xe9, 0x90, 0xed, 0x8f, 0x65, 0xbb, 0x46, 0x96, 0x59, 0x4e, 0x3f,
+	0x91, 0x6d, 0xa7, 0x3f, 0x12, 0xc6, 0xf6, 0xbc, 0xe1, 0x56, 0xd0, 0xdd, 0x7b, 0x1e, 0xd6, 0x34,
+	0x31, 0x3c, 0xc4, 0x8f, 0x83, 0xf6, 0xe5, 0xbf, 0x41, 0xeb, 0xf4, 0x96, 0x99, 0x06, 0x64, 0xc7,
+	0xfd, 0x1f, 0x16, 0x15, 0x73, 0xdd, 0xbb, 0x33, 0xf4, 0x82, 0xf6, 0xe9, 0xea, 0x32, 0xfd, 0x46,
+	0x68, 0xb5, 0x04, 0xdd, 0x06, 0xf8, 0x16, 0xc0, 0x5f, 0x35, 0xc2, 0x4b, 0x0b, 0x1e, 0x61, 0x43,
+	0x50, 0x11, 0x8f, 0xcd, 0xdf, 0x2f, 0x7b, 0x0f, 0xf1, 0xed, 0x86, 0xf8, 0xe3, 0x5b, 0x16, 0x17,
+	0xbf, 0x1e, 0x44, 0x87, 0xa4, 0x6b, 0x95, 0x65, 0xd3, 0x51, 0xd1, 0x60, 0xd3, 0xbb, 0x0b, 0x35,
+	0xde, 0x5c, 0xa2, 0xef, 0x48, 0xa7, 0xbc, 0xdd, 0x5e, 0x67, 0xe8, 0x05, 0xdd, 0xbd, 0x41, 0x2d,
+	0xf4, 0x04, 0x64, 0x08, 0x43, 0x13, 0xfd, 0x4e, 0x1e, 0x3a, 0xc1, 0x7e, 0x39, 0x04, 0x50, 0xc0,
+	0x3d, 0x28, 0x20, 0x68, 0xec, 0x0f, 0x7a, 0x30, 0x74, 0x53, 0x14, 0xd5, 0xe4, 0x29, 0x8e, 0xd1,
+	0x67, 0x9c, 0xa2, 0x13, 0x18, 0x22, 0xe0, 0xdc, 0x07, 0x4e, 0x58, 0xcb, 0x39, 0xdb, 0xe4, 0x44,
+	0x5a, 0x7d, 0x2c, 0xfd, 0x42, 0x76, 0x60, 0x24, 0x8f, 0x60, 0x22, 0x81, 0xf4, 0x00, 0x48, 0xaf,
+	0x6b, 0x49, 0xa3, 0xa5, 0xfe, 0x83, 0xb4, 0xda, 0x41, 0x56, 0x73, 0x8a, 0x72, 0xf0, 0x9e, 0xce,
+	0xca, 0x57, 0x7a, 0xf3, 0xde, 0x49, 0x43, 0x39, 0xc7, 0x9b, 0x9c, 0xae, 0x9c, 0xda, 0xd8, 0x83,
+	0xf7, 0x97, 0x73, 0xdf, 0xbb, 0x9a, 0xfb, 0xde, 0xff, 0xb9, 0xef, 0xfd, 0x5e, 0xf8, 0xad, 0xab,
+	0x85, 0xdf, 0xfa, 0xbb, 0xf0, 0x5b, 0x5f, 0xdf, 0xa4, 0xc2, 0x4e, 0x66, 0xe7, 0x61, 0xa2, 0xb2,
+	0x68, 0x09, 0x8d, 0x4c, 0x2e, 0xa3, 0x5f, 0xd5, 0xf3, 0x8e, 0xec, 0x45, 0xce, 0xcd, 0x79, 0x07,
+	0x1e, 0xe6, 0xdb, 0xeb, 0x01, 0x00, 0xf7, 0xfa, 0x2b, 0x4e, 0xcf, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -161,6 +201,62 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MainnetVestingAccountList) > 0 {
+		for iNdEx := len(m.MainnetVestingAccountList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MainnetVestingAccountList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.ShareLedgerList) > 0 {
+		for iNdEx := len(m.ShareLedgerList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ShareLedgerList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.VoucherTransferPolicyList) > 0 {
+		for iNdEx := len(m.VoucherTransferPolicyList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VoucherTransferPolicyList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.CampaignAirdropList) > 0 {
+		for iNdEx := len(m.CampaignAirdropList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CampaignAirdropList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -269,6 +365,30 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.CampaignAirdropList) > 0 {
+		for _, e := range m.CampaignAirdropList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VoucherTransferPolicyList) > 0 {
+		for _, e := range m.VoucherTransferPolicyList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ShareLedgerList) > 0 {
+		for _, e := range m.ShareLedgerList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MainnetVestingAccountList) > 0 {
+		for _, e := range m.MainnetVestingAccountList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -480,6 +600,142 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignAirdropList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CampaignAirdropList = append(m.CampaignAirdropList, CampaignAirdrop{})
+			if err := m.CampaignAirdropList[len(m.CampaignAirdropList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoucherTransferPolicyList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoucherTransferPolicyList = append(m.VoucherTransferPolicyList, VoucherTransferPolicy{})
+			if err := m.VoucherTransferPolicyList[len(m.VoucherTransferPolicyList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareLedgerList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShareLedgerList = append(m.ShareLedgerList, ShareLedgerEntry{})
+			if err := m.ShareLedgerList[len(m.ShareLedgerList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MainnetVestingAccountList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MainnetVestingAccountList = append(m.MainnetVestingAccountList, MainnetVestingAccount{})
+			if err := m.MainnetVestingAccountList[len(m.MainnetVestingAccountList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
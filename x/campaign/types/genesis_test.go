@@ -68,6 +68,18 @@ func TestGenesisState_Validate(t *testing.T) {
 						Shares:     shares3,
 					},
 				},
+				CampaignAirdropList: []types.CampaignAirdrop{
+					sample.CampaignAirdrop(r, campaign1.CampaignID),
+				},
+				VoucherTransferPolicyList: []types.VoucherTransferPolicy{
+					sample.VoucherTransferPolicy(r, campaign1.CampaignID),
+					types.NewVoucherTransferPolicy(campaign2.CampaignID, types.VoucherTransferPolicy_NO_IBC, nil),
+				},
+				ShareLedgerList: []types.ShareLedgerEntry{
+					sample.ShareLedgerEntry(r, campaign1.CampaignID, 0),
+					sample.ShareLedgerEntry(r, campaign1.CampaignID, 1),
+					sample.ShareLedgerEntry(r, campaign2.CampaignID, 0),
+				},
 				TotalShares: spntypes.TotalShareNumber,
 				Params:      types.DefaultParams(),
 			},
@@ -196,6 +208,70 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			errorMessage: "duplicated index for mainnetAccount",
 		},
+		{
+			desc: "non existing campaign for mainnet vesting account",
+			genState: &types.GenesisState{
+				CampaignList: []types.Campaign{
+					sample.Campaign(r, 0),
+				},
+				CampaignCounter: 1,
+				MainnetVestingAccountList: []types.MainnetVestingAccount{
+					sample.MainnetVestingAccount(r, 330, "330"),
+				},
+				TotalShares: spntypes.TotalShareNumber,
+			},
+			errorMessage: "campaign id 330 doesn't exist for mainnet vesting account 330",
+		},
+		{
+			desc: "duplicated mainnetVestingAccount",
+			genState: &types.GenesisState{
+				CampaignList: []types.Campaign{
+					sample.Campaign(r, 0),
+				},
+				CampaignCounter: 1,
+				MainnetVestingAccountList: []types.MainnetVestingAccount{
+					sample.MainnetVestingAccount(r, 0, "0"),
+					sample.MainnetVestingAccount(r, 0, "0"),
+				},
+				TotalShares: spntypes.TotalShareNumber,
+			},
+			errorMessage: "duplicated index for mainnetVestingAccount",
+		},
+		{
+			desc: "mainnet vesting account also a mainnet account",
+			genState: &types.GenesisState{
+				CampaignList: []types.Campaign{
+					sample.Campaign(r, 0),
+				},
+				CampaignCounter: 1,
+				MainnetAccountList: []types.MainnetAccount{
+					sample.MainnetAccount(r, 0, "0"),
+				},
+				MainnetVestingAccountList: []types.MainnetVestingAccount{
+					sample.MainnetVestingAccount(r, 0, "0"),
+				},
+				TotalShares: spntypes.TotalShareNumber,
+			},
+			errorMessage: "mainnet vesting account 0 is also a mainnet account of the campaign 0",
+		},
+		{
+			desc: "invalid mainnetVestingAccount",
+			genState: &types.GenesisState{
+				CampaignList: []types.Campaign{
+					sample.Campaign(r, 0),
+				},
+				CampaignCounter: 1,
+				MainnetVestingAccountList: []types.MainnetVestingAccount{
+					{
+						CampaignID:     0,
+						Address:        "0",
+						VestingOptions: types.ShareVestingOptions{},
+					},
+				},
+				TotalShares: spntypes.TotalShareNumber,
+			},
+			errorMessage: "invalid vesting options for mainnet vesting account 0: unrecognized vesting options",
+		},
 		{
 			desc: "invalid allocations",
 			genState: &types.GenesisState{
@@ -222,6 +298,141 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			errorMessage: "invalid campaign 0: more allocated shares than total shares",
 		},
+		{
+			desc: "non existing campaign for airdrop",
+			genState: &types.GenesisState{
+				CampaignList: []types.Campaign{
+					sample.Campaign(r, 0),
+				},
+				CampaignCounter: 1,
+				CampaignAirdropList: []types.CampaignAirdrop{
+					sample.CampaignAirdrop(r, 1),
+				},
+				TotalShares: spntypes.TotalShareNumber,
+			},
+			errorMessage: "campaign id 1 doesn't exist for airdrop",
+		},
+		{
+			desc: "duplicated campaignAirdrop",
+			genState: &types.GenesisState{
+				CampaignList: []types.Campaign{
+					sample.Campaign(r, 0),
+				},
+				CampaignCounter: 1,
+				CampaignAirdropList: []types.CampaignAirdrop{
+					sample.CampaignAirdrop(r, 0),
+					sample.CampaignAirdrop(r, 0),
+				},
+				TotalShares: spntypes.TotalShareNumber,
+			},
+			errorMessage: "duplicated index for campaignAirdrop",
+		},
+		{
+			desc: "invalid campaignAirdrop",
+			genState: &types.GenesisState{
+				CampaignList: []types.Campaign{
+					sample.Campaign(r, 0),
+				},
+				CampaignCounter: 1,
+				CampaignAirdropList: []types.CampaignAirdrop{
+					types.NewCampaignAirdrop(0, "foo", nil, nil),
+				},
+				TotalShares: spntypes.TotalShareNumber,
+			},
+			errorMessage: "invalid airdrop for campaign 0: no mission",
+		},
+		{
+			desc: "non existing campaign for voucher transfer policy",
+			genState: &types.GenesisState{
+				CampaignList: []types.Campaign{
+					sample.Campaign(r, 0),
+				},
+				CampaignCounter: 1,
+				VoucherTransferPolicyList: []types.VoucherTransferPolicy{
+					sample.VoucherTransferPolicy(r, 1),
+				},
+				TotalShares: spntypes.TotalShareNumber,
+			},
+			errorMessage: "campaign id 1 doesn't exist for voucher transfer policy",
+		},
+		{
+			desc: "duplicated voucherTransferPolicy",
+			genState: &types.GenesisState{
+				CampaignList: []types.Campaign{
+					sample.Campaign(r, 0),
+				},
+				CampaignCounter: 1,
+				VoucherTransferPolicyList: []types.VoucherTransferPolicy{
+					sample.VoucherTransferPolicy(r, 0),
+					sample.VoucherTransferPolicy(r, 0),
+				},
+				TotalShares: spntypes.TotalShareNumber,
+			},
+			errorMessage: "duplicated index for voucherTransferPolicy",
+		},
+		{
+			desc: "invalid voucherTransferPolicy",
+			genState: &types.GenesisState{
+				CampaignList: []types.Campaign{
+					sample.Campaign(r, 0),
+				},
+				CampaignCounter: 1,
+				VoucherTransferPolicyList: []types.VoucherTransferPolicy{
+					types.NewVoucherTransferPolicy(0, types.VoucherTransferPolicy_ALLOWLIST, []string{"invalid"}),
+				},
+				TotalShares: spntypes.TotalShareNumber,
+			},
+			errorMessage: "invalid voucher transfer policy for campaign 0: invalid allowlist address invalid: decoding bech32 failed: invalid bech32 string length 7",
+		},
+		{
+			desc: "non existing campaign for share ledger entry",
+			genState: &types.GenesisState{
+				CampaignList: []types.Campaign{
+					sample.Campaign(r, 0),
+				},
+				CampaignCounter: 1,
+				ShareLedgerList: []types.ShareLedgerEntry{
+					sample.ShareLedgerEntry(r, 1, 0),
+				},
+				TotalShares: spntypes.TotalShareNumber,
+			},
+			errorMessage: "campaign id 1 doesn't exist for share ledger entry 0",
+		},
+		{
+			desc: "duplicated shareLedger",
+			genState: &types.GenesisState{
+				CampaignList: []types.Campaign{
+					sample.Campaign(r, 0),
+				},
+				CampaignCounter: 1,
+				ShareLedgerList: []types.ShareLedgerEntry{
+					sample.ShareLedgerEntry(r, 0, 0),
+					sample.ShareLedgerEntry(r, 0, 0),
+				},
+				TotalShares: spntypes.TotalShareNumber,
+			},
+			errorMessage: "duplicated index for shareLedger",
+		},
+		{
+			desc: "invalid shareLedger",
+			genState: &types.GenesisState{
+				CampaignList: []types.Campaign{
+					sample.Campaign(r, 0),
+				},
+				CampaignCounter: 1,
+				ShareLedgerList: []types.ShareLedgerEntry{
+					{
+						CampaignID: 0,
+						LedgerID:   0,
+						Sender:     sample.Address(r),
+						Address:    sample.Address(r),
+						Shares:     types.EmptyShares(),
+					},
+				},
+				TotalShares: spntypes.TotalShareNumber,
+			},
+			errorMessage: "invalid share ledger entry 0 for campaign 0: shares must be valid and not empty",
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -274,14 +485,26 @@ func TestGenesisState_ValidateParams(t *testing.T) {
 		{
 			desc: "max total supply below min total supply",
 			genState: types.GenesisState{
-				Params: types.NewParams(types.DefaultMinTotalSupply, types.DefaultMinTotalSupply.Sub(sdkmath.OneInt()), types.DefaultCampaignCreationFee),
+				Params: types.NewParams(
+					types.DefaultMinTotalSupply,
+					types.DefaultMinTotalSupply.Sub(sdkmath.OneInt()),
+					types.DefaultCampaignCreationFee,
+					types.DefaultStakingMissionID,
+					types.DefaultVotingMissionID,
+				),
 			},
 			shouldBeValid: false,
 		},
 		{
 			desc: "valid parameters",
 			genState: types.GenesisState{
-				Params: types.NewParams(types.DefaultMinTotalSupply, types.DefaultMinTotalSupply.Add(sdkmath.OneInt()), types.DefaultCampaignCreationFee),
+				Params: types.NewParams(
+					types.DefaultMinTotalSupply,
+					types.DefaultMinTotalSupply.Add(sdkmath.OneInt()),
+					types.DefaultCampaignCreationFee,
+					types.DefaultStakingMissionID,
+					types.DefaultVotingMissionID,
+				),
 			},
 			shouldBeValid: true,
 		},
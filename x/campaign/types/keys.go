@@ -33,6 +33,18 @@ const (
 	// MainnetAccountKeyPrefix is the prefix to retrieve all MainnetAccount
 	MainnetAccountKeyPrefix = "MainnetAccount/value/"
 
+	// CampaignAirdropKeyPrefix is the prefix to retrieve all CampaignAirdrop
+	CampaignAirdropKeyPrefix = "CampaignAirdrop/value/"
+
+	// VoucherTransferPolicyKeyPrefix is the prefix to retrieve all VoucherTransferPolicy
+	VoucherTransferPolicyKeyPrefix = "VoucherTransferPolicy/value/"
+
+	// ShareLedgerKeyPrefix is the prefix to retrieve all ShareLedgerEntry
+	ShareLedgerKeyPrefix = "ShareLedger/value/"
+
+	// ShareLedgerCounterKeyPrefix is the prefix to store the ShareLedgerEntry counter of a campaign
+	ShareLedgerCounterKeyPrefix = "ShareLedger/count/"
+
 	// MainnetVestingAccountKeyPrefix is the prefix to retrieve all MainnetVestingAccount
 	MainnetVestingAccountKeyPrefix = "MainnetVestingAccount/value/"
 )
@@ -46,6 +58,37 @@ func CampaignChainsKey(campaignID uint64) []byte {
 	return append(spntypes.UintBytes(campaignID), byte('/'))
 }
 
+// CampaignAirdropKey returns the store key to retrieve a CampaignAirdrop from the index fields
+func CampaignAirdropKey(campaignID uint64) []byte {
+	return append(spntypes.UintBytes(campaignID), byte('/'))
+}
+
+// VoucherTransferPolicyKey returns the store key to retrieve a VoucherTransferPolicy from the index fields
+func VoucherTransferPolicyKey(campaignID uint64) []byte {
+	return append(spntypes.UintBytes(campaignID), byte('/'))
+}
+
+// ShareLedgerKeyPath returns the store key path without prefix for a ShareLedgerEntry
+// The entries of a campaign are sorted by height
+func ShareLedgerKeyPath(campaignID uint64, height int64, ledgerID uint64) []byte {
+	campaignIDBytes := append(spntypes.UintBytes(campaignID), byte('/'))
+	heightBytes := append(spntypes.UintBytes(uint64(height)), byte('/'))
+	ledgerIDBytes := append(spntypes.UintBytes(ledgerID), byte('/'))
+	return append(append(campaignIDBytes, heightBytes...), ledgerIDBytes...)
+}
+
+// ShareLedgerAllKey returns the store key to retrieve all ShareLedgerEntry by campaign id
+func ShareLedgerAllKey(campaignID uint64) []byte {
+	prefixBytes := []byte(ShareLedgerKeyPrefix)
+	campaignIDBytes := append(spntypes.UintBytes(campaignID), byte('/'))
+	return append(prefixBytes, campaignIDBytes...)
+}
+
+// ShareLedgerCounterKey returns the store key to retrieve the ShareLedgerEntry counter of a campaign
+func ShareLedgerCounterKey(campaignID uint64) []byte {
+	return append(spntypes.UintBytes(campaignID), byte('/'))
+}
+
 // AccountKeyPath returns the store key path without prefix for an account defined by a campaign ID and an address
 func AccountKeyPath(campaignID uint64, address string) []byte {
 	campaignIDBytes := append(spntypes.UintBytes(campaignID), byte('/'))
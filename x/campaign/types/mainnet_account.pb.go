@@ -86,6 +86,67 @@ func (m *MainnetAccount) GetShares() Shares {
 	return nil
 }
 
+// MainnetVestingAccount is an account of the mainnet genesis whose shares are vested
+type MainnetVestingAccount struct {
+	CampaignID     uint64              `protobuf:"varint,1,opt,name=campaignID,proto3" json:"campaignID,omitempty"`
+	Address        string              `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	VestingOptions ShareVestingOptions `protobuf:"bytes,3,opt,name=vestingOptions,proto3" json:"vestingOptions"`
+}
+
+func (m *MainnetVestingAccount) Reset()         { *m = MainnetVestingAccount{} }
+func (m *MainnetVestingAccount) String() string { return proto.CompactTextString(m) }
+func (*MainnetVestingAccount) ProtoMessage()    {}
+func (*MainnetVestingAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a87a85fe8b4c45d, []int{1}
+}
+func (m *MainnetVestingAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MainnetVestingAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MainnetVestingAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MainnetVestingAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MainnetVestingAccount.Merge(m, src)
+}
+func (m *MainnetVestingAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *MainnetVestingAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MainnetVestingAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MainnetVestingAccount proto.InternalMessageInfo
+
+func (m *MainnetVestingAccount) GetCampaignID() uint64 {
+	if m != nil {
+		return m.CampaignID
+	}
+	return 0
+}
+
+func (m *MainnetVestingAccount) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MainnetVestingAccount) GetVestingOptions() ShareVestingOptions {
+	if m != nil {
+		return m.VestingOptions
+	}
+	return ShareVestingOptions{}
+}
+
 type MainnetAccountBalance struct {
 	CampaignID uint64                                   `protobuf:"varint,1,opt,name=campaignID,proto3" json:"campaignID,omitempty"`
 	Address    string                                   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
@@ -96,7 +157,7 @@ func (m *MainnetAccountBalance) Reset()         { *m = MainnetAccountBalance{} }
 func (m *MainnetAccountBalance) String() string { return proto.CompactTextString(m) }
 func (*MainnetAccountBalance) ProtoMessage()    {}
 func (*MainnetAccountBalance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0a87a85fe8b4c45d, []int{1}
+	return fileDescriptor_0a87a85fe8b4c45d, []int{2}
 }
 func (m *MainnetAccountBalance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -146,38 +207,150 @@ func (m *MainnetAccountBalance) GetCoins() github_com_cosmos_cosmos_sdk_types.Co
 	return nil
 }
 
+// MainnetGenesisAccounts contains the balances of the mainnet genesis computed from the shares of a campaign
+type MainnetGenesisAccounts struct {
+	CampaignID uint64 `protobuf:"varint,1,opt,name=campaignID,proto3" json:"campaignID,omitempty"`
+	// accounts are the balances of the mainnet accounts sorted by address, the rounding dust is added to the balance of
+	// the dust recipient
+	Accounts            []MainnetAccountBalance                  `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts"`
+	GenesisDistribution github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=genesisDistribution,proto3,casttype=github.com/cosmos/cosmos-sdk/types.Coin,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"genesisDistribution"`
+	ClaimableAirdrop    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=claimableAirdrop,proto3,casttype=github.com/cosmos/cosmos-sdk/types.Coin,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"claimableAirdrop"`
+	// dustRecipient is the address of the campaign coordinator receiving the rounding dust
+	DustRecipient string                                   `protobuf:"bytes,5,opt,name=dustRecipient,proto3" json:"dustRecipient,omitempty"`
+	Dust          github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=dust,proto3,casttype=github.com/cosmos/cosmos-sdk/types.Coin,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"dust"`
+	// vestingAccounts are the total balances of the mainnet vesting accounts sorted by address, the vesting schedules
+	// are given by the vesting accounts of the mainnet chain
+	VestingAccounts []MainnetAccountBalance `protobuf:"bytes,7,rep,name=vestingAccounts,proto3" json:"vestingAccounts"`
+}
+
+func (m *MainnetGenesisAccounts) Reset()         { *m = MainnetGenesisAccounts{} }
+func (m *MainnetGenesisAccounts) String() string { return proto.CompactTextString(m) }
+func (*MainnetGenesisAccounts) ProtoMessage()    {}
+func (*MainnetGenesisAccounts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a87a85fe8b4c45d, []int{3}
+}
+func (m *MainnetGenesisAccounts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MainnetGenesisAccounts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MainnetGenesisAccounts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MainnetGenesisAccounts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MainnetGenesisAccounts.Merge(m, src)
+}
+func (m *MainnetGenesisAccounts) XXX_Size() int {
+	return m.Size()
+}
+func (m *MainnetGenesisAccounts) XXX_DiscardUnknown() {
+	xxx_messageInfo_MainnetGenesisAccounts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MainnetGenesisAccounts proto.InternalMessageInfo
+
+func (m *MainnetGenesisAccounts) GetCampaignID() uint64 {
+	if m != nil {
+		return m.CampaignID
+	}
+	return 0
+}
+
+func (m *MainnetGenesisAccounts) GetAccounts() []MainnetAccountBalance {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+func (m *MainnetGenesisAccounts) GetGenesisDistribution() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.GenesisDistribution
+	}
+	return nil
+}
+
+func (m *MainnetGenesisAccounts) GetClaimableAirdrop() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ClaimableAirdrop
+	}
+	return nil
+}
+
+func (m *MainnetGenesisAccounts) GetDustRecipient() string {
+	if m != nil {
+		return m.DustRecipient
+	}
+	return ""
+}
+
+func (m *MainnetGenesisAccounts) GetDust() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Dust
+	}
+	return nil
+}
+
+func (m *MainnetGenesisAccounts) GetVestingAccounts() []MainnetAccountBalance {
+	if m != nil {
+		return m.VestingAccounts
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MainnetAccount)(nil), "tendermint.spn.campaign.MainnetAccount")
+	proto.RegisterType((*MainnetVestingAccount)(nil), "tendermint.spn.campaign.MainnetVestingAccount")
 	proto.RegisterType((*MainnetAccountBalance)(nil), "tendermint.spn.campaign.MainnetAccountBalance")
+	proto.RegisterType((*MainnetGenesisAccounts)(nil), "tendermint.spn.campaign.MainnetGenesisAccounts")
 }
 
 func init() { proto.RegisterFile("campaign/mainnet_account.proto", fileDescriptor_0a87a85fe8b4c45d) }
 
 var fileDescriptor_0a87a85fe8b4c45d = []byte{
-	// 355 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x52, 0xb1, 0x4e, 0xf3, 0x30,
-	0x18, 0x8c, 0xff, 0xfe, 0x14, 0x61, 0x24, 0x86, 0xa8, 0x88, 0xb4, 0x83, 0x5b, 0x75, 0x21, 0x42,
-	0x6a, 0xac, 0x96, 0x89, 0xb1, 0xa1, 0x0b, 0x03, 0x4b, 0xba, 0xc1, 0x50, 0x39, 0x89, 0x95, 0x5a,
-	0x34, 0x76, 0x14, 0xbb, 0x08, 0xde, 0x81, 0x81, 0xe7, 0x60, 0xe6, 0x21, 0x3a, 0x56, 0x9d, 0x98,
-	0x0a, 0x6a, 0xdf, 0xa2, 0x13, 0x6a, 0xec, 0x42, 0xd9, 0xca, 0xc0, 0x94, 0xd8, 0x77, 0xdf, 0x7d,
-	0x77, 0xd6, 0x41, 0x14, 0x91, 0x34, 0x23, 0x2c, 0xe1, 0x38, 0x25, 0x8c, 0x73, 0xaa, 0x06, 0x24,
-	0x8a, 0xc4, 0x98, 0x2b, 0x2f, 0xcb, 0x85, 0x12, 0xf6, 0x89, 0xa2, 0x3c, 0xa6, 0x79, 0xca, 0xb8,
-	0xf2, 0x64, 0xc6, 0xbd, 0x0d, 0xbd, 0x56, 0x49, 0x44, 0x22, 0x0a, 0x0e, 0x5e, 0xff, 0x69, 0x7a,
-	0x0d, 0x45, 0x42, 0xa6, 0x42, 0xe2, 0x90, 0x48, 0x8a, 0xef, 0xdb, 0x21, 0x55, 0xa4, 0x8d, 0x23,
-	0xc1, 0xb8, 0xc1, 0xab, 0x1a, 0x1f, 0xe8, 0x41, 0x7d, 0xd0, 0x50, 0x73, 0x06, 0xe0, 0xd1, 0xb5,
-	0xf6, 0xd0, 0xd5, 0x16, 0x6c, 0x04, 0xe1, 0x66, 0xdf, 0x55, 0xcf, 0x01, 0x0d, 0xe0, 0xfe, 0x0f,
-	0xb6, 0x6e, 0xec, 0x0e, 0xdc, 0x27, 0x71, 0x9c, 0x53, 0x29, 0x9d, 0x7f, 0x0d, 0xe0, 0x1e, 0xf8,
-	0xce, 0xec, 0xb5, 0x55, 0x31, 0xaa, 0x5d, 0x8d, 0xf4, 0x55, 0xce, 0x78, 0x12, 0x6c, 0x88, 0xf6,
-	0x08, 0x96, 0xe5, 0x90, 0xe4, 0x54, 0x3a, 0xa5, 0x46, 0xc9, 0x3d, 0xec, 0x54, 0x3d, 0xc3, 0x5f,
-	0x5b, 0xf6, 0x8c, 0x65, 0xef, 0x52, 0x30, 0xee, 0x5f, 0x4c, 0xe6, 0x75, 0x6b, 0x35, 0xaf, 0x9f,
-	0x26, 0x4c, 0x0d, 0xc7, 0xa1, 0x17, 0x89, 0xd4, 0x58, 0x36, 0x9f, 0x96, 0x8c, 0xef, 0xb0, 0x7a,
-	0xcc, 0xa8, 0x2c, 0x06, 0x5e, 0xde, 0xeb, 0xe5, 0x7e, 0xa1, 0x1d, 0x98, 0x1d, 0xcd, 0x15, 0x80,
-	0xc7, 0x3f, 0x43, 0xf9, 0x64, 0x44, 0x78, 0x44, 0xff, 0x24, 0xdb, 0x13, 0x80, 0x7b, 0xeb, 0xc7,
-	0xde, 0x21, 0xdb, 0xed, 0xef, 0xb3, 0xb9, 0x3b, 0x52, 0x65, 0xa0, 0x4d, 0xf8, 0xbd, 0xc9, 0x02,
-	0x81, 0xe9, 0x02, 0x81, 0x8f, 0x05, 0x02, 0xcf, 0x4b, 0x64, 0x4d, 0x97, 0xc8, 0x7a, 0x5b, 0x22,
-	0xeb, 0xe6, 0x6c, 0x4b, 0xea, 0xbb, 0x60, 0x58, 0x66, 0x1c, 0x3f, 0xe0, 0xaf, 0x46, 0x16, 0x92,
-	0x61, 0xb9, 0xa8, 0xc7, 0xf9, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0x62, 0x6e, 0x2f, 0xe6, 0xaa,
-	0x02, 0x00, 0x00,
+	// 545 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x94, 0x41, 0x6b, 0xd4, 0x40,
+	0x14, 0xc7, 0x77, 0xec, 0x36, 0xd5, 0x29, 0x56, 0x89, 0xb5, 0xa6, 0x3d, 0x64, 0x97, 0xbd, 0x18,
+	0xc4, 0x4e, 0xe8, 0x7a, 0xf2, 0x22, 0x6c, 0x5c, 0x10, 0x0f, 0xa2, 0xa4, 0xd0, 0x43, 0x05, 0xcb,
+	0x24, 0x19, 0xd2, 0xc1, 0xcd, 0x4c, 0xc8, 0x9b, 0x5d, 0xf4, 0x13, 0x08, 0xe2, 0xc1, 0xcf, 0xe0,
+	0x49, 0x3c, 0xfb, 0x1d, 0xec, 0xb1, 0xf4, 0xe4, 0xa9, 0xca, 0xee, 0xb7, 0xe8, 0x49, 0x92, 0x99,
+	0xdd, 0x76, 0x6b, 0x4b, 0x2b, 0x52, 0xf6, 0x94, 0x64, 0xde, 0x7f, 0xde, 0xfc, 0x7f, 0xef, 0x4d,
+	0x1e, 0x76, 0x63, 0x9a, 0xe5, 0x94, 0xa7, 0xc2, 0xcf, 0x28, 0x17, 0x82, 0xa9, 0x1d, 0x1a, 0xc7,
+	0xb2, 0x2f, 0x14, 0xc9, 0x0b, 0xa9, 0xa4, 0x7d, 0x4f, 0x31, 0x91, 0xb0, 0x22, 0xe3, 0x42, 0x11,
+	0xc8, 0x05, 0x19, 0xcb, 0xd7, 0x96, 0x53, 0x99, 0xca, 0x4a, 0xe3, 0x97, 0x6f, 0x5a, 0xbe, 0xe6,
+	0xc6, 0x12, 0x32, 0x09, 0x7e, 0x44, 0x81, 0xf9, 0x83, 0x8d, 0x88, 0x29, 0xba, 0xe1, 0xc7, 0x92,
+	0x0b, 0x13, 0x5f, 0xd5, 0xf1, 0x1d, 0xbd, 0x51, 0x7f, 0x98, 0xd0, 0xca, 0xc4, 0xc9, 0x80, 0x81,
+	0xe2, 0x22, 0xd5, 0xeb, 0xad, 0x03, 0x84, 0x97, 0x5e, 0x68, 0x6f, 0x1d, 0x6d, 0xcd, 0x76, 0x31,
+	0x1e, 0x8b, 0x9f, 0x77, 0x1d, 0xd4, 0x44, 0x5e, 0x3d, 0x3c, 0xb1, 0x62, 0xb7, 0xf1, 0x02, 0x4d,
+	0x92, 0x82, 0x01, 0x38, 0xd7, 0x9a, 0xc8, 0xbb, 0x11, 0x38, 0x07, 0xdf, 0xd7, 0x97, 0xcd, 0x69,
+	0x1d, 0x1d, 0xd9, 0x54, 0x05, 0x17, 0x69, 0x38, 0x16, 0xda, 0x3d, 0x6c, 0xc1, 0x2e, 0x2d, 0x18,
+	0x38, 0x73, 0xcd, 0x39, 0x6f, 0xb1, 0xbd, 0x4a, 0x8c, 0xbe, 0x44, 0x21, 0x06, 0x85, 0x3c, 0x95,
+	0x5c, 0x04, 0x8f, 0xf7, 0x0e, 0x1b, 0xb5, 0xa3, 0xc3, 0xc6, 0xfd, 0x94, 0xab, 0xdd, 0x7e, 0x44,
+	0x62, 0x99, 0x19, 0x14, 0xf3, 0x58, 0x87, 0xe4, 0xad, 0xaf, 0xde, 0xe7, 0x0c, 0xaa, 0x0d, 0xdf,
+	0x7e, 0x35, 0xac, 0xcd, 0x2a, 0x77, 0x68, 0xce, 0x68, 0xfd, 0x40, 0xf8, 0xae, 0x81, 0xda, 0xd2,
+	0xb4, 0x57, 0xc9, 0xb6, 0x8d, 0x97, 0x4c, 0x4d, 0x5f, 0xe6, 0x8a, 0x4b, 0x51, 0x32, 0x22, 0x6f,
+	0xb1, 0xfd, 0x90, 0x9c, 0xd3, 0x5d, 0x52, 0xd9, 0xdd, 0x9a, 0xda, 0x13, 0xd4, 0x4b, 0xec, 0xf0,
+	0x54, 0xa6, 0xd6, 0xd1, 0x31, 0x89, 0x41, 0x08, 0x68, 0x8f, 0x8a, 0x98, 0x5d, 0x09, 0xc9, 0x27,
+	0x84, 0xe7, 0xcb, 0xeb, 0x74, 0x89, 0x2e, 0xbd, 0xfe, 0xf7, 0x2e, 0x79, 0x97, 0x94, 0x42, 0xa8,
+	0x4d, 0xb4, 0x3e, 0x58, 0x78, 0xc5, 0xc0, 0x3f, 0x63, 0x82, 0x01, 0x07, 0x53, 0x03, 0xb8, 0x90,
+	0xfe, 0x15, 0xbe, 0x6e, 0xfe, 0xb4, 0x12, 0xbf, 0x64, 0x21, 0xe7, 0x76, 0xe3, 0xcc, 0xfa, 0x9a,
+	0x7e, 0x4c, 0xb2, 0xd8, 0x5f, 0x11, 0xbe, 0x93, 0x6a, 0x17, 0x5d, 0x0e, 0xaa, 0xe0, 0x51, 0xbf,
+	0x6c, 0xd1, 0x8c, 0x2b, 0x75, 0x96, 0x25, 0xfb, 0x0b, 0xc2, 0xb7, 0xe3, 0x1e, 0xe5, 0x19, 0x8d,
+	0x7a, 0xac, 0xc3, 0x8b, 0xa4, 0x90, 0xb9, 0x53, 0x9f, 0xa9, 0xcf, 0xbf, 0xfc, 0xd8, 0x4f, 0xf0,
+	0xcd, 0xa4, 0x0f, 0x2a, 0x64, 0x31, 0xcf, 0x39, 0x13, 0xca, 0x99, 0xbf, 0xe0, 0x96, 0x4e, 0xcb,
+	0xed, 0x8f, 0x08, 0xd7, 0xcb, 0x15, 0xc7, 0x9a, 0x29, 0x58, 0xe5, 0xc1, 0x7e, 0x83, 0x6f, 0x0d,
+	0xa6, 0x06, 0x0d, 0x38, 0x0b, 0xff, 0x71, 0xeb, 0x4e, 0x27, 0x0b, 0xba, 0x7b, 0x43, 0x17, 0xed,
+	0x0f, 0x5d, 0xf4, 0x7b, 0xe8, 0xa2, 0xcf, 0x23, 0xb7, 0xb6, 0x3f, 0x72, 0x6b, 0x3f, 0x47, 0x6e,
+	0x6d, 0xfb, 0xc1, 0x09, 0xa7, 0xc7, 0x47, 0xf9, 0x90, 0x0b, 0xff, 0x9d, 0x3f, 0x99, 0xf9, 0x95,
+	0xe3, 0xc8, 0xaa, 0x46, 0xfe, 0xa3, 0x3f, 0x03, 0x00, 0xa7, 0x4e, 0x37, 0xda, 0x96, 0x06, 0x00,
+	0x00,
 }
 
 func (m *MainnetAccount) Marshal() (dAtA []byte, err error) {
@@ -229,6 +402,51 @@ func (m *MainnetAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MainnetVestingAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MainnetVestingAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MainnetVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.VestingOptions.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMainnetAccount(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMainnetAccount(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CampaignID != 0 {
+		i = encodeVarintMainnetAccount(dAtA, i, uint64(m.CampaignID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MainnetAccountBalance) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -278,6 +496,111 @@ func (m *MainnetAccountBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MainnetGenesisAccounts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MainnetGenesisAccounts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MainnetGenesisAccounts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VestingAccounts) > 0 {
+		for iNdEx := len(m.VestingAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMainnetAccount(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Dust) > 0 {
+		for iNdEx := len(m.Dust) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Dust[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMainnetAccount(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.DustRecipient) > 0 {
+		i -= len(m.DustRecipient)
+		copy(dAtA[i:], m.DustRecipient)
+		i = encodeVarintMainnetAccount(dAtA, i, uint64(len(m.DustRecipient)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ClaimableAirdrop) > 0 {
+		for iNdEx := len(m.ClaimableAirdrop) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimableAirdrop[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMainnetAccount(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.GenesisDistribution) > 0 {
+		for iNdEx := len(m.GenesisDistribution) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GenesisDistribution[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMainnetAccount(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMainnetAccount(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.CampaignID != 0 {
+		i = encodeVarintMainnetAccount(dAtA, i, uint64(m.CampaignID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMainnetAccount(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMainnetAccount(v)
 	base := offset
@@ -311,6 +634,24 @@ func (m *MainnetAccount) Size() (n int) {
 	return n
 }
 
+func (m *MainnetVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CampaignID != 0 {
+		n += 1 + sovMainnetAccount(uint64(m.CampaignID))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMainnetAccount(uint64(l))
+	}
+	l = m.VestingOptions.Size()
+	n += 1 + l + sovMainnetAccount(uint64(l))
+	return n
+}
+
 func (m *MainnetAccountBalance) Size() (n int) {
 	if m == nil {
 		return 0
@@ -333,13 +674,194 @@ func (m *MainnetAccountBalance) Size() (n int) {
 	return n
 }
 
+func (m *MainnetGenesisAccounts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CampaignID != 0 {
+		n += 1 + sovMainnetAccount(uint64(m.CampaignID))
+	}
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovMainnetAccount(uint64(l))
+		}
+	}
+	if len(m.GenesisDistribution) > 0 {
+		for _, e := range m.GenesisDistribution {
+			l = e.Size()
+			n += 1 + l + sovMainnetAccount(uint64(l))
+		}
+	}
+	if len(m.ClaimableAirdrop) > 0 {
+		for _, e := range m.ClaimableAirdrop {
+			l = e.Size()
+			n += 1 + l + sovMainnetAccount(uint64(l))
+		}
+	}
+	l = len(m.DustRecipient)
+	if l > 0 {
+		n += 1 + l + sovMainnetAccount(uint64(l))
+	}
+	if len(m.Dust) > 0 {
+		for _, e := range m.Dust {
+			l = e.Size()
+			n += 1 + l + sovMainnetAccount(uint64(l))
+		}
+	}
+	if len(m.VestingAccounts) > 0 {
+		for _, e := range m.VestingAccounts {
+			l = e.Size()
+			n += 1 + l + sovMainnetAccount(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovMainnetAccount(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozMainnetAccount(x uint64) (n int) {
 	return sovMainnetAccount(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *MainnetAccount) Unmarshal(dAtA []byte) error {
+func (m *MainnetAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMainnetAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MainnetAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MainnetAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignID", wireType)
+			}
+			m.CampaignID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMainnetAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMainnetAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMainnetAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMainnetAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMainnetAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMainnetAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMainnetAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shares = append(m.Shares, github_com_cosmos_cosmos_sdk_types.Coin{})
+			if err := m.Shares[len(m.Shares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMainnetAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMainnetAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MainnetVestingAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -362,10 +884,10 @@ func (m *MainnetAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MainnetAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: MainnetVestingAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MainnetAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MainnetVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -421,7 +943,7 @@ func (m *MainnetAccount) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingOptions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -448,8 +970,7 @@ func (m *MainnetAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Shares = append(m.Shares, github_com_cosmos_cosmos_sdk_types.Coin{})
-			if err := m.Shares[len(m.Shares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.VestingOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -609,6 +1130,277 @@ func (m *MainnetAccountBalance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MainnetGenesisAccounts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMainnetAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MainnetGenesisAccounts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MainnetGenesisAccounts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignID", wireType)
+			}
+			m.CampaignID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMainnetAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMainnetAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMainnetAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMainnetAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, MainnetAccountBalance{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisDistribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMainnetAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMainnetAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMainnetAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GenesisDistribution = append(m.GenesisDistribution, github_com_cosmos_cosmos_sdk_types.Coin{})
+			if err := m.GenesisDistribution[len(m.GenesisDistribution)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableAirdrop", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMainnetAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMainnetAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMainnetAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimableAirdrop = append(m.ClaimableAirdrop, github_com_cosmos_cosmos_sdk_types.Coin{})
+			if err := m.ClaimableAirdrop[len(m.ClaimableAirdrop)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DustRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMainnetAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMainnetAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMainnetAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DustRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dust", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMainnetAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMainnetAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMainnetAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dust = append(m.Dust, github_com_cosmos_cosmos_sdk_types.Coin{})
+			if err := m.Dust[len(m.Dust)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingAccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMainnetAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMainnetAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMainnetAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingAccounts = append(m.VestingAccounts, MainnetAccountBalance{})
+			if err := m.VestingAccounts[len(m.VestingAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMainnetAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMainnetAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMainnetAccount(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
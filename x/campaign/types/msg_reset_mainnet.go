@@ -0,0 +1,47 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgResetMainnet = "reset_mainnet"
+
+var _ sdk.Msg = &MsgResetMainnet{}
+
+func NewMsgResetMainnet(coordinator string, campaignID uint64) *MsgResetMainnet {
+	return &MsgResetMainnet{
+		Coordinator: coordinator,
+		CampaignID:  campaignID,
+	}
+}
+
+func (msg *MsgResetMainnet) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgResetMainnet) Type() string {
+	return TypeMsgResetMainnet
+}
+
+func (msg *MsgResetMainnet) GetSigners() []sdk.AccAddress {
+	coordinator, err := sdk.AccAddressFromBech32(msg.Coordinator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{coordinator}
+}
+
+func (msg *MsgResetMainnet) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgResetMainnet) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Coordinator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrortypes.ErrInvalidAddress, "invalid coordinator address (%s)", err)
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkerrortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/campaign/types"
+)
+
+func TestMsgResetMainnet_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  types.MsgResetMainnet
+		err  error
+	}{
+		{
+			name: "valid message",
+			msg: types.MsgResetMainnet{
+				Coordinator: sample.Address(r),
+				CampaignID:  sample.Uint64(r),
+			},
+		},
+		{
+			name: "invalid address",
+			msg: types.MsgResetMainnet{
+				Coordinator: "invalid_address",
+				CampaignID:  sample.Uint64(r),
+			},
+			err: sdkerrortypes.ErrInvalidAddress,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
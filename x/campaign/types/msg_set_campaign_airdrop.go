@@ -0,0 +1,63 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSetCampaignAirdrop = "set_campaign_airdrop"
+
+var _ sdk.Msg = &MsgSetCampaignAirdrop{}
+
+func NewMsgSetCampaignAirdrop(
+	coordinator string,
+	campaignID uint64,
+	denom string,
+	missions []AirdropMission,
+	claimRecords []AirdropClaimRecord,
+) *MsgSetCampaignAirdrop {
+	return &MsgSetCampaignAirdrop{
+		Coordinator:  coordinator,
+		CampaignID:   campaignID,
+		Denom:        denom,
+		Missions:     missions,
+		ClaimRecords: claimRecords,
+	}
+}
+
+func (msg *MsgSetCampaignAirdrop) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetCampaignAirdrop) Type() string {
+	return TypeMsgSetCampaignAirdrop
+}
+
+func (msg *MsgSetCampaignAirdrop) GetSigners() []sdk.AccAddress {
+	coordinator, err := sdk.AccAddressFromBech32(msg.Coordinator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{coordinator}
+}
+
+func (msg *MsgSetCampaignAirdrop) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// CampaignAirdrop returns the campaign airdrop set by the message
+func (msg *MsgSetCampaignAirdrop) CampaignAirdrop() CampaignAirdrop {
+	return NewCampaignAirdrop(msg.CampaignID, msg.Denom, msg.Missions, msg.ClaimRecords)
+}
+
+func (msg *MsgSetCampaignAirdrop) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Coordinator); err != nil {
+		return sdkerrors.Wrapf(sdkerrortypes.ErrInvalidAddress, "invalid coordinator address (%s)", err)
+	}
+	if err := msg.CampaignAirdrop().Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidAirdrop, err.Error())
+	}
+	return nil
+}
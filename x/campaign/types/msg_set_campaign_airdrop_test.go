@@ -0,0 +1,64 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkerrortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/campaign/types"
+)
+
+func TestMsgSetCampaignAirdrop_ValidateBasic(t *testing.T) {
+	airdrop := sample.CampaignAirdrop(r, 1)
+
+	tests := []struct {
+		name string
+		msg  *types.MsgSetCampaignAirdrop
+		err  error
+	}{
+		{
+			name: "valid message",
+			msg: types.NewMsgSetCampaignAirdrop(
+				sample.Address(r),
+				1,
+				airdrop.Denom,
+				airdrop.Missions,
+				airdrop.ClaimRecords,
+			),
+		},
+		{
+			name: "invalid address",
+			msg: types.NewMsgSetCampaignAirdrop(
+				"invalid_address",
+				1,
+				airdrop.Denom,
+				airdrop.Missions,
+				airdrop.ClaimRecords,
+			),
+			err: sdkerrortypes.ErrInvalidAddress,
+		},
+		{
+			name: "invalid airdrop",
+			msg: types.NewMsgSetCampaignAirdrop(
+				sample.Address(r),
+				1,
+				airdrop.Denom,
+				nil,
+				airdrop.ClaimRecords,
+			),
+			err: types.ErrInvalidAirdrop,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
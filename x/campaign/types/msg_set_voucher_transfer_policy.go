@@ -0,0 +1,61 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSetVoucherTransferPolicy = "set_voucher_transfer_policy"
+
+var _ sdk.Msg = &MsgSetVoucherTransferPolicy{}
+
+func NewMsgSetVoucherTransferPolicy(
+	coordinator string,
+	campaignID uint64,
+	mode VoucherTransferPolicy_Mode,
+	allowlist []string,
+) *MsgSetVoucherTransferPolicy {
+	return &MsgSetVoucherTransferPolicy{
+		Coordinator: coordinator,
+		CampaignID:  campaignID,
+		Mode:        mode,
+		Allowlist:   allowlist,
+	}
+}
+
+func (msg *MsgSetVoucherTransferPolicy) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetVoucherTransferPolicy) Type() string {
+	return TypeMsgSetVoucherTransferPolicy
+}
+
+func (msg *MsgSetVoucherTransferPolicy) GetSigners() []sdk.AccAddress {
+	coordinator, err := sdk.AccAddressFromBech32(msg.Coordinator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{coordinator}
+}
+
+func (msg *MsgSetVoucherTransferPolicy) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// VoucherTransferPolicy returns the voucher transfer policy set by the message
+func (msg *MsgSetVoucherTransferPolicy) VoucherTransferPolicy() VoucherTransferPolicy {
+	return NewVoucherTransferPolicy(msg.CampaignID, msg.Mode, msg.Allowlist)
+}
+
+func (msg *MsgSetVoucherTransferPolicy) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Coordinator); err != nil {
+		return sdkerrors.Wrapf(sdkerrortypes.ErrInvalidAddress, "invalid coordinator address (%s)", err)
+	}
+	if err := msg.VoucherTransferPolicy().Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidTransferPolicy, err.Error())
+	}
+	return nil
+}
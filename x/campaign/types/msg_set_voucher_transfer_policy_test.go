@@ -0,0 +1,59 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkerrortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/campaign/types"
+)
+
+func TestMsgSetVoucherTransferPolicy_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *types.MsgSetVoucherTransferPolicy
+		err  error
+	}{
+		{
+			name: "should validate a valid message",
+			msg: types.NewMsgSetVoucherTransferPolicy(
+				sample.Address(r),
+				0,
+				types.VoucherTransferPolicy_ALLOWLIST,
+				[]string{sample.Address(r)},
+			),
+		},
+		{
+			name: "should prevent validate message with invalid coordinator address",
+			msg: types.NewMsgSetVoucherTransferPolicy(
+				"invalid_address",
+				0,
+				types.VoucherTransferPolicy_NO_IBC,
+				nil,
+			),
+			err: sdkerrortypes.ErrInvalidAddress,
+		},
+		{
+			name: "should prevent validate message with invalid policy",
+			msg: types.NewMsgSetVoucherTransferPolicy(
+				sample.Address(r),
+				0,
+				types.VoucherTransferPolicy_FREE,
+				[]string{sample.Address(r)},
+			),
+			err: types.ErrInvalidTransferPolicy,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -15,9 +15,13 @@ var (
 	DefaultMinTotalSupply      = sdkmath.NewInt(100)                   // One hundred
 	DefaultMaxTotalSupply      = sdkmath.NewInt(1_000_000_000_000_000) // One Quadrillion
 	DefaultCampaignCreationFee = sdk.Coins(nil)                        // EmptyCoins
+	DefaultStakingMissionID    = uint64(1)
+	DefaultVotingMissionID     = uint64(2)
 
 	KeyTotalSupplyRange    = []byte("TotalSupplyRange")
 	KeyCampaignCreationFee = []byte("CampaignCreationFee")
+	KeyStakingMissionID    = []byte("StakingMissionID")
+	KeyVotingMissionID     = []byte("VotingMissionID")
 )
 
 // ParamKeyTable returns the parameter key table.
@@ -34,16 +38,30 @@ func NewTotalSupplyRange(minTotalSupply, maxTotalSupply sdkmath.Int) TotalSupply
 }
 
 // NewParams creates a new Params instance
-func NewParams(minTotalSupply, maxTotalSupply sdkmath.Int, campaignCreationFee sdk.Coins) Params {
+func NewParams(
+	minTotalSupply,
+	maxTotalSupply sdkmath.Int,
+	campaignCreationFee sdk.Coins,
+	stakingMissionID,
+	votingMissionID uint64,
+) Params {
 	return Params{
 		TotalSupplyRange:    NewTotalSupplyRange(minTotalSupply, maxTotalSupply),
 		CampaignCreationFee: campaignCreationFee,
+		StakingMissionID:    stakingMissionID,
+		VotingMissionID:     votingMissionID,
 	}
 }
 
 // DefaultParams returns default campaign parameters
 func DefaultParams() Params {
-	return NewParams(DefaultMinTotalSupply, DefaultMaxTotalSupply, DefaultCampaignCreationFee)
+	return NewParams(
+		DefaultMinTotalSupply,
+		DefaultMaxTotalSupply,
+		DefaultCampaignCreationFee,
+		DefaultStakingMissionID,
+		DefaultVotingMissionID,
+	)
 }
 
 // String implements stringer interface
@@ -57,6 +75,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyTotalSupplyRange, &p.TotalSupplyRange, validateTotalSupplyRange),
 		paramtypes.NewParamSetPair(KeyCampaignCreationFee, &p.CampaignCreationFee, validateCampaignCreationFee),
+		paramtypes.NewParamSetPair(KeyStakingMissionID, &p.StakingMissionID, validateMissionID),
+		paramtypes.NewParamSetPair(KeyVotingMissionID, &p.VotingMissionID, validateMissionID),
 	}
 }
 
@@ -65,6 +85,9 @@ func (p Params) ValidateBasic() error {
 	if err := validateTotalSupplyRange(p.TotalSupplyRange); err != nil {
 		return err
 	}
+	if p.StakingMissionID == p.VotingMissionID {
+		return errors.New("staking and voting mission IDs must be different")
+	}
 	return p.CampaignCreationFee.Validate()
 }
 
@@ -86,3 +109,10 @@ func validateCampaignCreationFee(i interface{}) error {
 	}
 	return v.Validate()
 }
+
+func validateMissionID(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
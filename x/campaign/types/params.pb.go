@@ -30,6 +30,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	TotalSupplyRange    TotalSupplyRange                         `protobuf:"bytes,1,opt,name=totalSupplyRange,proto3" json:"totalSupplyRange"`
 	CampaignCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=campaignCreationFee,proto3,casttype=github.com/cosmos/cosmos-sdk/types.Coin,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"campaignCreationFee"`
+	// stakingMissionID is the ID of the claim mission completed by delegating
+	StakingMissionID uint64 `protobuf:"varint,3,opt,name=stakingMissionID,proto3" json:"stakingMissionID,omitempty"`
+	// votingMissionID is the ID of the claim mission completed by voting on a proposal
+	VotingMissionID uint64 `protobuf:"varint,4,opt,name=votingMissionID,proto3" json:"votingMissionID,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -78,6 +82,20 @@ func (m *Params) GetCampaignCreationFee() github_com_cosmos_cosmos_sdk_types.Coi
 	return nil
 }
 
+func (m *Params) GetStakingMissionID() uint64 {
+	if m != nil {
+		return m.StakingMissionID
+	}
+	return 0
+}
+
+func (m *Params) GetVotingMissionID() uint64 {
+	if m != nil {
+		return m.VotingMissionID
+	}
+	return 0
+}
+
 // TotalSupplyRange defines the range of allowed values for total supply
 type TotalSupplyRange struct {
 	MinTotalSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=minTotalSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"minTotalSupply"`
@@ -125,31 +143,33 @@ func init() {
 func init() { proto.RegisterFile("campaign/params.proto", fileDescriptor_6f21b288c6be0f59) }
 
 var fileDescriptor_6f21b288c6be0f59 = []byte{
-	// 371 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4d, 0x4e, 0xcc, 0x2d,
-	0x48, 0xcc, 0x4c, 0xcf, 0xd3, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x12, 0x2f, 0x49, 0xcd, 0x4b, 0x49, 0x2d, 0xca, 0xcd, 0xcc, 0x2b, 0xd1, 0x2b, 0x2e,
-	0xc8, 0xd3, 0x83, 0xa9, 0x92, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xab, 0xd1, 0x07, 0xb1, 0x20,
-	0xca, 0xa5, 0xe4, 0x92, 0xf3, 0x8b, 0x73, 0xf3, 0x8b, 0xf5, 0x93, 0x12, 0x8b, 0x53, 0xf5, 0xcb,
-	0x0c, 0x93, 0x52, 0x4b, 0x12, 0x0d, 0xf5, 0x93, 0xf3, 0x33, 0xf3, 0xa0, 0xf2, 0x92, 0x10, 0xf9,
-	0x78, 0x88, 0x46, 0x08, 0x07, 0x22, 0xa5, 0x34, 0x8d, 0x89, 0x8b, 0x2d, 0x00, 0x6c, 0xb5, 0x50,
-	0x34, 0x97, 0x40, 0x49, 0x7e, 0x49, 0x62, 0x4e, 0x70, 0x69, 0x41, 0x41, 0x4e, 0x65, 0x50, 0x62,
-	0x5e, 0x7a, 0xaa, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0xa6, 0x1e, 0x0e, 0xf7, 0xe8, 0x85,
-	0xa0, 0x69, 0x70, 0x62, 0x39, 0x71, 0x4f, 0x9e, 0x21, 0x08, 0xc3, 0x20, 0xa1, 0x15, 0x8c, 0x5c,
-	0xc2, 0x30, 0x5d, 0xce, 0x45, 0xa9, 0x89, 0x25, 0x99, 0xf9, 0x79, 0x6e, 0xa9, 0xa9, 0x12, 0x4c,
-	0x0a, 0xcc, 0x1a, 0xdc, 0x46, 0x92, 0x7a, 0x50, 0x47, 0x81, 0x7c, 0xa0, 0x07, 0xf5, 0x81, 0x9e,
-	0x73, 0x7e, 0x66, 0x9e, 0x53, 0x34, 0xc8, 0xc0, 0x5f, 0xf7, 0xe4, 0xd5, 0xd3, 0x33, 0x4b, 0x32,
-	0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xa1, 0x3e, 0x80, 0x52, 0xba, 0xc5, 0x29, 0xd9, 0xfa, 0x25,
-	0x95, 0x05, 0xa9, 0xc5, 0x60, 0x0d, 0xab, 0xee, 0xcb, 0x6b, 0x10, 0xa9, 0xb4, 0x38, 0x08, 0x9b,
-	0x93, 0xac, 0x58, 0x66, 0x2c, 0x90, 0x67, 0x50, 0xba, 0xc7, 0xc8, 0x25, 0x80, 0xee, 0x3b, 0xa1,
-	0x14, 0x2e, 0xbe, 0xdc, 0xcc, 0x3c, 0x24, 0x61, 0x70, 0x00, 0x71, 0x3a, 0xd9, 0x80, 0x1c, 0x79,
-	0xeb, 0x9e, 0xbc, 0x1a, 0x11, 0x36, 0x7b, 0xe6, 0x95, 0x5c, 0xda, 0xa2, 0xcb, 0x05, 0xf5, 0xb0,
-	0x67, 0x5e, 0x49, 0x10, 0x9a, 0x99, 0x60, 0x5b, 0x12, 0x2b, 0x90, 0x6d, 0x61, 0xa2, 0x8a, 0x2d,
-	0x28, 0x66, 0x3a, 0xb9, 0x9c, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72,
-	0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x16,
-	0x92, 0xf9, 0x88, 0x88, 0xd7, 0x2f, 0x2e, 0xc8, 0xd3, 0xaf, 0xd0, 0x87, 0x27, 0x58, 0xb0, 0x3d,
-	0x49, 0x6c, 0xe0, 0x64, 0x64, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x91, 0x7c, 0xc9, 0x60, 0xc9,
-	0x02, 0x00, 0x00,
+	// 412 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x4f, 0x8b, 0xd3, 0x40,
+	0x14, 0xcf, 0x74, 0xc3, 0x82, 0xb3, 0xa0, 0x25, 0x2a, 0x76, 0xf7, 0x90, 0x94, 0x3d, 0x68, 0x5c,
+	0xd8, 0x19, 0x5a, 0x6f, 0xe2, 0x29, 0x2d, 0x42, 0x0f, 0x82, 0x44, 0x4f, 0xf6, 0x20, 0x93, 0x74,
+	0x88, 0x43, 0x9b, 0x99, 0x21, 0x33, 0x2d, 0xed, 0xb7, 0xf0, 0xe8, 0xd1, 0xa3, 0x78, 0xf6, 0x43,
+	0xf4, 0x58, 0x3c, 0x89, 0x87, 0x28, 0xed, 0xd5, 0x4f, 0xe0, 0x49, 0x32, 0x49, 0xb5, 0x4d, 0x15,
+	0x7a, 0xf0, 0x94, 0xe4, 0xbd, 0xdf, 0x9f, 0xf7, 0x7b, 0xbc, 0xc0, 0xbb, 0x31, 0x49, 0x25, 0x61,
+	0x09, 0xc7, 0x92, 0x64, 0x24, 0x55, 0x48, 0x66, 0x42, 0x0b, 0xe7, 0x9e, 0xa6, 0x7c, 0x44, 0xb3,
+	0x94, 0x71, 0x8d, 0x94, 0xe4, 0x68, 0x8b, 0xba, 0xb8, 0x93, 0x88, 0x44, 0x18, 0x0c, 0x2e, 0xde,
+	0x4a, 0xf8, 0x85, 0x1b, 0x0b, 0x95, 0x0a, 0x85, 0x23, 0xa2, 0x28, 0x9e, 0x75, 0x22, 0xaa, 0x49,
+	0x07, 0xc7, 0x82, 0xf1, 0xaa, 0x7f, 0x5e, 0xf6, 0x5f, 0x97, 0xc4, 0xf2, 0xa3, 0x6c, 0x5d, 0xfe,
+	0x68, 0xc0, 0xd3, 0xe7, 0xc6, 0xda, 0x19, 0xc2, 0xa6, 0x16, 0x9a, 0x4c, 0x5e, 0x4c, 0xa5, 0x9c,
+	0x2c, 0x42, 0xc2, 0x13, 0xda, 0x02, 0x6d, 0xe0, 0x9f, 0x75, 0x1f, 0xa2, 0x7f, 0xcc, 0x83, 0x5e,
+	0xd6, 0x08, 0x81, 0xbd, 0xcc, 0x3d, 0x2b, 0x3c, 0x10, 0x72, 0x3e, 0x00, 0x78, 0x7b, 0xcb, 0xea,
+	0x65, 0x94, 0x68, 0x26, 0xf8, 0x53, 0x4a, 0x5b, 0x8d, 0xf6, 0x89, 0x7f, 0xd6, 0x3d, 0x47, 0xd5,
+	0x50, 0x45, 0x02, 0x54, 0x25, 0x40, 0x3d, 0xc1, 0x78, 0x30, 0x2c, 0x04, 0x7f, 0xe6, 0xde, 0x83,
+	0x84, 0xe9, 0x37, 0xd3, 0x08, 0xc5, 0x22, 0xad, 0x12, 0x54, 0x8f, 0x6b, 0x35, 0x1a, 0x63, 0xbd,
+	0x90, 0x54, 0x19, 0xc2, 0xc7, 0x6f, 0x9e, 0x7f, 0x24, 0x54, 0x85, 0x7f, 0x1b, 0xc9, 0xb9, 0x82,
+	0x4d, 0xa5, 0xc9, 0x98, 0xf1, 0xe4, 0x19, 0x53, 0x8a, 0x09, 0x3e, 0xe8, 0xb7, 0x4e, 0xda, 0xc0,
+	0xb7, 0xc3, 0x83, 0xba, 0xe3, 0xc3, 0x5b, 0x33, 0xa1, 0xf7, 0xa0, 0xb6, 0x81, 0xd6, 0xcb, 0x8f,
+	0xed, 0x77, 0xef, 0x3d, 0xeb, 0x32, 0x07, 0xb0, 0x59, 0xdf, 0x99, 0x33, 0x82, 0x37, 0x53, 0xc6,
+	0x77, 0xca, 0x66, 0xed, 0x37, 0x82, 0x27, 0x45, 0xf4, 0xaf, 0xb9, 0x77, 0xff, 0x88, 0x3c, 0x03,
+	0xae, 0x3f, 0x7f, 0xba, 0x86, 0xd5, 0x1a, 0x07, 0x5c, 0x87, 0x35, 0x4d, 0xe3, 0x42, 0xe6, 0xbb,
+	0x2e, 0x8d, 0xff, 0xe2, 0xb2, 0xa7, 0x19, 0xf4, 0x97, 0x6b, 0x17, 0xac, 0xd6, 0x2e, 0xf8, 0xbe,
+	0x76, 0xc1, 0xdb, 0x8d, 0x6b, 0xad, 0x36, 0xae, 0xf5, 0x65, 0xe3, 0x5a, 0xaf, 0xae, 0x76, 0xf4,
+	0xff, 0x9c, 0x13, 0x56, 0x92, 0xe3, 0x39, 0xfe, 0xfd, 0x1b, 0x18, 0x9f, 0xe8, 0xd4, 0x1c, 0xe7,
+	0xa3, 0x5f, 0x03, 0x00, 0xc1, 0xb1, 0x19, 0x45, 0x1f, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -172,6 +192,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.VotingMissionID != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.VotingMissionID))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StakingMissionID != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.StakingMissionID))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.CampaignCreationFee) > 0 {
 		for iNdEx := len(m.CampaignCreationFee) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -267,6 +297,12 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovParams(uint64(l))
 		}
 	}
+	if m.StakingMissionID != 0 {
+		n += 1 + sovParams(uint64(m.StakingMissionID))
+	}
+	if m.VotingMissionID != 0 {
+		n += 1 + sovParams(uint64(m.VotingMissionID))
+	}
 	return n
 }
 
@@ -385,6 +421,44 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingMissionID", wireType)
+			}
+			m.StakingMissionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StakingMissionID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingMissionID", wireType)
+			}
+			m.VotingMissionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingMissionID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
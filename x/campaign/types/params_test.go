@@ -19,22 +19,33 @@ func TestParamsValidateBasic(t *testing.T) {
 	}{
 		{
 			name:   "invalid min total supply",
-			params: NewParams(sdkmath.ZeroInt(), DefaultMaxTotalSupply, DefaultCampaignCreationFee),
+			params: NewParams(sdkmath.ZeroInt(), DefaultMaxTotalSupply, DefaultCampaignCreationFee, DefaultStakingMissionID, DefaultVotingMissionID),
 			err:    errors.New("minimum total supply should be greater than one: invalid total supply range"),
 		},
 		{
 			name:   "min total supply greater than max",
-			params: NewParams(DefaultMaxTotalSupply, DefaultMinTotalSupply, DefaultCampaignCreationFee),
+			params: NewParams(DefaultMaxTotalSupply, DefaultMinTotalSupply, DefaultCampaignCreationFee, DefaultStakingMissionID, DefaultVotingMissionID),
 			err:    errors.New("maximum total supply should be greater or equal than minimum total supply: invalid total supply range"),
 		},
 		{
 			name:   "invalid coins for campaign creation fee",
-			params: NewParams(DefaultMinTotalSupply, DefaultMaxTotalSupply, sdk.Coins{sdk.Coin{Denom: "foo", Amount: sdkmath.NewInt(-1)}}),
+			params: NewParams(DefaultMinTotalSupply, DefaultMaxTotalSupply, sdk.Coins{sdk.Coin{Denom: "foo", Amount: sdkmath.NewInt(-1)}}, DefaultStakingMissionID, DefaultVotingMissionID),
 			err:    errors.New("coin -1foo amount is not positive"),
 		},
+		{
+			name: "same staking and voting mission IDs",
+			params: NewParams(
+				DefaultMinTotalSupply,
+				DefaultMaxTotalSupply,
+				DefaultCampaignCreationFee,
+				DefaultStakingMissionID,
+				DefaultStakingMissionID,
+			),
+			err: errors.New("staking and voting mission IDs must be different"),
+		},
 		{
 			name:   "valid params",
-			params: NewParams(DefaultMinTotalSupply, DefaultMaxTotalSupply, DefaultCampaignCreationFee),
+			params: NewParams(DefaultMinTotalSupply, DefaultMaxTotalSupply, DefaultCampaignCreationFee, DefaultStakingMissionID, DefaultVotingMissionID),
 		},
 	}
 	for _, tt := range tests {
@@ -130,3 +141,32 @@ func TestValidateCampaignCreationFee(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateMissionID(t *testing.T) {
+	tests := []struct {
+		name      string
+		missionID interface{}
+		err       error
+	}{
+		{
+			name:      "invalid interface",
+			missionID: "test",
+			err:       fmt.Errorf("invalid parameter type: string"),
+		},
+		{
+			name:      "valid param",
+			missionID: DefaultStakingMissionID,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateMissionID(tt.missionID)
+			if tt.err != nil {
+				require.Error(t, err, tt.err)
+				require.Equal(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
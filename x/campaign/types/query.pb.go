@@ -7,8 +7,8 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
-	_ "github.com/cosmos/cosmos-sdk/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -803,22 +803,22 @@ func (m *QueryAllMainnetAccountBalanceResponse) GetPagination() *query.PageRespo
 	return nil
 }
 
-// QueryParamsRequest is request type for the Query/Params RPC method.
-type QueryParamsRequest struct {
+type QueryMainnetGenesisAccountsRequest struct {
+	CampaignID uint64 `protobuf:"varint,1,opt,name=campaignID,proto3" json:"campaignID,omitempty"`
 }
 
-func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
-func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsRequest) ProtoMessage()    {}
-func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+func (m *QueryMainnetGenesisAccountsRequest) Reset()         { *m = QueryMainnetGenesisAccountsRequest{} }
+func (m *QueryMainnetGenesisAccountsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMainnetGenesisAccountsRequest) ProtoMessage()    {}
+func (*QueryMainnetGenesisAccountsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_7a55190e2afa5f29, []int{16}
 }
-func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryMainnetGenesisAccountsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryMainnetGenesisAccountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryMainnetGenesisAccountsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -828,35 +828,41 @@ func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+func (m *QueryMainnetGenesisAccountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMainnetGenesisAccountsRequest.Merge(m, src)
 }
-func (m *QueryParamsRequest) XXX_Size() int {
+func (m *QueryMainnetGenesisAccountsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+func (m *QueryMainnetGenesisAccountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMainnetGenesisAccountsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryMainnetGenesisAccountsRequest proto.InternalMessageInfo
 
-// QueryParamsResponse is response type for the Query/Params RPC method.
-type QueryParamsResponse struct {
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+func (m *QueryMainnetGenesisAccountsRequest) GetCampaignID() uint64 {
+	if m != nil {
+		return m.CampaignID
+	}
+	return 0
 }
 
-func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
-func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsResponse) ProtoMessage()    {}
-func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+type QueryMainnetGenesisAccountsResponse struct {
+	MainnetGenesisAccounts MainnetGenesisAccounts `protobuf:"bytes,1,opt,name=mainnetGenesisAccounts,proto3" json:"mainnetGenesisAccounts"`
+}
+
+func (m *QueryMainnetGenesisAccountsResponse) Reset()         { *m = QueryMainnetGenesisAccountsResponse{} }
+func (m *QueryMainnetGenesisAccountsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMainnetGenesisAccountsResponse) ProtoMessage()    {}
+func (*QueryMainnetGenesisAccountsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_7a55190e2afa5f29, []int{17}
 }
-func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryMainnetGenesisAccountsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryMainnetGenesisAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryMainnetGenesisAccountsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -866,40 +872,41 @@ func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+func (m *QueryMainnetGenesisAccountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMainnetGenesisAccountsResponse.Merge(m, src)
 }
-func (m *QueryParamsResponse) XXX_Size() int {
+func (m *QueryMainnetGenesisAccountsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+func (m *QueryMainnetGenesisAccountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMainnetGenesisAccountsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryMainnetGenesisAccountsResponse proto.InternalMessageInfo
 
-func (m *QueryParamsResponse) GetParams() Params {
+func (m *QueryMainnetGenesisAccountsResponse) GetMainnetGenesisAccounts() MainnetGenesisAccounts {
 	if m != nil {
-		return m.Params
+		return m.MainnetGenesisAccounts
 	}
-	return Params{}
+	return MainnetGenesisAccounts{}
 }
 
-type QueryTotalSharesRequest struct {
+type QueryGetCampaignAirdropRequest struct {
+	CampaignID uint64 `protobuf:"varint,1,opt,name=campaignID,proto3" json:"campaignID,omitempty"`
 }
 
-func (m *QueryTotalSharesRequest) Reset()         { *m = QueryTotalSharesRequest{} }
-func (m *QueryTotalSharesRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryTotalSharesRequest) ProtoMessage()    {}
-func (*QueryTotalSharesRequest) Descriptor() ([]byte, []int) {
+func (m *QueryGetCampaignAirdropRequest) Reset()         { *m = QueryGetCampaignAirdropRequest{} }
+func (m *QueryGetCampaignAirdropRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetCampaignAirdropRequest) ProtoMessage()    {}
+func (*QueryGetCampaignAirdropRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_7a55190e2afa5f29, []int{18}
 }
-func (m *QueryTotalSharesRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetCampaignAirdropRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTotalSharesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetCampaignAirdropRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTotalSharesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetCampaignAirdropRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -909,34 +916,43 @@ func (m *QueryTotalSharesRequest) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *QueryTotalSharesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTotalSharesRequest.Merge(m, src)
+func (m *QueryGetCampaignAirdropRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetCampaignAirdropRequest.Merge(m, src)
 }
-func (m *QueryTotalSharesRequest) XXX_Size() int {
+func (m *QueryGetCampaignAirdropRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTotalSharesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTotalSharesRequest.DiscardUnknown(m)
+func (m *QueryGetCampaignAirdropRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetCampaignAirdropRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTotalSharesRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetCampaignAirdropRequest proto.InternalMessageInfo
 
-type QueryTotalSharesResponse struct {
-	TotalShares uint64 `protobuf:"varint,1,opt,name=totalShares,proto3" json:"totalShares,omitempty"`
+func (m *QueryGetCampaignAirdropRequest) GetCampaignID() uint64 {
+	if m != nil {
+		return m.CampaignID
+	}
+	return 0
 }
 
-func (m *QueryTotalSharesResponse) Reset()         { *m = QueryTotalSharesResponse{} }
-func (m *QueryTotalSharesResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryTotalSharesResponse) ProtoMessage()    {}
-func (*QueryTotalSharesResponse) Descriptor() ([]byte, []int) {
+type QueryGetCampaignAirdropResponse struct {
+	CampaignAirdrop CampaignAirdrop `protobuf:"bytes,1,opt,name=campaignAirdrop,proto3" json:"campaignAirdrop"`
+	// airdropSupply is the amount of the claimable airdrop in the denom of the airdrop
+	AirdropSupply types.Coin `protobuf:"bytes,2,opt,name=airdropSupply,proto3" json:"airdropSupply"`
+}
+
+func (m *QueryGetCampaignAirdropResponse) Reset()         { *m = QueryGetCampaignAirdropResponse{} }
+func (m *QueryGetCampaignAirdropResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetCampaignAirdropResponse) ProtoMessage()    {}
+func (*QueryGetCampaignAirdropResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_7a55190e2afa5f29, []int{19}
 }
-func (m *QueryTotalSharesResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetCampaignAirdropResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTotalSharesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetCampaignAirdropResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTotalSharesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetCampaignAirdropResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -946,1172 +962,1280 @@ func (m *QueryTotalSharesResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *QueryTotalSharesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTotalSharesResponse.Merge(m, src)
+func (m *QueryGetCampaignAirdropResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetCampaignAirdropResponse.Merge(m, src)
 }
-func (m *QueryTotalSharesResponse) XXX_Size() int {
+func (m *QueryGetCampaignAirdropResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTotalSharesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTotalSharesResponse.DiscardUnknown(m)
+func (m *QueryGetCampaignAirdropResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetCampaignAirdropResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTotalSharesResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetCampaignAirdropResponse proto.InternalMessageInfo
 
-func (m *QueryTotalSharesResponse) GetTotalShares() uint64 {
+func (m *QueryGetCampaignAirdropResponse) GetCampaignAirdrop() CampaignAirdrop {
 	if m != nil {
-		return m.TotalShares
+		return m.CampaignAirdrop
 	}
-	return 0
+	return CampaignAirdrop{}
 }
 
-func init() {
-	proto.RegisterType((*QueryGetCampaignRequest)(nil), "tendermint.spn.campaign.QueryGetCampaignRequest")
-	proto.RegisterType((*QueryGetCampaignResponse)(nil), "tendermint.spn.campaign.QueryGetCampaignResponse")
-	proto.RegisterType((*QueryAllCampaignRequest)(nil), "tendermint.spn.campaign.QueryAllCampaignRequest")
-	proto.RegisterType((*QueryAllCampaignResponse)(nil), "tendermint.spn.campaign.QueryAllCampaignResponse")
-	proto.RegisterType((*QueryGetCampaignChainsRequest)(nil), "tendermint.spn.campaign.QueryGetCampaignChainsRequest")
-	proto.RegisterType((*QueryGetCampaignChainsResponse)(nil), "tendermint.spn.campaign.QueryGetCampaignChainsResponse")
-	proto.RegisterType((*QuerySpecialAllocationsBalanceRequest)(nil), "tendermint.spn.campaign.QuerySpecialAllocationsBalanceRequest")
-	proto.RegisterType((*QuerySpecialAllocationsBalanceResponse)(nil), "tendermint.spn.campaign.QuerySpecialAllocationsBalanceResponse")
-	proto.RegisterType((*QueryGetMainnetAccountRequest)(nil), "tendermint.spn.campaign.QueryGetMainnetAccountRequest")
-	proto.RegisterType((*QueryGetMainnetAccountResponse)(nil), "tendermint.spn.campaign.QueryGetMainnetAccountResponse")
-	proto.RegisterType((*QueryAllMainnetAccountRequest)(nil), "tendermint.spn.campaign.QueryAllMainnetAccountRequest")
-	proto.RegisterType((*QueryAllMainnetAccountResponse)(nil), "tendermint.spn.campaign.QueryAllMainnetAccountResponse")
-	proto.RegisterType((*QueryGetMainnetAccountBalanceRequest)(nil), "tendermint.spn.campaign.QueryGetMainnetAccountBalanceRequest")
-	proto.RegisterType((*QueryGetMainnetAccountBalanceResponse)(nil), "tendermint.spn.campaign.QueryGetMainnetAccountBalanceResponse")
-	proto.RegisterType((*QueryAllMainnetAccountBalanceRequest)(nil), "tendermint.spn.campaign.QueryAllMainnetAccountBalanceRequest")
-	proto.RegisterType((*QueryAllMainnetAccountBalanceResponse)(nil), "tendermint.spn.campaign.QueryAllMainnetAccountBalanceResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "tendermint.spn.campaign.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "tendermint.spn.campaign.QueryParamsResponse")
-	proto.RegisterType((*QueryTotalSharesRequest)(nil), "tendermint.spn.campaign.QueryTotalSharesRequest")
-	proto.RegisterType((*QueryTotalSharesResponse)(nil), "tendermint.spn.campaign.QueryTotalSharesResponse")
+func (m *QueryGetCampaignAirdropResponse) GetAirdropSupply() types.Coin {
+	if m != nil {
+		return m.AirdropSupply
+	}
+	return types.Coin{}
 }
 
-func init() { proto.RegisterFile("campaign/query.proto", fileDescriptor_7a55190e2afa5f29) }
-
-var fileDescriptor_7a55190e2afa5f29 = []byte{
-	// 1131 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0x24, 0x25, 0x94, 0x89, 0x14, 0x60, 0x9a, 0x10, 0xc7, 0x02, 0xbb, 0x59, 0x68, 0x5d,
-	0x0a, 0xd9, 0x6d, 0x82, 0x48, 0x85, 0x84, 0x1b, 0xd9, 0x0e, 0xb5, 0x72, 0x40, 0x14, 0xa7, 0x5c,
-	0xe0, 0x60, 0x8d, 0xd7, 0xa3, 0xcd, 0xc2, 0x7a, 0x77, 0xbb, 0xb3, 0x2e, 0x94, 0xaa, 0x17, 0x2e,
-	0x5c, 0x10, 0x02, 0xf5, 0xcc, 0x01, 0x2e, 0x54, 0x9c, 0xf9, 0x00, 0x95, 0xb8, 0x54, 0x9c, 0x2a,
-	0xb8, 0x70, 0x40, 0xa5, 0x4a, 0x90, 0xf8, 0x0a, 0x88, 0x13, 0xf2, 0xcc, 0x5b, 0xdb, 0x63, 0xef,
-	0x66, 0xd7, 0x4e, 0xa4, 0x9e, 0xb2, 0xd9, 0xf7, 0xef, 0xf7, 0x7b, 0xef, 0xcd, 0xbc, 0xb7, 0xc6,
-	0x4b, 0x26, 0xed, 0xf8, 0xd4, 0xb6, 0x5c, 0xe3, 0x46, 0x97, 0x05, 0xb7, 0x74, 0x3f, 0xf0, 0x42,
-	0x8f, 0xac, 0x84, 0xcc, 0x6d, 0xb3, 0xa0, 0x63, 0xbb, 0xa1, 0xce, 0x7d, 0x57, 0x8f, 0x94, 0xf2,
-	0x2f, 0x5a, 0x9e, 0x67, 0x39, 0xcc, 0xa0, 0xbe, 0x6d, 0x50, 0xd7, 0xf5, 0x42, 0x1a, 0xda, 0x9e,
-	0xcb, 0xa5, 0x59, 0xbe, 0x60, 0x7a, 0xbc, 0xe3, 0x71, 0xa3, 0x45, 0x39, 0x33, 0x6e, 0x6e, 0xb4,
-	0x58, 0x48, 0x37, 0x0c, 0xd3, 0xb3, 0x5d, 0x90, 0x5f, 0x1c, 0x96, 0x8b, 0x78, 0x7d, 0x2d, 0x9f,
-	0x5a, 0xb6, 0x2b, 0x9c, 0x81, 0xee, 0x92, 0xe5, 0x59, 0x9e, 0x78, 0x34, 0x7a, 0x4f, 0xf0, 0x76,
-	0x55, 0x7a, 0x68, 0x4a, 0x81, 0xfc, 0xa7, 0x1f, 0x3c, 0x62, 0x12, 0x3d, 0x34, 0xcd, 0x7d, 0x6a,
-	0xf7, 0xc1, 0xad, 0x8c, 0xc9, 0x41, 0xf0, 0x42, 0x5f, 0x70, 0x93, 0xf1, 0xd0, 0x76, 0xad, 0x31,
-	0x87, 0x1d, 0x6a, 0xbb, 0x2e, 0x0b, 0x9b, 0xd4, 0x34, 0xbd, 0xae, 0x1b, 0x82, 0x7c, 0xb9, 0x2f,
-	0xf7, 0x69, 0x40, 0x3b, 0x10, 0x47, 0x7b, 0x0b, 0xaf, 0xbc, 0xdf, 0xa3, 0x56, 0x67, 0x61, 0x0d,
-	0x14, 0x1a, 0xec, 0x46, 0x97, 0xf1, 0x90, 0x14, 0x30, 0x8e, 0x6c, 0x76, 0x77, 0x72, 0xe8, 0x2c,
-	0xba, 0x70, 0xaa, 0x31, 0xf4, 0x46, 0x6b, 0xe2, 0xdc, 0xb8, 0x29, 0xf7, 0x3d, 0x97, 0x33, 0x52,
-	0xc3, 0xa7, 0x23, 0x4d, 0x61, 0xb9, 0xb0, 0xb9, 0xa6, 0x27, 0x54, 0x49, 0x8f, 0x8c, 0xab, 0xa7,
-	0x1e, 0x3c, 0x2a, 0xce, 0x34, 0xfa, 0x86, 0x1a, 0x05, 0x6c, 0x15, 0xc7, 0x19, 0xc5, 0x76, 0x15,
-	0xe3, 0x41, 0x0d, 0x20, 0xc2, 0x79, 0x1d, 0x32, 0xdc, 0x2b, 0x98, 0x2e, 0x1b, 0x04, 0x0a, 0xa6,
-	0x5f, 0xa3, 0x16, 0x03, 0xdb, 0xc6, 0x90, 0xa5, 0x76, 0x0f, 0x01, 0x09, 0x25, 0x46, 0x2c, 0x89,
-	0xb9, 0xa9, 0x48, 0x90, 0xba, 0x82, 0x74, 0x56, 0x20, 0x2d, 0xa5, 0x22, 0x95, 0x08, 0x14, 0xa8,
-	0xdb, 0xf8, 0xa5, 0xd1, 0x74, 0xd7, 0x44, 0xc7, 0x64, 0xad, 0xd7, 0xa7, 0xb8, 0x90, 0xe4, 0x00,
-	0x08, 0x7f, 0x80, 0x17, 0x4d, 0x45, 0x02, 0x99, 0x2d, 0xa5, 0xd2, 0x96, 0xea, 0x40, 0x7e, 0xc4,
-	0x89, 0x56, 0xc7, 0xe7, 0x44, 0xe0, 0x3d, 0x9f, 0x99, 0x36, 0x75, 0x2a, 0x8e, 0xe3, 0x99, 0xf2,
-	0x24, 0x56, 0xa9, 0x43, 0x5d, 0x93, 0x65, 0x65, 0xf0, 0xef, 0x2c, 0x3e, 0x9f, 0xe6, 0x09, 0xa8,
-	0xdc, 0x43, 0xf8, 0x8c, 0xc5, 0x5c, 0xc6, 0x6d, 0xbe, 0x63, 0xf3, 0x30, 0xb0, 0x5b, 0x5d, 0x68,
-	0x95, 0x5e, 0x1d, 0x57, 0x95, 0x02, 0x44, 0xa9, 0xaf, 0x79, 0xb6, 0x5b, 0xfd, 0xa8, 0x47, 0xe1,
-	0xbf, 0x47, 0xc5, 0x92, 0x65, 0x87, 0xfb, 0xdd, 0x96, 0x6e, 0x7a, 0x1d, 0x38, 0xb9, 0xf0, 0x67,
-	0x9d, 0xb7, 0x3f, 0x31, 0xc2, 0x5b, 0x3e, 0xe3, 0xc2, 0xe0, 0xa7, 0xbf, 0x8a, 0x17, 0x32, 0xaa,
-	0xf2, 0x46, 0x1c, 0x24, 0xf2, 0x03, 0xc2, 0xcf, 0x99, 0x0e, 0xb5, 0x3b, 0xb4, 0xe5, 0xb0, 0x8a,
-	0x1d, 0xb4, 0x03, 0xcf, 0xcf, 0xcd, 0x3e, 0x51, 0x9c, 0x63, 0x78, 0x34, 0x3e, 0xe8, 0xbe, 0x77,
-	0xe5, 0xfd, 0x52, 0x91, 0xd7, 0x4b, 0xc6, 0xda, 0x91, 0x4d, 0xfc, 0x34, 0x6d, 0xb7, 0x03, 0xc6,
-	0xb9, 0x38, 0x04, 0xcf, 0x54, 0x73, 0xbf, 0xfd, 0xbc, 0xbe, 0x04, 0xf4, 0x2a, 0x52, 0xb2, 0x17,
-	0x06, 0xb6, 0x6b, 0x35, 0x22, 0xc5, 0xe1, 0x8e, 0x1d, 0x0d, 0x3a, 0xe8, 0xd8, 0x8e, 0x22, 0x49,
-	0xed, 0x58, 0xd5, 0x51, 0xd4, 0xb1, 0xaa, 0x13, 0xed, 0x4b, 0x04, 0x74, 0x2b, 0x8e, 0x33, 0x1d,
-	0xdd, 0xab, 0x31, 0xc7, 0x7e, 0x9a, 0x0b, 0xea, 0x3e, 0x82, 0x1c, 0xc4, 0x20, 0x39, 0x22, 0x07,
-	0x73, 0xc7, 0xce, 0xc1, 0xc9, 0x5d, 0x5c, 0x9f, 0xe3, 0x57, 0xe2, 0xab, 0x38, 0xd9, 0xe9, 0x9f,
-	0xaa, 0x83, 0xee, 0x22, 0xb8, 0x7b, 0x92, 0x83, 0x43, 0x16, 0x3f, 0xc6, 0xcb, 0x9d, 0x38, 0x05,
-	0x68, 0x28, 0x3d, 0x6b, 0x32, 0xa5, 0x15, 0xe4, 0x34, 0xde, 0xa5, 0xf6, 0x35, 0x82, 0x94, 0x8c,
-	0x15, 0x75, 0xc2, 0x94, 0x9c, 0x54, 0x97, 0xfd, 0x19, 0xa5, 0x29, 0x19, 0x50, 0x7a, 0x9a, 0xe6,
-	0x4e, 0x38, 0x4d, 0x27, 0xd7, 0x81, 0x4b, 0x98, 0x08, 0x76, 0xd7, 0xc4, 0xe6, 0x03, 0x09, 0xd0,
-	0xae, 0xe3, 0x33, 0xca, 0x5b, 0x60, 0x58, 0xc6, 0xf3, 0x72, 0x43, 0x82, 0xca, 0x17, 0x13, 0x29,
-	0x49, 0x43, 0xe0, 0x00, 0x46, 0xda, 0x2a, 0x2c, 0x2d, 0xd7, 0xbd, 0x90, 0x3a, 0x7b, 0xfb, 0x34,
-	0x60, 0xfd, 0x80, 0x6f, 0xc3, 0xae, 0xa1, 0x88, 0x20, 0xea, 0x59, 0xbc, 0x10, 0x0e, 0x5e, 0x43,
-	0xa9, 0x87, 0x5f, 0x6d, 0x7e, 0xfb, 0x2c, 0x7e, 0x4a, 0x98, 0x93, 0x1f, 0x11, 0x3e, 0x1d, 0x0d,
-	0x5e, 0x72, 0x29, 0x11, 0x5e, 0xc2, 0x5e, 0x97, 0xdf, 0x98, 0xc0, 0x42, 0xa2, 0xd3, 0xb6, 0xbe,
-	0xf8, 0xfd, 0xef, 0xbb, 0xb3, 0x97, 0x88, 0x6e, 0x0c, 0x4c, 0x0d, 0xee, 0x0f, 0x96, 0xd3, 0xc1,
-	0xc3, 0xed, 0x41, 0x7b, 0xde, 0x21, 0xdf, 0x21, 0xbc, 0x10, 0x39, 0xab, 0x38, 0x4e, 0x1a, 0xd8,
-	0xf1, 0x45, 0x2f, 0x0d, 0x6c, 0xcc, 0xda, 0xa6, 0xbd, 0x2a, 0xc0, 0xbe, 0x4c, 0xd6, 0x52, 0xc1,
-	0x92, 0xfb, 0x08, 0x2f, 0xaa, 0x2b, 0x0c, 0xd9, 0xca, 0x9c, 0x1d, 0x65, 0xfb, 0xca, 0x5f, 0x9e,
-	0xd8, 0x0e, 0xe0, 0x96, 0x05, 0xdc, 0xcb, 0xe4, 0xcd, 0x54, 0xb8, 0xf0, 0x85, 0xa0, 0xa6, 0xf8,
-	0x1f, 0x84, 0x57, 0x13, 0xd7, 0x21, 0x72, 0xe5, 0x68, 0x54, 0x69, 0x1b, 0x59, 0x7e, 0x7b, 0x6a,
-	0x7b, 0x60, 0xb7, 0x2b, 0xd8, 0xd5, 0x48, 0x25, 0x91, 0x1d, 0x97, 0x3e, 0x9a, 0x74, 0xe0, 0xa4,
-	0xd9, 0x92, 0x5e, 0x54, 0xa6, 0xbf, 0x22, 0xbc, 0xa8, 0xde, 0x22, 0x19, 0x8a, 0x15, 0x3b, 0xbd,
-	0x33, 0x14, 0x2b, 0x7e, 0xd6, 0x6a, 0x75, 0x41, 0xa7, 0x42, 0xb6, 0x13, 0xe9, 0x8c, 0x7c, 0x7d,
-	0x29, 0x14, 0x8c, 0xdb, 0x30, 0x97, 0xee, 0x90, 0x5f, 0x10, 0x7e, 0x5e, 0x8d, 0xd1, 0x3b, 0x1f,
-	0x5b, 0xa9, 0xdd, 0x3e, 0x15, 0x9f, 0xc4, 0xdd, 0x21, 0x43, 0xf3, 0x1d, 0xc5, 0xa7, 0xd7, 0x7c,
-	0xcb, 0xb1, 0x17, 0x3b, 0x29, 0x4f, 0x98, 0xe1, 0x91, 0xbe, 0xbb, 0x32, 0xad, 0x39, 0xf0, 0x7a,
-	0x4f, 0xf0, 0xda, 0x25, 0xf5, 0xac, 0xbc, 0x62, 0x5b, 0x6e, 0xa8, 0x5e, 0x8f, 0x11, 0xce, 0xc5,
-	0x86, 0xec, 0x95, 0xad, 0x3c, 0x61, 0xfa, 0x27, 0x23, 0x9b, 0x36, 0x93, 0xb5, 0x77, 0x04, 0xd9,
-	0x6d, 0x52, 0x3e, 0x16, 0x59, 0xf2, 0x15, 0xc2, 0xf3, 0x72, 0xa4, 0x91, 0xd7, 0x8e, 0x46, 0xa4,
-	0xcc, 0xd1, 0xfc, 0xeb, 0xd9, 0x94, 0x01, 0x6c, 0x49, 0x80, 0x5d, 0x23, 0xc5, 0x44, 0xb0, 0x72,
-	0x90, 0x92, 0xef, 0x11, 0x5e, 0x18, 0x9a, 0x94, 0x69, 0xb3, 0x63, 0x7c, 0xde, 0xa6, 0xcd, 0x8e,
-	0x98, 0x31, 0xac, 0xad, 0x0b, 0x74, 0x25, 0x72, 0x2e, 0x11, 0x9d, 0x18, 0xc9, 0x4d, 0x2e, 0xcc,
-	0xaa, 0x3b, 0x0f, 0x0e, 0x0a, 0xe8, 0xe1, 0x41, 0x01, 0x3d, 0x3e, 0x28, 0xa0, 0x6f, 0x0e, 0x0b,
-	0x33, 0x0f, 0x0f, 0x0b, 0x33, 0x7f, 0x1c, 0x16, 0x66, 0x3e, 0xbc, 0x38, 0xf4, 0xad, 0x35, 0xe2,
-	0xea, 0xb3, 0x21, 0x67, 0xbd, 0x6f, 0xae, 0xd6, 0xbc, 0xf8, 0x29, 0xe6, 0x8d, 0xff, 0x03, 0x00,
-	0x00, 0xff, 0xff, 0x23, 0xfe, 0xc7, 0x27, 0xde, 0x12, 0x00, 0x00,
+type QueryGetVoucherTransferPolicyRequest struct {
+	CampaignID uint64 `protobuf:"varint,1,opt,name=campaignID,proto3" json:"campaignID,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *QueryGetVoucherTransferPolicyRequest) Reset()         { *m = QueryGetVoucherTransferPolicyRequest{} }
+func (m *QueryGetVoucherTransferPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetVoucherTransferPolicyRequest) ProtoMessage()    {}
+func (*QueryGetVoucherTransferPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a55190e2afa5f29, []int{20}
+}
+func (m *QueryGetVoucherTransferPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetVoucherTransferPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetVoucherTransferPolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetVoucherTransferPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetVoucherTransferPolicyRequest.Merge(m, src)
+}
+func (m *QueryGetVoucherTransferPolicyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetVoucherTransferPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetVoucherTransferPolicyRequest.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryGetVoucherTransferPolicyRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Queries a campaign by id.
-	Campaign(ctx context.Context, in *QueryGetCampaignRequest, opts ...grpc.CallOption) (*QueryGetCampaignResponse, error)
-	// Queries a list of campaign items.
-	CampaignAll(ctx context.Context, in *QueryAllCampaignRequest, opts ...grpc.CallOption) (*QueryAllCampaignResponse, error)
-	// Queries a campaignChains by index.
-	CampaignChains(ctx context.Context, in *QueryGetCampaignChainsRequest, opts ...grpc.CallOption) (*QueryGetCampaignChainsResponse, error)
-	// Queries a list of SpecialAllocationsBalance items.
-	SpecialAllocationsBalance(ctx context.Context, in *QuerySpecialAllocationsBalanceRequest, opts ...grpc.CallOption) (*QuerySpecialAllocationsBalanceResponse, error)
-	// Queries a mainnetAccount by index.
-	MainnetAccount(ctx context.Context, in *QueryGetMainnetAccountRequest, opts ...grpc.CallOption) (*QueryGetMainnetAccountResponse, error)
-	// Queries a list of mainnetAccount items.
-	MainnetAccountAll(ctx context.Context, in *QueryAllMainnetAccountRequest, opts ...grpc.CallOption) (*QueryAllMainnetAccountResponse, error)
-	// Queries a mainnetAccountBalance by index.
-	MainnetAccountBalance(ctx context.Context, in *QueryGetMainnetAccountBalanceRequest, opts ...grpc.CallOption) (*QueryGetMainnetAccountBalanceResponse, error)
-	// Queries a list of mainnetAccountBalance items.
-	MainnetAccountBalanceAll(ctx context.Context, in *QueryAllMainnetAccountBalanceRequest, opts ...grpc.CallOption) (*QueryAllMainnetAccountBalanceResponse, error)
-	// Params queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Queries the TotalShares value
-	TotalShares(ctx context.Context, in *QueryTotalSharesRequest, opts ...grpc.CallOption) (*QueryTotalSharesResponse, error)
+func (m *QueryGetVoucherTransferPolicyRequest) GetCampaignID() uint64 {
+	if m != nil {
+		return m.CampaignID
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+type QueryGetVoucherTransferPolicyResponse struct {
+	VoucherTransferPolicy VoucherTransferPolicy `protobuf:"bytes,1,opt,name=voucherTransferPolicy,proto3" json:"voucherTransferPolicy"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryGetVoucherTransferPolicyResponse) Reset()         { *m = QueryGetVoucherTransferPolicyResponse{} }
+func (m *QueryGetVoucherTransferPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetVoucherTransferPolicyResponse) ProtoMessage()    {}
+func (*QueryGetVoucherTransferPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a55190e2afa5f29, []int{21}
 }
-
-func (c *queryClient) Campaign(ctx context.Context, in *QueryGetCampaignRequest, opts ...grpc.CallOption) (*QueryGetCampaignResponse, error) {
-	out := new(QueryGetCampaignResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.spn.campaign.Query/Campaign", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetVoucherTransferPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetVoucherTransferPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetVoucherTransferPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGetVoucherTransferPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetVoucherTransferPolicyResponse.Merge(m, src)
+}
+func (m *QueryGetVoucherTransferPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetVoucherTransferPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetVoucherTransferPolicyResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) CampaignAll(ctx context.Context, in *QueryAllCampaignRequest, opts ...grpc.CallOption) (*QueryAllCampaignResponse, error) {
-	out := new(QueryAllCampaignResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.spn.campaign.Query/CampaignAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetVoucherTransferPolicyResponse proto.InternalMessageInfo
+
+func (m *QueryGetVoucherTransferPolicyResponse) GetVoucherTransferPolicy() VoucherTransferPolicy {
+	if m != nil {
+		return m.VoucherTransferPolicy
 	}
-	return out, nil
+	return VoucherTransferPolicy{}
 }
 
-func (c *queryClient) CampaignChains(ctx context.Context, in *QueryGetCampaignChainsRequest, opts ...grpc.CallOption) (*QueryGetCampaignChainsResponse, error) {
-	out := new(QueryGetCampaignChainsResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.spn.campaign.Query/CampaignChains", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryShareLedgerRequest struct {
+	CampaignID uint64 `protobuf:"varint,1,opt,name=campaignID,proto3" json:"campaignID,omitempty"`
+	// address filters the entries involving the address if set
+	Address    string             `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) SpecialAllocationsBalance(ctx context.Context, in *QuerySpecialAllocationsBalanceRequest, opts ...grpc.CallOption) (*QuerySpecialAllocationsBalanceResponse, error) {
-	out := new(QuerySpecialAllocationsBalanceResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.spn.campaign.Query/SpecialAllocationsBalance", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryShareLedgerRequest) Reset()         { *m = QueryShareLedgerRequest{} }
+func (m *QueryShareLedgerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryShareLedgerRequest) ProtoMessage()    {}
+func (*QueryShareLedgerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a55190e2afa5f29, []int{22}
+}
+func (m *QueryShareLedgerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryShareLedgerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryShareLedgerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryShareLedgerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryShareLedgerRequest.Merge(m, src)
+}
+func (m *QueryShareLedgerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryShareLedgerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryShareLedgerRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) MainnetAccount(ctx context.Context, in *QueryGetMainnetAccountRequest, opts ...grpc.CallOption) (*QueryGetMainnetAccountResponse, error) {
-	out := new(QueryGetMainnetAccountResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.spn.campaign.Query/MainnetAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryShareLedgerRequest proto.InternalMessageInfo
+
+func (m *QueryShareLedgerRequest) GetCampaignID() uint64 {
+	if m != nil {
+		return m.CampaignID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) MainnetAccountAll(ctx context.Context, in *QueryAllMainnetAccountRequest, opts ...grpc.CallOption) (*QueryAllMainnetAccountResponse, error) {
-	out := new(QueryAllMainnetAccountResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.spn.campaign.Query/MainnetAccountAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryShareLedgerRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) MainnetAccountBalance(ctx context.Context, in *QueryGetMainnetAccountBalanceRequest, opts ...grpc.CallOption) (*QueryGetMainnetAccountBalanceResponse, error) {
-	out := new(QueryGetMainnetAccountBalanceResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.spn.campaign.Query/MainnetAccountBalance", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryShareLedgerRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) MainnetAccountBalanceAll(ctx context.Context, in *QueryAllMainnetAccountBalanceRequest, opts ...grpc.CallOption) (*QueryAllMainnetAccountBalanceResponse, error) {
-	out := new(QueryAllMainnetAccountBalanceResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.spn.campaign.Query/MainnetAccountBalanceAll", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryShareLedgerResponse struct {
+	ShareLedgerEntry []ShareLedgerEntry  `protobuf:"bytes,1,rep,name=shareLedgerEntry,proto3" json:"shareLedgerEntry"`
+	Pagination       *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.spn.campaign.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryShareLedgerResponse) Reset()         { *m = QueryShareLedgerResponse{} }
+func (m *QueryShareLedgerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryShareLedgerResponse) ProtoMessage()    {}
+func (*QueryShareLedgerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a55190e2afa5f29, []int{23}
+}
+func (m *QueryShareLedgerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryShareLedgerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryShareLedgerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryShareLedgerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryShareLedgerResponse.Merge(m, src)
+}
+func (m *QueryShareLedgerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryShareLedgerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryShareLedgerResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) TotalShares(ctx context.Context, in *QueryTotalSharesRequest, opts ...grpc.CallOption) (*QueryTotalSharesResponse, error) {
-	out := new(QueryTotalSharesResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.spn.campaign.Query/TotalShares", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryShareLedgerResponse proto.InternalMessageInfo
+
+func (m *QueryShareLedgerResponse) GetShareLedgerEntry() []ShareLedgerEntry {
+	if m != nil {
+		return m.ShareLedgerEntry
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Queries a campaign by id.
-	Campaign(context.Context, *QueryGetCampaignRequest) (*QueryGetCampaignResponse, error)
-	// Queries a list of campaign items.
-	CampaignAll(context.Context, *QueryAllCampaignRequest) (*QueryAllCampaignResponse, error)
-	// Queries a campaignChains by index.
-	CampaignChains(context.Context, *QueryGetCampaignChainsRequest) (*QueryGetCampaignChainsResponse, error)
-	// Queries a list of SpecialAllocationsBalance items.
-	SpecialAllocationsBalance(context.Context, *QuerySpecialAllocationsBalanceRequest) (*QuerySpecialAllocationsBalanceResponse, error)
-	// Queries a mainnetAccount by index.
-	MainnetAccount(context.Context, *QueryGetMainnetAccountRequest) (*QueryGetMainnetAccountResponse, error)
-	// Queries a list of mainnetAccount items.
-	MainnetAccountAll(context.Context, *QueryAllMainnetAccountRequest) (*QueryAllMainnetAccountResponse, error)
-	// Queries a mainnetAccountBalance by index.
-	MainnetAccountBalance(context.Context, *QueryGetMainnetAccountBalanceRequest) (*QueryGetMainnetAccountBalanceResponse, error)
-	// Queries a list of mainnetAccountBalance items.
-	MainnetAccountBalanceAll(context.Context, *QueryAllMainnetAccountBalanceRequest) (*QueryAllMainnetAccountBalanceResponse, error)
-	// Params queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Queries the TotalShares value
-	TotalShares(context.Context, *QueryTotalSharesRequest) (*QueryTotalSharesResponse, error)
+func (m *QueryShareLedgerResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+type QueryCapTableRequest struct {
+	CampaignID uint64 `protobuf:"varint,1,opt,name=campaignID,proto3" json:"campaignID,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Campaign(ctx context.Context, req *QueryGetCampaignRequest) (*QueryGetCampaignResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Campaign not implemented")
-}
-func (*UnimplementedQueryServer) CampaignAll(ctx context.Context, req *QueryAllCampaignRequest) (*QueryAllCampaignResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CampaignAll not implemented")
-}
-func (*UnimplementedQueryServer) CampaignChains(ctx context.Context, req *QueryGetCampaignChainsRequest) (*QueryGetCampaignChainsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CampaignChains not implemented")
-}
-func (*UnimplementedQueryServer) SpecialAllocationsBalance(ctx context.Context, req *QuerySpecialAllocationsBalanceRequest) (*QuerySpecialAllocationsBalanceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SpecialAllocationsBalance not implemented")
-}
-func (*UnimplementedQueryServer) MainnetAccount(ctx context.Context, req *QueryGetMainnetAccountRequest) (*QueryGetMainnetAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MainnetAccount not implemented")
+func (m *QueryCapTableRequest) Reset()         { *m = QueryCapTableRequest{} }
+func (m *QueryCapTableRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCapTableRequest) ProtoMessage()    {}
+func (*QueryCapTableRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a55190e2afa5f29, []int{24}
 }
-func (*UnimplementedQueryServer) MainnetAccountAll(ctx context.Context, req *QueryAllMainnetAccountRequest) (*QueryAllMainnetAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MainnetAccountAll not implemented")
+func (m *QueryCapTableRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) MainnetAccountBalance(ctx context.Context, req *QueryGetMainnetAccountBalanceRequest) (*QueryGetMainnetAccountBalanceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MainnetAccountBalance not implemented")
+func (m *QueryCapTableRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCapTableRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) MainnetAccountBalanceAll(ctx context.Context, req *QueryAllMainnetAccountBalanceRequest) (*QueryAllMainnetAccountBalanceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MainnetAccountBalanceAll not implemented")
+func (m *QueryCapTableRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCapTableRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryCapTableRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) TotalShares(ctx context.Context, req *QueryTotalSharesRequest) (*QueryTotalSharesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TotalShares not implemented")
+func (m *QueryCapTableRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCapTableRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryCapTableRequest proto.InternalMessageInfo
 
-func _Query_Campaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetCampaignRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Campaign(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.spn.campaign.Query/Campaign",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Campaign(ctx, req.(*QueryGetCampaignRequest))
+func (m *QueryCapTableRequest) GetCampaignID() uint64 {
+	if m != nil {
+		return m.CampaignID
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_CampaignAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllCampaignRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).CampaignAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.spn.campaign.Query/CampaignAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CampaignAll(ctx, req.(*QueryAllCampaignRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryCapTableResponse struct {
+	CampaignID  uint64           `protobuf:"varint,1,opt,name=campaignID,proto3" json:"campaignID,omitempty"`
+	TotalShares uint64           `protobuf:"varint,2,opt,name=totalShares,proto3" json:"totalShares,omitempty"`
+	Holders     []CapTableHolder `protobuf:"bytes,3,rep,name=holders,proto3" json:"holders"`
 }
 
-func _Query_CampaignChains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetCampaignChainsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).CampaignChains(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.spn.campaign.Query/CampaignChains",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CampaignChains(ctx, req.(*QueryGetCampaignChainsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryCapTableResponse) Reset()         { *m = QueryCapTableResponse{} }
+func (m *QueryCapTableResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCapTableResponse) ProtoMessage()    {}
+func (*QueryCapTableResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a55190e2afa5f29, []int{25}
 }
-
-func _Query_SpecialAllocationsBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySpecialAllocationsBalanceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SpecialAllocationsBalance(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.spn.campaign.Query/SpecialAllocationsBalance",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SpecialAllocationsBalance(ctx, req.(*QuerySpecialAllocationsBalanceRequest))
+func (m *QueryCapTableResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCapTableResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCapTableResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryCapTableResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCapTableResponse.Merge(m, src)
+}
+func (m *QueryCapTableResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCapTableResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCapTableResponse.DiscardUnknown(m)
 }
 
-func _Query_MainnetAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetMainnetAccountRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).MainnetAccount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.spn.campaign.Query/MainnetAccount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).MainnetAccount(ctx, req.(*QueryGetMainnetAccountRequest))
+var xxx_messageInfo_QueryCapTableResponse proto.InternalMessageInfo
+
+func (m *QueryCapTableResponse) GetCampaignID() uint64 {
+	if m != nil {
+		return m.CampaignID
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_MainnetAccountAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllMainnetAccountRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).MainnetAccountAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.spn.campaign.Query/MainnetAccountAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).MainnetAccountAll(ctx, req.(*QueryAllMainnetAccountRequest))
+func (m *QueryCapTableResponse) GetTotalShares() uint64 {
+	if m != nil {
+		return m.TotalShares
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_MainnetAccountBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetMainnetAccountBalanceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).MainnetAccountBalance(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.spn.campaign.Query/MainnetAccountBalance",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).MainnetAccountBalance(ctx, req.(*QueryGetMainnetAccountBalanceRequest))
+func (m *QueryCapTableResponse) GetHolders() []CapTableHolder {
+	if m != nil {
+		return m.Holders
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_MainnetAccountBalanceAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllMainnetAccountBalanceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).MainnetAccountBalanceAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.spn.campaign.Query/MainnetAccountBalanceAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).MainnetAccountBalanceAll(ctx, req.(*QueryAllMainnetAccountBalanceRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryParamsRequest is request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.spn.campaign.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a55190e2afa5f29, []int{26}
 }
-
-func _Query_TotalShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTotalSharesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TotalShares(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.spn.campaign.Query/TotalShares",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TotalShares(ctx, req.(*QueryTotalSharesRequest))
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "tendermint.spn.campaign.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Campaign",
-			Handler:    _Query_Campaign_Handler,
-		},
-		{
-			MethodName: "CampaignAll",
-			Handler:    _Query_CampaignAll_Handler,
-		},
-		{
-			MethodName: "CampaignChains",
-			Handler:    _Query_CampaignChains_Handler,
-		},
-		{
-			MethodName: "SpecialAllocationsBalance",
-			Handler:    _Query_SpecialAllocationsBalance_Handler,
-		},
-		{
-			MethodName: "MainnetAccount",
-			Handler:    _Query_MainnetAccount_Handler,
-		},
-		{
-			MethodName: "MainnetAccountAll",
-			Handler:    _Query_MainnetAccountAll_Handler,
-		},
-		{
-			MethodName: "MainnetAccountBalance",
-			Handler:    _Query_MainnetAccountBalance_Handler,
-		},
-		{
-			MethodName: "MainnetAccountBalanceAll",
-			Handler:    _Query_MainnetAccountBalanceAll_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "TotalShares",
-			Handler:    _Query_TotalShares_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "campaign/query.proto",
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
 }
-
-func (m *QueryGetCampaignRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryGetCampaignRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetCampaignRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CampaignID != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.CampaignID))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
 
-func (m *QueryGetCampaignResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// QueryParamsResponse is response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 }
 
-func (m *QueryGetCampaignResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a55190e2afa5f29, []int{27}
 }
-
-func (m *QueryGetCampaignResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Campaign.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllCampaignRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	return dAtA[:n], nil
+	return Params{}
 }
 
-func (m *QueryAllCampaignRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryTotalSharesRequest struct {
 }
 
-func (m *QueryAllCampaignRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryTotalSharesRequest) Reset()         { *m = QueryTotalSharesRequest{} }
+func (m *QueryTotalSharesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalSharesRequest) ProtoMessage()    {}
+func (*QueryTotalSharesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a55190e2afa5f29, []int{28}
+}
+func (m *QueryTotalSharesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalSharesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalSharesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAllCampaignResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryTotalSharesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalSharesRequest.Merge(m, src)
+}
+func (m *QueryTotalSharesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalSharesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalSharesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllCampaignResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryTotalSharesRequest proto.InternalMessageInfo
+
+type QueryTotalSharesResponse struct {
+	TotalShares uint64 `protobuf:"varint,1,opt,name=totalShares,proto3" json:"totalShares,omitempty"`
 }
 
-func (m *QueryAllCampaignResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryTotalSharesResponse) Reset()         { *m = QueryTotalSharesResponse{} }
+func (m *QueryTotalSharesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalSharesResponse) ProtoMessage()    {}
+func (*QueryTotalSharesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a55190e2afa5f29, []int{29}
+}
+func (m *QueryTotalSharesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalSharesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalSharesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Campaign) > 0 {
-		for iNdEx := len(m.Campaign) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Campaign[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *QueryTotalSharesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalSharesResponse.Merge(m, src)
+}
+func (m *QueryTotalSharesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalSharesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalSharesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTotalSharesResponse proto.InternalMessageInfo
+
+func (m *QueryTotalSharesResponse) GetTotalShares() uint64 {
+	if m != nil {
+		return m.TotalShares
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryGetCampaignChainsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*QueryGetCampaignRequest)(nil), "tendermint.spn.campaign.QueryGetCampaignRequest")
+	proto.RegisterType((*QueryGetCampaignResponse)(nil), "tendermint.spn.campaign.QueryGetCampaignResponse")
+	proto.RegisterType((*QueryAllCampaignRequest)(nil), "tendermint.spn.campaign.QueryAllCampaignRequest")
+	proto.RegisterType((*QueryAllCampaignResponse)(nil), "tendermint.spn.campaign.QueryAllCampaignResponse")
+	proto.RegisterType((*QueryGetCampaignChainsRequest)(nil), "tendermint.spn.campaign.QueryGetCampaignChainsRequest")
+	proto.RegisterType((*QueryGetCampaignChainsResponse)(nil), "tendermint.spn.campaign.QueryGetCampaignChainsResponse")
+	proto.RegisterType((*QuerySpecialAllocationsBalanceRequest)(nil), "tendermint.spn.campaign.QuerySpecialAllocationsBalanceRequest")
+	proto.RegisterType((*QuerySpecialAllocationsBalanceResponse)(nil), "tendermint.spn.campaign.QuerySpecialAllocationsBalanceResponse")
+	proto.RegisterType((*QueryGetMainnetAccountRequest)(nil), "tendermint.spn.campaign.QueryGetMainnetAccountRequest")
+	proto.RegisterType((*QueryGetMainnetAccountResponse)(nil), "tendermint.spn.campaign.QueryGetMainnetAccountResponse")
+	proto.RegisterType((*QueryAllMainnetAccountRequest)(nil), "tendermint.spn.campaign.QueryAllMainnetAccountRequest")
+	proto.RegisterType((*QueryAllMainnetAccountResponse)(nil), "tendermint.spn.campaign.QueryAllMainnetAccountResponse")
+	proto.RegisterType((*QueryGetMainnetAccountBalanceRequest)(nil), "tendermint.spn.campaign.QueryGetMainnetAccountBalanceRequest")
+	proto.RegisterType((*QueryGetMainnetAccountBalanceResponse)(nil), "tendermint.spn.campaign.QueryGetMainnetAccountBalanceResponse")
+	proto.RegisterType((*QueryAllMainnetAccountBalanceRequest)(nil), "tendermint.spn.campaign.QueryAllMainnetAccountBalanceRequest")
+	proto.RegisterType((*QueryAllMainnetAccountBalanceResponse)(nil), "tendermint.spn.campaign.QueryAllMainnetAccountBalanceResponse")
+	proto.RegisterType((*QueryMainnetGenesisAccountsRequest)(nil), "tendermint.spn.campaign.QueryMainnetGenesisAccountsRequest")
+	proto.RegisterType((*QueryMainnetGenesisAccountsResponse)(nil), "tendermint.spn.campaign.QueryMainnetGenesisAccountsResponse")
+	proto.RegisterType((*QueryGetCampaignAirdropRequest)(nil), "tendermint.spn.campaign.QueryGetCampaignAirdropRequest")
+	proto.RegisterType((*QueryGetCampaignAirdropResponse)(nil), "tendermint.spn.campaign.QueryGetCampaignAirdropResponse")
+	proto.RegisterType((*QueryGetVoucherTransferPolicyRequest)(nil), "tendermint.spn.campaign.QueryGetVoucherTransferPolicyRequest")
+	proto.RegisterType((*QueryGetVoucherTransferPolicyResponse)(nil), "tendermint.spn.campaign.QueryGetVoucherTransferPolicyResponse")
+	proto.RegisterType((*QueryShareLedgerRequest)(nil), "tendermint.spn.campaign.QueryShareLedgerRequest")
+	proto.RegisterType((*QueryShareLedgerResponse)(nil), "tendermint.spn.campaign.QueryShareLedgerResponse")
+	proto.RegisterType((*QueryCapTableRequest)(nil), "tendermint.spn.campaign.QueryCapTableRequest")
+	proto.RegisterType((*QueryCapTableResponse)(nil), "tendermint.spn.campaign.QueryCapTableResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "tendermint.spn.campaign.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "tendermint.spn.campaign.QueryParamsResponse")
+	proto.RegisterType((*QueryTotalSharesRequest)(nil), "tendermint.spn.campaign.QueryTotalSharesRequest")
+	proto.RegisterType((*QueryTotalSharesResponse)(nil), "tendermint.spn.campaign.QueryTotalSharesResponse")
+}
+
+func init() { proto.RegisterFile("campaign/query.proto", fileDescriptor_7a55190e2afa5f29) }
+
+var fileDescriptor_7a55190e2afa5f29 = []byte{
+	// 1571 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xcd, 0x6f, 0x1b, 0xd5,
+	0x16, 0xcf, 0x4d, 0xfa, 0xda, 0xbe, 0x1b, 0xbd, 0xb6, 0xef, 0x36, 0x49, 0x9d, 0x79, 0x0f, 0xbb,
+	0x9d, 0x7e, 0xa4, 0x2d, 0xc4, 0xae, 0x03, 0x24, 0x05, 0x9a, 0xa4, 0x4e, 0xd2, 0x98, 0x4a, 0x20,
+	0x8a, 0x13, 0x10, 0xa2, 0x0b, 0xeb, 0x7a, 0x7c, 0x71, 0x06, 0xc6, 0x33, 0xd3, 0xb9, 0xe3, 0x42,
+	0xa8, 0xba, 0x61, 0xc3, 0x06, 0x21, 0xa4, 0xb2, 0x43, 0x2c, 0xe8, 0x86, 0x8a, 0x05, 0x2b, 0xfe,
+	0x80, 0x0a, 0x58, 0x54, 0xac, 0x2a, 0xd8, 0x20, 0x01, 0xa5, 0x6a, 0x91, 0xd8, 0xf0, 0x07, 0x20,
+	0x56, 0xc8, 0xf7, 0x9e, 0xb1, 0x3d, 0x5f, 0x9e, 0xb1, 0x1b, 0x89, 0x55, 0xdd, 0xb9, 0xe7, 0xe3,
+	0xf7, 0x3b, 0xe7, 0xdc, 0x33, 0xe7, 0x4c, 0xf0, 0x84, 0x46, 0x9b, 0x36, 0xd5, 0x1b, 0x66, 0xe1,
+	0x4a, 0x8b, 0x39, 0xdb, 0x79, 0xdb, 0xb1, 0x5c, 0x8b, 0x1c, 0x72, 0x99, 0x59, 0x67, 0x4e, 0x53,
+	0x37, 0xdd, 0x3c, 0xb7, 0xcd, 0xbc, 0x27, 0xa4, 0xfc, 0xbf, 0x61, 0x59, 0x0d, 0x83, 0x15, 0xa8,
+	0xad, 0x17, 0xa8, 0x69, 0x5a, 0x2e, 0x75, 0x75, 0xcb, 0xe4, 0x52, 0x4d, 0xc9, 0x6a, 0x16, 0x6f,
+	0x5a, 0xbc, 0x50, 0xa3, 0x9c, 0x15, 0xae, 0x16, 0x6b, 0xcc, 0xa5, 0xc5, 0x82, 0x66, 0xe9, 0x26,
+	0x9c, 0x9f, 0xee, 0x3d, 0x17, 0xfe, 0x3a, 0x52, 0x36, 0x6d, 0xe8, 0xa6, 0x30, 0x06, 0xb2, 0x13,
+	0x0d, 0xab, 0x61, 0x89, 0x9f, 0x85, 0xf6, 0x2f, 0x78, 0x3a, 0x2d, 0x2d, 0x54, 0xe5, 0x81, 0xfc,
+	0x4f, 0xc7, 0xb9, 0xc7, 0xc4, 0xfb, 0x51, 0xd5, 0xb6, 0xa8, 0xde, 0x01, 0x77, 0x28, 0x74, 0x0e,
+	0x07, 0x53, 0x9d, 0x83, 0xab, 0x8c, 0xbb, 0xba, 0xd9, 0x08, 0x19, 0x6c, 0x52, 0xdd, 0x34, 0x99,
+	0x5b, 0xa5, 0x9a, 0x66, 0xb5, 0x4c, 0x17, 0xce, 0x27, 0x3b, 0xe7, 0x36, 0x75, 0x68, 0xd3, 0xf3,
+	0x93, 0x0b, 0xe3, 0xa0, 0xba, 0x53, 0x77, 0x2c, 0x1b, 0x04, 0x4e, 0x74, 0xfd, 0x59, 0x2d, 0x6d,
+	0x8b, 0x39, 0x55, 0xd7, 0xa1, 0x26, 0x7f, 0x83, 0x39, 0x55, 0xdb, 0x32, 0x74, 0x0d, 0x92, 0xa0,
+	0xfc, 0xaf, 0x23, 0xc7, 0xb7, 0xa8, 0xc3, 0xaa, 0x06, 0xab, 0x37, 0x98, 0x23, 0x0f, 0xd5, 0x67,
+	0xf0, 0xa1, 0x97, 0xdb, 0x01, 0x2c, 0x33, 0x77, 0x15, 0xc4, 0x2a, 0xec, 0x4a, 0x8b, 0x71, 0x97,
+	0x64, 0x31, 0xf6, 0x34, 0x2f, 0xae, 0x65, 0xd0, 0x61, 0x74, 0x72, 0x57, 0xa5, 0xe7, 0x89, 0x5a,
+	0xc5, 0x99, 0xb0, 0x2a, 0xb7, 0x2d, 0x93, 0x33, 0xb2, 0x8a, 0xf7, 0x7a, 0x92, 0x42, 0x73, 0x7c,
+	0xee, 0x48, 0x3e, 0xa6, 0x16, 0xf2, 0x9e, 0xf2, 0xca, 0xae, 0x3b, 0xf7, 0x72, 0x23, 0x95, 0x8e,
+	0xa2, 0x4a, 0x01, 0x5b, 0xc9, 0x30, 0x82, 0xd8, 0xd6, 0x31, 0xee, 0x66, 0x1a, 0x3c, 0x9c, 0xc8,
+	0x43, 0x1e, 0xdb, 0x65, 0x91, 0x97, 0x65, 0x08, 0x65, 0x91, 0xbf, 0x44, 0x1b, 0x0c, 0x74, 0x2b,
+	0x3d, 0x9a, 0xea, 0x2d, 0x84, 0x33, 0x61, 0x1f, 0x91, 0x24, 0xc6, 0x86, 0x22, 0x41, 0xca, 0x3e,
+	0xa4, 0xa3, 0x02, 0xe9, 0x4c, 0x22, 0x52, 0x89, 0xc0, 0x07, 0x75, 0x19, 0x3f, 0x16, 0x0c, 0xf7,
+	0xaa, 0xa8, 0xcb, 0xb4, 0xf9, 0x7a, 0x1b, 0x67, 0xe3, 0x0c, 0x00, 0xe1, 0x57, 0xf0, 0x3e, 0xcd,
+	0x77, 0x02, 0x91, 0x9d, 0x49, 0xa4, 0x2d, 0xc5, 0x81, 0x7c, 0xc0, 0x88, 0x5a, 0xc6, 0xc7, 0x85,
+	0xe3, 0x0d, 0x9b, 0x69, 0x3a, 0x35, 0x4a, 0x86, 0x61, 0x69, 0xf2, 0xbe, 0xaf, 0x50, 0x83, 0x9a,
+	0x1a, 0x4b, 0xcb, 0xe0, 0xcf, 0x51, 0x7c, 0x22, 0xc9, 0x12, 0x50, 0xb9, 0x85, 0xf0, 0xc1, 0x06,
+	0x33, 0x19, 0xd7, 0xf9, 0x9a, 0xce, 0x5d, 0x47, 0xaf, 0xb5, 0xa0, 0x54, 0xda, 0x79, 0x9c, 0xf6,
+	0x25, 0xc0, 0x0b, 0xfd, 0xaa, 0xa5, 0x9b, 0x2b, 0x97, 0xdb, 0x14, 0xfe, 0xba, 0x97, 0x9b, 0x69,
+	0xe8, 0xee, 0x56, 0xab, 0x96, 0xd7, 0xac, 0x26, 0xf4, 0x07, 0xf8, 0x67, 0x96, 0xd7, 0xdf, 0x2a,
+	0xb8, 0xdb, 0x36, 0xe3, 0x42, 0xe1, 0x8b, 0x5f, 0x73, 0x27, 0x53, 0x8a, 0xf2, 0x4a, 0x14, 0x24,
+	0x72, 0x13, 0xe1, 0x03, 0x9a, 0x41, 0xf5, 0x26, 0xad, 0x19, 0xac, 0x24, 0xaf, 0x78, 0x66, 0xf4,
+	0x1f, 0xc5, 0x19, 0xc2, 0xa3, 0xf2, 0x6e, 0xf5, 0xbd, 0x28, 0xbb, 0x58, 0x49, 0x36, 0xb1, 0x94,
+	0xb9, 0x23, 0x73, 0x78, 0x0f, 0xad, 0xd7, 0x1d, 0xc6, 0xb9, 0xb8, 0x04, 0xff, 0x5e, 0xc9, 0x7c,
+	0xff, 0xd5, 0xec, 0x04, 0xd0, 0x2b, 0xc9, 0x93, 0x0d, 0xd7, 0xd1, 0xcd, 0x46, 0xc5, 0x13, 0xec,
+	0xad, 0xd8, 0xa0, 0xd3, 0x6e, 0xc5, 0x36, 0x7d, 0x27, 0x89, 0x15, 0xeb, 0x37, 0xe4, 0x55, 0xac,
+	0xdf, 0x88, 0xfa, 0x3e, 0x02, 0xba, 0x25, 0xc3, 0x18, 0x8e, 0xee, 0x7a, 0xc4, 0xb5, 0x1f, 0xa6,
+	0x41, 0xdd, 0x46, 0x38, 0x1b, 0x87, 0xa4, 0x4f, 0x0c, 0xc6, 0x1e, 0x39, 0x06, 0x3b, 0xd7, 0xb8,
+	0xde, 0xc5, 0xc7, 0xa2, 0xb3, 0x38, 0xd8, 0xed, 0x1f, 0xaa, 0x82, 0x6e, 0x20, 0x7c, 0x3c, 0xc1,
+	0x39, 0x44, 0xf1, 0x4d, 0x3c, 0xd9, 0x8c, 0x12, 0x80, 0x82, 0xca, 0xa7, 0x0d, 0xa6, 0xd4, 0x82,
+	0x98, 0x46, 0x9b, 0x54, 0x3f, 0x44, 0xf8, 0x58, 0x74, 0x52, 0x07, 0x0c, 0xc9, 0x4e, 0x55, 0xd9,
+	0xcf, 0x5e, 0x98, 0xe2, 0x01, 0x25, 0x87, 0x69, 0x6c, 0x87, 0xc3, 0xb4, 0x73, 0x15, 0xb8, 0x86,
+	0x55, 0xc1, 0x0e, 0x30, 0x94, 0x65, 0x13, 0x06, 0x6f, 0xa9, 0xdf, 0x9f, 0x1f, 0x23, 0x7c, 0xb4,
+	0xaf, 0x19, 0x08, 0x51, 0x13, 0x4f, 0x35, 0x23, 0x25, 0xa0, 0x94, 0x0a, 0x49, 0x31, 0x0a, 0xa8,
+	0x41, 0x90, 0x62, 0x8c, 0xaa, 0xe7, 0xc3, 0xaf, 0x75, 0x68, 0xda, 0x69, 0x89, 0x7d, 0x8d, 0x70,
+	0x2e, 0xd6, 0x04, 0x90, 0x7a, 0x0d, 0xef, 0xd7, 0xfc, 0x47, 0xc0, 0xe6, 0x64, 0xe2, 0x6c, 0x00,
+	0xf2, 0x40, 0x23, 0x68, 0x86, 0x5c, 0xc0, 0xff, 0x81, 0xb9, 0x76, 0xa3, 0x65, 0xdb, 0xc6, 0x36,
+	0x24, 0xba, 0xcf, 0xab, 0x4f, 0x1a, 0xf2, 0x6b, 0xa9, 0xeb, 0xdd, 0x2e, 0xf3, 0xaa, 0x1c, 0x87,
+	0x37, 0x61, 0x1a, 0xbe, 0x24, 0x86, 0xe1, 0xb4, 0xc1, 0xe8, 0xed, 0x18, 0x31, 0x86, 0xba, 0x57,
+	0xe1, 0x6a, 0x94, 0x40, 0x62, 0xc7, 0x88, 0x34, 0xeb, 0x5d, 0x85, 0x48, 0x93, 0xea, 0x27, 0x08,
+	0x66, 0xe1, 0x8d, 0xf6, 0x08, 0xff, 0x82, 0x98, 0xe0, 0xd3, 0x36, 0x89, 0x4c, 0xa0, 0x6f, 0x76,
+	0xba, 0x63, 0xa0, 0x7d, 0x8c, 0x3d, 0xca, 0x4b, 0x2a, 0x13, 0x46, 0x07, 0x61, 0xba, 0x8c, 0x0f,
+	0xf0, 0xee, 0xe3, 0x0b, 0xa6, 0xeb, 0x6c, 0x43, 0xb3, 0x38, 0x15, 0x1b, 0xa1, 0x8d, 0x80, 0x02,
+	0x04, 0x27, 0x64, 0x68, 0xe7, 0x5a, 0xc4, 0x3c, 0x9e, 0x10, 0x0c, 0x56, 0xa9, 0xbd, 0xd9, 0x9e,
+	0x7b, 0xd2, 0x96, 0xcb, 0x4d, 0x84, 0x27, 0x03, 0x8a, 0xc0, 0x3b, 0x29, 0x2d, 0x87, 0xf1, 0xb8,
+	0x6b, 0xb9, 0xd4, 0x10, 0x5c, 0x65, 0x6a, 0x76, 0x55, 0x7a, 0x1f, 0x91, 0x32, 0xde, 0xb3, 0x65,
+	0x19, 0x75, 0xe6, 0xf0, 0xcc, 0x58, 0xc2, 0x1b, 0xdd, 0xf3, 0xfe, 0xbc, 0x90, 0x87, 0x70, 0x79,
+	0xda, 0xea, 0x04, 0x26, 0x02, 0xe3, 0x25, 0xb1, 0x5f, 0x02, 0x35, 0x75, 0x13, 0x1f, 0xf4, 0x3d,
+	0x05, 0xdc, 0x8b, 0x78, 0xb7, 0xdc, 0x43, 0xa1, 0x8e, 0x73, 0xb1, 0x4e, 0xa5, 0x22, 0x38, 0x03,
+	0x25, 0x75, 0x1a, 0x0a, 0x75, 0xb3, 0x4b, 0xc4, 0x73, 0x78, 0x0e, 0x67, 0xc2, 0x47, 0xe0, 0x35,
+	0x10, 0x0d, 0x14, 0x8a, 0xc6, 0xdc, 0x1f, 0x53, 0xf8, 0x5f, 0x42, 0x9d, 0x7c, 0x8e, 0xf0, 0x5e,
+	0xaf, 0xb9, 0x90, 0x33, 0xb1, 0xf0, 0x62, 0xf6, 0x5a, 0xa5, 0x38, 0x80, 0x86, 0x44, 0xa7, 0xce,
+	0xbf, 0xf7, 0xc3, 0x6f, 0x37, 0x46, 0xcf, 0x90, 0x7c, 0xa1, 0xab, 0x5a, 0xe0, 0x76, 0x77, 0x35,
+	0xef, 0xfe, 0xb8, 0xd6, 0x4d, 0xf1, 0x75, 0xf2, 0x29, 0xc2, 0xe3, 0x9d, 0x36, 0x68, 0x18, 0x49,
+	0x60, 0xc3, 0x8b, 0xae, 0x52, 0x1c, 0x40, 0x03, 0xc0, 0x9e, 0x12, 0x60, 0x8f, 0x92, 0x23, 0x89,
+	0x60, 0xc9, 0x6d, 0x84, 0xf7, 0xf9, 0x57, 0x38, 0x32, 0x9f, 0x3a, 0x3a, 0xbe, 0xed, 0x53, 0x59,
+	0x18, 0x58, 0x0f, 0xe0, 0x2e, 0x0a, 0xb8, 0x0b, 0xe4, 0xe9, 0x44, 0xb8, 0xf0, 0x1d, 0xc6, 0x1f,
+	0xe2, 0xdf, 0x11, 0x9e, 0x8e, 0x5d, 0x07, 0xc9, 0x52, 0x7f, 0x54, 0x49, 0x1b, 0xa9, 0xb2, 0x3c,
+	0xb4, 0x3e, 0xb0, 0xbb, 0x28, 0xd8, 0xad, 0x92, 0x52, 0x2c, 0x3b, 0x2e, 0x6d, 0x54, 0x69, 0xd7,
+	0x48, 0xb5, 0x26, 0xad, 0xf8, 0x99, 0x7e, 0x87, 0xf0, 0x3e, 0xff, 0x14, 0x95, 0x22, 0x59, 0x91,
+	0xdb, 0x8b, 0xb2, 0x30, 0xb0, 0x1e, 0xd0, 0x29, 0x0b, 0x3a, 0x25, 0xb2, 0x1c, 0x4b, 0x27, 0xf0,
+	0x8d, 0xcb, 0x47, 0xa1, 0x70, 0x0d, 0xde, 0x3c, 0xd7, 0xc9, 0x37, 0x08, 0xff, 0xd7, 0xef, 0xa3,
+	0x7d, 0x3f, 0xe6, 0x13, 0xab, 0x7d, 0x28, 0x3e, 0xb1, 0xbb, 0x53, 0x8a, 0xe2, 0xeb, 0xc7, 0xa7,
+	0x5d, 0x7c, 0x93, 0x91, 0x83, 0x2d, 0x59, 0x1c, 0x30, 0xc2, 0x81, 0xba, 0x5b, 0x1a, 0x56, 0x1d,
+	0x78, 0xbd, 0x24, 0x78, 0x5d, 0x24, 0xe5, 0xb4, 0xbc, 0x22, 0x4b, 0xae, 0x27, 0x5f, 0xf7, 0x11,
+	0xce, 0x44, 0xba, 0x6c, 0xa7, 0x6d, 0x71, 0xc0, 0xf0, 0x0f, 0x46, 0x36, 0x69, 0x27, 0x51, 0x2f,
+	0x08, 0xb2, 0xcb, 0x64, 0xf1, 0x91, 0xc8, 0x92, 0x9f, 0x10, 0x9e, 0x8a, 0x9e, 0xc0, 0xc9, 0x73,
+	0xfd, 0x11, 0xf6, 0xdd, 0x2b, 0x94, 0x73, 0xc3, 0x29, 0x03, 0xb9, 0x75, 0x41, 0xee, 0x3c, 0x59,
+	0x4a, 0x24, 0x07, 0xdf, 0x96, 0x3c, 0x92, 0x81, 0x3e, 0xf9, 0x2d, 0xc2, 0xfb, 0x03, 0x13, 0x39,
+	0x49, 0xdf, 0xb3, 0xfd, 0x1b, 0x85, 0x72, 0x76, 0x70, 0x45, 0xa0, 0xb3, 0x24, 0xe8, 0x9c, 0x25,
+	0xf3, 0xc9, 0xdd, 0x1e, 0xe6, 0x7b, 0x3f, 0x8d, 0x5f, 0x10, 0x9e, 0x8c, 0x9c, 0x9f, 0x53, 0xdc,
+	0xb8, 0x7e, 0x7b, 0x81, 0xb2, 0x34, 0xac, 0x7a, 0xea, 0x22, 0x8c, 0xf9, 0x4a, 0xef, 0xe7, 0xf7,
+	0x25, 0xc2, 0xe3, 0x3d, 0xd3, 0x6f, 0xd2, 0xc4, 0x10, 0x5e, 0x07, 0x94, 0xe2, 0x00, 0x1a, 0x80,
+	0xfd, 0x59, 0x81, 0xfd, 0x29, 0x32, 0x17, 0xff, 0x92, 0xea, 0xf9, 0xcb, 0x81, 0x1f, 0xf0, 0x4d,
+	0x31, 0x8c, 0xc9, 0xe9, 0x93, 0xcc, 0xf6, 0xf7, 0x1d, 0x18, 0xae, 0x95, 0x7c, 0x5a, 0x71, 0xc0,
+	0xb9, 0x20, 0x70, 0x16, 0x49, 0xa1, 0x4f, 0xf1, 0xd8, 0x55, 0xb7, 0xad, 0xe3, 0x07, 0xf9, 0x01,
+	0xc2, 0xbb, 0xe5, 0xb4, 0x4a, 0x1e, 0xef, 0xef, 0xd3, 0x37, 0x22, 0x2b, 0x4f, 0xa4, 0x13, 0x06,
+	0x78, 0x33, 0x02, 0xde, 0x11, 0x92, 0x8b, 0x85, 0x27, 0x67, 0x64, 0xf2, 0x19, 0xc2, 0xe3, 0x3d,
+	0x43, 0x70, 0x52, 0x92, 0xc3, 0xa3, 0xb4, 0x52, 0x1c, 0x40, 0x03, 0xd0, 0xcd, 0x0a, 0x74, 0x33,
+	0xe4, 0x78, 0x2c, 0x3a, 0x31, 0x6d, 0x57, 0x45, 0xaa, 0xf9, 0xca, 0xda, 0x9d, 0x07, 0x59, 0x74,
+	0xf7, 0x41, 0x16, 0xdd, 0x7f, 0x90, 0x45, 0x1f, 0x3d, 0xcc, 0x8e, 0xdc, 0x7d, 0x98, 0x1d, 0xf9,
+	0xf1, 0x61, 0x76, 0xe4, 0xf5, 0xd3, 0x3d, 0x9f, 0x91, 0x03, 0xa6, 0xde, 0xe9, 0x31, 0xb6, 0x6d,
+	0x33, 0x5e, 0xdb, 0x2d, 0xfe, 0xca, 0xf4, 0xe4, 0xdf, 0x03, 0x00, 0x9b, 0x30, 0xb1, 0xa9, 0x1f,
+	0x1c, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Queries a campaign by id.
+	Campaign(ctx context.Context, in *QueryGetCampaignRequest, opts ...grpc.CallOption) (*QueryGetCampaignResponse, error)
+	// Queries a list of campaign items.
+	CampaignAll(ctx context.Context, in *QueryAllCampaignRequest, opts ...grpc.CallOption) (*QueryAllCampaignResponse, error)
+	// Queries a campaignChains by index.
+	CampaignChains(ctx context.Context, in *QueryGetCampaignChainsRequest, opts ...grpc.CallOption) (*QueryGetCampaignChainsResponse, error)
+	// Queries a list of SpecialAllocationsBalance items.
+	SpecialAllocationsBalance(ctx context.Context, in *QuerySpecialAllocationsBalanceRequest, opts ...grpc.CallOption) (*QuerySpecialAllocationsBalanceResponse, error)
+	// Queries a mainnetAccount by index.
+	MainnetAccount(ctx context.Context, in *QueryGetMainnetAccountRequest, opts ...grpc.CallOption) (*QueryGetMainnetAccountResponse, error)
+	// Queries a list of mainnetAccount items.
+	MainnetAccountAll(ctx context.Context, in *QueryAllMainnetAccountRequest, opts ...grpc.CallOption) (*QueryAllMainnetAccountResponse, error)
+	// Queries a mainnetAccountBalance by index.
+	MainnetAccountBalance(ctx context.Context, in *QueryGetMainnetAccountBalanceRequest, opts ...grpc.CallOption) (*QueryGetMainnetAccountBalanceResponse, error)
+	// Queries a list of mainnetAccountBalance items.
+	MainnetAccountBalanceAll(ctx context.Context, in *QueryAllMainnetAccountBalanceRequest, opts ...grpc.CallOption) (*QueryAllMainnetAccountBalanceResponse, error)
+	// Queries the balances of the mainnet genesis accounts of a campaign.
+	MainnetGenesisAccounts(ctx context.Context, in *QueryMainnetGenesisAccountsRequest, opts ...grpc.CallOption) (*QueryMainnetGenesisAccountsResponse, error)
+	// Queries the airdrop of a campaign.
+	CampaignAirdrop(ctx context.Context, in *QueryGetCampaignAirdropRequest, opts ...grpc.CallOption) (*QueryGetCampaignAirdropResponse, error)
+	// Queries the voucher transfer policy of a campaign.
+	VoucherTransferPolicy(ctx context.Context, in *QueryGetVoucherTransferPolicyRequest, opts ...grpc.CallOption) (*QueryGetVoucherTransferPolicyResponse, error)
+	// Queries the share movements of a campaign, optionally filtered by address.
+	ShareLedger(ctx context.Context, in *QueryShareLedgerRequest, opts ...grpc.CallOption) (*QueryShareLedgerResponse, error)
+	// Queries the holders of the shares and vouchers of a campaign with their ownership.
+	CapTable(ctx context.Context, in *QueryCapTableRequest, opts ...grpc.CallOption) (*QueryCapTableResponse, error)
+	// Params queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries the TotalShares value
+	TotalShares(ctx context.Context, in *QueryTotalSharesRequest, opts ...grpc.CallOption) (*QueryTotalSharesResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Campaign(ctx context.Context, in *QueryGetCampaignRequest, opts ...grpc.CallOption) (*QueryGetCampaignResponse, error) {
+	out := new(QueryGetCampaignResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.campaign.Query/Campaign", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetCampaignChainsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) CampaignAll(ctx context.Context, in *QueryAllCampaignRequest, opts ...grpc.CallOption) (*QueryAllCampaignResponse, error) {
+	out := new(QueryAllCampaignResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.campaign.Query/CampaignAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetCampaignChainsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CampaignID != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.CampaignID))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) CampaignChains(ctx context.Context, in *QueryGetCampaignChainsRequest, opts ...grpc.CallOption) (*QueryGetCampaignChainsResponse, error) {
+	out := new(QueryGetCampaignChainsResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.campaign.Query/CampaignChains", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetCampaignChainsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) SpecialAllocationsBalance(ctx context.Context, in *QuerySpecialAllocationsBalanceRequest, opts ...grpc.CallOption) (*QuerySpecialAllocationsBalanceResponse, error) {
+	out := new(QuerySpecialAllocationsBalanceResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.campaign.Query/SpecialAllocationsBalance", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetCampaignChainsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) MainnetAccount(ctx context.Context, in *QueryGetMainnetAccountRequest, opts ...grpc.CallOption) (*QueryGetMainnetAccountResponse, error) {
+	out := new(QueryGetMainnetAccountResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.campaign.Query/MainnetAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetCampaignChainsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.CampaignChains.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) MainnetAccountAll(ctx context.Context, in *QueryAllMainnetAccountRequest, opts ...grpc.CallOption) (*QueryAllMainnetAccountResponse, error) {
+	out := new(QueryAllMainnetAccountResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.campaign.Query/MainnetAccountAll", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QuerySpecialAllocationsBalanceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) MainnetAccountBalance(ctx context.Context, in *QueryGetMainnetAccountBalanceRequest, opts ...grpc.CallOption) (*QueryGetMainnetAccountBalanceResponse, error) {
+	out := new(QueryGetMainnetAccountBalanceResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.campaign.Query/MainnetAccountBalance", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QuerySpecialAllocationsBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) MainnetAccountBalanceAll(ctx context.Context, in *QueryAllMainnetAccountBalanceRequest, opts ...grpc.CallOption) (*QueryAllMainnetAccountBalanceResponse, error) {
+	out := new(QueryAllMainnetAccountBalanceResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.campaign.Query/MainnetAccountBalanceAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QuerySpecialAllocationsBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CampaignID != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.CampaignID))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) MainnetGenesisAccounts(ctx context.Context, in *QueryMainnetGenesisAccountsRequest, opts ...grpc.CallOption) (*QueryMainnetGenesisAccountsResponse, error) {
+	out := new(QueryMainnetGenesisAccountsResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.campaign.Query/MainnetGenesisAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QuerySpecialAllocationsBalanceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) CampaignAirdrop(ctx context.Context, in *QueryGetCampaignAirdropRequest, opts ...grpc.CallOption) (*QueryGetCampaignAirdropResponse, error) {
+	out := new(QueryGetCampaignAirdropResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.campaign.Query/CampaignAirdrop", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QuerySpecialAllocationsBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) VoucherTransferPolicy(ctx context.Context, in *QueryGetVoucherTransferPolicyRequest, opts ...grpc.CallOption) (*QueryGetVoucherTransferPolicyResponse, error) {
+	out := new(QueryGetVoucherTransferPolicyResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.campaign.Query/VoucherTransferPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QuerySpecialAllocationsBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ClaimableAirdrop) > 0 {
-		for iNdEx := len(m.ClaimableAirdrop) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ClaimableAirdrop[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.GenesisDistribution) > 0 {
-		for iNdEx := len(m.GenesisDistribution) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.GenesisDistribution[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) ShareLedger(ctx context.Context, in *QueryShareLedgerRequest, opts ...grpc.CallOption) (*QueryShareLedgerResponse, error) {
+	out := new(QueryShareLedgerResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.campaign.Query/ShareLedger", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetMainnetAccountRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) CapTable(ctx context.Context, in *QueryCapTableRequest, opts ...grpc.CallOption) (*QueryCapTableResponse, error) {
+	out := new(QueryCapTableResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.campaign.Query/CapTable", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetMainnetAccountRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryGetMainnetAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.CampaignID != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.CampaignID))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.campaign.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetMainnetAccountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) TotalShares(ctx context.Context, in *QueryTotalSharesRequest, opts ...grpc.CallOption) (*QueryTotalSharesResponse, error) {
+	out := new(QueryTotalSharesResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.campaign.Query/TotalShares", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetMainnetAccountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Queries a campaign by id.
+	Campaign(context.Context, *QueryGetCampaignRequest) (*QueryGetCampaignResponse, error)
+	// Queries a list of campaign items.
+	CampaignAll(context.Context, *QueryAllCampaignRequest) (*QueryAllCampaignResponse, error)
+	// Queries a campaignChains by index.
+	CampaignChains(context.Context, *QueryGetCampaignChainsRequest) (*QueryGetCampaignChainsResponse, error)
+	// Queries a list of SpecialAllocationsBalance items.
+	SpecialAllocationsBalance(context.Context, *QuerySpecialAllocationsBalanceRequest) (*QuerySpecialAllocationsBalanceResponse, error)
+	// Queries a mainnetAccount by index.
+	MainnetAccount(context.Context, *QueryGetMainnetAccountRequest) (*QueryGetMainnetAccountResponse, error)
+	// Queries a list of mainnetAccount items.
+	MainnetAccountAll(context.Context, *QueryAllMainnetAccountRequest) (*QueryAllMainnetAccountResponse, error)
+	// Queries a mainnetAccountBalance by index.
+	MainnetAccountBalance(context.Context, *QueryGetMainnetAccountBalanceRequest) (*QueryGetMainnetAccountBalanceResponse, error)
+	// Queries a list of mainnetAccountBalance items.
+	MainnetAccountBalanceAll(context.Context, *QueryAllMainnetAccountBalanceRequest) (*QueryAllMainnetAccountBalanceResponse, error)
+	// Queries the balances of the mainnet genesis accounts of a campaign.
+	MainnetGenesisAccounts(context.Context, *QueryMainnetGenesisAccountsRequest) (*QueryMainnetGenesisAccountsResponse, error)
+	// Queries the airdrop of a campaign.
+	CampaignAirdrop(context.Context, *QueryGetCampaignAirdropRequest) (*QueryGetCampaignAirdropResponse, error)
+	// Queries the voucher transfer policy of a campaign.
+	VoucherTransferPolicy(context.Context, *QueryGetVoucherTransferPolicyRequest) (*QueryGetVoucherTransferPolicyResponse, error)
+	// Queries the share movements of a campaign, optionally filtered by address.
+	ShareLedger(context.Context, *QueryShareLedgerRequest) (*QueryShareLedgerResponse, error)
+	// Queries the holders of the shares and vouchers of a campaign with their ownership.
+	CapTable(context.Context, *QueryCapTableRequest) (*QueryCapTableResponse, error)
+	// Params queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries the TotalShares value
+	TotalShares(context.Context, *QueryTotalSharesRequest) (*QueryTotalSharesResponse, error)
 }
 
-func (m *QueryGetMainnetAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.MainnetAccount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryAllMainnetAccountRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) Campaign(ctx context.Context, req *QueryGetCampaignRequest) (*QueryGetCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Campaign not implemented")
+}
+func (*UnimplementedQueryServer) CampaignAll(ctx context.Context, req *QueryAllCampaignRequest) (*QueryAllCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CampaignAll not implemented")
+}
+func (*UnimplementedQueryServer) CampaignChains(ctx context.Context, req *QueryGetCampaignChainsRequest) (*QueryGetCampaignChainsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CampaignChains not implemented")
+}
+func (*UnimplementedQueryServer) SpecialAllocationsBalance(ctx context.Context, req *QuerySpecialAllocationsBalanceRequest) (*QuerySpecialAllocationsBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpecialAllocationsBalance not implemented")
+}
+func (*UnimplementedQueryServer) MainnetAccount(ctx context.Context, req *QueryGetMainnetAccountRequest) (*QueryGetMainnetAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MainnetAccount not implemented")
+}
+func (*UnimplementedQueryServer) MainnetAccountAll(ctx context.Context, req *QueryAllMainnetAccountRequest) (*QueryAllMainnetAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MainnetAccountAll not implemented")
+}
+func (*UnimplementedQueryServer) MainnetAccountBalance(ctx context.Context, req *QueryGetMainnetAccountBalanceRequest) (*QueryGetMainnetAccountBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MainnetAccountBalance not implemented")
+}
+func (*UnimplementedQueryServer) MainnetAccountBalanceAll(ctx context.Context, req *QueryAllMainnetAccountBalanceRequest) (*QueryAllMainnetAccountBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MainnetAccountBalanceAll not implemented")
+}
+func (*UnimplementedQueryServer) MainnetGenesisAccounts(ctx context.Context, req *QueryMainnetGenesisAccountsRequest) (*QueryMainnetGenesisAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MainnetGenesisAccounts not implemented")
+}
+func (*UnimplementedQueryServer) CampaignAirdrop(ctx context.Context, req *QueryGetCampaignAirdropRequest) (*QueryGetCampaignAirdropResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CampaignAirdrop not implemented")
+}
+func (*UnimplementedQueryServer) VoucherTransferPolicy(ctx context.Context, req *QueryGetVoucherTransferPolicyRequest) (*QueryGetVoucherTransferPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoucherTransferPolicy not implemented")
+}
+func (*UnimplementedQueryServer) ShareLedger(ctx context.Context, req *QueryShareLedgerRequest) (*QueryShareLedgerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShareLedger not implemented")
+}
+func (*UnimplementedQueryServer) CapTable(ctx context.Context, req *QueryCapTableRequest) (*QueryCapTableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CapTable not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) TotalShares(ctx context.Context, req *QueryTotalSharesRequest) (*QueryTotalSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalShares not implemented")
 }
 
-func (m *QueryAllMainnetAccountRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryAllMainnetAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_Campaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.CampaignID != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.CampaignID))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(QueryServer).Campaign(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.campaign.Query/Campaign",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Campaign(ctx, req.(*QueryGetCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllMainnetAccountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_CampaignAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllCampaignRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryAllMainnetAccountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryAllMainnetAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).CampaignAll(ctx, in)
 	}
-	if len(m.MainnetAccount) > 0 {
-		for iNdEx := len(m.MainnetAccount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MainnetAccount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.campaign.Query/CampaignAll",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CampaignAll(ctx, req.(*QueryAllCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetMainnetAccountBalanceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_CampaignChains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetCampaignChainsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetMainnetAccountBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryGetMainnetAccountBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).CampaignChains(ctx, in)
 	}
-	if m.CampaignID != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.CampaignID))
-		i--
-		dAtA[i] = 0x8
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.campaign.Query/CampaignChains",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CampaignChains(ctx, req.(*QueryGetCampaignChainsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetMainnetAccountBalanceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_SpecialAllocationsBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySpecialAllocationsBalanceRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).SpecialAllocationsBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.campaign.Query/SpecialAllocationsBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SpecialAllocationsBalance(ctx, req.(*QuerySpecialAllocationsBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetMainnetAccountBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_MainnetAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetMainnetAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MainnetAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.campaign.Query/MainnetAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MainnetAccount(ctx, req.(*QueryGetMainnetAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetMainnetAccountBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.MainnetAccountBalance.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_MainnetAccountAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllMainnetAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).MainnetAccountAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.campaign.Query/MainnetAccountAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MainnetAccountAll(ctx, req.(*QueryAllMainnetAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllMainnetAccountBalanceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_MainnetAccountBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetMainnetAccountBalanceRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).MainnetAccountBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.campaign.Query/MainnetAccountBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MainnetAccountBalance(ctx, req.(*QueryGetMainnetAccountBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllMainnetAccountBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_MainnetAccountBalanceAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllMainnetAccountBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MainnetAccountBalanceAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.campaign.Query/MainnetAccountBalanceAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MainnetAccountBalanceAll(ctx, req.(*QueryAllMainnetAccountBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllMainnetAccountBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_MainnetGenesisAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMainnetGenesisAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.CampaignID != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.CampaignID))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(QueryServer).MainnetGenesisAccounts(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.campaign.Query/MainnetGenesisAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MainnetGenesisAccounts(ctx, req.(*QueryMainnetGenesisAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllMainnetAccountBalanceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_CampaignAirdrop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetCampaignAirdropRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).CampaignAirdrop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.campaign.Query/CampaignAirdrop",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CampaignAirdrop(ctx, req.(*QueryGetCampaignAirdropRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllMainnetAccountBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_VoucherTransferPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetVoucherTransferPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VoucherTransferPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.campaign.Query/VoucherTransferPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VoucherTransferPolicy(ctx, req.(*QueryGetVoucherTransferPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllMainnetAccountBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_ShareLedger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryShareLedgerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.MainnetAccountBalance) > 0 {
-		for iNdEx := len(m.MainnetAccountBalance) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MainnetAccountBalance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).ShareLedger(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.campaign.Query/ShareLedger",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ShareLedger(ctx, req.(*QueryShareLedgerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_CapTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCapTableRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).CapTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.campaign.Query/CapTable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CapTable(ctx, req.(*QueryCapTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.campaign.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func _Query_TotalShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.campaign.Query/TotalShares",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalShares(ctx, req.(*QueryTotalSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "tendermint.spn.campaign.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Campaign",
+			Handler:    _Query_Campaign_Handler,
+		},
+		{
+			MethodName: "CampaignAll",
+			Handler:    _Query_CampaignAll_Handler,
+		},
+		{
+			MethodName: "CampaignChains",
+			Handler:    _Query_CampaignChains_Handler,
+		},
+		{
+			MethodName: "SpecialAllocationsBalance",
+			Handler:    _Query_SpecialAllocationsBalance_Handler,
+		},
+		{
+			MethodName: "MainnetAccount",
+			Handler:    _Query_MainnetAccount_Handler,
+		},
+		{
+			MethodName: "MainnetAccountAll",
+			Handler:    _Query_MainnetAccountAll_Handler,
+		},
+		{
+			MethodName: "MainnetAccountBalance",
+			Handler:    _Query_MainnetAccountBalance_Handler,
+		},
+		{
+			MethodName: "MainnetAccountBalanceAll",
+			Handler:    _Query_MainnetAccountBalanceAll_Handler,
+		},
+		{
+			MethodName: "MainnetGenesisAccounts",
+			Handler:    _Query_MainnetGenesisAccounts_Handler,
+		},
+		{
+			MethodName: "CampaignAirdrop",
+			Handler:    _Query_CampaignAirdrop_Handler,
+		},
+		{
+			MethodName: "VoucherTransferPolicy",
+			Handler:    _Query_VoucherTransferPolicy_Handler,
+		},
+		{
+			MethodName: "ShareLedger",
+			Handler:    _Query_ShareLedger_Handler,
+		},
+		{
+			MethodName: "CapTable",
+			Handler:    _Query_CapTable_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "TotalShares",
+			Handler:    _Query_TotalShares_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "campaign/query.proto",
+}
+
+func (m *QueryGetCampaignRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetCampaignRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCampaignRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.CampaignID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CampaignID))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetCampaignResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2121,18 +2245,18 @@ func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetCampaignResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetCampaignResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Campaign.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2144,7 +2268,7 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTotalSharesRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllCampaignRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2154,20 +2278,32 @@ func (m *QueryTotalSharesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTotalSharesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllCampaignRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTotalSharesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllCampaignRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTotalSharesResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllCampaignResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2177,318 +2313,2513 @@ func (m *QueryTotalSharesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTotalSharesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllCampaignResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTotalSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllCampaignResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.TotalShares != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TotalShares))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x12
+	}
+	if len(m.Campaign) > 0 {
+		for iNdEx := len(m.Campaign) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Campaign[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryGetCampaignChainsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryGetCampaignRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryGetCampaignChainsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCampaignChainsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.CampaignID != 0 {
-		n += 1 + sovQuery(uint64(m.CampaignID))
+		i = encodeVarintQuery(dAtA, i, uint64(m.CampaignID))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetCampaignResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetCampaignChainsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Campaign.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllCampaignRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryGetCampaignChainsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllCampaignResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetCampaignChainsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Campaign) > 0 {
-		for _, e := range m.Campaign {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.CampaignChains.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetCampaignChainsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CampaignID != 0 {
-		n += 1 + sovQuery(uint64(m.CampaignID))
+func (m *QuerySpecialAllocationsBalanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetCampaignChainsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.CampaignChains.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QuerySpecialAllocationsBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySpecialAllocationsBalanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QuerySpecialAllocationsBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.CampaignID != 0 {
-		n += 1 + sovQuery(uint64(m.CampaignID))
+		i = encodeVarintQuery(dAtA, i, uint64(m.CampaignID))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySpecialAllocationsBalanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySpecialAllocationsBalanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpecialAllocationsBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpecialAllocationsBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.GenesisDistribution) > 0 {
-		for _, e := range m.GenesisDistribution {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.ClaimableAirdrop) > 0 {
+		for iNdEx := len(m.ClaimableAirdrop) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimableAirdrop[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.ClaimableAirdrop) > 0 {
-		for _, e := range m.ClaimableAirdrop {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.GenesisDistribution) > 0 {
+		for iNdEx := len(m.GenesisDistribution) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GenesisDistribution[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetMainnetAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CampaignID != 0 {
-		n += 1 + sovQuery(uint64(m.CampaignID))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryGetMainnetAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetMainnetAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.MainnetAccount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryGetMainnetAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllMainnetAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetMainnetAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.CampaignID != 0 {
-		n += 1 + sovQuery(uint64(m.CampaignID))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.CampaignID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CampaignID))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllMainnetAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetMainnetAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMainnetAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMainnetAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.MainnetAccount) > 0 {
-		for _, e := range m.MainnetAccount {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.MainnetAccount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetMainnetAccountBalanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllMainnetAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllMainnetAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllMainnetAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.CampaignID != 0 {
-		n += 1 + sovQuery(uint64(m.CampaignID))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.CampaignID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CampaignID))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetMainnetAccountBalanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllMainnetAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.MainnetAccountBalance.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllMainnetAccountBalanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllMainnetAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllMainnetAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MainnetAccount) > 0 {
+		for iNdEx := len(m.MainnetAccount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MainnetAccount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMainnetAccountBalanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMainnetAccountBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMainnetAccountBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.CampaignID != 0 {
-		n += 1 + sovQuery(uint64(m.CampaignID))
+		i = encodeVarintQuery(dAtA, i, uint64(m.CampaignID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMainnetAccountBalanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMainnetAccountBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMainnetAccountBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MainnetAccountBalance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllMainnetAccountBalanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllMainnetAccountBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllMainnetAccountBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.CampaignID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CampaignID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllMainnetAccountBalanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllMainnetAccountBalanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllMainnetAccountBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllMainnetAccountBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.MainnetAccountBalance) > 0 {
-		for _, e := range m.MainnetAccountBalance {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.MainnetAccountBalance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MainnetAccountBalance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMainnetGenesisAccountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMainnetGenesisAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMainnetGenesisAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CampaignID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CampaignID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMainnetGenesisAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMainnetGenesisAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMainnetGenesisAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MainnetGenesisAccounts.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetCampaignAirdropRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetCampaignAirdropRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCampaignAirdropRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CampaignID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CampaignID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetCampaignAirdropResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetCampaignAirdropResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCampaignAirdropResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AirdropSupply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.CampaignAirdrop.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetVoucherTransferPolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetVoucherTransferPolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetVoucherTransferPolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CampaignID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CampaignID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetVoucherTransferPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetVoucherTransferPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetVoucherTransferPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.VoucherTransferPolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryShareLedgerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryShareLedgerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryShareLedgerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CampaignID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CampaignID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryShareLedgerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryShareLedgerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryShareLedgerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ShareLedgerEntry) > 0 {
+		for iNdEx := len(m.ShareLedgerEntry) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ShareLedgerEntry[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCapTableRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCapTableRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCapTableRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CampaignID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CampaignID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCapTableResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCapTableResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCapTableResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Holders) > 0 {
+		for iNdEx := len(m.Holders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Holders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.TotalShares != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalShares))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CampaignID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CampaignID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalSharesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalSharesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalSharesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalSharesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalSharesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalShares != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalShares))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryGetCampaignRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CampaignID != 0 {
+		n += 1 + sovQuery(uint64(m.CampaignID))
+	}
+	return n
+}
+
+func (m *QueryGetCampaignResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Campaign.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllCampaignRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllCampaignResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Campaign) > 0 {
+		for _, e := range m.Campaign {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetCampaignChainsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CampaignID != 0 {
+		n += 1 + sovQuery(uint64(m.CampaignID))
+	}
+	return n
+}
+
+func (m *QueryGetCampaignChainsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CampaignChains.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySpecialAllocationsBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CampaignID != 0 {
+		n += 1 + sovQuery(uint64(m.CampaignID))
+	}
+	return n
+}
+
+func (m *QuerySpecialAllocationsBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GenesisDistribution) > 0 {
+		for _, e := range m.GenesisDistribution {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ClaimableAirdrop) > 0 {
+		for _, e := range m.ClaimableAirdrop {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetMainnetAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CampaignID != 0 {
+		n += 1 + sovQuery(uint64(m.CampaignID))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetMainnetAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MainnetAccount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllMainnetAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CampaignID != 0 {
+		n += 1 + sovQuery(uint64(m.CampaignID))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllMainnetAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MainnetAccount) > 0 {
+		for _, e := range m.MainnetAccount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetMainnetAccountBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CampaignID != 0 {
+		n += 1 + sovQuery(uint64(m.CampaignID))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetMainnetAccountBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MainnetAccountBalance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllMainnetAccountBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CampaignID != 0 {
+		n += 1 + sovQuery(uint64(m.CampaignID))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllMainnetAccountBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MainnetAccountBalance) > 0 {
+		for _, e := range m.MainnetAccountBalance {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMainnetGenesisAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CampaignID != 0 {
+		n += 1 + sovQuery(uint64(m.CampaignID))
+	}
+	return n
+}
+
+func (m *QueryMainnetGenesisAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MainnetGenesisAccounts.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetCampaignAirdropRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CampaignID != 0 {
+		n += 1 + sovQuery(uint64(m.CampaignID))
+	}
+	return n
+}
+
+func (m *QueryGetCampaignAirdropResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CampaignAirdrop.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AirdropSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetVoucherTransferPolicyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CampaignID != 0 {
+		n += 1 + sovQuery(uint64(m.CampaignID))
+	}
+	return n
+}
+
+func (m *QueryGetVoucherTransferPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.VoucherTransferPolicy.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryShareLedgerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CampaignID != 0 {
+		n += 1 + sovQuery(uint64(m.CampaignID))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryShareLedgerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ShareLedgerEntry) > 0 {
+		for _, e := range m.ShareLedgerEntry {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCapTableRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CampaignID != 0 {
+		n += 1 + sovQuery(uint64(m.CampaignID))
+	}
+	return n
+}
+
+func (m *QueryCapTableResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CampaignID != 0 {
+		n += 1 + sovQuery(uint64(m.CampaignID))
+	}
+	if m.TotalShares != 0 {
+		n += 1 + sovQuery(uint64(m.TotalShares))
+	}
+	if len(m.Holders) > 0 {
+		for _, e := range m.Holders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTotalSharesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTotalSharesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TotalShares != 0 {
+		n += 1 + sovQuery(uint64(m.TotalShares))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryGetCampaignRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetCampaignRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetCampaignRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignID", wireType)
+			}
+			m.CampaignID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetCampaignResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetCampaignResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetCampaignResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Campaign", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Campaign.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllCampaignRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllCampaignRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllCampaignRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllCampaignResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllCampaignResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllCampaignResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Campaign", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Campaign = append(m.Campaign, Campaign{})
+			if err := m.Campaign[len(m.Campaign)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetCampaignChainsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetCampaignChainsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetCampaignChainsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignID", wireType)
+			}
+			m.CampaignID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetCampaignChainsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetCampaignChainsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetCampaignChainsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignChains", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CampaignChains.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySpecialAllocationsBalanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpecialAllocationsBalanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpecialAllocationsBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignID", wireType)
+			}
+			m.CampaignID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySpecialAllocationsBalanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpecialAllocationsBalanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpecialAllocationsBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisDistribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GenesisDistribution = append(m.GenesisDistribution, github_com_cosmos_cosmos_sdk_types.Coin{})
+			if err := m.GenesisDistribution[len(m.GenesisDistribution)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableAirdrop", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimableAirdrop = append(m.ClaimableAirdrop, github_com_cosmos_cosmos_sdk_types.Coin{})
+			if err := m.ClaimableAirdrop[len(m.ClaimableAirdrop)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMainnetAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMainnetAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMainnetAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignID", wireType)
+			}
+			m.CampaignID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMainnetAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMainnetAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMainnetAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MainnetAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MainnetAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllMainnetAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllMainnetAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllMainnetAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignID", wireType)
+			}
+			m.CampaignID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
-
-func (m *QueryTotalSharesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllMainnetAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllMainnetAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllMainnetAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MainnetAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MainnetAccount = append(m.MainnetAccount, MainnetAccount{})
+			if err := m.MainnetAccount[len(m.MainnetAccount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryTotalSharesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TotalShares != 0 {
-		n += 1 + sovQuery(uint64(m.TotalShares))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryGetCampaignRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetMainnetAccountBalanceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2511,10 +4842,10 @@ func (m *QueryGetCampaignRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetCampaignRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetMainnetAccountBalanceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetCampaignRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetMainnetAccountBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2536,6 +4867,38 @@ func (m *QueryGetCampaignRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2557,7 +4920,7 @@ func (m *QueryGetCampaignRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetCampaignResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetMainnetAccountBalanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2580,15 +4943,15 @@ func (m *QueryGetCampaignResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetCampaignResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetMainnetAccountBalanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetCampaignResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetMainnetAccountBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Campaign", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MainnetAccountBalance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2615,7 +4978,7 @@ func (m *QueryGetCampaignResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Campaign.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MainnetAccountBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2640,7 +5003,7 @@ func (m *QueryGetCampaignResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllCampaignRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllMainnetAccountBalanceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2663,13 +5026,32 @@ func (m *QueryAllCampaignRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllCampaignRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllMainnetAccountBalanceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllCampaignRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllMainnetAccountBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignID", wireType)
+			}
+			m.CampaignID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -2726,7 +5108,7 @@ func (m *QueryAllCampaignRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllCampaignResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllMainnetAccountBalanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2749,15 +5131,15 @@ func (m *QueryAllCampaignResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllCampaignResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllMainnetAccountBalanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllCampaignResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllMainnetAccountBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Campaign", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MainnetAccountBalance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2784,8 +5166,8 @@ func (m *QueryAllCampaignResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Campaign = append(m.Campaign, Campaign{})
-			if err := m.Campaign[len(m.Campaign)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MainnetAccountBalance = append(m.MainnetAccountBalance, MainnetAccountBalance{})
+			if err := m.MainnetAccountBalance[len(m.MainnetAccountBalance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2846,7 +5228,7 @@ func (m *QueryAllCampaignResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetCampaignChainsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMainnetGenesisAccountsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2869,10 +5251,10 @@ func (m *QueryGetCampaignChainsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetCampaignChainsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMainnetGenesisAccountsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetCampaignChainsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMainnetGenesisAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2915,7 +5297,7 @@ func (m *QueryGetCampaignChainsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetCampaignChainsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMainnetGenesisAccountsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2938,15 +5320,15 @@ func (m *QueryGetCampaignChainsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetCampaignChainsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMainnetGenesisAccountsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetCampaignChainsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMainnetGenesisAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CampaignChains", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MainnetGenesisAccounts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2973,7 +5355,7 @@ func (m *QueryGetCampaignChainsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CampaignChains.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MainnetGenesisAccounts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2998,7 +5380,7 @@ func (m *QueryGetCampaignChainsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySpecialAllocationsBalanceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetCampaignAirdropRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3021,10 +5403,10 @@ func (m *QuerySpecialAllocationsBalanceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySpecialAllocationsBalanceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetCampaignAirdropRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySpecialAllocationsBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetCampaignAirdropRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3067,7 +5449,7 @@ func (m *QuerySpecialAllocationsBalanceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySpecialAllocationsBalanceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetCampaignAirdropResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3090,49 +5472,15 @@ func (m *QuerySpecialAllocationsBalanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySpecialAllocationsBalanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetCampaignAirdropResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySpecialAllocationsBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetCampaignAirdropResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GenesisDistribution", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GenesisDistribution = append(m.GenesisDistribution, github_com_cosmos_cosmos_sdk_types.Coin{})
-			if err := m.GenesisDistribution[len(m.GenesisDistribution)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableAirdrop", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignAirdrop", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3157,167 +5505,15 @@ func (m *QuerySpecialAllocationsBalanceResponse) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ClaimableAirdrop = append(m.ClaimableAirdrop, github_com_cosmos_cosmos_sdk_types.Coin{})
-			if err := m.ClaimableAirdrop[len(m.ClaimableAirdrop)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetMainnetAccountRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetMainnetAccountRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetMainnetAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CampaignID", wireType)
-			}
-			m.CampaignID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CampaignID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetMainnetAccountResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetMainnetAccountResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetMainnetAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CampaignAirdrop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MainnetAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AirdropSupply", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3344,7 +5540,7 @@ func (m *QueryGetMainnetAccountResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MainnetAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AirdropSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3369,7 +5565,7 @@ func (m *QueryGetMainnetAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllMainnetAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetVoucherTransferPolicyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3392,10 +5588,10 @@ func (m *QueryAllMainnetAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllMainnetAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetVoucherTransferPolicyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllMainnetAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetVoucherTransferPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3417,42 +5613,6 @@ func (m *QueryAllMainnetAccountRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3474,7 +5634,7 @@ func (m *QueryAllMainnetAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllMainnetAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetVoucherTransferPolicyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3497,49 +5657,15 @@ func (m *QueryAllMainnetAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllMainnetAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetVoucherTransferPolicyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllMainnetAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetVoucherTransferPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MainnetAccount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MainnetAccount = append(m.MainnetAccount, MainnetAccount{})
-			if err := m.MainnetAccount[len(m.MainnetAccount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VoucherTransferPolicy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3566,10 +5692,7 @@ func (m *QueryAllMainnetAccountResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.VoucherTransferPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3594,7 +5717,7 @@ func (m *QueryAllMainnetAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetMainnetAccountBalanceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryShareLedgerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3617,10 +5740,10 @@ func (m *QueryGetMainnetAccountBalanceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetMainnetAccountBalanceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryShareLedgerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetMainnetAccountBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryShareLedgerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3674,6 +5797,42 @@ func (m *QueryGetMainnetAccountBalanceRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3695,7 +5854,7 @@ func (m *QueryGetMainnetAccountBalanceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetMainnetAccountBalanceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryShareLedgerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3718,15 +5877,15 @@ func (m *QueryGetMainnetAccountBalanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetMainnetAccountBalanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryShareLedgerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetMainnetAccountBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryShareLedgerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MainnetAccountBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareLedgerEntry", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3753,7 +5912,44 @@ func (m *QueryGetMainnetAccountBalanceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MainnetAccountBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ShareLedgerEntry = append(m.ShareLedgerEntry, ShareLedgerEntry{})
+			if err := m.ShareLedgerEntry[len(m.ShareLedgerEntry)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3778,7 +5974,7 @@ func (m *QueryGetMainnetAccountBalanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllMainnetAccountBalanceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCapTableRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3801,10 +5997,10 @@ func (m *QueryAllMainnetAccountBalanceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllMainnetAccountBalanceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCapTableRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllMainnetAccountBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCapTableRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3826,42 +6022,6 @@ func (m *QueryAllMainnetAccountBalanceRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3883,7 +6043,7 @@ func (m *QueryAllMainnetAccountBalanceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllMainnetAccountBalanceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCapTableResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3906,17 +6066,17 @@ func (m *QueryAllMainnetAccountBalanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllMainnetAccountBalanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCapTableResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllMainnetAccountBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCapTableResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MainnetAccountBalance", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignID", wireType)
 			}
-			var msglen int
+			m.CampaignID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3926,29 +6086,33 @@ func (m *QueryAllMainnetAccountBalanceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.CampaignID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalShares", wireType)
 			}
-			m.MainnetAccountBalance = append(m.MainnetAccountBalance, MainnetAccountBalance{})
-			if err := m.MainnetAccountBalance[len(m.MainnetAccountBalance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.TotalShares = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalShares |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holders", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3975,10 +6139,8 @@ func (m *QueryAllMainnetAccountBalanceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Holders = append(m.Holders, CapTableHolder{})
+			if err := m.Holders[len(m.Holders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,36 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate checks the share ledger entry is valid
+func (e ShareLedgerEntry) Validate() error {
+	if _, ok := ShareLedgerEntry_Operation_name[int32(e.Operation)]; !ok {
+		return fmt.Errorf("invalid operation %d", e.Operation)
+	}
+	if e.Height < 0 {
+		return fmt.Errorf("negative height %d", e.Height)
+	}
+	if _, err := sdk.AccAddressFromBech32(e.Sender); err != nil {
+		return fmt.Errorf("invalid sender address %s: %s", e.Sender, err.Error())
+	}
+	if _, err := sdk.AccAddressFromBech32(e.Address); err != nil {
+		return fmt.Errorf("invalid address %s: %s", e.Address, err.Error())
+	}
+	if err := CheckShares(e.Shares); err != nil {
+		return fmt.Errorf("invalid shares %s: %s", e.Shares.String(), err.Error())
+	}
+	if !sdk.Coins(e.Shares).IsValid() || e.Shares.Empty() {
+		return errors.New("shares must be valid and not empty")
+	}
+	return nil
+}
+
+// Involves returns true if the address is the sender or the account of the entry
+func (e ShareLedgerEntry) Involves(address string) bool {
+	return e.Sender == address || e.Address == address
+}
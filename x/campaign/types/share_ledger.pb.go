@@ -0,0 +1,1025 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: campaign/share_ledger.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type ShareLedgerEntry_Operation int32
+
+const (
+	ShareLedgerEntry_MINT_VOUCHERS     ShareLedgerEntry_Operation = 0
+	ShareLedgerEntry_BURN_VOUCHERS     ShareLedgerEntry_Operation = 1
+	ShareLedgerEntry_REDEEM_VOUCHERS   ShareLedgerEntry_Operation = 2
+	ShareLedgerEntry_UNREDEEM_VOUCHERS ShareLedgerEntry_Operation = 3
+)
+
+var ShareLedgerEntry_Operation_name = map[int32]string{
+	0: "MINT_VOUCHERS",
+	1: "BURN_VOUCHERS",
+	2: "REDEEM_VOUCHERS",
+	3: "UNREDEEM_VOUCHERS",
+}
+
+var ShareLedgerEntry_Operation_value = map[string]int32{
+	"MINT_VOUCHERS":     0,
+	"BURN_VOUCHERS":     1,
+	"REDEEM_VOUCHERS":   2,
+	"UNREDEEM_VOUCHERS": 3,
+}
+
+func (x ShareLedgerEntry_Operation) String() string {
+	return proto.EnumName(ShareLedgerEntry_Operation_name, int32(x))
+}
+
+func (ShareLedgerEntry_Operation) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ad73008193152030, []int{0, 0}
+}
+
+// ShareLedgerEntry records a movement of the shares of a campaign
+type ShareLedgerEntry struct {
+	CampaignID uint64 `protobuf:"varint,1,opt,name=campaignID,proto3" json:"campaignID,omitempty"`
+	// ledgerID is the sequence of the entry in the ledger of the campaign
+	LedgerID  uint64                     `protobuf:"varint,2,opt,name=ledgerID,proto3" json:"ledgerID,omitempty"`
+	Height    int64                      `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Timestamp int64                      `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Operation ShareLedgerEntry_Operation `protobuf:"varint,5,opt,name=operation,proto3,enum=tendermint.spn.campaign.ShareLedgerEntry_Operation" json:"operation,omitempty"`
+	// sender is the signer of the operation
+	Sender string `protobuf:"bytes,6,opt,name=sender,proto3" json:"sender,omitempty"`
+	// address is the account whose shares or vouchers are minted, burnt, redeemed or unredeemed
+	Address string `protobuf:"bytes,7,opt,name=address,proto3" json:"address,omitempty"`
+	Shares  Shares `protobuf:"bytes,8,rep,name=shares,proto3,casttype=github.com/cosmos/cosmos-sdk/types.Coin,castrepeated=Shares" json:"shares"`
+}
+
+func (m *ShareLedgerEntry) Reset()         { *m = ShareLedgerEntry{} }
+func (m *ShareLedgerEntry) String() string { return proto.CompactTextString(m) }
+func (*ShareLedgerEntry) ProtoMessage()    {}
+func (*ShareLedgerEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad73008193152030, []int{0}
+}
+func (m *ShareLedgerEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShareLedgerEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ShareLedgerEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ShareLedgerEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShareLedgerEntry.Merge(m, src)
+}
+func (m *ShareLedgerEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShareLedgerEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShareLedgerEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShareLedgerEntry proto.InternalMessageInfo
+
+func (m *ShareLedgerEntry) GetCampaignID() uint64 {
+	if m != nil {
+		return m.CampaignID
+	}
+	return 0
+}
+
+func (m *ShareLedgerEntry) GetLedgerID() uint64 {
+	if m != nil {
+		return m.LedgerID
+	}
+	return 0
+}
+
+func (m *ShareLedgerEntry) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ShareLedgerEntry) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *ShareLedgerEntry) GetOperation() ShareLedgerEntry_Operation {
+	if m != nil {
+		return m.Operation
+	}
+	return ShareLedgerEntry_MINT_VOUCHERS
+}
+
+func (m *ShareLedgerEntry) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *ShareLedgerEntry) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ShareLedgerEntry) GetShares() Shares {
+	if m != nil {
+		return m.Shares
+	}
+	return nil
+}
+
+// CapTableHolder defines the shares and vouchers of a campaign held by an address
+type CapTableHolder struct {
+	Address  string                                   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Shares   Shares                                   `protobuf:"bytes,2,rep,name=shares,proto3,casttype=github.com/cosmos/cosmos-sdk/types.Coin,castrepeated=Shares" json:"shares"`
+	Vouchers github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=vouchers,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"vouchers"`
+	// ownership is the percentage of the total shares held through shares and vouchers for each share denom
+	Ownership github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,4,rep,name=ownership,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"ownership"`
+}
+
+func (m *CapTableHolder) Reset()         { *m = CapTableHolder{} }
+func (m *CapTableHolder) String() string { return proto.CompactTextString(m) }
+func (*CapTableHolder) ProtoMessage()    {}
+func (*CapTableHolder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad73008193152030, []int{1}
+}
+func (m *CapTableHolder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CapTableHolder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CapTableHolder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CapTableHolder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CapTableHolder.Merge(m, src)
+}
+func (m *CapTableHolder) XXX_Size() int {
+	return m.Size()
+}
+func (m *CapTableHolder) XXX_DiscardUnknown() {
+	xxx_messageInfo_CapTableHolder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CapTableHolder proto.InternalMessageInfo
+
+func (m *CapTableHolder) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *CapTableHolder) GetShares() Shares {
+	if m != nil {
+		return m.Shares
+	}
+	return nil
+}
+
+func (m *CapTableHolder) GetVouchers() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Vouchers
+	}
+	return nil
+}
+
+func (m *CapTableHolder) GetOwnership() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Ownership
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("tendermint.spn.campaign.ShareLedgerEntry_Operation", ShareLedgerEntry_Operation_name, ShareLedgerEntry_Operation_value)
+	proto.RegisterType((*ShareLedgerEntry)(nil), "tendermint.spn.campaign.ShareLedgerEntry")
+	proto.RegisterType((*CapTableHolder)(nil), "tendermint.spn.campaign.CapTableHolder")
+}
+
+func init() { proto.RegisterFile("campaign/share_ledger.proto", fileDescriptor_ad73008193152030) }
+
+var fileDescriptor_ad73008193152030 = []byte{
+	// 549 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0xcf, 0x6e, 0xd3, 0x4a,
+	0x14, 0xc6, 0xe3, 0x38, 0xd7, 0x4d, 0xe6, 0x8a, 0xd2, 0x0e, 0x05, 0xdc, 0x50, 0x39, 0x51, 0x36,
+	0x58, 0xa0, 0xda, 0x6d, 0xb2, 0x62, 0x49, 0xfe, 0x48, 0x8d, 0x44, 0x53, 0xe1, 0x34, 0x2c, 0x90,
+	0x50, 0xe4, 0xd8, 0x23, 0xdb, 0x22, 0x9e, 0xb1, 0x66, 0xa6, 0x85, 0xbe, 0x02, 0x62, 0xc1, 0x73,
+	0x74, 0xcd, 0x43, 0x74, 0x59, 0xb1, 0x62, 0xd5, 0xa2, 0xe4, 0x2d, 0x58, 0x21, 0x8f, 0x27, 0x71,
+	0x15, 0x81, 0x5a, 0x16, 0xac, 0xec, 0x73, 0xce, 0x77, 0xce, 0x6f, 0xe6, 0x1b, 0x8f, 0xc1, 0x13,
+	0xcf, 0x8d, 0x13, 0x37, 0x0a, 0xb0, 0xcd, 0x42, 0x97, 0xa2, 0xf1, 0x14, 0xf9, 0x01, 0xa2, 0x56,
+	0x42, 0x09, 0x27, 0xf0, 0x31, 0x47, 0xd8, 0x47, 0x34, 0x8e, 0x30, 0xb7, 0x58, 0x82, 0xad, 0x85,
+	0xb6, 0xba, 0x15, 0x90, 0x80, 0x08, 0x8d, 0x9d, 0xbe, 0x65, 0xf2, 0xea, 0xb6, 0x47, 0x58, 0x4c,
+	0xd8, 0x38, 0x2b, 0x64, 0x81, 0x2c, 0x19, 0x59, 0x64, 0x4f, 0x5c, 0x86, 0xec, 0xd3, 0xfd, 0x09,
+	0xe2, 0xee, 0xbe, 0xed, 0x91, 0x08, 0x67, 0xf5, 0xc6, 0xa7, 0x12, 0xd8, 0x18, 0xa6, 0x0b, 0x78,
+	0x25, 0xf8, 0x3d, 0xcc, 0xe9, 0x19, 0x34, 0x00, 0x58, 0x10, 0xfb, 0x5d, 0x5d, 0xa9, 0x2b, 0x66,
+	0xc9, 0xb9, 0x91, 0x81, 0x55, 0x50, 0xce, 0x96, 0xdb, 0xef, 0xea, 0x45, 0x51, 0x5d, 0xc6, 0xf0,
+	0x11, 0xd0, 0x42, 0x14, 0x05, 0x21, 0xd7, 0xd5, 0xba, 0x62, 0xaa, 0x8e, 0x8c, 0xe0, 0x0e, 0xa8,
+	0xf0, 0x28, 0x46, 0x8c, 0xbb, 0x71, 0xa2, 0x97, 0x44, 0x29, 0x4f, 0xc0, 0xd7, 0xa0, 0x42, 0x12,
+	0x44, 0x5d, 0x1e, 0x11, 0xac, 0xff, 0x57, 0x57, 0xcc, 0xf5, 0x66, 0xcb, 0xfa, 0x83, 0x09, 0xd6,
+	0xea, 0x7a, 0xad, 0xa3, 0x45, 0xab, 0x93, 0x4f, 0x81, 0x7b, 0x40, 0x63, 0x62, 0x80, 0xae, 0xd5,
+	0x15, 0xb3, 0xd2, 0xd6, 0xbf, 0x7d, 0xdd, 0xdd, 0x92, 0xde, 0xbc, 0xf4, 0x7d, 0x8a, 0x18, 0x1b,
+	0x72, 0x1a, 0xe1, 0xc0, 0x91, 0x3a, 0xd8, 0x04, 0x6b, 0x6e, 0x56, 0xd0, 0xd7, 0x6e, 0x69, 0x59,
+	0x08, 0xe1, 0x14, 0x68, 0xe2, 0xfc, 0x98, 0x5e, 0xae, 0xab, 0xe6, 0xff, 0xcd, 0x6d, 0x4b, 0xea,
+	0x53, 0xc3, 0x2d, 0x69, 0xb8, 0xd5, 0x21, 0x11, 0x6e, 0xbf, 0xb8, 0xb8, 0xaa, 0x15, 0x7e, 0x5e,
+	0xd5, 0x9e, 0x06, 0x11, 0x0f, 0x4f, 0x26, 0x96, 0x47, 0x62, 0x79, 0x56, 0xf2, 0xb1, 0xcb, 0xfc,
+	0xf7, 0x36, 0x3f, 0x4b, 0x10, 0x13, 0x0d, 0xe7, 0xd7, 0x35, 0x4d, 0x6c, 0x95, 0x39, 0x92, 0xd1,
+	0x78, 0x07, 0x2a, 0xcb, 0xbd, 0xc2, 0x4d, 0x70, 0xef, 0xb0, 0x3f, 0x38, 0x1e, 0xbf, 0x39, 0x1a,
+	0x75, 0x0e, 0x7a, 0xce, 0x70, 0xa3, 0x90, 0xa6, 0xda, 0x23, 0x67, 0x90, 0xa7, 0x14, 0xf8, 0x00,
+	0xdc, 0x77, 0x7a, 0xdd, 0x5e, 0xef, 0x30, 0x4f, 0x16, 0xe1, 0x43, 0xb0, 0x39, 0x1a, 0xac, 0xa6,
+	0xd5, 0xc6, 0x67, 0x15, 0xac, 0x77, 0xdc, 0xe4, 0xd8, 0x9d, 0x4c, 0xd1, 0x01, 0x99, 0xae, 0x78,
+	0xa2, 0xfc, 0xbd, 0x27, 0xc5, 0x7f, 0xef, 0x09, 0x0c, 0x40, 0xf9, 0x94, 0x9c, 0x78, 0x21, 0xa2,
+	0x4c, 0x57, 0x6f, 0xe3, 0xed, 0xa5, 0xbc, 0xf3, 0xeb, 0x9a, 0x79, 0x47, 0x1e, 0x73, 0x96, 0xc3,
+	0x21, 0x01, 0x15, 0xf2, 0x01, 0x23, 0xca, 0xc2, 0x28, 0xfd, 0x82, 0x53, 0xd2, 0xce, 0x6f, 0x49,
+	0x5d, 0xe4, 0x09, 0x58, 0x4b, 0xc2, 0x9e, 0xdf, 0x01, 0x26, 0x7b, 0x98, 0x93, 0x33, 0xda, 0xdd,
+	0x8b, 0x99, 0xa1, 0x5c, 0xce, 0x0c, 0xe5, 0xc7, 0xcc, 0x50, 0xbe, 0xcc, 0x8d, 0xc2, 0xe5, 0xdc,
+	0x28, 0x7c, 0x9f, 0x1b, 0x85, 0xb7, 0xcf, 0x6e, 0x4c, 0xcc, 0x6f, 0x89, 0xcd, 0x12, 0x6c, 0x7f,
+	0xb4, 0x97, 0x3f, 0x16, 0x31, 0x79, 0xa2, 0x89, 0x8b, 0xde, 0xfa, 0x35, 0x00, 0xca, 0x4b, 0xf5,
+	0x85, 0x71, 0x04, 0x00, 0x00,
+}
+
+func (m *ShareLedgerEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShareLedgerEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShareLedgerEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Shares) > 0 {
+		for iNdEx := len(m.Shares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintShareLedger(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintShareLedger(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintShareLedger(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Operation != 0 {
+		i = encodeVarintShareLedger(dAtA, i, uint64(m.Operation))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintShareLedger(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Height != 0 {
+		i = encodeVarintShareLedger(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LedgerID != 0 {
+		i = encodeVarintShareLedger(dAtA, i, uint64(m.LedgerID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CampaignID != 0 {
+		i = encodeVarintShareLedger(dAtA, i, uint64(m.CampaignID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CapTableHolder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CapTableHolder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CapTableHolder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ownership) > 0 {
+		for iNdEx := len(m.Ownership) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ownership[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintShareLedger(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Vouchers) > 0 {
+		for iNdEx := len(m.Vouchers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Vouchers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintShareLedger(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Shares) > 0 {
+		for iNdEx := len(m.Shares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintShareLedger(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintShareLedger(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintShareLedger(dAtA []byte, offset int, v uint64) int {
+	offset -= sovShareLedger(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ShareLedgerEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CampaignID != 0 {
+		n += 1 + sovShareLedger(uint64(m.CampaignID))
+	}
+	if m.LedgerID != 0 {
+		n += 1 + sovShareLedger(uint64(m.LedgerID))
+	}
+	if m.Height != 0 {
+		n += 1 + sovShareLedger(uint64(m.Height))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovShareLedger(uint64(m.Timestamp))
+	}
+	if m.Operation != 0 {
+		n += 1 + sovShareLedger(uint64(m.Operation))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovShareLedger(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovShareLedger(uint64(l))
+	}
+	if len(m.Shares) > 0 {
+		for _, e := range m.Shares {
+			l = e.Size()
+			n += 1 + l + sovShareLedger(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CapTableHolder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovShareLedger(uint64(l))
+	}
+	if len(m.Shares) > 0 {
+		for _, e := range m.Shares {
+			l = e.Size()
+			n += 1 + l + sovShareLedger(uint64(l))
+		}
+	}
+	if len(m.Vouchers) > 0 {
+		for _, e := range m.Vouchers {
+			l = e.Size()
+			n += 1 + l + sovShareLedger(uint64(l))
+		}
+	}
+	if len(m.Ownership) > 0 {
+		for _, e := range m.Ownership {
+			l = e.Size()
+			n += 1 + l + sovShareLedger(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovShareLedger(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozShareLedger(x uint64) (n int) {
+	return sovShareLedger(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ShareLedgerEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowShareLedger
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShareLedgerEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShareLedgerEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignID", wireType)
+			}
+			m.CampaignID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShareLedger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LedgerID", wireType)
+			}
+			m.LedgerID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShareLedger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LedgerID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShareLedger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShareLedger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+			}
+			m.Operation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShareLedger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Operation |= ShareLedgerEntry_Operation(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShareLedger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthShareLedger
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthShareLedger
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShareLedger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthShareLedger
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthShareLedger
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShareLedger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthShareLedger
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthShareLedger
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shares = append(m.Shares, github_com_cosmos_cosmos_sdk_types.Coin{})
+			if err := m.Shares[len(m.Shares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipShareLedger(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthShareLedger
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CapTableHolder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowShareLedger
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CapTableHolder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CapTableHolder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShareLedger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthShareLedger
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthShareLedger
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShareLedger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthShareLedger
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthShareLedger
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shares = append(m.Shares, github_com_cosmos_cosmos_sdk_types.Coin{})
+			if err := m.Shares[len(m.Shares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vouchers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShareLedger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthShareLedger
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthShareLedger
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vouchers = append(m.Vouchers, types.Coin{})
+			if err := m.Vouchers[len(m.Vouchers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ownership", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShareLedger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthShareLedger
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthShareLedger
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ownership = append(m.Ownership, types.DecCoin{})
+			if err := m.Ownership[len(m.Ownership)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipShareLedger(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthShareLedger
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipShareLedger(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowShareLedger
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowShareLedger
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowShareLedger
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthShareLedger
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupShareLedger
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthShareLedger
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthShareLedger        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowShareLedger          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupShareLedger = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,94 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tc "github.com/tendermint/spn/testutil/constructor"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/campaign/types"
+)
+
+func TestShareLedgerEntry_Validate(t *testing.T) {
+	for _, tt := range []struct {
+		desc  string
+		entry func() types.ShareLedgerEntry
+		valid bool
+	}{
+		{
+			desc:  "should validate a valid entry",
+			entry: func() types.ShareLedgerEntry { return sample.ShareLedgerEntry(r, 0, 0) },
+			valid: true,
+		},
+		{
+			desc: "should prevent validate an entry with an invalid operation",
+			entry: func() types.ShareLedgerEntry {
+				entry := sample.ShareLedgerEntry(r, 0, 0)
+				entry.Operation = types.ShareLedgerEntry_Operation(10)
+				return entry
+			},
+		},
+		{
+			desc: "should prevent validate an entry with a negative height",
+			entry: func() types.ShareLedgerEntry {
+				entry := sample.ShareLedgerEntry(r, 0, 0)
+				entry.Height = -1
+				return entry
+			},
+		},
+		{
+			desc: "should prevent validate an entry with an invalid sender",
+			entry: func() types.ShareLedgerEntry {
+				entry := sample.ShareLedgerEntry(r, 0, 0)
+				entry.Sender = "invalid"
+				return entry
+			},
+		},
+		{
+			desc: "should prevent validate an entry with an invalid address",
+			entry: func() types.ShareLedgerEntry {
+				entry := sample.ShareLedgerEntry(r, 0, 0)
+				entry.Address = "invalid"
+				return entry
+			},
+		},
+		{
+			desc: "should prevent validate an entry with invalid shares",
+			entry: func() types.ShareLedgerEntry {
+				entry := sample.ShareLedgerEntry(r, 0, 0)
+				entry.Shares = types.Shares(tc.Coins(t, "100foo"))
+				return entry
+			},
+		},
+		{
+			desc: "should prevent validate an entry with empty shares",
+			entry: func() types.ShareLedgerEntry {
+				entry := sample.ShareLedgerEntry(r, 0, 0)
+				entry.Shares = types.EmptyShares()
+				return entry
+			},
+		},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			err := tt.entry().Validate()
+			if tt.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestShareLedgerEntry_Involves(t *testing.T) {
+	var (
+		sender  = sample.Address(r)
+		account = sample.Address(r)
+		entry   = types.ShareLedgerEntry{Sender: sender, Address: account}
+	)
+
+	require.True(t, entry.Involves(sender))
+	require.True(t, entry.Involves(account))
+	require.False(t, entry.Involves(sample.Address(r)))
+}
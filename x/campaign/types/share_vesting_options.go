@@ -20,7 +20,57 @@ func NewShareDelayedVesting(totalShare, vesting Shares, endTime int64) *ShareVes
 	}
 }
 
-// Validate check the ShareDelayedVesting object
+// NewShareContinuousVesting returns the ShareVestingOptions for a continuous vesting
+func NewShareContinuousVesting(totalShares, vesting Shares, startTime, endTime int64) *ShareVestingOptions {
+	return &ShareVestingOptions{
+		Options: &ShareVestingOptions_ContinuousVesting{
+			ContinuousVesting: &ShareContinuousVesting{
+				TotalShares: totalShares,
+				Vesting:     vesting,
+				StartTime:   startTime,
+				EndTime:     endTime,
+			},
+		},
+	}
+}
+
+// NewSharePeriodicVesting returns the ShareVestingOptions for a periodic vesting
+func NewSharePeriodicVesting(totalShares Shares, startTime int64, periods []ShareVestingPeriod) *ShareVestingOptions {
+	return &ShareVestingOptions{
+		Options: &ShareVestingOptions_PeriodicVesting{
+			PeriodicVesting: &SharePeriodicVesting{
+				TotalShares: totalShares,
+				StartTime:   startTime,
+				Periods:     periods,
+			},
+		},
+	}
+}
+
+// Vesting returns the total vesting shares of the periodic vesting
+func (m SharePeriodicVesting) Vesting() Shares {
+	vesting := EmptyShares()
+	for _, period := range m.Periods {
+		vesting = IncreaseShares(vesting, period.Shares)
+	}
+	return vesting
+}
+
+// TotalShares returns the total shares of the account with the share vesting options
+func (m ShareVestingOptions) TotalShares() Shares {
+	switch opt := m.Options.(type) {
+	case *ShareVestingOptions_DelayedVesting:
+		return opt.DelayedVesting.TotalShares
+	case *ShareVestingOptions_ContinuousVesting:
+		return opt.ContinuousVesting.TotalShares
+	case *ShareVestingOptions_PeriodicVesting:
+		return opt.PeriodicVesting.TotalShares
+	default:
+		return EmptyShares()
+	}
+}
+
+// Validate check the share vesting options
 func (m ShareVestingOptions) Validate() error {
 	switch vestionOptions := m.Options.(type) {
 	case *ShareVestingOptions_DelayedVesting:
@@ -49,6 +99,74 @@ func (m ShareVestingOptions) Validate() error {
 		if vestionOptions.DelayedVesting.EndTime == 0 {
 			return errors.New("end time for DelayedVesting cannot be 0")
 		}
+	case *ShareVestingOptions_ContinuousVesting:
+		cv := vestionOptions.ContinuousVesting
+
+		if sdk.Coins(cv.Vesting).Empty() {
+			return errors.New("empty vesting shares for ShareContinuousVesting")
+		}
+		if !sdk.Coins(cv.Vesting).IsValid() {
+			return fmt.Errorf(
+				"invalid vesting shares for ContinuousVesting: %s",
+				sdk.Coins(cv.Vesting).String(),
+			)
+		}
+
+		if !sdk.Coins(cv.TotalShares).IsValid() {
+			return fmt.Errorf(
+				"invalid total balance for ContinuousVesting: %s",
+				sdk.Coins(cv.TotalShares).String(),
+			)
+		}
+		if !cv.Vesting.IsAllLTE(cv.TotalShares) {
+			return errors.New("vesting is not a subset of the total shares")
+		}
+
+		if cv.StartTime == 0 {
+			return errors.New("start time for ContinuousVesting cannot be 0")
+		}
+		if cv.EndTime <= cv.StartTime {
+			return fmt.Errorf(
+				"end time for ContinuousVesting must be after start time: %d <= %d",
+				cv.EndTime,
+				cv.StartTime,
+			)
+		}
+	case *ShareVestingOptions_PeriodicVesting:
+		pv := vestionOptions.PeriodicVesting
+
+		if len(pv.Periods) == 0 {
+			return errors.New("no period for SharePeriodicVesting")
+		}
+		for i, period := range pv.Periods {
+			if period.Length <= 0 {
+				return fmt.Errorf("length of period %d for PeriodicVesting must be positive: %d", i, period.Length)
+			}
+			if period.Shares.Empty() {
+				return fmt.Errorf("empty shares for period %d of SharePeriodicVesting", i)
+			}
+			if !sdk.Coins(period.Shares).IsValid() {
+				return fmt.Errorf(
+					"invalid shares for period %d of PeriodicVesting: %s",
+					i,
+					period.Shares.String(),
+				)
+			}
+		}
+
+		if !sdk.Coins(pv.TotalShares).IsValid() {
+			return fmt.Errorf(
+				"invalid total balance for PeriodicVesting: %s",
+				sdk.Coins(pv.TotalShares).String(),
+			)
+		}
+		if !pv.Vesting().IsAllLTE(pv.TotalShares) {
+			return errors.New("vesting is not a subset of the total shares")
+		}
+
+		if pv.StartTime == 0 {
+			return errors.New("start time for PeriodicVesting cannot be 0")
+		}
 	default:
 		return errors.New("unrecognized vesting options")
 	}
@@ -142,3 +142,146 @@ func TestDelayedVesting_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestShareContinuousVesting_Validate(t *testing.T) {
+	totalShares := tc.Shares(t, "1000foo,1000bar,500toto")
+	vesting := tc.Shares(t, "1000foo,500bar")
+	startTime := time.Now().Unix()
+	endTime := startTime + 1000
+
+	tests := []struct {
+		name   string
+		option types.ShareVestingOptions
+		valid  bool
+	}{
+		{
+			name:   "no vesting shares",
+			option: *types.NewShareContinuousVesting(totalShares, types.EmptyShares(), startTime, endTime),
+			valid:  false,
+		},
+		{
+			name: "invalid vesting shares",
+			option: *types.NewShareContinuousVesting(
+				totalShares,
+				types.Shares{sdk.Coin{Denom: "", Amount: sdkmath.NewInt(10)}},
+				startTime,
+				endTime,
+			),
+			valid: false,
+		},
+		{
+			name: "invalid total shares",
+			option: *types.NewShareContinuousVesting(
+				types.Shares{sdk.Coin{Denom: "", Amount: sdkmath.NewInt(10)}},
+				vesting,
+				startTime,
+				endTime,
+			),
+			valid: false,
+		},
+		{
+			name: "total shares smaller than vesting",
+			option: *types.NewShareContinuousVesting(
+				tc.Shares(t, "1000foo,499bar"),
+				vesting,
+				startTime,
+				endTime,
+			),
+			valid: false,
+		},
+		{
+			name:   "no start time",
+			option: *types.NewShareContinuousVesting(totalShares, vesting, 0, endTime),
+			valid:  false,
+		},
+		{
+			name:   "end time before start time",
+			option: *types.NewShareContinuousVesting(totalShares, vesting, endTime, startTime),
+			valid:  false,
+		},
+		{
+			name:   "valid continuous vesting",
+			option: *types.NewShareContinuousVesting(totalShares, vesting, startTime, endTime),
+			valid:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.option.Validate()
+			if tt.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestSharePeriodicVesting_Validate(t *testing.T) {
+	totalShares := tc.Shares(t, "1000foo,1000bar,500toto")
+	periods := []types.ShareVestingPeriod{
+		{Length: 100, Shares: tc.Shares(t, "500foo")},
+		{Length: 200, Shares: tc.Shares(t, "500foo,500bar")},
+	}
+	startTime := time.Now().Unix()
+
+	tests := []struct {
+		name   string
+		option types.ShareVestingOptions
+		valid  bool
+	}{
+		{
+			name:   "no period",
+			option: *types.NewSharePeriodicVesting(totalShares, startTime, nil),
+			valid:  false,
+		},
+		{
+			name: "period with no length",
+			option: *types.NewSharePeriodicVesting(totalShares, startTime, []types.ShareVestingPeriod{
+				{Length: 0, Shares: tc.Shares(t, "500foo")},
+			}),
+			valid: false,
+		},
+		{
+			name: "period with no shares",
+			option: *types.NewSharePeriodicVesting(totalShares, startTime, []types.ShareVestingPeriod{
+				{Length: 100, Shares: types.EmptyShares()},
+			}),
+			valid: false,
+		},
+		{
+			name: "invalid total shares",
+			option: *types.NewSharePeriodicVesting(
+				types.Shares{sdk.Coin{Denom: "", Amount: sdkmath.NewInt(10)}},
+				startTime,
+				periods,
+			),
+			valid: false,
+		},
+		{
+			name:   "total shares smaller than vesting",
+			option: *types.NewSharePeriodicVesting(tc.Shares(t, "999foo,500bar"), startTime, periods),
+			valid:  false,
+		},
+		{
+			name:   "no start time",
+			option: *types.NewSharePeriodicVesting(totalShares, 0, periods),
+			valid:  false,
+		},
+		{
+			name:   "valid periodic vesting",
+			option: *types.NewSharePeriodicVesting(totalShares, startTime, periods),
+			valid:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.option.Validate()
+			if tt.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
@@ -558,6 +559,94 @@ func (m *MsgInitializeMainnetResponse) GetMainnetID() uint64 {
 	return 0
 }
 
+type MsgResetMainnet struct {
+	Coordinator string `protobuf:"bytes,1,opt,name=coordinator,proto3" json:"coordinator,omitempty"`
+	CampaignID  uint64 `protobuf:"varint,2,opt,name=campaignID,proto3" json:"campaignID,omitempty"`
+}
+
+func (m *MsgResetMainnet) Reset()         { *m = MsgResetMainnet{} }
+func (m *MsgResetMainnet) String() string { return proto.CompactTextString(m) }
+func (*MsgResetMainnet) ProtoMessage()    {}
+func (*MsgResetMainnet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb6bf904ffc53c1f, []int{10}
+}
+func (m *MsgResetMainnet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResetMainnet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResetMainnet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResetMainnet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResetMainnet.Merge(m, src)
+}
+func (m *MsgResetMainnet) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResetMainnet) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResetMainnet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResetMainnet proto.InternalMessageInfo
+
+func (m *MsgResetMainnet) GetCoordinator() string {
+	if m != nil {
+		return m.Coordinator
+	}
+	return ""
+}
+
+func (m *MsgResetMainnet) GetCampaignID() uint64 {
+	if m != nil {
+		return m.CampaignID
+	}
+	return 0
+}
+
+type MsgResetMainnetResponse struct {
+}
+
+func (m *MsgResetMainnetResponse) Reset()         { *m = MsgResetMainnetResponse{} }
+func (m *MsgResetMainnetResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResetMainnetResponse) ProtoMessage()    {}
+func (*MsgResetMainnetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb6bf904ffc53c1f, []int{11}
+}
+func (m *MsgResetMainnetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResetMainnetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResetMainnetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResetMainnetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResetMainnetResponse.Merge(m, src)
+}
+func (m *MsgResetMainnetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResetMainnetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResetMainnetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResetMainnetResponse proto.InternalMessageInfo
+
 type MsgMintVouchers struct {
 	Coordinator string `protobuf:"bytes,1,opt,name=coordinator,proto3" json:"coordinator,omitempty"`
 	CampaignID  uint64 `protobuf:"varint,2,opt,name=campaignID,proto3" json:"campaignID,omitempty"`
@@ -568,7 +657,7 @@ func (m *MsgMintVouchers) Reset()         { *m = MsgMintVouchers{} }
 func (m *MsgMintVouchers) String() string { return proto.CompactTextString(m) }
 func (*MsgMintVouchers) ProtoMessage()    {}
 func (*MsgMintVouchers) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fb6bf904ffc53c1f, []int{10}
+	return fileDescriptor_fb6bf904ffc53c1f, []int{12}
 }
 func (m *MsgMintVouchers) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -625,7 +714,7 @@ func (m *MsgMintVouchersResponse) Reset()         { *m = MsgMintVouchersResponse
 func (m *MsgMintVouchersResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgMintVouchersResponse) ProtoMessage()    {}
 func (*MsgMintVouchersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fb6bf904ffc53c1f, []int{11}
+	return fileDescriptor_fb6bf904ffc53c1f, []int{13}
 }
 func (m *MsgMintVouchersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -664,7 +753,7 @@ func (m *MsgBurnVouchers) Reset()         { *m = MsgBurnVouchers{} }
 func (m *MsgBurnVouchers) String() string { return proto.CompactTextString(m) }
 func (*MsgBurnVouchers) ProtoMessage()    {}
 func (*MsgBurnVouchers) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fb6bf904ffc53c1f, []int{12}
+	return fileDescriptor_fb6bf904ffc53c1f, []int{14}
 }
 func (m *MsgBurnVouchers) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -721,7 +810,7 @@ func (m *MsgBurnVouchersResponse) Reset()         { *m = MsgBurnVouchersResponse
 func (m *MsgBurnVouchersResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgBurnVouchersResponse) ProtoMessage()    {}
 func (*MsgBurnVouchersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fb6bf904ffc53c1f, []int{13}
+	return fileDescriptor_fb6bf904ffc53c1f, []int{15}
 }
 func (m *MsgBurnVouchersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -761,7 +850,7 @@ func (m *MsgRedeemVouchers) Reset()         { *m = MsgRedeemVouchers{} }
 func (m *MsgRedeemVouchers) String() string { return proto.CompactTextString(m) }
 func (*MsgRedeemVouchers) ProtoMessage()    {}
 func (*MsgRedeemVouchers) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fb6bf904ffc53c1f, []int{14}
+	return fileDescriptor_fb6bf904ffc53c1f, []int{16}
 }
 func (m *MsgRedeemVouchers) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -825,7 +914,7 @@ func (m *MsgRedeemVouchersResponse) Reset()         { *m = MsgRedeemVouchersResp
 func (m *MsgRedeemVouchersResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRedeemVouchersResponse) ProtoMessage()    {}
 func (*MsgRedeemVouchersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fb6bf904ffc53c1f, []int{15}
+	return fileDescriptor_fb6bf904ffc53c1f, []int{17}
 }
 func (m *MsgRedeemVouchersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -864,7 +953,7 @@ func (m *MsgUnredeemVouchers) Reset()         { *m = MsgUnredeemVouchers{} }
 func (m *MsgUnredeemVouchers) String() string { return proto.CompactTextString(m) }
 func (*MsgUnredeemVouchers) ProtoMessage()    {}
 func (*MsgUnredeemVouchers) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fb6bf904ffc53c1f, []int{16}
+	return fileDescriptor_fb6bf904ffc53c1f, []int{18}
 }
 func (m *MsgUnredeemVouchers) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -921,7 +1010,7 @@ func (m *MsgUnredeemVouchersResponse) Reset()         { *m = MsgUnredeemVouchers
 func (m *MsgUnredeemVouchersResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUnredeemVouchersResponse) ProtoMessage()    {}
 func (*MsgUnredeemVouchersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fb6bf904ffc53c1f, []int{17}
+	return fileDescriptor_fb6bf904ffc53c1f, []int{19}
 }
 func (m *MsgUnredeemVouchersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -950,6 +1039,312 @@ func (m *MsgUnredeemVouchersResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUnredeemVouchersResponse proto.InternalMessageInfo
 
+type MsgUpdateParams struct {
+	// authority is the address of the governance account
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the parameters to update, all the parameters must be provided
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb6bf904ffc53c1f, []int{20}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb6bf904ffc53c1f, []int{21}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+type MsgSetCampaignAirdrop struct {
+	Coordinator  string               `protobuf:"bytes,1,opt,name=coordinator,proto3" json:"coordinator,omitempty"`
+	CampaignID   uint64               `protobuf:"varint,2,opt,name=campaignID,proto3" json:"campaignID,omitempty"`
+	Denom        string               `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	Missions     []AirdropMission     `protobuf:"bytes,4,rep,name=missions,proto3" json:"missions"`
+	ClaimRecords []AirdropClaimRecord `protobuf:"bytes,5,rep,name=claimRecords,proto3" json:"claimRecords"`
+}
+
+func (m *MsgSetCampaignAirdrop) Reset()         { *m = MsgSetCampaignAirdrop{} }
+func (m *MsgSetCampaignAirdrop) String() string { return proto.CompactTextString(m) }
+func (*MsgSetCampaignAirdrop) ProtoMessage()    {}
+func (*MsgSetCampaignAirdrop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb6bf904ffc53c1f, []int{22}
+}
+func (m *MsgSetCampaignAirdrop) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetCampaignAirdrop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetCampaignAirdrop.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetCampaignAirdrop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetCampaignAirdrop.Merge(m, src)
+}
+func (m *MsgSetCampaignAirdrop) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetCampaignAirdrop) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetCampaignAirdrop.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetCampaignAirdrop proto.InternalMessageInfo
+
+func (m *MsgSetCampaignAirdrop) GetCoordinator() string {
+	if m != nil {
+		return m.Coordinator
+	}
+	return ""
+}
+
+func (m *MsgSetCampaignAirdrop) GetCampaignID() uint64 {
+	if m != nil {
+		return m.CampaignID
+	}
+	return 0
+}
+
+func (m *MsgSetCampaignAirdrop) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MsgSetCampaignAirdrop) GetMissions() []AirdropMission {
+	if m != nil {
+		return m.Missions
+	}
+	return nil
+}
+
+func (m *MsgSetCampaignAirdrop) GetClaimRecords() []AirdropClaimRecord {
+	if m != nil {
+		return m.ClaimRecords
+	}
+	return nil
+}
+
+type MsgSetCampaignAirdropResponse struct {
+}
+
+func (m *MsgSetCampaignAirdropResponse) Reset()         { *m = MsgSetCampaignAirdropResponse{} }
+func (m *MsgSetCampaignAirdropResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetCampaignAirdropResponse) ProtoMessage()    {}
+func (*MsgSetCampaignAirdropResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb6bf904ffc53c1f, []int{23}
+}
+func (m *MsgSetCampaignAirdropResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetCampaignAirdropResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetCampaignAirdropResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetCampaignAirdropResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetCampaignAirdropResponse.Merge(m, src)
+}
+func (m *MsgSetCampaignAirdropResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetCampaignAirdropResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetCampaignAirdropResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetCampaignAirdropResponse proto.InternalMessageInfo
+
+type MsgSetVoucherTransferPolicy struct {
+	Coordinator string                     `protobuf:"bytes,1,opt,name=coordinator,proto3" json:"coordinator,omitempty"`
+	CampaignID  uint64                     `protobuf:"varint,2,opt,name=campaignID,proto3" json:"campaignID,omitempty"`
+	Mode        VoucherTransferPolicy_Mode `protobuf:"varint,3,opt,name=mode,proto3,enum=tendermint.spn.campaign.VoucherTransferPolicy_Mode" json:"mode,omitempty"`
+	Allowlist   []string                   `protobuf:"bytes,4,rep,name=allowlist,proto3" json:"allowlist,omitempty"`
+}
+
+func (m *MsgSetVoucherTransferPolicy) Reset()         { *m = MsgSetVoucherTransferPolicy{} }
+func (m *MsgSetVoucherTransferPolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgSetVoucherTransferPolicy) ProtoMessage()    {}
+func (*MsgSetVoucherTransferPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb6bf904ffc53c1f, []int{24}
+}
+func (m *MsgSetVoucherTransferPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetVoucherTransferPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetVoucherTransferPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetVoucherTransferPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetVoucherTransferPolicy.Merge(m, src)
+}
+func (m *MsgSetVoucherTransferPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetVoucherTransferPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetVoucherTransferPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetVoucherTransferPolicy proto.InternalMessageInfo
+
+func (m *MsgSetVoucherTransferPolicy) GetCoordinator() string {
+	if m != nil {
+		return m.Coordinator
+	}
+	return ""
+}
+
+func (m *MsgSetVoucherTransferPolicy) GetCampaignID() uint64 {
+	if m != nil {
+		return m.CampaignID
+	}
+	return 0
+}
+
+func (m *MsgSetVoucherTransferPolicy) GetMode() VoucherTransferPolicy_Mode {
+	if m != nil {
+		return m.Mode
+	}
+	return VoucherTransferPolicy_FREE
+}
+
+func (m *MsgSetVoucherTransferPolicy) GetAllowlist() []string {
+	if m != nil {
+		return m.Allowlist
+	}
+	return nil
+}
+
+type MsgSetVoucherTransferPolicyResponse struct {
+}
+
+func (m *MsgSetVoucherTransferPolicyResponse) Reset()         { *m = MsgSetVoucherTransferPolicyResponse{} }
+func (m *MsgSetVoucherTransferPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetVoucherTransferPolicyResponse) ProtoMessage()    {}
+func (*MsgSetVoucherTransferPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb6bf904ffc53c1f, []int{25}
+}
+func (m *MsgSetVoucherTransferPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetVoucherTransferPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetVoucherTransferPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetVoucherTransferPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetVoucherTransferPolicyResponse.Merge(m, src)
+}
+func (m *MsgSetVoucherTransferPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetVoucherTransferPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetVoucherTransferPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetVoucherTransferPolicyResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateCampaign)(nil), "tendermint.spn.campaign.MsgCreateCampaign")
 	proto.RegisterType((*MsgCreateCampaignResponse)(nil), "tendermint.spn.campaign.MsgCreateCampaignResponse")
@@ -961,6 +1356,8 @@ func init() {
 	proto.RegisterType((*MsgUpdateSpecialAllocationsResponse)(nil), "tendermint.spn.campaign.MsgUpdateSpecialAllocationsResponse")
 	proto.RegisterType((*MsgInitializeMainnet)(nil), "tendermint.spn.campaign.MsgInitializeMainnet")
 	proto.RegisterType((*MsgInitializeMainnetResponse)(nil), "tendermint.spn.campaign.MsgInitializeMainnetResponse")
+	proto.RegisterType((*MsgResetMainnet)(nil), "tendermint.spn.campaign.MsgResetMainnet")
+	proto.RegisterType((*MsgResetMainnetResponse)(nil), "tendermint.spn.campaign.MsgResetMainnetResponse")
 	proto.RegisterType((*MsgMintVouchers)(nil), "tendermint.spn.campaign.MsgMintVouchers")
 	proto.RegisterType((*MsgMintVouchersResponse)(nil), "tendermint.spn.campaign.MsgMintVouchersResponse")
 	proto.RegisterType((*MsgBurnVouchers)(nil), "tendermint.spn.campaign.MsgBurnVouchers")
@@ -969,68 +1366,95 @@ func init() {
 	proto.RegisterType((*MsgRedeemVouchersResponse)(nil), "tendermint.spn.campaign.MsgRedeemVouchersResponse")
 	proto.RegisterType((*MsgUnredeemVouchers)(nil), "tendermint.spn.campaign.MsgUnredeemVouchers")
 	proto.RegisterType((*MsgUnredeemVouchersResponse)(nil), "tendermint.spn.campaign.MsgUnredeemVouchersResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "tendermint.spn.campaign.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "tendermint.spn.campaign.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSetCampaignAirdrop)(nil), "tendermint.spn.campaign.MsgSetCampaignAirdrop")
+	proto.RegisterType((*MsgSetCampaignAirdropResponse)(nil), "tendermint.spn.campaign.MsgSetCampaignAirdropResponse")
+	proto.RegisterType((*MsgSetVoucherTransferPolicy)(nil), "tendermint.spn.campaign.MsgSetVoucherTransferPolicy")
+	proto.RegisterType((*MsgSetVoucherTransferPolicyResponse)(nil), "tendermint.spn.campaign.MsgSetVoucherTransferPolicyResponse")
 }
 
 func init() { proto.RegisterFile("campaign/tx.proto", fileDescriptor_fb6bf904ffc53c1f) }
 
 var fileDescriptor_fb6bf904ffc53c1f = []byte{
-	// 889 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4f, 0x8f, 0xdb, 0x44,
-	0x14, 0x8f, 0x37, 0x21, 0x6c, 0x5e, 0xa2, 0x42, 0x4c, 0xd5, 0x66, 0xdd, 0xc5, 0x1b, 0x19, 0x01,
-	0x51, 0xa1, 0x36, 0x0d, 0xe5, 0x80, 0xe8, 0x85, 0xa4, 0x48, 0x54, 0x22, 0x1c, 0xbc, 0x94, 0x03,
-	0x1c, 0xd0, 0xc4, 0x19, 0x39, 0x53, 0xe2, 0x19, 0xcb, 0x33, 0x89, 0xba, 0x5c, 0xb9, 0xa3, 0x8a,
-	0x13, 0x1f, 0x80, 0x13, 0xe2, 0x1b, 0x20, 0x21, 0xc1, 0xa9, 0xc7, 0x1e, 0x39, 0x2d, 0x68, 0xf7,
-	0x5b, 0xec, 0x05, 0xe4, 0x3f, 0x99, 0xd8, 0x71, 0xe2, 0xdd, 0xb0, 0x11, 0xcb, 0x69, 0x33, 0x6f,
-	0x7f, 0xf3, 0xde, 0xfb, 0xfd, 0xe6, 0xfd, 0x49, 0xa0, 0xe9, 0x20, 0xcf, 0x47, 0xc4, 0xa5, 0x96,
-	0x78, 0x62, 0xfa, 0x01, 0x13, 0x4c, 0xbd, 0x29, 0x30, 0x1d, 0xe1, 0xc0, 0x23, 0x54, 0x98, 0xdc,
-	0xa7, 0xe6, 0x1c, 0xa1, 0x5d, 0x77, 0x99, 0xcb, 0x22, 0x8c, 0x15, 0x7e, 0x8a, 0xe1, 0x9a, 0xee,
-	0x30, 0xee, 0x31, 0x6e, 0x0d, 0x11, 0xc7, 0xd6, 0xec, 0xee, 0x10, 0x0b, 0x74, 0xd7, 0x72, 0x18,
-	0xa1, 0xc9, 0xff, 0x6f, 0xc8, 0x08, 0x33, 0xcc, 0x05, 0xa1, 0x6e, 0x62, 0x37, 0xa4, 0x9d, 0xfb,
-	0xd8, 0x21, 0x68, 0xf2, 0x15, 0x9a, 0x4c, 0x98, 0x83, 0x04, 0x61, 0x94, 0xc7, 0x18, 0xe3, 0xe9,
-	0x0e, 0x34, 0x07, 0xdc, 0xed, 0x07, 0x18, 0x09, 0xdc, 0x4f, 0xf0, 0x6a, 0x1b, 0xea, 0x0e, 0x63,
-	0xc1, 0x88, 0x50, 0x24, 0x58, 0xd0, 0x52, 0xda, 0x4a, 0xa7, 0x66, 0xa7, 0x4d, 0xaa, 0x01, 0x8d,
-	0xb9, 0xf7, 0x4f, 0x91, 0x87, 0x5b, 0x3b, 0x11, 0x24, 0x63, 0x53, 0x7f, 0x50, 0xa0, 0x2e, 0x98,
-	0x40, 0x93, 0xc3, 0xa9, 0xef, 0x4f, 0x8e, 0x5a, 0xe5, 0x76, 0xb9, 0x53, 0xef, 0xee, 0x99, 0x31,
-	0x1d, 0x33, 0xa4, 0x63, 0x26, 0x74, 0xcc, 0x3e, 0x23, 0xb4, 0xf7, 0xe5, 0xb3, 0xe3, 0x83, 0xd2,
-	0xd9, 0xf1, 0xc1, 0x9b, 0x2e, 0x11, 0xe3, 0xe9, 0xd0, 0x74, 0x98, 0x67, 0x25, 0xdc, 0xe3, 0x3f,
-	0x77, 0xf8, 0xe8, 0x6b, 0x4b, 0x1c, 0xf9, 0x98, 0x47, 0x17, 0x7e, 0xfa, 0xf3, 0xa0, 0x73, 0x41,
-	0x28, 0xb7, 0xd3, 0xa9, 0xa8, 0x1a, 0xec, 0x7a, 0x58, 0xa0, 0x11, 0x12, 0xa8, 0x55, 0x69, 0x2b,
-	0x9d, 0x86, 0x2d, 0xcf, 0xc6, 0x07, 0xb0, 0x97, 0x53, 0xc4, 0xc6, 0xdc, 0x67, 0x94, 0x63, 0x55,
-	0x07, 0x98, 0x73, 0x7c, 0xf8, 0x20, 0x12, 0xa6, 0x62, 0xa7, 0x2c, 0xc6, 0xb7, 0x0a, 0xbc, 0x34,
-	0xe0, 0xee, 0x47, 0x23, 0x22, 0x36, 0x50, 0x33, 0xeb, 0x75, 0x67, 0xd9, 0xab, 0xaa, 0x42, 0x85,
-	0x86, 0x2a, 0x97, 0xa3, 0xab, 0xd1, 0xe7, 0x42, 0x0a, 0x7b, 0x70, 0x73, 0x29, 0x89, 0x39, 0x01,
-	0xe3, 0x6f, 0x05, 0xae, 0x0f, 0xb8, 0xfb, 0xc8, 0x1f, 0x21, 0x81, 0x3f, 0x4b, 0x49, 0x72, 0xf9,
-	0x2c, 0x7f, 0x54, 0xa0, 0x99, 0x12, 0x39, 0x0e, 0x71, 0xc5, 0xaf, 0x9e, 0x4f, 0xc8, 0xd0, 0x61,
-	0x7f, 0x95, 0x00, 0x52, 0xa1, 0xdf, 0x15, 0xb8, 0x25, 0x01, 0x87, 0x71, 0xe7, 0x7c, 0xb8, 0x68,
-	0x9c, 0x2d, 0x08, 0x85, 0x40, 0xe5, 0x39, 0xbf, 0xd1, 0xe3, 0xd6, 0xbb, 0x6f, 0x99, 0x6b, 0x86,
-	0x83, 0x99, 0x4f, 0xa5, 0x57, 0x09, 0xa5, 0xb3, 0x57, 0x38, 0x33, 0x5e, 0x87, 0xd7, 0x0a, 0x38,
-	0x48, 0xae, 0xbf, 0xc6, 0xd5, 0xf0, 0x90, 0x12, 0x41, 0xd0, 0x84, 0x7c, 0x83, 0x07, 0x88, 0x50,
-	0x8a, 0xc5, 0x16, 0x48, 0xee, 0x43, 0x8d, 0xb3, 0x69, 0xe0, 0xe0, 0x47, 0xf6, 0x27, 0x49, 0xe1,
-	0x2e, 0x0c, 0xe1, 0xed, 0xf8, 0xf0, 0x31, 0xe2, 0xe3, 0xa8, 0x7e, 0x6b, 0x76, 0xca, 0xa2, 0xbe,
-	0x01, 0xd7, 0xbc, 0x38, 0x95, 0xfe, 0x18, 0x91, 0x30, 0xc2, 0x0b, 0x11, 0x66, 0xc9, 0x6a, 0xdc,
-	0x8f, 0x1e, 0x33, 0x97, 0xbf, 0xec, 0xd7, 0x7d, 0xa8, 0x25, 0x37, 0x64, 0xbb, 0x2e, 0x0c, 0xc6,
-	0x6f, 0x71, 0xb7, 0x0e, 0x08, 0x15, 0x9f, 0xb3, 0xa9, 0x33, 0xc6, 0xc1, 0x36, 0x9e, 0x77, 0x02,
-	0x55, 0x3e, 0x46, 0x01, 0xe6, 0xe7, 0xd7, 0xfe, 0xfb, 0x9b, 0xd7, 0x7e, 0xf5, 0x30, 0xf2, 0x6d,
-	0x27, 0x31, 0x92, 0x5e, 0x4f, 0x53, 0x90, 0xaf, 0x7b, 0x1c, 0xd3, 0xeb, 0x4d, 0x03, 0x2a, 0xe9,
-	0xdd, 0x80, 0x2a, 0x8f, 0x0a, 0x2c, 0x61, 0x96, 0x9c, 0xce, 0x25, 0xf5, 0xbd, 0x02, 0xbb, 0xb3,
-	0xc4, 0xc9, 0x15, 0xf7, 0xb4, 0xcc, 0x23, 0xe1, 0x9e, 0xe6, 0x27, 0xb9, 0x9f, 0x29, 0xd1, 0x62,
-	0xb3, 0xf1, 0x08, 0x63, 0xef, 0xd2, 0xec, 0x5b, 0xf0, 0x22, 0x72, 0x1c, 0x36, 0xa5, 0x22, 0x29,
-	0xe5, 0xf9, 0x31, 0xab, 0x4b, 0xe5, 0x7f, 0xa2, 0xcb, 0xad, 0x68, 0x85, 0x65, 0xb9, 0x4b, 0x65,
-	0x7e, 0x51, 0xe0, 0x95, 0x70, 0x36, 0xd0, 0x60, 0x3b, 0xda, 0x2c, 0xca, 0xbd, 0xf2, 0x1f, 0x94,
-	0xfb, 0xab, 0xf1, 0x70, 0x5e, 0x4a, 0x7e, 0x4e, 0xae, 0xfb, 0xf3, 0x2e, 0x94, 0x07, 0xdc, 0x55,
-	0x7d, 0xb8, 0xb6, 0xf4, 0x9d, 0xe6, 0xf6, 0xda, 0xc1, 0x9a, 0xdb, 0xf6, 0x5a, 0xf7, 0xe2, 0x58,
-	0x39, 0x69, 0x1e, 0x43, 0x23, 0xb3, 0xf5, 0x3b, 0x45, 0x3e, 0xd2, 0x48, 0xed, 0x9d, 0x8b, 0x22,
-	0x65, 0xac, 0x23, 0x68, 0xe6, 0x17, 0xf8, 0x9d, 0x22, 0x37, 0x39, 0xb8, 0xf6, 0xde, 0x46, 0x70,
-	0x19, 0xfa, 0x3b, 0x05, 0x5a, 0x6b, 0x57, 0xe3, 0xbd, 0xf3, 0x7d, 0xe6, 0x6f, 0x69, 0xf7, 0xff,
-	0xcd, 0xad, 0xb4, 0x16, 0xf9, 0xf5, 0x55, 0xa8, 0x45, 0x0e, 0x5e, 0xac, 0xc5, 0xfa, 0xe5, 0xf2,
-	0x18, 0x1a, 0x99, 0xd5, 0x51, 0xf8, 0xe4, 0x69, 0x64, 0xf1, 0x93, 0xaf, 0x9a, 0xe5, 0x61, 0xac,
-	0xcc, 0x1c, 0x2f, 0x8c, 0x95, 0x46, 0x16, 0xc7, 0x5a, 0x35, 0x3b, 0xc3, 0xe6, 0x59, 0x9a, 0x9b,
-	0x85, 0xcd, 0x93, 0xc5, 0x16, 0x37, 0xcf, 0xea, 0x99, 0xa4, 0xce, 0xe0, 0xe5, 0xdc, 0x3c, 0x7a,
-	0xbb, 0xb0, 0x2c, 0x96, 0xd0, 0xda, 0xbd, 0x4d, 0xd0, 0xf3, 0xb8, 0xbd, 0x07, 0xcf, 0x4e, 0x74,
-	0xe5, 0xf9, 0x89, 0xae, 0xfc, 0x75, 0xa2, 0x2b, 0x4f, 0x4f, 0xf5, 0xd2, 0xf3, 0x53, 0xbd, 0xf4,
-	0xc7, 0xa9, 0x5e, 0xfa, 0xe2, 0x76, 0x6a, 0x44, 0x2d, 0x3c, 0x5b, 0xdc, 0xa7, 0xd6, 0x13, 0x6b,
-	0xf1, 0x93, 0x2e, 0x1c, 0x55, 0xc3, 0x6a, 0xf4, 0x5b, 0xea, 0xdd, 0x7f, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0x60, 0x68, 0x03, 0x5d, 0xeb, 0x0d, 0x00, 0x00,
+	// 1223 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0x26, 0x6e, 0xa8, 0x5f, 0xa2, 0x40, 0x96, 0xb4, 0x75, 0x36, 0xa9, 0x1d, 0x19, 0xd1,
+	0x5a, 0x85, 0xd8, 0xa9, 0x9b, 0x56, 0x42, 0x84, 0x43, 0x92, 0x22, 0x88, 0x84, 0x51, 0xb5, 0x6e,
+	0x38, 0xc0, 0x21, 0x9a, 0xec, 0x0e, 0xf6, 0x80, 0x77, 0x66, 0xb5, 0x33, 0x0e, 0x0d, 0xe2, 0xc6,
+	0x85, 0x13, 0x2a, 0x70, 0xe1, 0x0f, 0xe0, 0xc4, 0x99, 0x1b, 0x12, 0x12, 0x1c, 0x50, 0x8f, 0x15,
+	0x27, 0x4e, 0x01, 0x25, 0xff, 0x45, 0x2f, 0x20, 0xef, 0x8c, 0xc7, 0xbb, 0x5e, 0x7b, 0x63, 0xb7,
+	0x16, 0xe5, 0x14, 0xcf, 0xcb, 0xf7, 0x7e, 0x7c, 0xdf, 0xbc, 0x99, 0x79, 0x36, 0x2c, 0x3a, 0xc8,
+	0xf3, 0x11, 0x69, 0xd0, 0x8a, 0x78, 0x50, 0xf6, 0x03, 0x26, 0x98, 0x79, 0x45, 0x60, 0xea, 0xe2,
+	0xc0, 0x23, 0x54, 0x94, 0xb9, 0x4f, 0xcb, 0x5d, 0x84, 0xb5, 0xd4, 0x60, 0x0d, 0x16, 0x62, 0x2a,
+	0x9d, 0x4f, 0x12, 0x6e, 0xe5, 0x1d, 0xc6, 0x3d, 0xc6, 0x2b, 0x87, 0x88, 0xe3, 0xca, 0xd1, 0xcd,
+	0x43, 0x2c, 0xd0, 0xcd, 0x8a, 0xc3, 0x08, 0x55, 0xff, 0xbf, 0xac, 0x33, 0x1c, 0x61, 0x2e, 0x08,
+	0x6d, 0x28, 0x7b, 0x51, 0xdb, 0xb9, 0x8f, 0x1d, 0x82, 0x5a, 0x07, 0xa8, 0xd5, 0x62, 0x0e, 0x12,
+	0x84, 0x51, 0xae, 0x30, 0xcb, 0x32, 0xf6, 0x81, 0x4c, 0x2a, 0x17, 0xea, 0x5f, 0x97, 0xb4, 0xbb,
+	0x8f, 0x02, 0xe4, 0x75, 0xcd, 0x05, 0x6d, 0xee, 0x7e, 0x38, 0x40, 0x24, 0x70, 0x03, 0xe6, 0x2b,
+	0xc0, 0xb5, 0x5e, 0x39, 0xac, 0xed, 0x34, 0x71, 0x70, 0x20, 0x02, 0x44, 0xf9, 0xc7, 0x38, 0x38,
+	0xf0, 0x59, 0x8b, 0x38, 0xc7, 0x12, 0x57, 0x7c, 0x38, 0x0d, 0x8b, 0x35, 0xde, 0xd8, 0x0d, 0x30,
+	0x12, 0x78, 0x57, 0xf9, 0x98, 0x6b, 0x30, 0xe7, 0x30, 0x16, 0xb8, 0x84, 0x22, 0xc1, 0x82, 0x9c,
+	0xb1, 0x66, 0x94, 0xb2, 0x76, 0xd4, 0x64, 0x16, 0x61, 0xbe, 0x9b, 0xe1, 0x7d, 0xe4, 0xe1, 0xdc,
+	0x74, 0x08, 0x89, 0xd9, 0xcc, 0xef, 0x0d, 0x98, 0x13, 0x4c, 0xa0, 0x56, 0xbd, 0xed, 0xfb, 0xad,
+	0xe3, 0xdc, 0xcc, 0xda, 0x4c, 0x69, 0xae, 0xba, 0x5c, 0x56, 0x04, 0x3b, 0x4a, 0x96, 0x95, 0x92,
+	0xe5, 0x5d, 0x46, 0xe8, 0xce, 0x47, 0x8f, 0x4e, 0x0a, 0x53, 0x4f, 0x4e, 0x0a, 0xd7, 0x1b, 0x44,
+	0x34, 0xdb, 0x87, 0x65, 0x87, 0x79, 0x4a, 0x0d, 0xf5, 0x67, 0x9d, 0xbb, 0x9f, 0x56, 0xc4, 0xb1,
+	0x8f, 0x79, 0xe8, 0xf0, 0xe3, 0x5f, 0x85, 0xd2, 0x88, 0x50, 0x6e, 0x47, 0x4b, 0x31, 0x2d, 0xb8,
+	0xe8, 0x61, 0x81, 0x5c, 0x24, 0x50, 0x2e, 0xb3, 0x66, 0x94, 0xe6, 0x6d, 0xbd, 0x2e, 0xbe, 0x09,
+	0xcb, 0x09, 0x45, 0x6c, 0xcc, 0x7d, 0x46, 0x39, 0x36, 0xf3, 0x00, 0x5d, 0x8e, 0x7b, 0x77, 0x43,
+	0x61, 0x32, 0x76, 0xc4, 0x52, 0xfc, 0xd2, 0x80, 0x17, 0x6b, 0xbc, 0xf1, 0xb6, 0x4b, 0xc4, 0x18,
+	0x6a, 0xc6, 0xa3, 0x4e, 0xf7, 0x47, 0x35, 0x4d, 0xc8, 0xd0, 0x8e, 0xca, 0x33, 0xa1, 0x6b, 0xf8,
+	0x39, 0x95, 0xc2, 0x32, 0x5c, 0xe9, 0x2b, 0xa2, 0x4b, 0xa0, 0xf8, 0x8f, 0x01, 0x4b, 0x35, 0xde,
+	0xd8, 0xf7, 0x5d, 0x24, 0xf0, 0xfd, 0x88, 0x24, 0xcf, 0x5e, 0xe5, 0x0f, 0x06, 0x2c, 0x46, 0x44,
+	0x96, 0x29, 0x9e, 0xf3, 0xae, 0x27, 0x0b, 0x2a, 0xe6, 0x61, 0x75, 0x90, 0x00, 0x5a, 0xa1, 0xdf,
+	0x0c, 0x58, 0xd1, 0x80, 0xba, 0x3c, 0xb4, 0xdb, 0xbd, 0x33, 0x3b, 0x01, 0xa1, 0x10, 0x98, 0x3c,
+	0x11, 0x37, 0xdc, 0xdc, 0xb9, 0xea, 0x6b, 0xe5, 0x21, 0xf7, 0x52, 0x39, 0x59, 0xca, 0x4e, 0xa6,
+	0x23, 0x9d, 0x3d, 0x20, 0x58, 0xf1, 0x55, 0x78, 0x25, 0x85, 0x83, 0xe6, 0xfa, 0x8b, 0xec, 0x86,
+	0x3d, 0x4a, 0x04, 0x41, 0x2d, 0xf2, 0x39, 0xae, 0x21, 0x42, 0x29, 0x16, 0x13, 0x20, 0xb9, 0x0a,
+	0x59, 0xce, 0xda, 0x81, 0x83, 0xf7, 0xed, 0xf7, 0x54, 0xe3, 0xf6, 0x0c, 0x1d, 0x6f, 0xb9, 0x78,
+	0x17, 0xf1, 0x66, 0xd8, 0xbf, 0x59, 0x3b, 0x62, 0x31, 0xaf, 0xc1, 0x82, 0x27, 0x4b, 0xd9, 0x6d,
+	0x22, 0xd2, 0xc9, 0x70, 0x21, 0xc4, 0xf4, 0x59, 0x8b, 0x5b, 0xb0, 0x3a, 0xa8, 0x7e, 0x7d, 0x5e,
+	0x57, 0x21, 0xab, 0x3c, 0xf4, 0x71, 0xed, 0x19, 0x8a, 0xf5, 0xf0, 0xb0, 0xda, 0x98, 0x63, 0x31,
+	0x31, 0xe2, 0xea, 0xf0, 0x45, 0x83, 0x6a, 0xb9, 0x7f, 0x95, 0xb7, 0x43, 0x8d, 0x50, 0xf1, 0x81,
+	0xbc, 0x96, 0x27, 0xd1, 0x4e, 0x2d, 0x98, 0xe5, 0x4d, 0x14, 0x60, 0x7e, 0xfe, 0x59, 0x7b, 0x63,
+	0xfc, 0xb3, 0x36, 0x5b, 0x0f, 0x63, 0xdb, 0x2a, 0x87, 0xa2, 0x17, 0xa5, 0xa0, 0xe9, 0x9d, 0x48,
+	0x7a, 0x3b, 0xed, 0x80, 0x6a, 0x7a, 0x97, 0x61, 0x96, 0x87, 0x0d, 0xad, 0x98, 0xa9, 0xd5, 0xb9,
+	0xa4, 0xbe, 0x35, 0xe0, 0xa2, 0x7a, 0xba, 0xf8, 0x73, 0xbe, 0x43, 0x74, 0x1d, 0x8a, 0x7b, 0x94,
+	0x9f, 0xe6, 0xfe, 0xc4, 0x08, 0x1f, 0x52, 0x1b, 0xbb, 0x18, 0x7b, 0xcf, 0xcc, 0x3e, 0x07, 0x2f,
+	0x20, 0xc7, 0x61, 0x6d, 0x2a, 0xd4, 0xd1, 0xe9, 0x2e, 0xe3, 0xba, 0x64, 0xfe, 0x27, 0xba, 0xac,
+	0xc0, 0x72, 0x82, 0xbb, 0x56, 0xe6, 0x67, 0x03, 0x5e, 0xee, 0xdc, 0x45, 0x34, 0x98, 0x8c, 0x36,
+	0xbd, 0x76, 0xcf, 0xfc, 0x07, 0xed, 0x7e, 0x15, 0x56, 0x06, 0x14, 0xaf, 0xc9, 0x7d, 0x25, 0x5b,
+	0x5e, 0x5e, 0xb4, 0xf7, 0xc2, 0x11, 0xcd, 0xbc, 0x03, 0x59, 0xd4, 0x16, 0x4d, 0x16, 0x10, 0x71,
+	0x2c, 0xb9, 0xed, 0xe4, 0xfe, 0xf8, 0x69, 0x7d, 0x49, 0x95, 0xb9, 0xed, 0xba, 0x01, 0xe6, 0xbc,
+	0x2e, 0x02, 0x42, 0x1b, 0x76, 0x0f, 0x6a, 0xbe, 0x05, 0xb3, 0x72, 0xc8, 0x0b, 0x49, 0xcf, 0x55,
+	0x0b, 0x43, 0x9f, 0x02, 0x99, 0x48, 0x5d, 0xff, 0xca, 0x49, 0x35, 0x67, 0xb4, 0x12, 0x5d, 0xe5,
+	0x37, 0xd3, 0x70, 0xa9, 0xc6, 0x1b, 0x75, 0xac, 0xe7, 0x81, 0x6d, 0x39, 0x2d, 0x4e, 0xe0, 0xf6,
+	0x59, 0x82, 0x0b, 0x2e, 0xa6, 0xcc, 0x53, 0x8d, 0x2a, 0x17, 0xe6, 0x1e, 0x5c, 0xf4, 0x08, 0xe7,
+	0xe1, 0xc3, 0x26, 0xb7, 0xe9, 0xfa, 0x50, 0x36, 0xaa, 0x96, 0x9a, 0xc4, 0x2b, 0x56, 0xda, 0xdd,
+	0xdc, 0x87, 0x79, 0xa7, 0x85, 0x88, 0x67, 0x63, 0x87, 0x05, 0x2e, 0xcf, 0x5d, 0x58, 0x9b, 0x49,
+	0x7d, 0x27, 0x55, 0xb8, 0xdd, 0x9e, 0x8f, 0x0a, 0x19, 0x0b, 0x53, 0x2c, 0xc0, 0xd5, 0x81, 0x92,
+	0x68, 0xd1, 0x7e, 0x97, 0x73, 0x40, 0x1d, 0x77, 0x2f, 0xba, 0xfb, 0x6a, 0x82, 0xbe, 0x17, 0x0e,
+	0xd0, 0x13, 0x90, 0xee, 0x1d, 0xc8, 0x78, 0xcc, 0x95, 0x63, 0xdd, 0x42, 0xf5, 0xd6, 0x50, 0x46,
+	0x03, 0xf3, 0x97, 0x6b, 0xcc, 0xc5, 0x76, 0x18, 0xa0, 0xf3, 0xca, 0x75, 0xbe, 0x55, 0x7c, 0xd6,
+	0x22, 0x5c, 0x84, 0x72, 0x67, 0xed, 0x9e, 0x41, 0xcd, 0x02, 0xc3, 0x78, 0x74, 0xf9, 0x56, 0xbf,
+	0x9b, 0x87, 0x99, 0x1a, 0x6f, 0x98, 0x3e, 0x2c, 0xf4, 0x7d, 0x1d, 0xb8, 0x31, 0xb4, 0xb2, 0xc4,
+	0xa0, 0x6c, 0x55, 0x47, 0xc7, 0xea, 0x47, 0xfa, 0x13, 0x98, 0x8f, 0x0d, 0xcc, 0xa5, 0xb4, 0x18,
+	0x51, 0xa4, 0xb5, 0x31, 0x2a, 0x52, 0xe7, 0x3a, 0x86, 0xc5, 0xe4, 0xec, 0xbb, 0x9e, 0x16, 0x26,
+	0x01, 0xb7, 0x6e, 0x8f, 0x05, 0xd7, 0xa9, 0xbf, 0x36, 0x20, 0x37, 0x74, 0xaa, 0xdc, 0x3c, 0x3f,
+	0x66, 0xd2, 0xcb, 0xda, 0x7a, 0x1a, 0xaf, 0xa8, 0x16, 0xc9, 0xc9, 0x2f, 0x55, 0x8b, 0x04, 0xdc,
+	0xba, 0x3d, 0x16, 0x3c, 0xba, 0xe5, 0xb1, 0x29, 0x28, 0x75, 0xcb, 0xa3, 0x48, 0x6b, 0x63, 0x54,
+	0x64, 0x34, 0x57, 0x6c, 0x24, 0x49, 0xcd, 0x15, 0x45, 0x5a, 0x1b, 0xa3, 0x22, 0x75, 0x2e, 0x1f,
+	0x16, 0xfa, 0x46, 0x80, 0xd4, 0xc3, 0x13, 0xc7, 0x5a, 0xd5, 0xd1, 0xb1, 0x3a, 0xe3, 0x11, 0xbc,
+	0x94, 0x78, 0x5a, 0x5f, 0x4f, 0x6d, 0x8b, 0x3e, 0xb4, 0xb5, 0x39, 0x0e, 0x3a, 0xaa, 0x6a, 0xec,
+	0xd5, 0x2b, 0x9d, 0xdf, 0x8a, 0x12, 0x69, 0x6d, 0x8c, 0x8a, 0xd4, 0xb9, 0xbe, 0x00, 0x73, 0xc0,
+	0xdb, 0x55, 0x4e, 0x8b, 0x93, 0xc4, 0x5b, 0x77, 0xc6, 0xc3, 0x47, 0x99, 0xc6, 0xbe, 0x22, 0x94,
+	0xd2, 0x77, 0xa9, 0x87, 0xb4, 0x36, 0x46, 0x45, 0xc6, 0xee, 0x88, 0xa1, 0x2f, 0xce, 0xe6, 0x39,
+	0x04, 0x06, 0x7a, 0x59, 0x5b, 0x4f, 0xe3, 0xd5, 0x2d, 0x68, 0xe7, 0xee, 0xa3, 0xd3, 0xbc, 0xf1,
+	0xf8, 0x34, 0x6f, 0xfc, 0x7d, 0x9a, 0x37, 0x1e, 0x9e, 0xe5, 0xa7, 0x1e, 0x9f, 0xe5, 0xa7, 0xfe,
+	0x3c, 0xcb, 0x4f, 0x7d, 0x78, 0x23, 0x32, 0x54, 0xf5, 0x32, 0x54, 0xb8, 0x4f, 0x2b, 0x0f, 0x2a,
+	0xbd, 0xdf, 0xdb, 0x3a, 0xc3, 0xd5, 0xe1, 0x6c, 0xf8, 0x6b, 0xd3, 0xad, 0x7f, 0x07, 0x00, 0x4c,
+	0x1b, 0xc7, 0x5a, 0x88, 0x13, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1054,6 +1478,10 @@ type MsgClient interface {
 	BurnVouchers(ctx context.Context, in *MsgBurnVouchers, opts ...grpc.CallOption) (*MsgBurnVouchersResponse, error)
 	RedeemVouchers(ctx context.Context, in *MsgRedeemVouchers, opts ...grpc.CallOption) (*MsgRedeemVouchersResponse, error)
 	UnredeemVouchers(ctx context.Context, in *MsgUnredeemVouchers, opts ...grpc.CallOption) (*MsgUnredeemVouchersResponse, error)
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	SetCampaignAirdrop(ctx context.Context, in *MsgSetCampaignAirdrop, opts ...grpc.CallOption) (*MsgSetCampaignAirdropResponse, error)
+	ResetMainnet(ctx context.Context, in *MsgResetMainnet, opts ...grpc.CallOption) (*MsgResetMainnetResponse, error)
+	SetVoucherTransferPolicy(ctx context.Context, in *MsgSetVoucherTransferPolicy, opts ...grpc.CallOption) (*MsgSetVoucherTransferPolicyResponse, error)
 }
 
 type msgClient struct {
@@ -1145,7 +1573,43 @@ func (c *msgClient) UnredeemVouchers(ctx context.Context, in *MsgUnredeemVoucher
 	return out, nil
 }
 
-// MsgServer is the server API for Msg service.
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.campaign.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetCampaignAirdrop(ctx context.Context, in *MsgSetCampaignAirdrop, opts ...grpc.CallOption) (*MsgSetCampaignAirdropResponse, error) {
+	out := new(MsgSetCampaignAirdropResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.campaign.Msg/SetCampaignAirdrop", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResetMainnet(ctx context.Context, in *MsgResetMainnet, opts ...grpc.CallOption) (*MsgResetMainnetResponse, error) {
+	out := new(MsgResetMainnetResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.campaign.Msg/ResetMainnet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetVoucherTransferPolicy(ctx context.Context, in *MsgSetVoucherTransferPolicy, opts ...grpc.CallOption) (*MsgSetVoucherTransferPolicyResponse, error) {
+	out := new(MsgSetVoucherTransferPolicyResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.campaign.Msg/SetVoucherTransferPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
 type MsgServer interface {
 	CreateCampaign(context.Context, *MsgCreateCampaign) (*MsgCreateCampaignResponse, error)
 	EditCampaign(context.Context, *MsgEditCampaign) (*MsgEditCampaignResponse, error)
@@ -1156,6 +1620,10 @@ type MsgServer interface {
 	BurnVouchers(context.Context, *MsgBurnVouchers) (*MsgBurnVouchersResponse, error)
 	RedeemVouchers(context.Context, *MsgRedeemVouchers) (*MsgRedeemVouchersResponse, error)
 	UnredeemVouchers(context.Context, *MsgUnredeemVouchers) (*MsgUnredeemVouchersResponse, error)
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	SetCampaignAirdrop(context.Context, *MsgSetCampaignAirdrop) (*MsgSetCampaignAirdropResponse, error)
+	ResetMainnet(context.Context, *MsgResetMainnet) (*MsgResetMainnetResponse, error)
+	SetVoucherTransferPolicy(context.Context, *MsgSetVoucherTransferPolicy) (*MsgSetVoucherTransferPolicyResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1189,6 +1657,18 @@ func (*UnimplementedMsgServer) RedeemVouchers(ctx context.Context, req *MsgRedee
 func (*UnimplementedMsgServer) UnredeemVouchers(ctx context.Context, req *MsgUnredeemVouchers) (*MsgUnredeemVouchersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnredeemVouchers not implemented")
 }
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) SetCampaignAirdrop(ctx context.Context, req *MsgSetCampaignAirdrop) (*MsgSetCampaignAirdropResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCampaignAirdrop not implemented")
+}
+func (*UnimplementedMsgServer) ResetMainnet(ctx context.Context, req *MsgResetMainnet) (*MsgResetMainnetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetMainnet not implemented")
+}
+func (*UnimplementedMsgServer) SetVoucherTransferPolicy(ctx context.Context, req *MsgSetVoucherTransferPolicy) (*MsgSetVoucherTransferPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetVoucherTransferPolicy not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1356,6 +1836,78 @@ func _Msg_UnredeemVouchers_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.campaign.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetCampaignAirdrop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetCampaignAirdrop)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetCampaignAirdrop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.campaign.Msg/SetCampaignAirdrop",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetCampaignAirdrop(ctx, req.(*MsgSetCampaignAirdrop))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResetMainnet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResetMainnet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResetMainnet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.campaign.Msg/ResetMainnet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResetMainnet(ctx, req.(*MsgResetMainnet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetVoucherTransferPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetVoucherTransferPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetVoucherTransferPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.campaign.Msg/SetVoucherTransferPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetVoucherTransferPolicy(ctx, req.(*MsgSetVoucherTransferPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "tendermint.spn.campaign.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1396,6 +1948,22 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UnredeemVouchers",
 			Handler:    _Msg_UnredeemVouchers_Handler,
 		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "SetCampaignAirdrop",
+			Handler:    _Msg_SetCampaignAirdrop_Handler,
+		},
+		{
+			MethodName: "ResetMainnet",
+			Handler:    _Msg_ResetMainnet_Handler,
+		},
+		{
+			MethodName: "SetVoucherTransferPolicy",
+			Handler:    _Msg_SetVoucherTransferPolicy_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "campaign/tx.proto",
@@ -1783,6 +2351,64 @@ func (m *MsgInitializeMainnetResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgResetMainnet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResetMainnet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResetMainnet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CampaignID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CampaignID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Coordinator) > 0 {
+		i -= len(m.Coordinator)
+		copy(dAtA[i:], m.Coordinator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Coordinator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResetMainnetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResetMainnetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResetMainnetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgMintVouchers) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2078,92 +2704,320 @@ func (m *MsgUnredeemVouchersResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgCreateCampaign) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Coordinator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.CampaignName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.TotalSupply) > 0 {
-		for _, e := range m.TotalSupply {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateCampaignResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CampaignID != 0 {
-		n += 1 + sovTx(uint64(m.CampaignID))
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgEditCampaign) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Coordinator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.CampaignID != 0 {
-		n += 1 + sovTx(uint64(m.CampaignID))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgEditCampaignResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateTotalSupply) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetCampaignAirdrop) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetCampaignAirdrop) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetCampaignAirdrop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClaimRecords) > 0 {
+		for iNdEx := len(m.ClaimRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Missions) > 0 {
+		for iNdEx := len(m.Missions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Missions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CampaignID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CampaignID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Coordinator) > 0 {
+		i -= len(m.Coordinator)
+		copy(dAtA[i:], m.Coordinator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Coordinator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetCampaignAirdropResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetCampaignAirdropResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetCampaignAirdropResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetVoucherTransferPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetVoucherTransferPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetVoucherTransferPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Allowlist) > 0 {
+		for iNdEx := len(m.Allowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Allowlist[iNdEx])
+			copy(dAtA[i:], m.Allowlist[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Allowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Mode != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CampaignID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CampaignID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Coordinator) > 0 {
+		i -= len(m.Coordinator)
+		copy(dAtA[i:], m.Coordinator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Coordinator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetVoucherTransferPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetVoucherTransferPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetVoucherTransferPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateCampaign) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Coordinator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CampaignName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.TotalSupply) > 0 {
+		for _, e := range m.TotalSupply {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateCampaignResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CampaignID != 0 {
+		n += 1 + sovTx(uint64(m.CampaignID))
+	}
+	return n
+}
+
+func (m *MsgEditCampaign) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Coordinator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CampaignID != 0 {
+		n += 1 + sovTx(uint64(m.CampaignID))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgEditCampaignResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateTotalSupply) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -2259,6 +3113,31 @@ func (m *MsgInitializeMainnetResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgResetMainnet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Coordinator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CampaignID != 0 {
+		n += 1 + sovTx(uint64(m.CampaignID))
+	}
+	return n
+}
+
+func (m *MsgResetMainnetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgMintVouchers) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2344,56 +3223,992 @@ func (m *MsgRedeemVouchers) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func (m *MsgRedeemVouchersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnredeemVouchers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CampaignID != 0 {
+		n += 1 + sovTx(uint64(m.CampaignID))
+	}
+	if len(m.Shares) > 0 {
+		for _, e := range m.Shares {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUnredeemVouchersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetCampaignAirdrop) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Coordinator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CampaignID != 0 {
+		n += 1 + sovTx(uint64(m.CampaignID))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Missions) > 0 {
+		for _, e := range m.Missions {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ClaimRecords) > 0 {
+		for _, e := range m.ClaimRecords {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetCampaignAirdropResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetVoucherTransferPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Coordinator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CampaignID != 0 {
+		n += 1 + sovTx(uint64(m.CampaignID))
+	}
+	if m.Mode != 0 {
+		n += 1 + sovTx(uint64(m.Mode))
+	}
+	if len(m.Allowlist) > 0 {
+		for _, s := range m.Allowlist {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetVoucherTransferPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateCampaign) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateCampaign: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateCampaign: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coordinator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coordinator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CampaignName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalSupply = append(m.TotalSupply, github_com_cosmos_cosmos_sdk_types.Coin{})
+			if err := m.TotalSupply[len(m.TotalSupply)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = append(m.Metadata[:0], dAtA[iNdEx:postIndex]...)
+			if m.Metadata == nil {
+				m.Metadata = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateCampaignResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateCampaignResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateCampaignResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignID", wireType)
+			}
+			m.CampaignID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEditCampaign) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditCampaign: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditCampaign: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coordinator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coordinator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignID", wireType)
+			}
+			m.CampaignID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = append(m.Metadata[:0], dAtA[iNdEx:postIndex]...)
+			if m.Metadata == nil {
+				m.Metadata = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEditCampaignResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditCampaignResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditCampaignResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateTotalSupply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateTotalSupply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateTotalSupply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coordinator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coordinator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignID", wireType)
+			}
+			m.CampaignID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupplyUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalSupplyUpdate = append(m.TotalSupplyUpdate, github_com_cosmos_cosmos_sdk_types.Coin{})
+			if err := m.TotalSupplyUpdate[len(m.TotalSupplyUpdate)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateTotalSupplyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateTotalSupplyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateTotalSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateSpecialAllocations) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateSpecialAllocations: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateSpecialAllocations: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coordinator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coordinator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignID", wireType)
+			}
+			m.CampaignID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecialAllocations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpecialAllocations.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgRedeemVouchersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgUnredeemVouchers) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.CampaignID != 0 {
-		n += 1 + sovTx(uint64(m.CampaignID))
-	}
-	if len(m.Shares) > 0 {
-		for _, e := range m.Shares {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+func (m *MsgUpdateSpecialAllocationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateSpecialAllocationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateSpecialAllocationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *MsgUnredeemVouchersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgCreateCampaign) Unmarshal(dAtA []byte) error {
+func (m *MsgInitializeMainnet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2416,10 +4231,10 @@ func (m *MsgCreateCampaign) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateCampaign: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgInitializeMainnet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateCampaign: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgInitializeMainnet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2455,8 +4270,27 @@ func (m *MsgCreateCampaign) Unmarshal(dAtA []byte) error {
 			m.Coordinator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignID", wireType)
+			}
+			m.CampaignID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CampaignName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2484,13 +4318,13 @@ func (m *MsgCreateCampaign) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CampaignName = string(dAtA[iNdEx:postIndex])
+			m.SourceURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceHash", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2500,31 +4334,29 @@ func (m *MsgCreateCampaign) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalSupply = append(m.TotalSupply, github_com_cosmos_cosmos_sdk_types.Coin{})
-			if err := m.TotalSupply[len(m.TotalSupply)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SourceHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MainnetChainID", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2534,25 +4366,23 @@ func (m *MsgCreateCampaign) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = append(m.Metadata[:0], dAtA[iNdEx:postIndex]...)
-			if m.Metadata == nil {
-				m.Metadata = []byte{}
-			}
+			m.MainnetChainID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2575,7 +4405,7 @@ func (m *MsgCreateCampaign) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateCampaignResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgInitializeMainnetResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2598,17 +4428,17 @@ func (m *MsgCreateCampaignResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateCampaignResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgInitializeMainnetResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateCampaignResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgInitializeMainnetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CampaignID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MainnetID", wireType)
 			}
-			m.CampaignID = 0
+			m.MainnetID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2618,7 +4448,7 @@ func (m *MsgCreateCampaignResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CampaignID |= uint64(b&0x7F) << shift
+				m.MainnetID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2644,7 +4474,7 @@ func (m *MsgCreateCampaignResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEditCampaign) Unmarshal(dAtA []byte) error {
+func (m *MsgResetMainnet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2667,10 +4497,10 @@ func (m *MsgEditCampaign) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditCampaign: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResetMainnet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditCampaign: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResetMainnet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2724,72 +4554,6 @@ func (m *MsgEditCampaign) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Metadata = append(m.Metadata[:0], dAtA[iNdEx:postIndex]...)
-			if m.Metadata == nil {
-				m.Metadata = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2811,7 +4575,7 @@ func (m *MsgEditCampaign) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEditCampaignResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgResetMainnetResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2834,10 +4598,10 @@ func (m *MsgEditCampaignResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditCampaignResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResetMainnetResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditCampaignResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResetMainnetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2861,7 +4625,7 @@ func (m *MsgEditCampaignResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateTotalSupply) Unmarshal(dAtA []byte) error {
+func (m *MsgMintVouchers) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2884,10 +4648,10 @@ func (m *MsgUpdateTotalSupply) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateTotalSupply: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMintVouchers: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateTotalSupply: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMintVouchers: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2943,7 +4707,7 @@ func (m *MsgUpdateTotalSupply) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupplyUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2970,8 +4734,8 @@ func (m *MsgUpdateTotalSupply) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalSupplyUpdate = append(m.TotalSupplyUpdate, github_com_cosmos_cosmos_sdk_types.Coin{})
-			if err := m.TotalSupplyUpdate[len(m.TotalSupplyUpdate)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Shares = append(m.Shares, github_com_cosmos_cosmos_sdk_types.Coin{})
+			if err := m.Shares[len(m.Shares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2996,7 +4760,7 @@ func (m *MsgUpdateTotalSupply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateTotalSupplyResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMintVouchersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3019,10 +4783,10 @@ func (m *MsgUpdateTotalSupplyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateTotalSupplyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMintVouchersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateTotalSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMintVouchersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3046,7 +4810,7 @@ func (m *MsgUpdateTotalSupplyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateSpecialAllocations) Unmarshal(dAtA []byte) error {
+func (m *MsgBurnVouchers) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3069,15 +4833,15 @@ func (m *MsgUpdateSpecialAllocations) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateSpecialAllocations: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBurnVouchers: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateSpecialAllocations: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBurnVouchers: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coordinator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3105,7 +4869,7 @@ func (m *MsgUpdateSpecialAllocations) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coordinator = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
@@ -3128,7 +4892,7 @@ func (m *MsgUpdateSpecialAllocations) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecialAllocations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vouchers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3155,7 +4919,8 @@ func (m *MsgUpdateSpecialAllocations) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SpecialAllocations.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Vouchers = append(m.Vouchers, github_com_cosmos_cosmos_sdk_types.Coin{})
+			if err := m.Vouchers[len(m.Vouchers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3180,7 +4945,7 @@ func (m *MsgUpdateSpecialAllocations) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateSpecialAllocationsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBurnVouchersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3203,10 +4968,10 @@ func (m *MsgUpdateSpecialAllocationsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateSpecialAllocationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBurnVouchersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateSpecialAllocationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBurnVouchersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3230,7 +4995,7 @@ func (m *MsgUpdateSpecialAllocationsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgInitializeMainnet) Unmarshal(dAtA []byte) error {
+func (m *MsgRedeemVouchers) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3253,15 +5018,15 @@ func (m *MsgInitializeMainnet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgInitializeMainnet: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRedeemVouchers: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgInitializeMainnet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRedeemVouchers: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coordinator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3289,7 +5054,7 @@ func (m *MsgInitializeMainnet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coordinator = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
@@ -3312,7 +5077,7 @@ func (m *MsgInitializeMainnet) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3340,13 +5105,13 @@ func (m *MsgInitializeMainnet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceURL = string(dAtA[iNdEx:postIndex])
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vouchers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3356,55 +5121,25 @@ func (m *MsgInitializeMainnet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MainnetChainID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Vouchers = append(m.Vouchers, github_com_cosmos_cosmos_sdk_types.Coin{})
+			if err := m.Vouchers[len(m.Vouchers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.MainnetChainID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3427,7 +5162,7 @@ func (m *MsgInitializeMainnet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgInitializeMainnetResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRedeemVouchersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3450,31 +5185,12 @@ func (m *MsgInitializeMainnetResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgInitializeMainnetResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRedeemVouchersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgInitializeMainnetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRedeemVouchersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MainnetID", wireType)
-			}
-			m.MainnetID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MainnetID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3496,7 +5212,7 @@ func (m *MsgInitializeMainnetResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMintVouchers) Unmarshal(dAtA []byte) error {
+func (m *MsgUnredeemVouchers) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3519,15 +5235,15 @@ func (m *MsgMintVouchers) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMintVouchers: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnredeemVouchers: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMintVouchers: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnredeemVouchers: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coordinator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3555,7 +5271,7 @@ func (m *MsgMintVouchers) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coordinator = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
@@ -3576,7 +5292,7 @@ func (m *MsgMintVouchers) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
 			}
@@ -3631,7 +5347,7 @@ func (m *MsgMintVouchers) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMintVouchersResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnredeemVouchersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3654,10 +5370,10 @@ func (m *MsgMintVouchersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMintVouchersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnredeemVouchersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMintVouchersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnredeemVouchersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3681,7 +5397,7 @@ func (m *MsgMintVouchersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBurnVouchers) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3704,15 +5420,15 @@ func (m *MsgBurnVouchers) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurnVouchers: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurnVouchers: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3740,30 +5456,11 @@ func (m *MsgBurnVouchers) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CampaignID", wireType)
-			}
-			m.CampaignID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CampaignID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vouchers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3790,8 +5487,7 @@ func (m *MsgBurnVouchers) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Vouchers = append(m.Vouchers, github_com_cosmos_cosmos_sdk_types.Coin{})
-			if err := m.Vouchers[len(m.Vouchers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3816,7 +5512,7 @@ func (m *MsgBurnVouchers) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBurnVouchersResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3839,10 +5535,10 @@ func (m *MsgBurnVouchersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurnVouchersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurnVouchersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3866,7 +5562,7 @@ func (m *MsgBurnVouchersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRedeemVouchers) Unmarshal(dAtA []byte) error {
+func (m *MsgSetCampaignAirdrop) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3889,15 +5585,15 @@ func (m *MsgRedeemVouchers) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRedeemVouchers: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetCampaignAirdrop: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRedeemVouchers: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetCampaignAirdrop: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Coordinator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3925,7 +5621,7 @@ func (m *MsgRedeemVouchers) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Coordinator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
@@ -3948,7 +5644,7 @@ func (m *MsgRedeemVouchers) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3976,11 +5672,11 @@ func (m *MsgRedeemVouchers) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vouchers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Missions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4007,8 +5703,42 @@ func (m *MsgRedeemVouchers) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Vouchers = append(m.Vouchers, github_com_cosmos_cosmos_sdk_types.Coin{})
-			if err := m.Vouchers[len(m.Vouchers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Missions = append(m.Missions, AirdropMission{})
+			if err := m.Missions[len(m.Missions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimRecords = append(m.ClaimRecords, AirdropClaimRecord{})
+			if err := m.ClaimRecords[len(m.ClaimRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4033,7 +5763,7 @@ func (m *MsgRedeemVouchers) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRedeemVouchersResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetCampaignAirdropResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4056,10 +5786,10 @@ func (m *MsgRedeemVouchersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRedeemVouchersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetCampaignAirdropResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRedeemVouchersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetCampaignAirdropResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4083,7 +5813,7 @@ func (m *MsgRedeemVouchersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnredeemVouchers) Unmarshal(dAtA []byte) error {
+func (m *MsgSetVoucherTransferPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4106,15 +5836,15 @@ func (m *MsgUnredeemVouchers) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnredeemVouchers: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetVoucherTransferPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnredeemVouchers: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetVoucherTransferPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Coordinator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4142,7 +5872,7 @@ func (m *MsgUnredeemVouchers) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Coordinator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
@@ -4163,11 +5893,30 @@ func (m *MsgUnredeemVouchers) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= VoucherTransferPolicy_Mode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4177,25 +5926,23 @@ func (m *MsgUnredeemVouchers) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Shares = append(m.Shares, github_com_cosmos_cosmos_sdk_types.Coin{})
-			if err := m.Shares[len(m.Shares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Allowlist = append(m.Allowlist, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4218,7 +5965,7 @@ func (m *MsgUnredeemVouchers) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnredeemVouchersResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetVoucherTransferPolicyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4241,10 +5988,10 @@ func (m *MsgUnredeemVouchersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnredeemVouchersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetVoucherTransferPolicyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnredeemVouchersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetVoucherTransferPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -28,6 +28,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type ShareVestingOptions struct {
 	// Types that are valid to be assigned to Options:
 	//	*ShareVestingOptions_DelayedVesting
+	//	*ShareVestingOptions_ContinuousVesting
+	//	*ShareVestingOptions_PeriodicVesting
 	Options isShareVestingOptions_Options `protobuf_oneof:"options"`
 }
 
@@ -73,8 +75,16 @@ type isShareVestingOptions_Options interface {
 type ShareVestingOptions_DelayedVesting struct {
 	DelayedVesting *ShareDelayedVesting `protobuf:"bytes,1,opt,name=delayedVesting,proto3,oneof" json:"delayedVesting,omitempty"`
 }
+type ShareVestingOptions_ContinuousVesting struct {
+	ContinuousVesting *ShareContinuousVesting `protobuf:"bytes,2,opt,name=continuousVesting,proto3,oneof" json:"continuousVesting,omitempty"`
+}
+type ShareVestingOptions_PeriodicVesting struct {
+	PeriodicVesting *SharePeriodicVesting `protobuf:"bytes,3,opt,name=periodicVesting,proto3,oneof" json:"periodicVesting,omitempty"`
+}
 
-func (*ShareVestingOptions_DelayedVesting) isShareVestingOptions_Options() {}
+func (*ShareVestingOptions_DelayedVesting) isShareVestingOptions_Options()    {}
+func (*ShareVestingOptions_ContinuousVesting) isShareVestingOptions_Options() {}
+func (*ShareVestingOptions_PeriodicVesting) isShareVestingOptions_Options()   {}
 
 func (m *ShareVestingOptions) GetOptions() isShareVestingOptions_Options {
 	if m != nil {
@@ -90,10 +100,26 @@ func (m *ShareVestingOptions) GetDelayedVesting() *ShareDelayedVesting {
 	return nil
 }
 
+func (m *ShareVestingOptions) GetContinuousVesting() *ShareContinuousVesting {
+	if x, ok := m.GetOptions().(*ShareVestingOptions_ContinuousVesting); ok {
+		return x.ContinuousVesting
+	}
+	return nil
+}
+
+func (m *ShareVestingOptions) GetPeriodicVesting() *SharePeriodicVesting {
+	if x, ok := m.GetOptions().(*ShareVestingOptions_PeriodicVesting); ok {
+		return x.PeriodicVesting
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*ShareVestingOptions) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*ShareVestingOptions_DelayedVesting)(nil),
+		(*ShareVestingOptions_ContinuousVesting)(nil),
+		(*ShareVestingOptions_PeriodicVesting)(nil),
 	}
 }
 
@@ -160,37 +186,236 @@ func (m *ShareDelayedVesting) GetEndTime() int64 {
 	return 0
 }
 
+// ShareContinuousVesting represents options for share continuous vesting
+// Continuous vesting is the type of vesting where vesting shares are vested
+// linearly between start time and end time
+type ShareContinuousVesting struct {
+	TotalShares Shares `protobuf:"bytes,1,rep,name=totalShares,proto3,casttype=github.com/cosmos/cosmos-sdk/types.Coin,castrepeated=Shares" json:"totalShares"`
+	Vesting     Shares `protobuf:"bytes,2,rep,name=vesting,proto3,casttype=github.com/cosmos/cosmos-sdk/types.Coin,castrepeated=Shares" json:"vesting"`
+	StartTime   int64  `protobuf:"varint,3,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime     int64  `protobuf:"varint,4,opt,name=endTime,proto3" json:"endTime,omitempty"`
+}
+
+func (m *ShareContinuousVesting) Reset()         { *m = ShareContinuousVesting{} }
+func (m *ShareContinuousVesting) String() string { return proto.CompactTextString(m) }
+func (*ShareContinuousVesting) ProtoMessage()    {}
+func (*ShareContinuousVesting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_57113a5fc3a0f84e, []int{2}
+}
+func (m *ShareContinuousVesting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShareContinuousVesting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ShareContinuousVesting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ShareContinuousVesting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShareContinuousVesting.Merge(m, src)
+}
+func (m *ShareContinuousVesting) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShareContinuousVesting) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShareContinuousVesting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShareContinuousVesting proto.InternalMessageInfo
+
+func (m *ShareContinuousVesting) GetTotalShares() Shares {
+	if m != nil {
+		return m.TotalShares
+	}
+	return nil
+}
+
+func (m *ShareContinuousVesting) GetVesting() Shares {
+	if m != nil {
+		return m.Vesting
+	}
+	return nil
+}
+
+func (m *ShareContinuousVesting) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *ShareContinuousVesting) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+// SharePeriodicVesting represents options for share periodic vesting
+// Periodic vesting is the type of vesting where vesting shares are vested
+// at the end of each period, periods start at start time
+type SharePeriodicVesting struct {
+	TotalShares Shares               `protobuf:"bytes,1,rep,name=totalShares,proto3,casttype=github.com/cosmos/cosmos-sdk/types.Coin,castrepeated=Shares" json:"totalShares"`
+	StartTime   int64                `protobuf:"varint,2,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	Periods     []ShareVestingPeriod `protobuf:"bytes,3,rep,name=periods,proto3" json:"periods"`
+}
+
+func (m *SharePeriodicVesting) Reset()         { *m = SharePeriodicVesting{} }
+func (m *SharePeriodicVesting) String() string { return proto.CompactTextString(m) }
+func (*SharePeriodicVesting) ProtoMessage()    {}
+func (*SharePeriodicVesting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_57113a5fc3a0f84e, []int{3}
+}
+func (m *SharePeriodicVesting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SharePeriodicVesting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SharePeriodicVesting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SharePeriodicVesting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SharePeriodicVesting.Merge(m, src)
+}
+func (m *SharePeriodicVesting) XXX_Size() int {
+	return m.Size()
+}
+func (m *SharePeriodicVesting) XXX_DiscardUnknown() {
+	xxx_messageInfo_SharePeriodicVesting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SharePeriodicVesting proto.InternalMessageInfo
+
+func (m *SharePeriodicVesting) GetTotalShares() Shares {
+	if m != nil {
+		return m.TotalShares
+	}
+	return nil
+}
+
+func (m *SharePeriodicVesting) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *SharePeriodicVesting) GetPeriods() []ShareVestingPeriod {
+	if m != nil {
+		return m.Periods
+	}
+	return nil
+}
+
+// ShareVestingPeriod represents a period of a share periodic vesting, length is in seconds
+type ShareVestingPeriod struct {
+	Length int64  `protobuf:"varint,1,opt,name=length,proto3" json:"length,omitempty"`
+	Shares Shares `protobuf:"bytes,2,rep,name=shares,proto3,casttype=github.com/cosmos/cosmos-sdk/types.Coin,castrepeated=Shares" json:"shares"`
+}
+
+func (m *ShareVestingPeriod) Reset()         { *m = ShareVestingPeriod{} }
+func (m *ShareVestingPeriod) String() string { return proto.CompactTextString(m) }
+func (*ShareVestingPeriod) ProtoMessage()    {}
+func (*ShareVestingPeriod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_57113a5fc3a0f84e, []int{4}
+}
+func (m *ShareVestingPeriod) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShareVestingPeriod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ShareVestingPeriod.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ShareVestingPeriod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShareVestingPeriod.Merge(m, src)
+}
+func (m *ShareVestingPeriod) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShareVestingPeriod) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShareVestingPeriod.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShareVestingPeriod proto.InternalMessageInfo
+
+func (m *ShareVestingPeriod) GetLength() int64 {
+	if m != nil {
+		return m.Length
+	}
+	return 0
+}
+
+func (m *ShareVestingPeriod) GetShares() Shares {
+	if m != nil {
+		return m.Shares
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ShareVestingOptions)(nil), "tendermint.spn.campaign.ShareVestingOptions")
 	proto.RegisterType((*ShareDelayedVesting)(nil), "tendermint.spn.campaign.ShareDelayedVesting")
+	proto.RegisterType((*ShareContinuousVesting)(nil), "tendermint.spn.campaign.ShareContinuousVesting")
+	proto.RegisterType((*SharePeriodicVesting)(nil), "tendermint.spn.campaign.SharePeriodicVesting")
+	proto.RegisterType((*ShareVestingPeriod)(nil), "tendermint.spn.campaign.ShareVestingPeriod")
 }
 
 func init() { proto.RegisterFile("campaign/vesting.proto", fileDescriptor_57113a5fc3a0f84e) }
 
 var fileDescriptor_57113a5fc3a0f84e = []byte{
-	// 337 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x92, 0xb1, 0x4e, 0x02, 0x31,
-	0x1c, 0xc6, 0xaf, 0x90, 0x40, 0x2c, 0x89, 0xc3, 0x69, 0xf4, 0x64, 0x28, 0x84, 0x45, 0x62, 0xb4,
-	0x0d, 0x38, 0xb9, 0x22, 0x83, 0x9b, 0x09, 0x1a, 0x06, 0xb7, 0xde, 0x5d, 0x73, 0x34, 0x72, 0xfd,
-	0x5f, 0x68, 0x25, 0xf0, 0x16, 0x6e, 0xbe, 0x83, 0x4f, 0xc2, 0xc8, 0xe8, 0x84, 0x06, 0xde, 0xc2,
-	0xc9, 0xd0, 0x1e, 0x0a, 0x26, 0x8e, 0x4e, 0x77, 0xcd, 0xff, 0xfb, 0xbe, 0x5f, 0xbe, 0xfe, 0x8b,
-	0x8f, 0x22, 0x9e, 0x66, 0x5c, 0x26, 0x8a, 0x8d, 0x85, 0x36, 0x52, 0x25, 0x34, 0x1b, 0x81, 0x01,
-	0xff, 0xd8, 0x08, 0x15, 0x8b, 0x51, 0x2a, 0x95, 0xa1, 0x3a, 0x53, 0x74, 0x23, 0xab, 0x1e, 0x26,
-	0x90, 0x80, 0xd5, 0xb0, 0xf5, 0x9f, 0x93, 0x57, 0x49, 0x04, 0x3a, 0x05, 0xcd, 0x42, 0xae, 0x05,
-	0x1b, 0xb7, 0x42, 0x61, 0x78, 0x8b, 0x45, 0x20, 0x95, 0x9b, 0x37, 0x26, 0xf8, 0xe0, 0x6e, 0xc0,
-	0x47, 0xa2, 0xef, 0x20, 0xb7, 0x99, 0x91, 0xa0, 0xb4, 0xdf, 0xc7, 0xfb, 0xb1, 0x18, 0xf2, 0xa9,
-	0x88, 0xf3, 0x41, 0x80, 0xea, 0xa8, 0x59, 0x69, 0x9f, 0xd3, 0x3f, 0xf0, 0xd4, 0xa6, 0x74, 0x77,
-	0x3c, 0x37, 0x5e, 0xef, 0x57, 0x4a, 0x67, 0x0f, 0x97, 0xc1, 0x21, 0x1a, 0x2f, 0x85, 0x1c, 0xbd,
-	0x6b, 0xf2, 0xc7, 0xb8, 0x62, 0xc0, 0xf0, 0xa1, 0x9d, 0xe9, 0x00, 0xd5, 0x8b, 0xcd, 0x4a, 0xfb,
-	0x84, 0xba, 0x1e, 0x74, 0xdd, 0x83, 0xe6, 0x3d, 0xe8, 0x35, 0x48, 0xd5, 0xb9, 0x9a, 0x2d, 0x6a,
-	0xde, 0xe7, 0xa2, 0x76, 0x9a, 0x48, 0x33, 0x78, 0x0a, 0x69, 0x04, 0x29, 0xcb, 0x4b, 0xbb, 0xcf,
-	0x85, 0x8e, 0x1f, 0x99, 0x99, 0x66, 0x42, 0x5b, 0xc3, 0xeb, 0x7b, 0xad, 0xe4, 0xb2, 0x7b, 0xdb,
-	0x20, 0x5f, 0xe1, 0x72, 0x7e, 0xd3, 0x41, 0xe1, 0x1f, 0x99, 0x1b, 0x88, 0x1f, 0xe0, 0xb2, 0x50,
-	0xf1, 0xbd, 0x4c, 0x45, 0x50, 0xac, 0xa3, 0x66, 0xb1, 0xb7, 0x39, 0x76, 0xba, 0xb3, 0x25, 0x41,
-	0xf3, 0x25, 0x41, 0x1f, 0x4b, 0x82, 0x9e, 0x57, 0xc4, 0x9b, 0xaf, 0x88, 0xf7, 0xb6, 0x22, 0xde,
-	0xc3, 0xd9, 0x16, 0xef, 0x67, 0x11, 0x4c, 0x67, 0x8a, 0x4d, 0xd8, 0xf7, 0x83, 0xb1, 0xdc, 0xb0,
-	0x64, 0x17, 0x7c, 0xf9, 0x15, 0x00, 0x00, 0xff, 0xff, 0xa3, 0x51, 0xd2, 0xb3, 0x49, 0x02, 0x00,
-	0x00,
+	// 493 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x94, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0xe3, 0x66, 0x6a, 0xb5, 0x57, 0x09, 0x84, 0x99, 0x4a, 0x99, 0x50, 0x3a, 0xf5, 0xc2,
+	0x04, 0xcc, 0xd6, 0xc6, 0x89, 0x6b, 0xb7, 0x43, 0x25, 0x0e, 0xa0, 0x80, 0x26, 0xc1, 0x05, 0xb9,
+	0x89, 0x95, 0x5a, 0x34, 0x76, 0x14, 0xbb, 0x15, 0xfb, 0x16, 0xdc, 0x90, 0xf8, 0x08, 0x9c, 0x39,
+	0xf0, 0x11, 0x76, 0xdc, 0x91, 0xd3, 0x40, 0xed, 0xa7, 0x80, 0x13, 0xaa, 0x9d, 0x6c, 0x4d, 0x36,
+	0x7a, 0x9b, 0x38, 0x70, 0x4a, 0x1c, 0xbf, 0xf7, 0x7b, 0x7e, 0xff, 0x7f, 0xfc, 0xa0, 0x13, 0xb1,
+	0x34, 0x63, 0x22, 0x91, 0x74, 0xc6, 0xb5, 0x11, 0x32, 0x21, 0x59, 0xae, 0x8c, 0xc2, 0xf7, 0x0c,
+	0x97, 0x31, 0xcf, 0x53, 0x21, 0x0d, 0xd1, 0x99, 0x24, 0x65, 0xd8, 0xf6, 0x56, 0xa2, 0x12, 0x65,
+	0x63, 0xe8, 0xf2, 0xcd, 0x85, 0x6f, 0x07, 0x91, 0xd2, 0xa9, 0xd2, 0x74, 0xc4, 0x34, 0xa7, 0xb3,
+	0xfd, 0x11, 0x37, 0x6c, 0x9f, 0x46, 0x4a, 0x48, 0xb7, 0xdf, 0xff, 0xda, 0x80, 0xbb, 0xaf, 0xc6,
+	0x2c, 0xe7, 0xc7, 0xae, 0xca, 0x8b, 0xcc, 0x08, 0x25, 0x35, 0x3e, 0x86, 0x5b, 0x31, 0x9f, 0xb0,
+	0x13, 0x1e, 0x17, 0x1b, 0x5d, 0xb4, 0x83, 0x76, 0xdb, 0x07, 0x4f, 0xc8, 0x5f, 0xea, 0x13, 0x4b,
+	0x39, 0xaa, 0xe4, 0x0c, 0xbd, 0xb0, 0x46, 0xc1, 0xef, 0xe0, 0x4e, 0xa4, 0xa4, 0x11, 0x72, 0xaa,
+	0xa6, 0xba, 0x44, 0x37, 0x2c, 0x9a, 0xae, 0x47, 0x1f, 0xd6, 0xd3, 0x86, 0x5e, 0x78, 0x95, 0x85,
+	0xdf, 0xc0, 0xed, 0x8c, 0xe7, 0x42, 0xc5, 0x22, 0x2a, 0xf1, 0xbe, 0xc5, 0xef, 0xad, 0xc7, 0xbf,
+	0xac, 0x26, 0x0d, 0xbd, 0xb0, 0xce, 0x19, 0x6c, 0x42, 0x4b, 0x39, 0x79, 0xfa, 0x9f, 0x4a, 0xd9,
+	0xaa, 0x0d, 0xe3, 0x19, 0xb4, 0x8d, 0x32, 0x6c, 0x62, 0xf7, 0x74, 0x17, 0xed, 0xf8, 0xbb, 0xed,
+	0x83, 0xfb, 0xc4, 0x99, 0x40, 0x96, 0x26, 0x90, 0xc2, 0x04, 0x72, 0xa8, 0x84, 0x1c, 0x3c, 0x3b,
+	0x3d, 0xef, 0x79, 0xbf, 0xcf, 0x7b, 0x0f, 0x13, 0x61, 0xc6, 0xd3, 0x11, 0x89, 0x54, 0x4a, 0x0b,
+	0xc7, 0xdc, 0x63, 0x4f, 0xc7, 0xef, 0xa9, 0x39, 0xc9, 0xb8, 0xb6, 0x09, 0x5f, 0x7e, 0xf4, 0x9a,
+	0x8e, 0x1d, 0xae, 0x16, 0xc2, 0x12, 0x5a, 0xb3, 0x0b, 0x31, 0x6f, 0xae, 0x66, 0x59, 0x04, 0x77,
+	0xa1, 0xc5, 0x65, 0xfc, 0x5a, 0xa4, 0xdc, 0xaa, 0xeb, 0x87, 0xe5, 0xb2, 0xff, 0xad, 0x01, 0x9d,
+	0xeb, 0xfd, 0xfa, 0x6f, 0xc4, 0x79, 0x00, 0x9b, 0xda, 0xb0, 0xdc, 0xac, 0xc8, 0x73, 0xf9, 0x61,
+	0x55, 0xba, 0x8d, 0xaa, 0x74, 0xbf, 0x10, 0x6c, 0x5d, 0xf7, 0x2f, 0xfe, 0x33, 0xe1, 0x2a, 0x8d,
+	0x34, 0xea, 0x8d, 0x3c, 0x87, 0x96, 0xbb, 0x21, 0xba, 0xeb, 0xdb, 0x13, 0x3d, 0x5e, 0x7f, 0xc3,
+	0x8a, 0x6e, 0x5c, 0x73, 0x83, 0x8d, 0xe5, 0x19, 0xc3, 0x92, 0xd0, 0xff, 0x8c, 0x00, 0x5f, 0x8d,
+	0xc2, 0x1d, 0x68, 0x4e, 0xb8, 0x4c, 0xcc, 0xd8, 0x8e, 0x1f, 0x3f, 0x2c, 0x56, 0x78, 0x02, 0x4d,
+	0xed, 0xc4, 0xb8, 0x49, 0x47, 0x8b, 0x1a, 0x83, 0xa3, 0xd3, 0x79, 0x80, 0xce, 0xe6, 0x01, 0xfa,
+	0x39, 0x0f, 0xd0, 0xc7, 0x45, 0xe0, 0x9d, 0x2d, 0x02, 0xef, 0xfb, 0x22, 0xf0, 0xde, 0x3e, 0x5a,
+	0x81, 0x5e, 0x36, 0x4f, 0x75, 0x26, 0xe9, 0x07, 0x7a, 0x31, 0xc1, 0x2d, 0x7c, 0xd4, 0xb4, 0x13,
+	0xf7, 0xe9, 0x9f, 0x01, 0x00, 0x13, 0x25, 0x41, 0xf7, 0xda, 0x05, 0x00, 0x00,
 }
 
 func (m *ShareVestingOptions) Marshal() (dAtA []byte, err error) {
@@ -246,6 +471,48 @@ func (m *ShareVestingOptions_DelayedVesting) MarshalToSizedBuffer(dAtA []byte) (
 	}
 	return len(dAtA) - i, nil
 }
+func (m *ShareVestingOptions_ContinuousVesting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShareVestingOptions_ContinuousVesting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ContinuousVesting != nil {
+		{
+			size, err := m.ContinuousVesting.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintVesting(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ShareVestingOptions_PeriodicVesting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShareVestingOptions_PeriodicVesting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.PeriodicVesting != nil {
+		{
+			size, err := m.PeriodicVesting.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintVesting(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *ShareDelayedVesting) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -302,70 +569,322 @@ func (m *ShareDelayedVesting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintVesting(dAtA []byte, offset int, v uint64) int {
-	offset -= sovVesting(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ShareContinuousVesting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ShareVestingOptions) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Options != nil {
-		n += m.Options.Size()
-	}
-	return n
+
+func (m *ShareContinuousVesting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ShareVestingOptions_DelayedVesting) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ShareContinuousVesting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.DelayedVesting != nil {
-		l = m.DelayedVesting.Size()
-		n += 1 + l + sovVesting(uint64(l))
-	}
-	return n
-}
-func (m *ShareDelayedVesting) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.EndTime != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x20
 	}
-	var l int
-	_ = l
-	if len(m.TotalShares) > 0 {
-		for _, e := range m.TotalShares {
-			l = e.Size()
-			n += 1 + l + sovVesting(uint64(l))
-		}
+	if m.StartTime != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x18
 	}
 	if len(m.Vesting) > 0 {
-		for _, e := range m.Vesting {
-			l = e.Size()
-			n += 1 + l + sovVesting(uint64(l))
+		for iNdEx := len(m.Vesting) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Vesting[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if m.EndTime != 0 {
-		n += 1 + sovVesting(uint64(m.EndTime))
+	if len(m.TotalShares) > 0 {
+		for iNdEx := len(m.TotalShares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalShares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovVesting(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *SharePeriodicVesting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozVesting(x uint64) (n int) {
-	return sovVesting(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *SharePeriodicVesting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SharePeriodicVesting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Periods) > 0 {
+		for iNdEx := len(m.Periods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Periods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TotalShares) > 0 {
+		for iNdEx := len(m.TotalShares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalShares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ShareVestingPeriod) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShareVestingPeriod) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShareVestingPeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Shares) > 0 {
+		for iNdEx := len(m.Shares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Length != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.Length))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintVesting(dAtA []byte, offset int, v uint64) int {
+	offset -= sovVesting(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ShareVestingOptions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Options != nil {
+		n += m.Options.Size()
+	}
+	return n
+}
+
+func (m *ShareVestingOptions_DelayedVesting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DelayedVesting != nil {
+		l = m.DelayedVesting.Size()
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	return n
+}
+func (m *ShareVestingOptions_ContinuousVesting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContinuousVesting != nil {
+		l = m.ContinuousVesting.Size()
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	return n
+}
+func (m *ShareVestingOptions_PeriodicVesting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PeriodicVesting != nil {
+		l = m.PeriodicVesting.Size()
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	return n
+}
+func (m *ShareDelayedVesting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TotalShares) > 0 {
+		for _, e := range m.TotalShares {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	if len(m.Vesting) > 0 {
+		for _, e := range m.Vesting {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovVesting(uint64(m.EndTime))
+	}
+	return n
+}
+
+func (m *ShareContinuousVesting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TotalShares) > 0 {
+		for _, e := range m.TotalShares {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	if len(m.Vesting) > 0 {
+		for _, e := range m.Vesting {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovVesting(uint64(m.StartTime))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovVesting(uint64(m.EndTime))
+	}
+	return n
+}
+
+func (m *SharePeriodicVesting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TotalShares) > 0 {
+		for _, e := range m.TotalShares {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovVesting(uint64(m.StartTime))
+	}
+	if len(m.Periods) > 0 {
+		for _, e := range m.Periods {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ShareVestingPeriod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Length != 0 {
+		n += 1 + sovVesting(uint64(m.Length))
+	}
+	if len(m.Shares) > 0 {
+		for _, e := range m.Shares {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovVesting(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozVesting(x uint64) (n int) {
+	return sovVesting(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *ShareVestingOptions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -390,15 +909,307 @@ func (m *ShareVestingOptions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ShareVestingOptions: wiretype end group for non-group")
+			return fmt.Errorf("proto: ShareVestingOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShareVestingOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayedVesting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ShareDelayedVesting{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Options = &ShareVestingOptions_DelayedVesting{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinuousVesting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ShareContinuousVesting{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Options = &ShareVestingOptions_ContinuousVesting{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodicVesting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &SharePeriodicVesting{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Options = &ShareVestingOptions_PeriodicVesting{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVesting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShareDelayedVesting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShareDelayedVesting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShareDelayedVesting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalShares = append(m.TotalShares, github_com_cosmos_cosmos_sdk_types.Coin{})
+			if err := m.TotalShares[len(m.TotalShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vesting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vesting = append(m.Vesting, github_com_cosmos_cosmos_sdk_types.Coin{})
+			if err := m.Vesting[len(m.Vesting)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVesting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShareContinuousVesting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShareContinuousVesting: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ShareVestingOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ShareContinuousVesting: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelayedVesting", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalShares", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -425,12 +1236,83 @@ func (m *ShareVestingOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ShareDelayedVesting{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TotalShares = append(m.TotalShares, github_com_cosmos_cosmos_sdk_types.Coin{})
+			if err := m.TotalShares[len(m.TotalShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vesting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vesting = append(m.Vesting, github_com_cosmos_cosmos_sdk_types.Coin{})
+			if err := m.Vesting[len(m.Vesting)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Options = &ShareVestingOptions_DelayedVesting{v}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVesting(dAtA[iNdEx:])
@@ -452,7 +1334,7 @@ func (m *ShareVestingOptions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ShareDelayedVesting) Unmarshal(dAtA []byte) error {
+func (m *SharePeriodicVesting) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -475,10 +1357,10 @@ func (m *ShareDelayedVesting) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ShareDelayedVesting: wiretype end group for non-group")
+			return fmt.Errorf("proto: SharePeriodicVesting: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ShareDelayedVesting: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SharePeriodicVesting: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -516,8 +1398,27 @@ func (m *ShareDelayedVesting) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vesting", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Periods", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -544,16 +1445,66 @@ func (m *ShareDelayedVesting) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Vesting = append(m.Vesting, github_com_cosmos_cosmos_sdk_types.Coin{})
-			if err := m.Vesting[len(m.Vesting)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Periods = append(m.Periods, ShareVestingPeriod{})
+			if err := m.Periods[len(m.Periods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVesting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShareVestingPeriod) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShareVestingPeriod: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShareVestingPeriod: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
 			}
-			m.EndTime = 0
+			m.Length = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVesting
@@ -563,11 +1514,45 @@ func (m *ShareDelayedVesting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EndTime |= int64(b&0x7F) << shift
+				m.Length |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shares = append(m.Shares, github_com_cosmos_cosmos_sdk_types.Coin{})
+			if err := m.Shares[len(m.Shares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVesting(dAtA[iNdEx:])
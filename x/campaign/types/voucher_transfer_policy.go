@@ -0,0 +1,76 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewVoucherTransferPolicy returns a new voucher transfer policy
+func NewVoucherTransferPolicy(
+	campaignID uint64,
+	mode VoucherTransferPolicy_Mode,
+	allowlist []string,
+) VoucherTransferPolicy {
+	return VoucherTransferPolicy{
+		CampaignID: campaignID,
+		Mode:       mode,
+		Allowlist:  allowlist,
+	}
+}
+
+// DefaultVoucherTransferPolicy returns the policy of a campaign without transfer restriction
+func DefaultVoucherTransferPolicy(campaignID uint64) VoucherTransferPolicy {
+	return NewVoucherTransferPolicy(campaignID, VoucherTransferPolicy_FREE, []string{})
+}
+
+// Validate checks the voucher transfer policy is valid
+func (p VoucherTransferPolicy) Validate() error {
+	if _, ok := VoucherTransferPolicy_Mode_name[int32(p.Mode)]; !ok {
+		return fmt.Errorf("invalid mode %d", p.Mode)
+	}
+
+	if p.Mode != VoucherTransferPolicy_ALLOWLIST {
+		if len(p.Allowlist) > 0 {
+			return errors.New("allowlist can only be set for the allowlist mode")
+		}
+		return nil
+	}
+
+	addressMap := make(map[string]struct{})
+	for _, address := range p.Allowlist {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return fmt.Errorf("invalid allowlist address %s: %s", address, err.Error())
+		}
+		if _, ok := addressMap[address]; ok {
+			return fmt.Errorf("duplicated allowlist address %s", address)
+		}
+		addressMap[address] = struct{}{}
+	}
+	return nil
+}
+
+// AllowsTransfer returns true if the vouchers can be sent to the recipient on the chain
+func (p VoucherTransferPolicy) AllowsTransfer(recipient string) bool {
+	if p.Mode != VoucherTransferPolicy_ALLOWLIST {
+		return true
+	}
+	for _, address := range p.Allowlist {
+		if address == recipient {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowsIBCTransfer returns true if the vouchers can be transferred through IBC
+func (p VoucherTransferPolicy) AllowsIBCTransfer() bool {
+	return p.Mode == VoucherTransferPolicy_FREE
+}
+
+// AllowsRewardPool returns true if the vouchers can be deposited in a reward pool, the rewards being
+// distributed to the validators without restriction
+func (p VoucherTransferPolicy) AllowsRewardPool() bool {
+	return p.Mode == VoucherTransferPolicy_FREE
+}
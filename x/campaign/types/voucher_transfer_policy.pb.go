@@ -0,0 +1,435 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: campaign/voucher_transfer_policy.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type VoucherTransferPolicy_Mode int32
+
+const (
+	// vouchers can be transferred freely, including through IBC
+	VoucherTransferPolicy_FREE VoucherTransferPolicy_Mode = 0
+	// vouchers can only be transferred to the addresses of the allowlist, IBC transfers are not allowed
+	VoucherTransferPolicy_ALLOWLIST VoucherTransferPolicy_Mode = 1
+	// vouchers can be transferred freely on the chain but not through IBC
+	VoucherTransferPolicy_NO_IBC VoucherTransferPolicy_Mode = 2
+)
+
+var VoucherTransferPolicy_Mode_name = map[int32]string{
+	0: "FREE",
+	1: "ALLOWLIST",
+	2: "NO_IBC",
+}
+
+var VoucherTransferPolicy_Mode_value = map[string]int32{
+	"FREE":      0,
+	"ALLOWLIST": 1,
+	"NO_IBC":    2,
+}
+
+func (x VoucherTransferPolicy_Mode) String() string {
+	return proto.EnumName(VoucherTransferPolicy_Mode_name, int32(x))
+}
+
+func (VoucherTransferPolicy_Mode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2e0c2c6b3d87f664, []int{0, 0}
+}
+
+// VoucherTransferPolicy defines the restrictions applied to the transfers of the vouchers of a campaign
+type VoucherTransferPolicy struct {
+	CampaignID uint64                     `protobuf:"varint,1,opt,name=campaignID,proto3" json:"campaignID,omitempty"`
+	Mode       VoucherTransferPolicy_Mode `protobuf:"varint,2,opt,name=mode,proto3,enum=tendermint.spn.campaign.VoucherTransferPolicy_Mode" json:"mode,omitempty"`
+	// allowlist contains the addresses allowed to receive vouchers when the mode is ALLOWLIST
+	Allowlist []string `protobuf:"bytes,3,rep,name=allowlist,proto3" json:"allowlist,omitempty"`
+}
+
+func (m *VoucherTransferPolicy) Reset()         { *m = VoucherTransferPolicy{} }
+func (m *VoucherTransferPolicy) String() string { return proto.CompactTextString(m) }
+func (*VoucherTransferPolicy) ProtoMessage()    {}
+func (*VoucherTransferPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e0c2c6b3d87f664, []int{0}
+}
+func (m *VoucherTransferPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoucherTransferPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoucherTransferPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoucherTransferPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoucherTransferPolicy.Merge(m, src)
+}
+func (m *VoucherTransferPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoucherTransferPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoucherTransferPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoucherTransferPolicy proto.InternalMessageInfo
+
+func (m *VoucherTransferPolicy) GetCampaignID() uint64 {
+	if m != nil {
+		return m.CampaignID
+	}
+	return 0
+}
+
+func (m *VoucherTransferPolicy) GetMode() VoucherTransferPolicy_Mode {
+	if m != nil {
+		return m.Mode
+	}
+	return VoucherTransferPolicy_FREE
+}
+
+func (m *VoucherTransferPolicy) GetAllowlist() []string {
+	if m != nil {
+		return m.Allowlist
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("tendermint.spn.campaign.VoucherTransferPolicy_Mode", VoucherTransferPolicy_Mode_name, VoucherTransferPolicy_Mode_value)
+	proto.RegisterType((*VoucherTransferPolicy)(nil), "tendermint.spn.campaign.VoucherTransferPolicy")
+}
+
+func init() {
+	proto.RegisterFile("campaign/voucher_transfer_policy.proto", fileDescriptor_2e0c2c6b3d87f664)
+}
+
+var fileDescriptor_2e0c2c6b3d87f664 = []byte{
+	// 309 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0xd0, 0xc1, 0x4a, 0x02, 0x41,
+	0x18, 0x07, 0xf0, 0x1d, 0x5d, 0x24, 0x07, 0x0a, 0x19, 0x8a, 0xb6, 0x0e, 0x83, 0x78, 0x08, 0x29,
+	0x9a, 0x81, 0x84, 0xee, 0x9a, 0x16, 0x82, 0x65, 0xac, 0x52, 0xd0, 0x65, 0x59, 0x77, 0x27, 0x1d,
+	0xd8, 0x9d, 0x19, 0x66, 0xc6, 0xca, 0xb7, 0xe8, 0x61, 0x7a, 0x88, 0x8e, 0xd2, 0xa9, 0x63, 0x28,
+	0xbd, 0x47, 0xb4, 0x6a, 0x76, 0xa8, 0xe3, 0x0c, 0xdf, 0xff, 0x37, 0xf3, 0xfd, 0xe1, 0x41, 0x14,
+	0xa6, 0x2a, 0xe4, 0x43, 0x41, 0x1f, 0xe4, 0x38, 0x1a, 0x31, 0x1d, 0x58, 0x1d, 0x0a, 0x73, 0xcf,
+	0x74, 0xa0, 0x64, 0xc2, 0xa3, 0x09, 0x51, 0x5a, 0x5a, 0x89, 0x76, 0x2d, 0x13, 0x31, 0xd3, 0x29,
+	0x17, 0x96, 0x18, 0x25, 0xc8, 0x2a, 0xb6, 0xbf, 0x17, 0x49, 0x93, 0x4a, 0x13, 0x64, 0x63, 0x74,
+	0x71, 0x58, 0x64, 0x2a, 0x9f, 0x00, 0xee, 0xdc, 0x2c, 0xd4, 0xfe, 0x12, 0xbd, 0xce, 0x4c, 0x84,
+	0x21, 0x5c, 0x01, 0xed, 0xa6, 0x07, 0xca, 0xa0, 0xea, 0xfa, 0xbf, 0x6e, 0xd0, 0x05, 0x74, 0x53,
+	0x19, 0x33, 0x2f, 0x57, 0x06, 0xd5, 0xad, 0x93, 0x1a, 0xf9, 0xe7, 0x71, 0xf2, 0xa7, 0x4e, 0x2e,
+	0x65, 0xcc, 0xfc, 0x0c, 0x40, 0xa7, 0xb0, 0x18, 0x26, 0x89, 0x7c, 0x4c, 0xb8, 0xb1, 0x5e, 0xbe,
+	0x9c, 0xaf, 0x16, 0x1b, 0xde, 0xdb, 0xcb, 0xf1, 0xf6, 0xf2, 0x9f, 0xf5, 0x38, 0xd6, 0xcc, 0x98,
+	0x9e, 0xd5, 0x5c, 0x0c, 0xfd, 0xf5, 0x68, 0xe5, 0x08, 0xba, 0xdf, 0x0a, 0xda, 0x80, 0xee, 0xb9,
+	0xdf, 0x6a, 0x95, 0x1c, 0xb4, 0x09, 0x8b, 0xf5, 0x4e, 0xa7, 0x7b, 0xdb, 0x69, 0xf7, 0xfa, 0x25,
+	0x80, 0x20, 0x2c, 0x5c, 0x75, 0x83, 0x76, 0xe3, 0xac, 0x94, 0x6b, 0x34, 0x5f, 0x67, 0x18, 0x4c,
+	0x67, 0x18, 0x7c, 0xcc, 0x30, 0x78, 0x9e, 0x63, 0x67, 0x3a, 0xc7, 0xce, 0xfb, 0x1c, 0x3b, 0x77,
+	0x87, 0x43, 0x6e, 0x47, 0xe3, 0x01, 0x89, 0x64, 0x4a, 0xd7, 0x3b, 0x50, 0xa3, 0x04, 0x7d, 0xa2,
+	0x3f, 0xcd, 0xdb, 0x89, 0x62, 0x66, 0x50, 0xc8, 0x4a, 0xab, 0x7d, 0x0d, 0x00, 0x58, 0xc2, 0xf5,
+	0x37, 0x92, 0x01, 0x00, 0x00,
+}
+
+func (m *VoucherTransferPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoucherTransferPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoucherTransferPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Allowlist) > 0 {
+		for iNdEx := len(m.Allowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Allowlist[iNdEx])
+			copy(dAtA[i:], m.Allowlist[iNdEx])
+			i = encodeVarintVoucherTransferPolicy(dAtA, i, uint64(len(m.Allowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Mode != 0 {
+		i = encodeVarintVoucherTransferPolicy(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CampaignID != 0 {
+		i = encodeVarintVoucherTransferPolicy(dAtA, i, uint64(m.CampaignID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintVoucherTransferPolicy(dAtA []byte, offset int, v uint64) int {
+	offset -= sovVoucherTransferPolicy(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *VoucherTransferPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CampaignID != 0 {
+		n += 1 + sovVoucherTransferPolicy(uint64(m.CampaignID))
+	}
+	if m.Mode != 0 {
+		n += 1 + sovVoucherTransferPolicy(uint64(m.Mode))
+	}
+	if len(m.Allowlist) > 0 {
+		for _, s := range m.Allowlist {
+			l = len(s)
+			n += 1 + l + sovVoucherTransferPolicy(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovVoucherTransferPolicy(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozVoucherTransferPolicy(x uint64) (n int) {
+	return sovVoucherTransferPolicy(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *VoucherTransferPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVoucherTransferPolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoucherTransferPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoucherTransferPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignID", wireType)
+			}
+			m.CampaignID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoucherTransferPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoucherTransferPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= VoucherTransferPolicy_Mode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoucherTransferPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVoucherTransferPolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoucherTransferPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowlist = append(m.Allowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVoucherTransferPolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVoucherTransferPolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipVoucherTransferPolicy(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowVoucherTransferPolicy
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowVoucherTransferPolicy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowVoucherTransferPolicy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthVoucherTransferPolicy
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupVoucherTransferPolicy
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthVoucherTransferPolicy
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthVoucherTransferPolicy        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowVoucherTransferPolicy          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupVoucherTransferPolicy = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,113 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/campaign/types"
+)
+
+func TestVoucherTransferPolicy_Validate(t *testing.T) {
+	addr := sample.Address(r)
+
+	for _, tc := range []struct {
+		desc   string
+		policy types.VoucherTransferPolicy
+		valid  bool
+	}{
+		{
+			desc:   "should validate the default policy",
+			policy: types.DefaultVoucherTransferPolicy(0),
+			valid:  true,
+		},
+		{
+			desc:   "should validate a policy without IBC transfers",
+			policy: types.NewVoucherTransferPolicy(0, types.VoucherTransferPolicy_NO_IBC, nil),
+			valid:  true,
+		},
+		{
+			desc:   "should validate an allowlist policy",
+			policy: sample.VoucherTransferPolicy(r, 0),
+			valid:  true,
+		},
+		{
+			desc:   "should validate an allowlist policy with an empty allowlist",
+			policy: types.NewVoucherTransferPolicy(0, types.VoucherTransferPolicy_ALLOWLIST, nil),
+			valid:  true,
+		},
+		{
+			desc:   "should prevent validate an invalid mode",
+			policy: types.NewVoucherTransferPolicy(0, types.VoucherTransferPolicy_Mode(10), nil),
+		},
+		{
+			desc:   "should prevent validate an allowlist for a free policy",
+			policy: types.NewVoucherTransferPolicy(0, types.VoucherTransferPolicy_FREE, []string{addr}),
+		},
+		{
+			desc:   "should prevent validate an allowlist for a policy without IBC transfers",
+			policy: types.NewVoucherTransferPolicy(0, types.VoucherTransferPolicy_NO_IBC, []string{addr}),
+		},
+		{
+			desc:   "should prevent validate an allowlist with an invalid address",
+			policy: types.NewVoucherTransferPolicy(0, types.VoucherTransferPolicy_ALLOWLIST, []string{"invalid"}),
+		},
+		{
+			desc:   "should prevent validate an allowlist with duplicated addresses",
+			policy: types.NewVoucherTransferPolicy(0, types.VoucherTransferPolicy_ALLOWLIST, []string{addr, addr}),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.policy.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestVoucherTransferPolicy_AllowsTransfer(t *testing.T) {
+	var (
+		allowed    = sample.Address(r)
+		notAllowed = sample.Address(r)
+	)
+
+	for _, tc := range []struct {
+		desc               string
+		policy             types.VoucherTransferPolicy
+		allowsAllowed      bool
+		allowsNotAllowed   bool
+		allowsIBCTransfers bool
+		allowsRewardPool   bool
+	}{
+		{
+			desc:               "should allow all transfers with the free mode",
+			policy:             types.DefaultVoucherTransferPolicy(0),
+			allowsAllowed:      true,
+			allowsNotAllowed:   true,
+			allowsIBCTransfers: true,
+			allowsRewardPool:   true,
+		},
+		{
+			desc:             "should allow all transfers except IBC with the no IBC mode",
+			policy:           types.NewVoucherTransferPolicy(0, types.VoucherTransferPolicy_NO_IBC, nil),
+			allowsAllowed:    true,
+			allowsNotAllowed: true,
+		},
+		{
+			desc:          "should allow the transfers to the allowlist with the allowlist mode",
+			policy:        types.NewVoucherTransferPolicy(0, types.VoucherTransferPolicy_ALLOWLIST, []string{allowed}),
+			allowsAllowed: true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			require.Equal(t, tc.allowsAllowed, tc.policy.AllowsTransfer(allowed))
+			require.Equal(t, tc.allowsNotAllowed, tc.policy.AllowsTransfer(notAllowed))
+			require.Equal(t, tc.allowsIBCTransfers, tc.policy.AllowsIBCTransfer())
+			require.Equal(t, tc.allowsRewardPool, tc.policy.AllowsRewardPool())
+		})
+	}
+}
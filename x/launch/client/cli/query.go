@@ -35,7 +35,10 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		CmdListGenesisValidator(),
 		CmdShowRequest(),
 		CmdListRequest(),
+		CmdListRequestByCreator(),
+		CmdShowApprovalPolicy(),
 		CmdQueryParams(),
+		CmdBuildGenesis(),
 	)
 
 	// this line is used by starport scaffolding # 1
@@ -0,0 +1,45 @@
+package cli
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+func CmdShowApprovalPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-approval-policy [launch-id]",
+		Short: "Shows the approval policy of a chain",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			launchID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryGetApprovalPolicyRequest{
+				LaunchID: launchID,
+			}
+
+			res, err := queryClient.ApprovalPolicy(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,212 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/spf13/cobra"
+
+	"github.com/tendermint/spn/pkg/genesis"
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+const (
+	flagInitialGenesis = "initial-genesis"
+	flagAddressPrefix  = "address-prefix"
+	flagOutput         = "output"
+
+	// initialGenesisFetchTimeout is the timeout to fetch the initial genesis from the genesis URL
+	initialGenesisFetchTimeout = time.Minute
+
+	// maxInitialGenesisSize is the maximum size in bytes of an initial genesis fetched from the genesis URL
+	maxInitialGenesisSize = 100 << 20
+)
+
+func CmdBuildGenesis() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "build-genesis [launch-id]",
+		Short: "Build the genesis of a chain from its approved launch information",
+		Long: `Build the genesis of a chain from its initial genesis and its approved genesis accounts,
+vesting accounts and genesis validators. The resulting genesis is deterministic: all validators
+building the genesis of a chain from the same launch information get the same genesis hash.
+
+The initial genesis is fetched from the genesis URL of the chain if any, otherwise it must be
+provided with the --initial-genesis flag.
+
+The addresses of the accounts are converted to the bech32 prefix of the chain provided with the
+--address-prefix flag.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			launchID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			initialGenesisPath, err := cmd.Flags().GetString(flagInitialGenesis)
+			if err != nil {
+				return err
+			}
+			addressPrefix, err := cmd.Flags().GetString(flagAddressPrefix)
+			if err != nil {
+				return err
+			}
+			output, err := cmd.Flags().GetString(flagOutput)
+			if err != nil {
+				return err
+			}
+
+			ctx := context.Background()
+			chainRes, err := queryClient.Chain(ctx, &types.QueryGetChainRequest{LaunchID: launchID})
+			if err != nil {
+				return err
+			}
+			chain := chainRes.Chain
+
+			initialGenesis, err := fetchInitialGenesis(chain, initialGenesisPath)
+			if err != nil {
+				return err
+			}
+
+			info, err := queryLaunchInformation(ctx, queryClient, launchID)
+			if err != nil {
+				return err
+			}
+
+			genBz, err := genesis.Build(
+				clientCtx.Codec,
+				chain,
+				initialGenesis,
+				info,
+				genesis.WithAddressPrefix(addressPrefix),
+			)
+			if err != nil {
+				return err
+			}
+
+			if output == "" {
+				if _, err := cmd.OutOrStdout().Write(append(genBz, '\n')); err != nil {
+					return err
+				}
+			} else if err := os.WriteFile(output, genBz, 0o644); err != nil {
+				return err
+			}
+
+			cmd.PrintErrf("genesis hash: %s\n", genesis.Hash(genBz))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagInitialGenesis, "", "Path to the initial genesis of the chain, required for a default initial genesis")
+	cmd.Flags().String(flagAddressPrefix, "", "Bech32 prefix of the account addresses of the chain")
+	cmd.Flags().String(flagOutput, "", "Path to write the genesis to, the genesis is printed if not set")
+	flags.AddQueryFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(flagAddressPrefix)
+
+	return cmd
+}
+
+// fetchInitialGenesis returns the initial genesis of the chain from the provided file or from its genesis URL
+func fetchInitialGenesis(chain types.Chain, path string) ([]byte, error) {
+	if path != "" {
+		return os.ReadFile(path)
+	}
+
+	genesisURL := chain.InitialGenesis.GetGenesisURL()
+	if genesisURL == nil {
+		return nil, fmt.Errorf(
+			"chain %d uses a default initial genesis, --%s must be provided",
+			chain.LaunchID,
+			flagInitialGenesis,
+		)
+	}
+
+	httpClient := &http.Client{Timeout: initialGenesisFetchTimeout}
+	res, err := httpClient.Get(genesisURL.Url)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch initial genesis from %s: %s", genesisURL.Url, res.Status)
+	}
+
+	// one more byte is read to detect an initial genesis exceeding the maximum size
+	initialGenesis, err := io.ReadAll(io.LimitReader(res.Body, maxInitialGenesisSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(initialGenesis) > maxInitialGenesisSize {
+		return nil, fmt.Errorf("initial genesis from %s exceeds the maximum size of %d bytes", genesisURL.Url, maxInitialGenesisSize)
+	}
+	return initialGenesis, nil
+}
+
+// queryLaunchInformation returns all the genesis accounts, vesting accounts and genesis validators of a chain
+func queryLaunchInformation(
+	ctx context.Context,
+	queryClient types.QueryClient,
+	launchID uint64,
+) (info genesis.LaunchInformation, err error) {
+	pageReq := &query.PageRequest{}
+	for {
+		res, err := queryClient.GenesisAccountAll(ctx, &types.QueryAllGenesisAccountRequest{
+			LaunchID:   launchID,
+			Pagination: pageReq,
+		})
+		if err != nil {
+			return info, err
+		}
+		info.GenesisAccounts = append(info.GenesisAccounts, res.GenesisAccount...)
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			break
+		}
+		pageReq = &query.PageRequest{Key: res.Pagination.NextKey}
+	}
+
+	pageReq = &query.PageRequest{}
+	for {
+		res, err := queryClient.VestingAccountAll(ctx, &types.QueryAllVestingAccountRequest{
+			LaunchID:   launchID,
+			Pagination: pageReq,
+		})
+		if err != nil {
+			return info, err
+		}
+		info.VestingAccounts = append(info.VestingAccounts, res.VestingAccount...)
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			break
+		}
+		pageReq = &query.PageRequest{Key: res.Pagination.NextKey}
+	}
+
+	pageReq = &query.PageRequest{}
+	for {
+		res, err := queryClient.GenesisValidatorAll(ctx, &types.QueryAllGenesisValidatorRequest{
+			LaunchID:   launchID,
+			Pagination: pageReq,
+		})
+		if err != nil {
+			return info, err
+		}
+		info.GenesisValidators = append(info.GenesisValidators, res.GenesisValidator...)
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			break
+		}
+		pageReq = &query.PageRequest{Key: res.Pagination.NextKey}
+	}
+
+	return info, nil
+}
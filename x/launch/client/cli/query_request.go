@@ -2,7 +2,9 @@ package cli
 
 import (
 	"context"
+	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -12,11 +14,19 @@ import (
 	"github.com/tendermint/spn/x/launch/types"
 )
 
+const (
+	flagStatus      = "status"
+	flagContentType = "content-type"
+)
+
 func CmdListRequest() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "list-request [launch-id]",
 		Short: "List all requests",
-		Args:  cobra.ExactArgs(1),
+		Long: `List all requests of a chain.
+The requests can be filtered by status with --status and by type of content with --content-type.`,
+		Example: "  list-request 1 --status pending --content-type genesisValidator",
+		Args:    cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx := client.GetClientContextFromCmd(cmd)
 
@@ -32,9 +42,42 @@ func CmdListRequest() *cobra.Command {
 				return err
 			}
 
+			contentType, err := cmd.Flags().GetString(flagContentType)
+			if err != nil {
+				return err
+			}
+
+			statusStr, err := cmd.Flags().GetString(flagStatus)
+			if err != nil {
+				return err
+			}
+
+			// the status index is queried if a status is provided
+			if statusStr != "" {
+				status, ok := types.Request_Status_value[strings.ToUpper(statusStr)]
+				if !ok {
+					return fmt.Errorf("invalid status '%s'", statusStr)
+				}
+
+				params := &types.QueryAllRequestByStatusRequest{
+					LaunchID:    launchID,
+					Status:      types.Request_Status(status),
+					ContentType: contentType,
+					Pagination:  pageReq,
+				}
+
+				res, err := queryClient.RequestAllByStatus(context.Background(), params)
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(res)
+			}
+
 			params := &types.QueryAllRequestRequest{
-				LaunchID:   launchID,
-				Pagination: pageReq,
+				LaunchID:    launchID,
+				ContentType: contentType,
+				Pagination:  pageReq,
 			}
 
 			res, err := queryClient.RequestAll(context.Background(), params)
@@ -46,6 +89,50 @@ func CmdListRequest() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(flagStatus, "", "Filter the requests by status (pending|approved|rejected|cancelled)")
+	cmd.Flags().String(flagContentType, "", fmt.Sprintf("Filter the requests by type of content %v", types.RequestContentTypes))
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdListRequestByCreator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-request-by-creator [address]",
+		Short: "List all requests sent by an account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			contentType, err := cmd.Flags().GetString(flagContentType)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryAllRequestByCreatorRequest{
+				Creator:     args[0],
+				ContentType: contentType,
+				Pagination:  pageReq,
+			}
+
+			res, err := queryClient.RequestAllByCreator(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagContentType, "", fmt.Sprintf("Filter the requests by type of content %v", types.RequestContentTypes))
 	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
 	flags.AddQueryFlagsToCmd(cmd)
 
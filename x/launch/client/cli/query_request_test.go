@@ -7,6 +7,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/require"
 	tmcli "github.com/tendermint/tendermint/libs/cli"
 	"google.golang.org/grpc/codes"
@@ -128,3 +129,40 @@ func (suite *QueryTestSuite) TestListRequest() {
 		require.ElementsMatch(t, requests, resp.Request)
 	})
 }
+
+func (suite *QueryTestSuite) TestListRequestFiltered() {
+	ctx := suite.Network.Validators[0].ClientCtx
+	requests := suite.LaunchState.RequestList
+
+	list := func(t *testing.T, cmd *cobra.Command, args ...string) []types.Request {
+		args = append(args, fmt.Sprintf("--%s=json", tmcli.OutputFlag))
+		out, err := clitestutil.ExecTestCLICmd(ctx, cmd, args)
+		require.NoError(t, err)
+		var resp types.QueryAllRequestResponse
+		require.NoError(t, suite.Network.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+		return resp.Request
+	}
+
+	suite.T().Run("should allow listing requests by status", func(t *testing.T) {
+		got := list(t, cli.CmdListRequest(), "0", "--status=pending")
+		require.ElementsMatch(t, requests, got)
+
+		got = list(t, cli.CmdListRequest(), "0", "--status=approved")
+		require.Empty(t, got)
+	})
+	suite.T().Run("should allow listing requests by content type", func(t *testing.T) {
+		got := list(t, cli.CmdListRequest(), "0", "--content-type="+types.RequestContentTypeGenesisAccount)
+		require.ElementsMatch(t, requests, got)
+
+		got = list(t, cli.CmdListRequest(), "0", "--status=pending", "--content-type="+types.RequestContentTypeGenesisValidator)
+		require.Empty(t, got)
+	})
+	suite.T().Run("should allow listing requests by creator", func(t *testing.T) {
+		got := list(t, cli.CmdListRequestByCreator(), requests[0].Creator)
+		require.ElementsMatch(t, requests[:1], got)
+	})
+	suite.T().Run("should prevent listing requests with invalid status", func(t *testing.T) {
+		_, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdListRequest(), []string{"0", "--status=invalid"})
+		require.Error(t, err)
+	})
+}
@@ -26,11 +26,17 @@ func GetTxCmd() *cobra.Command {
 		CmdRequestAddAccount(),
 		CmdRequestAddVestingAccount(),
 		CmdRequestRemoveAccount(),
+		CmdRequestUpdateAccount(),
 		CmdRequestAddValidator(),
 		CmdRequestRemoveValidator(),
+		CmdRequestUpdateValidatorPeer(),
+		CmdRequestUpdateValidatorGentx(),
 		CmdSettleRequest(),
+		CmdSettleRequests(),
+		CmdCancelRequest(),
 		CmdTriggerLaunch(),
 		CmdRevertLaunch(),
+		CmdSetApprovalPolicy(),
 	)
 	// this line is used by starport scaffolding # 1
 
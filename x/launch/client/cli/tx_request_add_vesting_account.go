@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"errors"
 	"fmt"
 	"strconv"
 
@@ -15,33 +16,34 @@ import (
 
 func CmdRequestAddVestingAccount() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "request-add-vesting-account [launch-id] [total-balance] [vesting-coins] [vesting-end-time]",
+		Use:   "request-add-vesting-account [launch-id] [total-balance]",
 		Short: "Request to add a vesting account",
-		Args:  cobra.ExactArgs(4),
+		Long: "Request to add a vesting account, the vesting is periodic if vesting periods are provided, " +
+			"continuous if a start time is provided and delayed otherwise",
+		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			totalBalance, err := sdk.ParseCoinsNormalized(args[1])
+			launchID, err := strconv.ParseUint(args[0], 10, 64)
 			if err != nil {
-				return fmt.Errorf("failed to parse coins: %w", err)
+				return err
 			}
 
-			vestingCoins, err := sdk.ParseCoinsNormalized(args[2])
+			totalBalance, err := sdk.ParseCoinsNormalized(args[1])
 			if err != nil {
 				return fmt.Errorf("failed to parse coins: %w", err)
 			}
 
-			endTime, _ := strconv.ParseUint(args[3], 10, 64)
-
-			delayedVesting := *types.NewDelayedVesting(totalBalance, vestingCoins, int64(endTime))
-
-			launchID, err := strconv.ParseUint(args[0], 10, 64)
+			vestingOptions, err := parseVestingOptions(cmd, totalBalance)
 			if err != nil {
 				return err
 			}
+			if vestingOptions == nil {
+				return errors.New("vesting coins or vesting periods must be provided")
+			}
 
 			fromAddr := clientCtx.GetFromAddress().String()
 			accountAddr, _ := cmd.Flags().GetString(flagAccountAddress)
@@ -53,7 +55,7 @@ func CmdRequestAddVestingAccount() *cobra.Command {
 				fromAddr,
 				launchID,
 				accountAddr,
-				delayedVesting,
+				*vestingOptions,
 			)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -63,6 +65,7 @@ func CmdRequestAddVestingAccount() *cobra.Command {
 	}
 
 	cmd.Flags().String(flagAccountAddress, "", "Address of the vesting account to request")
+	addVestingFlags(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
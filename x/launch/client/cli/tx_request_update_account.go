@@ -0,0 +1,72 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+func CmdRequestUpdateAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "request-update-account [launch-id] [coins]",
+		Short: "Request to update the balance of an account",
+		Long: "Request to update the balance of an existing account, " +
+			"the account becomes a vesting account with the coins as total balance if vesting coins or periods are provided",
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			launchID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			coins, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("failed to parse coins: %w", err)
+			}
+
+			vestingOptions, err := parseVestingOptions(cmd, coins)
+			if err != nil {
+				return err
+			}
+			if vestingOptions != nil {
+				coins = nil
+			}
+
+			fromAddr := clientCtx.GetFromAddress().String()
+			accountAddr, _ := cmd.Flags().GetString(flagAccountAddress)
+			if accountAddr == "" {
+				accountAddr = fromAddr
+			}
+
+			msg := types.NewMsgRequestUpdateAccount(
+				fromAddr,
+				launchID,
+				accountAddr,
+				coins,
+				vestingOptions,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagAccountAddress, "", "Address of the account to update")
+	addVestingFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,76 @@
+package cli
+
+import (
+	"encoding/base64"
+	"os"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+func CmdRequestUpdateValidatorGentx() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "request-update-validator-gentx [launch-id] [gentx-file] [consensus-public-key] [self-delegation]",
+		Short: "Send a request to update the gentx of a genesis validator",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			launchID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			// Read gentxFile
+			gentxBytes, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			// Read consensus pub key
+			consPubKey, err := base64.StdEncoding.DecodeString(args[2])
+			if err != nil {
+				return err
+			}
+
+			// Read self-delegation
+			selfDelegation, err := sdk.ParseCoinNormalized(args[3])
+			if err != nil {
+				return err
+			}
+
+			fromAddr := clientCtx.GetFromAddress().String()
+			valAddr, _ := cmd.Flags().GetString(flagValidatorAddress)
+			if valAddr == "" {
+				valAddr = fromAddr
+			}
+
+			msg := types.NewMsgRequestUpdateValidatorGentx(
+				fromAddr,
+				launchID,
+				valAddr,
+				gentxBytes,
+				consPubKey,
+				selfDelegation,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagValidatorAddress, "", "Address of the genesis validator to update")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,57 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+func CmdRequestUpdateValidatorPeer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "request-update-validator-peer [launch-id] [peer-id] [peer-address]",
+		Short: "Update the peer of a genesis validator",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			launchID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			fromAddr := clientCtx.GetFromAddress().String()
+			valAddr, _ := cmd.Flags().GetString(flagValidatorAddress)
+			if valAddr == "" {
+				valAddr = fromAddr
+			}
+
+			valPeerTunnel, _ := cmd.Flags().GetString(flagValidatorPeerTunnel)
+			var peer types.Peer
+			if valPeerTunnel != "" {
+				peer = types.NewPeerTunnel(args[1], valPeerTunnel, args[2])
+			} else {
+				peer = types.NewPeerConn(args[1], args[2])
+			}
+
+			msg := types.NewMsgRequestUpdateValidatorPeer(fromAddr, launchID, valAddr, peer)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagValidatorAddress, "", "Address of the genesis validator to update")
+	cmd.Flags().String(flagValidatorPeerTunnel, "", "Update the validator peer as a tunnel and create a name")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
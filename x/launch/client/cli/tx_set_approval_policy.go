@@ -0,0 +1,78 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+const (
+	flagMaxAccountCoins   = "max-account-coins"
+	flagMinSelfDelegation = "min-self-delegation"
+	flagAllowlist         = "allowlist"
+)
+
+func CmdSetApprovalPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-approval-policy [launch-id]",
+		Short: "Set the policy to automatically approve the requests of a chain",
+		Long: "Set the policy to automatically approve the requests of a chain, " +
+			"the policy is removed if no rule is provided",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			launchID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			maxAccountCoinsStr, _ := cmd.Flags().GetString(flagMaxAccountCoins)
+			maxAccountCoins, err := sdk.ParseCoinsNormalized(maxAccountCoinsStr)
+			if err != nil {
+				return fmt.Errorf("failed to parse max account coins: %w", err)
+			}
+
+			var minSelfDelegation *sdk.Coin
+			minSelfDelegationStr, _ := cmd.Flags().GetString(flagMinSelfDelegation)
+			if minSelfDelegationStr != "" {
+				coin, err := sdk.ParseCoinNormalized(minSelfDelegationStr)
+				if err != nil {
+					return fmt.Errorf("failed to parse min self delegation: %w", err)
+				}
+				minSelfDelegation = &coin
+			}
+
+			allowlist, _ := cmd.Flags().GetStringSlice(flagAllowlist)
+
+			msg := types.NewMsgSetApprovalPolicy(
+				clientCtx.GetFromAddress().String(),
+				launchID,
+				maxAccountCoins,
+				minSelfDelegation,
+				allowlist,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagMaxAccountCoins, "", "Maximum balance of the account requests automatically approved")
+	cmd.Flags().String(flagMinSelfDelegation, "", "Minimum self delegation of the validator requests automatically approved")
+	cmd.Flags().StringSlice(flagAllowlist, []string{}, "Addresses whose requests are automatically approved")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,142 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+const (
+	flagApprove     = "approve"
+	flagReject      = "reject"
+	flagRange       = "range"
+	flagRangeAction = "range-action"
+	flagBestEffort  = "best-effort"
+)
+
+func CmdSettleRequests() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "settle-requests [launch-id]",
+		Short: "Approve or reject several pending requests",
+		Long: `Approve or reject several pending requests in a single transaction.
+The requests are either listed with the --approve and --reject flags or all the pending
+requests of a range are settled with the --range and --range-action flags.
+
+By default, all settlements are reverted if one of them fails. With --best-effort, the valid
+requests are settled and the failed ones are reported.
+`,
+		Example: `  settle-requests 1 --approve 1,2,3 --reject 4
+  settle-requests 1 --range 1:100 --range-action approve --best-effort`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			launchID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			approved, err := cmd.Flags().GetUintSlice(flagApprove)
+			if err != nil {
+				return err
+			}
+			rejected, err := cmd.Flags().GetUintSlice(flagReject)
+			if err != nil {
+				return err
+			}
+			var settlements []types.RequestSettlement
+			for _, requestID := range approved {
+				settlements = append(settlements, types.RequestSettlement{
+					RequestID: uint64(requestID),
+					Approve:   true,
+				})
+			}
+			for _, requestID := range rejected {
+				settlements = append(settlements, types.RequestSettlement{
+					RequestID: uint64(requestID),
+					Approve:   false,
+				})
+			}
+
+			requestIDRange, err := getRequestIDRange(cmd)
+			if err != nil {
+				return err
+			}
+
+			bestEffort, err := cmd.Flags().GetBool(flagBestEffort)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSettleRequests(
+				clientCtx.GetFromAddress().String(),
+				launchID,
+				settlements,
+				requestIDRange,
+				bestEffort,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().UintSlice(flagApprove, []uint{}, "IDs of the requests to approve")
+	cmd.Flags().UintSlice(flagReject, []uint{}, "IDs of the requests to reject")
+	cmd.Flags().String(flagRange, "", "Range of request IDs to settle, bounds included (e.g. 1:100)")
+	cmd.Flags().String(flagRangeAction, "approve", "Settlement of the requests of the range (approve|reject)")
+	cmd.Flags().Bool(flagBestEffort, false, "Settle the valid requests and report the failed ones instead of reverting all settlements")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// getRequestIDRange returns the request ID range from the flags if set
+func getRequestIDRange(cmd *cobra.Command) (*types.RequestIDRange, error) {
+	rangeStr, err := cmd.Flags().GetString(flagRange)
+	if err != nil || rangeStr == "" {
+		return nil, err
+	}
+
+	bounds := strings.Split(rangeStr, ":")
+	if len(bounds) != 2 {
+		return nil, fmt.Errorf("invalid range '%s', expected format is start:end", rangeStr)
+	}
+	start, err := strconv.ParseUint(bounds[0], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	end, err := strconv.ParseUint(bounds[1], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	rangeAction, err := cmd.Flags().GetString(flagRangeAction)
+	if err != nil {
+		return nil, err
+	}
+	approve, ok := approveMap[rangeAction]
+	if !ok {
+		return nil, fmt.Errorf(
+			"invalid range action '%s'. actions must be %v",
+			rangeAction, approveMap,
+		)
+	}
+
+	return &types.RequestIDRange{
+		Start:   start,
+		End:     end,
+		Approve: approve,
+	}, nil
+}
@@ -0,0 +1,91 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+const (
+	flagVestingCoins     = "vesting-coins"
+	flagVestingStartTime = "vesting-start-time"
+	flagVestingEndTime   = "vesting-end-time"
+	flagVestingPeriod    = "vesting-period"
+)
+
+// addVestingFlags adds the flags to define the vesting options of an account
+func addVestingFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagVestingCoins, "", "Vesting coins of a delayed or continuous vesting")
+	cmd.Flags().Int64(flagVestingStartTime, 0, "Start time of a continuous or periodic vesting, delayed vesting is used if not set")
+	cmd.Flags().Int64(flagVestingEndTime, 0, "End time of a delayed or continuous vesting")
+	cmd.Flags().StringArray(
+		flagVestingPeriod,
+		nil,
+		"Period of a periodic vesting as <length>:<coins>, can be repeated, periodic vesting is used if set",
+	)
+}
+
+// parseVestingOptions returns the vesting options of an account with the total balance from the vesting flags
+// the vesting is periodic if periods are provided, continuous if a start time is provided and delayed otherwise
+// nil is returned if neither vesting coins nor periods are provided
+func parseVestingOptions(cmd *cobra.Command, totalBalance sdk.Coins) (*types.VestingOptions, error) {
+	var (
+		vestingCoinsStr, _ = cmd.Flags().GetString(flagVestingCoins)
+		startTime, _       = cmd.Flags().GetInt64(flagVestingStartTime)
+		endTime, _         = cmd.Flags().GetInt64(flagVestingEndTime)
+		periodsStr, _      = cmd.Flags().GetStringArray(flagVestingPeriod)
+	)
+
+	if len(periodsStr) > 0 {
+		if vestingCoinsStr != "" || endTime != 0 {
+			return nil, errors.New("vesting coins and end time can't be set for a periodic vesting")
+		}
+		periods := make([]types.VestingPeriod, 0, len(periodsStr))
+		for _, periodStr := range periodsStr {
+			period, err := parseVestingPeriod(periodStr)
+			if err != nil {
+				return nil, err
+			}
+			periods = append(periods, period)
+		}
+		return types.NewPeriodicVesting(totalBalance, startTime, periods), nil
+	}
+
+	if vestingCoinsStr == "" {
+		return nil, nil
+	}
+	vestingCoins, err := sdk.ParseCoinsNormalized(vestingCoinsStr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse vesting coins: %w", err)
+	}
+	if startTime != 0 {
+		return types.NewContinuousVesting(totalBalance, vestingCoins, startTime, endTime), nil
+	}
+	return types.NewDelayedVesting(totalBalance, vestingCoins, endTime), nil
+}
+
+// parseVestingPeriod parses a vesting period in the format <length>:<coins>
+func parseVestingPeriod(periodStr string) (types.VestingPeriod, error) {
+	lengthStr, coinsStr, found := strings.Cut(periodStr, ":")
+	if !found {
+		return types.VestingPeriod{}, fmt.Errorf("invalid vesting period %s, expected <length>:<coins>", periodStr)
+	}
+	length, err := strconv.ParseInt(lengthStr, 10, 64)
+	if err != nil {
+		return types.VestingPeriod{}, fmt.Errorf("failed to parse vesting period length: %w", err)
+	}
+	amount, err := sdk.ParseCoinsNormalized(coinsStr)
+	if err != nil {
+		return types.VestingPeriod{}, fmt.Errorf("failed to parse vesting period coins: %w", err)
+	}
+	return types.VestingPeriod{
+		Length: length,
+		Amount: amount,
+	}, nil
+}
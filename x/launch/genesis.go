@@ -43,6 +43,11 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		k.SetRequestCounter(ctx, elem.LaunchID, elem.Counter)
 	}
 
+	// Set all the approvalPolicy
+	for _, elem := range genState.ApprovalPolicyList {
+		k.SetApprovalPolicy(ctx, elem)
+	}
+
 	k.SetParams(ctx, genState.Params)
 }
 
@@ -56,6 +61,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.VestingAccountList = k.GetAllVestingAccount(ctx)
 	genesis.GenesisValidatorList = k.GetAllGenesisValidator(ctx)
 	genesis.RequestList = k.GetAllRequest(ctx)
+	genesis.ApprovalPolicyList = k.GetAllApprovalPolicy(ctx)
 	genesis.Params = k.GetParams(ctx)
 
 	// Get request counts
@@ -33,6 +33,7 @@ func TestGenesis(t *testing.T) {
 		require.ElementsMatch(t, genesisState.GenesisValidatorList, got.GenesisValidatorList)
 		require.ElementsMatch(t, genesisState.RequestList, got.RequestList)
 		require.ElementsMatch(t, genesisState.RequestCounterList, got.RequestCounterList)
+		require.ElementsMatch(t, genesisState.ApprovalPolicyList, got.ApprovalPolicyList)
 
 		require.Equal(t, genesisState.Params, got.Params)
 	})
@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tendermint/spn/x/launch/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+)
+
+// SetApprovalPolicy set a specific approvalPolicy in the store from its index
+func (k Keeper) SetApprovalPolicy(ctx sdk.Context, approvalPolicy types.ApprovalPolicy) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ApprovalPolicyKeyPrefix))
+	b := k.cdc.MustMarshal(&approvalPolicy)
+	store.Set(types.ChainKey(approvalPolicy.LaunchID), b)
+}
+
+// GetApprovalPolicy returns an approvalPolicy from its index
+func (k Keeper) GetApprovalPolicy(ctx sdk.Context, launchID uint64) (val types.ApprovalPolicy, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ApprovalPolicyKeyPrefix))
+
+	b := store.Get(types.ChainKey(launchID))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveApprovalPolicy removes an approvalPolicy from the store
+func (k Keeper) RemoveApprovalPolicy(ctx sdk.Context, launchID uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ApprovalPolicyKeyPrefix))
+	store.Delete(types.ChainKey(launchID))
+}
+
+// GetAllApprovalPolicy returns all approvalPolicy
+func (k Keeper) GetAllApprovalPolicy(ctx sdk.Context) (list []types.ApprovalPolicy) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ApprovalPolicyKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ApprovalPolicy
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// IsRequestAutoApproved returns true if a request created by creator is approved without the coordinator
+// action, either because the creator can settle the requests for the coordinator or because the approval
+// policy of the chain allows it
+func (k Keeper) IsRequestAutoApproved(
+	ctx sdk.Context,
+	launchID uint64,
+	coord profiletypes.Coordinator,
+	creator string,
+	content types.RequestContent,
+) bool {
+	if coord.HasPermission(creator, profiletypes.CoordinatorPermission_SETTLE_REQUESTS) {
+		return true
+	}
+
+	policy, found := k.GetApprovalPolicy(ctx, launchID)
+	return found && policy.Approves(creator, content)
+}
@@ -0,0 +1,145 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	tc "github.com/tendermint/spn/testutil/constructor"
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/launch/keeper"
+	"github.com/tendermint/spn/x/launch/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+)
+
+func createNApprovalPolicy(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.ApprovalPolicy {
+	items := make([]types.ApprovalPolicy, n)
+	for i := range items {
+		items[i] = sample.ApprovalPolicy(r, uint64(i))
+		keeper.SetApprovalPolicy(ctx, items[i])
+	}
+	return items
+}
+
+func TestApprovalPolicyGet(t *testing.T) {
+	ctx, tk, _ := testkeeper.NewTestSetup(t)
+	items := createNApprovalPolicy(tk.LaunchKeeper, ctx, 10)
+
+	t.Run("should get an approval policy", func(t *testing.T) {
+		for _, item := range items {
+			rst, found := tk.LaunchKeeper.GetApprovalPolicy(ctx, item.LaunchID)
+			require.True(t, found)
+			require.Equal(t, item, rst)
+		}
+	})
+}
+
+func TestApprovalPolicyRemove(t *testing.T) {
+	ctx, tk, _ := testkeeper.NewTestSetup(t)
+	items := createNApprovalPolicy(tk.LaunchKeeper, ctx, 10)
+
+	t.Run("should remove an approval policy", func(t *testing.T) {
+		for _, item := range items {
+			tk.LaunchKeeper.RemoveApprovalPolicy(ctx, item.LaunchID)
+			_, found := tk.LaunchKeeper.GetApprovalPolicy(ctx, item.LaunchID)
+			require.False(t, found)
+		}
+	})
+}
+
+func TestApprovalPolicyGetAll(t *testing.T) {
+	ctx, tk, _ := testkeeper.NewTestSetup(t)
+	items := createNApprovalPolicy(tk.LaunchKeeper, ctx, 10)
+
+	t.Run("should get all approval policies", func(t *testing.T) {
+		require.ElementsMatch(t, items, tk.LaunchKeeper.GetAllApprovalPolicy(ctx))
+	})
+}
+
+func TestKeeper_IsRequestAutoApproved(t *testing.T) {
+	var (
+		ctx, tk, _      = testkeeper.NewTestSetup(t)
+		coordAddr       = sample.Address(r)
+		operatorAddr    = sample.Address(r)
+		allowedAddr     = sample.Address(r)
+		launchID        = uint64(1)
+		noPolicyID      = uint64(2)
+		smallAccount    = types.NewGenesisAccount(launchID, sample.Address(r), tc.Coins(t, "10foo"))
+		largeAccount    = types.NewGenesisAccount(launchID, sample.Address(r), tc.Coins(t, "1000foo"))
+		noPolicyAccount = types.NewGenesisAccount(noPolicyID, sample.Address(r), tc.Coins(t, "10foo"))
+	)
+	coord := profiletypes.Coordinator{
+		Address: coordAddr,
+		Active:  true,
+		Operators: []profiletypes.CoordinatorOperator{
+			{
+				Address:     operatorAddr,
+				Permissions: []profiletypes.CoordinatorPermission{profiletypes.CoordinatorPermission_SETTLE_REQUESTS},
+			},
+		},
+	}
+	tk.LaunchKeeper.SetApprovalPolicy(ctx, types.NewApprovalPolicy(
+		launchID,
+		tc.Coins(t, "100foo"),
+		nil,
+		[]string{allowedAddr},
+	))
+
+	for _, tt := range []struct {
+		name     string
+		launchID uint64
+		creator  string
+		content  types.RequestContent
+		want     bool
+	}{
+		{
+			name:     "should approve a request from the coordinator",
+			launchID: noPolicyID,
+			creator:  coordAddr,
+			content:  noPolicyAccount,
+			want:     true,
+		},
+		{
+			name:     "should approve a request from an operator allowed to settle requests",
+			launchID: noPolicyID,
+			creator:  operatorAddr,
+			content:  noPolicyAccount,
+			want:     true,
+		},
+		{
+			name:     "should not approve a request for a chain without approval policy",
+			launchID: noPolicyID,
+			creator:  sample.Address(r),
+			content:  noPolicyAccount,
+			want:     false,
+		},
+		{
+			name:     "should approve a request allowed by the approval policy",
+			launchID: launchID,
+			creator:  sample.Address(r),
+			content:  smallAccount,
+			want:     true,
+		},
+		{
+			name:     "should approve a request from an allowlisted creator",
+			launchID: launchID,
+			creator:  allowedAddr,
+			content:  largeAccount,
+			want:     true,
+		},
+		{
+			name:     "should not approve a request not allowed by the approval policy",
+			launchID: launchID,
+			creator:  sample.Address(r),
+			content:  largeAccount,
+			want:     false,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tk.LaunchKeeper.IsRequestAutoApproved(ctx, tt.launchID, coord, tt.creator, tt.content)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
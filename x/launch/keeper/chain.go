@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	sdkerrors "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -75,6 +76,11 @@ func (k Keeper) CreateNewChain(
 	// Append the chain to the store
 	launchID := k.AppendChain(ctx, chain)
 
+	// mainnets are tracked in the coordinator stats when the campaign mainnet is initialized
+	if !isMainnet {
+		k.profileKeeper.IncrementChainsCreated(ctx, coordinatorID)
+	}
+
 	// Register the chain to the campaign
 	if hasCampaign {
 		if err := k.campaignKeeper.AddChainToCampaign(ctx, campaignID, launchID); err != nil {
@@ -153,6 +159,34 @@ func (k Keeper) EnableMonitoringConnection(ctx sdk.Context, launchID uint64) err
 	return nil
 }
 
+// ArchiveChain sets a chain as archived and rejects its pending requests
+// An archived chain can no longer be launched
+func (k Keeper) ArchiveChain(ctx sdk.Context, launchID uint64) error {
+	chain, found := k.GetChain(ctx, launchID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrChainNotFound, "%d", launchID)
+	}
+
+	if chain.LaunchTriggered {
+		return sdkerrors.Wrapf(types.ErrTriggeredLaunch, "%d", launchID)
+	}
+
+	if chain.Archived {
+		return sdkerrors.Wrapf(types.ErrChainArchived, "%d", launchID)
+	}
+
+	chain.Archived = true
+	k.SetChain(ctx, chain)
+
+	if err := k.RejectPendingRequests(ctx, launchID); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventChainArchived{
+		LaunchID: launchID,
+	})
+}
+
 // GetChain returns a chain from its index
 func (k Keeper) GetChain(ctx sdk.Context, launchID uint64) (val types.Chain, found bool) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ChainKeyPrefix))
@@ -180,6 +180,8 @@ func TestKeeper_CreateNewChain(t *testing.T) {
 		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
+			statsBefore, _ := tk.ProfileKeeper.GetCoordinatorStats(sdkCtx, tc.coordinatorID)
+
 			id, err := tk.LaunchKeeper.CreateNewChain(
 				sdkCtx,
 				tc.coordinatorID,
@@ -229,6 +231,14 @@ func TestKeeper_CreateNewChain(t *testing.T) {
 				require.True(t, found)
 				require.Contains(t, campaignChains.Chains, id)
 			}
+
+			// Check the created chain is recorded in the coordinator stats, mainnets are excluded
+			stats, _ := tk.ProfileKeeper.GetCoordinatorStats(sdkCtx, tc.coordinatorID)
+			if tc.isMainnet {
+				require.EqualValues(t, statsBefore.ChainsCreated, stats.ChainsCreated)
+			} else {
+				require.EqualValues(t, statsBefore.ChainsCreated+1, stats.ChainsCreated)
+			}
 		})
 	}
 }
@@ -293,6 +303,48 @@ func TestEnableMonitoringConnection(t *testing.T) {
 	})
 }
 
+func TestArchiveChain(t *testing.T) {
+	ctx, tk, _ := testkeeper.NewTestSetup(t)
+
+	t.Run("should archive a chain and reject its pending requests", func(t *testing.T) {
+		chain := sample.Chain(r, 0, 0)
+		launchID := tk.LaunchKeeper.AppendChain(ctx, chain)
+		request := sample.Request(r, launchID, sample.Address(r))
+		requestID := tk.LaunchKeeper.AppendRequest(ctx, request)
+
+		err := tk.LaunchKeeper.ArchiveChain(ctx, launchID)
+		require.NoError(t, err)
+		rst, found := tk.LaunchKeeper.GetChain(ctx, launchID)
+		require.True(t, found)
+		require.True(t, rst.Archived)
+
+		request, found = tk.LaunchKeeper.GetRequest(ctx, launchID, requestID)
+		require.True(t, found)
+		require.Equal(t, types.Request_REJECTED, request.Status)
+	})
+
+	t.Run("should prevent archiving a non existing chain", func(t *testing.T) {
+		err := tk.LaunchKeeper.ArchiveChain(ctx, 1000)
+		require.ErrorIs(t, err, types.ErrChainNotFound)
+	})
+
+	t.Run("should prevent archiving a chain with launch triggered", func(t *testing.T) {
+		chain := sample.Chain(r, 0, 0)
+		chain.LaunchTriggered = true
+		launchID := tk.LaunchKeeper.AppendChain(ctx, chain)
+		err := tk.LaunchKeeper.ArchiveChain(ctx, launchID)
+		require.ErrorIs(t, err, types.ErrTriggeredLaunch)
+	})
+
+	t.Run("should prevent archiving a chain already archived", func(t *testing.T) {
+		launchID := tk.LaunchKeeper.AppendChain(ctx, sample.Chain(r, 0, 0))
+		err := tk.LaunchKeeper.ArchiveChain(ctx, launchID)
+		require.NoError(t, err)
+		err = tk.LaunchKeeper.ArchiveChain(ctx, launchID)
+		require.ErrorIs(t, err, types.ErrChainArchived)
+	})
+}
+
 func TestGetAllChain(t *testing.T) {
 	ctx, tk, _ := testkeeper.NewTestSetup(t)
 	items := createNChain(tk.LaunchKeeper, ctx, 10)
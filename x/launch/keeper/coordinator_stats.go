@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+)
+
+// CountCoordinatorChains returns the number of chains created and launched by each coordinator
+// from the chains in the store. The number of launches reverted can't be derived from the store
+// and is not counted
+func (k Keeper) CountCoordinatorChains(ctx sdk.Context) []profiletypes.CoordinatorStats {
+	var stats []profiletypes.CoordinatorStats
+	indexes := make(map[uint64]int)
+
+	for _, chain := range k.GetAllChain(ctx) {
+		i, ok := indexes[chain.CoordinatorID]
+		if !ok {
+			i = len(stats)
+			indexes[chain.CoordinatorID] = i
+			stats = append(stats, profiletypes.NewCoordinatorStats(chain.CoordinatorID))
+		}
+
+		// mainnets are counted by the campaign module
+		if !chain.IsMainnet {
+			stats[i].ChainsCreated++
+		}
+
+		// the revision height is kept when the launch is reverted
+		if chain.LaunchTriggered || chain.ConsumerRevisionHeight != 0 {
+			stats[i].ChainsLaunched++
+		}
+	}
+
+	return stats
+}
@@ -0,0 +1,51 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/sample"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+)
+
+func TestKeeper_CountCoordinatorChains(t *testing.T) {
+	ctx, tk, _ := testkeeper.NewTestSetup(t)
+
+	t.Run("should count the chains created and launched by the coordinators", func(t *testing.T) {
+		chains := []struct {
+			coordinatorID uint64
+			launched      bool
+			reverted      bool
+			mainnet       bool
+		}{
+			{coordinatorID: 0},
+			{coordinatorID: 0, launched: true},
+			{coordinatorID: 0, reverted: true},
+			{coordinatorID: 0, mainnet: true, launched: true},
+			{coordinatorID: 1},
+		}
+		for i, c := range chains {
+			chain := sample.Chain(r, uint64(i), c.coordinatorID)
+			chain.IsMainnet = c.mainnet
+			if c.launched || c.reverted {
+				chain.ConsumerRevisionHeight = 100
+			}
+			chain.LaunchTriggered = c.launched
+			tk.LaunchKeeper.SetChain(ctx, chain)
+		}
+
+		require.Equal(t, []profiletypes.CoordinatorStats{
+			{
+				CoordinatorID:  0,
+				ChainsCreated:  3,
+				ChainsLaunched: 3,
+			},
+			{
+				CoordinatorID: 1,
+				ChainsCreated: 1,
+			},
+		}, tk.LaunchKeeper.CountCoordinatorChains(ctx))
+	})
+}
@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+func (k Keeper) ApprovalPolicy(
+	c context.Context,
+	req *types.QueryGetApprovalPolicyRequest,
+) (*types.QueryGetApprovalPolicyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetApprovalPolicy(ctx, req.LaunchID)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetApprovalPolicyResponse{ApprovalPolicy: val}, nil
+}
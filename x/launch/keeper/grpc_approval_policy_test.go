@@ -0,0 +1,55 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+func TestApprovalPolicyQuerySingle(t *testing.T) {
+	ctx, tk, _ := testkeeper.NewTestSetup(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNApprovalPolicy(tk.LaunchKeeper, ctx, 2)
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryGetApprovalPolicyRequest
+		response *types.QueryGetApprovalPolicyResponse
+		err      error
+	}{
+		{
+			desc:     "should allow querying first approval policy",
+			request:  &types.QueryGetApprovalPolicyRequest{LaunchID: msgs[0].LaunchID},
+			response: &types.QueryGetApprovalPolicyResponse{ApprovalPolicy: msgs[0]},
+		},
+		{
+			desc:     "should allow querying second approval policy",
+			request:  &types.QueryGetApprovalPolicyRequest{LaunchID: msgs[1].LaunchID},
+			response: &types.QueryGetApprovalPolicyResponse{ApprovalPolicy: msgs[1]},
+		},
+		{
+			desc:    "should prevent querying non existing approval policy",
+			request: &types.QueryGetApprovalPolicyRequest{LaunchID: uint64(1000)},
+			err:     status.Error(codes.NotFound, "not found"),
+		},
+		{
+			desc: "should prevent querying an approval policy with invalid request",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := tk.LaunchKeeper.ApprovalPolicy(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.response, response)
+			}
+		})
+	}
+}
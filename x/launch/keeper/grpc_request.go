@@ -16,6 +16,9 @@ func (k Keeper) RequestAll(c context.Context, req *types.QueryAllRequestRequest)
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
+	if err := validateContentTypeFilter(req.ContentType); err != nil {
+		return nil, err
+	}
 
 	var requests []types.Request
 	ctx := sdk.UnwrapSDKContext(c)
@@ -24,14 +27,19 @@ func (k Keeper) RequestAll(c context.Context, req *types.QueryAllRequestRequest)
 	keyPrefix := append(types.KeyPrefix(types.RequestKeyPrefix), types.RequestPoolKey(req.LaunchID)...)
 	requestStore := prefix.NewStore(store, keyPrefix)
 
-	pageRes, err := query.Paginate(requestStore, req.Pagination, func(key []byte, value []byte) error {
+	pageRes, err := query.FilteredPaginate(requestStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
 		var request types.Request
 		if err := k.cdc.Unmarshal(value, &request); err != nil {
-			return err
+			return false, err
 		}
 
-		requests = append(requests, request)
-		return nil
+		if !matchContentType(request, req.ContentType) {
+			return false, nil
+		}
+		if accumulate {
+			requests = append(requests, request)
+		}
+		return true, nil
 	})
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -40,6 +48,58 @@ func (k Keeper) RequestAll(c context.Context, req *types.QueryAllRequestRequest)
 	return &types.QueryAllRequestResponse{Request: requests, Pagination: pageRes}, nil
 }
 
+func (k Keeper) RequestAllByStatus(
+	c context.Context,
+	req *types.QueryAllRequestByStatusRequest,
+) (*types.QueryAllRequestByStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := validateContentTypeFilter(req.ContentType); err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	requests, pageRes, err := k.paginateIndexedRequests(
+		ctx,
+		types.RequestByStatusKeyPrefix,
+		types.RequestByStatusPoolKey(req.LaunchID, req.Status),
+		req.ContentType,
+		req.Pagination,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAllRequestByStatusResponse{Request: requests, Pagination: pageRes}, nil
+}
+
+func (k Keeper) RequestAllByCreator(
+	c context.Context,
+	req *types.QueryAllRequestByCreatorRequest,
+) (*types.QueryAllRequestByCreatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := validateContentTypeFilter(req.ContentType); err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	requests, pageRes, err := k.paginateIndexedRequests(
+		ctx,
+		types.RequestByCreatorKeyPrefix,
+		types.RequestByCreatorPoolKey(req.Creator),
+		req.ContentType,
+		req.Pagination,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAllRequestByCreatorResponse{Request: requests, Pagination: pageRes}, nil
+}
+
 func (k Keeper) Request(c context.Context, req *types.QueryGetRequestRequest) (*types.QueryGetRequestResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
@@ -57,3 +117,52 @@ func (k Keeper) Request(c context.Context, req *types.QueryGetRequestRequest) (*
 
 	return &types.QueryGetRequestResponse{Request: val}, nil
 }
+
+// paginateIndexedRequests paginates the requests referenced by the entries of an index with the provided prefix
+func (k Keeper) paginateIndexedRequests(
+	ctx sdk.Context,
+	indexPrefix string,
+	keyPrefix []byte,
+	contentType string,
+	pagination *query.PageRequest,
+) ([]types.Request, *query.PageResponse, error) {
+	var requests []types.Request
+
+	store := ctx.KVStore(k.storeKey)
+	indexStore := prefix.NewStore(store, append(types.KeyPrefix(indexPrefix), keyPrefix...))
+	requestStore := prefix.NewStore(store, types.KeyPrefix(types.RequestKeyPrefix))
+
+	pageRes, err := query.FilteredPaginate(indexStore, pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var request types.Request
+		if err := k.cdc.Unmarshal(requestStore.Get(value), &request); err != nil {
+			return false, err
+		}
+
+		if !matchContentType(request, contentType) {
+			return false, nil
+		}
+		if accumulate {
+			requests = append(requests, request)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return requests, pageRes, nil
+}
+
+// validateContentTypeFilter checks the request content type used to filter the requests if set
+func validateContentTypeFilter(contentType string) error {
+	if contentType != "" && !types.IsValidRequestContentType(contentType) {
+		return status.Errorf(codes.InvalidArgument, "invalid content type %s, must be one of %v",
+			contentType, types.RequestContentTypes)
+	}
+	return nil
+}
+
+// matchContentType checks if the request content is of the provided type, all requests match an empty type
+func matchContentType(request types.Request, contentType string) bool {
+	return contentType == "" || request.Content.Type() == contentType
+}
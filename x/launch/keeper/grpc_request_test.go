@@ -10,6 +10,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/sample"
 	"github.com/tendermint/spn/x/launch/types"
 )
 
@@ -110,3 +111,129 @@ func TestRequestQueryPaginated(t *testing.T) {
 		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
 	})
 }
+
+func TestRequestQueryByStatus(t *testing.T) {
+	ctx, tk, _ := testkeeper.NewTestSetup(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	launchID := uint64(10)
+
+	var pending, pendingValidators, approved []types.Request
+	for i := 0; i < 6; i++ {
+		request := sample.Request(r, launchID, sample.Address(r))
+		if i%3 == 0 {
+			request.Content = sample.GenesisValidatorContent(r, launchID, sample.Address(r))
+		}
+		if i%2 == 0 {
+			request.Status = types.Request_APPROVED
+		}
+		request.RequestID = tk.LaunchKeeper.AppendRequest(ctx, request)
+
+		switch {
+		case request.Status == types.Request_APPROVED:
+			approved = append(approved, request)
+		case request.Content.Type() == types.RequestContentTypeGenesisValidator:
+			pending = append(pending, request)
+			pendingValidators = append(pendingValidators, request)
+		default:
+			pending = append(pending, request)
+		}
+	}
+	// request of another chain
+	tk.LaunchKeeper.AppendRequest(ctx, sample.Request(r, launchID+1, sample.Address(r)))
+
+	request := func(status types.Request_Status, contentType string, next []byte, limit uint64) *types.QueryAllRequestByStatusRequest {
+		return &types.QueryAllRequestByStatusRequest{
+			LaunchID:    launchID,
+			Status:      status,
+			ContentType: contentType,
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Limit:      limit,
+				CountTotal: true,
+			},
+		}
+	}
+	t.Run("should allow querying requests by status", func(t *testing.T) {
+		resp, err := tk.LaunchKeeper.RequestAllByStatus(wctx, request(types.Request_PENDING, "", nil, 0))
+		require.NoError(t, err)
+		require.Equal(t, len(pending), int(resp.Pagination.Total))
+		require.ElementsMatch(t, pending, resp.Request)
+
+		resp, err = tk.LaunchKeeper.RequestAllByStatus(wctx, request(types.Request_APPROVED, "", nil, 0))
+		require.NoError(t, err)
+		require.ElementsMatch(t, approved, resp.Request)
+	})
+	t.Run("should allow querying requests by status by key", func(t *testing.T) {
+		var (
+			next []byte
+			all  []types.Request
+		)
+		for {
+			resp, err := tk.LaunchKeeper.RequestAllByStatus(wctx, request(types.Request_PENDING, "", next, 1))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.Request), 1)
+			all = append(all, resp.Request...)
+			next = resp.Pagination.NextKey
+			if next == nil {
+				break
+			}
+		}
+		require.ElementsMatch(t, pending, all)
+	})
+	t.Run("should allow querying requests by status and content type", func(t *testing.T) {
+		resp, err := tk.LaunchKeeper.RequestAllByStatus(
+			wctx,
+			request(types.Request_PENDING, types.RequestContentTypeGenesisValidator, nil, 0),
+		)
+		require.NoError(t, err)
+		require.ElementsMatch(t, pendingValidators, resp.Request)
+	})
+	t.Run("should prevent querying requests with invalid content type", func(t *testing.T) {
+		_, err := tk.LaunchKeeper.RequestAllByStatus(wctx, request(types.Request_PENDING, "invalid", nil, 0))
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("should prevent querying requests with invalid query request", func(t *testing.T) {
+		_, err := tk.LaunchKeeper.RequestAllByStatus(wctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
+
+func TestRequestQueryByCreator(t *testing.T) {
+	ctx, tk, _ := testkeeper.NewTestSetup(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	creator := sample.Address(r)
+
+	var requests []types.Request
+	for launchID := uint64(0); launchID < 3; launchID++ {
+		request := sample.RequestWithContentAndCreator(r, launchID, sample.GenesisAccountContent(r, launchID, creator), creator)
+		request.RequestID = tk.LaunchKeeper.AppendRequest(ctx, request)
+		requests = append(requests, request)
+	}
+	validatorRequest := sample.RequestWithContentAndCreator(r, 1, sample.GenesisValidatorContent(r, 1, creator), creator)
+	validatorRequest.RequestID = tk.LaunchKeeper.AppendRequest(ctx, validatorRequest)
+	requests = append(requests, validatorRequest)
+	// request of another creator
+	tk.LaunchKeeper.AppendRequest(ctx, sample.Request(r, 0, sample.Address(r)))
+
+	t.Run("should allow querying requests by creator", func(t *testing.T) {
+		resp, err := tk.LaunchKeeper.RequestAllByCreator(wctx, &types.QueryAllRequestByCreatorRequest{
+			Creator:    creator,
+			Pagination: &query.PageRequest{CountTotal: true},
+		})
+		require.NoError(t, err)
+		require.Equal(t, len(requests), int(resp.Pagination.Total))
+		require.ElementsMatch(t, requests, resp.Request)
+	})
+	t.Run("should allow querying requests by creator and content type", func(t *testing.T) {
+		resp, err := tk.LaunchKeeper.RequestAllByCreator(wctx, &types.QueryAllRequestByCreatorRequest{
+			Creator:     creator,
+			ContentType: types.RequestContentTypeGenesisValidator,
+		})
+		require.NoError(t, err)
+		require.ElementsMatch(t, []types.Request{validatorRequest}, resp.Request)
+	})
+	t.Run("should prevent querying requests with invalid query request", func(t *testing.T) {
+		_, err := tk.LaunchKeeper.RequestAllByCreator(wctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
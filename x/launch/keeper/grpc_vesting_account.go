@@ -20,6 +20,16 @@ func (k Keeper) VestingAccountAll(c context.Context, req *types.QueryAllVestingA
 	var vestingAccounts []types.VestingAccount
 	ctx := sdk.UnwrapSDKContext(c)
 
+	// if the chain is a mainnet, the vesting accounts are computed from the campaign
+	chain, found := k.GetChain(ctx, req.LaunchID)
+	if found && chain.IsMainnet {
+		mainnetVestingAccounts, err := k.GetMainnetVestingAccounts(ctx, chain)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		return &types.QueryAllVestingAccountResponse{VestingAccount: mainnetVestingAccounts}, nil
+	}
+
 	store := ctx.KVStore(k.storeKey)
 	vestingAccountStore := prefix.NewStore(store, types.VestingAccountAllKey(req.LaunchID))
 
@@ -45,6 +55,19 @@ func (k Keeper) VestingAccount(c context.Context, req *types.QueryGetVestingAcco
 	}
 	ctx := sdk.UnwrapSDKContext(c)
 
+	// if the chain is a mainnet, the vesting account is computed from the campaign
+	chain, found := k.GetChain(ctx, req.LaunchID)
+	if found && chain.IsMainnet {
+		val, found, err := k.GetMainnetVestingAccount(ctx, chain, req.Address)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if !found {
+			return nil, status.Error(codes.NotFound, "not found")
+		}
+		return &types.QueryGetVestingAccountResponse{VestingAccount: val}, nil
+	}
+
 	val, found := k.GetVestingAccount(
 		ctx,
 		req.LaunchID,
@@ -10,8 +10,10 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	tc "github.com/tendermint/spn/testutil/constructor"
 	testkeeper "github.com/tendermint/spn/testutil/keeper"
 	"github.com/tendermint/spn/testutil/sample"
+	campaigntypes "github.com/tendermint/spn/x/campaign/types"
 	"github.com/tendermint/spn/x/launch/keeper"
 	"github.com/tendermint/spn/x/launch/types"
 )
@@ -126,3 +128,79 @@ func TestVestingAccountQueryPaginated(t *testing.T) {
 		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
 	})
 }
+
+func TestVestingAccountMainnet(t *testing.T) {
+	var (
+		ctx, tk, _ = testkeeper.NewTestSetup(t)
+		wctx       = sdk.WrapSDKContext(ctx)
+
+		campaignID  = uint64(5)
+		campaign    = sample.Campaign(r, campaignID)
+		launchID    = uint64(10)
+		chain       = sample.Chain(r, launchID, sample.Uint64(r))
+		totalSupply = tc.Coins(t, "1000foo")
+		totalShares = uint64(100)
+		addr1       = sample.Address(r)
+		addr2       = sample.Address(r)
+	)
+
+	// create campaign and mainnet vesting accounts and mainnet chain
+	campaign.TotalSupply = totalSupply
+	tk.CampaignKeeper.SetCampaign(ctx, campaign)
+	tk.CampaignKeeper.SetTotalShares(ctx, totalShares)
+	tk.CampaignKeeper.SetMainnetVestingAccount(ctx, campaigntypes.MainnetVestingAccount{
+		CampaignID:     campaignID,
+		Address:        addr1,
+		VestingOptions: *campaigntypes.NewShareDelayedVesting(tc.Shares(t, "60foo"), tc.Shares(t, "30foo"), 1000),
+	})
+	tk.CampaignKeeper.SetMainnetVestingAccount(ctx, campaigntypes.MainnetVestingAccount{
+		CampaignID: campaignID,
+		Address:    addr2,
+		VestingOptions: *campaigntypes.NewSharePeriodicVesting(tc.Shares(t, "40foo"), 1000, []campaigntypes.ShareVestingPeriod{
+			{Length: 100, Shares: tc.Shares(t, "20foo")},
+			{Length: 100, Shares: tc.Shares(t, "20foo")},
+		}),
+	})
+	chain.IsMainnet = true
+	chain.CampaignID = campaignID
+	tk.LaunchKeeper.SetChain(ctx, chain)
+
+	vestingAccount1 := types.VestingAccount{
+		LaunchID:       launchID,
+		Address:        addr1,
+		VestingOptions: *types.NewDelayedVesting(tc.Coins(t, "600foo"), tc.Coins(t, "300foo"), 1000),
+	}
+	vestingAccount2 := types.VestingAccount{
+		LaunchID: launchID,
+		Address:  addr2,
+		VestingOptions: *types.NewPeriodicVesting(tc.Coins(t, "400foo"), 1000, []types.VestingPeriod{
+			{Length: 100, Amount: tc.Coins(t, "200foo")},
+			{Length: 100, Amount: tc.Coins(t, "200foo")},
+		}),
+	}
+
+	t.Run("should allow querying a single vesting account for a mainnet", func(t *testing.T) {
+		res, err := tk.LaunchKeeper.VestingAccount(wctx, &types.QueryGetVestingAccountRequest{
+			LaunchID: launchID,
+			Address:  addr1,
+		})
+		require.NoError(t, err)
+		require.EqualValues(t, vestingAccount1, res.VestingAccount)
+	})
+	t.Run("should prevent querying a non existing vesting account for a mainnet", func(t *testing.T) {
+		_, err := tk.LaunchKeeper.VestingAccount(wctx, &types.QueryGetVestingAccountRequest{
+			LaunchID: launchID,
+			Address:  sample.Address(r),
+		})
+		require.ErrorIs(t, err, status.Error(codes.NotFound, "not found"))
+	})
+	t.Run("should allow querying all vesting accounts for a mainnet", func(t *testing.T) {
+		res, err := tk.LaunchKeeper.VestingAccountAll(wctx, &types.QueryAllVestingAccountRequest{
+			LaunchID: launchID,
+		})
+		require.NoError(t, err)
+		require.Len(t, res.VestingAccount, 2)
+		require.Contains(t, res.VestingAccount, vestingAccount1)
+		require.Contains(t, res.VestingAccount, vestingAccount2)
+	})
+}
@@ -101,6 +101,8 @@ func UnknownRequestTypeInvariant(k Keeper) sdk.Invariant {
 			case *types.RequestContent_GenesisAccount,
 				*types.RequestContent_VestingAccount,
 				*types.RequestContent_AccountRemoval,
+				*types.RequestContent_GenesisAccountUpdate,
+				*types.RequestContent_GenesisValidatorUpdate,
 				*types.RequestContent_GenesisValidator,
 				*types.RequestContent_ValidatorRemoval:
 			default:
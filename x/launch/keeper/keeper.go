@@ -18,6 +18,7 @@ type (
 		storeKey          storetypes.StoreKey
 		memKey            storetypes.StoreKey
 		paramstore        paramtypes.Subspace
+		authority         string
 		distrKeeper       types.DistributionKeeper
 		profileKeeper     types.ProfileKeeper
 		campaignKeeper    types.CampaignKeeper
@@ -32,6 +33,7 @@ func NewKeeper(
 	ps paramtypes.Subspace,
 	distrKeeper types.DistributionKeeper,
 	profileKeeper types.ProfileKeeper,
+	authority string,
 ) *Keeper {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
@@ -43,6 +45,7 @@ func NewKeeper(
 		storeKey:      storeKey,
 		memKey:        memKey,
 		paramstore:    ps,
+		authority:     authority,
 		distrKeeper:   distrKeeper,
 		profileKeeper: profileKeeper,
 	}
@@ -52,6 +55,11 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
+// GetAuthority returns the address allowed to update the module parameters
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // SetCampaignKeeper sets the campaign keeper interface of the module
 func (k *Keeper) SetCampaignKeeper(campaignKeeper types.CampaignKeeper) {
 	if k.campaignKeeper != nil {
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	campaigntypes "github.com/tendermint/spn/x/campaign/types"
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+// GetMainnetVestingAccounts returns the vesting accounts of a mainnet
+// the vesting options are computed from the vesting shares of the mainnet vesting accounts of the campaign
+func (k Keeper) GetMainnetVestingAccounts(ctx sdk.Context, chain types.Chain) ([]types.VestingAccount, error) {
+	campaign, found := k.campaignKeeper.GetCampaign(ctx, chain.CampaignID)
+	if !found {
+		return nil, sdkerrors.Wrapf(campaigntypes.ErrCampaignNotFound, "%d", chain.CampaignID)
+	}
+	totalShareNumber := k.campaignKeeper.GetTotalShares(ctx)
+
+	var vestingAccounts []types.VestingAccount
+	for _, acc := range k.campaignKeeper.GetCampaignMainnetVestingAccounts(ctx, chain.CampaignID) {
+		vestingAccount, err := mainnetVestingAccount(chain.LaunchID, campaign, totalShareNumber, acc)
+		if err != nil {
+			return nil, err
+		}
+		vestingAccounts = append(vestingAccounts, vestingAccount)
+	}
+	return vestingAccounts, nil
+}
+
+// GetMainnetVestingAccount returns a vesting account of a mainnet
+// the vesting options are computed from the vesting shares of the mainnet vesting account of the campaign
+func (k Keeper) GetMainnetVestingAccount(
+	ctx sdk.Context,
+	chain types.Chain,
+	address string,
+) (types.VestingAccount, bool, error) {
+	campaign, found := k.campaignKeeper.GetCampaign(ctx, chain.CampaignID)
+	if !found {
+		return types.VestingAccount{}, false, sdkerrors.Wrapf(campaigntypes.ErrCampaignNotFound, "%d", chain.CampaignID)
+	}
+	acc, found := k.campaignKeeper.GetMainnetVestingAccount(ctx, chain.CampaignID, address)
+	if !found {
+		return types.VestingAccount{}, false, nil
+	}
+
+	vestingAccount, err := mainnetVestingAccount(chain.LaunchID, campaign, k.campaignKeeper.GetTotalShares(ctx), acc)
+	return vestingAccount, true, err
+}
+
+// mainnetVestingAccount converts the vesting shares of a mainnet vesting account into coins from the campaign total supply
+func mainnetVestingAccount(
+	launchID uint64,
+	campaign campaigntypes.Campaign,
+	totalShareNumber uint64,
+	acc campaigntypes.MainnetVestingAccount,
+) (types.VestingAccount, error) {
+	vestingOptions, err := types.NewVestingOptionsFromShares(acc.VestingOptions, campaign.TotalSupply, totalShareNumber)
+	if err != nil {
+		return types.VestingAccount{}, sdkerrors.Wrapf(
+			campaigntypes.ErrInvalidShares,
+			"mainnet vesting account %s: %s",
+			acc.Address,
+			err.Error(),
+		)
+	}
+	return types.VestingAccount{
+		LaunchID:       launchID,
+		Address:        acc.Address,
+		VestingOptions: vestingOptions,
+	}, nil
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+func (k msgServer) CancelRequest(
+	goCtx context.Context,
+	msg *types.MsgCancelRequest,
+) (*types.MsgCancelRequestResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	chain, found := k.GetChain(ctx, msg.LaunchID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrChainNotFound, "%d", msg.LaunchID)
+	}
+
+	if chain.LaunchTriggered {
+		return nil, sdkerrors.Wrapf(types.ErrTriggeredLaunch, "%d", msg.LaunchID)
+	}
+
+	request, found := k.GetRequest(ctx, msg.LaunchID, msg.RequestID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrRequestNotFound,
+			"request %d for chain %d not found",
+			msg.RequestID,
+			msg.LaunchID,
+		)
+	}
+
+	// only the creator of the request can withdraw it
+	if msg.Signer != request.Creator {
+		return nil, sdkerrors.Wrap(types.ErrNoAddressPermission, msg.Signer)
+	}
+
+	if request.Status != types.Request_PENDING {
+		return nil, sdkerrors.Wrapf(types.ErrRequestSettled,
+			"request %d is not pending",
+			msg.RequestID,
+		)
+	}
+
+	request.Status = types.Request_CANCELLED
+	k.SetRequest(ctx, request)
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventRequestCancelled{
+		LaunchID:  msg.LaunchID,
+		RequestID: msg.RequestID,
+	})
+
+	return &types.MsgCancelRequestResponse{}, err
+}
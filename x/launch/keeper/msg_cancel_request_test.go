@@ -0,0 +1,141 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+func TestMsgCancelRequest(t *testing.T) {
+	var (
+		coordinator    = sample.Coordinator(r, sample.Address(r))
+		invalidChain   = uint64(1000)
+		sdkCtx, tk, ts = testkeeper.NewTestSetup(t)
+		ctx            = sdk.WrapSDKContext(sdkCtx)
+	)
+	coordinator.CoordinatorID = tk.ProfileKeeper.AppendCoordinator(sdkCtx, coordinator)
+
+	chains := createNChainForCoordinator(tk.LaunchKeeper, sdkCtx, coordinator.CoordinatorID, 2)
+	chains[0].LaunchTriggered = true
+	tk.LaunchKeeper.SetChain(sdkCtx, chains[0])
+	launchID := chains[1].LaunchID
+
+	requestSamples := make([]RequestSample, 3)
+	for i := range requestSamples {
+		addr := sample.Address(r)
+		requestSamples[i] = RequestSample{
+			Content: sample.GenesisAccountContent(r, launchID, addr),
+			Creator: addr,
+			Status:  types.Request_PENDING,
+		}
+	}
+	requestSamples[2].Status = types.Request_REJECTED
+	requests := createRequestsFromSamples(tk.LaunchKeeper, sdkCtx, launchID, requestSamples)
+
+	tests := []struct {
+		name string
+		msg  types.MsgCancelRequest
+		err  error
+	}{
+		{
+			name: "should prevent cancelling request for non existing chain",
+			msg: types.MsgCancelRequest{
+				Signer:    requests[0].Creator,
+				LaunchID:  invalidChain,
+				RequestID: requests[0].RequestID,
+			},
+			err: types.ErrChainNotFound,
+		},
+		{
+			name: "should prevent cancelling request with launch triggered chain",
+			msg: types.MsgCancelRequest{
+				Signer:    requests[0].Creator,
+				LaunchID:  chains[0].LaunchID,
+				RequestID: requests[0].RequestID,
+			},
+			err: types.ErrTriggeredLaunch,
+		},
+		{
+			name: "should prevent cancelling a request that does not exist",
+			msg: types.MsgCancelRequest{
+				Signer:    requests[0].Creator,
+				LaunchID:  launchID,
+				RequestID: 99999999,
+			},
+			err: types.ErrRequestNotFound,
+		},
+		{
+			name: "should prevent cancelling request from the coordinator",
+			msg: types.MsgCancelRequest{
+				Signer:    coordinator.Address,
+				LaunchID:  launchID,
+				RequestID: requests[0].RequestID,
+			},
+			err: types.ErrNoAddressPermission,
+		},
+		{
+			name: "should prevent cancelling request from another account",
+			msg: types.MsgCancelRequest{
+				Signer:    requests[1].Creator,
+				LaunchID:  launchID,
+				RequestID: requests[0].RequestID,
+			},
+			err: types.ErrNoAddressPermission,
+		},
+		{
+			name: "should prevent cancelling request already settled",
+			msg: types.MsgCancelRequest{
+				Signer:    requests[2].Creator,
+				LaunchID:  launchID,
+				RequestID: requests[2].RequestID,
+			},
+			err: types.ErrRequestSettled,
+		},
+		{
+			name: "should allow cancelling request from the creator",
+			msg: types.MsgCancelRequest{
+				Signer:    requests[0].Creator,
+				LaunchID:  launchID,
+				RequestID: requests[0].RequestID,
+			},
+		},
+		{
+			name: "should prevent cancelling request already cancelled",
+			msg: types.MsgCancelRequest{
+				Signer:    requests[0].Creator,
+				LaunchID:  launchID,
+				RequestID: requests[0].RequestID,
+			},
+			err: types.ErrRequestSettled,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ts.LaunchSrv.CancelRequest(ctx, &tt.msg)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+
+			request, found := tk.LaunchKeeper.GetRequest(sdkCtx, tt.msg.LaunchID, tt.msg.RequestID)
+			require.True(t, found)
+			require.Equal(t, types.Request_CANCELLED, request.Status)
+		})
+	}
+
+	t.Run("should prevent settling a cancelled request", func(t *testing.T) {
+		_, err := ts.LaunchSrv.SettleRequest(ctx, &types.MsgSettleRequest{
+			Signer:    coordinator.Address,
+			LaunchID:  launchID,
+			RequestID: requests[0].RequestID,
+			Approve:   true,
+		})
+		require.ErrorIs(t, err, types.ErrRequestSettled)
+	})
+}
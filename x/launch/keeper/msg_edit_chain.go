@@ -2,7 +2,6 @@ package keeper
 
 import (
 	"context"
-	"fmt"
 
 	sdkerrors "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -22,17 +21,19 @@ func (k msgServer) EditChain(goCtx context.Context, msg *types.MsgEditChain) (*t
 		return nil, sdkerrors.Wrapf(types.ErrChainNotFound, "%d", msg.LaunchID)
 	}
 
-	// Get the coordinator ID associated to the sender address
-	coordID, err := k.profileKeeper.CoordinatorIDFromAddress(ctx, msg.Coordinator)
+	// Check the sender is allowed to act on behalf of the chain coordinator
+	err = k.profileKeeper.CheckCoordinatorPermission(
+		ctx,
+		msg.Coordinator,
+		chain.CoordinatorID,
+		profiletypes.CoordinatorPermission_EDIT_CHAIN,
+	)
 	if err != nil {
 		return nil, err
 	}
 
-	if chain.CoordinatorID != coordID {
-		return nil, sdkerrors.Wrap(profiletypes.ErrCoordInvalid, fmt.Sprintf(
-			"coordinator of the chain is %d",
-			chain.CoordinatorID,
-		))
+	if chain.Archived {
+		return nil, sdkerrors.Wrapf(types.ErrChainArchived, "%d", msg.LaunchID)
 	}
 
 	if len(msg.Metadata) > 0 {
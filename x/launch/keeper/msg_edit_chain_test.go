@@ -76,6 +76,13 @@ func TestMsgEditChain(t *testing.T) {
 	err = tk.CampaignKeeper.AddChainToCampaign(sdkCtx, campaignDuplicateChain, launchID2)
 	require.NoError(t, err)
 
+	// Create an archived chain
+	msgCreateChain = sample.MsgCreateChain(r, coordAddress, "", false, 0)
+	res, err = ts.LaunchSrv.CreateChain(ctx, &msgCreateChain)
+	require.NoError(t, err)
+	launchIDArchived := res.LaunchID
+	require.NoError(t, tk.LaunchKeeper.ArchiveChain(sdkCtx, launchIDArchived))
+
 	for _, tc := range []struct {
 		name string
 		msg  types.MsgEditChain
@@ -112,6 +119,17 @@ func TestMsgEditChain(t *testing.T) {
 			),
 			err: types.ErrChainNotFound,
 		},
+		{
+			name: "should prevent editing an archived chain",
+			msg: sample.MsgEditChain(r,
+				coordAddress,
+				launchIDArchived,
+				false,
+				0,
+				true,
+			),
+			err: types.ErrChainArchived,
+		},
 		{
 			name: "should prevent editing chain with non existent coordinator",
 			msg: sample.MsgEditChain(r,
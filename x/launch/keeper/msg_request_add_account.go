@@ -34,6 +34,10 @@ func (k msgServer) RequestAddAccount(
 		return nil, sdkerrors.Wrapf(types.ErrTriggeredLaunch, "%d", msg.LaunchID)
 	}
 
+	if chain.Archived {
+		return nil, sdkerrors.Wrapf(types.ErrChainArchived, "%d", msg.LaunchID)
+	}
+
 	coord, found := k.profileKeeper.GetCoordinator(ctx, chain.CoordinatorID)
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrChainInactive,
@@ -50,6 +54,7 @@ func (k msgServer) RequestAddAccount(
 		LaunchID:  msg.LaunchID,
 		Creator:   msg.Creator,
 		CreatedAt: ctx.BlockTime().Unix(),
+		ExpiresAt: k.RequestExpiresAt(ctx),
 		Content:   content,
 		Status:    types.Request_PENDING,
 	}
@@ -59,7 +64,7 @@ func (k msgServer) RequestAddAccount(
 		err       error
 	)
 	approved := false
-	if msg.Creator == coord.Address {
+	if k.IsRequestAutoApproved(ctx, msg.LaunchID, coord, msg.Creator, content) {
 		err := ApplyRequest(ctx, k.Keeper, chain, request, coord)
 		if err != nil {
 			return nil, err
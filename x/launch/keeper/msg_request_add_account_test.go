@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"testing"
 
+	tc "github.com/tendermint/spn/testutil/constructor"
 	testkeeper "github.com/tendermint/spn/testutil/keeper"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -22,6 +23,7 @@ func TestMsgRequestAddAccount(t *testing.T) {
 		addr2            = sample.Address(r)
 		addr3            = sample.Address(r)
 		addr4            = sample.Address(r)
+		allowedAddr      = sample.Address(r)
 		sdkCtx, tk, ts   = testkeeper.NewTestSetup(t)
 		ctx              = sdk.WrapSDKContext(sdkCtx)
 	)
@@ -30,7 +32,7 @@ func TestMsgRequestAddAccount(t *testing.T) {
 		Address: coordAddr,
 		Active:  true,
 	})
-	chains := createNChainForCoordinator(tk.LaunchKeeper, sdkCtx, coordID, 6)
+	chains := createNChainForCoordinator(tk.LaunchKeeper, sdkCtx, coordID, 7)
 	chains[0].LaunchTriggered = true
 	tk.LaunchKeeper.SetChain(sdkCtx, chains[0])
 	chains[1].CoordinatorID = 99999
@@ -38,6 +40,12 @@ func TestMsgRequestAddAccount(t *testing.T) {
 	chains[5].IsMainnet = true
 	chains[5].HasCampaign = true
 	tk.LaunchKeeper.SetChain(sdkCtx, chains[5])
+	tk.LaunchKeeper.SetApprovalPolicy(sdkCtx, types.NewApprovalPolicy(
+		chains[6].LaunchID,
+		tc.Coins(t, "1000foo"),
+		nil,
+		[]string{allowedAddr},
+	))
 
 	coordDisableID := tk.ProfileKeeper.AppendCoordinator(sdkCtx, profiletypes.Coordinator{
 		Address: coordDisableAddr,
@@ -108,6 +116,23 @@ func TestMsgRequestAddAccount(t *testing.T) {
 			msg:  sample.MsgRequestAddAccount(r, coordAddr, addr4, chains[4].LaunchID),
 			err:  types.ErrAccountAlreadyExist,
 		},
+		{
+			name:        "should allow approving an account with a balance allowed by the approval policy",
+			msg:         *types.NewMsgRequestAddAccount(sample.Address(r), chains[6].LaunchID, addr1, tc.Coins(t, "1000foo")),
+			wantApprove: true,
+			wantID:      1,
+		},
+		{
+			name:   "should allow requesting an account with a balance above the approval policy",
+			msg:    *types.NewMsgRequestAddAccount(sample.Address(r), chains[6].LaunchID, addr2, tc.Coins(t, "1001foo")),
+			wantID: 2,
+		},
+		{
+			name:        "should allow approving an account from a creator allowed by the approval policy",
+			msg:         *types.NewMsgRequestAddAccount(allowedAddr, chains[6].LaunchID, addr3, tc.Coins(t, "1001foo")),
+			wantApprove: true,
+			wantID:      3,
+		},
 		{
 			name: "should prevent requesting an account for a mainnet chain",
 			msg:  sample.MsgRequestAddAccount(r, coordAddr, sample.Address(r), chains[5].LaunchID),
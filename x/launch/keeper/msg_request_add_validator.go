@@ -25,6 +25,10 @@ func (k msgServer) RequestAddValidator(
 		return nil, sdkerrors.Wrapf(types.ErrTriggeredLaunch, "%d", msg.LaunchID)
 	}
 
+	if chain.Archived {
+		return nil, sdkerrors.Wrapf(types.ErrChainArchived, "%d", msg.LaunchID)
+	}
+
 	coord, found := k.profileKeeper.GetCoordinator(ctx, chain.CoordinatorID)
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrChainInactive,
@@ -48,6 +52,7 @@ func (k msgServer) RequestAddValidator(
 		LaunchID:  msg.LaunchID,
 		Creator:   msg.Creator,
 		CreatedAt: ctx.BlockTime().Unix(),
+		ExpiresAt: k.RequestExpiresAt(ctx),
 		Content:   content,
 		Status:    types.Request_PENDING,
 	}
@@ -57,7 +62,7 @@ func (k msgServer) RequestAddValidator(
 		err       error
 	)
 	approved := false
-	if msg.Creator == coord.Address {
+	if k.IsRequestAutoApproved(ctx, msg.LaunchID, coord, msg.Creator, content) {
 		err := ApplyRequest(ctx, k.Keeper, chain, request, coord)
 		if err != nil {
 			return nil, err
@@ -32,6 +32,10 @@ func (k msgServer) RequestRemoveAccount(
 	if chain.LaunchTriggered {
 		return nil, sdkerrors.Wrapf(types.ErrTriggeredLaunch, "%d", msg.LaunchID)
 	}
+
+	if chain.Archived {
+		return nil, sdkerrors.Wrapf(types.ErrChainArchived, "%d", msg.LaunchID)
+	}
 	coord, found := k.profileKeeper.GetCoordinator(ctx, chain.CoordinatorID)
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrChainInactive,
@@ -43,7 +47,7 @@ func (k msgServer) RequestRemoveAccount(
 			"the chain %d coordinator inactive", chain.LaunchID)
 	}
 
-	if msg.Creator != msg.Address && msg.Creator != coord.Address {
+	if msg.Creator != msg.Address && !coord.HasPermission(msg.Creator, profiletypes.CoordinatorPermission_SETTLE_REQUESTS) {
 		return nil, sdkerrors.Wrap(types.ErrNoAddressPermission, msg.Creator)
 	}
 
@@ -52,13 +56,14 @@ func (k msgServer) RequestRemoveAccount(
 		LaunchID:  msg.LaunchID,
 		Creator:   msg.Address,
 		CreatedAt: ctx.BlockTime().Unix(),
+		ExpiresAt: k.RequestExpiresAt(ctx),
 		Content:   content,
 		Status:    types.Request_PENDING,
 	}
 
 	var requestID uint64
 	approved := false
-	if msg.Creator == coord.Address {
+	if k.IsRequestAutoApproved(ctx, msg.LaunchID, coord, msg.Creator, content) {
 		err := ApplyRequest(ctx, k.Keeper, chain, request, coord)
 		if err != nil {
 			return nil, err
@@ -25,6 +25,10 @@ func (k msgServer) RequestRemoveValidator(
 		return nil, sdkerrors.Wrapf(types.ErrTriggeredLaunch, "%d", msg.LaunchID)
 	}
 
+	if chain.Archived {
+		return nil, sdkerrors.Wrapf(types.ErrChainArchived, "%d", msg.LaunchID)
+	}
+
 	coord, found := k.profileKeeper.GetCoordinator(ctx, chain.CoordinatorID)
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrChainInactive,
@@ -36,7 +40,7 @@ func (k msgServer) RequestRemoveValidator(
 			"the chain %d coordinator inactive", chain.LaunchID)
 	}
 
-	if msg.Creator != msg.ValidatorAddress && msg.Creator != coord.Address {
+	if msg.Creator != msg.ValidatorAddress && !coord.HasPermission(msg.Creator, profiletypes.CoordinatorPermission_SETTLE_REQUESTS) {
 		return nil, sdkerrors.Wrap(types.ErrNoAddressPermission, msg.Creator)
 	}
 
@@ -45,13 +49,14 @@ func (k msgServer) RequestRemoveValidator(
 		LaunchID:  msg.LaunchID,
 		Creator:   msg.ValidatorAddress,
 		CreatedAt: ctx.BlockTime().Unix(),
+		ExpiresAt: k.RequestExpiresAt(ctx),
 		Content:   content,
 		Status:    types.Request_PENDING,
 	}
 
 	var requestID uint64
 	approved := false
-	if msg.Creator == coord.Address {
+	if k.IsRequestAutoApproved(ctx, msg.LaunchID, coord, msg.Creator, content) {
 		err := ApplyRequest(ctx, k.Keeper, chain, request, coord)
 		if err != nil {
 			return nil, err
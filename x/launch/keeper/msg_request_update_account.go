@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"context"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tendermint/spn/x/launch/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+)
+
+func (k msgServer) RequestUpdateAccount(
+	goCtx context.Context,
+	msg *types.MsgRequestUpdateAccount,
+) (*types.MsgRequestUpdateAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	chain, found := k.GetChain(ctx, msg.LaunchID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrChainNotFound, "%d", msg.LaunchID)
+	}
+
+	if chain.IsMainnet {
+		return nil, sdkerrors.Wrapf(
+			types.ErrUpdateMainnetAccount,
+			"the chain %d is a mainnet",
+			msg.LaunchID,
+		)
+	}
+
+	if chain.LaunchTriggered {
+		return nil, sdkerrors.Wrapf(types.ErrTriggeredLaunch, "%d", msg.LaunchID)
+	}
+
+	if chain.Archived {
+		return nil, sdkerrors.Wrapf(types.ErrChainArchived, "%d", msg.LaunchID)
+	}
+
+	coord, found := k.profileKeeper.GetCoordinator(ctx, chain.CoordinatorID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrChainInactive,
+			"the chain %d coordinator not found", chain.LaunchID)
+	}
+
+	if !coord.Active {
+		return nil, sdkerrors.Wrapf(profiletypes.ErrCoordInactive,
+			"the chain %d coordinator is inactive", chain.LaunchID)
+	}
+
+	// only the account owner or the coordinator can update the account
+	if msg.Creator != msg.Address && !coord.HasPermission(msg.Creator, profiletypes.CoordinatorPermission_SETTLE_REQUESTS) {
+		return nil, sdkerrors.Wrap(types.ErrNoAddressPermission, msg.Creator)
+	}
+
+	content := types.NewGenesisAccountUpdate(msg.LaunchID, msg.Address, msg.Coins, msg.VestingOptions)
+	request := types.Request{
+		LaunchID:  msg.LaunchID,
+		Creator:   msg.Creator,
+		CreatedAt: ctx.BlockTime().Unix(),
+		ExpiresAt: k.RequestExpiresAt(ctx),
+		Content:   content,
+		Status:    types.Request_PENDING,
+	}
+
+	var requestID uint64
+	approved := false
+	if k.IsRequestAutoApproved(ctx, msg.LaunchID, coord, msg.Creator, content) {
+		err := ApplyRequest(ctx, k.Keeper, chain, request, coord)
+		if err != nil {
+			return nil, err
+		}
+		approved = true
+		request.Status = types.Request_APPROVED
+	}
+
+	requestID = k.AppendRequest(ctx, request)
+	err := ctx.EventManager().EmitTypedEvent(&types.EventRequestCreated{
+		Creator: msg.Creator,
+		Request: request,
+	})
+
+	return &types.MsgRequestUpdateAccountResponse{
+		RequestID:    requestID,
+		AutoApproved: approved,
+	}, err
+}
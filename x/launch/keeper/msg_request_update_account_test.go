@@ -0,0 +1,155 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/launch/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+)
+
+func TestMsgRequestUpdateAccount(t *testing.T) {
+	var (
+		invalidChain     = uint64(1000)
+		coordAddr        = sample.Address(r)
+		coordDisableAddr = sample.Address(r)
+		addr1            = sample.Address(r)
+		addr2            = sample.Address(r)
+		addr3            = sample.Address(r)
+		vestingOptions   = sample.VestingOptions(r)
+		sdkCtx, tk, ts   = testkeeper.NewTestSetup(t)
+		ctx              = sdk.WrapSDKContext(sdkCtx)
+	)
+
+	coordID := tk.ProfileKeeper.AppendCoordinator(sdkCtx, profiletypes.Coordinator{
+		Address: coordAddr,
+		Active:  true,
+	})
+	chains := createNChainForCoordinator(tk.LaunchKeeper, sdkCtx, coordID, 5)
+	chains[0].LaunchTriggered = true
+	tk.LaunchKeeper.SetChain(sdkCtx, chains[0])
+	chains[1].CoordinatorID = 99999
+	tk.LaunchKeeper.SetChain(sdkCtx, chains[1])
+	chains[4].IsMainnet = true
+	chains[4].HasCampaign = true
+	tk.LaunchKeeper.SetChain(sdkCtx, chains[4])
+
+	tk.LaunchKeeper.SetGenesisAccount(sdkCtx, sample.GenesisAccount(r, chains[2].LaunchID, addr1))
+	tk.LaunchKeeper.SetGenesisAccount(sdkCtx, sample.GenesisAccount(r, chains[3].LaunchID, addr1))
+	tk.LaunchKeeper.SetVestingAccount(sdkCtx, sample.VestingAccount(r, chains[3].LaunchID, addr2))
+
+	coordDisableID := tk.ProfileKeeper.AppendCoordinator(sdkCtx, profiletypes.Coordinator{
+		Address: coordDisableAddr,
+		Active:  false,
+	})
+	disableChain := createNChainForCoordinator(tk.LaunchKeeper, sdkCtx, coordDisableID, 1)
+
+	tests := []struct {
+		name        string
+		msg         types.MsgRequestUpdateAccount
+		wantID      uint64
+		wantApprove bool
+		err         error
+	}{
+		{
+			name: "should prevent requesting account update for a non existing chain",
+			msg:  *types.NewMsgRequestUpdateAccount(addr1, invalidChain, addr1, sample.Coins(r), nil),
+			err:  types.ErrChainNotFound,
+		},
+		{
+			name: "should prevent requesting account update for a launch triggered chain",
+			msg:  *types.NewMsgRequestUpdateAccount(addr1, chains[0].LaunchID, addr1, sample.Coins(r), nil),
+			err:  types.ErrTriggeredLaunch,
+		},
+		{
+			name: "should prevent requesting account update for a chain where coordinator not found",
+			msg:  *types.NewMsgRequestUpdateAccount(addr1, chains[1].LaunchID, addr1, sample.Coins(r), nil),
+			err:  types.ErrChainInactive,
+		},
+		{
+			name: "should prevent requesting account update without address permission",
+			msg:  *types.NewMsgRequestUpdateAccount(addr1, chains[2].LaunchID, addr3, sample.Coins(r), nil),
+			err:  types.ErrNoAddressPermission,
+		},
+		{
+			name: "should prevent requesting account update for a mainnet chain",
+			msg:  *types.NewMsgRequestUpdateAccount(coordAddr, chains[4].LaunchID, addr1, sample.Coins(r), nil),
+			err:  types.ErrUpdateMainnetAccount,
+		},
+		{
+			name: "should prevent requesting account update for a chain where the coordinator of the chain is disabled",
+			msg: *types.NewMsgRequestUpdateAccount(
+				addr1,
+				disableChain[0].LaunchID,
+				addr1,
+				sample.Coins(r),
+				nil,
+			),
+			err: profiletypes.ErrCoordInactive,
+		},
+		{
+			name: "should prevent requesting account update from coordinator if no account to update",
+			msg:  *types.NewMsgRequestUpdateAccount(coordAddr, chains[2].LaunchID, addr3, sample.Coins(r), nil),
+			err:  types.ErrAccountNotFound,
+		},
+		{
+			name:   "should allow requesting account update from the account",
+			msg:    *types.NewMsgRequestUpdateAccount(addr1, chains[2].LaunchID, addr1, sample.Coins(r), nil),
+			wantID: 1,
+		},
+		{
+			name:        "should allow requesting and approving an update to a vesting account from the coordinator",
+			msg:         *types.NewMsgRequestUpdateAccount(coordAddr, chains[3].LaunchID, addr1, nil, &vestingOptions),
+			wantApprove: true,
+			wantID:      1,
+		},
+		{
+			name:        "should allow requesting and approving an update to a genesis account from the coordinator",
+			msg:         *types.NewMsgRequestUpdateAccount(coordAddr, chains[3].LaunchID, addr2, sample.Coins(r), nil),
+			wantApprove: true,
+			wantID:      2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ts.LaunchSrv.RequestUpdateAccount(ctx, &tt.msg)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantID, got.RequestID)
+			require.Equal(t, tt.wantApprove, got.AutoApproved)
+
+			request, found := tk.LaunchKeeper.GetRequest(sdkCtx, tt.msg.LaunchID, got.RequestID)
+			require.True(t, found, "request not found")
+			require.Equal(t, tt.wantID, request.RequestID)
+			content := request.Content.GetGenesisAccountUpdate()
+			require.NotNil(t, content)
+			require.Equal(t, tt.msg.Address, content.Address)
+
+			if !tt.wantApprove {
+				require.Equal(t, types.Request_PENDING, request.Status)
+				return
+			}
+			require.Equal(t, types.Request_APPROVED, request.Status)
+
+			// the chains of the test have no fixed account balance
+			ga, foundGenesis := tk.LaunchKeeper.GetGenesisAccount(sdkCtx, tt.msg.LaunchID, tt.msg.Address)
+			va, foundVesting := tk.LaunchKeeper.GetVestingAccount(sdkCtx, tt.msg.LaunchID, tt.msg.Address)
+			if tt.msg.VestingOptions == nil {
+				require.True(t, foundGenesis, "genesis account not found")
+				require.False(t, foundVesting, "vesting account not removed")
+				require.Equal(t, tt.msg.Coins, ga.Coins)
+			} else {
+				require.False(t, foundGenesis, "genesis account not removed")
+				require.True(t, foundVesting, "vesting account not found")
+				require.Equal(t, *tt.msg.VestingOptions, va.VestingOptions)
+			}
+		})
+	}
+}
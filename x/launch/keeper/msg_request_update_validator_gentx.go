@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"context"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tendermint/spn/x/launch/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+)
+
+func (k msgServer) RequestUpdateValidatorGentx(
+	goCtx context.Context,
+	msg *types.MsgRequestUpdateValidatorGentx,
+) (*types.MsgRequestUpdateValidatorGentxResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	chain, found := k.GetChain(ctx, msg.LaunchID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrChainNotFound, "%d", msg.LaunchID)
+	}
+
+	if chain.LaunchTriggered {
+		return nil, sdkerrors.Wrapf(types.ErrTriggeredLaunch, "%d", msg.LaunchID)
+	}
+
+	if chain.Archived {
+		return nil, sdkerrors.Wrapf(types.ErrChainArchived, "%d", msg.LaunchID)
+	}
+
+	coord, found := k.profileKeeper.GetCoordinator(ctx, chain.CoordinatorID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrChainInactive,
+			"the chain %d coordinator not found", chain.LaunchID)
+	}
+
+	if !coord.Active {
+		return nil, sdkerrors.Wrapf(profiletypes.ErrCoordInactive,
+			"the chain %d coordinator inactive", chain.LaunchID)
+	}
+
+	if msg.Creator != msg.ValAddress && !coord.HasPermission(msg.Creator, profiletypes.CoordinatorPermission_SETTLE_REQUESTS) {
+		return nil, sdkerrors.Wrap(types.ErrNoAddressPermission, msg.Creator)
+	}
+
+	content := types.NewGenesisValidatorGentxUpdate(
+		msg.LaunchID,
+		msg.ValAddress,
+		msg.GenTx,
+		msg.ConsPubKey,
+		msg.SelfDelegation,
+	)
+	request := types.Request{
+		LaunchID:  msg.LaunchID,
+		Creator:   msg.Creator,
+		CreatedAt: ctx.BlockTime().Unix(),
+		ExpiresAt: k.RequestExpiresAt(ctx),
+		Content:   content,
+		Status:    types.Request_PENDING,
+	}
+
+	approved := false
+	if k.IsRequestAutoApproved(ctx, msg.LaunchID, coord, msg.Creator, content) {
+		err := ApplyRequest(ctx, k.Keeper, chain, request, coord)
+		if err != nil {
+			return nil, err
+		}
+		approved = true
+		request.Status = types.Request_APPROVED
+	}
+
+	requestID := k.AppendRequest(ctx, request)
+	err := ctx.EventManager().EmitTypedEvent(&types.EventRequestCreated{
+		Creator: msg.Creator,
+		Request: request,
+	})
+
+	return &types.MsgRequestUpdateValidatorGentxResponse{
+		RequestID:    requestID,
+		AutoApproved: approved,
+	}, err
+}
@@ -0,0 +1,135 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/launch/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+)
+
+func TestMsgRequestUpdateValidatorGentx(t *testing.T) {
+	var (
+		invalidChain     = uint64(1000)
+		coordAddr        = sample.Address(r)
+		coordDisableAddr = sample.Address(r)
+		valAddr          = sample.Address(r)
+		sdkCtx, tk, ts   = testkeeper.NewTestSetup(t)
+		ctx              = sdk.WrapSDKContext(sdkCtx)
+	)
+
+	coordID := tk.ProfileKeeper.AppendCoordinator(sdkCtx, profiletypes.Coordinator{
+		Address: coordAddr,
+		Active:  true,
+	})
+	chains := createNChainForCoordinator(tk.LaunchKeeper, sdkCtx, coordID, 3)
+	chains[0].LaunchTriggered = true
+	tk.LaunchKeeper.SetChain(sdkCtx, chains[0])
+	chains[1].CoordinatorID = 99999
+	tk.LaunchKeeper.SetChain(sdkCtx, chains[1])
+	tk.LaunchKeeper.SetGenesisValidator(sdkCtx, sample.GenesisValidator(r, chains[2].LaunchID, valAddr))
+
+	coordDisableID := tk.ProfileKeeper.AppendCoordinator(sdkCtx, profiletypes.Coordinator{
+		Address: coordDisableAddr,
+		Active:  false,
+	})
+	disableChain := createNChainForCoordinator(tk.LaunchKeeper, sdkCtx, coordDisableID, 1)
+
+	newMsg := func(creator string, launchID uint64, valAddress string) types.MsgRequestUpdateValidatorGentx {
+		return *types.NewMsgRequestUpdateValidatorGentx(
+			creator,
+			launchID,
+			valAddress,
+			sample.Bytes(r, 300),
+			sample.Bytes(r, 30),
+			sample.Coin(r),
+		)
+	}
+
+	tests := []struct {
+		name        string
+		msg         types.MsgRequestUpdateValidatorGentx
+		wantID      uint64
+		wantApprove bool
+		err         error
+	}{
+		{
+			name: "should prevent requesting gentx update for a non existing chain",
+			msg:  newMsg(valAddr, invalidChain, valAddr),
+			err:  types.ErrChainNotFound,
+		},
+		{
+			name: "should prevent requesting gentx update for a launch triggered chain",
+			msg:  newMsg(valAddr, chains[0].LaunchID, valAddr),
+			err:  types.ErrTriggeredLaunch,
+		},
+		{
+			name: "should prevent requesting gentx update for a chain where coordinator not found",
+			msg:  newMsg(valAddr, chains[1].LaunchID, valAddr),
+			err:  types.ErrChainInactive,
+		},
+		{
+			name: "should prevent requesting gentx update for a chain where the coordinator of the chain is disabled",
+			msg:  newMsg(valAddr, disableChain[0].LaunchID, valAddr),
+			err:  profiletypes.ErrCoordInactive,
+		},
+		{
+			name: "should prevent requesting gentx update without address permission",
+			msg:  newMsg(sample.Address(r), chains[2].LaunchID, valAddr),
+			err:  types.ErrNoAddressPermission,
+		},
+		{
+			name: "should prevent requesting gentx update from coordinator for a non existing validator",
+			msg:  newMsg(coordAddr, chains[2].LaunchID, sample.Address(r)),
+			err:  types.ErrValidatorNotFound,
+		},
+		{
+			name:   "should allow requesting gentx update from the validator",
+			msg:    newMsg(valAddr, chains[2].LaunchID, valAddr),
+			wantID: 1,
+		},
+		{
+			name:        "should allow requesting and approving gentx update from the coordinator",
+			msg:         newMsg(coordAddr, chains[2].LaunchID, valAddr),
+			wantID:      2,
+			wantApprove: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			previous, _ := tk.LaunchKeeper.GetGenesisValidator(sdkCtx, tt.msg.LaunchID, tt.msg.ValAddress)
+
+			got, err := ts.LaunchSrv.RequestUpdateValidatorGentx(ctx, &tt.msg)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantID, got.RequestID)
+			require.Equal(t, tt.wantApprove, got.AutoApproved)
+
+			request, found := tk.LaunchKeeper.GetRequest(sdkCtx, tt.msg.LaunchID, got.RequestID)
+			require.True(t, found, "request not found")
+			content := request.Content.GetGenesisValidatorUpdate()
+			require.NotNil(t, content)
+			require.True(t, content.IsGentxUpdate())
+
+			validator, found := tk.LaunchKeeper.GetGenesisValidator(sdkCtx, tt.msg.LaunchID, tt.msg.ValAddress)
+			require.True(t, found)
+			if !tt.wantApprove {
+				require.Equal(t, types.Request_PENDING, request.Status)
+				require.Equal(t, previous, validator)
+				return
+			}
+			require.Equal(t, types.Request_APPROVED, request.Status)
+			require.Equal(t, tt.msg.GenTx, validator.GenTx)
+			require.Equal(t, tt.msg.ConsPubKey, validator.ConsPubKey)
+			require.Equal(t, tt.msg.SelfDelegation, validator.SelfDelegation)
+			require.Equal(t, previous.Peer, validator.Peer)
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"context"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tendermint/spn/x/launch/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+)
+
+func (k msgServer) RequestUpdateValidatorPeer(
+	goCtx context.Context,
+	msg *types.MsgRequestUpdateValidatorPeer,
+) (*types.MsgRequestUpdateValidatorPeerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	chain, found := k.GetChain(ctx, msg.LaunchID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrChainNotFound, "%d", msg.LaunchID)
+	}
+
+	if chain.LaunchTriggered {
+		return nil, sdkerrors.Wrapf(types.ErrTriggeredLaunch, "%d", msg.LaunchID)
+	}
+
+	if chain.Archived {
+		return nil, sdkerrors.Wrapf(types.ErrChainArchived, "%d", msg.LaunchID)
+	}
+
+	coord, found := k.profileKeeper.GetCoordinator(ctx, chain.CoordinatorID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrChainInactive,
+			"the chain %d coordinator not found", chain.LaunchID)
+	}
+
+	if !coord.Active {
+		return nil, sdkerrors.Wrapf(profiletypes.ErrCoordInactive,
+			"the chain %d coordinator inactive", chain.LaunchID)
+	}
+
+	if msg.Creator != msg.ValAddress && !coord.HasPermission(msg.Creator, profiletypes.CoordinatorPermission_SETTLE_REQUESTS) {
+		return nil, sdkerrors.Wrap(types.ErrNoAddressPermission, msg.Creator)
+	}
+
+	content := types.NewGenesisValidatorPeerUpdate(msg.LaunchID, msg.ValAddress, msg.Peer)
+	request := types.Request{
+		LaunchID:  msg.LaunchID,
+		Creator:   msg.Creator,
+		CreatedAt: ctx.BlockTime().Unix(),
+		ExpiresAt: k.RequestExpiresAt(ctx),
+		Content:   content,
+		Status:    types.Request_APPROVED,
+	}
+
+	// peer changes are approved without the coordinator since they don't change the genesis
+	if err := ApplyRequest(ctx, k.Keeper, chain, request, coord); err != nil {
+		return nil, err
+	}
+
+	requestID := k.AppendRequest(ctx, request)
+	err := ctx.EventManager().EmitTypedEvent(&types.EventRequestCreated{
+		Creator: msg.Creator,
+		Request: request,
+	})
+
+	return &types.MsgRequestUpdateValidatorPeerResponse{
+		RequestID:    requestID,
+		AutoApproved: true,
+	}, err
+}
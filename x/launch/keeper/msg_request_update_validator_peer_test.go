@@ -0,0 +1,152 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/launch/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+)
+
+func TestMsgRequestUpdateValidatorPeer(t *testing.T) {
+	var (
+		invalidChain     = uint64(1000)
+		coordAddr        = sample.Address(r)
+		coordDisableAddr = sample.Address(r)
+		valAddr          = sample.Address(r)
+		sdkCtx, tk, ts   = testkeeper.NewTestSetup(t)
+		ctx              = sdk.WrapSDKContext(sdkCtx)
+	)
+
+	coordID := tk.ProfileKeeper.AppendCoordinator(sdkCtx, profiletypes.Coordinator{
+		Address: coordAddr,
+		Active:  true,
+	})
+	chains := createNChainForCoordinator(tk.LaunchKeeper, sdkCtx, coordID, 3)
+	chains[0].LaunchTriggered = true
+	tk.LaunchKeeper.SetChain(sdkCtx, chains[0])
+	chains[1].CoordinatorID = 99999
+	tk.LaunchKeeper.SetChain(sdkCtx, chains[1])
+	tk.LaunchKeeper.SetGenesisValidator(sdkCtx, sample.GenesisValidator(r, chains[2].LaunchID, valAddr))
+
+	coordDisableID := tk.ProfileKeeper.AppendCoordinator(sdkCtx, profiletypes.Coordinator{
+		Address: coordDisableAddr,
+		Active:  false,
+	})
+	disableChain := createNChainForCoordinator(tk.LaunchKeeper, sdkCtx, coordDisableID, 1)
+
+	tests := []struct {
+		name   string
+		msg    types.MsgRequestUpdateValidatorPeer
+		wantID uint64
+		err    error
+	}{
+		{
+			name: "should prevent requesting peer update for a non existing chain",
+			msg:  *types.NewMsgRequestUpdateValidatorPeer(valAddr, invalidChain, valAddr, sample.GenesisValidatorPeer(r)),
+			err:  types.ErrChainNotFound,
+		},
+		{
+			name: "should prevent requesting peer update for a launch triggered chain",
+			msg: *types.NewMsgRequestUpdateValidatorPeer(
+				valAddr,
+				chains[0].LaunchID,
+				valAddr,
+				sample.GenesisValidatorPeer(r),
+			),
+			err: types.ErrTriggeredLaunch,
+		},
+		{
+			name: "should prevent requesting peer update for a chain where coordinator not found",
+			msg: *types.NewMsgRequestUpdateValidatorPeer(
+				valAddr,
+				chains[1].LaunchID,
+				valAddr,
+				sample.GenesisValidatorPeer(r),
+			),
+			err: types.ErrChainInactive,
+		},
+		{
+			name: "should prevent requesting peer update for a chain where the coordinator of the chain is disabled",
+			msg: *types.NewMsgRequestUpdateValidatorPeer(
+				valAddr,
+				disableChain[0].LaunchID,
+				valAddr,
+				sample.GenesisValidatorPeer(r),
+			),
+			err: profiletypes.ErrCoordInactive,
+		},
+		{
+			name: "should prevent requesting peer update without address permission",
+			msg: *types.NewMsgRequestUpdateValidatorPeer(
+				sample.Address(r),
+				chains[2].LaunchID,
+				valAddr,
+				sample.GenesisValidatorPeer(r),
+			),
+			err: types.ErrNoAddressPermission,
+		},
+		{
+			name: "should prevent requesting peer update for a non existing validator",
+			msg: *types.NewMsgRequestUpdateValidatorPeer(
+				coordAddr,
+				chains[2].LaunchID,
+				sample.Address(r),
+				sample.GenesisValidatorPeer(r),
+			),
+			err: types.ErrValidatorNotFound,
+		},
+		{
+			name: "should allow updating the peer from the validator",
+			msg: *types.NewMsgRequestUpdateValidatorPeer(
+				valAddr,
+				chains[2].LaunchID,
+				valAddr,
+				sample.GenesisValidatorPeer(r),
+			),
+			wantID: 1,
+		},
+		{
+			name: "should allow updating the peer from the coordinator",
+			msg: *types.NewMsgRequestUpdateValidatorPeer(
+				coordAddr,
+				chains[2].LaunchID,
+				valAddr,
+				sample.GenesisValidatorPeer(r),
+			),
+			wantID: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			previous, _ := tk.LaunchKeeper.GetGenesisValidator(sdkCtx, tt.msg.LaunchID, tt.msg.ValAddress)
+
+			got, err := ts.LaunchSrv.RequestUpdateValidatorPeer(ctx, &tt.msg)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantID, got.RequestID)
+			require.True(t, got.AutoApproved)
+
+			request, found := tk.LaunchKeeper.GetRequest(sdkCtx, tt.msg.LaunchID, got.RequestID)
+			require.True(t, found, "request not found")
+			require.Equal(t, types.Request_APPROVED, request.Status)
+			content := request.Content.GetGenesisValidatorUpdate()
+			require.NotNil(t, content)
+			require.True(t, content.IsPeerUpdate())
+
+			validator, found := tk.LaunchKeeper.GetGenesisValidator(sdkCtx, tt.msg.LaunchID, tt.msg.ValAddress)
+			require.True(t, found)
+			require.Equal(t, tt.msg.Peer, validator.Peer)
+			require.Equal(t, previous.GenTx, validator.GenTx)
+			require.Equal(t, previous.ConsPubKey, validator.ConsPubKey)
+			require.Equal(t, previous.SelfDelegation, validator.SelfDelegation)
+		})
+	}
+}
@@ -19,20 +19,17 @@ func (k msgServer) RevertLaunch(goCtx context.Context, msg *types.MsgRevertLaunc
 		return nil, sdkerrors.Wrapf(types.ErrChainNotFound, "%d", msg.LaunchID)
 	}
 
-	// Get the coordinator ID associated to the sender address
-	coordID, err := k.profileKeeper.CoordinatorIDFromAddress(ctx, msg.Coordinator)
+	// Check the sender is allowed to act on behalf of the chain coordinator
+	err := k.profileKeeper.CheckCoordinatorPermission(
+		ctx,
+		msg.Coordinator,
+		chain.CoordinatorID,
+		profiletypes.CoordinatorPermission_TRIGGER_LAUNCH,
+	)
 	if err != nil {
 		return nil, err
 	}
 
-	if chain.CoordinatorID != coordID {
-		return nil, sdkerrors.Wrapf(
-			profiletypes.ErrCoordInvalid,
-			"coordinator of the chain is %d",
-			chain.CoordinatorID,
-		)
-	}
-
 	if !chain.LaunchTriggered {
 		return nil, sdkerrors.Wrapf(types.ErrNotTriggeredLaunch, "%d", msg.LaunchID)
 	}
@@ -49,6 +46,7 @@ func (k msgServer) RevertLaunch(goCtx context.Context, msg *types.MsgRevertLaunc
 	chain.LaunchTriggered = false
 	chain.LaunchTime = time.Unix(0, 0).UTC()
 	k.SetChain(ctx, chain)
+	k.profileKeeper.IncrementLaunchesReverted(ctx, chain.CoordinatorID)
 
 	// clear associated client IDs from monitoring
 	k.monitoringcKeeper.ClearVerifiedClientIDs(ctx, msg.LaunchID)
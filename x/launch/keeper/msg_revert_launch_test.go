@@ -246,6 +246,8 @@ func TestMsgRevertLaunch(t *testing.T) {
 				})
 			}
 
+			statsBefore, _ := tk.ProfileKeeper.GetCoordinatorStats(sdkCtx, tt.inputState.chain.CoordinatorID)
+
 			// Send the message
 			_, err := ts.LaunchSrv.RevertLaunch(sdkCtx, &tt.msg)
 			if tt.err != nil {
@@ -259,6 +261,11 @@ func TestMsgRevertLaunch(t *testing.T) {
 			require.True(t, found)
 			require.False(t, chain.LaunchTriggered)
 
+			// Check the revert is recorded in the coordinator stats
+			stats, found := tk.ProfileKeeper.GetCoordinatorStats(sdkCtx, chain.CoordinatorID)
+			require.True(t, found)
+			require.EqualValues(t, statsBefore.LaunchesReverted+1, stats.LaunchesReverted)
+
 			// check that monitoringc client ids are removed
 			_, found = tk.MonitoringConsumerKeeper.GetVerifiedClientID(sdkCtx, tt.msg.LaunchID)
 			require.False(t, found)
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tendermint/spn/x/launch/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+)
+
+func (k msgServer) SetApprovalPolicy(
+	goCtx context.Context,
+	msg *types.MsgSetApprovalPolicy,
+) (*types.MsgSetApprovalPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	chain, found := k.GetChain(ctx, msg.LaunchID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrChainNotFound, "%d", msg.LaunchID)
+	}
+
+	// Check the sender is allowed to act on behalf of the chain coordinator
+	err := k.profileKeeper.CheckCoordinatorPermission(
+		ctx,
+		msg.Coordinator,
+		chain.CoordinatorID,
+		profiletypes.CoordinatorPermission_EDIT_CHAIN,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if chain.LaunchTriggered {
+		return nil, sdkerrors.Wrapf(types.ErrTriggeredLaunch, "%d", msg.LaunchID)
+	}
+
+	if chain.Archived {
+		return nil, sdkerrors.Wrapf(types.ErrChainArchived, "%d", msg.LaunchID)
+	}
+
+	// an empty policy disables the automatic approval of requests
+	policy := msg.ApprovalPolicy()
+	if policy.IsEmpty() {
+		k.RemoveApprovalPolicy(ctx, msg.LaunchID)
+	} else {
+		k.Keeper.SetApprovalPolicy(ctx, policy)
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventApprovalPolicySet{
+		ApprovalPolicy:     policy,
+		CoordinatorAddress: msg.Coordinator,
+	})
+
+	return &types.MsgSetApprovalPolicyResponse{}, err
+}
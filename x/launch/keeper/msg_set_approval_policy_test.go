@@ -0,0 +1,105 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	tc "github.com/tendermint/spn/testutil/constructor"
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/launch/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+)
+
+func TestMsgSetApprovalPolicy(t *testing.T) {
+	var (
+		coordAddr      = sample.Address(r)
+		otherCoordAddr = sample.Address(r)
+		sdkCtx, tk, ts = testkeeper.NewTestSetup(t)
+		ctx            = sdk.WrapSDKContext(sdkCtx)
+	)
+
+	msgCreateCoordinator := sample.MsgCreateCoordinator(coordAddr)
+	res, err := ts.ProfileSrv.CreateCoordinator(ctx, &msgCreateCoordinator)
+	require.NoError(t, err)
+	msgCreateCoordinator = sample.MsgCreateCoordinator(otherCoordAddr)
+	_, err = ts.ProfileSrv.CreateCoordinator(ctx, &msgCreateCoordinator)
+	require.NoError(t, err)
+
+	chains := createNChainForCoordinator(tk.LaunchKeeper, sdkCtx, res.CoordinatorID, 2)
+	chains[1].LaunchTriggered = true
+	tk.LaunchKeeper.SetChain(sdkCtx, chains[1])
+
+	minSelfDelegation := sdk.NewInt64Coin("stake", 100)
+
+	for _, tt := range []struct {
+		name       string
+		msg        types.MsgSetApprovalPolicy
+		wantPolicy bool
+		err        error
+	}{
+		{
+			name: "should allow setting an approval policy",
+			msg: *types.NewMsgSetApprovalPolicy(
+				coordAddr,
+				chains[0].LaunchID,
+				tc.Coins(t, "1000foo"),
+				&minSelfDelegation,
+				[]string{sample.Address(r)},
+			),
+			wantPolicy: true,
+		},
+		{
+			name: "should allow updating an approval policy",
+			msg: *types.NewMsgSetApprovalPolicy(
+				coordAddr,
+				chains[0].LaunchID,
+				nil,
+				nil,
+				[]string{sample.Address(r)},
+			),
+			wantPolicy: true,
+		},
+		{
+			name: "should allow removing an approval policy with an empty policy",
+			msg:  *types.NewMsgSetApprovalPolicy(coordAddr, chains[0].LaunchID, nil, nil, nil),
+		},
+		{
+			name: "should prevent setting an approval policy for a non existing chain",
+			msg:  *types.NewMsgSetApprovalPolicy(coordAddr, 1000, nil, nil, nil),
+			err:  types.ErrChainNotFound,
+		},
+		{
+			name: "should prevent setting an approval policy from a non coordinator address",
+			msg:  *types.NewMsgSetApprovalPolicy(sample.Address(r), chains[0].LaunchID, nil, nil, nil),
+			err:  profiletypes.ErrCoordAddressNotFound,
+		},
+		{
+			name: "should prevent setting an approval policy from a different coordinator",
+			msg:  *types.NewMsgSetApprovalPolicy(otherCoordAddr, chains[0].LaunchID, nil, nil, nil),
+			err:  profiletypes.ErrCoordInvalid,
+		},
+		{
+			name: "should prevent setting an approval policy for a launch triggered chain",
+			msg:  *types.NewMsgSetApprovalPolicy(coordAddr, chains[1].LaunchID, nil, nil, nil),
+			err:  types.ErrTriggeredLaunch,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ts.LaunchSrv.SetApprovalPolicy(ctx, &tt.msg)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+
+			policy, found := tk.LaunchKeeper.GetApprovalPolicy(sdkCtx, tt.msg.LaunchID)
+			require.Equal(t, tt.wantPolicy, found)
+			if tt.wantPolicy {
+				require.Equal(t, tt.msg.ApprovalPolicy(), policy)
+			}
+		})
+	}
+}
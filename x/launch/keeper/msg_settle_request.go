@@ -16,56 +16,86 @@ func (k msgServer) SettleRequest(
 ) (*types.MsgSettleRequestResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	chain, found := k.GetChain(ctx, msg.LaunchID)
+	chain, coord, err := k.getSettlementChain(ctx, msg.LaunchID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.settleRequest(ctx, chain, coord, msg.Signer, msg.RequestID, msg.Approve); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSettleRequestResponse{}, nil
+}
+
+// getSettlementChain returns the chain and its coordinator if requests of the chain can be settled
+func (k Keeper) getSettlementChain(ctx sdk.Context, launchID uint64) (types.Chain, profiletypes.Coordinator, error) {
+	chain, found := k.GetChain(ctx, launchID)
 	if !found {
-		return nil, sdkerrors.Wrapf(types.ErrChainNotFound, "%d", msg.LaunchID)
+		return chain, profiletypes.Coordinator{}, sdkerrors.Wrapf(types.ErrChainNotFound, "%d", launchID)
 	}
 
 	if chain.LaunchTriggered {
-		return nil, sdkerrors.Wrapf(types.ErrTriggeredLaunch, "%d", msg.LaunchID)
+		return chain, profiletypes.Coordinator{}, sdkerrors.Wrapf(types.ErrTriggeredLaunch, "%d", launchID)
+	}
+
+	if chain.Archived {
+		return chain, profiletypes.Coordinator{}, sdkerrors.Wrapf(types.ErrChainArchived, "%d", launchID)
 	}
 
 	coord, found := k.profileKeeper.GetCoordinator(ctx, chain.CoordinatorID)
 	if !found {
-		return nil, sdkerrors.Wrapf(types.ErrChainInactive,
+		return chain, coord, sdkerrors.Wrapf(types.ErrChainInactive,
 			"the chain %d coordinator not found", chain.LaunchID)
 	}
 
 	if !coord.Active {
-		return nil, sdkerrors.Wrapf(profiletypes.ErrCoordInactive,
+		return chain, coord, sdkerrors.Wrapf(profiletypes.ErrCoordInactive,
 			"the chain %d coordinator inactive", chain.LaunchID)
 	}
 
-	if msg.Approve && msg.Signer != coord.Address {
-		return nil, sdkerrors.Wrap(types.ErrNoAddressPermission, msg.Signer)
+	return chain, coord, nil
+}
+
+// settleRequest approves or rejects a pending request of the chain on behalf of the signer
+func (k Keeper) settleRequest(
+	ctx sdk.Context,
+	chain types.Chain,
+	coord profiletypes.Coordinator,
+	signer string,
+	requestID uint64,
+	approve bool,
+) error {
+	if approve && !coord.HasPermission(signer, profiletypes.CoordinatorPermission_SETTLE_REQUESTS) {
+		return sdkerrors.Wrap(types.ErrNoAddressPermission, signer)
 	}
 
 	// first check if the request exists
-	request, found := k.GetRequest(ctx, msg.LaunchID, msg.RequestID)
+	request, found := k.GetRequest(ctx, chain.LaunchID, requestID)
 	if !found {
-		return nil, sdkerrors.Wrapf(types.ErrRequestNotFound,
+		return sdkerrors.Wrapf(types.ErrRequestNotFound,
 			"request %d for chain %d not found",
-			msg.RequestID,
-			msg.LaunchID,
+			requestID,
+			chain.LaunchID,
 		)
 	}
 
 	if request.Status != types.Request_PENDING {
-		return nil, sdkerrors.Wrapf(types.ErrRequestSettled,
+		return sdkerrors.Wrapf(types.ErrRequestSettled,
 			"request %d is not pending",
-			msg.RequestID,
+			requestID,
 		)
 	}
 
-	if msg.Signer != request.Creator && msg.Signer != coord.Address {
-		return nil, sdkerrors.Wrap(types.ErrNoAddressPermission, msg.Signer)
+	if signer != request.Creator && !coord.HasPermission(signer, profiletypes.CoordinatorPermission_SETTLE_REQUESTS) {
+		return sdkerrors.Wrap(types.ErrNoAddressPermission, signer)
 	}
 
 	// apply request if approving and update status
-	if msg.Approve {
-		err := ApplyRequest(ctx, k.Keeper, chain, request, coord)
+	if approve {
+		err := ApplyRequest(ctx, k, chain, request, coord)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		request.Status = types.Request_APPROVED
 	} else {
@@ -73,11 +103,9 @@ func (k msgServer) SettleRequest(
 	}
 
 	k.SetRequest(ctx, request)
-	err := ctx.EventManager().EmitTypedEvent(&types.EventRequestSettled{
-		LaunchID:  msg.LaunchID,
+	return ctx.EventManager().EmitTypedEvent(&types.EventRequestSettled{
+		LaunchID:  chain.LaunchID,
 		RequestID: request.RequestID,
-		Approved:  msg.Approve,
+		Approved:  approve,
 	})
-
-	return &types.MsgSettleRequestResponse{}, err
 }
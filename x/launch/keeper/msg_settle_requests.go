@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ignterrors "github.com/ignite/modules/errors"
+
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+func (k msgServer) SettleRequests(
+	goCtx context.Context,
+	msg *types.MsgSettleRequests,
+) (*types.MsgSettleRequestsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	chain, coord, err := k.getSettlementChain(ctx, msg.LaunchID)
+	if err != nil {
+		return nil, err
+	}
+
+	// settle all the pending requests of the range if specified
+	settlements := msg.Settlements
+	if msg.RequestIDRange != nil {
+		settlements = nil
+		requests := k.GetRequestsInRange(ctx, msg.LaunchID, msg.RequestIDRange.Start, msg.RequestIDRange.End)
+		for _, request := range requests {
+			if request.Status == types.Request_PENDING {
+				settlements = append(settlements, types.RequestSettlement{
+					RequestID: request.RequestID,
+					Approve:   msg.RequestIDRange.Approve,
+				})
+			}
+		}
+	}
+
+	res := &types.MsgSettleRequestsResponse{}
+	for _, settlement := range settlements {
+		if !msg.BestEffort {
+			err := k.settleRequest(ctx, chain, coord, msg.Signer, settlement.RequestID, settlement.Approve)
+			if err != nil {
+				return nil, sdkerrors.Wrapf(err, "request %d", settlement.RequestID)
+			}
+			res.SettledRequestIDs = append(res.SettledRequestIDs, settlement.RequestID)
+			continue
+		}
+
+		// in best effort mode, a failed settlement doesn't revert the other settlements
+		cacheCtx, writeCache := ctx.CacheContext()
+		err := k.settleRequest(cacheCtx, chain, coord, msg.Signer, settlement.RequestID, settlement.Approve)
+		switch {
+		case errors.Is(err, ignterrors.ErrCritical):
+			return nil, err
+		case err != nil:
+			res.Failures = append(res.Failures, types.RequestSettlementFailure{
+				RequestID: settlement.RequestID,
+				Error:     err.Error(),
+			})
+		default:
+			writeCache()
+			ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+			res.SettledRequestIDs = append(res.SettledRequestIDs, settlement.RequestID)
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,184 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+func TestMsgSettleRequests(t *testing.T) {
+	var (
+		coordinator    = sample.Coordinator(r, sample.Address(r))
+		sdkCtx, tk, ts = testkeeper.NewTestSetup(t)
+		ctx            = sdk.WrapSDKContext(sdkCtx)
+	)
+	coordinator.CoordinatorID = tk.ProfileKeeper.AppendCoordinator(sdkCtx, coordinator)
+
+	chains := createNChainForCoordinator(tk.LaunchKeeper, sdkCtx, coordinator.CoordinatorID, 2)
+	chains[0].LaunchTriggered = true
+	tk.LaunchKeeper.SetChain(sdkCtx, chains[0])
+	launchID := chains[1].LaunchID
+
+	createRequests := func(n int) ([]types.Request, []RequestSample) {
+		samples := make([]RequestSample, n)
+		for i := range samples {
+			addr := sample.Address(r)
+			samples[i] = RequestSample{
+				Content: sample.GenesisAccountContent(r, launchID, addr),
+				Creator: addr,
+				Status:  types.Request_PENDING,
+			}
+		}
+		return createRequestsFromSamples(tk.LaunchKeeper, sdkCtx, launchID, samples), samples
+	}
+
+	requireStatus := func(t *testing.T, request types.Request, status types.Request_Status) {
+		got, found := tk.LaunchKeeper.GetRequest(sdkCtx, launchID, request.RequestID)
+		require.True(t, found)
+		require.Equal(t, status, got.Status)
+	}
+
+	countSettledEvents := func(events sdk.Events) (count int) {
+		for _, event := range events {
+			if event.Type == proto.MessageName(&types.EventRequestSettled{}) {
+				count++
+			}
+		}
+		return count
+	}
+
+	t.Run("should prevent settling requests for non existing chain", func(t *testing.T) {
+		_, err := ts.LaunchSrv.SettleRequests(ctx, &types.MsgSettleRequests{
+			Signer:      coordinator.Address,
+			LaunchID:    1000,
+			Settlements: []types.RequestSettlement{{RequestID: 1, Approve: true}},
+		})
+		require.ErrorIs(t, err, types.ErrChainNotFound)
+	})
+
+	t.Run("should prevent settling requests with launch triggered chain", func(t *testing.T) {
+		_, err := ts.LaunchSrv.SettleRequests(ctx, &types.MsgSettleRequests{
+			Signer:      coordinator.Address,
+			LaunchID:    chains[0].LaunchID,
+			Settlements: []types.RequestSettlement{{RequestID: 1, Approve: true}},
+		})
+		require.ErrorIs(t, err, types.ErrTriggeredLaunch)
+	})
+
+	t.Run("should allow settling a list of requests", func(t *testing.T) {
+		requests, samples := createRequests(3)
+		eventCtx := sdkCtx.WithEventManager(sdk.NewEventManager())
+
+		res, err := ts.LaunchSrv.SettleRequests(sdk.WrapSDKContext(eventCtx), &types.MsgSettleRequests{
+			Signer:   coordinator.Address,
+			LaunchID: launchID,
+			Settlements: []types.RequestSettlement{
+				{RequestID: requests[0].RequestID, Approve: true},
+				{RequestID: requests[1].RequestID, Approve: false},
+				{RequestID: requests[2].RequestID, Approve: true},
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []uint64{requests[0].RequestID, requests[1].RequestID, requests[2].RequestID}, res.SettledRequestIDs)
+		require.Empty(t, res.Failures)
+		require.Equal(t, 3, countSettledEvents(eventCtx.EventManager().Events()))
+
+		requireStatus(t, requests[0], types.Request_APPROVED)
+		requireStatus(t, requests[1], types.Request_REJECTED)
+		requireStatus(t, requests[2], types.Request_APPROVED)
+		_, found := tk.LaunchKeeper.GetGenesisAccount(sdkCtx, launchID, samples[0].Creator)
+		require.True(t, found)
+		_, found = tk.LaunchKeeper.GetGenesisAccount(sdkCtx, launchID, samples[1].Creator)
+		require.False(t, found)
+		_, found = tk.LaunchKeeper.GetGenesisAccount(sdkCtx, launchID, samples[2].Creator)
+		require.True(t, found)
+	})
+
+	t.Run("should prevent settling requests if a settlement fails", func(t *testing.T) {
+		requests, _ := createRequests(1)
+
+		_, err := ts.LaunchSrv.SettleRequests(ctx, &types.MsgSettleRequests{
+			Signer:   coordinator.Address,
+			LaunchID: launchID,
+			Settlements: []types.RequestSettlement{
+				{RequestID: requests[0].RequestID, Approve: true},
+				{RequestID: 99999999, Approve: true},
+			},
+		})
+		require.ErrorIs(t, err, types.ErrRequestNotFound)
+	})
+
+	t.Run("should prevent approving requests from an account other than coordinator", func(t *testing.T) {
+		requests, samples := createRequests(1)
+
+		_, err := ts.LaunchSrv.SettleRequests(ctx, &types.MsgSettleRequests{
+			Signer:      samples[0].Creator,
+			LaunchID:    launchID,
+			Settlements: []types.RequestSettlement{{RequestID: requests[0].RequestID, Approve: true}},
+		})
+		require.ErrorIs(t, err, types.ErrNoAddressPermission)
+		requireStatus(t, requests[0], types.Request_PENDING)
+	})
+
+	t.Run("should report failed settlements in best effort mode", func(t *testing.T) {
+		requests, samples := createRequests(3)
+		settled := requests[1]
+		settled.Status = types.Request_REJECTED
+		tk.LaunchKeeper.SetRequest(sdkCtx, settled)
+		eventCtx := sdkCtx.WithEventManager(sdk.NewEventManager())
+
+		res, err := ts.LaunchSrv.SettleRequests(sdk.WrapSDKContext(eventCtx), &types.MsgSettleRequests{
+			Signer:   coordinator.Address,
+			LaunchID: launchID,
+			Settlements: []types.RequestSettlement{
+				{RequestID: requests[0].RequestID, Approve: true},
+				{RequestID: requests[1].RequestID, Approve: true},
+				{RequestID: 99999999, Approve: true},
+				{RequestID: requests[2].RequestID, Approve: true},
+			},
+			BestEffort: true,
+		})
+		require.NoError(t, err)
+		require.Equal(t, []uint64{requests[0].RequestID, requests[2].RequestID}, res.SettledRequestIDs)
+		require.Len(t, res.Failures, 2)
+		require.EqualValues(t, requests[1].RequestID, res.Failures[0].RequestID)
+		require.EqualValues(t, 99999999, res.Failures[1].RequestID)
+		require.Equal(t, 2, countSettledEvents(eventCtx.EventManager().Events()))
+
+		requireStatus(t, requests[0], types.Request_APPROVED)
+		requireStatus(t, requests[1], types.Request_REJECTED)
+		requireStatus(t, requests[2], types.Request_APPROVED)
+		_, found := tk.LaunchKeeper.GetGenesisAccount(sdkCtx, launchID, samples[1].Creator)
+		require.False(t, found)
+	})
+
+	t.Run("should allow settling all the pending requests of a range", func(t *testing.T) {
+		requests, _ := createRequests(4)
+		settled := requests[1]
+		settled.Status = types.Request_APPROVED
+		tk.LaunchKeeper.SetRequest(sdkCtx, settled)
+
+		res, err := ts.LaunchSrv.SettleRequests(ctx, &types.MsgSettleRequests{
+			Signer:   coordinator.Address,
+			LaunchID: launchID,
+			RequestIDRange: &types.RequestIDRange{
+				Start:   requests[0].RequestID,
+				End:     requests[2].RequestID,
+				Approve: false,
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []uint64{requests[0].RequestID, requests[2].RequestID}, res.SettledRequestIDs)
+
+		requireStatus(t, requests[0], types.Request_REJECTED)
+		requireStatus(t, requests[1], types.Request_APPROVED)
+		requireStatus(t, requests[2], types.Request_REJECTED)
+		requireStatus(t, requests[3], types.Request_PENDING)
+	})
+}
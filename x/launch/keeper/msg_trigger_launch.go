@@ -2,7 +2,6 @@ package keeper
 
 import (
 	"context"
-	"fmt"
 
 	sdkerrors "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -19,23 +18,25 @@ func (k msgServer) TriggerLaunch(goCtx context.Context, msg *types.MsgTriggerLau
 		return nil, sdkerrors.Wrapf(types.ErrChainNotFound, "%d", msg.LaunchID)
 	}
 
-	// Get the coordinator ID associated to the sender address
-	coordID, err := k.profileKeeper.CoordinatorIDFromAddress(ctx, msg.Coordinator)
+	// Check the sender is allowed to act on behalf of the chain coordinator
+	err := k.profileKeeper.CheckCoordinatorPermission(
+		ctx,
+		msg.Coordinator,
+		chain.CoordinatorID,
+		profiletypes.CoordinatorPermission_TRIGGER_LAUNCH,
+	)
 	if err != nil {
 		return nil, err
 	}
 
-	if chain.CoordinatorID != coordID {
-		return nil, sdkerrors.Wrap(profiletypes.ErrCoordInvalid, fmt.Sprintf(
-			"coordinator of the chain is %d",
-			chain.CoordinatorID,
-		))
-	}
-
 	if chain.LaunchTriggered {
 		return nil, sdkerrors.Wrapf(types.ErrTriggeredLaunch, "%d", msg.LaunchID)
 	}
 
+	if chain.Archived {
+		return nil, sdkerrors.Wrapf(types.ErrChainArchived, "%d", msg.LaunchID)
+	}
+
 	if msg.LaunchTime.Before(ctx.BlockTime().Add(k.LaunchTimeRange(ctx).MinLaunchTime)) {
 		return nil, sdkerrors.Wrapf(types.ErrLaunchTimeTooLow, "%s", msg.LaunchTime.String())
 	}
@@ -43,6 +44,10 @@ func (k msgServer) TriggerLaunch(goCtx context.Context, msg *types.MsgTriggerLau
 		return nil, sdkerrors.Wrapf(types.ErrLaunchTimeTooHigh, "%s", msg.LaunchTime.String())
 	}
 
+	// the revision height is kept when the launch is reverted, a chain is only counted
+	// in the coordinator stats the first time its launch is triggered
+	firstLaunch := chain.ConsumerRevisionHeight == 0
+
 	// set launch timestamp
 	chain.LaunchTriggered = true
 	chain.LaunchTime = msg.LaunchTime
@@ -51,6 +56,14 @@ func (k msgServer) TriggerLaunch(goCtx context.Context, msg *types.MsgTriggerLau
 	chain.ConsumerRevisionHeight = ctx.BlockHeight()
 
 	k.SetChain(ctx, chain)
+	if firstLaunch {
+		k.profileKeeper.IncrementChainsLaunched(ctx, chain.CoordinatorID)
+	}
+
+	// the requests can no longer be settled once the launch is triggered
+	if err := k.RejectPendingRequests(ctx, msg.LaunchID); err != nil {
+		return nil, err
+	}
 
 	err = ctx.EventManager().EmitTypedEvent(&types.EventLaunchTriggered{
 		LaunchID:        msg.LaunchID,
@@ -25,6 +25,7 @@ func TestMsgTriggerLaunch(t *testing.T) {
 	}
 	sampleTime := sample.Time(r)
 	sampleAddr := sample.Address(r)
+	operatorAddr := sample.Address(r)
 
 	for _, tt := range []struct {
 		name       string
@@ -68,6 +69,55 @@ func TestMsgTriggerLaunch(t *testing.T) {
 				Coordinator: sampleAddr,
 			},
 		},
+		{
+			name: "should allow triggering a chain launch from an operator with the permission",
+			inputState: inputState{
+				chain: sample.Chain(r, 8, 8),
+				coordinator: profiletypes.Coordinator{
+					CoordinatorID: 8,
+					Address:       sampleAddr,
+					Active:        true,
+					Operators: []profiletypes.CoordinatorOperator{
+						{
+							Address:     operatorAddr,
+							Permissions: []profiletypes.CoordinatorPermission{profiletypes.CoordinatorPermission_TRIGGER_LAUNCH},
+						},
+					},
+				},
+				blockTime:   sampleTime,
+				blockHeight: 100,
+			},
+			msg: types.MsgTriggerLaunch{
+				LaunchID:    8,
+				LaunchTime:  sampleTime.Add(types.DefaultMinLaunchTime),
+				Coordinator: operatorAddr,
+			},
+		},
+		{
+			name: "should prevent triggering a chain launch from an operator without the permission",
+			inputState: inputState{
+				chain: sample.Chain(r, 9, 9),
+				coordinator: profiletypes.Coordinator{
+					CoordinatorID: 9,
+					Address:       sampleAddr,
+					Active:        true,
+					Operators: []profiletypes.CoordinatorOperator{
+						{
+							Address:     operatorAddr,
+							Permissions: []profiletypes.CoordinatorPermission{profiletypes.CoordinatorPermission_SETTLE_REQUESTS},
+						},
+					},
+				},
+				blockTime:   sampleTime,
+				blockHeight: 100,
+			},
+			msg: types.MsgTriggerLaunch{
+				LaunchID:    9,
+				LaunchTime:  sampleTime.Add(types.DefaultMinLaunchTime),
+				Coordinator: operatorAddr,
+			},
+			err: profiletypes.ErrCoordInvalid,
+		},
 		{
 			name: "should prevent triggering a chain launch from a non existing chain",
 			inputState: inputState{
@@ -144,6 +194,29 @@ func TestMsgTriggerLaunch(t *testing.T) {
 			},
 			err: types.ErrTriggeredLaunch,
 		},
+		{
+			name: "should prevent triggering the launch of an archived chain",
+			inputState: inputState{
+				chain: types.Chain{
+					LaunchID:      15,
+					CoordinatorID: 15,
+					Archived:      true,
+				},
+				coordinator: profiletypes.Coordinator{
+					CoordinatorID: 15,
+					Address:       sampleAddr,
+					Active:        true,
+				},
+				blockTime:   sampleTime,
+				blockHeight: 100,
+			},
+			msg: types.MsgTriggerLaunch{
+				LaunchID:    15,
+				LaunchTime:  sampleTime.Add(types.DefaultMinLaunchTime),
+				Coordinator: sampleAddr,
+			},
+			err: types.ErrChainArchived,
+		},
 		{
 			name: "should prevent triggering a chain launch with launch time too low",
 			inputState: inputState{
@@ -202,6 +275,8 @@ func TestMsgTriggerLaunch(t *testing.T) {
 				sdkCtx = sdkCtx.WithBlockHeight(tt.inputState.blockHeight)
 			}
 
+			statsBefore, _ := tk.ProfileKeeper.GetCoordinatorStats(sdkCtx, tt.inputState.chain.CoordinatorID)
+
 			// Send the message
 			_, err := ts.LaunchSrv.TriggerLaunch(sdkCtx, &tt.msg)
 			if tt.err != nil {
@@ -216,6 +291,95 @@ func TestMsgTriggerLaunch(t *testing.T) {
 			require.True(t, chain.LaunchTriggered)
 			require.EqualValues(t, tt.msg.LaunchTime, chain.LaunchTime)
 			require.EqualValues(t, tt.inputState.blockHeight, chain.ConsumerRevisionHeight)
+
+			// Check the launch is recorded in the coordinator stats
+			stats, found := tk.ProfileKeeper.GetCoordinatorStats(sdkCtx, chain.CoordinatorID)
+			require.True(t, found)
+			require.EqualValues(t, statsBefore.ChainsLaunched+1, stats.ChainsLaunched)
 		})
 	}
 }
+
+func TestMsgTriggerLaunchRejectPendingRequests(t *testing.T) {
+	var (
+		sdkCtx, tk, ts = testkeeper.NewTestSetup(t)
+		coordinator    = sample.Coordinator(r, sample.Address(r))
+		sampleTime     = sample.Time(r)
+	)
+	coordinator.CoordinatorID = tk.ProfileKeeper.AppendCoordinator(sdkCtx, coordinator)
+	tk.ProfileKeeper.SetCoordinatorByAddress(sdkCtx, profiletypes.CoordinatorByAddress{
+		Address:       coordinator.Address,
+		CoordinatorID: coordinator.CoordinatorID,
+	})
+	chain := createNChainForCoordinator(tk.LaunchKeeper, sdkCtx, coordinator.CoordinatorID, 1)[0]
+	sdkCtx = sdkCtx.WithBlockTime(sampleTime)
+
+	samples := make([]RequestSample, 3)
+	for i, status := range []types.Request_Status{
+		types.Request_PENDING,
+		types.Request_APPROVED,
+		types.Request_PENDING,
+	} {
+		addr := sample.Address(r)
+		samples[i] = RequestSample{
+			Content: sample.GenesisAccountContent(r, chain.LaunchID, addr),
+			Creator: addr,
+			Status:  status,
+		}
+	}
+	requests := createRequestsFromSamples(tk.LaunchKeeper, sdkCtx, chain.LaunchID, samples)
+
+	_, err := ts.LaunchSrv.TriggerLaunch(sdkCtx, &types.MsgTriggerLaunch{
+		LaunchID:    chain.LaunchID,
+		LaunchTime:  sampleTime.Add(types.DefaultMinLaunchTime),
+		Coordinator: coordinator.Address,
+	})
+	require.NoError(t, err)
+
+	for i, status := range []types.Request_Status{
+		types.Request_REJECTED,
+		types.Request_APPROVED,
+		types.Request_REJECTED,
+	} {
+		request, found := tk.LaunchKeeper.GetRequest(sdkCtx, chain.LaunchID, requests[i].RequestID)
+		require.True(t, found)
+		require.Equal(t, status, request.Status)
+	}
+}
+
+func TestMsgTriggerLaunchAfterRevert(t *testing.T) {
+	var (
+		sdkCtx, tk, ts = testkeeper.NewTestSetup(t)
+		coordinator    = sample.Coordinator(r, sample.Address(r))
+		sampleTime     = sample.Time(r)
+	)
+	coordinator.CoordinatorID = tk.ProfileKeeper.AppendCoordinator(sdkCtx, coordinator)
+	tk.ProfileKeeper.SetCoordinatorByAddress(sdkCtx, profiletypes.CoordinatorByAddress{
+		Address:       coordinator.Address,
+		CoordinatorID: coordinator.CoordinatorID,
+	})
+	tk.ProfileKeeper.SetCoordinatorStats(sdkCtx, profiletypes.CoordinatorStats{
+		CoordinatorID:    coordinator.CoordinatorID,
+		ChainsLaunched:   1,
+		LaunchesReverted: 1,
+	})
+
+	// the chain has been launched and reverted
+	chain := sample.Chain(r, 0, coordinator.CoordinatorID)
+	chain.ConsumerRevisionHeight = 10
+	tk.LaunchKeeper.SetChain(sdkCtx, chain)
+
+	t.Run("should not count a chain launched again after a revert in the coordinator stats", func(t *testing.T) {
+		sdkCtx = sdkCtx.WithBlockTime(sampleTime).WithBlockHeight(100)
+		_, err := ts.LaunchSrv.TriggerLaunch(sdkCtx, &types.MsgTriggerLaunch{
+			LaunchID:    chain.LaunchID,
+			LaunchTime:  sampleTime.Add(types.DefaultMinLaunchTime),
+			Coordinator: coordinator.Address,
+		})
+		require.NoError(t, err)
+
+		stats, found := tk.ProfileKeeper.GetCoordinatorStats(sdkCtx, coordinator.CoordinatorID)
+		require.True(t, found)
+		require.EqualValues(t, 1, stats.ChainsLaunched)
+	})
+}
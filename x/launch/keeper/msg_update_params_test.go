@@ -0,0 +1,97 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+func TestMsgUpdateParams(t *testing.T) {
+	var (
+		sdkCtx, tk, ts = testkeeper.NewTestSetup(t)
+		ctx            = sdk.WrapSDKContext(sdkCtx)
+		authority      = tk.LaunchKeeper.GetAuthority()
+	)
+
+	t.Run("should prevent updating params from an account other than authority", func(t *testing.T) {
+		_, err := ts.LaunchSrv.UpdateParams(ctx, &types.MsgUpdateParams{
+			Authority: sample.Address(r),
+			Params:    sample.LaunchParams(r),
+		})
+		require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+	})
+
+	for _, tt := range []struct {
+		name   string
+		update func(*types.Params)
+	}{
+		{
+			name: "should prevent updating params with a minimum launch time greater than the maximum",
+			update: func(params *types.Params) {
+				params.LaunchTimeRange = types.NewLaunchTimeRange(10, 1)
+			},
+		},
+		{
+			name: "should prevent updating params with a maximum launch time above the parametrable limit",
+			update: func(params *types.Params) {
+				params.LaunchTimeRange = types.NewLaunchTimeRange(1, types.MaxParametrableLaunchTime+1)
+			},
+		},
+		{
+			name: "should prevent updating params with a zero revert delay",
+			update: func(params *types.Params) {
+				params.RevertDelay = 0
+			},
+		},
+		{
+			name: "should prevent updating params with a negative request expiration",
+			update: func(params *types.Params) {
+				params.RequestExpiration = -time.Second
+			},
+		},
+		{
+			name: "should prevent updating params with a validator set threshold greater than one",
+			update: func(params *types.Params) {
+				params.ValidatorSetThreshold = sdk.NewDecWithPrec(11, 1)
+			},
+		},
+		{
+			name: "should prevent updating params with an invalid chain creation fee",
+			update: func(params *types.Params) {
+				params.ChainCreationFee = sdk.Coins{sdk.Coin{Denom: "foo", Amount: sdkmath.NewInt(-1)}}
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			current := tk.LaunchKeeper.GetParams(sdkCtx)
+			invalidParams := sample.LaunchParams(r)
+			tt.update(&invalidParams)
+
+			_, err := ts.LaunchSrv.UpdateParams(ctx, &types.MsgUpdateParams{
+				Authority: authority,
+				Params:    invalidParams,
+			})
+			require.ErrorIs(t, err, sdkerrortypes.ErrInvalidRequest)
+			require.EqualValues(t, current, tk.LaunchKeeper.GetParams(sdkCtx))
+		})
+	}
+
+	t.Run("should allow updating params from authority", func(t *testing.T) {
+		params := sample.LaunchParams(r)
+		_, err := ts.LaunchSrv.UpdateParams(ctx, &types.MsgUpdateParams{
+			Authority: authority,
+			Params:    params,
+		})
+		require.NoError(t, err)
+		require.EqualValues(t, params, tk.LaunchKeeper.GetParams(sdkCtx))
+	})
+}
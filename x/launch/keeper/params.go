@@ -26,6 +26,24 @@ func (k Keeper) ChainCreationFee(ctx sdk.Context) (chainCreationFee sdk.Coins) {
 	return
 }
 
+// RequestExpiration returns the request expiration param
+func (k Keeper) RequestExpiration(ctx sdk.Context) (res time.Duration) {
+	k.paramstore.Get(ctx, types.KeyRequestExpiration, &res)
+	return
+}
+
+// ValidatorSetThreshold returns the validator set threshold param
+func (k Keeper) ValidatorSetThreshold(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyValidatorSetThreshold, &res)
+	return
+}
+
+// MaxNonGenesisValidators returns the max non genesis validators param
+func (k Keeper) MaxNonGenesisValidators(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyMaxNonGenesisValidators, &res)
+	return
+}
+
 // GetParams get all parameters as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	return types.NewParams(
@@ -33,6 +51,9 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 		k.LaunchTimeRange(ctx).MaxLaunchTime,
 		k.RevertDelay(ctx),
 		k.ChainCreationFee(ctx),
+		k.RequestExpiration(ctx),
+		k.ValidatorSetThreshold(ctx),
+		k.MaxNonGenesisValidators(ctx),
 	)
 }
 
@@ -21,5 +21,8 @@ func Test_GetParams(t *testing.T) {
 		require.EqualValues(t, params.LaunchTimeRange.MinLaunchTime, tk.LaunchKeeper.LaunchTimeRange(ctx).MinLaunchTime)
 		require.EqualValues(t, params.RevertDelay, tk.LaunchKeeper.RevertDelay(ctx))
 		require.EqualValues(t, params.ChainCreationFee, tk.LaunchKeeper.ChainCreationFee(ctx))
+		require.EqualValues(t, params.RequestExpiration, tk.LaunchKeeper.RequestExpiration(ctx))
+		require.EqualValues(t, params.ValidatorSetThreshold, tk.LaunchKeeper.ValidatorSetThreshold(ctx))
+		require.EqualValues(t, params.MaxNonGenesisValidators, tk.LaunchKeeper.MaxNonGenesisValidators(ctx))
 	})
 }
@@ -3,9 +3,11 @@ package keeper
 import (
 	"encoding/binary"
 	"fmt"
+	"math"
 
 	sdkerrors "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	ignterrors "github.com/ignite/modules/errors"
 
@@ -37,12 +39,19 @@ func (k Keeper) SetRequestCounter(ctx sdk.Context, launchID, counter uint64) {
 
 // SetRequest set a specific request in the store from its index
 func (k Keeper) SetRequest(ctx sdk.Context, request types.Request) {
+	// the indexes of the previous version of the request are outdated
+	if previous, found := k.GetRequest(ctx, request.LaunchID, request.RequestID); found {
+		k.removeRequestIndexes(ctx, previous)
+	}
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RequestKeyPrefix))
 	b := k.cdc.MustMarshal(&request)
 	store.Set(types.RequestKey(
 		request.LaunchID,
 		request.RequestID,
 	), b)
+
+	k.setRequestIndexes(ctx, request)
 }
 
 // AppendRequest appends a request for a chain in the store with a new id and update the counter
@@ -58,6 +67,8 @@ func (k Keeper) AppendRequest(ctx sdk.Context, request types.Request) uint64 {
 		request.RequestID,
 	), b)
 
+	k.setRequestIndexes(ctx, request)
+
 	// increment the counter
 	k.SetRequestCounter(ctx, request.LaunchID, counter+1)
 
@@ -90,11 +101,18 @@ func (k Keeper) RemoveRequest(
 	launchID,
 	requestID uint64,
 ) {
+	request, found := k.GetRequest(ctx, launchID, requestID)
+	if !found {
+		return
+	}
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RequestKeyPrefix))
 	store.Delete(types.RequestKey(
 		launchID,
 		requestID,
 	))
+
+	k.removeRequestIndexes(ctx, request)
 }
 
 // GetAllRequest returns all request
@@ -113,6 +131,27 @@ func (k Keeper) GetAllRequest(ctx sdk.Context) (list []types.Request) {
 	return
 }
 
+// GetRequestsInRange returns the requests of a chain with an ID in the range, bounds included
+func (k Keeper) GetRequestsInRange(ctx sdk.Context, launchID, start, end uint64) (list []types.Request) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RequestKeyPrefix))
+
+	endKey := storetypes.PrefixEndBytes(types.RequestPoolKey(launchID))
+	if end < math.MaxUint64 {
+		endKey = types.RequestKey(launchID, end+1)
+	}
+	iterator := store.Iterator(types.RequestKey(launchID, start), endKey)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Request
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
 // CheckAccount check account inconsistency and return
 // if an account exists for genesis or vesting accounts
 func CheckAccount(ctx sdk.Context, k Keeper, launchID uint64, address string) (bool, error) {
@@ -157,20 +196,14 @@ func ApplyRequest(
 
 	case *types.RequestContent_VestingAccount:
 		va := requestContent.VestingAccount
-		if !chain.AccountBalance.Empty() {
-			switch opt := va.VestingOptions.Options.(type) {
-			case *types.VestingOptions_DelayedVesting:
-				dv := opt.DelayedVesting
-				va = &types.VestingAccount{
-					Address:  va.Address,
-					LaunchID: va.LaunchID,
-					VestingOptions: *types.NewDelayedVesting(
-						chain.AccountBalance,
-						chain.AccountBalance,
-						dv.EndTime,
-					),
-				}
-			}
+		vestingOptions, vestingErr := accountVestingOptions(chain, va.VestingOptions)
+		if vestingErr != nil {
+			return vestingErr
+		}
+		va = &types.VestingAccount{
+			Address:        va.Address,
+			LaunchID:       va.LaunchID,
+			VestingOptions: vestingOptions,
 		}
 		k.SetVestingAccount(ctx, *va)
 		err = ctx.EventManager().EmitTypedEvent(&types.EventVestingAccountAdded{
@@ -180,6 +213,42 @@ func ApplyRequest(
 			CoordinatorAddress: coord.Address,
 		})
 
+	case *types.RequestContent_GenesisAccountUpdate:
+		au := requestContent.GenesisAccountUpdate
+		event := types.EventGenesisAccountUpdated{
+			LaunchID:           chain.LaunchID,
+			Address:            au.Address,
+			CoordinatorAddress: coord.Address,
+		}
+
+		// the account is replaced atomically, its type can change with the update
+		k.RemoveGenesisAccount(ctx, chain.LaunchID, au.Address)
+		k.RemoveVestingAccount(ctx, chain.LaunchID, au.Address)
+		if au.VestingOptions == nil {
+			coins := au.Coins
+			if !chain.AccountBalance.Empty() {
+				coins = chain.AccountBalance
+			}
+			k.SetGenesisAccount(ctx, types.GenesisAccount{
+				LaunchID: chain.LaunchID,
+				Address:  au.Address,
+				Coins:    coins,
+			})
+			event.Coins = coins
+		} else {
+			vestingOptions, vestingErr := accountVestingOptions(chain, *au.VestingOptions)
+			if vestingErr != nil {
+				return vestingErr
+			}
+			k.SetVestingAccount(ctx, types.VestingAccount{
+				LaunchID:       chain.LaunchID,
+				Address:        au.Address,
+				VestingOptions: vestingOptions,
+			})
+			event.VestingOptions = &vestingOptions
+		}
+		err = ctx.EventManager().EmitTypedEvent(&event)
+
 	case *types.RequestContent_AccountRemoval:
 		ar := requestContent.AccountRemoval
 		k.RemoveGenesisAccount(ctx, chain.LaunchID, ar.Address)
@@ -216,6 +285,24 @@ func ApplyRequest(
 			CoordinatorAddress:      coord.Address,
 		})
 
+	case *types.RequestContent_GenesisValidatorUpdate:
+		vu := requestContent.GenesisValidatorUpdate
+		validator, found := k.GetGenesisValidator(ctx, chain.LaunchID, vu.Address)
+		if !found {
+			return ignterrors.Criticalf("genesis validator %s for chain %d not found", vu.Address, chain.LaunchID)
+		}
+		validator = vu.Apply(validator)
+		k.SetGenesisValidator(ctx, validator)
+		err = ctx.EventManager().EmitTypedEvent(&types.EventValidatorUpdated{
+			LaunchID:           chain.LaunchID,
+			Address:            validator.Address,
+			GenTx:              validator.GenTx,
+			ConsPubKey:         validator.ConsPubKey,
+			SelfDelegation:     validator.SelfDelegation,
+			Peer:               validator.Peer,
+			CoordinatorAddress: coord.Address,
+		})
+
 	}
 	return err
 }
@@ -256,6 +343,9 @@ func CheckRequest(
 				va.Address, launchID,
 			)
 		}
+		if err := checkAccountVestingOptions(ctx, k, launchID, va.VestingOptions); err != nil {
+			return err
+		}
 	case *types.RequestContent_AccountRemoval:
 		ar := requestContent.AccountRemoval
 		found, err := CheckAccount(ctx, k, launchID, ar.Address)
@@ -268,6 +358,23 @@ func CheckRequest(
 				ar.Address, launchID,
 			)
 		}
+	case *types.RequestContent_GenesisAccountUpdate:
+		au := requestContent.GenesisAccountUpdate
+		found, err := CheckAccount(ctx, k, launchID, au.Address)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return sdkerrors.Wrapf(types.ErrAccountNotFound,
+				"account %s for chain %d not found",
+				au.Address, launchID,
+			)
+		}
+		if au.VestingOptions != nil {
+			if err := checkAccountVestingOptions(ctx, k, launchID, *au.VestingOptions); err != nil {
+				return err
+			}
+		}
 	case *types.RequestContent_GenesisValidator:
 		ga := requestContent.GenesisValidator
 		if _, found := k.GetGenesisValidator(ctx, launchID, ga.Address); found {
@@ -276,6 +383,14 @@ func CheckRequest(
 				ga.Address, launchID,
 			)
 		}
+	case *types.RequestContent_GenesisValidatorUpdate:
+		vu := requestContent.GenesisValidatorUpdate
+		if _, found := k.GetGenesisValidator(ctx, launchID, vu.Address); !found {
+			return sdkerrors.Wrapf(types.ErrValidatorNotFound,
+				"genesis validator %s for chain %d not found",
+				vu.Address, launchID,
+			)
+		}
 	case *types.RequestContent_ValidatorRemoval:
 		vr := requestContent.ValidatorRemoval
 		if _, found := k.GetGenesisValidator(ctx, launchID, vr.ValAddress); !found {
@@ -287,3 +402,29 @@ func CheckRequest(
 	}
 	return nil
 }
+
+// accountVestingOptions returns the vesting options of an account added to the chain
+// if the chain defines an account balance, it is set as the balance of the vesting options
+func accountVestingOptions(chain types.Chain, options types.VestingOptions) (types.VestingOptions, error) {
+	if chain.AccountBalance.Empty() {
+		return options, nil
+	}
+	options, err := options.WithBalance(chain.AccountBalance)
+	if err != nil {
+		return options, sdkerrors.Wrap(types.ErrInvalidVestingOption, err.Error())
+	}
+	if err := options.Validate(); err != nil {
+		return options, sdkerrors.Wrap(types.ErrInvalidVestingOption, err.Error())
+	}
+	return options, nil
+}
+
+// checkAccountVestingOptions checks the vesting options of an account remain valid with the account balance of the chain
+func checkAccountVestingOptions(ctx sdk.Context, k Keeper, launchID uint64, options types.VestingOptions) error {
+	chain, found := k.GetChain(ctx, launchID)
+	if !found {
+		return nil
+	}
+	_, err := accountVestingOptions(chain, options)
+	return err
+}
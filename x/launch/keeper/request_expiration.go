@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+// MaxExpiredRequestsPerBlock is the maximum number of expired requests rejected at the end of a block,
+// the other expired requests are rejected in the next blocks
+const MaxExpiredRequestsPerBlock = 100
+
+// RequestExpiresAt returns the expiration timestamp of a request created at the current block time
+// It returns 0 if the request expiration is disabled
+func (k Keeper) RequestExpiresAt(ctx sdk.Context) int64 {
+	requestExpiration := k.RequestExpiration(ctx)
+	if requestExpiration == 0 {
+		return 0
+	}
+	return ctx.BlockTime().Add(requestExpiration).Unix()
+}
+
+// setRequestExpiration indexes the request by its expiration time if the request is pending and can expire
+func (k Keeper) setRequestExpiration(ctx sdk.Context, request types.Request) {
+	if request.ExpiresAt == 0 || request.Status != types.Request_PENDING {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RequestExpirationKeyPrefix))
+	store.Set(
+		types.RequestExpirationKey(request.ExpiresAt, request.LaunchID, request.RequestID),
+		types.RequestKey(request.LaunchID, request.RequestID),
+	)
+}
+
+// removeRequestExpiration removes the request from the expiration index
+func (k Keeper) removeRequestExpiration(ctx sdk.Context, request types.Request) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RequestExpirationKeyPrefix))
+	store.Delete(types.RequestExpirationKey(request.ExpiresAt, request.LaunchID, request.RequestID))
+}
+
+// GetExpiredRequests returns at most limit pending requests expired at the current block time
+// ordered by expiration time, all the expired requests are returned if limit is 0
+func (k Keeper) GetExpiredRequests(ctx sdk.Context, limit int) (list []types.Request) {
+	expirationStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RequestExpirationKeyPrefix))
+	requestStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RequestKeyPrefix))
+
+	iterator := expirationStore.Iterator(nil, types.RequestExpirationTimeKey(ctx.BlockTime().Unix()+1))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid() && (limit == 0 || len(list) < limit); iterator.Next() {
+		var val types.Request
+		k.cdc.MustUnmarshal(requestStore.Get(iterator.Value()), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// RejectExpiredRequests rejects the first MaxExpiredRequestsPerBlock pending requests expired at the current block time
+// no request is rejected if an error occurs
+func (k Keeper) RejectExpiredRequests(ctx sdk.Context) error {
+	cacheCtx, writeCache := ctx.CacheContext()
+	for _, request := range k.GetExpiredRequests(cacheCtx, MaxExpiredRequestsPerBlock) {
+		if err := k.rejectRequest(cacheCtx, request); err != nil {
+			return err
+		}
+	}
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return nil
+}
+
+// RejectPendingRequests rejects all the pending requests of a chain
+func (k Keeper) RejectPendingRequests(ctx sdk.Context, launchID uint64) error {
+	for _, request := range k.GetRequestsByStatus(ctx, launchID, types.Request_PENDING) {
+		if err := k.rejectRequest(ctx, request); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rejectRequest sets the status of the request to rejected
+func (k Keeper) rejectRequest(ctx sdk.Context, request types.Request) error {
+	request.Status = types.Request_REJECTED
+	k.SetRequest(ctx, request)
+	return ctx.EventManager().EmitTypedEvent(&types.EventRequestSettled{
+		LaunchID:  request.LaunchID,
+		RequestID: request.RequestID,
+		Approved:  false,
+	})
+}
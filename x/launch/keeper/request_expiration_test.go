@@ -0,0 +1,138 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/launch/keeper"
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+func TestKeeper_RequestExpiresAt(t *testing.T) {
+	ctx, tk, _ := testkeeper.NewTestSetup(t)
+	blockTime := sample.Time(r)
+	ctx = ctx.WithBlockTime(blockTime)
+
+	t.Run("should return the expiration time", func(t *testing.T) {
+		params := tk.LaunchKeeper.GetParams(ctx)
+		params.RequestExpiration = time.Hour
+		tk.LaunchKeeper.SetParams(ctx, params)
+
+		require.EqualValues(t, blockTime.Add(time.Hour).Unix(), tk.LaunchKeeper.RequestExpiresAt(ctx))
+	})
+
+	t.Run("should return zero if the expiration is disabled", func(t *testing.T) {
+		params := tk.LaunchKeeper.GetParams(ctx)
+		params.RequestExpiration = 0
+		tk.LaunchKeeper.SetParams(ctx, params)
+
+		require.Zero(t, tk.LaunchKeeper.RequestExpiresAt(ctx))
+	})
+}
+
+func TestKeeper_RejectExpiredRequests(t *testing.T) {
+	ctx, tk, _ := testkeeper.NewTestSetup(t)
+	blockTime := time.Unix(1_000_000, 0).UTC()
+	ctx = ctx.WithBlockTime(blockTime)
+	launchID := uint64(1)
+
+	newRequest := func(expiresAt int64) types.Request {
+		request := sample.Request(r, launchID, sample.Address(r))
+		request.ExpiresAt = expiresAt
+		request.RequestID = tk.LaunchKeeper.AppendRequest(ctx, request)
+		return request
+	}
+	requireStatus := func(t *testing.T, request types.Request, status types.Request_Status) {
+		got, found := tk.LaunchKeeper.GetRequest(ctx, launchID, request.RequestID)
+		require.True(t, found)
+		require.Equal(t, status, got.Status)
+	}
+
+	expired := newRequest(blockTime.Unix())
+	expiredEarlier := newRequest(blockTime.Add(-time.Hour).Unix())
+	notExpired := newRequest(blockTime.Add(time.Second).Unix())
+	noExpiration := newRequest(0)
+	settled := newRequest(blockTime.Unix())
+	settled.Status = types.Request_APPROVED
+	tk.LaunchKeeper.SetRequest(ctx, settled)
+	removed := newRequest(blockTime.Unix())
+	tk.LaunchKeeper.RemoveRequest(ctx, launchID, removed.RequestID)
+
+	t.Run("should get the pending expired requests", func(t *testing.T) {
+		expiredRequests := tk.LaunchKeeper.GetExpiredRequests(ctx, 0)
+		require.ElementsMatch(t, []types.Request{expired, expiredEarlier}, expiredRequests)
+	})
+
+	t.Run("should reject the expired requests", func(t *testing.T) {
+		require.NoError(t, tk.LaunchKeeper.RejectExpiredRequests(ctx))
+
+		requireStatus(t, expired, types.Request_REJECTED)
+		requireStatus(t, expiredEarlier, types.Request_REJECTED)
+		requireStatus(t, notExpired, types.Request_PENDING)
+		requireStatus(t, noExpiration, types.Request_PENDING)
+		requireStatus(t, settled, types.Request_APPROVED)
+		require.Empty(t, tk.LaunchKeeper.GetExpiredRequests(ctx, 0))
+	})
+
+	t.Run("should reject requests once expired", func(t *testing.T) {
+		laterCtx := ctx.WithBlockTime(blockTime.Add(time.Second))
+		require.ElementsMatch(t, []types.Request{notExpired}, tk.LaunchKeeper.GetExpiredRequests(laterCtx, 0))
+		require.NoError(t, tk.LaunchKeeper.RejectExpiredRequests(laterCtx))
+
+		requireStatus(t, notExpired, types.Request_REJECTED)
+		requireStatus(t, noExpiration, types.Request_PENDING)
+	})
+
+	t.Run("should reject at most the max number of expired requests per block", func(t *testing.T) {
+		laterCtx := ctx.WithBlockTime(blockTime.Add(time.Hour))
+		requests := make([]types.Request, keeper.MaxExpiredRequestsPerBlock+1)
+		for i := range requests {
+			// the last request expires first
+			requests[i] = newRequest(blockTime.Add(time.Duration(len(requests)-i) * time.Second).Unix())
+		}
+		require.Len(t, tk.LaunchKeeper.GetExpiredRequests(laterCtx, 0), len(requests))
+		require.Len(t, tk.LaunchKeeper.GetExpiredRequests(laterCtx, 1), 1)
+
+		require.NoError(t, tk.LaunchKeeper.RejectExpiredRequests(laterCtx))
+		requireStatus(t, requests[0], types.Request_PENDING)
+		for _, request := range requests[1:] {
+			requireStatus(t, request, types.Request_REJECTED)
+		}
+		require.Equal(t, []types.Request{requests[0]}, tk.LaunchKeeper.GetExpiredRequests(laterCtx, 0))
+
+		// the remaining expired requests are rejected in the next block
+		require.NoError(t, tk.LaunchKeeper.RejectExpiredRequests(laterCtx))
+		requireStatus(t, requests[0], types.Request_REJECTED)
+	})
+}
+
+func TestKeeper_RejectPendingRequests(t *testing.T) {
+	ctx, tk, _ := testkeeper.NewTestSetup(t)
+
+	pending := sample.Request(r, 1, sample.Address(r))
+	pending.RequestID = tk.LaunchKeeper.AppendRequest(ctx, pending)
+	approved := sample.Request(r, 1, sample.Address(r))
+	approved.Status = types.Request_APPROVED
+	approved.RequestID = tk.LaunchKeeper.AppendRequest(ctx, approved)
+	otherChain := sample.Request(r, 2, sample.Address(r))
+	otherChain.RequestID = tk.LaunchKeeper.AppendRequest(ctx, otherChain)
+
+	require.NoError(t, tk.LaunchKeeper.RejectPendingRequests(ctx, 1))
+
+	for _, tc := range []struct {
+		request types.Request
+		status  types.Request_Status
+	}{
+		{pending, types.Request_REJECTED},
+		{approved, types.Request_APPROVED},
+		{otherChain, types.Request_PENDING},
+	} {
+		got, found := tk.LaunchKeeper.GetRequest(ctx, tc.request.LaunchID, tc.request.RequestID)
+		require.True(t, found)
+		require.Equal(t, tc.status, got.Status)
+	}
+}
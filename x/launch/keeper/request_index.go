@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+// setRequestIndexes indexes the request by status, creator and expiration time
+// The value of the indexes is the store key of the request
+func (k Keeper) setRequestIndexes(ctx sdk.Context, request types.Request) {
+	requestKey := types.RequestKey(request.LaunchID, request.RequestID)
+
+	statusStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RequestByStatusKeyPrefix))
+	statusStore.Set(types.RequestByStatusKey(request.LaunchID, request.Status, request.RequestID), requestKey)
+
+	creatorStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RequestByCreatorKeyPrefix))
+	creatorStore.Set(types.RequestByCreatorKey(request.Creator, request.LaunchID, request.RequestID), requestKey)
+
+	k.setRequestExpiration(ctx, request)
+}
+
+// removeRequestIndexes removes the request from the status, creator and expiration time indexes
+func (k Keeper) removeRequestIndexes(ctx sdk.Context, request types.Request) {
+	statusStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RequestByStatusKeyPrefix))
+	statusStore.Delete(types.RequestByStatusKey(request.LaunchID, request.Status, request.RequestID))
+
+	creatorStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RequestByCreatorKeyPrefix))
+	creatorStore.Delete(types.RequestByCreatorKey(request.Creator, request.LaunchID, request.RequestID))
+
+	k.removeRequestExpiration(ctx, request)
+}
+
+// GetRequestsByStatus returns the requests of a chain with a specific status
+func (k Keeper) GetRequestsByStatus(ctx sdk.Context, launchID uint64, status types.Request_Status) []types.Request {
+	return k.getIndexedRequests(
+		ctx,
+		types.RequestByStatusKeyPrefix,
+		types.RequestByStatusPoolKey(launchID, status),
+	)
+}
+
+// GetRequestsByCreator returns the requests sent by a creator
+func (k Keeper) GetRequestsByCreator(ctx sdk.Context, creator string) []types.Request {
+	return k.getIndexedRequests(
+		ctx,
+		types.RequestByCreatorKeyPrefix,
+		types.RequestByCreatorPoolKey(creator),
+	)
+}
+
+// getIndexedRequests returns the requests referenced by the entries of an index with the provided prefix
+func (k Keeper) getIndexedRequests(ctx sdk.Context, indexPrefix string, keyPrefix []byte) (list []types.Request) {
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(indexPrefix))
+	requestStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RequestKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(indexStore, keyPrefix)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Request
+		k.cdc.MustUnmarshal(requestStore.Get(iterator.Value()), &val)
+		list = append(list, val)
+	}
+
+	return
+}
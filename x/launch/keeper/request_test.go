@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"math"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -89,6 +90,67 @@ func TestRequestGetAll(t *testing.T) {
 	})
 }
 
+func TestRequestGetInRange(t *testing.T) {
+	ctx, tk, _ := testkeeper.NewTestSetup(t)
+	items := createNRequest(tk.LaunchKeeper, ctx, 10)
+	otherChainRequest := sample.Request(r, 1, sample.Address(r))
+	tk.LaunchKeeper.AppendRequest(ctx, otherChainRequest)
+
+	t.Run("should get the requests in range", func(t *testing.T) {
+		require.ElementsMatch(t, items[2:5], tk.LaunchKeeper.GetRequestsInRange(ctx, 0, 3, 5))
+	})
+
+	t.Run("should get a single request", func(t *testing.T) {
+		require.ElementsMatch(t, items[4:5], tk.LaunchKeeper.GetRequestsInRange(ctx, 0, 5, 5))
+	})
+
+	t.Run("should get all requests of the chain with an unbounded range", func(t *testing.T) {
+		require.ElementsMatch(t, items, tk.LaunchKeeper.GetRequestsInRange(ctx, 0, 0, math.MaxUint64))
+	})
+
+	t.Run("should get no request for a range without requests", func(t *testing.T) {
+		require.Empty(t, tk.LaunchKeeper.GetRequestsInRange(ctx, 0, 100, 200))
+	})
+}
+
+func TestRequestIndexes(t *testing.T) {
+	ctx, tk, _ := testkeeper.NewTestSetup(t)
+	launchID := uint64(1)
+	creator := sample.Address(r)
+
+	requests := make([]types.Request, 3)
+	for i := range requests {
+		requests[i] = sample.RequestWithContentAndCreator(r, launchID, sample.GenesisAccountContent(r, launchID, creator), creator)
+		requests[i].RequestID = tk.LaunchKeeper.AppendRequest(ctx, requests[i])
+	}
+
+	t.Run("should index requests by status and creator", func(t *testing.T) {
+		require.ElementsMatch(t, requests, tk.LaunchKeeper.GetRequestsByStatus(ctx, launchID, types.Request_PENDING))
+		require.Empty(t, tk.LaunchKeeper.GetRequestsByStatus(ctx, launchID, types.Request_APPROVED))
+		require.ElementsMatch(t, requests, tk.LaunchKeeper.GetRequestsByCreator(ctx, creator))
+		require.Empty(t, tk.LaunchKeeper.GetRequestsByCreator(ctx, sample.Address(r)))
+	})
+
+	t.Run("should update the status index when the status changes", func(t *testing.T) {
+		requests[0].Status = types.Request_APPROVED
+		tk.LaunchKeeper.SetRequest(ctx, requests[0])
+
+		require.ElementsMatch(t, requests[1:], tk.LaunchKeeper.GetRequestsByStatus(ctx, launchID, types.Request_PENDING))
+		require.ElementsMatch(t, requests[:1], tk.LaunchKeeper.GetRequestsByStatus(ctx, launchID, types.Request_APPROVED))
+		require.ElementsMatch(t, requests, tk.LaunchKeeper.GetRequestsByCreator(ctx, creator))
+	})
+
+	t.Run("should remove the request from the indexes", func(t *testing.T) {
+		tk.LaunchKeeper.RemoveRequest(ctx, launchID, requests[1].RequestID)
+
+		require.ElementsMatch(t, requests[2:], tk.LaunchKeeper.GetRequestsByStatus(ctx, launchID, types.Request_PENDING))
+		require.ElementsMatch(t,
+			[]types.Request{requests[0], requests[2]},
+			tk.LaunchKeeper.GetRequestsByCreator(ctx, creator),
+		)
+	})
+}
+
 func TestRequestCounter(t *testing.T) {
 	ctx, tk, _ := testkeeper.NewTestSetup(t)
 	items := createNRequest(tk.LaunchKeeper, ctx, 10)
@@ -180,12 +242,29 @@ func TestApplyRequest(t *testing.T) {
 		launchID       = uint64(10)
 		contents       = sample.AllRequestContents(r, launchID, genesisAcc, vestingAcc, validatorAcc)
 		invalidContent = types.NewGenesisAccount(launchID, "", sdk.NewCoins())
+
+		continuousVestingContent = types.NewVestingAccount(
+			launchID,
+			sample.Address(r),
+			sample.ContinuousVestingOptions(r),
+		)
+		periodicVestingContent = types.NewVestingAccount(
+			launchID,
+			sample.Address(r),
+			sample.PeriodicVestingOptions(r),
+		)
+		updatedAcc               = sample.Address(r)
+		updatedVestingOptions    = sample.ContinuousVestingOptions(r)
+		updateToVestingContent   = types.NewGenesisAccountUpdate(launchID, updatedAcc, nil, &updatedVestingOptions)
+		updateToGenesisContent   = types.NewGenesisAccountUpdate(launchID, updatedAcc, sample.Coins(r), nil)
+		updateNotFoundAccContent = sample.GenesisAccountUpdateContent(r, launchID, sample.Address(r))
 	)
 
 	coord.CoordinatorID = coordID
 	tk.ProfileKeeper.SetCoordinator(ctx, coord)
 	chain := sample.Chain(r, launchID, coordID)
 	tk.LaunchKeeper.SetChain(ctx, chain)
+	tk.LaunchKeeper.SetGenesisAccount(ctx, sample.GenesisAccount(r, launchID, updatedAcc))
 
 	tests := []struct {
 		name    string
@@ -219,6 +298,14 @@ func TestApplyRequest(t *testing.T) {
 			request: sample.RequestWithContent(r, launchID, contents[2]),
 			wantErr: true,
 		},
+		{
+			name:    "should allow applying VestingAccount content with continuous vesting",
+			request: sample.RequestWithContent(r, launchID, continuousVestingContent),
+		},
+		{
+			name:    "should allow applying VestingAccount content with periodic vesting",
+			request: sample.RequestWithContent(r, launchID, periodicVestingContent),
+		},
 		{
 			name:    "should allow applying vesting AccountRemoval content",
 			request: sample.RequestWithContent(r, launchID, contents[3]),
@@ -246,6 +333,19 @@ func TestApplyRequest(t *testing.T) {
 			request: sample.RequestWithContent(r, launchID, contents[5]),
 			wantErr: true,
 		},
+		{
+			name:    "should allow applying GenesisAccountUpdate content to update a genesis account to a vesting account",
+			request: sample.RequestWithContent(r, launchID, updateToVestingContent),
+		},
+		{
+			name:    "should allow applying GenesisAccountUpdate content to update a vesting account to a genesis account",
+			request: sample.RequestWithContent(r, launchID, updateToGenesisContent),
+		},
+		{
+			name:    "should prevent applying GenesisAccountUpdate content when account not found",
+			request: sample.RequestWithContent(r, launchID, updateNotFoundAccContent),
+			wantErr: true,
+		},
 		{
 			name:    "should prevent applying invalid request content",
 			request: sample.RequestWithContent(r, launchID, invalidContent),
@@ -275,14 +375,30 @@ func TestApplyRequest(t *testing.T) {
 				require.True(t, found, "genesis account not found")
 			case *types.RequestContent_VestingAccount:
 				va := requestContent.VestingAccount
-				_, found := tk.LaunchKeeper.GetVestingAccount(ctx, launchID, va.Address)
+				got, found := tk.LaunchKeeper.GetVestingAccount(ctx, launchID, va.Address)
 				require.True(t, found, "vesting account not found")
+				require.IsType(t, va.VestingOptions.Options, got.VestingOptions.Options)
+				require.Equal(t, chain.AccountBalance, got.VestingOptions.TotalBalance())
 			case *types.RequestContent_AccountRemoval:
 				ar := requestContent.AccountRemoval
 				_, foundGenesis := tk.LaunchKeeper.GetGenesisAccount(ctx, launchID, ar.Address)
 				require.False(t, foundGenesis, "genesis account not removed")
 				_, foundVesting := tk.LaunchKeeper.GetVestingAccount(ctx, launchID, ar.Address)
 				require.False(t, foundVesting, "vesting account not removed")
+			case *types.RequestContent_GenesisAccountUpdate:
+				au := requestContent.GenesisAccountUpdate
+				ga, foundGenesis := tk.LaunchKeeper.GetGenesisAccount(ctx, launchID, au.Address)
+				va, foundVesting := tk.LaunchKeeper.GetVestingAccount(ctx, launchID, au.Address)
+				if au.VestingOptions == nil {
+					require.True(t, foundGenesis, "genesis account not found")
+					require.False(t, foundVesting, "vesting account not removed")
+					require.Equal(t, chain.AccountBalance, ga.Coins)
+				} else {
+					require.False(t, foundGenesis, "genesis account not removed")
+					require.True(t, foundVesting, "vesting account not found")
+					require.IsType(t, au.VestingOptions.Options, va.VestingOptions.Options)
+					require.Equal(t, chain.AccountBalance, va.VestingOptions.TotalBalance())
+				}
 			case *types.RequestContent_GenesisValidator:
 				ga := requestContent.GenesisValidator
 				_, found := tk.LaunchKeeper.GetGenesisValidator(ctx, launchID, ga.Address)
@@ -296,6 +412,59 @@ func TestApplyRequest(t *testing.T) {
 	}
 }
 
+func TestApplyRequestAccountBalanceTooSmall(t *testing.T) {
+	var (
+		coord          = sample.Coordinator(r, sample.Address(r))
+		coordID        = uint64(3)
+		ctx, tk, _     = testkeeper.NewTestSetup(t)
+		launchID       = uint64(10)
+		vestingAcc     = sample.Address(r)
+		updatedAcc     = sample.Address(r)
+		vestingOptions = sample.PeriodicVestingOptions(r)
+	)
+
+	coord.CoordinatorID = coordID
+	tk.ProfileKeeper.SetCoordinator(ctx, coord)
+	chain := sample.Chain(r, launchID, coordID)
+
+	// the account balance can't be split among the periods of the vesting options
+	chain.AccountBalance = sdk.NewCoins(sdk.NewInt64Coin("foo", 1))
+	tk.LaunchKeeper.SetChain(ctx, chain)
+	tk.LaunchKeeper.SetGenesisAccount(ctx, sample.GenesisAccount(r, launchID, updatedAcc))
+
+	for _, tc := range []struct {
+		name    string
+		request types.Request
+	}{
+		{
+			name: "should prevent applying VestingAccount content",
+			request: sample.RequestWithContent(r, launchID,
+				types.NewVestingAccount(launchID, vestingAcc, vestingOptions),
+			),
+		},
+		{
+			name: "should prevent applying GenesisAccountUpdate content",
+			request: sample.RequestWithContent(r, launchID,
+				types.NewGenesisAccountUpdate(launchID, updatedAcc, nil, &vestingOptions),
+			),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := keeper.CheckRequest(ctx, *tk.LaunchKeeper, launchID, tc.request)
+			require.ErrorIs(t, err, types.ErrInvalidVestingOption)
+			err = keeper.ApplyRequest(ctx, *tk.LaunchKeeper, chain, tc.request, coord)
+			require.ErrorIs(t, err, types.ErrInvalidVestingOption)
+		})
+	}
+
+	_, found := tk.LaunchKeeper.GetVestingAccount(ctx, launchID, vestingAcc)
+	require.False(t, found)
+	_, found = tk.LaunchKeeper.GetVestingAccount(ctx, launchID, updatedAcc)
+	require.False(t, found)
+	_, found = tk.LaunchKeeper.GetGenesisAccount(ctx, launchID, updatedAcc)
+	require.True(t, found)
+}
+
 func TestCheckRequest(t *testing.T) {
 	var (
 		coord                           = sample.Coordinator(r, sample.Address(r))
@@ -311,6 +480,9 @@ func TestCheckRequest(t *testing.T) {
 		duplicatedRequestGenesisContent = types.NewGenesisAccount(launchID, duplicatedAcc, sample.Coins(r))
 		duplicatedRequestVestingContent = types.NewVestingAccount(launchID, duplicatedAcc, sample.VestingOptions(r))
 		duplicatedRequestRemovalContent = types.NewAccountRemoval(duplicatedAcc)
+		duplicatedRequestUpdateContent  = types.NewGenesisAccountUpdate(launchID, duplicatedAcc, sample.Coins(r), nil)
+		updatedAcc                      = sample.Address(r)
+		updateContent                   = sample.GenesisAccountUpdateContent(r, launchID, updatedAcc)
 	)
 
 	coord.CoordinatorID = coordID
@@ -330,6 +502,8 @@ func TestCheckRequest(t *testing.T) {
 		VestingOptions: types.VestingOptions{},
 	})
 
+	tk.LaunchKeeper.SetGenesisAccount(ctx, sample.GenesisAccount(r, launchID, updatedAcc))
+
 	tests := []struct {
 		name    string
 		request types.Request
@@ -389,6 +563,20 @@ func TestCheckRequest(t *testing.T) {
 			request: sample.RequestWithContent(r, launchID, contents[5]),
 			err:     types.ErrValidatorNotFound,
 		},
+		{
+			name:    "should validate valid GenesisAccountUpdate content",
+			request: sample.RequestWithContent(r, launchID, updateContent),
+		},
+		{
+			name:    "should prevent validate GenesisAccountUpdate content with no account to update",
+			request: sample.RequestWithContent(r, launchID, contents[6]),
+			err:     types.ErrAccountNotFound,
+		},
+		{
+			name:    "should prevent validate GenesisValidatorUpdate content with no validator to update",
+			request: sample.RequestWithContent(r, launchID, contents[7]),
+			err:     types.ErrValidatorNotFound,
+		},
 		{
 			name:    "should prevent validate request content with invalid parameters",
 			request: sample.RequestWithContent(r, launchID, invalidContent),
@@ -409,6 +597,11 @@ func TestCheckRequest(t *testing.T) {
 			request: sample.RequestWithContent(r, launchID, duplicatedRequestRemovalContent),
 			err:     ignterrors.ErrCritical,
 		},
+		{
+			name:    "should prevent validate with critical error account update request content with genesis and vesting account",
+			request: sample.RequestWithContent(r, launchID, duplicatedRequestUpdateContent),
+			err:     ignterrors.ErrCritical,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"encoding/base64"
+	"fmt"
+	"strings"
 
 	sdkerrors "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -33,30 +35,84 @@ func (k Keeper) CheckValidatorSet(
 		return sdkerrors.Wrap(types.ErrInvalidGenesisChainID, chainID)
 	}
 
+	return k.CheckValidatorSetSelfDelegation(ctx, launchID, validatorSet)
+}
+
+// CheckValidatorSetSelfDelegation checks the validators of the validator set are genesis validators
+// of the chain, up to the tolerated number of non-genesis validators holding less than a third of the
+// voting power, and that they hold the threshold of the total genesis self-delegation
+func (k Keeper) CheckValidatorSetSelfDelegation(
+	ctx sdk.Context,
+	launchID uint64,
+	validatorSet tmtypes.ValidatorSet,
+) error {
 	validators, totalSelfDelegation := k.GetValidatorsAndTotalDelegation(ctx, launchID)
 
-	// all validators must be present in the launch module and
-	// the total amount of self-delegation from the provided validators
-	// must reach at least 2/3 of the total self delegation for the chain
-	valSetSelfDelegation := sdk.ZeroDec()
+	// the validators must be present in the launch module, up to the tolerated number of
+	// validators not present in the genesis, and the total amount of self-delegation from
+	// the provided validators must reach the validator set threshold of the total self delegation for the chain
+	var (
+		valSetSelfDelegation  = sdk.ZeroDec()
+		nonGenesisValidators  uint64
+		nonGenesisVotingPower int64
+		breakdown             = make([]string, 0, len(validatorSet.Validators))
+	)
 	for _, validator := range validatorSet.Validators {
 		consPubKey := base64.StdEncoding.EncodeToString(validator.PubKey.Bytes())
 		launchValidator, found := validators[consPubKey]
 		if !found {
-			return sdkerrors.Wrapf(
-				types.ErrValidatorNotFound,
-				"validator consensus pub key not found: %s",
+			nonGenesisValidators++
+			nonGenesisVotingPower += validator.VotingPower
+			breakdown = append(breakdown, fmt.Sprintf(
+				"%s: not in genesis",
 				validator.PubKey.Address().String(),
-			)
+			))
+			continue
 		}
 		valSetSelfDelegation = valSetSelfDelegation.Add(sdk.NewDecFromInt(launchValidator.SelfDelegation.Amount))
+		breakdown = append(breakdown, fmt.Sprintf(
+			"%s: %s",
+			launchValidator.Address,
+			launchValidator.SelfDelegation.String(),
+		))
+	}
+
+	maxNonGenesisValidators := k.MaxNonGenesisValidators(ctx)
+	if nonGenesisValidators > maxNonGenesisValidators {
+		return sdkerrors.Wrapf(
+			types.ErrValidatorNotFound,
+			"%d validators not found in genesis, maximum tolerated is %d: [%s]",
+			nonGenesisValidators,
+			maxNonGenesisValidators,
+			strings.Join(breakdown, ", "),
+		)
+	}
+
+	// the validators not in genesis must not be able to halt the chain or censor its blocks
+	totalVotingPower := validatorSet.TotalVotingPower()
+	if nonGenesisVotingPower > 0 && nonGenesisVotingPower*3 >= totalVotingPower {
+		return sdkerrors.Wrapf(
+			types.ErrNonGenesisVotingPower,
+			"validators not found in genesis hold %d of the total voting power %d, maximum tolerated is less than a third: [%s]",
+			nonGenesisVotingPower,
+			totalVotingPower,
+			strings.Join(breakdown, ", "),
+		)
 	}
 
-	// check if 2/3 of total self-delegation is reached from the provided validator set
+	// check if the threshold of total self-delegation is reached from the provided validator set
 	// GetTotalSelfDelegation is the sum of all self delegation
-	minSelfDelegation := totalSelfDelegation.Mul(sdk.NewDecWithPrec(6666, 4))
+	threshold := k.ValidatorSetThreshold(ctx)
+	minSelfDelegation := totalSelfDelegation.Mul(threshold)
 	if valSetSelfDelegation.LT(minSelfDelegation) {
-		return sdkerrors.Wrap(types.ErrMinSelfDelegationNotReached, validatorSet.String())
+		return sdkerrors.Wrapf(
+			types.ErrMinSelfDelegationNotReached,
+			"validator set self-delegation %s is lower than %s of the total self-delegation %s: [%s]",
+			valSetSelfDelegation.String(),
+			threshold.String(),
+			totalSelfDelegation.String(),
+			strings.Join(breakdown, ", "),
+		)
 	}
 	return nil
 }
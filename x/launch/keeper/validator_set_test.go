@@ -145,3 +145,128 @@ func TestKeeper_CheckValidatorSet(t *testing.T) {
 		})
 	}
 }
+
+func TestKeeper_CheckValidatorSetWithParams(t *testing.T) {
+	var (
+		ctx, tk, _ = testkeeper.NewTestSetup(t)
+		validators = []crypto.PubKey{sample.PubKey(r), sample.PubKey(r), sample.PubKey(r)}
+		params     = types.DefaultParams()
+	)
+	launchID := tk.LaunchKeeper.AppendChain(ctx, types.Chain{
+		CoordinatorID:   0,
+		LaunchTriggered: true,
+		GenesisChainID:  "spn-1",
+	})
+	for _, validator := range validators {
+		addr := sdk.AccAddress(validator.Address().Bytes())
+		tk.LaunchKeeper.SetGenesisValidator(ctx, types.GenesisValidator{
+			LaunchID:       launchID,
+			Address:        addr.String(),
+			ConsPubKey:     validator.Bytes(),
+			SelfDelegation: sdk.NewCoin("spn", sdkmath.NewInt(1000)),
+		})
+	}
+	genesisValidator := tmtypes.NewValidator(validators[0], 10)
+	nonGenesisValidator := tmtypes.NewValidator(sample.PubKey(r), 1)
+
+	tests := []struct {
+		name                    string
+		validatorSetThreshold   sdk.Dec
+		maxNonGenesisValidators uint64
+		validatorSet            tmtypes.ValidatorSet
+		err                     error
+	}{
+		{
+			name:                  "should prevent validate validator set below the default threshold",
+			validatorSetThreshold: types.DefaultValidatorSetThreshold,
+			validatorSet: tmtypes.ValidatorSet{
+				Validators: []*tmtypes.Validator{genesisValidator},
+			},
+			err: types.ErrMinSelfDelegationNotReached,
+		},
+		{
+			name:                  "should allow validate validator set reaching a lower threshold",
+			validatorSetThreshold: types.MinValidatorSetThreshold,
+			validatorSet: tmtypes.ValidatorSet{
+				Validators: []*tmtypes.Validator{genesisValidator},
+			},
+		},
+		{
+			name:                  "should prevent validate validator set with non genesis validators by default",
+			validatorSetThreshold: types.MinValidatorSetThreshold,
+			validatorSet: tmtypes.ValidatorSet{
+				Validators: []*tmtypes.Validator{genesisValidator, nonGenesisValidator},
+			},
+			err: types.ErrValidatorNotFound,
+		},
+		{
+			name:                    "should allow validate validator set with tolerated non genesis validators",
+			validatorSetThreshold:   types.MinValidatorSetThreshold,
+			maxNonGenesisValidators: 1,
+			validatorSet: tmtypes.ValidatorSet{
+				Validators: []*tmtypes.Validator{genesisValidator, nonGenesisValidator},
+			},
+		},
+		{
+			name:                    "should prevent validate validator set with more non genesis validators than tolerated",
+			validatorSetThreshold:   types.MinValidatorSetThreshold,
+			maxNonGenesisValidators: 1,
+			validatorSet: tmtypes.ValidatorSet{
+				Validators: []*tmtypes.Validator{
+					genesisValidator,
+					nonGenesisValidator,
+					tmtypes.NewValidator(sample.PubKey(r), 1),
+				},
+			},
+			err: types.ErrValidatorNotFound,
+		},
+		{
+			name:                    "should prevent validate validator set with non genesis validators holding a third of the voting power",
+			validatorSetThreshold:   types.MinValidatorSetThreshold,
+			maxNonGenesisValidators: 2,
+			validatorSet: tmtypes.ValidatorSet{
+				Validators: []*tmtypes.Validator{
+					genesisValidator,
+					nonGenesisValidator,
+					tmtypes.NewValidator(sample.PubKey(r), 4),
+				},
+			},
+			err: types.ErrNonGenesisVotingPower,
+		},
+		{
+			name:                    "should allow validate validator set with non genesis validators holding less than a third of the voting power",
+			validatorSetThreshold:   types.MinValidatorSetThreshold,
+			maxNonGenesisValidators: 2,
+			validatorSet: tmtypes.ValidatorSet{
+				Validators: []*tmtypes.Validator{
+					genesisValidator,
+					nonGenesisValidator,
+					tmtypes.NewValidator(sample.PubKey(r), 3),
+				},
+			},
+		},
+		{
+			name:                    "should prevent validate validator set with tolerated non genesis validators below threshold",
+			validatorSetThreshold:   types.DefaultValidatorSetThreshold,
+			maxNonGenesisValidators: 1,
+			validatorSet: tmtypes.ValidatorSet{
+				Validators: []*tmtypes.Validator{genesisValidator, nonGenesisValidator},
+			},
+			err: types.ErrMinSelfDelegationNotReached,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params.ValidatorSetThreshold = tt.validatorSetThreshold
+			params.MaxNonGenesisValidators = tt.maxNonGenesisValidators
+			tk.LaunchKeeper.SetParams(ctx, params)
+
+			err := tk.LaunchKeeper.CheckValidatorSet(ctx, launchID, "spn-1", tt.validatorSet)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package v2
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+// MigrateStore performs in-place store migrations from v1 to v2 of the launch module:
+//   - the request expiration and validator set parameters are set to their default values
+//   - the pending requests expire after the default request expiration from the upgrade time
+//   - the requests are indexed by status, creator and expiration time
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec, paramSpace paramtypes.Subspace) error {
+	migrateParams(ctx, paramSpace)
+	return migrateRequests(ctx, storeKey, cdc)
+}
+
+// migrateParams sets the parameters introduced in v2
+func migrateParams(ctx sdk.Context, paramSpace paramtypes.Subspace) {
+	if !paramSpace.Has(ctx, types.KeyRequestExpiration) {
+		paramSpace.Set(ctx, types.KeyRequestExpiration, types.DefaultRequestExpiration)
+	}
+	if !paramSpace.Has(ctx, types.KeyValidatorSetThreshold) {
+		paramSpace.Set(ctx, types.KeyValidatorSetThreshold, types.DefaultValidatorSetThreshold)
+	}
+	if !paramSpace.Has(ctx, types.KeyMaxNonGenesisValidators) {
+		paramSpace.Set(ctx, types.KeyMaxNonGenesisValidators, types.DefaultMaxNonGenesisValidators)
+	}
+}
+
+// migrateRequests sets the expiration time of the pending requests and indexes all the requests
+func migrateRequests(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+	requestStore := prefix.NewStore(store, types.KeyPrefix(types.RequestKeyPrefix))
+	statusStore := prefix.NewStore(store, types.KeyPrefix(types.RequestByStatusKeyPrefix))
+	creatorStore := prefix.NewStore(store, types.KeyPrefix(types.RequestByCreatorKeyPrefix))
+	expirationStore := prefix.NewStore(store, types.KeyPrefix(types.RequestExpirationKeyPrefix))
+	expiresAt := ctx.BlockTime().Add(types.DefaultRequestExpiration).Unix()
+
+	// the requests are collected first since the request store is updated during the migration
+	var requests []types.Request
+	iterator := requestStore.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		var request types.Request
+		if err := cdc.Unmarshal(iterator.Value(), &request); err != nil {
+			iterator.Close()
+			return err
+		}
+		requests = append(requests, request)
+	}
+	iterator.Close()
+
+	for _, request := range requests {
+		requestKey := types.RequestKey(request.LaunchID, request.RequestID)
+
+		if request.Status == types.Request_PENDING && request.ExpiresAt == 0 {
+			request.ExpiresAt = expiresAt
+			bz, err := cdc.Marshal(&request)
+			if err != nil {
+				return err
+			}
+			requestStore.Set(requestKey, bz)
+			expirationStore.Set(
+				types.RequestExpirationKey(request.ExpiresAt, request.LaunchID, request.RequestID),
+				requestKey,
+			)
+		}
+
+		statusStore.Set(types.RequestByStatusKey(request.LaunchID, request.Status, request.RequestID), requestKey)
+		creatorStore.Set(types.RequestByCreatorKey(request.Creator, request.LaunchID, request.RequestID), requestKey)
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package v2_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmdb "github.com/tendermint/tm-db"
+
+	"github.com/tendermint/spn/testutil/sample"
+	v2 "github.com/tendermint/spn/x/launch/migrations/v2"
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	var (
+		r          = sample.Rand()
+		cdc        = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+		storeKey   = sdk.NewKVStoreKey(types.StoreKey)
+		paramsKey  = sdk.NewKVStoreKey(paramtypes.StoreKey)
+		paramsTKey = sdk.NewTransientStoreKey(paramtypes.TStoreKey)
+		blockTime  = time.Unix(1_000_000, 0)
+	)
+
+	db := tmdb.NewMemDB()
+	cms := store.NewCommitMultiStore(db)
+	cms.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	cms.MountStoreWithDB(paramsKey, storetypes.StoreTypeIAVL, db)
+	cms.MountStoreWithDB(paramsTKey, storetypes.StoreTypeTransient, db)
+	require.NoError(t, cms.LoadLatestVersion())
+	ctx := sdk.NewContext(cms, tmproto.Header{Time: blockTime}, false, log.NewNopLogger())
+
+	paramSpace := paramtypes.NewSubspace(cdc, codec.NewLegacyAmino(), paramsKey, paramsTKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+
+	// v1 fixture: the request expiration and validator set params are not set and the requests are not indexed
+	params := types.DefaultParams()
+	paramSpace.Set(ctx, types.KeyLaunchTimeRange, params.LaunchTimeRange)
+	paramSpace.Set(ctx, types.KeyRevertDelay, params.RevertDelay)
+	paramSpace.Set(ctx, types.KeyChainCreationFee, params.ChainCreationFee)
+
+	requestStore := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.RequestKeyPrefix))
+	requests := []types.Request{
+		sample.Request(r, 0, sample.Address(r)),
+		sample.Request(r, 0, sample.Address(r)),
+		sample.Request(r, 1, sample.Address(r)),
+	}
+	requests[0].RequestID = 0
+	requests[1].RequestID = 1
+	requests[1].Status = types.Request_APPROVED
+	requests[2].RequestID = 0
+	for i := range requests {
+		requests[i].ExpiresAt = 0
+		requestStore.Set(types.RequestKey(requests[i].LaunchID, requests[i].RequestID), cdc.MustMarshal(&requests[i]))
+	}
+
+	require.NoError(t, v2.MigrateStore(ctx, storeKey, cdc, paramSpace))
+
+	t.Run("should set the request expiration param", func(t *testing.T) {
+		var requestExpiration time.Duration
+		paramSpace.Get(ctx, types.KeyRequestExpiration, &requestExpiration)
+		require.Equal(t, types.DefaultRequestExpiration, requestExpiration)
+	})
+
+	t.Run("should set the validator set params", func(t *testing.T) {
+		var validatorSetThreshold sdk.Dec
+		paramSpace.Get(ctx, types.KeyValidatorSetThreshold, &validatorSetThreshold)
+		require.True(t, types.DefaultValidatorSetThreshold.Equal(validatorSetThreshold))
+
+		var maxNonGenesisValidators uint64
+		paramSpace.Get(ctx, types.KeyMaxNonGenesisValidators, &maxNonGenesisValidators)
+		require.Equal(t, types.DefaultMaxNonGenesisValidators, maxNonGenesisValidators)
+	})
+
+	t.Run("should set the expiration time of the pending requests", func(t *testing.T) {
+		expiresAt := blockTime.Add(types.DefaultRequestExpiration).Unix()
+		expirationStore := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.RequestExpirationKeyPrefix))
+
+		for _, request := range requests {
+			var got types.Request
+			cdc.MustUnmarshal(requestStore.Get(types.RequestKey(request.LaunchID, request.RequestID)), &got)
+
+			if request.Status == types.Request_PENDING {
+				require.EqualValues(t, expiresAt, got.ExpiresAt)
+				require.True(t, expirationStore.Has(types.RequestExpirationKey(expiresAt, request.LaunchID, request.RequestID)))
+			} else {
+				require.Zero(t, got.ExpiresAt)
+				require.False(t, expirationStore.Has(types.RequestExpirationKey(expiresAt, request.LaunchID, request.RequestID)))
+			}
+		}
+	})
+
+	t.Run("should index the requests by status and creator", func(t *testing.T) {
+		statusStore := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.RequestByStatusKeyPrefix))
+		creatorStore := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.RequestByCreatorKeyPrefix))
+
+		for _, request := range requests {
+			requestKey := types.RequestKey(request.LaunchID, request.RequestID)
+			require.Equal(t, requestKey, statusStore.Get(types.RequestByStatusKey(request.LaunchID, request.Status, request.RequestID)))
+			require.Equal(t, requestKey, creatorStore.Get(types.RequestByCreatorKey(request.Creator, request.LaunchID, request.RequestID)))
+		}
+	})
+}
@@ -18,6 +18,7 @@ const (
 	defaultWeightMsgEditChain                int = 20
 	defaultWeightMsgRequestAddGenesisAccount int = 50
 	defaultWeightMsgRequestAddVestingAccount int = 50
+	defaultWeightMsgRequestUpdateAccount     int = 20
 	defaultWeightMsgRequestRemoveAccount     int = 15
 	defaultWeightMsgRequestAddValidator      int = 50
 	defaultWeightMsgRequestRemoveValidator   int = 15
@@ -30,6 +31,7 @@ const (
 	opWeightMsgEditChain                = "op_weight_msg_edit_chain"
 	opWeightMsgRequestAddGenesisAccount = "op_weight_msg_request_add_genesis_account"
 	opWeightMsgRequestAddVestingAccount = "op_weight_msg_request_add_vesting_account"
+	opWeightMsgRequestUpdateAccount     = "op_weight_msg_request_update_account"
 	opWeightMsgRequestRemoveAccount     = "op_weight_msg_request_remove_account"
 	opWeightMsgRequestAddValidator      = "op_weight_msg_request_add_validator"
 	opWeightMsgRequestRemoveValidator   = "op_weight_msg_request_remove_validator"
@@ -66,6 +68,15 @@ func (am AppModule) RandomizedParams(r *rand.Rand) []simtypes.ParamChange {
 		simulation.NewSimParamChange(types.ModuleName, string(types.KeyChainCreationFee), func(r *rand.Rand) string {
 			return string(types.Amino.MustMarshalJSON(launchParams.ChainCreationFee))
 		}),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyRequestExpiration), func(r *rand.Rand) string {
+			return string(types.Amino.MustMarshalJSON(launchParams.RequestExpiration))
+		}),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyValidatorSetThreshold), func(r *rand.Rand) string {
+			return string(types.Amino.MustMarshalJSON(launchParams.ValidatorSetThreshold))
+		}),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyMaxNonGenesisValidators), func(r *rand.Rand) string {
+			return string(types.Amino.MustMarshalJSON(launchParams.MaxNonGenesisValidators))
+		}),
 	}
 }
 
@@ -80,6 +91,7 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		weightMsgEditChain                int
 		weightMsgRequestAddGenesisAccount int
 		weightMsgRequestAddVestingAccount int
+		weightMsgRequestUpdateAccount     int
 		weightMsgRequestRemoveAccount     int
 		weightMsgRequestAddValidator      int
 		weightMsgRequestRemoveValidator   int
@@ -122,6 +134,11 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 			weightMsgRequestAddVestingAccount = defaultWeightMsgRequestAddVestingAccount
 		},
 	)
+	appParams.GetOrGenerate(cdc, opWeightMsgRequestUpdateAccount, &weightMsgRequestUpdateAccount, nil,
+		func(_ *rand.Rand) {
+			weightMsgRequestUpdateAccount = defaultWeightMsgRequestUpdateAccount
+		},
+	)
 	appParams.GetOrGenerate(cdc, opWeightMsgRequestRemoveAccount, &weightMsgRequestRemoveAccount, nil,
 		func(_ *rand.Rand) {
 			weightMsgRequestRemoveAccount = defaultWeightMsgRequestRemoveAccount
@@ -170,6 +187,10 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 			weightMsgRequestAddVestingAccount,
 			launchsim.SimulateMsgRequestAddVestingAccount(am.accountKeeper, am.bankKeeper, am.keeper),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgRequestUpdateAccount,
+			launchsim.SimulateMsgRequestUpdateAccount(am.accountKeeper, am.bankKeeper, am.keeper),
+		),
 		simulation.NewWeightedOperation(
 			weightMsgRequestRemoveAccount,
 			launchsim.SimulateMsgRequestRemoveAccount(am.accountKeeper, am.bankKeeper, am.keeper),
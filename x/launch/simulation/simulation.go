@@ -243,6 +243,89 @@ func SimulateMsgRequestAddVestingAccount(ak types.AccountKeeper, bk types.BankKe
 	}
 }
 
+// SimulateMsgRequestUpdateAccount simulates a MsgRequestUpdateAccount message
+func SimulateMsgRequestUpdateAccount(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		type accChain struct {
+			address  string
+			launchID uint64
+		}
+
+		// build list of genesis and vesting accounts
+		accChainList := make([]accChain, 0)
+		for _, acc := range k.GetAllGenesisAccount(ctx) {
+			accChainList = append(accChainList, accChain{
+				address:  acc.Address,
+				launchID: acc.LaunchID,
+			})
+		}
+		for _, acc := range k.GetAllVestingAccount(ctx) {
+			accChainList = append(accChainList, accChain{
+				address:  acc.Address,
+				launchID: acc.LaunchID,
+			})
+		}
+
+		// add entropy
+		r.Shuffle(len(accChainList), func(i, j int) {
+			accChainList[i], accChainList[j] = accChainList[j], accChainList[i]
+		})
+
+		// select an account owned by a simulation account on a chain that can be updated
+		var (
+			simAccount simtypes.Account
+			accAddr    string
+			accChainID uint64
+		)
+		found := false
+		for _, accChain := range accChainList {
+			chain, chainFound := k.GetChain(ctx, accChain.launchID)
+			if !chainFound || chain.LaunchTriggered || chain.IsMainnet || chain.Archived {
+				continue
+			}
+			coord, coordFound := k.GetProfileKeeper().GetCoordinator(ctx, chain.CoordinatorID)
+			if !coordFound || !coord.Active {
+				continue
+			}
+			var err error
+			simAccount, err = FindAccount(accs, accChain.address)
+			if err != nil {
+				continue
+			}
+			accAddr = accChain.address
+			accChainID = accChain.launchID
+			found = true
+			break
+		}
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRequestUpdateAccount, "account to update not found"), nil, nil
+		}
+
+		msg := sample.MsgRequestUpdateAccount(r,
+			simAccount.Address.String(),
+			accAddr,
+			accChainID,
+		)
+		txCtx := sdksimulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             &msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx, helpers.DefaultGenTxGas)
+	}
+}
+
 // SimulateMsgRequestRemoveAccount simulates a MsgRequestRemoveAccount message
 func SimulateMsgRequestRemoveAccount(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
 	return func(
@@ -167,12 +167,27 @@ func FindRandomRequest(
 			); !found {
 				continue
 			}
+		case *types.RequestContent_GenesisValidatorUpdate:
+			// if is validator update, check if the validator exist
+			if _, found := k.GetGenesisValidator(
+				ctx,
+				chain.LaunchID,
+				content.GenesisValidatorUpdate.Address,
+			); !found {
+				continue
+			}
 		case *types.RequestContent_AccountRemoval:
 			// if is account removal, check if account exist
 			found, err := keeper.CheckAccount(ctx, k, chain.LaunchID, content.AccountRemoval.Address)
 			if err != nil || !found {
 				continue
 			}
+		case *types.RequestContent_GenesisAccountUpdate:
+			// if is account update, check if account exist
+			found, err := keeper.CheckAccount(ctx, k, chain.LaunchID, content.GenesisAccountUpdate.Address)
+			if err != nil || !found {
+				continue
+			}
 		}
 
 		return req, true
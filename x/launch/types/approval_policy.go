@@ -0,0 +1,104 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewApprovalPolicy returns a new approval policy for a chain
+func NewApprovalPolicy(
+	launchID uint64,
+	maxAccountCoins sdk.Coins,
+	minSelfDelegation *sdk.Coin,
+	allowlist []string,
+) ApprovalPolicy {
+	return ApprovalPolicy{
+		LaunchID:          launchID,
+		MaxAccountCoins:   maxAccountCoins,
+		MinSelfDelegation: minSelfDelegation,
+		Allowlist:         allowlist,
+	}
+}
+
+// IsEmpty returns true if the approval policy contains no rule
+func (m ApprovalPolicy) IsEmpty() bool {
+	return m.MaxAccountCoins.Empty() && m.MinSelfDelegation == nil && len(m.Allowlist) == 0
+}
+
+// Validate checks the approval policy is valid
+func (m ApprovalPolicy) Validate() error {
+	if !m.MaxAccountCoins.IsValid() {
+		return fmt.Errorf("invalid max account coins: %s", m.MaxAccountCoins.String())
+	}
+
+	if m.MinSelfDelegation != nil {
+		if !m.MinSelfDelegation.IsValid() || m.MinSelfDelegation.IsZero() {
+			return fmt.Errorf("invalid min self delegation: %s", m.MinSelfDelegation.String())
+		}
+	}
+
+	allowed := make(map[string]struct{})
+	for _, address := range m.Allowlist {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return fmt.Errorf("invalid allowlist address %s: %s", address, err.Error())
+		}
+		if _, ok := allowed[address]; ok {
+			return errors.New("duplicated address in allowlist: " + address)
+		}
+		allowed[address] = struct{}{}
+	}
+
+	return nil
+}
+
+// IsAllowed returns true if the address is in the allowlist of the policy
+func (m ApprovalPolicy) IsAllowed(address string) bool {
+	for _, allowed := range m.Allowlist {
+		if allowed == address {
+			return true
+		}
+	}
+	return false
+}
+
+// Approves returns true if the policy approves a request with the provided content created by creator
+func (m ApprovalPolicy) Approves(creator string, content RequestContent) bool {
+	if m.IsAllowed(creator) {
+		return true
+	}
+
+	switch c := content.Content.(type) {
+	case *RequestContent_GenesisAccount:
+		return m.approvesAccountCoins(c.GenesisAccount.Coins)
+	case *RequestContent_VestingAccount:
+		return m.approvesAccountCoins(c.VestingAccount.VestingOptions.TotalBalance())
+	case *RequestContent_GenesisAccountUpdate:
+		if c.GenesisAccountUpdate.VestingOptions != nil {
+			return m.approvesAccountCoins(c.GenesisAccountUpdate.VestingOptions.TotalBalance())
+		}
+		return m.approvesAccountCoins(c.GenesisAccountUpdate.Coins)
+	case *RequestContent_GenesisValidator:
+		return m.approvesSelfDelegation(c.GenesisValidator.SelfDelegation)
+	case *RequestContent_GenesisValidatorUpdate:
+		if c.GenesisValidatorUpdate.SelfDelegation == nil {
+			return false
+		}
+		return m.approvesSelfDelegation(*c.GenesisValidatorUpdate.SelfDelegation)
+	default:
+		return false
+	}
+}
+
+// approvesAccountCoins returns true if the account balance is below the maximum of the policy
+func (m ApprovalPolicy) approvesAccountCoins(coins sdk.Coins) bool {
+	return !m.MaxAccountCoins.Empty() && coins.IsAllLTE(m.MaxAccountCoins)
+}
+
+// approvesSelfDelegation returns true if the self delegation is above the minimum of the policy
+func (m ApprovalPolicy) approvesSelfDelegation(selfDelegation sdk.Coin) bool {
+	return m.MinSelfDelegation != nil &&
+		selfDelegation.Denom == m.MinSelfDelegation.Denom &&
+		selfDelegation.Amount.GTE(m.MinSelfDelegation.Amount)
+}
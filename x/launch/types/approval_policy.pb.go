@@ -0,0 +1,496 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: launch/approval_policy.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ApprovalPolicy defines the rules to automatically approve the requests of a chain
+type ApprovalPolicy struct {
+	LaunchID uint64 `protobuf:"varint,1,opt,name=launchID,proto3" json:"launchID,omitempty"`
+	// maxAccountCoins approves the account requests with a balance lower or equal, disabled if empty
+	MaxAccountCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=maxAccountCoins,proto3,casttype=github.com/cosmos/cosmos-sdk/types.Coin,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"maxAccountCoins"`
+	// minSelfDelegation approves the validator requests with a self delegation greater or equal, disabled if not set
+	MinSelfDelegation *types.Coin `protobuf:"bytes,3,opt,name=minSelfDelegation,proto3" json:"minSelfDelegation,omitempty"`
+	// allowlist approves all the requests created by the listed addresses
+	Allowlist []string `protobuf:"bytes,4,rep,name=allowlist,proto3" json:"allowlist,omitempty"`
+}
+
+func (m *ApprovalPolicy) Reset()         { *m = ApprovalPolicy{} }
+func (m *ApprovalPolicy) String() string { return proto.CompactTextString(m) }
+func (*ApprovalPolicy) ProtoMessage()    {}
+func (*ApprovalPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b148ada0b9422f88, []int{0}
+}
+func (m *ApprovalPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApprovalPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApprovalPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApprovalPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApprovalPolicy.Merge(m, src)
+}
+func (m *ApprovalPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApprovalPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApprovalPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApprovalPolicy proto.InternalMessageInfo
+
+func (m *ApprovalPolicy) GetLaunchID() uint64 {
+	if m != nil {
+		return m.LaunchID
+	}
+	return 0
+}
+
+func (m *ApprovalPolicy) GetMaxAccountCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MaxAccountCoins
+	}
+	return nil
+}
+
+func (m *ApprovalPolicy) GetMinSelfDelegation() *types.Coin {
+	if m != nil {
+		return m.MinSelfDelegation
+	}
+	return nil
+}
+
+func (m *ApprovalPolicy) GetAllowlist() []string {
+	if m != nil {
+		return m.Allowlist
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ApprovalPolicy)(nil), "tendermint.spn.launch.ApprovalPolicy")
+}
+
+func init() { proto.RegisterFile("launch/approval_policy.proto", fileDescriptor_b148ada0b9422f88) }
+
+var fileDescriptor_b148ada0b9422f88 = []byte{
+	// 356 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xc1, 0x4a, 0xf3, 0x40,
+	0x10, 0x80, 0x93, 0xb6, 0xfc, 0xfc, 0xcd, 0x0f, 0xbf, 0x18, 0x2a, 0xa4, 0x45, 0xd2, 0xe0, 0xc5,
+	0x5c, 0x9a, 0xa5, 0x0a, 0xde, 0x1b, 0x0b, 0xe2, 0x4d, 0xd2, 0x9b, 0x1e, 0xca, 0x26, 0x59, 0xd3,
+	0xc5, 0xcd, 0x4e, 0xc8, 0x6e, 0x6b, 0xfb, 0x16, 0x3e, 0x82, 0x67, 0xcf, 0x3e, 0x44, 0x8f, 0xc5,
+	0x93, 0xa7, 0x2a, 0xed, 0x5b, 0x78, 0x92, 0x26, 0x8b, 0x15, 0x05, 0xf1, 0xb4, 0x3b, 0xcc, 0x37,
+	0xb3, 0xdf, 0x30, 0x6b, 0xec, 0x33, 0x3c, 0xe6, 0xd1, 0x08, 0xe1, 0x2c, 0xcb, 0x61, 0x82, 0xd9,
+	0x30, 0x03, 0x46, 0xa3, 0x99, 0x97, 0xe5, 0x20, 0xc1, 0xdc, 0x93, 0x84, 0xc7, 0x24, 0x4f, 0x29,
+	0x97, 0x9e, 0xc8, 0xb8, 0x57, 0xc2, 0xad, 0x46, 0x02, 0x09, 0x14, 0x04, 0xda, 0xdc, 0x4a, 0xb8,
+	0x65, 0x47, 0x20, 0x52, 0x10, 0x28, 0xc4, 0x82, 0xa0, 0x49, 0x37, 0x24, 0x12, 0x77, 0x51, 0x04,
+	0x94, 0xab, 0x7c, 0xb3, 0xcc, 0x0f, 0xcb, 0xc2, 0x32, 0x28, 0x53, 0x07, 0x8b, 0x8a, 0xf1, 0xbf,
+	0xa7, 0x0c, 0x2e, 0x0a, 0x01, 0xb3, 0x65, 0xfc, 0x2d, 0x5f, 0x3b, 0xef, 0x5b, 0xba, 0xa3, 0xbb,
+	0xb5, 0xe0, 0x23, 0x36, 0xef, 0x75, 0x63, 0x27, 0xc5, 0xd3, 0x5e, 0x14, 0xc1, 0x98, 0xcb, 0x53,
+	0xa0, 0x5c, 0x58, 0x15, 0xa7, 0xea, 0xfe, 0x3b, 0x6a, 0x7a, 0xaa, 0xef, 0x46, 0xc2, 0x53, 0x12,
+	0xde, 0x86, 0xf0, 0xaf, 0xe6, 0xcb, 0xb6, 0xf6, 0xb6, 0x6c, 0x1f, 0x26, 0x54, 0x8e, 0xc6, 0xa1,
+	0x17, 0x41, 0xaa, 0x24, 0xd4, 0xd1, 0x11, 0xf1, 0x0d, 0x92, 0xb3, 0x8c, 0x88, 0xa2, 0xe0, 0xe1,
+	0xa5, 0xed, 0xfe, 0x12, 0x15, 0xc1, 0x57, 0x1d, 0xf3, 0xcc, 0xd8, 0x4d, 0x29, 0x1f, 0x10, 0x76,
+	0xdd, 0x27, 0x8c, 0x24, 0x58, 0x52, 0xe0, 0x56, 0xd5, 0xd1, 0x7f, 0x74, 0x0c, 0xbe, 0xd7, 0x98,
+	0x27, 0x46, 0x1d, 0x33, 0x06, 0xb7, 0x8c, 0x0a, 0x69, 0xd5, 0x9c, 0xaa, 0x5b, 0xf7, 0xad, 0xa7,
+	0xc7, 0x4e, 0x43, 0xf5, 0xe8, 0xc5, 0x71, 0x4e, 0x84, 0x18, 0xc8, 0x9c, 0xf2, 0x24, 0xd8, 0xa2,
+	0xbe, 0x3f, 0x5f, 0xd9, 0xfa, 0x62, 0x65, 0xeb, 0xaf, 0x2b, 0x5b, 0xbf, 0x5b, 0xdb, 0xda, 0x62,
+	0x6d, 0x6b, 0xcf, 0x6b, 0x5b, 0xbb, 0xfc, 0x3c, 0xd5, 0x76, 0xbf, 0x48, 0x64, 0x1c, 0x4d, 0x91,
+	0xfa, 0x0e, 0xc5, 0x6c, 0xe1, 0x9f, 0x62, 0x3b, 0xc7, 0xef, 0x03, 0x00, 0x40, 0x16, 0x98, 0xbe,
+	0x25, 0x02, 0x00, 0x00,
+}
+
+func (m *ApprovalPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApprovalPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApprovalPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Allowlist) > 0 {
+		for iNdEx := len(m.Allowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Allowlist[iNdEx])
+			copy(dAtA[i:], m.Allowlist[iNdEx])
+			i = encodeVarintApprovalPolicy(dAtA, i, uint64(len(m.Allowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.MinSelfDelegation != nil {
+		{
+			size, err := m.MinSelfDelegation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApprovalPolicy(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MaxAccountCoins) > 0 {
+		for iNdEx := len(m.MaxAccountCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxAccountCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApprovalPolicy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.LaunchID != 0 {
+		i = encodeVarintApprovalPolicy(dAtA, i, uint64(m.LaunchID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintApprovalPolicy(dAtA []byte, offset int, v uint64) int {
+	offset -= sovApprovalPolicy(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ApprovalPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LaunchID != 0 {
+		n += 1 + sovApprovalPolicy(uint64(m.LaunchID))
+	}
+	if len(m.MaxAccountCoins) > 0 {
+		for _, e := range m.MaxAccountCoins {
+			l = e.Size()
+			n += 1 + l + sovApprovalPolicy(uint64(l))
+		}
+	}
+	if m.MinSelfDelegation != nil {
+		l = m.MinSelfDelegation.Size()
+		n += 1 + l + sovApprovalPolicy(uint64(l))
+	}
+	if len(m.Allowlist) > 0 {
+		for _, s := range m.Allowlist {
+			l = len(s)
+			n += 1 + l + sovApprovalPolicy(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovApprovalPolicy(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozApprovalPolicy(x uint64) (n int) {
+	return sovApprovalPolicy(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ApprovalPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApprovalPolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApprovalPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApprovalPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LaunchID", wireType)
+			}
+			m.LaunchID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApprovalPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LaunchID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAccountCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApprovalPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApprovalPolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApprovalPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxAccountCoins = append(m.MaxAccountCoins, github_com_cosmos_cosmos_sdk_types.Coin{})
+			if err := m.MaxAccountCoins[len(m.MaxAccountCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApprovalPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApprovalPolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApprovalPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MinSelfDelegation == nil {
+				m.MinSelfDelegation = &types.Coin{}
+			}
+			if err := m.MinSelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApprovalPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApprovalPolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApprovalPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowlist = append(m.Allowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApprovalPolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApprovalPolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipApprovalPolicy(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowApprovalPolicy
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowApprovalPolicy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowApprovalPolicy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthApprovalPolicy
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupApprovalPolicy
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthApprovalPolicy
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthApprovalPolicy        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowApprovalPolicy          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupApprovalPolicy = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,208 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	tc "github.com/tendermint/spn/testutil/constructor"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+func TestApprovalPolicy_Validate(t *testing.T) {
+	addr := sample.Address(r)
+	zeroCoin := sdk.NewInt64Coin("stake", 0)
+
+	for _, tt := range []struct {
+		name   string
+		policy types.ApprovalPolicy
+		valid  bool
+	}{
+		{
+			name:   "should validate valid approval policy",
+			policy: sample.ApprovalPolicy(r, 0),
+			valid:  true,
+		},
+		{
+			name:   "should validate empty approval policy",
+			policy: types.NewApprovalPolicy(0, nil, nil, nil),
+			valid:  true,
+		},
+		{
+			name: "should prevent validate approval policy with invalid max account coins",
+			policy: types.NewApprovalPolicy(0, sdk.Coins{
+				sdk.Coin{Denom: "foo", Amount: sdk.NewInt(-1)},
+			}, nil, nil),
+			valid: false,
+		},
+		{
+			name:   "should prevent validate approval policy with zero min self delegation",
+			policy: types.NewApprovalPolicy(0, nil, &zeroCoin, nil),
+			valid:  false,
+		},
+		{
+			name:   "should prevent validate approval policy with invalid allowlist address",
+			policy: types.NewApprovalPolicy(0, nil, nil, []string{"invalid"}),
+			valid:  false,
+		},
+		{
+			name:   "should prevent validate approval policy with duplicated allowlist address",
+			policy: types.NewApprovalPolicy(0, nil, nil, []string{addr, addr}),
+			valid:  false,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.policy.Validate()
+			if !tt.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestApprovalPolicy_Approves(t *testing.T) {
+	var (
+		launchID          = uint64(0)
+		creator           = sample.Address(r)
+		allowedAddr       = sample.Address(r)
+		minSelfDelegation = sdk.NewInt64Coin("stake", 100)
+		policy            = types.NewApprovalPolicy(
+			launchID,
+			tc.Coins(t, "1000foo,1000bar"),
+			&minSelfDelegation,
+			[]string{allowedAddr},
+		)
+		validator = func(selfDelegation sdk.Coin) types.RequestContent {
+			return types.NewGenesisValidator(
+				launchID,
+				sample.Address(r),
+				sample.Bytes(r, 100),
+				sample.Bytes(r, 30),
+				selfDelegation,
+				sample.GenesisValidatorPeer(r),
+			)
+		}
+	)
+
+	for _, tt := range []struct {
+		name    string
+		policy  types.ApprovalPolicy
+		creator string
+		content types.RequestContent
+		want    bool
+	}{
+		{
+			name:    "should approve any request from an allowlisted creator",
+			policy:  policy,
+			creator: allowedAddr,
+			content: types.NewAccountRemoval(sample.Address(r)),
+			want:    true,
+		},
+		{
+			name:    "should approve genesis account below max account coins",
+			policy:  policy,
+			creator: creator,
+			content: types.NewGenesisAccount(launchID, creator, tc.Coins(t, "1000foo")),
+			want:    true,
+		},
+		{
+			name:    "should not approve genesis account above max account coins",
+			policy:  policy,
+			creator: creator,
+			content: types.NewGenesisAccount(launchID, creator, tc.Coins(t, "1001foo")),
+			want:    false,
+		},
+		{
+			name:    "should not approve genesis account with a denom not in max account coins",
+			policy:  policy,
+			creator: creator,
+			content: types.NewGenesisAccount(launchID, creator, tc.Coins(t, "10foo,10baz")),
+			want:    false,
+		},
+		{
+			name:    "should not approve genesis account if max account coins is not set",
+			policy:  types.NewApprovalPolicy(launchID, nil, nil, nil),
+			creator: creator,
+			content: types.NewGenesisAccount(launchID, creator, tc.Coins(t, "10foo")),
+			want:    false,
+		},
+		{
+			name:    "should approve vesting account with a total balance below max account coins",
+			policy:  policy,
+			creator: creator,
+			content: types.NewVestingAccount(launchID, creator, *types.NewDelayedVesting(
+				tc.Coins(t, "1000foo"),
+				tc.Coins(t, "500foo"),
+				sample.Time(r).Unix(),
+			)),
+			want: true,
+		},
+		{
+			name:    "should not approve vesting account with a total balance above max account coins",
+			policy:  policy,
+			creator: creator,
+			content: types.NewVestingAccount(launchID, creator, *types.NewDelayedVesting(
+				tc.Coins(t, "2000foo"),
+				tc.Coins(t, "500foo"),
+				sample.Time(r).Unix(),
+			)),
+			want: false,
+		},
+		{
+			name:    "should approve account update below max account coins",
+			policy:  policy,
+			creator: creator,
+			content: types.NewGenesisAccountUpdate(launchID, creator, tc.Coins(t, "1000bar"), nil),
+			want:    true,
+		},
+		{
+			name:    "should approve validator with self delegation above min self delegation",
+			policy:  policy,
+			creator: creator,
+			content: validator(sdk.NewInt64Coin("stake", 100)),
+			want:    true,
+		},
+		{
+			name:    "should not approve validator with self delegation below min self delegation",
+			policy:  policy,
+			creator: creator,
+			content: validator(sdk.NewInt64Coin("stake", 99)),
+			want:    false,
+		},
+		{
+			name:    "should not approve validator with a different self delegation denom",
+			policy:  policy,
+			creator: creator,
+			content: validator(sdk.NewInt64Coin("foo", 1000)),
+			want:    false,
+		},
+		{
+			name:    "should approve validator gentx update with self delegation above min self delegation",
+			policy:  policy,
+			creator: creator,
+			content: types.NewGenesisValidatorGentxUpdate(
+				launchID,
+				creator,
+				sample.Bytes(r, 100),
+				sample.Bytes(r, 30),
+				sdk.NewInt64Coin("stake", 1000),
+			),
+			want: true,
+		},
+		{
+			name:    "should not approve removal requests",
+			policy:  policy,
+			creator: creator,
+			content: types.NewValidatorRemoval(creator),
+			want:    false,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.policy.Approves(tt.creator, tt.content))
+		})
+	}
+}
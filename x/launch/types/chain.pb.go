@@ -49,6 +49,9 @@ type Chain struct {
 	// contained in the requests
 	AccountBalance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,15,rep,name=accountBalance,proto3,casttype=github.com/cosmos/cosmos-sdk/types.Coin,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"accountBalance"`
 	Metadata       []byte                                   `protobuf:"bytes,16,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// archived is set when the chain is replaced as the mainnet of its campaign,
+	// an archived chain can no longer be launched or receive requests
+	Archived bool `protobuf:"varint,17,opt,name=archived,proto3" json:"archived,omitempty"`
 }
 
 func (m *Chain) Reset()         { *m = Chain{} }
@@ -196,6 +199,13 @@ func (m *Chain) GetMetadata() []byte {
 	return nil
 }
 
+func (m *Chain) GetArchived() bool {
+	if m != nil {
+		return m.Archived
+	}
+	return false
+}
+
 type InitialGenesis struct {
 	// Types that are valid to be assigned to Source:
 	//	*InitialGenesis_DefaultInitialGenesis
@@ -382,49 +392,50 @@ func init() {
 func init() { proto.RegisterFile("launch/chain.proto", fileDescriptor_36e96f39bc2e1bde) }
 
 var fileDescriptor_36e96f39bc2e1bde = []byte{
-	// 661 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0x8e, 0x9b, 0x34, 0x24, 0x93, 0x36, 0xad, 0x16, 0x0a, 0x4b, 0x84, 0x1c, 0x13, 0xf1, 0xe3,
-	0x03, 0xd8, 0x34, 0x48, 0xdc, 0x49, 0x22, 0x35, 0x91, 0xe0, 0x62, 0xca, 0x05, 0x4e, 0x9b, 0xf5,
-	0xd6, 0x59, 0x11, 0xef, 0x46, 0xde, 0x75, 0x05, 0x6f, 0xd1, 0x2b, 0x17, 0x1e, 0x80, 0xf7, 0x40,
-	0xea, 0xb1, 0x47, 0x4e, 0x2d, 0x6a, 0xdf, 0x82, 0x13, 0xf2, 0xda, 0x6d, 0x7e, 0x94, 0x4a, 0x9c,
-	0x32, 0xf3, 0xcd, 0x37, 0x7f, 0xde, 0x6f, 0x02, 0x68, 0x4a, 0x52, 0x41, 0x27, 0x3e, 0x9d, 0x10,
-	0x2e, 0xbc, 0x59, 0x22, 0xb5, 0x44, 0x7b, 0x9a, 0x89, 0x90, 0x25, 0x31, 0x17, 0xda, 0x53, 0x33,
-	0xe1, 0xe5, 0x94, 0xd6, 0xbd, 0x48, 0x46, 0xd2, 0x30, 0xfc, 0xcc, 0xca, 0xc9, 0xad, 0x76, 0x24,
-	0x65, 0x34, 0x65, 0xbe, 0xf1, 0xc6, 0xe9, 0x91, 0xaf, 0x79, 0xcc, 0x94, 0x26, 0xf1, 0xac, 0x20,
-	0xd8, 0x54, 0xaa, 0x58, 0x2a, 0x7f, 0x4c, 0x14, 0xf3, 0x8f, 0xf7, 0xc7, 0x4c, 0x93, 0x7d, 0x9f,
-	0xca, 0xeb, 0x6e, 0x9d, 0xef, 0x55, 0xd8, 0xec, 0x67, 0xdd, 0x51, 0x0b, 0x6a, 0x79, 0xab, 0xd1,
-	0x00, 0x5b, 0x8e, 0xe5, 0x56, 0x82, 0x1b, 0x1f, 0x3d, 0x81, 0x6d, 0x2a, 0x65, 0x12, 0x72, 0x41,
-	0xb4, 0x4c, 0x46, 0x03, 0xbc, 0x61, 0x08, 0xcb, 0x20, 0x7a, 0x06, 0xcd, 0x88, 0x09, 0xa6, 0xb8,
-	0x32, 0x15, 0x47, 0x03, 0x5c, 0x76, 0x2c, 0xb7, 0x1e, 0xac, 0xa0, 0xe8, 0x11, 0xd4, 0x69, 0xc2,
-	0x88, 0x66, 0xe1, 0x5b, 0x8d, 0x2b, 0x8e, 0xe5, 0x96, 0x83, 0x39, 0x90, 0x45, 0x95, 0x4c, 0x13,
-	0xca, 0x3e, 0x06, 0xef, 0xf0, 0xa6, 0x29, 0x30, 0x07, 0x90, 0x0d, 0x90, 0x3b, 0x43, 0xa2, 0x26,
-	0xb8, 0x6a, 0xc2, 0x0b, 0x08, 0xfa, 0x00, 0x4d, 0x2e, 0xb8, 0xe6, 0x64, 0x7a, 0x90, 0x37, 0xc5,
-	0x77, 0x1c, 0xcb, 0x6d, 0x74, 0x9f, 0x7a, 0x6b, 0x3f, 0xab, 0x37, 0x5a, 0x22, 0xf7, 0x2a, 0xa7,
-	0xe7, 0xed, 0x52, 0xb0, 0x52, 0x02, 0x39, 0xd0, 0x98, 0x10, 0xd5, 0x27, 0xf1, 0x8c, 0xf0, 0x48,
-	0xe0, 0x9a, 0x63, 0xb9, 0xb5, 0x60, 0x11, 0xca, 0xc6, 0xa2, 0x85, 0x3d, 0x1a, 0xe0, 0xba, 0xf9,
-	0x3a, 0x0b, 0x48, 0xb6, 0x14, 0x57, 0xef, 0x09, 0x17, 0x82, 0x69, 0x0c, 0x26, 0x7f, 0x0e, 0x20,
-	0x17, 0x76, 0xf2, 0x71, 0x0e, 0x13, 0x1e, 0x45, 0x2c, 0x61, 0x21, 0x6e, 0x18, 0xce, 0x2a, 0x8c,
-	0x06, 0x00, 0x05, 0xc4, 0x63, 0x86, 0xb7, 0xcc, 0x6a, 0x2d, 0x2f, 0x17, 0x81, 0x77, 0x2d, 0x02,
-	0xef, 0xf0, 0x5a, 0x04, 0xbd, 0x5a, 0xb6, 0xcf, 0xc9, 0x45, 0xdb, 0x0a, 0x16, 0xf2, 0xd0, 0x1b,
-	0xb8, 0x4f, 0xa5, 0x50, 0x69, 0xcc, 0x92, 0x80, 0x1d, 0x73, 0xc5, 0xa5, 0x18, 0x32, 0x1e, 0x4d,
-	0x34, 0xde, 0x36, 0xaf, 0x71, 0x4b, 0x14, 0xbd, 0x82, 0xbb, 0xb1, 0x14, 0x5c, 0xcb, 0x84, 0x8b,
-	0xa8, 0x2f, 0x85, 0x60, 0x54, 0xb3, 0x10, 0x37, 0xcd, 0xac, 0xeb, 0x42, 0xe8, 0x87, 0x05, 0x4d,
-	0x42, 0xa9, 0x4c, 0x85, 0xee, 0x91, 0x29, 0x11, 0x94, 0xe1, 0x1d, 0xa7, 0xec, 0x36, 0xba, 0x0f,
-	0xbd, 0x5c, 0x98, 0x5e, 0x26, 0x4c, 0xaf, 0x10, 0xa6, 0xd7, 0x97, 0x5c, 0xf4, 0x3e, 0x67, 0x33,
-	0xff, 0x3d, 0x6f, 0x3f, 0x8f, 0xb8, 0x9e, 0xa4, 0x63, 0x8f, 0xca, 0xd8, 0x2f, 0x54, 0x9c, 0xff,
-	0xbc, 0x54, 0xe1, 0x17, 0x5f, 0x7f, 0x9b, 0x31, 0x65, 0x12, 0x7e, 0x5e, 0xb4, 0xdd, 0xff, 0xa4,
-	0xaa, 0x60, 0x65, 0x9a, 0x4c, 0xf5, 0x31, 0xd3, 0x24, 0x24, 0x9a, 0xe0, 0x5d, 0xc7, 0x72, 0xb7,
-	0x82, 0x1b, 0xbf, 0xf3, 0xcb, 0x82, 0xe6, 0xb2, 0x3e, 0x50, 0x08, 0x7b, 0x21, 0x3b, 0x22, 0xe9,
-	0x54, 0x2f, 0x07, 0xcc, 0xc5, 0x34, 0xba, 0x2f, 0x6e, 0x51, 0xd9, 0x60, 0x5d, 0xce, 0xb0, 0x14,
-	0xac, 0x2f, 0x86, 0xfa, 0x00, 0xc5, 0xc9, 0x64, 0x37, 0xb0, 0x61, 0x4a, 0x3f, 0xbe, 0xa5, 0xf4,
-	0xc1, 0x0d, 0x71, 0x58, 0x0a, 0x16, 0xd2, 0x7a, 0x35, 0xa8, 0xe6, 0x77, 0xd1, 0x79, 0x00, 0x7b,
-	0x6b, 0x07, 0xe8, 0x74, 0x01, 0xe6, 0xe9, 0x68, 0x17, 0xca, 0x69, 0x32, 0x35, 0x9b, 0xd4, 0x83,
-	0xcc, 0x44, 0x08, 0x2a, 0x93, 0xec, 0xcc, 0x36, 0x0c, 0x64, 0xec, 0x5e, 0xef, 0xf4, 0xd2, 0xb6,
-	0xce, 0x2e, 0x6d, 0xeb, 0xcf, 0xa5, 0x6d, 0x9d, 0x5c, 0xd9, 0xa5, 0xb3, 0x2b, 0xbb, 0xf4, 0xfb,
-	0xca, 0x2e, 0x7d, 0x5a, 0x7c, 0x84, 0xf9, 0xac, 0xbe, 0x9a, 0x09, 0xff, 0xab, 0x5f, 0xfc, 0xd1,
-	0x99, 0xa7, 0x18, 0x57, 0x8d, 0x52, 0x5f, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x6b, 0xe3, 0xea,
-	0x19, 0xff, 0x04, 0x00, 0x00,
+	// 674 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcd, 0x6e, 0xd3, 0x4a,
+	0x14, 0x8e, 0x9b, 0x34, 0x37, 0x39, 0x69, 0xd3, 0xde, 0xb9, 0xb7, 0xf7, 0x0e, 0x11, 0x72, 0x4c,
+	0xc4, 0x8f, 0x17, 0x60, 0xd3, 0x20, 0xb1, 0x27, 0x89, 0xd4, 0x44, 0x82, 0x8d, 0x29, 0x1b, 0x58,
+	0x4d, 0xc6, 0x53, 0x7b, 0x44, 0x3c, 0x13, 0x79, 0xc6, 0x15, 0xbc, 0x45, 0x9f, 0x80, 0x07, 0x60,
+	0xcf, 0x23, 0x20, 0x75, 0xd9, 0x25, 0xab, 0x16, 0xb5, 0x6f, 0xc1, 0x0a, 0x79, 0x9c, 0xe6, 0x4f,
+	0xa9, 0xc4, 0x2a, 0xe7, 0x7c, 0xe7, 0x3b, 0x7f, 0x9e, 0xef, 0x04, 0xd0, 0x84, 0x64, 0x82, 0xc6,
+	0x3e, 0x8d, 0x09, 0x17, 0xde, 0x34, 0x95, 0x5a, 0xa2, 0x03, 0xcd, 0x44, 0xc8, 0xd2, 0x84, 0x0b,
+	0xed, 0xa9, 0xa9, 0xf0, 0x0a, 0x4a, 0xeb, 0xdf, 0x48, 0x46, 0xd2, 0x30, 0xfc, 0xdc, 0x2a, 0xc8,
+	0xad, 0x76, 0x24, 0x65, 0x34, 0x61, 0xbe, 0xf1, 0xc6, 0xd9, 0x89, 0xaf, 0x79, 0xc2, 0x94, 0x26,
+	0xc9, 0x74, 0x46, 0xb0, 0xa9, 0x54, 0x89, 0x54, 0xfe, 0x98, 0x28, 0xe6, 0x9f, 0x1e, 0x8e, 0x99,
+	0x26, 0x87, 0x3e, 0x95, 0xb7, 0xdd, 0x3a, 0xdf, 0xaa, 0xb0, 0xdd, 0xcf, 0xbb, 0xa3, 0x16, 0xd4,
+	0x8a, 0x56, 0xa3, 0x01, 0xb6, 0x1c, 0xcb, 0xad, 0x04, 0x73, 0x1f, 0x3d, 0x84, 0x5d, 0x2a, 0x65,
+	0x1a, 0x72, 0x41, 0xb4, 0x4c, 0x47, 0x03, 0xbc, 0x65, 0x08, 0xab, 0x20, 0x7a, 0x0c, 0xcd, 0x88,
+	0x09, 0xa6, 0xb8, 0x32, 0x15, 0x47, 0x03, 0x5c, 0x76, 0x2c, 0xb7, 0x1e, 0xac, 0xa1, 0xe8, 0x3e,
+	0xd4, 0x69, 0xca, 0x88, 0x66, 0xe1, 0x2b, 0x8d, 0x2b, 0x8e, 0xe5, 0x96, 0x83, 0x05, 0x90, 0x47,
+	0x95, 0xcc, 0x52, 0xca, 0xde, 0x05, 0xaf, 0xf1, 0xb6, 0x29, 0xb0, 0x00, 0x90, 0x0d, 0x50, 0x38,
+	0x43, 0xa2, 0x62, 0x5c, 0x35, 0xe1, 0x25, 0x04, 0xbd, 0x85, 0x26, 0x17, 0x5c, 0x73, 0x32, 0x39,
+	0x2a, 0x9a, 0xe2, 0xbf, 0x1c, 0xcb, 0x6d, 0x74, 0x1f, 0x79, 0x1b, 0x3f, 0xab, 0x37, 0x5a, 0x21,
+	0xf7, 0x2a, 0xe7, 0x97, 0xed, 0x52, 0xb0, 0x56, 0x02, 0x39, 0xd0, 0x88, 0x89, 0xea, 0x93, 0x64,
+	0x4a, 0x78, 0x24, 0x70, 0xcd, 0xb1, 0xdc, 0x5a, 0xb0, 0x0c, 0xe5, 0x63, 0xd1, 0x99, 0x3d, 0x1a,
+	0xe0, 0xba, 0xf9, 0x3a, 0x4b, 0x48, 0xbe, 0x14, 0x57, 0x6f, 0x08, 0x17, 0x82, 0x69, 0x0c, 0x26,
+	0x7f, 0x01, 0x20, 0x17, 0xf6, 0x8a, 0x71, 0x8e, 0x53, 0x1e, 0x45, 0x2c, 0x65, 0x21, 0x6e, 0x18,
+	0xce, 0x3a, 0x8c, 0x06, 0x00, 0x33, 0x88, 0x27, 0x0c, 0xef, 0x98, 0xd5, 0x5a, 0x5e, 0x21, 0x02,
+	0xef, 0x56, 0x04, 0xde, 0xf1, 0xad, 0x08, 0x7a, 0xb5, 0x7c, 0x9f, 0xb3, 0xab, 0xb6, 0x15, 0x2c,
+	0xe5, 0xa1, 0x97, 0xf0, 0x1f, 0x95, 0x42, 0x65, 0x09, 0x4b, 0x03, 0x76, 0xca, 0x15, 0x97, 0x62,
+	0xc8, 0x78, 0x14, 0x6b, 0xbc, 0x6b, 0x5e, 0xe3, 0x8e, 0x28, 0x7a, 0x0e, 0xff, 0x24, 0x52, 0x70,
+	0x2d, 0x53, 0x2e, 0xa2, 0xbe, 0x14, 0x82, 0x51, 0xcd, 0x42, 0xdc, 0x34, 0xb3, 0x6e, 0x0a, 0xa1,
+	0x2f, 0x16, 0x34, 0x09, 0xa5, 0x32, 0x13, 0xba, 0x47, 0x26, 0x44, 0x50, 0x86, 0xf7, 0x9c, 0xb2,
+	0xdb, 0xe8, 0xde, 0xf3, 0x0a, 0x61, 0x7a, 0xb9, 0x30, 0xbd, 0x99, 0x30, 0xbd, 0xbe, 0xe4, 0xa2,
+	0xf7, 0x21, 0x9f, 0xf9, 0xd7, 0x65, 0xfb, 0x49, 0xc4, 0x75, 0x9c, 0x8d, 0x3d, 0x2a, 0x13, 0x7f,
+	0xa6, 0xe2, 0xe2, 0xe7, 0x99, 0x0a, 0x3f, 0xfa, 0xfa, 0xf3, 0x94, 0x29, 0x93, 0xf0, 0xf5, 0xaa,
+	0xed, 0xfe, 0x21, 0x55, 0x05, 0x6b, 0xd3, 0xe4, 0xaa, 0x4f, 0x98, 0x26, 0x21, 0xd1, 0x04, 0xef,
+	0x3b, 0x96, 0xbb, 0x13, 0xcc, 0xfd, 0x3c, 0x46, 0x52, 0x1a, 0xf3, 0x53, 0x16, 0xe2, 0xbf, 0xcd,
+	0x8e, 0x73, 0xbf, 0xf3, 0xdd, 0x82, 0xe6, 0xaa, 0x76, 0x50, 0x08, 0x07, 0x21, 0x3b, 0x21, 0xd9,
+	0x44, 0xaf, 0x06, 0xcc, 0x35, 0x35, 0xba, 0x4f, 0xef, 0x50, 0xe0, 0x60, 0x53, 0xce, 0xb0, 0x14,
+	0x6c, 0x2e, 0x86, 0xfa, 0x00, 0xb3, 0x73, 0xca, 0xef, 0x63, 0xcb, 0x94, 0x7e, 0x70, 0x47, 0xe9,
+	0xa3, 0x39, 0x71, 0x58, 0x0a, 0x96, 0xd2, 0x7a, 0x35, 0xa8, 0x16, 0x37, 0xd3, 0xf9, 0x1f, 0x0e,
+	0x36, 0x0e, 0xd0, 0xe9, 0x02, 0x2c, 0xd2, 0xd1, 0x3e, 0x94, 0xb3, 0x74, 0x62, 0x36, 0xa9, 0x07,
+	0xb9, 0x89, 0x10, 0x54, 0xe2, 0xfc, 0x04, 0xb7, 0x0c, 0x64, 0xec, 0x5e, 0xef, 0xfc, 0xda, 0xb6,
+	0x2e, 0xae, 0x6d, 0xeb, 0xe7, 0xb5, 0x6d, 0x9d, 0xdd, 0xd8, 0xa5, 0x8b, 0x1b, 0xbb, 0xf4, 0xe3,
+	0xc6, 0x2e, 0xbd, 0x5f, 0x7e, 0xa0, 0xc5, 0xac, 0xbe, 0x9a, 0x0a, 0xff, 0x93, 0x3f, 0xfb, 0x13,
+	0x34, 0xcf, 0x34, 0xae, 0x1a, 0x15, 0xbf, 0xf8, 0x3d, 0x00, 0xf6, 0xe7, 0xd9, 0xbe, 0x1b, 0x05,
+	0x00, 0x00,
 }
 
 func (m *Chain) Marshal() (dAtA []byte, err error) {
@@ -447,6 +458,18 @@ func (m *Chain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Archived {
+		i--
+		if m.Archived {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
 	if len(m.Metadata) > 0 {
 		i -= len(m.Metadata)
 		copy(dAtA[i:], m.Metadata)
@@ -781,6 +804,9 @@ func (m *Chain) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovChain(uint64(l))
 	}
+	if m.Archived {
+		n += 3
+	}
 	return n
 }
 
@@ -1286,6 +1312,26 @@ func (m *Chain) Unmarshal(dAtA []byte) error {
 				m.Metadata = []byte{}
 			}
 			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Archived", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Archived = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipChain(dAtA[iNdEx:])
@@ -13,12 +13,19 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgRequestAddAccount{}, "launch/RequestAddAccount", nil)
 	cdc.RegisterConcrete(&MsgRequestAddVestingAccount{}, "launch/RequestAddVestingdAccount", nil)
 	cdc.RegisterConcrete(&MsgRequestRemoveAccount{}, "launch/RequestRemoveAccount", nil)
+	cdc.RegisterConcrete(&MsgRequestUpdateAccount{}, "launch/RequestUpdateAccount", nil)
 	cdc.RegisterConcrete(&MsgRequestAddValidator{}, "launch/RequestAddValidator", nil)
 	cdc.RegisterConcrete(&MsgRequestRemoveValidator{}, "launch/RequestRemoveValidator", nil)
+	cdc.RegisterConcrete(&MsgRequestUpdateValidatorPeer{}, "launch/RequestUpdateValidatorPeer", nil)
+	cdc.RegisterConcrete(&MsgRequestUpdateValidatorGentx{}, "launch/RequestUpdateValidatorGentx", nil)
 	cdc.RegisterConcrete(&MsgSettleRequest{}, "launch/SettleRequest", nil)
+	cdc.RegisterConcrete(&MsgSettleRequests{}, "launch/SettleRequests", nil)
+	cdc.RegisterConcrete(&MsgCancelRequest{}, "launch/CancelRequest", nil)
 	cdc.RegisterConcrete(&MsgTriggerLaunch{}, "launch/TriggerLaunch", nil)
 	cdc.RegisterConcrete(&MsgRevertLaunch{}, "launch/RevertLaunch", nil)
 	cdc.RegisterConcrete(&MsgUpdateLaunchInformation{}, "launch/UpdateLaunchInformation", nil)
+	cdc.RegisterConcrete(&MsgSetApprovalPolicy{}, "launch/SetApprovalPolicy", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "launch/UpdateParams", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -30,11 +37,18 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgRequestAddAccount{},
 		&MsgRequestAddVestingAccount{},
 		&MsgRequestRemoveAccount{},
+		&MsgRequestUpdateAccount{},
 		&MsgRequestAddValidator{},
 		&MsgRequestRemoveValidator{},
+		&MsgRequestUpdateValidatorPeer{},
+		&MsgRequestUpdateValidatorGentx{},
 		&MsgSettleRequest{},
+		&MsgSettleRequests{},
+		&MsgCancelRequest{},
 		&MsgTriggerLaunch{},
 		&MsgRevertLaunch{},
+		&MsgSetApprovalPolicy{},
+		&MsgUpdateParams{},
 	)
 	// this line is used by starport scaffolding # 3
 
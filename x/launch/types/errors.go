@@ -40,4 +40,11 @@ var (
 	ErrInvalidLaunchTime           = sdkerrors.Register(ModuleName, 31, "invalid launch time")
 	ErrChainMonitoringConnected    = sdkerrors.Register(ModuleName, 32, "chain is already connected to monitoring")
 	ErrRequestSettled              = sdkerrors.Register(ModuleName, 33, "request is already settled")
+	ErrInvalidRequestSettlements   = sdkerrors.Register(ModuleName, 34, "invalid request settlements")
+	ErrUpdateMainnetAccount        = sdkerrors.Register(ModuleName, 35, "accounts can't be updated for mainnet")
+	ErrInvalidAccountUpdate        = sdkerrors.Register(ModuleName, 36, "invalid account update")
+	ErrInvalidValidatorUpdate      = sdkerrors.Register(ModuleName, 37, "invalid validator update")
+	ErrInvalidApprovalPolicy       = sdkerrors.Register(ModuleName, 38, "invalid approval policy")
+	ErrChainArchived               = sdkerrors.Register(ModuleName, 39, "chain is archived")
+	ErrNonGenesisVotingPower       = sdkerrors.Register(ModuleName, 40, "voting power of non-genesis validators is too high")
 )
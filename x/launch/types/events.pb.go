@@ -198,6 +198,58 @@ func (m *EventRequestSettled) GetApproved() bool {
 	return false
 }
 
+type EventRequestCancelled struct {
+	LaunchID  uint64 `protobuf:"varint,1,opt,name=launchID,proto3" json:"launchID,omitempty"`
+	RequestID uint64 `protobuf:"varint,2,opt,name=requestID,proto3" json:"requestID,omitempty"`
+}
+
+func (m *EventRequestCancelled) Reset()         { *m = EventRequestCancelled{} }
+func (m *EventRequestCancelled) String() string { return proto.CompactTextString(m) }
+func (*EventRequestCancelled) ProtoMessage()    {}
+func (*EventRequestCancelled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb8579c84a3d4015, []int{3}
+}
+func (m *EventRequestCancelled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRequestCancelled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRequestCancelled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRequestCancelled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRequestCancelled.Merge(m, src)
+}
+func (m *EventRequestCancelled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRequestCancelled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRequestCancelled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRequestCancelled proto.InternalMessageInfo
+
+func (m *EventRequestCancelled) GetLaunchID() uint64 {
+	if m != nil {
+		return m.LaunchID
+	}
+	return 0
+}
+
+func (m *EventRequestCancelled) GetRequestID() uint64 {
+	if m != nil {
+		return m.RequestID
+	}
+	return 0
+}
+
 type EventGenesisAccountAdded struct {
 	LaunchID           uint64                                   `protobuf:"varint,1,opt,name=launchID,proto3" json:"launchID,omitempty"`
 	Address            string                                   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
@@ -209,7 +261,7 @@ func (m *EventGenesisAccountAdded) Reset()         { *m = EventGenesisAccountAdd
 func (m *EventGenesisAccountAdded) String() string { return proto.CompactTextString(m) }
 func (*EventGenesisAccountAdded) ProtoMessage()    {}
 func (*EventGenesisAccountAdded) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bb8579c84a3d4015, []int{3}
+	return fileDescriptor_bb8579c84a3d4015, []int{4}
 }
 func (m *EventGenesisAccountAdded) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -277,7 +329,7 @@ func (m *EventVestingAccountAdded) Reset()         { *m = EventVestingAccountAdd
 func (m *EventVestingAccountAdded) String() string { return proto.CompactTextString(m) }
 func (*EventVestingAccountAdded) ProtoMessage()    {}
 func (*EventVestingAccountAdded) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bb8579c84a3d4015, []int{4}
+	return fileDescriptor_bb8579c84a3d4015, []int{5}
 }
 func (m *EventVestingAccountAdded) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -350,7 +402,7 @@ func (m *EventValidatorAdded) Reset()         { *m = EventValidatorAdded{} }
 func (m *EventValidatorAdded) String() string { return proto.CompactTextString(m) }
 func (*EventValidatorAdded) ProtoMessage()    {}
 func (*EventValidatorAdded) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bb8579c84a3d4015, []int{5}
+	return fileDescriptor_bb8579c84a3d4015, []int{6}
 }
 func (m *EventValidatorAdded) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -442,6 +494,174 @@ func (m *EventValidatorAdded) GetCoordinatorAddress() string {
 	return ""
 }
 
+type EventGenesisAccountUpdated struct {
+	LaunchID           uint64                                   `protobuf:"varint,1,opt,name=launchID,proto3" json:"launchID,omitempty"`
+	Address            string                                   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Coins              github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=coins,proto3,casttype=github.com/cosmos/cosmos-sdk/types.Coin,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+	VestingOptions     *VestingOptions                          `protobuf:"bytes,4,opt,name=vestingOptions,proto3" json:"vestingOptions,omitempty"`
+	CoordinatorAddress string                                   `protobuf:"bytes,5,opt,name=coordinatorAddress,proto3" json:"coordinatorAddress,omitempty"`
+}
+
+func (m *EventGenesisAccountUpdated) Reset()         { *m = EventGenesisAccountUpdated{} }
+func (m *EventGenesisAccountUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventGenesisAccountUpdated) ProtoMessage()    {}
+func (*EventGenesisAccountUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb8579c84a3d4015, []int{7}
+}
+func (m *EventGenesisAccountUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventGenesisAccountUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventGenesisAccountUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventGenesisAccountUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventGenesisAccountUpdated.Merge(m, src)
+}
+func (m *EventGenesisAccountUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventGenesisAccountUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventGenesisAccountUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventGenesisAccountUpdated proto.InternalMessageInfo
+
+func (m *EventGenesisAccountUpdated) GetLaunchID() uint64 {
+	if m != nil {
+		return m.LaunchID
+	}
+	return 0
+}
+
+func (m *EventGenesisAccountUpdated) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EventGenesisAccountUpdated) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
+
+func (m *EventGenesisAccountUpdated) GetVestingOptions() *VestingOptions {
+	if m != nil {
+		return m.VestingOptions
+	}
+	return nil
+}
+
+func (m *EventGenesisAccountUpdated) GetCoordinatorAddress() string {
+	if m != nil {
+		return m.CoordinatorAddress
+	}
+	return ""
+}
+
+type EventValidatorUpdated struct {
+	LaunchID           uint64     `protobuf:"varint,1,opt,name=launchID,proto3" json:"launchID,omitempty"`
+	Address            string     `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	GenTx              []byte     `protobuf:"bytes,3,opt,name=genTx,proto3" json:"genTx,omitempty"`
+	ConsPubKey         []byte     `protobuf:"bytes,4,opt,name=consPubKey,proto3" json:"consPubKey,omitempty"`
+	SelfDelegation     types.Coin `protobuf:"bytes,5,opt,name=selfDelegation,proto3" json:"selfDelegation"`
+	Peer               Peer       `protobuf:"bytes,6,opt,name=peer,proto3" json:"peer"`
+	CoordinatorAddress string     `protobuf:"bytes,7,opt,name=coordinatorAddress,proto3" json:"coordinatorAddress,omitempty"`
+}
+
+func (m *EventValidatorUpdated) Reset()         { *m = EventValidatorUpdated{} }
+func (m *EventValidatorUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventValidatorUpdated) ProtoMessage()    {}
+func (*EventValidatorUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb8579c84a3d4015, []int{8}
+}
+func (m *EventValidatorUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventValidatorUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventValidatorUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventValidatorUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventValidatorUpdated.Merge(m, src)
+}
+func (m *EventValidatorUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventValidatorUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventValidatorUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventValidatorUpdated proto.InternalMessageInfo
+
+func (m *EventValidatorUpdated) GetLaunchID() uint64 {
+	if m != nil {
+		return m.LaunchID
+	}
+	return 0
+}
+
+func (m *EventValidatorUpdated) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EventValidatorUpdated) GetGenTx() []byte {
+	if m != nil {
+		return m.GenTx
+	}
+	return nil
+}
+
+func (m *EventValidatorUpdated) GetConsPubKey() []byte {
+	if m != nil {
+		return m.ConsPubKey
+	}
+	return nil
+}
+
+func (m *EventValidatorUpdated) GetSelfDelegation() types.Coin {
+	if m != nil {
+		return m.SelfDelegation
+	}
+	return types.Coin{}
+}
+
+func (m *EventValidatorUpdated) GetPeer() Peer {
+	if m != nil {
+		return m.Peer
+	}
+	return Peer{}
+}
+
+func (m *EventValidatorUpdated) GetCoordinatorAddress() string {
+	if m != nil {
+		return m.CoordinatorAddress
+	}
+	return ""
+}
+
 type EventAccountRemoved struct {
 	Address            string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	LaunchID           uint64 `protobuf:"varint,2,opt,name=launchID,proto3" json:"launchID,omitempty"`
@@ -452,7 +672,7 @@ func (m *EventAccountRemoved) Reset()         { *m = EventAccountRemoved{} }
 func (m *EventAccountRemoved) String() string { return proto.CompactTextString(m) }
 func (*EventAccountRemoved) ProtoMessage()    {}
 func (*EventAccountRemoved) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bb8579c84a3d4015, []int{6}
+	return fileDescriptor_bb8579c84a3d4015, []int{9}
 }
 func (m *EventAccountRemoved) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -514,7 +734,7 @@ func (m *EventValidatorRemoved) Reset()         { *m = EventValidatorRemoved{} }
 func (m *EventValidatorRemoved) String() string { return proto.CompactTextString(m) }
 func (*EventValidatorRemoved) ProtoMessage()    {}
 func (*EventValidatorRemoved) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bb8579c84a3d4015, []int{7}
+	return fileDescriptor_bb8579c84a3d4015, []int{10}
 }
 func (m *EventValidatorRemoved) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -587,7 +807,7 @@ func (m *EventLaunchTriggered) Reset()         { *m = EventLaunchTriggered{} }
 func (m *EventLaunchTriggered) String() string { return proto.CompactTextString(m) }
 func (*EventLaunchTriggered) ProtoMessage()    {}
 func (*EventLaunchTriggered) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bb8579c84a3d4015, []int{8}
+	return fileDescriptor_bb8579c84a3d4015, []int{11}
 }
 func (m *EventLaunchTriggered) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -638,7 +858,7 @@ func (m *EventLaunchReverted) Reset()         { *m = EventLaunchReverted{} }
 func (m *EventLaunchReverted) String() string { return proto.CompactTextString(m) }
 func (*EventLaunchReverted) ProtoMessage()    {}
 func (*EventLaunchReverted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bb8579c84a3d4015, []int{9}
+	return fileDescriptor_bb8579c84a3d4015, []int{12}
 }
 func (m *EventLaunchReverted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -674,72 +894,181 @@ func (m *EventLaunchReverted) GetLaunchID() uint64 {
 	return 0
 }
 
+type EventChainArchived struct {
+	LaunchID uint64 `protobuf:"varint,1,opt,name=launchID,proto3" json:"launchID,omitempty"`
+}
+
+func (m *EventChainArchived) Reset()         { *m = EventChainArchived{} }
+func (m *EventChainArchived) String() string { return proto.CompactTextString(m) }
+func (*EventChainArchived) ProtoMessage()    {}
+func (*EventChainArchived) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb8579c84a3d4015, []int{13}
+}
+func (m *EventChainArchived) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventChainArchived) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventChainArchived.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventChainArchived) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventChainArchived.Merge(m, src)
+}
+func (m *EventChainArchived) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventChainArchived) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventChainArchived.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventChainArchived proto.InternalMessageInfo
+
+func (m *EventChainArchived) GetLaunchID() uint64 {
+	if m != nil {
+		return m.LaunchID
+	}
+	return 0
+}
+
+type EventApprovalPolicySet struct {
+	ApprovalPolicy     ApprovalPolicy `protobuf:"bytes,1,opt,name=approvalPolicy,proto3" json:"approvalPolicy"`
+	CoordinatorAddress string         `protobuf:"bytes,2,opt,name=coordinatorAddress,proto3" json:"coordinatorAddress,omitempty"`
+}
+
+func (m *EventApprovalPolicySet) Reset()         { *m = EventApprovalPolicySet{} }
+func (m *EventApprovalPolicySet) String() string { return proto.CompactTextString(m) }
+func (*EventApprovalPolicySet) ProtoMessage()    {}
+func (*EventApprovalPolicySet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb8579c84a3d4015, []int{14}
+}
+func (m *EventApprovalPolicySet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventApprovalPolicySet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventApprovalPolicySet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventApprovalPolicySet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventApprovalPolicySet.Merge(m, src)
+}
+func (m *EventApprovalPolicySet) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventApprovalPolicySet) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventApprovalPolicySet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventApprovalPolicySet proto.InternalMessageInfo
+
+func (m *EventApprovalPolicySet) GetApprovalPolicy() ApprovalPolicy {
+	if m != nil {
+		return m.ApprovalPolicy
+	}
+	return ApprovalPolicy{}
+}
+
+func (m *EventApprovalPolicySet) GetCoordinatorAddress() string {
+	if m != nil {
+		return m.CoordinatorAddress
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventChainCreated)(nil), "tendermint.spn.launch.EventChainCreated")
 	proto.RegisterType((*EventRequestCreated)(nil), "tendermint.spn.launch.EventRequestCreated")
 	proto.RegisterType((*EventRequestSettled)(nil), "tendermint.spn.launch.EventRequestSettled")
+	proto.RegisterType((*EventRequestCancelled)(nil), "tendermint.spn.launch.EventRequestCancelled")
 	proto.RegisterType((*EventGenesisAccountAdded)(nil), "tendermint.spn.launch.EventGenesisAccountAdded")
 	proto.RegisterType((*EventVestingAccountAdded)(nil), "tendermint.spn.launch.EventVestingAccountAdded")
 	proto.RegisterType((*EventValidatorAdded)(nil), "tendermint.spn.launch.EventValidatorAdded")
+	proto.RegisterType((*EventGenesisAccountUpdated)(nil), "tendermint.spn.launch.EventGenesisAccountUpdated")
+	proto.RegisterType((*EventValidatorUpdated)(nil), "tendermint.spn.launch.EventValidatorUpdated")
 	proto.RegisterType((*EventAccountRemoved)(nil), "tendermint.spn.launch.EventAccountRemoved")
 	proto.RegisterType((*EventValidatorRemoved)(nil), "tendermint.spn.launch.EventValidatorRemoved")
 	proto.RegisterType((*EventLaunchTriggered)(nil), "tendermint.spn.launch.EventLaunchTriggered")
 	proto.RegisterType((*EventLaunchReverted)(nil), "tendermint.spn.launch.EventLaunchReverted")
+	proto.RegisterType((*EventChainArchived)(nil), "tendermint.spn.launch.EventChainArchived")
+	proto.RegisterType((*EventApprovalPolicySet)(nil), "tendermint.spn.launch.EventApprovalPolicySet")
 }
 
 func init() { proto.RegisterFile("launch/events.proto", fileDescriptor_bb8579c84a3d4015) }
 
 var fileDescriptor_bb8579c84a3d4015 = []byte{
-	// 784 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x4f, 0x1b, 0x39,
-	0x14, 0xcf, 0x90, 0x84, 0x10, 0xc3, 0xb2, 0xda, 0x21, 0x68, 0x07, 0x16, 0x0d, 0x51, 0xb4, 0xab,
-	0xcd, 0x85, 0x19, 0x41, 0x55, 0xa9, 0xa7, 0x4a, 0x24, 0xa9, 0x68, 0xd4, 0x4a, 0x45, 0x03, 0xe2,
-	0xd0, 0x56, 0x42, 0xce, 0xcc, 0xeb, 0x64, 0x44, 0x62, 0x4f, 0x6d, 0x27, 0x82, 0x8f, 0x50, 0xa9,
-	0x87, 0x7e, 0x83, 0x1e, 0x2b, 0xf5, 0xd2, 0x4b, 0xbf, 0x41, 0x2f, 0x1c, 0x51, 0x4f, 0x3d, 0xd1,
-	0x0a, 0x3e, 0x45, 0x7b, 0xaa, 0xc6, 0x76, 0x20, 0x89, 0x08, 0x49, 0x51, 0x39, 0xc5, 0x7e, 0x7f,
-	0xec, 0xf7, 0x7e, 0xbf, 0xdf, 0x73, 0x06, 0x2d, 0xb4, 0x70, 0x87, 0xf8, 0x4d, 0x17, 0xba, 0x40,
-	0x04, 0x77, 0x62, 0x46, 0x05, 0x35, 0x17, 0x05, 0x90, 0x00, 0x58, 0x3b, 0x22, 0xc2, 0xe1, 0x31,
-	0x71, 0x54, 0xcc, 0x72, 0x21, 0xa4, 0x21, 0x95, 0x11, 0x6e, 0xb2, 0x52, 0xc1, 0xcb, 0xb6, 0x4f,
-	0x79, 0x9b, 0x72, 0xb7, 0x81, 0x39, 0xb8, 0xdd, 0xf5, 0x06, 0x08, 0xbc, 0xee, 0xfa, 0x34, 0x22,
-	0xda, 0xbf, 0xa4, 0xfc, 0xfb, 0x2a, 0x51, 0x6d, 0xb4, 0xcb, 0xd4, 0x97, 0xfb, 0x4d, 0x7c, 0x11,
-	0x5e, 0xd0, 0x36, 0x06, 0x2f, 0x3b, 0xc0, 0x85, 0xb6, 0xae, 0x68, 0x6b, 0x08, 0x04, 0x78, 0xc4,
-	0xf7, 0xb1, 0xef, 0xd3, 0x0e, 0x19, 0xf6, 0x76, 0x81, 0x8b, 0x88, 0x84, 0x43, 0x5e, 0x7b, 0x28,
-	0xb7, 0x8b, 0x5b, 0x51, 0x80, 0x05, 0x65, 0xca, 0x5f, 0x7a, 0x6b, 0xa0, 0xbf, 0x1e, 0x24, 0xed,
-	0x57, 0x93, 0x32, 0xaa, 0x0c, 0xb0, 0x80, 0xc0, 0x5c, 0x46, 0x33, 0x2a, 0xaf, 0x5e, 0xb3, 0x8c,
-	0xa2, 0x51, 0xce, 0x78, 0x17, 0x7b, 0xf3, 0x21, 0x32, 0x7d, 0x4a, 0x59, 0x10, 0x91, 0xe4, 0x98,
-	0xcd, 0x20, 0x60, 0xc0, 0xb9, 0x35, 0x55, 0x34, 0xca, 0xf9, 0x8a, 0xf5, 0xf9, 0xe3, 0x5a, 0x41,
-	0x77, 0xa9, 0x3d, 0x3b, 0x82, 0x45, 0x24, 0xf4, 0xae, 0xc8, 0x31, 0xff, 0x45, 0x7f, 0xf4, 0x59,
-	0xeb, 0x35, 0x2b, 0x2d, 0xaf, 0x1a, 0x34, 0x96, 0x28, 0x5a, 0x90, 0x05, 0x7a, 0x0a, 0x93, 0x5e,
-	0x89, 0x16, 0xca, 0xf9, 0xc9, 0x92, 0x32, 0x59, 0x61, 0xde, 0xeb, 0x6d, 0xcd, 0xfb, 0x28, 0xa7,
-	0xf1, 0x93, 0x55, 0xcd, 0x6e, 0xd8, 0xce, 0x95, 0x94, 0x3a, 0xfa, 0xc4, 0x4a, 0xe6, 0xf8, 0x74,
-	0x35, 0xe5, 0xf5, 0x92, 0x4a, 0x07, 0x83, 0x17, 0xee, 0x80, 0x10, 0xad, 0x31, 0x98, 0xac, 0xa0,
-	0xbc, 0xce, 0xae, 0xd7, 0xe4, 0xa5, 0x19, 0xef, 0xd2, 0x90, 0x64, 0xe2, 0x38, 0x66, 0xb4, 0x0b,
-	0x81, 0x6c, 0x71, 0xc6, 0xbb, 0xd8, 0x97, 0x3e, 0x4d, 0x21, 0x4b, 0xde, 0xb6, 0xa5, 0x08, 0xda,
-	0x54, 0xec, 0x6d, 0x06, 0xc1, 0x98, 0x2b, 0x37, 0x50, 0x0e, 0x4f, 0x88, 0x7d, 0x2f, 0xd0, 0x7c,
-	0x6d, 0xa0, 0x6c, 0x22, 0x4e, 0x6e, 0xa5, 0x8b, 0xe9, 0xf2, 0xec, 0xc6, 0x92, 0xa3, 0xe3, 0x13,
-	0xf9, 0x3a, 0x5a, 0xbe, 0x4e, 0x95, 0x46, 0xa4, 0xf2, 0x2c, 0xc1, 0xe4, 0xc7, 0xe9, 0xea, 0xff,
-	0x61, 0x24, 0x9a, 0x9d, 0x86, 0xe3, 0xd3, 0xb6, 0x96, 0xaf, 0xfe, 0x59, 0xe3, 0xc1, 0x81, 0x2b,
-	0x8e, 0x62, 0xe0, 0x32, 0xe1, 0xfd, 0xd7, 0xd5, 0xf2, 0x84, 0xa1, 0xdc, 0x53, 0x45, 0x8c, 0x50,
-	0x52, 0xe6, 0xd7, 0x95, 0x54, 0x7a, 0xd5, 0x43, 0x71, 0x4f, 0x0d, 0xc1, 0xad, 0xa2, 0xb8, 0x83,
-	0xe6, 0xf5, 0xac, 0x3d, 0x89, 0x45, 0x44, 0x25, 0x9a, 0x89, 0xcc, 0xfe, 0x1b, 0x21, 0xb3, 0xbd,
-	0x81, 0x60, 0xad, 0xb6, 0xa1, 0x23, 0x7e, 0x23, 0x16, 0xef, 0xd2, 0x5a, 0xbf, 0x7b, 0xbd, 0x51,
-	0xbf, 0x1d, 0x18, 0x0a, 0x28, 0x1b, 0x02, 0xd9, 0x3d, 0x94, 0xdd, 0xcf, 0x79, 0x6a, 0x63, 0xda,
-	0x08, 0xf9, 0x94, 0xf0, 0xed, 0x4e, 0xe3, 0x11, 0x1c, 0xc9, 0xfa, 0xe7, 0xbc, 0x3e, 0x8b, 0xb9,
-	0x85, 0xe6, 0x39, 0xb4, 0x5e, 0xd4, 0xa0, 0x05, 0x21, 0x4e, 0x5a, 0xb7, 0xb2, 0x12, 0xbc, 0x6b,
-	0xa4, 0xa8, 0x01, 0x1b, 0x4c, 0x33, 0xef, 0xa2, 0x4c, 0x0c, 0xc0, 0xac, 0x69, 0x99, 0xfe, 0xcf,
-	0x08, 0xec, 0xb7, 0x01, 0x98, 0x3e, 0x40, 0x86, 0x9b, 0x45, 0x34, 0xdb, 0xc4, 0xbc, 0x8a, 0xdb,
-	0x31, 0x8e, 0x42, 0x62, 0xe5, 0xe4, 0x38, 0xf6, 0x9b, 0x64, 0x07, 0x7a, 0x5d, 0xaf, 0x59, 0x33,
-	0x12, 0xa9, 0x3e, 0xcb, 0x08, 0xa6, 0xf2, 0x37, 0x60, 0xea, 0x83, 0xa1, 0x99, 0xd2, 0x72, 0xf5,
-	0xa0, 0x9d, 0xbc, 0x09, 0xfd, 0x6c, 0x18, 0x93, 0xb2, 0xd1, 0xcf, 0xee, 0xd4, 0x44, 0x2f, 0x76,
-	0xfa, 0x06, 0x15, 0x7f, 0x37, 0xd0, 0xe2, 0xa0, 0xb6, 0x7a, 0x35, 0xdf, 0x43, 0x7f, 0xeb, 0xbf,
-	0x98, 0x4b, 0xd9, 0xa9, 0xae, 0xf4, 0xf3, 0x3c, 0xca, 0x7d, 0x6d, 0xe5, 0x43, 0x6c, 0xa5, 0xc7,
-	0xb1, 0x95, 0x99, 0x90, 0xad, 0xec, 0x0d, 0x7a, 0x7f, 0x8e, 0x0a, 0xb2, 0xf5, 0xc7, 0xb2, 0xb8,
-	0x5d, 0x16, 0x85, 0x21, 0xb0, 0x31, 0x73, 0x55, 0x46, 0x7f, 0xaa, 0xf5, 0x6e, 0xd4, 0x06, 0x2e,
-	0x70, 0x3b, 0x96, 0x2d, 0xa6, 0xbd, 0x61, 0x73, 0x69, 0x5d, 0x4b, 0x41, 0x9d, 0xee, 0x41, 0x17,
-	0xd8, 0x98, 0x3f, 0xe2, 0x4a, 0xe5, 0xf8, 0xcc, 0x36, 0x4e, 0xce, 0x6c, 0xe3, 0xdb, 0x99, 0x6d,
-	0xbc, 0x39, 0xb7, 0x53, 0x27, 0xe7, 0x76, 0xea, 0xcb, 0xb9, 0x9d, 0x7a, 0xda, 0xff, 0x12, 0x5f,
-	0xce, 0x85, 0xcb, 0x63, 0xe2, 0x1e, 0xba, 0xfa, 0x83, 0x40, 0xbe, 0xc7, 0x8d, 0x69, 0xf9, 0x15,
-	0x70, 0xe7, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0x11, 0xda, 0x11, 0x3e, 0x0a, 0x09, 0x00, 0x00,
+	// 904 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0xaf, 0xeb, 0x74, 0xd3, 0x4e, 0x97, 0x45, 0xb8, 0x29, 0x78, 0xcb, 0xca, 0x8d, 0x2c, 0x10,
+	0xb9, 0xac, 0x4d, 0x8b, 0x90, 0x38, 0x21, 0x35, 0x0d, 0x5a, 0x2a, 0x40, 0x14, 0xb7, 0xf4, 0x00,
+	0x48, 0xd5, 0xc4, 0x7e, 0x38, 0xd6, 0x3a, 0x33, 0x66, 0x66, 0x12, 0x6d, 0x3f, 0x02, 0x12, 0x07,
+	0x0e, 0xdc, 0x39, 0x22, 0x71, 0xe1, 0x82, 0xc4, 0x07, 0xe0, 0xb2, 0xc7, 0x15, 0x27, 0x4e, 0x0b,
+	0x6a, 0x0f, 0x7c, 0x06, 0x38, 0x21, 0xcf, 0x8c, 0x5b, 0xdb, 0x34, 0x4d, 0x36, 0xb4, 0x12, 0x9c,
+	0x32, 0xf3, 0xfe, 0xcc, 0xbc, 0xf7, 0x7b, 0xef, 0xf7, 0x32, 0x46, 0x6b, 0x29, 0x1e, 0x91, 0x70,
+	0xe0, 0xc3, 0x18, 0x88, 0xe0, 0x5e, 0xc6, 0xa8, 0xa0, 0xd6, 0xba, 0x00, 0x12, 0x01, 0x1b, 0x26,
+	0x44, 0x78, 0x3c, 0x23, 0x9e, 0xb2, 0xd9, 0x68, 0xc5, 0x34, 0xa6, 0xd2, 0xc2, 0xcf, 0x57, 0xca,
+	0x78, 0xc3, 0x09, 0x29, 0x1f, 0x52, 0xee, 0xf7, 0x31, 0x07, 0x7f, 0xbc, 0xd5, 0x07, 0x81, 0xb7,
+	0xfc, 0x90, 0x26, 0x44, 0xeb, 0xef, 0x2a, 0xfd, 0xb1, 0x72, 0x54, 0x1b, 0xad, 0xb2, 0xf4, 0xe5,
+	0xe1, 0x00, 0x9f, 0x9b, 0xb7, 0xb4, 0x8c, 0xc1, 0x17, 0x23, 0xe0, 0x42, 0x4b, 0xef, 0x69, 0x69,
+	0x0c, 0x04, 0x78, 0xc2, 0x8f, 0x71, 0x18, 0xd2, 0x11, 0xa9, 0x6b, 0xc7, 0xc0, 0x45, 0x42, 0xe2,
+	0x9a, 0xd6, 0xa9, 0xf9, 0x8e, 0x71, 0x9a, 0x44, 0x58, 0x50, 0x56, 0xf3, 0xc6, 0x59, 0xc6, 0xe8,
+	0x18, 0xa7, 0xc7, 0x19, 0x4d, 0x93, 0xf0, 0x44, 0x69, 0xdd, 0x6f, 0x0d, 0xf4, 0xc2, 0x3b, 0x39,
+	0x38, 0xbb, 0x79, 0x90, 0xbb, 0x0c, 0xb0, 0x80, 0xc8, 0xda, 0x40, 0xcb, 0xca, 0x6b, 0xaf, 0x67,
+	0x1b, 0x6d, 0xa3, 0xd3, 0x08, 0xce, 0xf7, 0xd6, 0xbb, 0xc8, 0x0a, 0x29, 0x65, 0x51, 0x42, 0xf2,
+	0x4b, 0x76, 0xa2, 0x88, 0x01, 0xe7, 0xf6, 0x62, 0xdb, 0xe8, 0xac, 0x74, 0xed, 0x5f, 0x7e, 0xbc,
+	0xdf, 0xd2, 0x18, 0x68, 0xcd, 0x81, 0x60, 0x09, 0x89, 0x83, 0x4b, 0x7c, 0xac, 0x57, 0xd0, 0x73,
+	0x25, 0xe9, 0x5e, 0xcf, 0x36, 0xe5, 0x55, 0x55, 0xa1, 0x4b, 0xd1, 0x9a, 0x0c, 0x30, 0x50, 0x88,
+	0x15, 0x21, 0xda, 0xa8, 0x19, 0xe6, 0x4b, 0xca, 0x64, 0x84, 0x2b, 0x41, 0xb1, 0xb5, 0xde, 0x46,
+	0x4d, 0x8d, 0xae, 0x8c, 0x6a, 0x75, 0xdb, 0xf1, 0x2e, 0x2d, 0xb8, 0xa7, 0x4f, 0xec, 0x36, 0x1e,
+	0x3f, 0xdd, 0x5c, 0x08, 0x0a, 0x27, 0xf7, 0x61, 0xf5, 0xc2, 0x03, 0x10, 0x22, 0x9d, 0x82, 0xc9,
+	0x3d, 0xb4, 0xa2, 0xbd, 0xf7, 0x7a, 0xf2, 0xd2, 0x46, 0x70, 0x21, 0xc8, 0x3d, 0x15, 0xf8, 0x10,
+	0xc9, 0x14, 0x97, 0x83, 0xf3, 0xbd, 0xfb, 0x11, 0x5a, 0xaf, 0x64, 0x87, 0x49, 0x08, 0xe9, 0xbf,
+	0xba, 0xce, 0xfd, 0x79, 0x11, 0xd9, 0xf2, 0xcc, 0x07, 0xaa, 0x23, 0x76, 0x54, 0xbb, 0xec, 0x44,
+	0xd1, 0x94, 0x63, 0xb7, 0x51, 0x13, 0xcf, 0x58, 0xce, 0xc2, 0xd0, 0xfa, 0xca, 0x40, 0x4b, 0x39,
+	0x1b, 0xb8, 0x6d, 0xb6, 0xcd, 0xce, 0xea, 0xf6, 0x5d, 0x4f, 0xdb, 0xe7, 0x7c, 0xf1, 0x34, 0x5f,
+	0xbc, 0x5d, 0x9a, 0x90, 0xee, 0xa7, 0x39, 0xcc, 0x7f, 0x3d, 0xdd, 0x7c, 0x2d, 0x4e, 0xc4, 0x60,
+	0xd4, 0xf7, 0x42, 0x3a, 0xd4, 0x7c, 0xd1, 0x3f, 0xf7, 0x79, 0xf4, 0xd0, 0x17, 0x27, 0x19, 0x70,
+	0xe9, 0xf0, 0xfd, 0x6f, 0x9b, 0x9d, 0x19, 0x4d, 0x79, 0xa0, 0x82, 0x98, 0xd0, 0x9c, 0x8d, 0x67,
+	0x6f, 0x4e, 0xf7, 0xcb, 0x02, 0xc5, 0x23, 0xc5, 0xba, 0x1b, 0x45, 0xf1, 0x00, 0xdd, 0xd1, 0xe4,
+	0xfe, 0x30, 0x13, 0x09, 0x95, 0x68, 0xe6, 0x9d, 0xfb, 0xea, 0x84, 0xce, 0x3d, 0xaa, 0x18, 0xeb,
+	0x06, 0xae, 0x1d, 0x71, 0x8d, 0x58, 0x7c, 0x67, 0x6a, 0x4a, 0x1c, 0x15, 0xb3, 0xe5, 0x66, 0x60,
+	0x68, 0xa1, 0xa5, 0x18, 0xc8, 0xe1, 0x23, 0x99, 0xfd, 0xed, 0x40, 0x6d, 0x2c, 0x07, 0xa1, 0x90,
+	0x12, 0xbe, 0x3f, 0xea, 0xbf, 0x07, 0x27, 0x32, 0xfe, 0xdb, 0x41, 0x49, 0x62, 0x3d, 0x40, 0x77,
+	0x38, 0xa4, 0x9f, 0xf7, 0x20, 0x85, 0x18, 0xe7, 0xa9, 0xdb, 0x4b, 0x6d, 0xe3, 0xea, 0x56, 0xd4,
+	0x80, 0x55, 0xdd, 0xac, 0x37, 0x51, 0x23, 0x03, 0x60, 0xf6, 0x2d, 0xe9, 0xfe, 0xf2, 0x04, 0xec,
+	0xf7, 0x01, 0x98, 0x3e, 0x40, 0x9a, 0x5b, 0x6d, 0xb4, 0x3a, 0xc0, 0x7c, 0x17, 0x0f, 0x33, 0x9c,
+	0xc4, 0xc4, 0x6e, 0x4a, 0x86, 0x97, 0x45, 0x32, 0x03, 0xbd, 0xde, 0xeb, 0xd9, 0xcb, 0x12, 0xa9,
+	0x92, 0x64, 0x42, 0xa5, 0x56, 0xe6, 0xa8, 0xd4, 0x37, 0x26, 0xda, 0xb8, 0x84, 0xfb, 0x1f, 0x67,
+	0x11, 0x16, 0x37, 0x50, 0xb0, 0xff, 0x18, 0xfb, 0x3f, 0xf8, 0x07, 0x8d, 0x1a, 0xcf, 0x40, 0xa3,
+	0x19, 0x09, 0xb4, 0x34, 0x47, 0x59, 0xfe, 0x58, 0x44, 0xeb, 0x55, 0x02, 0xdd, 0x54, 0x45, 0xfe,
+	0x9f, 0x14, 0xba, 0x1c, 0xe9, 0xe6, 0x1c, 0x48, 0xff, 0x60, 0xe8, 0x51, 0xa5, 0x3b, 0x3f, 0x80,
+	0x61, 0xfe, 0x3f, 0x5b, 0xc6, 0xd2, 0x98, 0x15, 0xcb, 0x72, 0x6d, 0x16, 0x67, 0x7a, 0x05, 0x99,
+	0x73, 0x44, 0xfc, 0xa7, 0x51, 0xef, 0x8d, 0x22, 0xe6, 0xb7, 0xd0, 0x4b, 0xfa, 0x51, 0x77, 0xae,
+	0xd2, 0x59, 0xe9, 0x27, 0xcf, 0x24, 0xf5, 0x95, 0x91, 0xd7, 0xc6, 0x95, 0x39, 0x6d, 0x5c, 0x35,
+	0x66, 0x1c, 0x57, 0xf3, 0xf0, 0xe2, 0x33, 0xd4, 0x92, 0xa9, 0xbf, 0x2f, 0x83, 0x3b, 0x64, 0x49,
+	0x1c, 0x03, 0x9b, 0xc2, 0x8a, 0x0e, 0x7a, 0x5e, 0xad, 0x0f, 0x93, 0x21, 0x70, 0x81, 0x87, 0x99,
+	0x4c, 0xd1, 0x0c, 0xea, 0x62, 0x77, 0x0b, 0xad, 0x95, 0x4e, 0x0f, 0x60, 0x0c, 0x6c, 0x0a, 0xe5,
+	0xdc, 0xd7, 0x91, 0x75, 0xf1, 0x1a, 0xde, 0x61, 0xe1, 0x20, 0x19, 0x4f, 0xf1, 0xf8, 0xc9, 0x40,
+	0x2f, 0xaa, 0x86, 0xd3, 0xef, 0xeb, 0x7d, 0xf9, 0xbc, 0x3e, 0x00, 0x91, 0xff, 0xab, 0xe3, 0x8a,
+	0xd0, 0x36, 0xae, 0x1c, 0x47, 0xd5, 0x13, 0x0a, 0x86, 0x55, 0x8f, 0xb8, 0xbe, 0xe7, 0x77, 0xb7,
+	0xfb, 0xf8, 0xd4, 0x31, 0x9e, 0x9c, 0x3a, 0xc6, 0xef, 0xa7, 0x8e, 0xf1, 0xf5, 0x99, 0xb3, 0xf0,
+	0xe4, 0xcc, 0x59, 0xf8, 0xf5, 0xcc, 0x59, 0xf8, 0xa4, 0x3c, 0x78, 0x2f, 0x42, 0xf5, 0x79, 0x46,
+	0xfc, 0x47, 0xbe, 0xfe, 0x9c, 0x90, 0xe3, 0xb7, 0x7f, 0x4b, 0x7e, 0x45, 0xbc, 0xf1, 0xf7, 0x00,
+	0x8b, 0x8b, 0xc7, 0x77, 0x68, 0x0d, 0x00, 0x00,
 }
 
 func (m *EventChainCreated) Marshal() (dAtA []byte, err error) {
@@ -865,7 +1194,7 @@ func (m *EventRequestSettled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventGenesisAccountAdded) Marshal() (dAtA []byte, err error) {
+func (m *EventRequestCancelled) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -875,36 +1204,69 @@ func (m *EventGenesisAccountAdded) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventGenesisAccountAdded) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventRequestCancelled) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventGenesisAccountAdded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventRequestCancelled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.CoordinatorAddress) > 0 {
-		i -= len(m.CoordinatorAddress)
-		copy(dAtA[i:], m.CoordinatorAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.CoordinatorAddress)))
+	if m.RequestID != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.RequestID))
 		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Coins) > 0 {
-		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+		dAtA[i] = 0x10
+	}
+	if m.LaunchID != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.LaunchID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventGenesisAccountAdded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventGenesisAccountAdded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventGenesisAccountAdded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CoordinatorAddress) > 0 {
+		i -= len(m.CoordinatorAddress)
+		copy(dAtA[i:], m.CoordinatorAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CoordinatorAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
 	if len(m.Address) > 0 {
 		i -= len(m.Address)
@@ -1064,6 +1426,150 @@ func (m *EventValidatorAdded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventGenesisAccountUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventGenesisAccountUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventGenesisAccountUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CoordinatorAddress) > 0 {
+		i -= len(m.CoordinatorAddress)
+		copy(dAtA[i:], m.CoordinatorAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CoordinatorAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.VestingOptions != nil {
+		{
+			size, err := m.VestingOptions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.LaunchID != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.LaunchID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventValidatorUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventValidatorUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventValidatorUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CoordinatorAddress) > 0 {
+		i -= len(m.CoordinatorAddress)
+		copy(dAtA[i:], m.CoordinatorAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CoordinatorAddress)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size, err := m.Peer.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.SelfDelegation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.ConsPubKey) > 0 {
+		i -= len(m.ConsPubKey)
+		copy(dAtA[i:], m.ConsPubKey)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ConsPubKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.GenTx) > 0 {
+		i -= len(m.GenTx)
+		copy(dAtA[i:], m.GenTx)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.GenTx)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.LaunchID != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.LaunchID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventAccountRemoved) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1224,6 +1730,74 @@ func (m *EventLaunchReverted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventChainArchived) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventChainArchived) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventChainArchived) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LaunchID != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.LaunchID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventApprovalPolicySet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventApprovalPolicySet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventApprovalPolicySet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CoordinatorAddress) > 0 {
+		i -= len(m.CoordinatorAddress)
+		copy(dAtA[i:], m.CoordinatorAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CoordinatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.ApprovalPolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -1287,6 +1861,21 @@ func (m *EventRequestSettled) Size() (n int) {
 	return n
 }
 
+func (m *EventRequestCancelled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LaunchID != 0 {
+		n += 1 + sovEvents(uint64(m.LaunchID))
+	}
+	if m.RequestID != 0 {
+		n += 1 + sovEvents(uint64(m.RequestID))
+	}
+	return n
+}
+
 func (m *EventGenesisAccountAdded) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1373,13 +1962,75 @@ func (m *EventValidatorAdded) Size() (n int) {
 	return n
 }
 
-func (m *EventAccountRemoved) Size() (n int) {
+func (m *EventGenesisAccountUpdated) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Address)
+	if m.LaunchID != 0 {
+		n += 1 + sovEvents(uint64(m.LaunchID))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if m.VestingOptions != nil {
+		l = m.VestingOptions.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.CoordinatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventValidatorUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LaunchID != 0 {
+		n += 1 + sovEvents(uint64(m.LaunchID))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.GenTx)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ConsPubKey)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.SelfDelegation.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Peer.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.CoordinatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAccountRemoved) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
@@ -1446,6 +2097,33 @@ func (m *EventLaunchReverted) Size() (n int) {
 	return n
 }
 
+func (m *EventChainArchived) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LaunchID != 0 {
+		n += 1 + sovEvents(uint64(m.LaunchID))
+	}
+	return n
+}
+
+func (m *EventApprovalPolicySet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ApprovalPolicy.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.CoordinatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1795,6 +2473,94 @@ func (m *EventRequestSettled) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventRequestCancelled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRequestCancelled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRequestCancelled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LaunchID", wireType)
+			}
+			m.LaunchID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LaunchID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
+			}
+			m.RequestID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EventGenesisAccountAdded) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1988,7 +2754,479 @@ func (m *EventVestingAccountAdded) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: EventVestingAccountAdded: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventVestingAccountAdded: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventVestingAccountAdded: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LaunchID", wireType)
+			}
+			m.LaunchID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LaunchID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VestingOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoordinatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CoordinatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventValidatorAdded) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventValidatorAdded: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventValidatorAdded: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LaunchID", wireType)
+			}
+			m.LaunchID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LaunchID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenTx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GenTx = append(m.GenTx[:0], dAtA[iNdEx:postIndex]...)
+			if m.GenTx == nil {
+				m.GenTx = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsPubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsPubKey = append(m.ConsPubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.ConsPubKey == nil {
+				m.ConsPubKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfDelegation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Peer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasCampaign", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasCampaign = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignID", wireType)
+			}
+			m.CampaignID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoordinatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CoordinatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventGenesisAccountUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventGenesisAccountUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventGenesisAccountUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2043,6 +3281,40 @@ func (m *EventVestingAccountAdded) Unmarshal(dAtA []byte) error {
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, github_com_cosmos_cosmos_sdk_types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field VestingOptions", wireType)
 			}
@@ -2071,11 +3343,14 @@ func (m *EventVestingAccountAdded) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
+			if m.VestingOptions == nil {
+				m.VestingOptions = &VestingOptions{}
+			}
 			if err := m.VestingOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field CoordinatorAddress", wireType)
 			}
@@ -2128,7 +3403,7 @@ func (m *EventVestingAccountAdded) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventValidatorAdded) Unmarshal(dAtA []byte) error {
+func (m *EventValidatorUpdated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2151,10 +3426,10 @@ func (m *EventValidatorAdded) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventValidatorAdded: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventValidatorUpdated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventValidatorAdded: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventValidatorUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2343,45 +3618,6 @@ func (m *EventValidatorAdded) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasCampaign", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.HasCampaign = bool(v != 0)
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CampaignID", wireType)
-			}
-			m.CampaignID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CampaignID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 9:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field CoordinatorAddress", wireType)
 			}
@@ -2896,6 +4132,190 @@ func (m *EventLaunchReverted) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventChainArchived) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventChainArchived: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventChainArchived: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LaunchID", wireType)
+			}
+			m.LaunchID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LaunchID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventApprovalPolicySet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventApprovalPolicySet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventApprovalPolicySet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovalPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ApprovalPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoordinatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CoordinatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
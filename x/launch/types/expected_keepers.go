@@ -23,6 +23,13 @@ type CampaignKeeper interface {
 		c context.Context,
 		req *campaigntypes.QueryGetMainnetAccountBalanceRequest,
 	) (*campaigntypes.QueryGetMainnetAccountBalanceResponse, error)
+	GetMainnetVestingAccount(
+		ctx sdk.Context,
+		campaignID uint64,
+		address string,
+	) (campaigntypes.MainnetVestingAccount, bool)
+	GetCampaignMainnetVestingAccounts(ctx sdk.Context, campaignID uint64) []campaigntypes.MainnetVestingAccount
+	GetTotalShares(ctx sdk.Context) uint64
 }
 
 type MonitoringConsumerKeeper interface {
@@ -31,7 +38,16 @@ type MonitoringConsumerKeeper interface {
 
 type ProfileKeeper interface {
 	CoordinatorIDFromAddress(ctx sdk.Context, address string) (id uint64, err error)
+	CheckCoordinatorPermission(
+		ctx sdk.Context,
+		address string,
+		coordinatorID uint64,
+		permission profiletypes.CoordinatorPermission,
+	) error
 	GetCoordinator(ctx sdk.Context, id uint64) (val profiletypes.Coordinator, found bool)
+	IncrementChainsCreated(ctx sdk.Context, coordinatorID uint64)
+	IncrementChainsLaunched(ctx sdk.Context, coordinatorID uint64)
+	IncrementLaunchesReverted(ctx sdk.Context, coordinatorID uint64)
 }
 
 type AccountKeeper interface {
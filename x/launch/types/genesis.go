@@ -13,6 +13,7 @@ func DefaultGenesis() *GenesisState {
 		GenesisValidatorList: []GenesisValidator{},
 		RequestList:          []Request{},
 		RequestCounterList:   []RequestCounter{},
+		ApprovalPolicyList:   []ApprovalPolicy{},
 		Params:               DefaultParams(),
 	}
 }
@@ -34,6 +35,10 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	if err := validateApprovalPolicies(gs, launchIDMap); err != nil {
+		return err
+	}
+
 	return gs.Params.Validate()
 }
 
@@ -179,3 +184,27 @@ func validateAccounts(gs GenesisState, launchIDMap map[uint64]struct{}) error {
 
 	return nil
 }
+
+func validateApprovalPolicies(gs GenesisState, launchIDMap map[uint64]struct{}) error {
+	// Check for duplicated index in approval policy
+	approvalPolicyIndexMap := make(map[uint64]struct{})
+	for _, elem := range gs.ApprovalPolicyList {
+		if _, ok := approvalPolicyIndexMap[elem.LaunchID]; ok {
+			return fmt.Errorf("duplicated index for approval policy")
+		}
+		approvalPolicyIndexMap[elem.LaunchID] = struct{}{}
+
+		// Each approval policy must be associated with an existing chain
+		if _, ok := launchIDMap[elem.LaunchID]; !ok {
+			return fmt.Errorf("approval policy to a non-existing chain: %d",
+				elem.LaunchID,
+			)
+		}
+
+		if err := elem.Validate(); err != nil {
+			return fmt.Errorf("invalid approval policy for chain %d: %s", elem.LaunchID, err.Error())
+		}
+	}
+
+	return nil
+}
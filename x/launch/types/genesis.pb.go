@@ -34,6 +34,7 @@ type GenesisState struct {
 	RequestList          []Request          `protobuf:"bytes,6,rep,name=requestList,proto3" json:"requestList"`
 	RequestCounterList   []RequestCounter   `protobuf:"bytes,7,rep,name=requestCounterList,proto3" json:"requestCounterList"`
 	Params               Params             `protobuf:"bytes,8,opt,name=params,proto3" json:"params"`
+	ApprovalPolicyList   []ApprovalPolicy   `protobuf:"bytes,9,rep,name=approvalPolicyList,proto3" json:"approvalPolicyList"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -125,6 +126,13 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetApprovalPolicyList() []ApprovalPolicy {
+	if m != nil {
+		return m.ApprovalPolicyList
+	}
+	return nil
+}
+
 type RequestCounter struct {
 	LaunchID uint64 `protobuf:"varint,1,opt,name=launchID,proto3" json:"launchID,omitempty"`
 	Counter  uint64 `protobuf:"varint,2,opt,name=counter,proto3" json:"counter,omitempty"`
@@ -185,34 +193,36 @@ func init() {
 func init() { proto.RegisterFile("launch/genesis.proto", fileDescriptor_02cd66d27edc51cd) }
 
 var fileDescriptor_02cd66d27edc51cd = []byte{
-	// 429 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x4d, 0x8b, 0xda, 0x40,
-	0x18, 0xc7, 0x93, 0x9a, 0xaa, 0x1d, 0xa5, 0x87, 0xa9, 0x85, 0x20, 0x76, 0x2a, 0x42, 0x69, 0x4e,
-	0x09, 0xd8, 0x63, 0x2f, 0xad, 0x16, 0xa5, 0xd0, 0x43, 0xc9, 0x82, 0x87, 0xdd, 0xc3, 0x32, 0xc6,
-	0x21, 0x06, 0x74, 0x92, 0xcd, 0x4c, 0x64, 0xf7, 0x5b, 0xec, 0xc7, 0xf2, 0xe8, 0x71, 0x4f, 0xcb,
-	0xa2, 0x1f, 0x61, 0xbf, 0xc0, 0x92, 0xc9, 0x13, 0x35, 0xbe, 0xdd, 0xf2, 0xbc, 0xfc, 0x7f, 0xff,
-	0x67, 0x1e, 0x9e, 0xa0, 0xc6, 0x8c, 0x26, 0xdc, 0x9b, 0x3a, 0x3e, 0xe3, 0x4c, 0x04, 0xc2, 0x8e,
-	0xe2, 0x50, 0x86, 0xf8, 0xb3, 0x64, 0x7c, 0xc2, 0xe2, 0x79, 0xc0, 0xa5, 0x2d, 0x22, 0x6e, 0x67,
-	0x4d, 0xcd, 0x86, 0x1f, 0xfa, 0xa1, 0xea, 0x70, 0xd2, 0xaf, 0xac, 0xb9, 0x99, 0x23, 0x62, 0x76,
-	0x97, 0x30, 0x21, 0x21, 0xdb, 0x82, 0xec, 0x82, 0x09, 0x19, 0x70, 0xff, 0x96, 0x7a, 0x5e, 0x98,
-	0xf0, 0xc3, 0x2a, 0xd8, 0x1e, 0x54, 0xc9, 0x41, 0x75, 0x41, 0x67, 0xc1, 0x84, 0xca, 0x30, 0x86,
-	0x3a, 0x86, 0xba, 0x37, 0xa5, 0x01, 0x87, 0xdc, 0x27, 0xc8, 0x45, 0x34, 0xa6, 0x73, 0x78, 0x47,
-	0xe7, 0xd5, 0x40, 0xf5, 0x61, 0x06, 0xb9, 0x92, 0x54, 0x32, 0xfc, 0x0b, 0x7d, 0x50, 0xa2, 0x7f,
-	0x81, 0x90, 0xa6, 0xde, 0x2e, 0x59, 0xb5, 0x6e, 0xcb, 0x3e, 0xf9, 0x58, 0xbb, 0x9f, 0xf6, 0xf5,
-	0x8c, 0xe5, 0xf3, 0x57, 0xcd, 0xdd, 0x89, 0x70, 0x07, 0xd5, 0x55, 0xd0, 0x4f, 0xe7, 0x65, 0xb1,
-	0xf9, 0xae, 0xad, 0x5b, 0x86, 0x5b, 0xc8, 0xe1, 0x1b, 0x84, 0x61, 0xf4, 0xdf, 0xd9, 0xbb, 0x94,
-	0x5d, 0x49, 0xd9, 0x7d, 0x3b, 0x63, 0x37, 0x2c, 0x08, 0xc0, 0xf7, 0x04, 0x26, 0x85, 0xc3, 0x4e,
-	0xf7, 0xe1, 0xc6, 0x45, 0xf8, 0xa8, 0x20, 0xc8, 0xe1, 0xc7, 0x18, 0x4c, 0x51, 0x03, 0x2c, 0x47,
-	0xf9, 0xce, 0x15, 0xfe, 0xbd, 0xc2, 0x7f, 0xbf, 0x3c, 0xfb, 0x56, 0x02, 0x06, 0x27, 0x51, 0x78,
-	0x80, 0x6a, 0x70, 0x29, 0x8a, 0x5c, 0x56, 0x64, 0x72, 0x86, 0xec, 0x66, 0x9d, 0x00, 0xdc, 0x17,
-	0xa6, 0x7b, 0x80, 0x10, 0xd6, 0xae, 0x70, 0x95, 0x8b, 0x7b, 0x70, 0x0b, 0x82, 0x7c, 0x0f, 0xc7,
-	0x18, 0xfc, 0x13, 0x95, 0xb3, 0x43, 0x32, 0xab, 0x6d, 0xdd, 0xaa, 0x75, 0xbf, 0x9c, 0x01, 0xfe,
-	0x57, 0x4d, 0x00, 0x02, 0x49, 0x67, 0x80, 0x3e, 0x16, 0x8d, 0x70, 0x13, 0x55, 0x33, 0xc1, 0xdf,
-	0x3f, 0xa6, 0xae, 0x0e, 0x66, 0x1b, 0x63, 0x13, 0x55, 0xbc, 0xc2, 0x2d, 0xe5, 0x61, 0xaf, 0xb7,
-	0x5c, 0x13, 0x7d, 0xb5, 0x26, 0xfa, 0xcb, 0x9a, 0xe8, 0x8f, 0x1b, 0xa2, 0xad, 0x36, 0x44, 0x7b,
-	0xda, 0x10, 0xed, 0xda, 0xf2, 0x03, 0x39, 0x4d, 0xc6, 0xb6, 0x17, 0xce, 0x9d, 0xdd, 0x60, 0x8e,
-	0x88, 0xb8, 0x73, 0xef, 0xc0, 0x8f, 0x20, 0x1f, 0x22, 0x26, 0xc6, 0x65, 0xf5, 0x23, 0xfc, 0x78,
-	0x0b, 0x00, 0x00, 0xff, 0xff, 0x00, 0x8a, 0x86, 0x19, 0xe8, 0x03, 0x00, 0x00,
+	// 460 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x41, 0x6b, 0xdb, 0x30,
+	0x14, 0xc7, 0xed, 0x35, 0x4d, 0x5b, 0xa5, 0xec, 0xa0, 0x65, 0x60, 0x42, 0xa7, 0x85, 0xc0, 0x98,
+	0x4f, 0x36, 0x74, 0xc7, 0x5d, 0xd6, 0x74, 0xb4, 0x0c, 0x76, 0x28, 0x1e, 0xf4, 0xb0, 0x1d, 0x8a,
+	0xea, 0x0a, 0x47, 0xe0, 0x48, 0x9a, 0x25, 0x87, 0xf5, 0x5b, 0xec, 0x63, 0xf5, 0x98, 0xe3, 0x4e,
+	0x63, 0x24, 0x5f, 0x64, 0x58, 0x7a, 0x6e, 0x6b, 0x27, 0xf1, 0x2d, 0x7a, 0xef, 0xfd, 0x7f, 0xff,
+	0xf7, 0x5e, 0x9e, 0xd1, 0x30, 0xa7, 0xa5, 0x48, 0x67, 0x71, 0xc6, 0x04, 0xd3, 0x5c, 0x47, 0xaa,
+	0x90, 0x46, 0xe2, 0xd7, 0x86, 0x89, 0x3b, 0x56, 0xcc, 0xb9, 0x30, 0x91, 0x56, 0x22, 0x72, 0x45,
+	0xa3, 0x61, 0x26, 0x33, 0x69, 0x2b, 0xe2, 0xea, 0x97, 0x2b, 0x1e, 0xd5, 0x88, 0x82, 0xfd, 0x2c,
+	0x99, 0x36, 0x10, 0x3d, 0x81, 0xe8, 0x82, 0x69, 0xc3, 0x45, 0x76, 0x43, 0xd3, 0x54, 0x96, 0xa2,
+	0x9d, 0x05, 0xdb, 0x56, 0x96, 0xb4, 0xb2, 0x0b, 0x9a, 0xf3, 0x3b, 0x6a, 0x64, 0x01, 0x79, 0x0c,
+	0xf9, 0x74, 0x46, 0xb9, 0x80, 0xd8, 0x2b, 0x88, 0x29, 0x5a, 0xd0, 0xb9, 0x6e, 0xd9, 0x50, 0xa5,
+	0x0a, 0xb9, 0xa0, 0xf9, 0x8d, 0x92, 0x39, 0x4f, 0xef, 0x5d, 0x76, 0xb2, 0xdc, 0x47, 0xc7, 0x97,
+	0xce, 0xe2, 0x9b, 0xa1, 0x86, 0xe1, 0x4f, 0xe8, 0xc8, 0x22, 0xbf, 0x72, 0x6d, 0x02, 0x7f, 0xbc,
+	0x17, 0x0e, 0x4e, 0x4f, 0xa2, 0xad, 0xab, 0x88, 0xce, 0xab, 0xba, 0x69, 0xef, 0xe1, 0xef, 0x5b,
+	0x2f, 0x79, 0x12, 0xe1, 0x09, 0x3a, 0xb6, 0x8f, 0xf3, 0x6a, 0x1a, 0x56, 0x04, 0x2f, 0xc6, 0x7e,
+	0xd8, 0x4b, 0x1a, 0x31, 0xfc, 0x03, 0x61, 0x18, 0xec, 0xcc, 0x4d, 0x6d, 0xed, 0xf6, 0xac, 0xdd,
+	0xbb, 0x1d, 0x76, 0x97, 0x0d, 0x01, 0xf8, 0x6e, 0xc1, 0x54, 0x70, 0xd8, 0xf8, 0x73, 0x78, 0xaf,
+	0x13, 0x7e, 0xdd, 0x10, 0xd4, 0xf0, 0x4d, 0x0c, 0xa6, 0x68, 0x08, 0x96, 0xd7, 0xf5, 0x3f, 0x62,
+	0xf1, 0xfb, 0x16, 0xff, 0xbe, 0xbb, 0xf7, 0x47, 0x09, 0x18, 0x6c, 0x45, 0xe1, 0x0b, 0x34, 0x80,
+	0x3b, 0xb2, 0xe4, 0xbe, 0x25, 0x93, 0x1d, 0xe4, 0xc4, 0x55, 0x02, 0xf0, 0xb9, 0xb0, 0xda, 0x03,
+	0x3c, 0x61, 0xed, 0x16, 0x77, 0xd0, 0xb9, 0x87, 0xa4, 0x21, 0xa8, 0xf7, 0xb0, 0x89, 0xc1, 0x1f,
+	0x51, 0xdf, 0x9d, 0x59, 0x70, 0x38, 0xf6, 0xc3, 0xc1, 0xe9, 0x9b, 0x1d, 0xc0, 0x2b, 0x5b, 0x04,
+	0x20, 0x90, 0x54, 0x9d, 0xd5, 0xe7, 0x78, 0x65, 0xaf, 0xd1, 0x76, 0x76, 0xd4, 0xd9, 0xd9, 0x59,
+	0x43, 0x50, 0x77, 0xb6, 0x89, 0x99, 0x5c, 0xa0, 0x97, 0xcd, 0x29, 0xf0, 0x08, 0x1d, 0x3a, 0xc8,
+	0x97, 0xcf, 0x81, 0x6f, 0xaf, 0xf1, 0xf1, 0x8d, 0x03, 0x74, 0x90, 0x36, 0x0e, 0xb5, 0x7e, 0x4e,
+	0xa7, 0x0f, 0x2b, 0xe2, 0x2f, 0x57, 0xc4, 0xff, 0xb7, 0x22, 0xfe, 0xef, 0x35, 0xf1, 0x96, 0x6b,
+	0xe2, 0xfd, 0x59, 0x13, 0xef, 0x7b, 0x98, 0x71, 0x33, 0x2b, 0x6f, 0xa3, 0x54, 0xce, 0xe3, 0xa7,
+	0x66, 0x63, 0xad, 0x44, 0xfc, 0x2b, 0x86, 0xcf, 0xcd, 0xdc, 0x2b, 0xa6, 0x6f, 0xfb, 0xf6, 0x2b,
+	0xfb, 0xf0, 0x7f, 0x00, 0x75, 0xcb, 0x61, 0x46, 0x63, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -235,6 +245,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ApprovalPolicyList) > 0 {
+		for iNdEx := len(m.ApprovalPolicyList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ApprovalPolicyList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -428,6 +452,12 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ApprovalPolicyList) > 0 {
+		for _, e := range m.ApprovalPolicyList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -737,6 +767,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovalPolicyList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApprovalPolicyList = append(m.ApprovalPolicyList, ApprovalPolicy{})
+			if err := m.ApprovalPolicyList[len(m.ApprovalPolicyList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
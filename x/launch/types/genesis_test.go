@@ -98,6 +98,7 @@ func TestGenesisState_Validate(t *testing.T) {
 				GenesisValidatorList: sampleGenesisValidatorList,
 				RequestList:          sampleRequestList,
 				RequestCounterList:   sampleRequestCounterList,
+				ApprovalPolicyList:   []types.ApprovalPolicy{sample.ApprovalPolicy(r, launchID1)},
 				Params:               types.DefaultParams(),
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
@@ -386,6 +387,43 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			shouldBeValid: false,
 		},
+		{
+			desc: "should prevent validate genesis with duplicated approval policies",
+			genState: &types.GenesisState{
+				ChainList:    sampleChainList,
+				ChainCounter: 10,
+				ApprovalPolicyList: []types.ApprovalPolicy{
+					sample.ApprovalPolicy(r, launchID1),
+					sample.ApprovalPolicy(r, launchID1),
+				},
+				Params: types.DefaultParams(),
+			},
+			shouldBeValid: false,
+		},
+		{
+			desc: "should prevent validate genesis with an approval policy not associated with chain",
+			genState: &types.GenesisState{
+				ChainList:    sampleChainList,
+				ChainCounter: 10,
+				ApprovalPolicyList: []types.ApprovalPolicy{
+					sample.ApprovalPolicy(r, noExistLaunchID),
+				},
+				Params: types.DefaultParams(),
+			},
+			shouldBeValid: false,
+		},
+		{
+			desc: "should prevent validate genesis with an invalid approval policy",
+			genState: &types.GenesisState{
+				ChainList:    sampleChainList,
+				ChainCounter: 10,
+				ApprovalPolicyList: []types.ApprovalPolicy{
+					types.NewApprovalPolicy(launchID1, nil, nil, []string{"invalid"}),
+				},
+				Params: types.DefaultParams(),
+			},
+			shouldBeValid: false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			err := tc.genState.Validate()
@@ -436,14 +474,14 @@ func TestGenesisState_ValidateParams(t *testing.T) {
 		{
 			desc: "should prevent validate genesis with invalid params",
 			genState: types.GenesisState{
-				Params: types.NewParams(types.DefaultMinLaunchTime, types.MaxParametrableLaunchTime+1, types.DefaultRevertDelay, types.DefaultChainCreationFee),
+				Params: types.NewParams(types.DefaultMinLaunchTime, types.MaxParametrableLaunchTime+1, types.DefaultRevertDelay, types.DefaultChainCreationFee, types.DefaultRequestExpiration, types.DefaultValidatorSetThreshold, types.DefaultMaxNonGenesisValidators),
 			},
 			shouldBeValid: false,
 		},
 		{
 			desc: "should validate genesis with valid params",
 			genState: types.GenesisState{
-				Params: types.NewParams(types.DefaultMinLaunchTime, types.DefaultMaxLaunchTime, types.DefaultRevertDelay, types.DefaultChainCreationFee),
+				Params: types.NewParams(types.DefaultMinLaunchTime, types.DefaultMaxLaunchTime, types.DefaultRevertDelay, types.DefaultChainCreationFee, types.DefaultRequestExpiration, types.DefaultValidatorSetThreshold, types.DefaultMaxNonGenesisValidators),
 			},
 			shouldBeValid: true,
 		},
@@ -38,6 +38,18 @@ const (
 
 	// RequestCounterKeyPrefix is the prefix to store request counter
 	RequestCounterKeyPrefix = "Request/count/"
+
+	// RequestExpirationKeyPrefix is the prefix to retrieve the pending requests by expiration time
+	RequestExpirationKeyPrefix = "Request/expiration/"
+
+	// RequestByStatusKeyPrefix is the prefix to retrieve the requests of a chain by status
+	RequestByStatusKeyPrefix = "Request/status/"
+
+	// RequestByCreatorKeyPrefix is the prefix to retrieve the requests by creator
+	RequestByCreatorKeyPrefix = "Request/creator/"
+
+	// ApprovalPolicyKeyPrefix is the prefix to retrieve all ApprovalPolicy
+	ApprovalPolicyKeyPrefix = "ApprovalPolicy/value/"
 )
 
 func KeyPrefix(p string) []byte {
@@ -94,3 +106,35 @@ func RequestPoolKey(launchID uint64) []byte {
 func RequestCounterKey(launchID uint64) []byte {
 	return append(spntypes.UintBytes(launchID), byte('/'))
 }
+
+// RequestExpirationKey returns the store key to index a pending request by its expiration time
+func RequestExpirationKey(expiresAt int64, launchID, requestID uint64) []byte {
+	return append(RequestExpirationTimeKey(expiresAt), RequestKey(launchID, requestID)...)
+}
+
+// RequestExpirationTimeKey returns the store key prefix of the pending requests expiring at the provided time
+func RequestExpirationTimeKey(expiresAt int64) []byte {
+	return spntypes.UintBytes(uint64(expiresAt))
+}
+
+// RequestByStatusKey returns the store key to index a request by its chain and status
+func RequestByStatusKey(launchID uint64, status Request_Status, requestID uint64) []byte {
+	requestIDBytes := append(spntypes.UintBytes(requestID), byte('/'))
+	return append(RequestByStatusPoolKey(launchID, status), requestIDBytes...)
+}
+
+// RequestByStatusPoolKey returns the store key prefix of the requests of a chain with a specific status
+func RequestByStatusPoolKey(launchID uint64, status Request_Status) []byte {
+	statusBytes := append(spntypes.UintBytes(uint64(status)), byte('/'))
+	return append(RequestPoolKey(launchID), statusBytes...)
+}
+
+// RequestByCreatorKey returns the store key to index a request by its creator
+func RequestByCreatorKey(creator string, launchID, requestID uint64) []byte {
+	return append(RequestByCreatorPoolKey(creator), RequestKey(launchID, requestID)...)
+}
+
+// RequestByCreatorPoolKey returns the store key prefix of the requests sent by a creator
+func RequestByCreatorPoolKey(creator string) []byte {
+	return []byte(creator + "/")
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgCancelRequest = "cancel_request"
+
+var _ sdk.Msg = &MsgCancelRequest{}
+
+func NewMsgCancelRequest(creator string, launchID uint64, requestID uint64) *MsgCancelRequest {
+	return &MsgCancelRequest{
+		Signer:    creator,
+		LaunchID:  launchID,
+		RequestID: requestID,
+	}
+}
+
+func (msg *MsgCancelRequest) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCancelRequest) Type() string {
+	return TypeMsgCancelRequest
+}
+
+func (msg *MsgCancelRequest) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCancelRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCancelRequest) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrortypes.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	return nil
+}
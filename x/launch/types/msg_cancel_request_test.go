@@ -0,0 +1,48 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkerrortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+func TestMsgCancelRequest_ValidateBasic(t *testing.T) {
+	launchID := uint64(0)
+	tests := []struct {
+		name string
+		msg  types.MsgCancelRequest
+		err  error
+	}{
+		{
+			name: "should prevent validate message with invalid creator address",
+			msg: types.MsgCancelRequest{
+				Signer:    "invalid_address",
+				LaunchID:  launchID,
+				RequestID: 10,
+			},
+			err: sdkerrortypes.ErrInvalidAddress,
+		},
+		{
+			name: "should validate valid message",
+			msg: types.MsgCancelRequest{
+				Signer:    sample.Address(r),
+				LaunchID:  launchID,
+				RequestID: 10,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgRequestUpdateAccount = "request_update_account"
+
+var _ sdk.Msg = &MsgRequestUpdateAccount{}
+
+func NewMsgRequestUpdateAccount(
+	creator string,
+	launchID uint64,
+	address string,
+	coins sdk.Coins,
+	vestingOptions *VestingOptions,
+) *MsgRequestUpdateAccount {
+	return &MsgRequestUpdateAccount{
+		Creator:        creator,
+		LaunchID:       launchID,
+		Address:        address,
+		Coins:          coins,
+		VestingOptions: vestingOptions,
+	}
+}
+
+func (msg *MsgRequestUpdateAccount) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRequestUpdateAccount) Type() string {
+	return TypeMsgRequestUpdateAccount
+}
+
+func (msg *MsgRequestUpdateAccount) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRequestUpdateAccount) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRequestUpdateAccount) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.Wrapf(sdkerrortypes.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	content := NewGenesisAccountUpdate(msg.LaunchID, msg.Address, msg.Coins, msg.VestingOptions)
+	return content.Validate()
+}
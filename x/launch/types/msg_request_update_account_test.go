@@ -0,0 +1,76 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkerrortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	tc "github.com/tendermint/spn/testutil/constructor"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+func TestMsgRequestUpdateAccount_ValidateBasic(t *testing.T) {
+	var (
+		launchID       = uint64(10)
+		vestingOptions = sample.VestingOptions(r)
+		invalidOptions = *types.NewDelayedVesting(tc.Coins(t, "100foo"), tc.Coins(t, "100foo"), 0)
+	)
+
+	tests := []struct {
+		name string
+		msg  types.MsgRequestUpdateAccount
+		err  error
+	}{
+		{
+			name: "should prevent validate message with invalid creator address",
+			msg:  *types.NewMsgRequestUpdateAccount("invalid_address", launchID, sample.Address(r), sample.Coins(r), nil),
+			err:  sdkerrortypes.ErrInvalidAddress,
+		},
+		{
+			name: "should prevent validate message with invalid account address",
+			msg:  *types.NewMsgRequestUpdateAccount(sample.Address(r), launchID, "invalid_address", sample.Coins(r), nil),
+			err:  sdkerrortypes.ErrInvalidAddress,
+		},
+		{
+			name: "should prevent validate message with no coins and no vesting options",
+			msg:  *types.NewMsgRequestUpdateAccount(sample.Address(r), launchID, sample.Address(r), nil, nil),
+			err:  types.ErrInvalidCoins,
+		},
+		{
+			name: "should prevent validate message with both coins and vesting options",
+			msg: *types.NewMsgRequestUpdateAccount(
+				sample.Address(r),
+				launchID,
+				sample.Address(r),
+				sample.Coins(r),
+				&vestingOptions,
+			),
+			err: types.ErrInvalidAccountUpdate,
+		},
+		{
+			name: "should prevent validate message with invalid vesting options",
+			msg:  *types.NewMsgRequestUpdateAccount(sample.Address(r), launchID, sample.Address(r), nil, &invalidOptions),
+			err:  types.ErrInvalidVestingOption,
+		},
+		{
+			name: "should validate valid message with coins",
+			msg:  *types.NewMsgRequestUpdateAccount(sample.Address(r), launchID, sample.Address(r), sample.Coins(r), nil),
+		},
+		{
+			name: "should validate valid message with vesting options",
+			msg:  *types.NewMsgRequestUpdateAccount(sample.Address(r), launchID, sample.Address(r), nil, &vestingOptions),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
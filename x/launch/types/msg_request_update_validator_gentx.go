@@ -0,0 +1,78 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgRequestUpdateValidatorGentx = "request_update_validator_gentx"
+
+var _ sdk.Msg = &MsgRequestUpdateValidatorGentx{}
+
+func NewMsgRequestUpdateValidatorGentx(
+	creator string,
+	launchID uint64,
+	valAddress string,
+	genTx,
+	consPubKey []byte,
+	selfDelegation sdk.Coin,
+) *MsgRequestUpdateValidatorGentx {
+	return &MsgRequestUpdateValidatorGentx{
+		Creator:        creator,
+		LaunchID:       launchID,
+		ValAddress:     valAddress,
+		GenTx:          genTx,
+		ConsPubKey:     consPubKey,
+		SelfDelegation: selfDelegation,
+	}
+}
+
+func (msg *MsgRequestUpdateValidatorGentx) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRequestUpdateValidatorGentx) Type() string {
+	return TypeMsgRequestUpdateValidatorGentx
+}
+
+func (msg *MsgRequestUpdateValidatorGentx) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRequestUpdateValidatorGentx) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRequestUpdateValidatorGentx) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.Wrapf(sdkerrortypes.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.ValAddress); err != nil {
+		return sdkerrors.Wrapf(sdkerrortypes.ErrInvalidAddress, "invalid validator address (%s)", err)
+	}
+
+	if len(msg.GenTx) == 0 {
+		return sdkerrors.Wrap(ErrInvalidGenTx, "empty gentx")
+	}
+
+	if len(msg.ConsPubKey) == 0 {
+		return sdkerrors.Wrap(ErrInvalidConsPubKey, "empty consensus public key")
+	}
+
+	if !msg.SelfDelegation.IsValid() {
+		return sdkerrors.Wrap(ErrInvalidSelfDelegation, "")
+	}
+
+	if msg.SelfDelegation.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidSelfDelegation, "self delegation is zero")
+	}
+
+	return nil
+}
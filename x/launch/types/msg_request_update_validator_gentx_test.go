@@ -0,0 +1,117 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+func TestMsgRequestUpdateValidatorGentx_ValidateBasic(t *testing.T) {
+	launchID := uint64(10)
+
+	tests := []struct {
+		name string
+		msg  types.MsgRequestUpdateValidatorGentx
+		err  error
+	}{
+		{
+			name: "should prevent validate message with invalid creator address",
+			msg: *types.NewMsgRequestUpdateValidatorGentx(
+				"invalid_address",
+				launchID,
+				sample.Address(r),
+				sample.Bytes(r, 300),
+				sample.Bytes(r, 30),
+				sample.Coin(r),
+			),
+			err: sdkerrortypes.ErrInvalidAddress,
+		},
+		{
+			name: "should prevent validate message with invalid validator address",
+			msg: *types.NewMsgRequestUpdateValidatorGentx(
+				sample.Address(r),
+				launchID,
+				"invalid_address",
+				sample.Bytes(r, 300),
+				sample.Bytes(r, 30),
+				sample.Coin(r),
+			),
+			err: sdkerrortypes.ErrInvalidAddress,
+		},
+		{
+			name: "should prevent validate message with empty gentx",
+			msg: *types.NewMsgRequestUpdateValidatorGentx(
+				sample.Address(r),
+				launchID,
+				sample.Address(r),
+				nil,
+				sample.Bytes(r, 30),
+				sample.Coin(r),
+			),
+			err: types.ErrInvalidGenTx,
+		},
+		{
+			name: "should prevent validate message with empty consensus public key",
+			msg: *types.NewMsgRequestUpdateValidatorGentx(
+				sample.Address(r),
+				launchID,
+				sample.Address(r),
+				sample.Bytes(r, 300),
+				nil,
+				sample.Coin(r),
+			),
+			err: types.ErrInvalidConsPubKey,
+		},
+		{
+			name: "should prevent validate message with invalid self delegation",
+			msg: *types.NewMsgRequestUpdateValidatorGentx(
+				sample.Address(r),
+				launchID,
+				sample.Address(r),
+				sample.Bytes(r, 300),
+				sample.Bytes(r, 30),
+				sdk.Coin{Denom: "", Amount: sdkmath.NewInt(10)},
+			),
+			err: types.ErrInvalidSelfDelegation,
+		},
+		{
+			name: "should prevent validate message with zero self delegation",
+			msg: *types.NewMsgRequestUpdateValidatorGentx(
+				sample.Address(r),
+				launchID,
+				sample.Address(r),
+				sample.Bytes(r, 300),
+				sample.Bytes(r, 30),
+				sdk.NewCoin("stake", sdkmath.ZeroInt()),
+			),
+			err: types.ErrInvalidSelfDelegation,
+		},
+		{
+			name: "should validate valid message",
+			msg: *types.NewMsgRequestUpdateValidatorGentx(
+				sample.Address(r),
+				launchID,
+				sample.Address(r),
+				sample.Bytes(r, 300),
+				sample.Bytes(r, 30),
+				sample.Coin(r),
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
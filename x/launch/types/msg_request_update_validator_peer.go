@@ -0,0 +1,62 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgRequestUpdateValidatorPeer = "request_update_validator_peer"
+
+var _ sdk.Msg = &MsgRequestUpdateValidatorPeer{}
+
+func NewMsgRequestUpdateValidatorPeer(
+	creator string,
+	launchID uint64,
+	valAddress string,
+	peer Peer,
+) *MsgRequestUpdateValidatorPeer {
+	return &MsgRequestUpdateValidatorPeer{
+		Creator:    creator,
+		LaunchID:   launchID,
+		ValAddress: valAddress,
+		Peer:       peer,
+	}
+}
+
+func (msg *MsgRequestUpdateValidatorPeer) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRequestUpdateValidatorPeer) Type() string {
+	return TypeMsgRequestUpdateValidatorPeer
+}
+
+func (msg *MsgRequestUpdateValidatorPeer) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRequestUpdateValidatorPeer) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRequestUpdateValidatorPeer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.Wrapf(sdkerrortypes.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.ValAddress); err != nil {
+		return sdkerrors.Wrapf(sdkerrortypes.ErrInvalidAddress, "invalid validator address (%s)", err)
+	}
+
+	if err := msg.Peer.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidPeer, err.Error())
+	}
+
+	return nil
+}
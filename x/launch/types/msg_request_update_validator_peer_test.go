@@ -0,0 +1,71 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkerrortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+func TestMsgRequestUpdateValidatorPeer_ValidateBasic(t *testing.T) {
+	launchID := uint64(10)
+
+	tests := []struct {
+		name string
+		msg  types.MsgRequestUpdateValidatorPeer
+		err  error
+	}{
+		{
+			name: "should prevent validate message with invalid creator address",
+			msg: *types.NewMsgRequestUpdateValidatorPeer(
+				"invalid_address",
+				launchID,
+				sample.Address(r),
+				sample.GenesisValidatorPeer(r),
+			),
+			err: sdkerrortypes.ErrInvalidAddress,
+		},
+		{
+			name: "should prevent validate message with invalid validator address",
+			msg: *types.NewMsgRequestUpdateValidatorPeer(
+				sample.Address(r),
+				launchID,
+				"invalid_address",
+				sample.GenesisValidatorPeer(r),
+			),
+			err: sdkerrortypes.ErrInvalidAddress,
+		},
+		{
+			name: "should prevent validate message with invalid peer",
+			msg: *types.NewMsgRequestUpdateValidatorPeer(
+				sample.Address(r),
+				launchID,
+				sample.Address(r),
+				types.Peer{},
+			),
+			err: types.ErrInvalidPeer,
+		},
+		{
+			name: "should validate valid message",
+			msg: *types.NewMsgRequestUpdateValidatorPeer(
+				sample.Address(r),
+				launchID,
+				sample.Address(r),
+				sample.GenesisValidatorPeer(r),
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSetApprovalPolicy = "set_approval_policy"
+
+var _ sdk.Msg = &MsgSetApprovalPolicy{}
+
+func NewMsgSetApprovalPolicy(
+	coordinator string,
+	launchID uint64,
+	maxAccountCoins sdk.Coins,
+	minSelfDelegation *sdk.Coin,
+	allowlist []string,
+) *MsgSetApprovalPolicy {
+	return &MsgSetApprovalPolicy{
+		Coordinator:       coordinator,
+		LaunchID:          launchID,
+		MaxAccountCoins:   maxAccountCoins,
+		MinSelfDelegation: minSelfDelegation,
+		Allowlist:         allowlist,
+	}
+}
+
+func (msg *MsgSetApprovalPolicy) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetApprovalPolicy) Type() string {
+	return TypeMsgSetApprovalPolicy
+}
+
+func (msg *MsgSetApprovalPolicy) GetSigners() []sdk.AccAddress {
+	coordinator, err := sdk.AccAddressFromBech32(msg.Coordinator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{coordinator}
+}
+
+func (msg *MsgSetApprovalPolicy) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ApprovalPolicy returns the approval policy defined by the message
+func (msg *MsgSetApprovalPolicy) ApprovalPolicy() ApprovalPolicy {
+	return NewApprovalPolicy(msg.LaunchID, msg.MaxAccountCoins, msg.MinSelfDelegation, msg.Allowlist)
+}
+
+func (msg *MsgSetApprovalPolicy) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Coordinator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrortypes.ErrInvalidAddress, "invalid coordinator address (%s)", err)
+	}
+
+	if err := msg.ApprovalPolicy().Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidApprovalPolicy, err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	tc "github.com/tendermint/spn/testutil/constructor"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+func TestMsgSetApprovalPolicy_ValidateBasic(t *testing.T) {
+	launchID := uint64(10)
+	minSelfDelegation := sdk.NewInt64Coin("stake", 100)
+
+	tests := []struct {
+		name string
+		msg  types.MsgSetApprovalPolicy
+		err  error
+	}{
+		{
+			name: "should validate valid message",
+			msg: *types.NewMsgSetApprovalPolicy(
+				sample.Address(r),
+				launchID,
+				tc.Coins(t, "1000foo"),
+				&minSelfDelegation,
+				[]string{sample.Address(r)},
+			),
+		},
+		{
+			name: "should validate message with an empty policy",
+			msg:  *types.NewMsgSetApprovalPolicy(sample.Address(r), launchID, nil, nil, nil),
+		},
+		{
+			name: "should prevent validate message with invalid coordinator address",
+			msg:  *types.NewMsgSetApprovalPolicy("invalid_address", launchID, nil, nil, nil),
+			err:  sdkerrortypes.ErrInvalidAddress,
+		},
+		{
+			name: "should prevent validate message with invalid approval policy",
+			msg:  *types.NewMsgSetApprovalPolicy(sample.Address(r), launchID, nil, nil, []string{"invalid_address"}),
+			err:  types.ErrInvalidApprovalPolicy,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
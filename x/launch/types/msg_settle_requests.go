@@ -0,0 +1,86 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSettleRequests = "settle_requests"
+
+var _ sdk.Msg = &MsgSettleRequests{}
+
+func NewMsgSettleRequests(
+	settler string,
+	launchID uint64,
+	settlements []RequestSettlement,
+	requestIDRange *RequestIDRange,
+	bestEffort bool,
+) *MsgSettleRequests {
+	return &MsgSettleRequests{
+		Signer:         settler,
+		LaunchID:       launchID,
+		Settlements:    settlements,
+		RequestIDRange: requestIDRange,
+		BestEffort:     bestEffort,
+	}
+}
+
+func (msg *MsgSettleRequests) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSettleRequests) Type() string {
+	return TypeMsgSettleRequests
+}
+
+func (msg *MsgSettleRequests) GetSigners() []sdk.AccAddress {
+	settler, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{settler}
+}
+
+func (msg *MsgSettleRequests) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSettleRequests) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrortypes.ErrInvalidAddress, "invalid settler address (%s)", err)
+	}
+
+	if msg.RequestIDRange != nil {
+		if len(msg.Settlements) > 0 {
+			return sdkerrors.Wrap(ErrInvalidRequestSettlements, "settlements and request ID range can't be both set")
+		}
+		if msg.RequestIDRange.Start > msg.RequestIDRange.End {
+			return sdkerrors.Wrapf(ErrInvalidRequestSettlements,
+				"request ID range start %d is greater than end %d",
+				msg.RequestIDRange.Start,
+				msg.RequestIDRange.End,
+			)
+		}
+		return nil
+	}
+
+	if len(msg.Settlements) == 0 {
+		return sdkerrors.Wrap(ErrInvalidRequestSettlements, "no request to settle")
+	}
+
+	requestIDs := make(map[uint64]struct{})
+	for _, settlement := range msg.Settlements {
+		if _, ok := requestIDs[settlement.RequestID]; ok {
+			return sdkerrors.Wrapf(ErrInvalidRequestSettlements,
+				"request %d is settled several times",
+				settlement.RequestID,
+			)
+		}
+		requestIDs[settlement.RequestID] = struct{}{}
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkerrortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/launch/types"
+)
+
+func TestMsgSettleRequests_ValidateBasic(t *testing.T) {
+	launchID := uint64(0)
+	tests := []struct {
+		name string
+		msg  types.MsgSettleRequests
+		err  error
+	}{
+		{
+			name: "should prevent validate message with invalid coordinator address",
+			msg: types.MsgSettleRequests{
+				Signer:   "invalid_address",
+				LaunchID: launchID,
+				Settlements: []types.RequestSettlement{
+					{RequestID: 10, Approve: true},
+				},
+			},
+			err: sdkerrortypes.ErrInvalidAddress,
+		},
+		{
+			name: "should prevent validate message without settlement",
+			msg: types.MsgSettleRequests{
+				Signer:   sample.Address(r),
+				LaunchID: launchID,
+			},
+			err: types.ErrInvalidRequestSettlements,
+		},
+		{
+			name: "should prevent validate message with duplicated settlements",
+			msg: types.MsgSettleRequests{
+				Signer:   sample.Address(r),
+				LaunchID: launchID,
+				Settlements: []types.RequestSettlement{
+					{RequestID: 10, Approve: true},
+					{RequestID: 11, Approve: true},
+					{RequestID: 10, Approve: false},
+				},
+			},
+			err: types.ErrInvalidRequestSettlements,
+		},
+		{
+			name: "should prevent validate message with both settlements and range",
+			msg: types.MsgSettleRequests{
+				Signer:   sample.Address(r),
+				LaunchID: launchID,
+				Settlements: []types.RequestSettlement{
+					{RequestID: 10, Approve: true},
+				},
+				RequestIDRange: &types.RequestIDRange{Start: 1, End: 10, Approve: true},
+			},
+			err: types.ErrInvalidRequestSettlements,
+		},
+		{
+			name: "should prevent validate message with invalid range",
+			msg: types.MsgSettleRequests{
+				Signer:         sample.Address(r),
+				LaunchID:       launchID,
+				RequestIDRange: &types.RequestIDRange{Start: 10, End: 1, Approve: true},
+			},
+			err: types.ErrInvalidRequestSettlements,
+		},
+		{
+			name: "should validate valid message with settlements",
+			msg: types.MsgSettleRequests{
+				Signer:   sample.Address(r),
+				LaunchID: launchID,
+				Settlements: []types.RequestSettlement{
+					{RequestID: 10, Approve: true},
+					{RequestID: 11, Approve: false},
+				},
+			},
+		},
+		{
+			name: "should validate valid message with range",
+			msg: types.MsgSettleRequests{
+				Signer:         sample.Address(r),
+				LaunchID:       launchID,
+				RequestIDRange: &types.RequestIDRange{Start: 10, End: 10, Approve: false},
+				BestEffort:     true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
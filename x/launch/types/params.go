@@ -26,12 +26,31 @@ var (
 
 	DefaultChainCreationFee = sdk.Coins(nil) // EmptyCoins
 
+	// DefaultRequestExpiration is the duration after which a pending request is automatically rejected
+	DefaultRequestExpiration = time.Hour * 24 * 30
+
+	// DefaultValidatorSetThreshold is the minimum ratio of the total genesis self-delegation
+	// the validator set of a chain must hold to connect its monitoring
+	DefaultValidatorSetThreshold = sdk.NewDecWithPrec(6666, 4)
+
+	// MinValidatorSetThreshold is the minimum value of the validator set threshold, the validator set
+	// of a chain must hold at least a third of the total genesis self-delegation to connect its monitoring
+	MinValidatorSetThreshold = sdk.NewDecWithPrec(3333, 4)
+
+	// DefaultMaxNonGenesisValidators is the default number of validators not present in the genesis
+	// tolerated in the validator set of a chain to connect its monitoring
+	DefaultMaxNonGenesisValidators = uint64(0)
+
 	MaxParametrableLaunchTime  = time.Hour * 24 * 31
 	MaxParametrableRevertDelay = time.Hour * 24
 
-	KeyLaunchTimeRange  = []byte("LaunchTimeRange")
-	KeyRevertDelay      = []byte("RevertDelay")
-	KeyChainCreationFee = []byte("ChainCreationFee")
+	KeyLaunchTimeRange   = []byte("LaunchTimeRange")
+	KeyRevertDelay       = []byte("RevertDelay")
+	KeyChainCreationFee  = []byte("ChainCreationFee")
+	KeyRequestExpiration = []byte("RequestExpiration")
+
+	KeyValidatorSetThreshold   = []byte("ValidatorSetThreshold")
+	KeyMaxNonGenesisValidators = []byte("MaxNonGenesisValidators")
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -50,11 +69,22 @@ func NewLaunchTimeRange(minLaunchTime, maxLaunchTime time.Duration) LaunchTimeRa
 }
 
 // NewParams creates a new Params instance
-func NewParams(minLaunchTime, maxLaunchTime, revertDelay time.Duration, chainCreationFee sdk.Coins) Params {
+func NewParams(
+	minLaunchTime,
+	maxLaunchTime,
+	revertDelay time.Duration,
+	chainCreationFee sdk.Coins,
+	requestExpiration time.Duration,
+	validatorSetThreshold sdk.Dec,
+	maxNonGenesisValidators uint64,
+) Params {
 	return Params{
-		LaunchTimeRange:  NewLaunchTimeRange(minLaunchTime, maxLaunchTime),
-		RevertDelay:      revertDelay,
-		ChainCreationFee: chainCreationFee,
+		LaunchTimeRange:         NewLaunchTimeRange(minLaunchTime, maxLaunchTime),
+		RevertDelay:             revertDelay,
+		ChainCreationFee:        chainCreationFee,
+		RequestExpiration:       requestExpiration,
+		ValidatorSetThreshold:   validatorSetThreshold,
+		MaxNonGenesisValidators: maxNonGenesisValidators,
 	}
 }
 
@@ -65,6 +95,9 @@ func DefaultParams() Params {
 		DefaultMaxLaunchTime,
 		DefaultRevertDelay,
 		DefaultChainCreationFee,
+		DefaultRequestExpiration,
+		DefaultValidatorSetThreshold,
+		DefaultMaxNonGenesisValidators,
 	)
 }
 
@@ -74,6 +107,9 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyLaunchTimeRange, &p.LaunchTimeRange, validateLaunchTimeRange),
 		paramtypes.NewParamSetPair(KeyRevertDelay, &p.RevertDelay, validateRevertDelay),
 		paramtypes.NewParamSetPair(KeyChainCreationFee, &p.ChainCreationFee, validateChainCreationFee),
+		paramtypes.NewParamSetPair(KeyRequestExpiration, &p.RequestExpiration, validateRequestExpiration),
+		paramtypes.NewParamSetPair(KeyValidatorSetThreshold, &p.ValidatorSetThreshold, validateValidatorSetThreshold),
+		paramtypes.NewParamSetPair(KeyMaxNonGenesisValidators, &p.MaxNonGenesisValidators, validateMaxNonGenesisValidators),
 	}
 }
 
@@ -85,6 +121,15 @@ func (p Params) Validate() error {
 	if err := validateRevertDelay(p.RevertDelay); err != nil {
 		return err
 	}
+	if err := validateRequestExpiration(p.RequestExpiration); err != nil {
+		return err
+	}
+	if err := validateValidatorSetThreshold(p.ValidatorSetThreshold); err != nil {
+		return err
+	}
+	if err := validateMaxNonGenesisValidators(p.MaxNonGenesisValidators); err != nil {
+		return err
+	}
 	return p.ChainCreationFee.Validate()
 }
 
@@ -140,3 +185,44 @@ func validateChainCreationFee(i interface{}) error {
 	}
 	return v.Validate()
 }
+
+func validateRequestExpiration(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return errors.New("request expiration parameter can't be negative")
+	}
+
+	return nil
+}
+
+func validateValidatorSetThreshold(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return errors.New("validator set threshold can't be nil")
+	}
+
+	if v.LT(MinValidatorSetThreshold) {
+		return fmt.Errorf("validator set threshold can't be lower than %s", MinValidatorSetThreshold)
+	}
+
+	if v.GT(sdk.OneDec()) {
+		return errors.New("validator set threshold can't be greater than 1")
+	}
+
+	return nil
+}
+
+func validateMaxNonGenesisValidators(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
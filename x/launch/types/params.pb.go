@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
@@ -34,6 +35,14 @@ type Params struct {
 	LaunchTimeRange  LaunchTimeRange                          `protobuf:"bytes,1,opt,name=launchTimeRange,proto3" json:"launchTimeRange"`
 	RevertDelay      time.Duration                            `protobuf:"bytes,2,opt,name=revertDelay,proto3,stdduration" json:"revertDelay"`
 	ChainCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=chainCreationFee,proto3,casttype=github.com/cosmos/cosmos-sdk/types.Coin,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"chainCreationFee"`
+	// requestExpiration is the duration after which a pending request is rejected, 0 disables the expiration
+	RequestExpiration time.Duration `protobuf:"bytes,4,opt,name=requestExpiration,proto3,stdduration" json:"requestExpiration"`
+	// validatorSetThreshold is the minimum ratio of the total genesis self-delegation
+	// the validator set must hold to connect the monitoring of the chain
+	ValidatorSetThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=validatorSetThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"validatorSetThreshold"`
+	// maxNonGenesisValidators is the number of validators not present in the genesis
+	// tolerated in the validator set to connect the monitoring of the chain
+	MaxNonGenesisValidators uint64 `protobuf:"varint,6,opt,name=maxNonGenesisValidators,proto3" json:"maxNonGenesisValidators,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -89,6 +98,20 @@ func (m *Params) GetChainCreationFee() github_com_cosmos_cosmos_sdk_types.Coins
 	return nil
 }
 
+func (m *Params) GetRequestExpiration() time.Duration {
+	if m != nil {
+		return m.RequestExpiration
+	}
+	return 0
+}
+
+func (m *Params) GetMaxNonGenesisValidators() uint64 {
+	if m != nil {
+		return m.MaxNonGenesisValidators
+	}
+	return 0
+}
+
 type LaunchTimeRange struct {
 	MinLaunchTime time.Duration `protobuf:"bytes,1,opt,name=minLaunchTime,proto3,stdduration" json:"minLaunchTime"`
 	MaxLaunchTime time.Duration `protobuf:"bytes,2,opt,name=maxLaunchTime,proto3,stdduration" json:"maxLaunchTime"`
@@ -149,32 +172,38 @@ func init() {
 func init() { proto.RegisterFile("launch/params.proto", fileDescriptor_b8f73d6645a211b2) }
 
 var fileDescriptor_b8f73d6645a211b2 = []byte{
-	// 391 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x3f, 0x6f, 0xe2, 0x30,
-	0x18, 0xc6, 0x63, 0x40, 0xe8, 0x14, 0x74, 0xe2, 0x94, 0xbb, 0x93, 0x38, 0x86, 0x04, 0x31, 0xdc,
-	0xb1, 0x9c, 0x2d, 0xda, 0xad, 0x63, 0xa0, 0x95, 0x2a, 0x75, 0xa8, 0xa2, 0xaa, 0x43, 0x3b, 0x39,
-	0xc1, 0x0d, 0x56, 0x89, 0x1d, 0xc5, 0x0e, 0x82, 0x6f, 0xd1, 0x91, 0xb1, 0xea, 0x52, 0xa9, 0x9f,
-	0x84, 0x11, 0x75, 0xea, 0x04, 0x15, 0x7c, 0x8b, 0x4e, 0x55, 0x9c, 0x20, 0x02, 0xed, 0x40, 0xa7,
-	0xfc, 0xf1, 0xf3, 0xfe, 0xde, 0x47, 0xbf, 0x44, 0xff, 0x39, 0xc0, 0x31, 0xf3, 0xfa, 0x28, 0xc4,
-	0x11, 0x0e, 0x04, 0x0c, 0x23, 0x2e, 0xb9, 0xf1, 0x5b, 0x12, 0xd6, 0x23, 0x51, 0x40, 0x99, 0x84,
-	0x22, 0x64, 0x30, 0xcd, 0xd4, 0x7f, 0xf9, 0xdc, 0xe7, 0x2a, 0x81, 0x92, 0xbb, 0x34, 0x5c, 0x37,
-	0x3d, 0x2e, 0x02, 0x2e, 0x90, 0x8b, 0x05, 0x41, 0xc3, 0xb6, 0x4b, 0x24, 0x6e, 0x23, 0x8f, 0x53,
-	0xb6, 0x3e, 0xf7, 0x39, 0xf7, 0x07, 0x04, 0xa9, 0x27, 0x37, 0xbe, 0x41, 0xbd, 0x38, 0xc2, 0x92,
-	0xf2, 0xec, 0xbc, 0xf9, 0x5c, 0xd0, 0xcb, 0xe7, 0x6a, 0xbb, 0x71, 0xa9, 0x57, 0xd3, 0x55, 0x17,
-	0x34, 0x20, 0x0e, 0x66, 0x3e, 0xa9, 0x81, 0x06, 0x68, 0x55, 0x0e, 0xfe, 0xc2, 0x4f, 0x1b, 0xc1,
-	0xb3, 0xed, 0xb4, 0x5d, 0x9a, 0xce, 0x2d, 0xcd, 0xd9, 0x85, 0x18, 0xc7, 0x7a, 0x25, 0x22, 0x43,
-	0x12, 0xc9, 0x2e, 0x19, 0xe0, 0x71, 0xad, 0xa0, 0x98, 0x7f, 0x60, 0x5a, 0x0c, 0xae, 0x8b, 0xc1,
-	0x6e, 0x56, 0xcc, 0xfe, 0x96, 0x60, 0x26, 0x0b, 0x0b, 0x38, 0xf9, 0x39, 0xe3, 0x01, 0xe8, 0x3f,
-	0xbc, 0x3e, 0xa6, 0xac, 0x13, 0x11, 0x15, 0x3c, 0x21, 0xa4, 0x56, 0x6c, 0x14, 0x15, 0x2c, 0xb5,
-	0x00, 0x13, 0x0b, 0x30, 0xb3, 0x00, 0x3b, 0x9c, 0x32, 0xfb, 0x3a, 0x81, 0xbd, 0xcd, 0xad, 0x7f,
-	0x3e, 0x95, 0xfd, 0xd8, 0x85, 0x1e, 0x0f, 0x50, 0xa6, 0x2c, 0xbd, 0xfc, 0x17, 0xbd, 0x5b, 0x24,
-	0xc7, 0x21, 0x11, 0x6a, 0xe0, 0x69, 0x61, 0xb5, 0xf6, 0x8c, 0x0a, 0xe7, 0x43, 0x9f, 0xa3, 0xd2,
-	0xe4, 0xde, 0xd2, 0x9a, 0x8f, 0x40, 0xaf, 0xee, 0xc8, 0x31, 0x4e, 0xf5, 0xef, 0x01, 0x65, 0x9b,
-	0xb7, 0x99, 0xdb, 0xbd, 0x3c, 0x6c, 0x4f, 0x2a, 0x14, 0x1e, 0xe5, 0x50, 0x85, 0xaf, 0xa0, 0xf2,
-	0x93, 0xb6, 0x3d, 0x5d, 0x9a, 0x60, 0xb6, 0x34, 0xc1, 0xeb, 0xd2, 0x04, 0x77, 0x2b, 0x53, 0x9b,
-	0xad, 0x4c, 0xed, 0x65, 0x65, 0x6a, 0x57, 0x79, 0x0b, 0x9b, 0xcf, 0x8f, 0x44, 0xc8, 0xd0, 0x08,
-	0x65, 0xbf, 0xad, 0x72, 0xe1, 0x96, 0xd5, 0xbe, 0xc3, 0xf7, 0x00, 0x00, 0x00, 0xff, 0xff, 0x29,
-	0x22, 0xf1, 0x87, 0xcd, 0x02, 0x00, 0x00,
+	// 494 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x3f, 0x6f, 0xd3, 0x40,
+	0x18, 0xc6, 0x73, 0x6d, 0xa8, 0xe0, 0x2a, 0x54, 0x30, 0x54, 0xb8, 0x1d, 0xec, 0xa8, 0x43, 0xc9,
+	0xd2, 0x3b, 0x15, 0x16, 0x84, 0x98, 0xdc, 0x14, 0x84, 0x84, 0x10, 0x98, 0xaa, 0x03, 0x0c, 0xe8,
+	0x62, 0xbf, 0xd8, 0x27, 0xec, 0x3b, 0x73, 0x77, 0x8e, 0xd2, 0x6f, 0xc1, 0xd8, 0x11, 0xb1, 0x20,
+	0x31, 0xf3, 0x21, 0x3a, 0x56, 0xb0, 0x20, 0x86, 0x14, 0x25, 0xdf, 0x82, 0x09, 0xf9, 0xec, 0xa8,
+	0xe9, 0x1f, 0xa4, 0x30, 0x25, 0xe7, 0xf7, 0x79, 0x7e, 0x7e, 0xee, 0xd1, 0x6b, 0x7c, 0x2b, 0x63,
+	0xa5, 0x88, 0x52, 0x5a, 0x30, 0xc5, 0x72, 0x4d, 0x0a, 0x25, 0x8d, 0x74, 0x56, 0x0d, 0x88, 0x18,
+	0x54, 0xce, 0x85, 0x21, 0xba, 0x10, 0xa4, 0xd6, 0xac, 0xdf, 0x4e, 0x64, 0x22, 0xad, 0x82, 0x56,
+	0xff, 0x6a, 0xf1, 0xba, 0x17, 0x49, 0x9d, 0x4b, 0x4d, 0xfb, 0x4c, 0x03, 0x1d, 0x6c, 0xf7, 0xc1,
+	0xb0, 0x6d, 0x1a, 0x49, 0x2e, 0xa6, 0xf3, 0x44, 0xca, 0x24, 0x03, 0x6a, 0x4f, 0xfd, 0xf2, 0x1d,
+	0x8d, 0x4b, 0xc5, 0x0c, 0x97, 0xd3, 0xf9, 0x5a, 0xed, 0x7f, 0x5b, 0x83, 0xeb, 0x43, 0x3d, 0xda,
+	0xf8, 0xd1, 0xc6, 0x4b, 0x2f, 0x6c, 0x30, 0x67, 0x1f, 0xaf, 0xd4, 0x29, 0xf6, 0x78, 0x0e, 0x21,
+	0x13, 0x09, 0xb8, 0xa8, 0x83, 0xba, 0xcb, 0xf7, 0x36, 0xc9, 0xa5, 0x61, 0xc9, 0xb3, 0xb3, 0xea,
+	0xa0, 0x7d, 0x34, 0xf2, 0x5b, 0xe1, 0x79, 0x88, 0xb3, 0x8b, 0x97, 0x15, 0x0c, 0x40, 0x99, 0x1e,
+	0x64, 0xec, 0xc0, 0x5d, 0xb0, 0xcc, 0x35, 0x52, 0x67, 0x26, 0xd3, 0xcc, 0xa4, 0xd7, 0x64, 0x0e,
+	0xae, 0x56, 0x98, 0xc3, 0x13, 0x1f, 0x85, 0xb3, 0x3e, 0xe7, 0x33, 0xc2, 0x37, 0xa2, 0x94, 0x71,
+	0xb1, 0xa3, 0xc0, 0x0a, 0x1f, 0x03, 0xb8, 0x8b, 0x9d, 0x45, 0x0b, 0x6b, 0xee, 0x54, 0x15, 0x44,
+	0x9a, 0x82, 0xc8, 0x8e, 0xe4, 0x22, 0x78, 0x53, 0xc1, 0xfe, 0x8c, 0xfc, 0xbb, 0x09, 0x37, 0x69,
+	0xd9, 0x27, 0x91, 0xcc, 0x9b, 0x02, 0x9a, 0x9f, 0x2d, 0x1d, 0xbf, 0xa7, 0xe6, 0xa0, 0x00, 0x6d,
+	0x0d, 0x5f, 0x4f, 0xfc, 0xee, 0x9c, 0x52, 0x1d, 0x5e, 0xc8, 0xe3, 0xbc, 0xc4, 0x37, 0x15, 0x7c,
+	0x28, 0x41, 0x9b, 0xdd, 0x61, 0xc1, 0xeb, 0x0b, 0xb9, 0xed, 0xf9, 0x6f, 0x7c, 0xd1, 0xed, 0x28,
+	0xbc, 0x3a, 0x60, 0x19, 0x8f, 0x99, 0x91, 0xea, 0x15, 0x98, 0xbd, 0x54, 0x81, 0x4e, 0x65, 0x16,
+	0xbb, 0x57, 0x3a, 0xa8, 0x7b, 0x2d, 0x78, 0x54, 0x79, 0x7f, 0x8d, 0xfc, 0xcd, 0x39, 0x52, 0xf7,
+	0x20, 0xfa, 0xfe, 0x6d, 0x0b, 0x37, 0x65, 0xf5, 0x20, 0x0a, 0x2f, 0x47, 0x3b, 0x0f, 0xf0, 0x9d,
+	0x9c, 0x0d, 0x9f, 0x4b, 0xf1, 0x04, 0x04, 0x68, 0xae, 0xf7, 0xa7, 0x2a, 0xed, 0x2e, 0x75, 0x50,
+	0xb7, 0x1d, 0xfe, 0x6b, 0xfc, 0xb0, 0x7d, 0xf8, 0xc9, 0x6f, 0x6d, 0x7c, 0x41, 0x78, 0xe5, 0xdc,
+	0x76, 0x38, 0x4f, 0xf1, 0xf5, 0x9c, 0x8b, 0xd3, 0xa7, 0x2e, 0x9a, 0xbf, 0x96, 0xb3, 0x4e, 0x8b,
+	0x62, 0xc3, 0x19, 0xd4, 0xc2, 0xff, 0xa0, 0x66, 0x9d, 0x41, 0x70, 0x34, 0xf6, 0xd0, 0xf1, 0xd8,
+	0x43, 0xbf, 0xc7, 0x1e, 0xfa, 0x38, 0xf1, 0x5a, 0xc7, 0x13, 0xaf, 0xf5, 0x73, 0xe2, 0xb5, 0x5e,
+	0xcf, 0xae, 0xc1, 0xe9, 0xfe, 0x53, 0x5d, 0x08, 0x3a, 0xa4, 0xcd, 0x27, 0x6d, 0x6b, 0xed, 0x2f,
+	0xd9, 0xf7, 0xdd, 0xff, 0x3b, 0x00, 0xdc, 0x37, 0xb7, 0x08, 0xe9, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -197,6 +226,29 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxNonGenesisValidators != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxNonGenesisValidators))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.ValidatorSetThreshold.Size()
+		i -= size
+		if _, err := m.ValidatorSetThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RequestExpiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RequestExpiration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
 	if len(m.ChainCreationFee) > 0 {
 		for iNdEx := len(m.ChainCreationFee) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -211,12 +263,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x1a
 		}
 	}
-	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RevertDelay, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RevertDelay):])
-	if err1 != nil {
-		return 0, err1
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RevertDelay, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RevertDelay):])
+	if err2 != nil {
+		return 0, err2
 	}
-	i -= n1
-	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i -= n2
+	i = encodeVarintParams(dAtA, i, uint64(n2))
 	i--
 	dAtA[i] = 0x12
 	{
@@ -252,21 +304,21 @@ func (m *LaunchTimeRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxLaunchTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxLaunchTime):])
-	if err3 != nil {
-		return 0, err3
-	}
-	i -= n3
-	i = encodeVarintParams(dAtA, i, uint64(n3))
-	i--
-	dAtA[i] = 0x12
-	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MinLaunchTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinLaunchTime):])
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxLaunchTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxLaunchTime):])
 	if err4 != nil {
 		return 0, err4
 	}
 	i -= n4
 	i = encodeVarintParams(dAtA, i, uint64(n4))
 	i--
+	dAtA[i] = 0x12
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MinLaunchTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinLaunchTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintParams(dAtA, i, uint64(n5))
+	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
@@ -298,6 +350,13 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovParams(uint64(l))
 		}
 	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.RequestExpiration)
+	n += 1 + l + sovParams(uint64(l))
+	l = m.ValidatorSetThreshold.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxNonGenesisValidators != 0 {
+		n += 1 + sovParams(uint64(m.MaxNonGenesisValidators))
+	}
 	return n
 }
 
@@ -449,6 +508,92 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestExpiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.RequestExpiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSetThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorSetThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNonGenesisValidators", wireType)
+			}
+			m.MaxNonGenesisValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxNonGenesisValidators |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
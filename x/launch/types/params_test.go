@@ -22,12 +22,12 @@ func TestParamsValidate(t *testing.T) {
 	}{
 		{
 			name:   "should prevent validate params with invalid launch time range",
-			params: NewParams(DefaultMaxLaunchTime, DefaultMinLaunchTime, DefaultRevertDelay, DefaultChainCreationFee),
+			params: NewParams(DefaultMaxLaunchTime, DefaultMinLaunchTime, DefaultRevertDelay, DefaultChainCreationFee, DefaultRequestExpiration, DefaultValidatorSetThreshold, DefaultMaxNonGenesisValidators),
 			err:    errors.New("MinLaunchTime can't be higher than MaxLaunchTime"),
 		},
 		{
 			name:   "should validate valid params",
-			params: NewParams(DefaultMinLaunchTime, DefaultMaxLaunchTime, DefaultRevertDelay, DefaultChainCreationFee),
+			params: NewParams(DefaultMinLaunchTime, DefaultMaxLaunchTime, DefaultRevertDelay, DefaultChainCreationFee, DefaultRequestExpiration, DefaultValidatorSetThreshold, DefaultMaxNonGenesisValidators),
 		},
 	}
 	for _, tt := range tests {
@@ -134,6 +134,130 @@ func TestValidateRevertDelay(t *testing.T) {
 	}
 }
 
+func TestValidateRequestExpiration(t *testing.T) {
+	tests := []struct {
+		name              string
+		requestExpiration interface{}
+		err               error
+	}{
+		{
+			name:              "should prevent validate request expiration with invalid interface",
+			requestExpiration: "test",
+			err:               fmt.Errorf("invalid parameter type: string"),
+		},
+		{
+			name:              "should prevent validate negative request expiration",
+			requestExpiration: time.Duration(-1),
+			err:               errors.New("request expiration parameter can't be negative"),
+		},
+		{
+			name:              "should validate disabled request expiration",
+			requestExpiration: time.Duration(0),
+		},
+		{
+			name:              "should validate valid request expiration",
+			requestExpiration: DefaultRequestExpiration,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateRequestExpiration(tt.requestExpiration)
+			if tt.err != nil {
+				require.Error(t, err, tt.err)
+				require.Equal(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateValidatorSetThreshold(t *testing.T) {
+	tests := []struct {
+		name                  string
+		validatorSetThreshold interface{}
+		err                   error
+	}{
+		{
+			name:                  "should prevent validate validator set threshold with invalid interface",
+			validatorSetThreshold: "test",
+			err:                   fmt.Errorf("invalid parameter type: string"),
+		},
+		{
+			name:                  "should prevent validate nil validator set threshold",
+			validatorSetThreshold: sdk.Dec{},
+			err:                   errors.New("validator set threshold can't be nil"),
+		},
+		{
+			name:                  "should prevent validate zero validator set threshold",
+			validatorSetThreshold: sdk.ZeroDec(),
+			err:                   fmt.Errorf("validator set threshold can't be lower than %s", MinValidatorSetThreshold),
+		},
+		{
+			name:                  "should prevent validate validator set threshold lower than the minimum",
+			validatorSetThreshold: sdk.NewDecWithPrec(3, 1),
+			err:                   fmt.Errorf("validator set threshold can't be lower than %s", MinValidatorSetThreshold),
+		},
+		{
+			name:                  "should validate minimum validator set threshold",
+			validatorSetThreshold: MinValidatorSetThreshold,
+		},
+		{
+			name:                  "should prevent validate validator set threshold greater than 1",
+			validatorSetThreshold: sdk.NewDecWithPrec(101, 2),
+			err:                   errors.New("validator set threshold can't be greater than 1"),
+		},
+		{
+			name:                  "should validate validator set threshold of 1",
+			validatorSetThreshold: sdk.OneDec(),
+		},
+		{
+			name:                  "should validate valid validator set threshold",
+			validatorSetThreshold: DefaultValidatorSetThreshold,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateValidatorSetThreshold(tt.validatorSetThreshold)
+			if tt.err != nil {
+				require.Error(t, err, tt.err)
+				require.Equal(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateMaxNonGenesisValidators(t *testing.T) {
+	tests := []struct {
+		name                    string
+		maxNonGenesisValidators interface{}
+		err                     error
+	}{
+		{
+			name:                    "should prevent validate max non genesis validators with invalid interface",
+			maxNonGenesisValidators: "test",
+			err:                     fmt.Errorf("invalid parameter type: string"),
+		},
+		{
+			name:                    "should validate valid max non genesis validators",
+			maxNonGenesisValidators: uint64(10),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateMaxNonGenesisValidators(tt.maxNonGenesisValidators)
+			if tt.err != nil {
+				require.Error(t, err, tt.err)
+				require.Equal(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestValidateChainCreationFee(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -914,6 +914,8 @@ func (m *QueryGetRequestResponse) GetRequest() Request {
 type QueryAllRequestRequest struct {
 	LaunchID   uint64             `protobuf:"varint,1,opt,name=launchID,proto3" json:"launchID,omitempty"`
 	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// contentType filters the requests by type of content if set
+	ContentType string `protobuf:"bytes,3,opt,name=contentType,proto3" json:"contentType,omitempty"`
 }
 
 func (m *QueryAllRequestRequest) Reset()         { *m = QueryAllRequestRequest{} }
@@ -963,6 +965,13 @@ func (m *QueryAllRequestRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryAllRequestRequest) GetContentType() string {
+	if m != nil {
+		return m.ContentType
+	}
+	return ""
+}
+
 type QueryAllRequestResponse struct {
 	Request    []Request           `protobuf:"bytes,1,rep,name=request,proto3" json:"request"`
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
@@ -1015,6 +1024,238 @@ func (m *QueryAllRequestResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+type QueryAllRequestByStatusRequest struct {
+	LaunchID    uint64             `protobuf:"varint,1,opt,name=launchID,proto3" json:"launchID,omitempty"`
+	Status      Request_Status     `protobuf:"varint,2,opt,name=status,proto3,enum=tendermint.spn.launch.Request_Status" json:"status,omitempty"`
+	ContentType string             `protobuf:"bytes,3,opt,name=contentType,proto3" json:"contentType,omitempty"`
+	Pagination  *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllRequestByStatusRequest) Reset()         { *m = QueryAllRequestByStatusRequest{} }
+func (m *QueryAllRequestByStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllRequestByStatusRequest) ProtoMessage()    {}
+func (*QueryAllRequestByStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16d1d5d3029eb866, []int{20}
+}
+func (m *QueryAllRequestByStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllRequestByStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllRequestByStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllRequestByStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllRequestByStatusRequest.Merge(m, src)
+}
+func (m *QueryAllRequestByStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllRequestByStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllRequestByStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllRequestByStatusRequest proto.InternalMessageInfo
+
+func (m *QueryAllRequestByStatusRequest) GetLaunchID() uint64 {
+	if m != nil {
+		return m.LaunchID
+	}
+	return 0
+}
+
+func (m *QueryAllRequestByStatusRequest) GetStatus() Request_Status {
+	if m != nil {
+		return m.Status
+	}
+	return Request_PENDING
+}
+
+func (m *QueryAllRequestByStatusRequest) GetContentType() string {
+	if m != nil {
+		return m.ContentType
+	}
+	return ""
+}
+
+func (m *QueryAllRequestByStatusRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllRequestByStatusResponse struct {
+	Request    []Request           `protobuf:"bytes,1,rep,name=request,proto3" json:"request"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllRequestByStatusResponse) Reset()         { *m = QueryAllRequestByStatusResponse{} }
+func (m *QueryAllRequestByStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllRequestByStatusResponse) ProtoMessage()    {}
+func (*QueryAllRequestByStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16d1d5d3029eb866, []int{21}
+}
+func (m *QueryAllRequestByStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllRequestByStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllRequestByStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllRequestByStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllRequestByStatusResponse.Merge(m, src)
+}
+func (m *QueryAllRequestByStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllRequestByStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllRequestByStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllRequestByStatusResponse proto.InternalMessageInfo
+
+func (m *QueryAllRequestByStatusResponse) GetRequest() []Request {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (m *QueryAllRequestByStatusResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllRequestByCreatorRequest struct {
+	Creator     string             `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ContentType string             `protobuf:"bytes,2,opt,name=contentType,proto3" json:"contentType,omitempty"`
+	Pagination  *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllRequestByCreatorRequest) Reset()         { *m = QueryAllRequestByCreatorRequest{} }
+func (m *QueryAllRequestByCreatorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllRequestByCreatorRequest) ProtoMessage()    {}
+func (*QueryAllRequestByCreatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16d1d5d3029eb866, []int{22}
+}
+func (m *QueryAllRequestByCreatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllRequestByCreatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllRequestByCreatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllRequestByCreatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllRequestByCreatorRequest.Merge(m, src)
+}
+func (m *QueryAllRequestByCreatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllRequestByCreatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllRequestByCreatorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllRequestByCreatorRequest proto.InternalMessageInfo
+
+func (m *QueryAllRequestByCreatorRequest) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *QueryAllRequestByCreatorRequest) GetContentType() string {
+	if m != nil {
+		return m.ContentType
+	}
+	return ""
+}
+
+func (m *QueryAllRequestByCreatorRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllRequestByCreatorResponse struct {
+	Request    []Request           `protobuf:"bytes,1,rep,name=request,proto3" json:"request"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllRequestByCreatorResponse) Reset()         { *m = QueryAllRequestByCreatorResponse{} }
+func (m *QueryAllRequestByCreatorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllRequestByCreatorResponse) ProtoMessage()    {}
+func (*QueryAllRequestByCreatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16d1d5d3029eb866, []int{23}
+}
+func (m *QueryAllRequestByCreatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllRequestByCreatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllRequestByCreatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllRequestByCreatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllRequestByCreatorResponse.Merge(m, src)
+}
+func (m *QueryAllRequestByCreatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllRequestByCreatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllRequestByCreatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllRequestByCreatorResponse proto.InternalMessageInfo
+
+func (m *QueryAllRequestByCreatorResponse) GetRequest() []Request {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (m *QueryAllRequestByCreatorResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryParamsRequest is request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -1023,7 +1264,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_16d1d5d3029eb866, []int{20}
+	return fileDescriptor_16d1d5d3029eb866, []int{24}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1061,7 +1302,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_16d1d5d3029eb866, []int{21}
+	return fileDescriptor_16d1d5d3029eb866, []int{25}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1097,6 +1338,94 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+type QueryGetApprovalPolicyRequest struct {
+	LaunchID uint64 `protobuf:"varint,1,opt,name=launchID,proto3" json:"launchID,omitempty"`
+}
+
+func (m *QueryGetApprovalPolicyRequest) Reset()         { *m = QueryGetApprovalPolicyRequest{} }
+func (m *QueryGetApprovalPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetApprovalPolicyRequest) ProtoMessage()    {}
+func (*QueryGetApprovalPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16d1d5d3029eb866, []int{26}
+}
+func (m *QueryGetApprovalPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetApprovalPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetApprovalPolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetApprovalPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetApprovalPolicyRequest.Merge(m, src)
+}
+func (m *QueryGetApprovalPolicyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetApprovalPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetApprovalPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetApprovalPolicyRequest proto.InternalMessageInfo
+
+func (m *QueryGetApprovalPolicyRequest) GetLaunchID() uint64 {
+	if m != nil {
+		return m.LaunchID
+	}
+	return 0
+}
+
+type QueryGetApprovalPolicyResponse struct {
+	ApprovalPolicy ApprovalPolicy `protobuf:"bytes,1,opt,name=approvalPolicy,proto3" json:"approvalPolicy"`
+}
+
+func (m *QueryGetApprovalPolicyResponse) Reset()         { *m = QueryGetApprovalPolicyResponse{} }
+func (m *QueryGetApprovalPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetApprovalPolicyResponse) ProtoMessage()    {}
+func (*QueryGetApprovalPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16d1d5d3029eb866, []int{27}
+}
+func (m *QueryGetApprovalPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetApprovalPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetApprovalPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetApprovalPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetApprovalPolicyResponse.Merge(m, src)
+}
+func (m *QueryGetApprovalPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetApprovalPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetApprovalPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetApprovalPolicyResponse proto.InternalMessageInfo
+
+func (m *QueryGetApprovalPolicyResponse) GetApprovalPolicy() ApprovalPolicy {
+	if m != nil {
+		return m.ApprovalPolicy
+	}
+	return ApprovalPolicy{}
+}
+
 func init() {
 	proto.RegisterType((*QueryGetChainRequest)(nil), "tendermint.spn.launch.QueryGetChainRequest")
 	proto.RegisterType((*QueryGetChainResponse)(nil), "tendermint.spn.launch.QueryGetChainResponse")
@@ -1118,80 +1447,102 @@ func init() {
 	proto.RegisterType((*QueryGetRequestResponse)(nil), "tendermint.spn.launch.QueryGetRequestResponse")
 	proto.RegisterType((*QueryAllRequestRequest)(nil), "tendermint.spn.launch.QueryAllRequestRequest")
 	proto.RegisterType((*QueryAllRequestResponse)(nil), "tendermint.spn.launch.QueryAllRequestResponse")
+	proto.RegisterType((*QueryAllRequestByStatusRequest)(nil), "tendermint.spn.launch.QueryAllRequestByStatusRequest")
+	proto.RegisterType((*QueryAllRequestByStatusResponse)(nil), "tendermint.spn.launch.QueryAllRequestByStatusResponse")
+	proto.RegisterType((*QueryAllRequestByCreatorRequest)(nil), "tendermint.spn.launch.QueryAllRequestByCreatorRequest")
+	proto.RegisterType((*QueryAllRequestByCreatorResponse)(nil), "tendermint.spn.launch.QueryAllRequestByCreatorResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "tendermint.spn.launch.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "tendermint.spn.launch.QueryParamsResponse")
+	proto.RegisterType((*QueryGetApprovalPolicyRequest)(nil), "tendermint.spn.launch.QueryGetApprovalPolicyRequest")
+	proto.RegisterType((*QueryGetApprovalPolicyResponse)(nil), "tendermint.spn.launch.QueryGetApprovalPolicyResponse")
 }
 
 func init() { proto.RegisterFile("launch/query.proto", fileDescriptor_16d1d5d3029eb866) }
 
 var fileDescriptor_16d1d5d3029eb866 = []byte{
-	// 1055 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcd, 0x6f, 0xdc, 0x44,
-	0x14, 0xcf, 0x74, 0xf3, 0xd1, 0x3e, 0xa4, 0x52, 0x26, 0x5b, 0x28, 0xab, 0xc4, 0xa9, 0x2c, 0x4a,
-	0x9a, 0x96, 0xd8, 0x4a, 0xd2, 0xb4, 0xa0, 0x28, 0xa0, 0x4d, 0x4b, 0xa3, 0xde, 0x5a, 0x57, 0xaa,
-	0x54, 0x0e, 0x54, 0xce, 0x66, 0xe4, 0x58, 0x72, 0x6c, 0xc7, 0xf6, 0x46, 0x54, 0x25, 0x17, 0x10,
-	0x5c, 0xb8, 0x20, 0xf5, 0x82, 0x38, 0x70, 0xe0, 0xc2, 0x3f, 0xc0, 0x0d, 0x89, 0x03, 0x48, 0xa8,
-	0xe2, 0x54, 0xc1, 0x85, 0x13, 0x42, 0x09, 0x7f, 0x08, 0xda, 0x99, 0xe7, 0x6c, 0x66, 0xe2, 0xcf,
-	0xcd, 0x86, 0x5b, 0xd6, 0xf3, 0x3e, 0x7e, 0x1f, 0x6f, 0x77, 0x9e, 0x03, 0xd4, 0xb3, 0xbb, 0x7e,
-	0x67, 0xcb, 0xdc, 0xe9, 0xb2, 0xe8, 0xa9, 0x11, 0x46, 0x41, 0x12, 0xd0, 0x8b, 0x09, 0xf3, 0x37,
-	0x59, 0xb4, 0xed, 0xfa, 0x89, 0x11, 0x87, 0xbe, 0x21, 0x42, 0x5a, 0x4d, 0x27, 0x70, 0x02, 0x1e,
-	0x61, 0xf6, 0xfe, 0x12, 0xc1, 0xad, 0x29, 0x27, 0x08, 0x1c, 0x8f, 0x99, 0x76, 0xe8, 0x9a, 0xb6,
-	0xef, 0x07, 0x89, 0x9d, 0xb8, 0x81, 0x1f, 0xe3, 0xe9, 0xb5, 0x4e, 0x10, 0x6f, 0x07, 0xb1, 0xb9,
-	0x61, 0xc7, 0x4c, 0xf4, 0x30, 0x77, 0x17, 0x36, 0x58, 0x62, 0x2f, 0x98, 0xa1, 0xed, 0xb8, 0x3e,
-	0x0f, 0xc6, 0xd8, 0x37, 0x45, 0xec, 0x13, 0xd1, 0x42, 0x7c, 0xc0, 0xa3, 0x26, 0xa2, 0x8c, 0xd8,
-	0x4e, 0x97, 0xc5, 0x49, 0xda, 0x1a, 0x9f, 0xee, 0xb2, 0x38, 0x71, 0x7d, 0xe7, 0x89, 0xdd, 0xe9,
-	0x04, 0x5d, 0x5f, 0x3d, 0x75, 0x98, 0xcf, 0x62, 0x37, 0x56, 0x4e, 0x35, 0xe5, 0x74, 0xd7, 0xf6,
-	0xdc, 0x4d, 0x3b, 0x09, 0x22, 0x3c, 0x4f, 0x75, 0xe9, 0x6c, 0xd9, 0x6e, 0x0a, 0x70, 0x12, 0x9f,
-	0x85, 0x76, 0x64, 0x6f, 0x23, 0x34, 0x7d, 0x11, 0x9a, 0x0f, 0x7a, 0xbc, 0xd6, 0x59, 0x72, 0xbb,
-	0x17, 0x6b, 0x09, 0x88, 0xb4, 0x05, 0x67, 0x45, 0xf8, 0xbd, 0x3b, 0x97, 0xc8, 0x65, 0x72, 0x75,
-	0xd4, 0x3a, 0xfc, 0xac, 0x3f, 0x80, 0x8b, 0x4a, 0x4e, 0x1c, 0x06, 0x7e, 0xcc, 0xe8, 0xbb, 0x30,
-	0xc6, 0x1b, 0xf2, 0x8c, 0x57, 0x16, 0xa7, 0x8c, 0x4c, 0x27, 0x0c, 0x9e, 0xb4, 0x36, 0xfa, 0xe2,
-	0xef, 0x99, 0x11, 0x4b, 0x24, 0xe8, 0x1f, 0x23, 0x8c, 0xb6, 0xe7, 0x49, 0x30, 0xee, 0x02, 0xf4,
-	0x85, 0xc6, 0xb2, 0x6f, 0x1b, 0x28, 0x6e, 0xcf, 0x15, 0x43, 0x38, 0x8f, 0xae, 0x18, 0xf7, 0x6d,
-	0x87, 0x61, 0xae, 0x75, 0x24, 0x53, 0xff, 0x96, 0x20, 0xe6, 0x7e, 0x83, 0xe3, 0x98, 0x1b, 0xb5,
-	0x30, 0xd3, 0x75, 0x09, 0xdb, 0x19, 0x8e, 0x6d, 0xb6, 0x14, 0x9b, 0x68, 0x2b, 0x81, 0x0b, 0x60,
-	0x3a, 0xd5, 0x73, 0x5d, 0xf8, 0xd9, 0x16, 0x66, 0x57, 0x30, 0x83, 0x2e, 0xc2, 0x84, 0xbd, 0xb9,
-	0x19, 0xb1, 0x38, 0xe6, 0x10, 0xce, 0xad, 0x5d, 0xfa, 0xe3, 0xc7, 0xf9, 0x26, 0xa2, 0x68, 0x8b,
-	0x93, 0x87, 0x49, 0xe4, 0xfa, 0x8e, 0x95, 0x06, 0xea, 0x5d, 0xd0, 0xf2, 0x1a, 0xa2, 0x2a, 0x0f,
-	0xe1, 0xbc, 0x23, 0x9d, 0xa0, 0xf6, 0x57, 0x72, 0xe4, 0x91, 0xcb, 0xa0, 0x4e, 0x4a, 0x09, 0xfd,
-	0x73, 0x82, 0x44, 0xdb, 0x9e, 0x57, 0x9f, 0xe8, 0xdd, 0x0c, 0xb9, 0x07, 0x19, 0x85, 0x9f, 0x09,
-	0xb2, 0xcf, 0x40, 0x51, 0xc0, 0xbe, 0x71, 0x42, 0xf6, 0xa7, 0x32, 0x2e, 0x8f, 0xc4, 0x4f, 0xc7,
-	0xff, 0x37, 0x2e, 0x6a, 0xc3, 0xbe, 0x60, 0xbb, 0xd2, 0x49, 0xc9, 0xb8, 0xc8, 0x65, 0x52, 0xc1,
-	0xe4, 0x12, 0xd2, 0xb8, 0xd4, 0x27, 0x7a, 0x1a, 0xe3, 0x52, 0x83, 0x7d, 0xe3, 0x84, 0xec, 0x87,
-	0x37, 0x2e, 0x3b, 0x30, 0xa3, 0x7c, 0xd9, 0x1f, 0xa5, 0x97, 0xc5, 0x69, 0x0d, 0xcc, 0x1e, 0x5c,
-	0xce, 0x6f, 0x89, 0xa2, 0x3d, 0x86, 0x0b, 0x8e, 0x72, 0x86, 0x43, 0x33, 0x5b, 0xfc, 0x2d, 0x3b,
-	0x0c, 0x47, 0xe1, 0x8e, 0x95, 0xd1, 0xbf, 0x20, 0x48, 0xb9, 0xff, 0x0d, 0xaf, 0x45, 0x79, 0x58,
-	0xa3, 0xf3, 0x1b, 0x41, 0x1d, 0x32, 0x71, 0x14, 0xea, 0xd0, 0x18, 0x82, 0x0e, 0xc3, 0x1b, 0x21,
-	0x0b, 0x5e, 0x4f, 0xfd, 0x4c, 0x79, 0x56, 0x90, 0x71, 0x0a, 0xce, 0xe1, 0xc2, 0x73, 0xef, 0x0e,
-	0xef, 0x3e, 0x6a, 0xf5, 0x1f, 0xe8, 0x8f, 0xe1, 0x8d, 0x63, 0x35, 0x51, 0x92, 0xf7, 0x61, 0x02,
-	0xe3, 0x70, 0x22, 0xb4, 0x1c, 0x25, 0x30, 0x11, 0x05, 0x48, 0x93, 0xf4, 0x4f, 0x11, 0x6e, 0xdb,
-	0xf3, 0x6a, 0xc0, 0x1d, 0x96, 0xeb, 0xdf, 0x13, 0x64, 0x76, 0xb4, 0x7d, 0x16, 0xb3, 0x46, 0x6d,
-	0x66, 0xc3, 0x73, 0xb4, 0x09, 0x94, 0x63, 0xbc, 0xcf, 0x77, 0x41, 0xec, 0xa6, 0x5b, 0x30, 0x29,
-	0x3d, 0x45, 0xd4, 0x2b, 0x30, 0x2e, 0x76, 0x46, 0xb4, 0x63, 0x3a, 0x07, 0xb4, 0x48, 0x43, 0xcc,
-	0x98, 0xb2, 0xf8, 0xcd, 0xab, 0x30, 0xc6, 0x8b, 0xd2, 0xe7, 0x04, 0xc6, 0xf8, 0x1a, 0x45, 0xaf,
-	0xe7, 0x14, 0xc8, 0xda, 0x44, 0x5b, 0xef, 0x54, 0x0b, 0x16, 0x58, 0x75, 0xf3, 0xb3, 0x3f, 0xff,
-	0x7d, 0x7e, 0x66, 0x8e, 0xce, 0x9a, 0xfd, 0x2c, 0x33, 0x0e, 0x7d, 0xf3, 0xe8, 0x42, 0x6c, 0x3e,
-	0x4b, 0x5d, 0xdf, 0xa3, 0x5f, 0x11, 0x38, 0xcb, 0x4b, 0xb4, 0x3d, 0xaf, 0x18, 0x98, 0xb2, 0x9b,
-	0x16, 0x03, 0x53, 0xf7, 0x4c, 0xfd, 0x2d, 0x0e, 0x4c, 0xa3, 0x53, 0x45, 0xc0, 0xe8, 0x2f, 0x04,
-	0xce, 0xcb, 0xdb, 0x04, 0xbd, 0x51, 0xc2, 0x3f, 0x73, 0x93, 0x6a, 0x2d, 0xd7, 0xcc, 0x42, 0x94,
-	0xb7, 0x39, 0xca, 0x55, 0xba, 0x92, 0x83, 0x52, 0x79, 0x1b, 0x39, 0x22, 0xa4, 0xf9, 0x0c, 0x7f,
-	0xfd, 0xf7, 0xe8, 0x4f, 0x04, 0x5e, 0x93, 0xeb, 0xf7, 0xb4, 0xbd, 0x51, 0x22, 0xd7, 0x00, 0x3c,
-	0x72, 0x37, 0x38, 0xfd, 0x3d, 0xce, 0x63, 0x89, 0x2e, 0xd4, 0xe6, 0xc1, 0x2d, 0x90, 0x6f, 0xe8,
-	0x52, 0x0b, 0x32, 0xb7, 0x93, 0x52, 0x0b, 0xb2, 0xb7, 0x89, 0x52, 0x0b, 0x94, 0xd7, 0xc5, 0x7c,
-	0x0b, 0xe4, 0xfa, 0x55, 0x2c, 0x18, 0x80, 0x47, 0xee, 0x56, 0x54, 0x6a, 0x41, 0x3e, 0x0f, 0xfa,
-	0x3b, 0x81, 0x0b, 0xea, 0x2d, 0x47, 0x6f, 0x56, 0x9b, 0x68, 0xf5, 0xa6, 0x6f, 0xdd, 0xaa, 0x9d,
-	0x87, 0x04, 0x3e, 0xe4, 0x04, 0x3e, 0xa0, 0xab, 0x25, 0x33, 0x74, 0xf8, 0xee, 0x9d, 0x6d, 0xc5,
-	0xaf, 0x04, 0x26, 0xd5, 0x1e, 0x3d, 0x33, 0x6e, 0x56, 0x9b, 0xec, 0x7a, 0x7c, 0x0a, 0x36, 0x0d,
-	0x7d, 0x85, 0xf3, 0x59, 0xa6, 0x4b, 0x03, 0xf0, 0xa1, 0x3f, 0x10, 0x98, 0x48, 0x6f, 0xd1, 0xf9,
-	0x12, 0x45, 0xe5, 0x4b, 0xb7, 0x65, 0x54, 0x0d, 0x47, 0x9c, 0xab, 0x1c, 0xe7, 0x2d, 0xba, 0x9c,
-	0x83, 0x13, 0x2f, 0x43, 0x49, 0xed, 0xc3, 0xbd, 0x62, 0x8f, 0x7e, 0x47, 0x00, 0xb0, 0x64, 0x4f,
-	0xe6, 0xf9, 0x12, 0xb9, 0xea, 0x80, 0x3d, 0x7e, 0xa3, 0xeb, 0x0b, 0x1c, 0xec, 0x75, 0x3a, 0x57,
-	0x19, 0x2c, 0xfd, 0x92, 0xc0, 0xb8, 0xb8, 0x2a, 0xe9, 0x5c, 0x51, 0x37, 0xe9, 0x6e, 0x6e, 0x5d,
-	0xab, 0x12, 0x8a, 0xa0, 0xae, 0x70, 0x50, 0x33, 0x74, 0x3a, 0x07, 0x94, 0xb8, 0x9a, 0xd7, 0xd6,
-	0x5e, 0xec, 0x6b, 0xe4, 0xe5, 0xbe, 0x46, 0xfe, 0xd9, 0xd7, 0xc8, 0xd7, 0x07, 0xda, 0xc8, 0xcb,
-	0x03, 0x6d, 0xe4, 0xaf, 0x03, 0x6d, 0xe4, 0xa3, 0xab, 0x8e, 0x9b, 0x6c, 0x75, 0x37, 0x8c, 0x4e,
-	0xb0, 0xad, 0x96, 0xf8, 0x24, 0x2d, 0x92, 0x3c, 0x0d, 0x59, 0xbc, 0x31, 0xce, 0xff, 0x8d, 0xb4,
-	0xf4, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xcc, 0xb1, 0x81, 0x64, 0x89, 0x13, 0x00, 0x00,
+	// 1304 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcf, 0x4f, 0xdc, 0xc6,
+	0x17, 0x67, 0xb2, 0x04, 0x92, 0x17, 0x09, 0xe5, 0x3b, 0xc0, 0x37, 0xd4, 0x82, 0x05, 0x59, 0x4d,
+	0x09, 0x49, 0xb0, 0x05, 0x64, 0x49, 0x2b, 0x4a, 0xaa, 0x05, 0x1a, 0x94, 0x1b, 0x59, 0xaa, 0x48,
+	0xe9, 0xa1, 0xc8, 0x2c, 0x96, 0xb1, 0x64, 0x6c, 0xb3, 0xf6, 0xa2, 0x22, 0xc4, 0xa5, 0x55, 0x7b,
+	0xe9, 0xa5, 0x52, 0x6e, 0x3d, 0xe4, 0xd0, 0x4b, 0xa5, 0xf6, 0xda, 0x43, 0xa5, 0x4a, 0xad, 0xd4,
+	0x4a, 0x55, 0xda, 0x53, 0xd4, 0x5e, 0x7a, 0xaa, 0x2a, 0x68, 0xfe, 0x8f, 0x6a, 0x67, 0x9e, 0x59,
+	0xcf, 0xec, 0x7a, 0x6d, 0x6f, 0x96, 0x2a, 0x37, 0xd6, 0xf3, 0x7e, 0x7c, 0x3e, 0x9f, 0xf7, 0x3c,
+	0xfb, 0xde, 0x02, 0xd4, 0x31, 0xea, 0x6e, 0x75, 0x57, 0xdf, 0xaf, 0x9b, 0xb5, 0x43, 0xcd, 0xaf,
+	0x79, 0xa1, 0x47, 0x47, 0x43, 0xd3, 0xdd, 0x31, 0x6b, 0x7b, 0xb6, 0x1b, 0x6a, 0x81, 0xef, 0x6a,
+	0xdc, 0x44, 0x19, 0xb1, 0x3c, 0xcb, 0x63, 0x16, 0x7a, 0xe3, 0x2f, 0x6e, 0xac, 0x8c, 0x5b, 0x9e,
+	0x67, 0x39, 0xa6, 0x6e, 0xf8, 0xb6, 0x6e, 0xb8, 0xae, 0x17, 0x1a, 0xa1, 0xed, 0xb9, 0x01, 0x9e,
+	0xde, 0xac, 0x7a, 0xc1, 0x9e, 0x17, 0xe8, 0xdb, 0x46, 0x60, 0xf2, 0x1c, 0xfa, 0xc1, 0xdc, 0xb6,
+	0x19, 0x1a, 0x73, 0xba, 0x6f, 0x58, 0xb6, 0xcb, 0x8c, 0xd1, 0xf6, 0x35, 0x6e, 0xbb, 0xc5, 0x53,
+	0xf0, 0x0f, 0x78, 0x34, 0x82, 0x28, 0x6b, 0xe6, 0x7e, 0xdd, 0x0c, 0xc2, 0x28, 0x35, 0x3e, 0x3d,
+	0x30, 0x83, 0xd0, 0x76, 0xad, 0x2d, 0xa3, 0x5a, 0xf5, 0xea, 0xae, 0x7c, 0x6a, 0x99, 0xae, 0x19,
+	0xd8, 0x81, 0x74, 0x5a, 0x94, 0x4e, 0x0f, 0x0c, 0xc7, 0xde, 0x31, 0x42, 0xaf, 0x86, 0xe7, 0x91,
+	0x2e, 0xd5, 0x5d, 0xc3, 0x8e, 0x00, 0x0e, 0xe3, 0x33, 0xdf, 0xa8, 0x19, 0x7b, 0x81, 0x94, 0xc6,
+	0xf0, 0xfd, 0x9a, 0x77, 0x60, 0x38, 0x5b, 0xbe, 0xe7, 0xd8, 0x55, 0x94, 0x52, 0x9d, 0x87, 0x91,
+	0x87, 0x0d, 0xd6, 0xeb, 0x66, 0xb8, 0xda, 0x88, 0x54, 0xe1, 0x04, 0xa8, 0x02, 0x97, 0xb8, 0xdf,
+	0x83, 0xb5, 0x31, 0x32, 0x45, 0x6e, 0xf4, 0x57, 0xce, 0x3e, 0xab, 0x0f, 0x61, 0x54, 0xf2, 0x09,
+	0x7c, 0xcf, 0x0d, 0x4c, 0xfa, 0x26, 0x5c, 0x64, 0x70, 0x98, 0xc7, 0x95, 0xf9, 0x71, 0xad, 0x6d,
+	0x9d, 0x34, 0xe6, 0xb4, 0xd2, 0xff, 0xec, 0xaf, 0xc9, 0xbe, 0x0a, 0x77, 0x50, 0x3f, 0x40, 0x18,
+	0x65, 0xc7, 0x11, 0x60, 0xdc, 0x07, 0x68, 0x96, 0x01, 0xc3, 0xbe, 0xa1, 0xa1, 0xf4, 0x8d, 0x9a,
+	0x69, 0xbc, 0x2f, 0xb0, 0x66, 0xda, 0x86, 0x61, 0x99, 0xe8, 0x5b, 0x89, 0x79, 0xaa, 0x5f, 0x10,
+	0x18, 0x95, 0x12, 0xb4, 0x62, 0x2e, 0xe4, 0xc2, 0x4c, 0xd7, 0x05, 0x6c, 0x17, 0x18, 0xb6, 0xe9,
+	0x54, 0x6c, 0x3c, 0xad, 0x00, 0xce, 0x83, 0x89, 0x48, 0xcf, 0x75, 0x5e, 0xed, 0x32, 0x6f, 0x85,
+	0x0c, 0xc5, 0xa0, 0xf3, 0x30, 0x68, 0xec, 0xec, 0xd4, 0xcc, 0x20, 0x60, 0x10, 0x2e, 0xaf, 0x8c,
+	0xfd, 0xfe, 0xed, 0xec, 0x08, 0xa2, 0x28, 0xf3, 0x93, 0xcd, 0xb0, 0x66, 0xbb, 0x56, 0x25, 0x32,
+	0x54, 0xeb, 0x50, 0x4c, 0x4a, 0x88, 0xaa, 0x6c, 0xc2, 0x90, 0x25, 0x9c, 0xa0, 0xf6, 0xd7, 0x13,
+	0xe4, 0x11, 0xc3, 0xa0, 0x4e, 0x52, 0x08, 0xf5, 0x63, 0x82, 0x44, 0xcb, 0x8e, 0x93, 0x9f, 0xe8,
+	0xfd, 0x36, 0x72, 0x77, 0xd3, 0x0a, 0x3f, 0x10, 0x28, 0x26, 0xa1, 0xe8, 0xc0, 0xbe, 0xf0, 0x92,
+	0xec, 0xcf, 0xa5, 0x5d, 0x1e, 0xf1, 0x8b, 0xe5, 0xbf, 0x6b, 0x17, 0x39, 0x61, 0x53, 0xb0, 0x03,
+	0xe1, 0x24, 0xa5, 0x5d, 0xc4, 0x30, 0x91, 0x60, 0x62, 0x08, 0xa1, 0x5d, 0xf2, 0x13, 0x3d, 0x8f,
+	0x76, 0xc9, 0xc1, 0xbe, 0xf0, 0x92, 0xec, 0x7b, 0xd7, 0x2e, 0xfb, 0x30, 0x29, 0xbd, 0xec, 0x8f,
+	0xa2, 0xaf, 0x92, 0xf3, 0x6a, 0x98, 0x63, 0x98, 0x4a, 0x4e, 0x89, 0xa2, 0x3d, 0x86, 0xab, 0x96,
+	0x74, 0x86, 0x4d, 0x33, 0xdd, 0xf9, 0x2d, 0x3b, 0x33, 0x47, 0xe1, 0x5a, 0xc2, 0xa8, 0x9f, 0x10,
+	0x98, 0x94, 0xde, 0xf0, 0x5c, 0x94, 0x7b, 0xd5, 0x3a, 0xbf, 0x10, 0x98, 0x4a, 0xc6, 0xd1, 0x51,
+	0x87, 0x42, 0x0f, 0x74, 0xe8, 0x5d, 0x0b, 0x55, 0xe0, 0xff, 0x51, 0x3d, 0x23, 0x9e, 0x19, 0x64,
+	0x1c, 0x87, 0xcb, 0x38, 0x0e, 0x3d, 0x58, 0x63, 0xd9, 0xfb, 0x2b, 0xcd, 0x07, 0xea, 0x63, 0xb8,
+	0xd6, 0x12, 0x13, 0x25, 0xb9, 0x07, 0x83, 0x68, 0x87, 0x1d, 0x51, 0x4c, 0x50, 0x02, 0x1d, 0x51,
+	0x80, 0xc8, 0x49, 0x7d, 0x4a, 0x10, 0x6f, 0xd9, 0x71, 0x72, 0xe0, 0xed, 0x51, 0xd9, 0xe9, 0x14,
+	0x5c, 0xa9, 0x7a, 0x6e, 0x68, 0xba, 0xe1, 0x7b, 0x87, 0xbe, 0x39, 0x56, 0x68, 0xbc, 0x35, 0x95,
+	0xf8, 0x23, 0xf5, 0x4b, 0x02, 0xd7, 0x5a, 0x00, 0xb6, 0x23, 0x5f, 0xc8, 0x4d, 0xbe, 0x77, 0x45,
+	0x7f, 0x11, 0xbb, 0xf8, 0xa2, 0x5c, 0x87, 0x9b, 0xa1, 0x11, 0xd6, 0x83, 0x2c, 0x6a, 0x2e, 0xc3,
+	0x40, 0xc0, 0x8c, 0x19, 0x86, 0xa1, 0xc4, 0xcb, 0x10, 0x63, 0x69, 0x18, 0x19, 0x9d, 0xd2, 0x45,
+	0x94, 0xca, 0xd5, 0xdf, 0xf5, 0x5b, 0xfa, 0x75, 0xec, 0xb6, 0x68, 0xe1, 0xf9, 0xaa, 0x15, 0xe5,
+	0xc7, 0x76, 0x60, 0x57, 0x6b, 0x66, 0xfc, 0x6a, 0x9b, 0x87, 0xc1, 0x2a, 0x7f, 0x32, 0x46, 0xd2,
+	0x6e, 0x6c, 0x34, 0x94, 0xe5, 0xbe, 0x90, 0x26, 0x77, 0xa1, 0x6b, 0xb9, 0xbf, 0x89, 0x5d, 0x8a,
+	0xad, 0x0c, 0x5e, 0x35, 0xbd, 0x47, 0x80, 0x32, 0xb0, 0x1b, 0x6c, 0xa3, 0xc2, 0x6c, 0x6a, 0x05,
+	0x86, 0x85, 0xa7, 0x88, 0x7a, 0x09, 0x06, 0xf8, 0xe6, 0x85, 0xd7, 0xd6, 0x44, 0x02, 0x68, 0xee,
+	0x86, 0x98, 0xd1, 0x45, 0x5d, 0x6a, 0x4e, 0x75, 0x65, 0xdc, 0xd4, 0x36, 0xd8, 0xa2, 0x96, 0x65,
+	0x23, 0x8b, 0x4d, 0x68, 0xb2, 0x73, 0x73, 0x46, 0x31, 0x84, 0x93, 0x94, 0x09, 0x4d, 0x0c, 0x13,
+	0xcd, 0x28, 0x62, 0x88, 0xf9, 0x17, 0xc3, 0x70, 0x91, 0xe5, 0xa5, 0x4f, 0x08, 0x5c, 0x64, 0x2b,
+	0x12, 0xbd, 0x95, 0x10, 0xb0, 0xdd, 0x96, 0xa9, 0xdc, 0xce, 0x66, 0xcc, 0x39, 0xa8, 0xfa, 0x47,
+	0x7f, 0xfc, 0xf3, 0xe4, 0xc2, 0x0c, 0x9d, 0xd6, 0x9b, 0x5e, 0x7a, 0xe0, 0xbb, 0x7a, 0x7c, 0x15,
+	0xd6, 0x8f, 0x22, 0x55, 0x8e, 0xe9, 0x67, 0x04, 0x2e, 0xb1, 0x10, 0x65, 0xc7, 0xe9, 0x0c, 0x4c,
+	0xda, 0x3b, 0x95, 0xdb, 0xd9, 0x8c, 0x11, 0xd8, 0xeb, 0x0c, 0x58, 0x91, 0x8e, 0x77, 0x02, 0x46,
+	0x7f, 0x22, 0x30, 0x24, 0x6e, 0x0a, 0xf4, 0x4e, 0x0a, 0xff, 0xb6, 0x5b, 0x92, 0x52, 0xca, 0xe9,
+	0x85, 0x28, 0x57, 0x19, 0xca, 0x65, 0xba, 0x94, 0x80, 0x52, 0xfa, 0x1d, 0x22, 0x26, 0xa4, 0x7e,
+	0x84, 0x93, 0xdd, 0x31, 0xfd, 0x9e, 0xc0, 0xff, 0xc4, 0xf8, 0x0d, 0x6d, 0xef, 0xa4, 0xc8, 0xd5,
+	0x05, 0x8f, 0xc4, 0xed, 0x4c, 0x7d, 0x8b, 0xf1, 0x58, 0xa0, 0x73, 0xb9, 0x79, 0xb0, 0x12, 0x88,
+	0xd3, 0x77, 0x6a, 0x09, 0xda, 0x6e, 0x1e, 0x4a, 0x29, 0xa7, 0x57, 0xc6, 0x12, 0x48, 0x3f, 0x14,
+	0x25, 0x97, 0x40, 0x8c, 0x9f, 0xa5, 0x04, 0x5d, 0xf0, 0x48, 0xdc, 0x78, 0x52, 0x4b, 0x90, 0xcc,
+	0x83, 0xfe, 0x46, 0xe0, 0xaa, 0x3c, 0xc1, 0xd2, 0xc5, 0x6c, 0x1d, 0x2d, 0x4f, 0xf1, 0xca, 0xdd,
+	0xdc, 0x7e, 0x48, 0xe0, 0x5d, 0x46, 0xe0, 0x1d, 0xba, 0x9c, 0xd2, 0x43, 0x67, 0xbf, 0xba, 0xb5,
+	0x2f, 0xc5, 0xcf, 0x04, 0x86, 0xe5, 0x1c, 0x8d, 0x62, 0x2c, 0x66, 0xeb, 0xec, 0x7c, 0x7c, 0x3a,
+	0x6c, 0x11, 0xea, 0x12, 0xe3, 0x53, 0xa2, 0x0b, 0x5d, 0xf0, 0xa1, 0x5f, 0x11, 0x18, 0x8c, 0xbe,
+	0x65, 0x66, 0x53, 0x14, 0x15, 0xe7, 0x69, 0x45, 0xcb, 0x6a, 0x8e, 0x38, 0x97, 0x19, 0xce, 0xbb,
+	0xb4, 0x94, 0x80, 0x13, 0xbf, 0xc0, 0x05, 0xb5, 0xcf, 0x76, 0x86, 0x63, 0xfa, 0x94, 0x00, 0x60,
+	0xc8, 0x86, 0xcc, 0xb3, 0x29, 0x72, 0xe5, 0x01, 0xdb, 0x3a, 0x8a, 0xab, 0x73, 0x0c, 0xec, 0x2d,
+	0x3a, 0x93, 0x19, 0x2c, 0xfd, 0x95, 0x00, 0x6d, 0x02, 0x8c, 0xe6, 0x48, 0x5a, 0xca, 0x96, 0x59,
+	0x9a, 0xaf, 0x95, 0xc5, 0xbc, 0x6e, 0x08, 0x7c, 0x8d, 0x01, 0xbf, 0x47, 0xdf, 0xee, 0x0c, 0x7c,
+	0x6b, 0xfb, 0x70, 0x8b, 0x8f, 0xdb, 0x82, 0xde, 0xfc, 0x11, 0x6f, 0xee, 0x38, 0x17, 0x1c, 0xd2,
+	0x68, 0x66, 0x54, 0xe2, 0x5c, 0x9a, 0xda, 0xdc, 0x49, 0xd3, 0x60, 0x6a, 0x73, 0xc7, 0xe8, 0xe0,
+	0x3c, 0xab, 0x1f, 0xe1, 0x1f, 0xc7, 0xf4, 0x3b, 0x02, 0x43, 0xe2, 0x30, 0x93, 0x7a, 0xe5, 0xb7,
+	0x9d, 0xbf, 0x94, 0x52, 0x4e, 0xaf, 0x8c, 0x57, 0xa5, 0xf4, 0xb3, 0x7c, 0xbc, 0x99, 0x3e, 0x25,
+	0x30, 0xc0, 0x67, 0x45, 0x3a, 0xd3, 0x29, 0xb9, 0x30, 0x9c, 0x2a, 0x37, 0xb3, 0x98, 0x22, 0xb8,
+	0xeb, 0x0c, 0xdc, 0x24, 0x9d, 0x48, 0x00, 0xc7, 0x67, 0xd3, 0x95, 0x95, 0x67, 0x27, 0x45, 0xf2,
+	0xfc, 0xa4, 0x48, 0xfe, 0x3e, 0x29, 0x92, 0xcf, 0x4f, 0x8b, 0x7d, 0xcf, 0x4f, 0x8b, 0x7d, 0x7f,
+	0x9e, 0x16, 0xfb, 0xde, 0xbf, 0x61, 0xd9, 0xe1, 0x6e, 0x7d, 0x5b, 0xab, 0x7a, 0x7b, 0x72, 0x88,
+	0x0f, 0xa3, 0x20, 0xe1, 0xa1, 0x6f, 0x06, 0xdb, 0x03, 0xec, 0xff, 0x0d, 0x0b, 0xff, 0x0e, 0x00,
+	0x73, 0x98, 0x2b, 0x99, 0xd0, 0x19, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1226,6 +1577,12 @@ type QueryClient interface {
 	Request(ctx context.Context, in *QueryGetRequestRequest, opts ...grpc.CallOption) (*QueryGetRequestResponse, error)
 	// Queries a list of request for a chain.
 	RequestAll(ctx context.Context, in *QueryAllRequestRequest, opts ...grpc.CallOption) (*QueryAllRequestResponse, error)
+	// Queries a list of request for a chain with a specific status.
+	RequestAllByStatus(ctx context.Context, in *QueryAllRequestByStatusRequest, opts ...grpc.CallOption) (*QueryAllRequestByStatusResponse, error)
+	// Queries a list of request sent by an account.
+	RequestAllByCreator(ctx context.Context, in *QueryAllRequestByCreatorRequest, opts ...grpc.CallOption) (*QueryAllRequestByCreatorResponse, error)
+	// Queries the approval policy of a chain.
+	ApprovalPolicy(ctx context.Context, in *QueryGetApprovalPolicyRequest, opts ...grpc.CallOption) (*QueryGetApprovalPolicyResponse, error)
 	// Params queries the parameters of the module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
@@ -1328,6 +1685,33 @@ func (c *queryClient) RequestAll(ctx context.Context, in *QueryAllRequestRequest
 	return out, nil
 }
 
+func (c *queryClient) RequestAllByStatus(ctx context.Context, in *QueryAllRequestByStatusRequest, opts ...grpc.CallOption) (*QueryAllRequestByStatusResponse, error) {
+	out := new(QueryAllRequestByStatusResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.launch.Query/RequestAllByStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RequestAllByCreator(ctx context.Context, in *QueryAllRequestByCreatorRequest, opts ...grpc.CallOption) (*QueryAllRequestByCreatorResponse, error) {
+	out := new(QueryAllRequestByCreatorResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.launch.Query/RequestAllByCreator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ApprovalPolicy(ctx context.Context, in *QueryGetApprovalPolicyRequest, opts ...grpc.CallOption) (*QueryGetApprovalPolicyResponse, error) {
+	out := new(QueryGetApprovalPolicyResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.launch.Query/ApprovalPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/tendermint.spn.launch.Query/Params", in, out, opts...)
@@ -1359,6 +1743,12 @@ type QueryServer interface {
 	Request(context.Context, *QueryGetRequestRequest) (*QueryGetRequestResponse, error)
 	// Queries a list of request for a chain.
 	RequestAll(context.Context, *QueryAllRequestRequest) (*QueryAllRequestResponse, error)
+	// Queries a list of request for a chain with a specific status.
+	RequestAllByStatus(context.Context, *QueryAllRequestByStatusRequest) (*QueryAllRequestByStatusResponse, error)
+	// Queries a list of request sent by an account.
+	RequestAllByCreator(context.Context, *QueryAllRequestByCreatorRequest) (*QueryAllRequestByCreatorResponse, error)
+	// Queries the approval policy of a chain.
+	ApprovalPolicy(context.Context, *QueryGetApprovalPolicyRequest) (*QueryGetApprovalPolicyResponse, error)
 	// Params queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
@@ -1397,6 +1787,15 @@ func (*UnimplementedQueryServer) Request(ctx context.Context, req *QueryGetReque
 func (*UnimplementedQueryServer) RequestAll(ctx context.Context, req *QueryAllRequestRequest) (*QueryAllRequestResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RequestAll not implemented")
 }
+func (*UnimplementedQueryServer) RequestAllByStatus(ctx context.Context, req *QueryAllRequestByStatusRequest) (*QueryAllRequestByStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestAllByStatus not implemented")
+}
+func (*UnimplementedQueryServer) RequestAllByCreator(ctx context.Context, req *QueryAllRequestByCreatorRequest) (*QueryAllRequestByCreatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestAllByCreator not implemented")
+}
+func (*UnimplementedQueryServer) ApprovalPolicy(ctx context.Context, req *QueryGetApprovalPolicyRequest) (*QueryGetApprovalPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApprovalPolicy not implemented")
+}
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -1585,43 +1984,97 @@ func _Query_RequestAll_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
+func _Query_RequestAllByStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllRequestByStatusRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
+		return srv.(QueryServer).RequestAllByStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/tendermint.spn.launch.Query/Params",
+		FullMethod: "/tendermint.spn.launch.Query/RequestAllByStatus",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+		return srv.(QueryServer).RequestAllByStatus(ctx, req.(*QueryAllRequestByStatusRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "tendermint.spn.launch.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Chain",
-			Handler:    _Query_Chain_Handler,
-		},
-		{
-			MethodName: "ChainAll",
-			Handler:    _Query_ChainAll_Handler,
-		},
-		{
-			MethodName: "GenesisAccount",
-			Handler:    _Query_GenesisAccount_Handler,
-		},
-		{
-			MethodName: "GenesisAccountAll",
-			Handler:    _Query_GenesisAccountAll_Handler,
+func _Query_RequestAllByCreator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllRequestByCreatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RequestAllByCreator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.launch.Query/RequestAllByCreator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RequestAllByCreator(ctx, req.(*QueryAllRequestByCreatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ApprovalPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetApprovalPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ApprovalPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.launch.Query/ApprovalPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ApprovalPolicy(ctx, req.(*QueryGetApprovalPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.launch.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "tendermint.spn.launch.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Chain",
+			Handler:    _Query_Chain_Handler,
+		},
+		{
+			MethodName: "ChainAll",
+			Handler:    _Query_ChainAll_Handler,
+		},
+		{
+			MethodName: "GenesisAccount",
+			Handler:    _Query_GenesisAccount_Handler,
+		},
+		{
+			MethodName: "GenesisAccountAll",
+			Handler:    _Query_GenesisAccountAll_Handler,
 		},
 		{
 			MethodName: "VestingAccount",
@@ -1647,6 +2100,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RequestAll",
 			Handler:    _Query_RequestAll_Handler,
 		},
+		{
+			MethodName: "RequestAllByStatus",
+			Handler:    _Query_RequestAllByStatus_Handler,
+		},
+		{
+			MethodName: "RequestAllByCreator",
+			Handler:    _Query_RequestAllByCreator_Handler,
+		},
+		{
+			MethodName: "ApprovalPolicy",
+			Handler:    _Query_ApprovalPolicy_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -2358,6 +2823,13 @@ func (m *QueryAllRequestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.ContentType) > 0 {
+		i -= len(m.ContentType)
+		copy(dAtA[i:], m.ContentType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContentType)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -2427,7 +2899,7 @@ func (m *QueryAllRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllRequestByStatusRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2437,20 +2909,49 @@ func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllRequestByStatusRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllRequestByStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ContentType) > 0 {
+		i -= len(m.ContentType)
+		copy(dAtA[i:], m.ContentType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContentType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LaunchID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LaunchID))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllRequestByStatusResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2460,142 +2961,373 @@ func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllRequestByStatusResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllRequestByStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Request) > 0 {
+		for iNdEx := len(m.Request) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Request[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryGetChainRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.LaunchID != 0 {
-		n += 1 + sovQuery(uint64(m.LaunchID))
+func (m *QueryAllRequestByCreatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetChainResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Chain.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryAllRequestByCreatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllChainRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAllRequestByCreatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryAllChainResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Chain) > 0 {
-		for _, e := range m.Chain {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ContentType) > 0 {
+		i -= len(m.ContentType)
+		copy(dAtA[i:], m.ContentType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContentType)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetGenesisAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.LaunchID != 0 {
-		n += 1 + sovQuery(uint64(m.LaunchID))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryAllRequestByCreatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetGenesisAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.GenesisAccount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryAllRequestByCreatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllGenesisAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAllRequestByCreatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.LaunchID != 0 {
-		n += 1 + sovQuery(uint64(m.LaunchID))
-	}
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryAllGenesisAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Request) > 0 {
+		for iNdEx := len(m.Request) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Request[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetApprovalPolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetApprovalPolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetApprovalPolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LaunchID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LaunchID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetApprovalPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetApprovalPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetApprovalPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ApprovalPolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryGetChainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LaunchID != 0 {
+		n += 1 + sovQuery(uint64(m.LaunchID))
+	}
+	return n
+}
+
+func (m *QueryGetChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Chain.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllChainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Chain) > 0 {
+		for _, e := range m.Chain {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetGenesisAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LaunchID != 0 {
+		n += 1 + sovQuery(uint64(m.LaunchID))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetGenesisAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GenesisAccount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllGenesisAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LaunchID != 0 {
+		n += 1 + sovQuery(uint64(m.LaunchID))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllGenesisAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	if len(m.GenesisAccount) > 0 {
@@ -2774,6 +3506,10 @@ func (m *QueryAllRequestRequest) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	l = len(m.ContentType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -2789,40 +3525,912 @@ func (m *QueryAllRequestResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllRequestByStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LaunchID != 0 {
+		n += 1 + sovQuery(uint64(m.LaunchID))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	l = len(m.ContentType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllRequestByStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Request) > 0 {
+		for _, e := range m.Request {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllRequestByCreatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ContentType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllRequestByCreatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Request) > 0 {
+		for _, e := range m.Request {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetApprovalPolicyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LaunchID != 0 {
+		n += 1 + sovQuery(uint64(m.LaunchID))
+	}
+	return n
+}
+
+func (m *QueryGetApprovalPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ApprovalPolicy.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryGetChainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetChainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LaunchID", wireType)
+			}
+			m.LaunchID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LaunchID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetChainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetChainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Chain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllChainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllChainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllChainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllChainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = append(m.Chain, Chain{})
+			if err := m.Chain[len(m.Chain)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetGenesisAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetGenesisAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetGenesisAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LaunchID", wireType)
+			}
+			m.LaunchID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LaunchID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetGenesisAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetGenesisAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetGenesisAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GenesisAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllGenesisAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllGenesisAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllGenesisAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LaunchID", wireType)
+			}
+			m.LaunchID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LaunchID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllGenesisAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllGenesisAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllGenesisAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GenesisAccount = append(m.GenesisAccount, GenesisAccount{})
+			if err := m.GenesisAccount[len(m.GenesisAccount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryGetChainRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetVestingAccountRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2845,10 +4453,10 @@ func (m *QueryGetChainRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetChainRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetVestingAccountRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetVestingAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2870,6 +4478,38 @@ func (m *QueryGetChainRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2891,7 +4531,7 @@ func (m *QueryGetChainRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetChainResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetVestingAccountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2914,15 +4554,15 @@ func (m *QueryGetChainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetChainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetVestingAccountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingAccount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2949,7 +4589,7 @@ func (m *QueryGetChainResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Chain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.VestingAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2974,7 +4614,7 @@ func (m *QueryGetChainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllChainRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllVestingAccountRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2997,13 +4637,32 @@ func (m *QueryAllChainRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllChainRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllVestingAccountRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllVestingAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LaunchID", wireType)
+			}
+			m.LaunchID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LaunchID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -3060,7 +4719,7 @@ func (m *QueryAllChainRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllChainResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllVestingAccountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3083,15 +4742,15 @@ func (m *QueryAllChainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllChainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllVestingAccountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingAccount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3118,8 +4777,8 @@ func (m *QueryAllChainResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Chain = append(m.Chain, Chain{})
-			if err := m.Chain[len(m.Chain)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.VestingAccount = append(m.VestingAccount, VestingAccount{})
+			if err := m.VestingAccount[len(m.VestingAccount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3180,7 +4839,7 @@ func (m *QueryAllChainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetGenesisAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetGenesisValidatorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3203,10 +4862,10 @@ func (m *QueryGetGenesisAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetGenesisAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetGenesisValidatorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetGenesisAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetGenesisValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3281,7 +4940,7 @@ func (m *QueryGetGenesisAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetGenesisAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetGenesisValidatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3304,15 +4963,15 @@ func (m *QueryGetGenesisAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetGenesisAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetGenesisValidatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetGenesisAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetGenesisValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GenesisAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisValidator", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3339,7 +4998,7 @@ func (m *QueryGetGenesisAccountResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GenesisAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GenesisValidator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3364,7 +5023,7 @@ func (m *QueryGetGenesisAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllGenesisAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllGenesisValidatorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3387,10 +5046,10 @@ func (m *QueryAllGenesisAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllGenesisAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllGenesisValidatorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllGenesisAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllGenesisValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3469,7 +5128,7 @@ func (m *QueryAllGenesisAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllGenesisAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllGenesisValidatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3492,15 +5151,15 @@ func (m *QueryAllGenesisAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllGenesisAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllGenesisValidatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllGenesisAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllGenesisValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GenesisAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisValidator", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3527,8 +5186,8 @@ func (m *QueryAllGenesisAccountResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GenesisAccount = append(m.GenesisAccount, GenesisAccount{})
-			if err := m.GenesisAccount[len(m.GenesisAccount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.GenesisValidator = append(m.GenesisValidator, GenesisValidator{})
+			if err := m.GenesisValidator[len(m.GenesisValidator)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3589,7 +5248,7 @@ func (m *QueryAllGenesisAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetVestingAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetRequestRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3612,10 +5271,10 @@ func (m *QueryGetVestingAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetVestingAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetRequestRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetVestingAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetRequestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3638,10 +5297,10 @@ func (m *QueryGetVestingAccountRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
 			}
-			var stringLen uint64
+			m.RequestID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3651,24 +5310,11 @@ func (m *QueryGetVestingAccountRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.RequestID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3690,7 +5336,7 @@ func (m *QueryGetVestingAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetVestingAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetRequestResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3713,15 +5359,15 @@ func (m *QueryGetVestingAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetVestingAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetRequestResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VestingAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3748,7 +5394,7 @@ func (m *QueryGetVestingAccountResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.VestingAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3773,7 +5419,7 @@ func (m *QueryGetVestingAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllVestingAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllRequestRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3796,10 +5442,10 @@ func (m *QueryAllVestingAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllVestingAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllRequestRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllVestingAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllRequestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3857,6 +5503,38 @@ func (m *QueryAllVestingAccountRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3878,7 +5556,7 @@ func (m *QueryAllVestingAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllVestingAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllRequestResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3901,15 +5579,15 @@ func (m *QueryAllVestingAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllVestingAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllRequestResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VestingAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3936,8 +5614,8 @@ func (m *QueryAllVestingAccountResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VestingAccount = append(m.VestingAccount, VestingAccount{})
-			if err := m.VestingAccount[len(m.VestingAccount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Request = append(m.Request, Request{})
+			if err := m.Request[len(m.Request)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3998,7 +5676,7 @@ func (m *QueryAllVestingAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetGenesisValidatorRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllRequestByStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4021,10 +5699,10 @@ func (m *QueryGetGenesisValidatorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetGenesisValidatorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllRequestByStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetGenesisValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllRequestByStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4047,10 +5725,10 @@ func (m *QueryGetGenesisValidatorRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4060,162 +5738,16 @@ func (m *QueryGetGenesisValidatorRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Status |= Request_Status(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetGenesisValidatorResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetGenesisValidatorResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetGenesisValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GenesisValidator", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.GenesisValidator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAllGenesisValidatorRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllGenesisValidatorRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllGenesisValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LaunchID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
 			}
-			m.LaunchID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4225,12 +5757,25 @@ func (m *QueryAllGenesisValidatorRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LaunchID |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -4287,7 +5832,7 @@ func (m *QueryAllGenesisValidatorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllGenesisValidatorResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllRequestByStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4310,15 +5855,15 @@ func (m *QueryAllGenesisValidatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllGenesisValidatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllRequestByStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllGenesisValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllRequestByStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GenesisValidator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4345,8 +5890,8 @@ func (m *QueryAllGenesisValidatorResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GenesisValidator = append(m.GenesisValidator, GenesisValidator{})
-			if err := m.GenesisValidator[len(m.GenesisValidator)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Request = append(m.Request, Request{})
+			if err := m.Request[len(m.Request)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4407,7 +5952,7 @@ func (m *QueryAllGenesisValidatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetRequestRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllRequestByCreatorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4430,17 +5975,17 @@ func (m *QueryGetRequestRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetRequestRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllRequestByCreatorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetRequestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllRequestByCreatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LaunchID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
-			m.LaunchID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4450,16 +5995,29 @@ func (m *QueryGetRequestRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LaunchID |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
 			}
-			m.RequestID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4469,64 +6027,27 @@ func (m *QueryGetRequestRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestID |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetRequestResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetRequestResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.ContentType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4553,7 +6074,10 @@ func (m *QueryGetRequestResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4578,7 +6102,7 @@ func (m *QueryGetRequestResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllRequestRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllRequestByCreatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4601,17 +6125,17 @@ func (m *QueryAllRequestRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllRequestRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllRequestByCreatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllRequestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllRequestByCreatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LaunchID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
-			m.LaunchID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4621,11 +6145,26 @@ func (m *QueryAllRequestRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LaunchID |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Request = append(m.Request, Request{})
+			if err := m.Request[len(m.Request)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -4656,7 +6195,7 @@ func (m *QueryAllRequestRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -4683,7 +6222,7 @@ func (m *QueryAllRequestRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllRequestResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4706,49 +6245,65 @@ func (m *QueryAllRequestResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllRequestResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Request = append(m.Request, Request{})
-			if err := m.Request[len(m.Request)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 2:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4775,10 +6330,7 @@ func (m *QueryAllRequestResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4803,7 +6355,7 @@ func (m *QueryAllRequestResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetApprovalPolicyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4826,12 +6378,31 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetApprovalPolicyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetApprovalPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LaunchID", wireType)
+			}
+			m.LaunchID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LaunchID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4853,7 +6424,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetApprovalPolicyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4876,15 +6447,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetApprovalPolicyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetApprovalPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovalPolicy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4911,7 +6482,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ApprovalPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6,6 +6,8 @@ package types
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -27,21 +29,24 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Request_Status int32
 
 const (
-	Request_PENDING  Request_Status = 0
-	Request_APPROVED Request_Status = 1
-	Request_REJECTED Request_Status = 2
+	Request_PENDING   Request_Status = 0
+	Request_APPROVED  Request_Status = 1
+	Request_REJECTED  Request_Status = 2
+	Request_CANCELLED Request_Status = 3
 )
 
 var Request_Status_name = map[int32]string{
 	0: "PENDING",
 	1: "APPROVED",
 	2: "REJECTED",
+	3: "CANCELLED",
 }
 
 var Request_Status_value = map[string]int32{
-	"PENDING":  0,
-	"APPROVED": 1,
-	"REJECTED": 2,
+	"PENDING":   0,
+	"APPROVED":  1,
+	"REJECTED":  2,
+	"CANCELLED": 3,
 }
 
 func (x Request_Status) String() string {
@@ -59,6 +64,8 @@ type Request struct {
 	CreatedAt int64          `protobuf:"varint,4,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
 	Content   RequestContent `protobuf:"bytes,5,opt,name=content,proto3" json:"content"`
 	Status    Request_Status `protobuf:"varint,6,opt,name=status,proto3,enum=tendermint.spn.launch.Request_Status" json:"status,omitempty"`
+	// expiresAt is the timestamp when the request is rejected if still pending, 0 if the request never expires
+	ExpiresAt int64 `protobuf:"varint,7,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
 }
 
 func (m *Request) Reset()         { *m = Request{} }
@@ -136,6 +143,13 @@ func (m *Request) GetStatus() Request_Status {
 	return Request_PENDING
 }
 
+func (m *Request) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
 type RequestContent struct {
 	// Types that are valid to be assigned to Content:
 	//	*RequestContent_GenesisAccount
@@ -143,6 +157,8 @@ type RequestContent struct {
 	//	*RequestContent_GenesisValidator
 	//	*RequestContent_AccountRemoval
 	//	*RequestContent_ValidatorRemoval
+	//	*RequestContent_GenesisAccountUpdate
+	//	*RequestContent_GenesisValidatorUpdate
 	Content isRequestContent_Content `protobuf_oneof:"content"`
 }
 
@@ -200,12 +216,20 @@ type RequestContent_AccountRemoval struct {
 type RequestContent_ValidatorRemoval struct {
 	ValidatorRemoval *ValidatorRemoval `protobuf:"bytes,5,opt,name=validatorRemoval,proto3,oneof" json:"validatorRemoval,omitempty"`
 }
+type RequestContent_GenesisAccountUpdate struct {
+	GenesisAccountUpdate *GenesisAccountUpdate `protobuf:"bytes,6,opt,name=genesisAccountUpdate,proto3,oneof" json:"genesisAccountUpdate,omitempty"`
+}
+type RequestContent_GenesisValidatorUpdate struct {
+	GenesisValidatorUpdate *GenesisValidatorUpdate `protobuf:"bytes,7,opt,name=genesisValidatorUpdate,proto3,oneof" json:"genesisValidatorUpdate,omitempty"`
+}
 
-func (*RequestContent_GenesisAccount) isRequestContent_Content()   {}
-func (*RequestContent_VestingAccount) isRequestContent_Content()   {}
-func (*RequestContent_GenesisValidator) isRequestContent_Content() {}
-func (*RequestContent_AccountRemoval) isRequestContent_Content()   {}
-func (*RequestContent_ValidatorRemoval) isRequestContent_Content() {}
+func (*RequestContent_GenesisAccount) isRequestContent_Content()         {}
+func (*RequestContent_VestingAccount) isRequestContent_Content()         {}
+func (*RequestContent_GenesisValidator) isRequestContent_Content()       {}
+func (*RequestContent_AccountRemoval) isRequestContent_Content()         {}
+func (*RequestContent_ValidatorRemoval) isRequestContent_Content()       {}
+func (*RequestContent_GenesisAccountUpdate) isRequestContent_Content()   {}
+func (*RequestContent_GenesisValidatorUpdate) isRequestContent_Content() {}
 
 func (m *RequestContent) GetContent() isRequestContent_Content {
 	if m != nil {
@@ -249,6 +273,20 @@ func (m *RequestContent) GetValidatorRemoval() *ValidatorRemoval {
 	return nil
 }
 
+func (m *RequestContent) GetGenesisAccountUpdate() *GenesisAccountUpdate {
+	if x, ok := m.GetContent().(*RequestContent_GenesisAccountUpdate); ok {
+		return x.GenesisAccountUpdate
+	}
+	return nil
+}
+
+func (m *RequestContent) GetGenesisValidatorUpdate() *GenesisValidatorUpdate {
+	if x, ok := m.GetContent().(*RequestContent_GenesisValidatorUpdate); ok {
+		return x.GenesisValidatorUpdate
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*RequestContent) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -257,6 +295,8 @@ func (*RequestContent) XXX_OneofWrappers() []interface{} {
 		(*RequestContent_GenesisValidator)(nil),
 		(*RequestContent_AccountRemoval)(nil),
 		(*RequestContent_ValidatorRemoval)(nil),
+		(*RequestContent_GenesisAccountUpdate)(nil),
+		(*RequestContent_GenesisValidatorUpdate)(nil),
 	}
 }
 
@@ -348,52 +388,226 @@ func (m *ValidatorRemoval) GetValAddress() string {
 	return ""
 }
 
+// GenesisAccountUpdate updates the balance of an existing genesis or vesting account
+// the account becomes a genesis account with coins if set, a vesting account with vestingOptions otherwise
+type GenesisAccountUpdate struct {
+	LaunchID       uint64                                   `protobuf:"varint,1,opt,name=launchID,proto3" json:"launchID,omitempty"`
+	Address        string                                   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Coins          github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=coins,proto3,casttype=github.com/cosmos/cosmos-sdk/types.Coin,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+	VestingOptions *VestingOptions                          `protobuf:"bytes,4,opt,name=vestingOptions,proto3" json:"vestingOptions,omitempty"`
+}
+
+func (m *GenesisAccountUpdate) Reset()         { *m = GenesisAccountUpdate{} }
+func (m *GenesisAccountUpdate) String() string { return proto.CompactTextString(m) }
+func (*GenesisAccountUpdate) ProtoMessage()    {}
+func (*GenesisAccountUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_028e4b0ce31bf039, []int{4}
+}
+func (m *GenesisAccountUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisAccountUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisAccountUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisAccountUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisAccountUpdate.Merge(m, src)
+}
+func (m *GenesisAccountUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisAccountUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisAccountUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisAccountUpdate proto.InternalMessageInfo
+
+func (m *GenesisAccountUpdate) GetLaunchID() uint64 {
+	if m != nil {
+		return m.LaunchID
+	}
+	return 0
+}
+
+func (m *GenesisAccountUpdate) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GenesisAccountUpdate) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
+
+func (m *GenesisAccountUpdate) GetVestingOptions() *VestingOptions {
+	if m != nil {
+		return m.VestingOptions
+	}
+	return nil
+}
+
+// GenesisValidatorUpdate updates the peer or the gentx of an existing genesis validator
+// the gentx, the consensus public key and the self delegation are updated together
+type GenesisValidatorUpdate struct {
+	LaunchID       uint64      `protobuf:"varint,1,opt,name=launchID,proto3" json:"launchID,omitempty"`
+	Address        string      `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Peer           *Peer       `protobuf:"bytes,3,opt,name=peer,proto3" json:"peer,omitempty"`
+	GenTx          []byte      `protobuf:"bytes,4,opt,name=genTx,proto3" json:"genTx,omitempty"`
+	ConsPubKey     []byte      `protobuf:"bytes,5,opt,name=consPubKey,proto3" json:"consPubKey,omitempty"`
+	SelfDelegation *types.Coin `protobuf:"bytes,6,opt,name=selfDelegation,proto3" json:"selfDelegation,omitempty"`
+}
+
+func (m *GenesisValidatorUpdate) Reset()         { *m = GenesisValidatorUpdate{} }
+func (m *GenesisValidatorUpdate) String() string { return proto.CompactTextString(m) }
+func (*GenesisValidatorUpdate) ProtoMessage()    {}
+func (*GenesisValidatorUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_028e4b0ce31bf039, []int{5}
+}
+func (m *GenesisValidatorUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisValidatorUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisValidatorUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisValidatorUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisValidatorUpdate.Merge(m, src)
+}
+func (m *GenesisValidatorUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisValidatorUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisValidatorUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisValidatorUpdate proto.InternalMessageInfo
+
+func (m *GenesisValidatorUpdate) GetLaunchID() uint64 {
+	if m != nil {
+		return m.LaunchID
+	}
+	return 0
+}
+
+func (m *GenesisValidatorUpdate) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GenesisValidatorUpdate) GetPeer() *Peer {
+	if m != nil {
+		return m.Peer
+	}
+	return nil
+}
+
+func (m *GenesisValidatorUpdate) GetGenTx() []byte {
+	if m != nil {
+		return m.GenTx
+	}
+	return nil
+}
+
+func (m *GenesisValidatorUpdate) GetConsPubKey() []byte {
+	if m != nil {
+		return m.ConsPubKey
+	}
+	return nil
+}
+
+func (m *GenesisValidatorUpdate) GetSelfDelegation() *types.Coin {
+	if m != nil {
+		return m.SelfDelegation
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("tendermint.spn.launch.Request_Status", Request_Status_name, Request_Status_value)
 	proto.RegisterType((*Request)(nil), "tendermint.spn.launch.Request")
 	proto.RegisterType((*RequestContent)(nil), "tendermint.spn.launch.RequestContent")
 	proto.RegisterType((*AccountRemoval)(nil), "tendermint.spn.launch.AccountRemoval")
 	proto.RegisterType((*ValidatorRemoval)(nil), "tendermint.spn.launch.ValidatorRemoval")
+	proto.RegisterType((*GenesisAccountUpdate)(nil), "tendermint.spn.launch.GenesisAccountUpdate")
+	proto.RegisterType((*GenesisValidatorUpdate)(nil), "tendermint.spn.launch.GenesisValidatorUpdate")
 }
 
 func init() { proto.RegisterFile("launch/request.proto", fileDescriptor_028e4b0ce31bf039) }
 
 var fileDescriptor_028e4b0ce31bf039 = []byte{
-	// 534 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xcf, 0x6e, 0xd3, 0x30,
-	0x18, 0x4f, 0xda, 0xae, 0x5d, 0x5d, 0x54, 0x55, 0x56, 0x91, 0x42, 0x35, 0x85, 0xaa, 0x12, 0x22,
-	0x17, 0x12, 0x51, 0x2e, 0x5c, 0x38, 0xa4, 0x4b, 0xb4, 0x15, 0xa1, 0xad, 0xf2, 0xa0, 0x07, 0x2e,
-	0x53, 0x96, 0x5a, 0x59, 0xa4, 0xd6, 0x2e, 0xb1, 0x53, 0xc1, 0x5b, 0xf0, 0x30, 0x3b, 0xf2, 0x00,
-	0x3b, 0x4e, 0x9c, 0x38, 0x21, 0xd4, 0xbe, 0x08, 0x72, 0xec, 0xb4, 0x24, 0xb0, 0xb2, 0x9b, 0x3f,
-	0xff, 0xfe, 0xf8, 0x67, 0x7f, 0x9f, 0x41, 0x77, 0x1e, 0xa4, 0x24, 0xbc, 0x76, 0x12, 0xfc, 0x29,
-	0xc5, 0x8c, 0xdb, 0xcb, 0x84, 0x72, 0x0a, 0x1f, 0x73, 0x4c, 0x66, 0x38, 0x59, 0xc4, 0x84, 0xdb,
-	0x6c, 0x49, 0x6c, 0x49, 0xea, 0x75, 0x23, 0x1a, 0xd1, 0x8c, 0xe1, 0x88, 0x95, 0x24, 0xf7, 0x9e,
-	0x84, 0x94, 0x2d, 0x28, 0xbb, 0x94, 0x80, 0x2c, 0x14, 0x74, 0xa4, 0xdc, 0x23, 0x4c, 0x30, 0x8b,
-	0xd9, 0x65, 0x10, 0x86, 0x34, 0x25, 0xbc, 0x84, 0xae, 0x30, 0xe3, 0x31, 0x89, 0x4a, 0xa8, 0x59,
-	0xd2, 0xae, 0x82, 0x79, 0x3c, 0x0b, 0x38, 0x4d, 0x24, 0x3e, 0xb8, 0xa9, 0x80, 0x06, 0x92, 0xa9,
-	0x61, 0x0f, 0x1c, 0x4a, 0xf6, 0xd8, 0x33, 0xf4, 0xbe, 0x6e, 0xd5, 0xd0, 0xb6, 0x86, 0x47, 0xa0,
-	0xa9, 0x2e, 0x37, 0xf6, 0x8c, 0x4a, 0x06, 0xee, 0x36, 0xa0, 0x01, 0x1a, 0x61, 0x82, 0x85, 0xad,
-	0x51, 0xed, 0xeb, 0x56, 0x13, 0xe5, 0xa5, 0xd0, 0x65, 0x4b, 0x3c, 0x73, 0xb9, 0x51, 0xeb, 0xeb,
-	0x56, 0x15, 0xed, 0x36, 0xa0, 0x0f, 0x1a, 0x21, 0x25, 0x1c, 0x13, 0x6e, 0x1c, 0xf4, 0x75, 0xab,
-	0x35, 0x7c, 0x66, 0xff, 0xf3, 0xcd, 0x6c, 0x15, 0xf1, 0x58, 0x92, 0x47, 0xb5, 0xdb, 0x9f, 0x4f,
-	0x35, 0x94, 0x6b, 0xe1, 0x1b, 0x50, 0x67, 0x3c, 0xe0, 0x29, 0x33, 0xea, 0x7d, 0xdd, 0x6a, 0xff,
-	0xcf, 0xc5, 0xbe, 0xc8, 0xc8, 0x48, 0x89, 0x06, 0x2f, 0x41, 0x5d, 0xee, 0xc0, 0x16, 0x68, 0x4c,
-	0xfc, 0x33, 0x6f, 0x7c, 0x76, 0xd2, 0xd1, 0xe0, 0x23, 0x70, 0xe8, 0x4e, 0x26, 0xe8, 0x7c, 0xea,
-	0x7b, 0x1d, 0x5d, 0x54, 0xc8, 0x7f, 0xeb, 0x1f, 0xbf, 0xf7, 0xbd, 0x4e, 0x65, 0xf0, 0xad, 0x0a,
-	0xda, 0xc5, 0x4c, 0xf0, 0x1c, 0xb4, 0xd5, 0x23, 0xbb, 0xb2, 0x03, 0xd9, 0x1b, 0xde, 0x7f, 0xa5,
-	0x93, 0x02, 0xf9, 0x54, 0x43, 0x25, 0xb9, 0x30, 0x54, 0x3d, 0xcd, 0x0d, 0x2b, 0x7b, 0x0d, 0xa7,
-	0x05, 0xb2, 0x30, 0x2c, 0xca, 0xe1, 0x07, 0xd0, 0x51, 0x47, 0x4c, 0xf3, 0x29, 0xc8, 0xda, 0xd5,
-	0x1a, 0x3e, 0xdf, 0x9f, 0x71, 0x4b, 0x3f, 0xd5, 0xd0, 0x5f, 0x16, 0x22, 0xa7, 0x9a, 0x39, 0x84,
-	0x17, 0x74, 0x15, 0xcc, 0xb3, 0x3e, 0xdf, 0x9f, 0xd3, 0x2d, 0x90, 0x45, 0xce, 0xa2, 0x5c, 0xe4,
-	0xdc, 0x8e, 0x69, 0x6e, 0x79, 0xb0, 0x37, 0xe7, 0xb4, 0x44, 0x17, 0x39, 0xcb, 0x16, 0xa3, 0xe6,
-	0x76, 0xd8, 0x06, 0x1e, 0x68, 0x17, 0x53, 0xc0, 0x21, 0x68, 0x04, 0xb3, 0x59, 0x82, 0x19, 0xcb,
-	0xda, 0xd6, 0x1c, 0x19, 0xdf, 0x6f, 0x5e, 0x74, 0xd5, 0x37, 0x74, 0x25, 0x72, 0xc1, 0x93, 0x98,
-	0x44, 0x28, 0x27, 0x0e, 0xde, 0x81, 0x4e, 0xf9, 0x60, 0xf8, 0x1a, 0x80, 0x55, 0x30, 0x77, 0x1f,
-	0x68, 0xf5, 0x07, 0x77, 0x34, 0xba, 0x5d, 0x9b, 0xfa, 0xdd, 0xda, 0xd4, 0x7f, 0xad, 0x4d, 0xfd,
-	0xeb, 0xc6, 0xd4, 0xee, 0x36, 0xa6, 0xf6, 0x63, 0x63, 0x6a, 0x1f, 0xad, 0x28, 0xe6, 0xd7, 0xe9,
-	0x95, 0x1d, 0xd2, 0x85, 0xb3, 0xbb, 0xbf, 0xc3, 0x96, 0xc4, 0xf9, 0xec, 0xa8, 0xff, 0xcd, 0xbf,
-	0x2c, 0x31, 0xbb, 0xaa, 0x67, 0x9f, 0xfa, 0xd5, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x05, 0xdf,
-	0x6c, 0x3b, 0x90, 0x04, 0x00, 0x00,
+	// 800 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4f, 0x4f, 0xe3, 0x46,
+	0x14, 0xb7, 0xf3, 0x97, 0x4c, 0x68, 0x14, 0x8d, 0x52, 0x64, 0x28, 0x32, 0x51, 0xa4, 0x8a, 0x48,
+	0x15, 0xb6, 0x48, 0x2f, 0xbd, 0x54, 0xaa, 0x13, 0x5b, 0x40, 0x4b, 0x21, 0x1a, 0x20, 0x87, 0xf6,
+	0x80, 0x1c, 0x67, 0x6a, 0xac, 0x26, 0x63, 0xd7, 0x33, 0x89, 0xe0, 0x1b, 0xf4, 0xd0, 0x43, 0xb5,
+	0x1f, 0x63, 0xcf, 0xfb, 0x11, 0xf6, 0xc0, 0x11, 0xed, 0x69, 0x4f, 0xec, 0x2e, 0x7c, 0x8b, 0x3d,
+	0xad, 0xec, 0x99, 0x84, 0xd8, 0x1b, 0xb2, 0x39, 0xec, 0x29, 0x79, 0xf3, 0x7e, 0xef, 0x37, 0xbf,
+	0xf7, 0x67, 0x9e, 0x41, 0x6d, 0x68, 0x8f, 0x89, 0x73, 0xa5, 0x87, 0xf8, 0x9f, 0x31, 0xa6, 0x4c,
+	0x0b, 0x42, 0x9f, 0xf9, 0xf0, 0x5b, 0x86, 0xc9, 0x00, 0x87, 0x23, 0x8f, 0x30, 0x8d, 0x06, 0x44,
+	0xe3, 0xa0, 0xad, 0x9a, 0xeb, 0xbb, 0x7e, 0x8c, 0xd0, 0xa3, 0x7f, 0x1c, 0xbc, 0xb5, 0xe9, 0xf8,
+	0x74, 0xe4, 0xd3, 0x4b, 0xee, 0xe0, 0x86, 0x70, 0xa9, 0xdc, 0xd2, 0xfb, 0x36, 0xc5, 0xfa, 0x64,
+	0xbf, 0x8f, 0x99, 0xbd, 0xaf, 0x3b, 0xbe, 0x47, 0x84, 0x7f, 0x5b, 0xdc, 0xee, 0x62, 0x82, 0xa9,
+	0x47, 0x2f, 0x6d, 0xc7, 0xf1, 0xc7, 0x84, 0xa5, 0xbc, 0x13, 0x4c, 0x99, 0x47, 0xdc, 0x94, 0x57,
+	0x4d, 0xc5, 0x4e, 0xec, 0xa1, 0x37, 0xb0, 0x99, 0x1f, 0x72, 0x7f, 0xe3, 0x43, 0x06, 0x14, 0x11,
+	0xcf, 0x0a, 0x6e, 0x81, 0x35, 0x8e, 0x3e, 0x32, 0x15, 0xb9, 0x2e, 0x37, 0x73, 0x68, 0x66, 0xc3,
+	0x6d, 0x50, 0x12, 0xc9, 0x1f, 0x99, 0x4a, 0x26, 0x76, 0x3e, 0x1d, 0x40, 0x05, 0x14, 0x9d, 0x10,
+	0x47, 0xb4, 0x4a, 0xb6, 0x2e, 0x37, 0x4b, 0x68, 0x6a, 0x46, 0x71, 0xf1, 0x5f, 0x3c, 0x30, 0x98,
+	0x92, 0xab, 0xcb, 0xcd, 0x2c, 0x7a, 0x3a, 0x80, 0x16, 0x28, 0x3a, 0x3e, 0x61, 0x98, 0x30, 0x25,
+	0x5f, 0x97, 0x9b, 0xe5, 0xd6, 0xf7, 0xda, 0xc2, 0x9a, 0x6a, 0x42, 0x62, 0x87, 0x83, 0xdb, 0xb9,
+	0xdb, 0xfb, 0x1d, 0x09, 0x4d, 0x63, 0xe1, 0xcf, 0xa0, 0x40, 0x99, 0xcd, 0xc6, 0x54, 0x29, 0xd4,
+	0xe5, 0x66, 0xe5, 0x4b, 0x2c, 0xda, 0x59, 0x0c, 0x46, 0x22, 0x28, 0xd2, 0x88, 0xaf, 0x03, 0x2f,
+	0xc4, 0xd4, 0x60, 0x4a, 0x91, 0x6b, 0x9c, 0x1d, 0x34, 0x7e, 0x01, 0x05, 0x8e, 0x87, 0x65, 0x50,
+	0xec, 0x5a, 0x27, 0xe6, 0xd1, 0xc9, 0x41, 0x55, 0x82, 0xeb, 0x60, 0xcd, 0xe8, 0x76, 0xd1, 0x69,
+	0xcf, 0x32, 0xab, 0x72, 0x64, 0x21, 0xeb, 0x57, 0xab, 0x73, 0x6e, 0x99, 0xd5, 0x0c, 0xfc, 0x06,
+	0x94, 0x3a, 0xc6, 0x49, 0xc7, 0x3a, 0x3e, 0xb6, 0xcc, 0x6a, 0xb6, 0xf1, 0x6f, 0x1e, 0x54, 0x92,
+	0x09, 0xc0, 0x53, 0x50, 0x11, 0x1d, 0x31, 0x78, 0xbb, 0x14, 0x79, 0x69, 0xfe, 0x07, 0x09, 0xf0,
+	0xa1, 0x84, 0x52, 0xe1, 0x11, 0xa1, 0x18, 0x80, 0x29, 0x61, 0x66, 0x29, 0x61, 0x2f, 0x01, 0x8e,
+	0x08, 0x93, 0xe1, 0xf0, 0x02, 0x54, 0xc5, 0x15, 0xbd, 0xe9, 0xc8, 0xc4, 0xbd, 0x2d, 0xb7, 0x76,
+	0x97, 0x6b, 0x9c, 0xc1, 0x0f, 0x25, 0xf4, 0x19, 0x45, 0xa4, 0x53, 0x0c, 0x28, 0xc2, 0x23, 0x7f,
+	0x62, 0x0f, 0x95, 0xdc, 0x52, 0x9d, 0x46, 0x02, 0x1c, 0xe9, 0x4c, 0x86, 0x47, 0x3a, 0x67, 0x33,
+	0x3d, 0xa5, 0xcc, 0x2f, 0xd5, 0xd9, 0x4b, 0xc1, 0x23, 0x9d, 0x69, 0x0a, 0x68, 0x83, 0x5a, 0xb2,
+	0xc2, 0x17, 0xc1, 0xc0, 0x66, 0x38, 0x1e, 0xb0, 0x72, 0xeb, 0x87, 0x95, 0xda, 0xc4, 0x43, 0x0e,
+	0x25, 0xb4, 0x90, 0x0a, 0xba, 0x60, 0x23, 0x5d, 0x1e, 0x71, 0x49, 0x31, 0xbe, 0x64, 0x6f, 0xc5,
+	0x3a, 0xcf, 0xae, 0x79, 0x86, 0xae, 0x5d, 0x9a, 0xbd, 0xb2, 0x86, 0x09, 0x2a, 0xc9, 0x8a, 0xc2,
+	0x16, 0x28, 0xda, 0x83, 0x41, 0x88, 0x29, 0x8d, 0x47, 0xb0, 0xd4, 0x56, 0xde, 0xbc, 0xda, 0xab,
+	0x89, 0xfd, 0x64, 0x70, 0xcf, 0x19, 0x0b, 0x3d, 0xe2, 0xa2, 0x29, 0xb0, 0x71, 0x0c, 0xaa, 0xe9,
+	0x22, 0xc2, 0x9f, 0x00, 0x98, 0xd8, 0x43, 0x63, 0x45, 0xaa, 0x39, 0x6c, 0xe3, 0x75, 0x06, 0xd4,
+	0x16, 0x15, 0x6e, 0xe9, 0x3e, 0x9a, 0x93, 0x9d, 0x59, 0x51, 0x36, 0xfc, 0x4f, 0x06, 0xf9, 0x68,
+	0xad, 0x52, 0x25, 0x5b, 0xcf, 0x36, 0xcb, 0xad, 0x4d, 0x4d, 0xe0, 0xa3, 0xc5, 0xab, 0x89, 0xc5,
+	0xab, 0x75, 0x7c, 0x8f, 0xb4, 0xff, 0x8c, 0x16, 0xcc, 0xc7, 0xfb, 0x9d, 0x5d, 0xd7, 0x63, 0x57,
+	0xe3, 0xbe, 0xe6, 0xf8, 0x23, 0xb1, 0xb3, 0xc5, 0xcf, 0x1e, 0x1d, 0xfc, 0xad, 0xb3, 0x9b, 0x00,
+	0xd3, 0x38, 0xe0, 0xe5, 0xbb, 0x9d, 0xe6, 0x8a, 0x50, 0x8a, 0xb8, 0x08, 0xf8, 0xfb, 0xec, 0xc9,
+	0x9e, 0x06, 0xcc, 0xf3, 0x09, 0x55, 0x72, 0xab, 0x3c, 0x59, 0x01, 0x46, 0xa9, 0xe0, 0xc6, 0x8b,
+	0x0c, 0xd8, 0x58, 0x3c, 0x1a, 0x5f, 0xbd, 0x90, 0x3a, 0xc8, 0x05, 0x18, 0x4f, 0xf7, 0xc1, 0x77,
+	0xcf, 0xe8, 0xed, 0x62, 0x1c, 0xa2, 0x18, 0x08, 0x6b, 0x20, 0xef, 0x62, 0x72, 0x7e, 0x1d, 0x67,
+	0xb8, 0x8e, 0xb8, 0x01, 0x55, 0x00, 0x1c, 0x9f, 0xd0, 0xee, 0xb8, 0xff, 0x1b, 0xbe, 0x89, 0x1f,
+	0xed, 0x3a, 0x9a, 0x3b, 0x81, 0x06, 0xa8, 0x50, 0x3c, 0xfc, 0xcb, 0xc4, 0x43, 0xec, 0xda, 0x51,
+	0x92, 0xe2, 0xf5, 0x3d, 0xdf, 0x37, 0x94, 0x0a, 0x68, 0xb7, 0x6f, 0x1f, 0x54, 0xf9, 0xee, 0x41,
+	0x95, 0xdf, 0x3f, 0xa8, 0xf2, 0xff, 0x8f, 0xaa, 0x74, 0xf7, 0xa8, 0x4a, 0x6f, 0x1f, 0x55, 0xe9,
+	0x8f, 0xf9, 0x76, 0x3d, 0xe9, 0xd7, 0x69, 0x40, 0xf4, 0x6b, 0x5d, 0x7c, 0x34, 0xe3, 0xa6, 0xf5,
+	0x0b, 0xf1, 0x97, 0xf2, 0xc7, 0x4f, 0x03, 0x00, 0x40, 0x39, 0xb7, 0x71, 0x05, 0x08, 0x00, 0x00,
 }
 
 func (m *Request) Marshal() (dAtA []byte, err error) {
@@ -416,6 +630,11 @@ func (m *Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExpiresAt != 0 {
+		i = encodeVarintRequest(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.Status != 0 {
 		i = encodeVarintRequest(dAtA, i, uint64(m.Status))
 		i--
@@ -593,6 +812,48 @@ func (m *RequestContent_ValidatorRemoval) MarshalToSizedBuffer(dAtA []byte) (int
 	}
 	return len(dAtA) - i, nil
 }
+func (m *RequestContent_GenesisAccountUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestContent_GenesisAccountUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.GenesisAccountUpdate != nil {
+		{
+			size, err := m.GenesisAccountUpdate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequest(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	return len(dAtA) - i, nil
+}
+func (m *RequestContent_GenesisValidatorUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestContent_GenesisValidatorUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.GenesisValidatorUpdate != nil {
+		{
+			size, err := m.GenesisValidatorUpdate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequest(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *AccountRemoval) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -653,6 +914,140 @@ func (m *ValidatorRemoval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GenesisAccountUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisAccountUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisAccountUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VestingOptions != nil {
+		{
+			size, err := m.VestingOptions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequest(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequest(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintRequest(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.LaunchID != 0 {
+		i = encodeVarintRequest(dAtA, i, uint64(m.LaunchID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisValidatorUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisValidatorUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisValidatorUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SelfDelegation != nil {
+		{
+			size, err := m.SelfDelegation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequest(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ConsPubKey) > 0 {
+		i -= len(m.ConsPubKey)
+		copy(dAtA[i:], m.ConsPubKey)
+		i = encodeVarintRequest(dAtA, i, uint64(len(m.ConsPubKey)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.GenTx) > 0 {
+		i -= len(m.GenTx)
+		copy(dAtA[i:], m.GenTx)
+		i = encodeVarintRequest(dAtA, i, uint64(len(m.GenTx)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Peer != nil {
+		{
+			size, err := m.Peer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequest(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintRequest(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.LaunchID != 0 {
+		i = encodeVarintRequest(dAtA, i, uint64(m.LaunchID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintRequest(dAtA []byte, offset int, v uint64) int {
 	offset -= sovRequest(v)
 	base := offset
@@ -688,6 +1083,9 @@ func (m *Request) Size() (n int) {
 	if m.Status != 0 {
 		n += 1 + sovRequest(uint64(m.Status))
 	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovRequest(uint64(m.ExpiresAt))
+	}
 	return n
 }
 
@@ -763,27 +1161,109 @@ func (m *RequestContent_ValidatorRemoval) Size() (n int) {
 	}
 	return n
 }
-func (m *AccountRemoval) Size() (n int) {
+func (m *RequestContent_GenesisAccountUpdate) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
+	if m.GenesisAccountUpdate != nil {
+		l = m.GenesisAccountUpdate.Size()
 		n += 1 + l + sovRequest(uint64(l))
 	}
 	return n
 }
-
-func (m *ValidatorRemoval) Size() (n int) {
+func (m *RequestContent_GenesisValidatorUpdate) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ValAddress)
-	if l > 0 {
+	if m.GenesisValidatorUpdate != nil {
+		l = m.GenesisValidatorUpdate.Size()
+		n += 1 + l + sovRequest(uint64(l))
+	}
+	return n
+}
+func (m *AccountRemoval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovRequest(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorRemoval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValAddress)
+	if l > 0 {
+		n += 1 + l + sovRequest(uint64(l))
+	}
+	return n
+}
+
+func (m *GenesisAccountUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LaunchID != 0 {
+		n += 1 + sovRequest(uint64(m.LaunchID))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovRequest(uint64(l))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovRequest(uint64(l))
+		}
+	}
+	if m.VestingOptions != nil {
+		l = m.VestingOptions.Size()
+		n += 1 + l + sovRequest(uint64(l))
+	}
+	return n
+}
+
+func (m *GenesisValidatorUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LaunchID != 0 {
+		n += 1 + sovRequest(uint64(m.LaunchID))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovRequest(uint64(l))
+	}
+	if m.Peer != nil {
+		l = m.Peer.Size()
+		n += 1 + l + sovRequest(uint64(l))
+	}
+	l = len(m.GenTx)
+	if l > 0 {
+		n += 1 + l + sovRequest(uint64(l))
+	}
+	l = len(m.ConsPubKey)
+	if l > 0 {
+		n += 1 + l + sovRequest(uint64(l))
+	}
+	if m.SelfDelegation != nil {
+		l = m.SelfDelegation.Size()
 		n += 1 + l + sovRequest(uint64(l))
 	}
 	return n
@@ -965,6 +1445,25 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequest(dAtA[iNdEx:])
@@ -1190,6 +1689,76 @@ func (m *RequestContent) Unmarshal(dAtA []byte) error {
 			}
 			m.Content = &RequestContent_ValidatorRemoval{v}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisAccountUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &GenesisAccountUpdate{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Content = &RequestContent_GenesisAccountUpdate{v}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisValidatorUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &GenesisValidatorUpdate{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Content = &RequestContent_GenesisValidatorUpdate{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequest(dAtA[iNdEx:])
@@ -1375,6 +1944,418 @@ func (m *ValidatorRemoval) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GenesisAccountUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequest
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisAccountUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisAccountUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LaunchID", wireType)
+			}
+			m.LaunchID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LaunchID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequest
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, github_com_cosmos_cosmos_sdk_types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VestingOptions == nil {
+				m.VestingOptions = &VestingOptions{}
+			}
+			if err := m.VestingOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequest(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRequest
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisValidatorUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequest
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisValidatorUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisValidatorUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LaunchID", wireType)
+			}
+			m.LaunchID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LaunchID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequest
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Peer == nil {
+				m.Peer = &Peer{}
+			}
+			if err := m.Peer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenTx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRequest
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GenTx = append(m.GenTx[:0], dAtA[iNdEx:postIndex]...)
+			if m.GenTx == nil {
+				m.GenTx = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsPubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRequest
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsPubKey = append(m.ConsPubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.ConsPubKey == nil {
+				m.ConsPubKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfDelegation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SelfDelegation == nil {
+				m.SelfDelegation = &types.Coin{}
+			}
+			if err := m.SelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequest(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRequest
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipRequest(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -8,6 +8,60 @@ import (
 	sdkerrortypes "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
+const (
+	RequestContentTypeGenesisAccount   = "genesisAccount"
+	RequestContentTypeVestingAccount   = "vestingAccount"
+	RequestContentTypeGenesisValidator = "genesisValidator"
+	RequestContentTypeAccountRemoval   = "accountRemoval"
+	RequestContentTypeValidatorRemoval = "validatorRemoval"
+
+	RequestContentTypeGenesisAccountUpdate   = "genesisAccountUpdate"
+	RequestContentTypeGenesisValidatorUpdate = "genesisValidatorUpdate"
+)
+
+// RequestContentTypes lists the types of request content
+var RequestContentTypes = []string{
+	RequestContentTypeGenesisAccount,
+	RequestContentTypeVestingAccount,
+	RequestContentTypeGenesisValidator,
+	RequestContentTypeAccountRemoval,
+	RequestContentTypeValidatorRemoval,
+	RequestContentTypeGenesisAccountUpdate,
+	RequestContentTypeGenesisValidatorUpdate,
+}
+
+// IsValidRequestContentType checks if the provided type is a type of request content
+func IsValidRequestContentType(contentType string) bool {
+	for _, t := range RequestContentTypes {
+		if t == contentType {
+			return true
+		}
+	}
+	return false
+}
+
+// Type returns the type of the request content, an empty string if the content is not recognized
+func (m RequestContent) Type() string {
+	switch m.Content.(type) {
+	case *RequestContent_GenesisAccount:
+		return RequestContentTypeGenesisAccount
+	case *RequestContent_VestingAccount:
+		return RequestContentTypeVestingAccount
+	case *RequestContent_GenesisValidator:
+		return RequestContentTypeGenesisValidator
+	case *RequestContent_AccountRemoval:
+		return RequestContentTypeAccountRemoval
+	case *RequestContent_ValidatorRemoval:
+		return RequestContentTypeValidatorRemoval
+	case *RequestContent_GenesisAccountUpdate:
+		return RequestContentTypeGenesisAccountUpdate
+	case *RequestContent_GenesisValidatorUpdate:
+		return RequestContentTypeGenesisValidatorUpdate
+	default:
+		return ""
+	}
+}
+
 func (m RequestContent) Validate() error {
 	switch requestContent := m.Content.(type) {
 	case *RequestContent_GenesisAccount:
@@ -20,6 +74,10 @@ func (m RequestContent) Validate() error {
 		return requestContent.AccountRemoval.Validate()
 	case *RequestContent_ValidatorRemoval:
 		return requestContent.ValidatorRemoval.Validate()
+	case *RequestContent_GenesisAccountUpdate:
+		return requestContent.GenesisAccountUpdate.Validate()
+	case *RequestContent_GenesisValidatorUpdate:
+		return requestContent.GenesisValidatorUpdate.Validate()
 	default:
 		return errors.New("unrecognized request content")
 	}
@@ -168,3 +226,139 @@ func (m ValidatorRemoval) Validate() error {
 	}
 	return nil
 }
+
+// NewGenesisAccountUpdate returns a RequestContent containing a GenesisAccountUpdate
+// either coins or vestingOptions must be set
+func NewGenesisAccountUpdate(
+	launchID uint64,
+	address string,
+	coins sdk.Coins,
+	vestingOptions *VestingOptions,
+) RequestContent {
+	return RequestContent{
+		Content: &RequestContent_GenesisAccountUpdate{
+			GenesisAccountUpdate: &GenesisAccountUpdate{
+				LaunchID:       launchID,
+				Address:        address,
+				Coins:          coins,
+				VestingOptions: vestingOptions,
+			},
+		},
+	}
+}
+
+// Validate implements GenesisAccountUpdate validation
+func (m GenesisAccountUpdate) Validate() error {
+	_, err := sdk.AccAddressFromBech32(m.Address)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrortypes.ErrInvalidAddress, "invalid account address (%s)", err)
+	}
+
+	switch {
+	case m.VestingOptions == nil:
+		if !m.Coins.IsValid() || m.Coins.Empty() {
+			return sdkerrors.Wrap(ErrInvalidCoins, m.Address)
+		}
+	case !m.Coins.Empty():
+		return sdkerrors.Wrapf(ErrInvalidAccountUpdate, "both coins and vesting options set for %s", m.Address)
+	default:
+		if err := m.VestingOptions.Validate(); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidVestingOption, err.Error())
+		}
+	}
+	return nil
+}
+
+// NewGenesisValidatorPeerUpdate returns a RequestContent containing a GenesisValidatorUpdate of the peer
+func NewGenesisValidatorPeerUpdate(launchID uint64, address string, peer Peer) RequestContent {
+	return RequestContent{
+		Content: &RequestContent_GenesisValidatorUpdate{
+			GenesisValidatorUpdate: &GenesisValidatorUpdate{
+				LaunchID: launchID,
+				Address:  address,
+				Peer:     &peer,
+			},
+		},
+	}
+}
+
+// NewGenesisValidatorGentxUpdate returns a RequestContent containing a GenesisValidatorUpdate of the gentx
+func NewGenesisValidatorGentxUpdate(
+	launchID uint64,
+	address string,
+	genTx,
+	consPubKey []byte,
+	selfDelegation sdk.Coin,
+) RequestContent {
+	return RequestContent{
+		Content: &RequestContent_GenesisValidatorUpdate{
+			GenesisValidatorUpdate: &GenesisValidatorUpdate{
+				LaunchID:       launchID,
+				Address:        address,
+				GenTx:          genTx,
+				ConsPubKey:     consPubKey,
+				SelfDelegation: &selfDelegation,
+			},
+		},
+	}
+}
+
+// IsPeerUpdate returns true if the update only changes the peer of the validator
+func (m GenesisValidatorUpdate) IsPeerUpdate() bool {
+	return m.Peer != nil && !m.IsGentxUpdate()
+}
+
+// IsGentxUpdate returns true if the update changes the gentx of the validator
+func (m GenesisValidatorUpdate) IsGentxUpdate() bool {
+	return len(m.GenTx) > 0 || len(m.ConsPubKey) > 0 || m.SelfDelegation != nil
+}
+
+// Apply returns the genesis validator with the update applied
+func (m GenesisValidatorUpdate) Apply(validator GenesisValidator) GenesisValidator {
+	if m.Peer != nil {
+		validator.Peer = *m.Peer
+	}
+	if m.IsGentxUpdate() {
+		validator.GenTx = m.GenTx
+		validator.ConsPubKey = m.ConsPubKey
+		validator.SelfDelegation = *m.SelfDelegation
+	}
+	return validator
+}
+
+// Validate implements GenesisValidatorUpdate validation
+func (m GenesisValidatorUpdate) Validate() error {
+	_, err := sdk.AccAddressFromBech32(m.Address)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrortypes.ErrInvalidAddress, "invalid validator address (%s)", err)
+	}
+
+	if m.Peer == nil && !m.IsGentxUpdate() {
+		return sdkerrors.Wrapf(ErrInvalidValidatorUpdate, "no update for validator %s", m.Address)
+	}
+
+	if m.Peer != nil {
+		if err := m.Peer.Validate(); err != nil {
+			return sdkerrors.Wrap(ErrInvalidPeer, err.Error())
+		}
+	}
+
+	if m.IsGentxUpdate() {
+		if len(m.GenTx) == 0 {
+			return sdkerrors.Wrap(ErrInvalidGenTx, "empty gentx")
+		}
+
+		if len(m.ConsPubKey) == 0 {
+			return sdkerrors.Wrap(ErrInvalidConsPubKey, "empty consensus public key")
+		}
+
+		if m.SelfDelegation == nil || !m.SelfDelegation.IsValid() {
+			return sdkerrors.Wrap(ErrInvalidSelfDelegation, "")
+		}
+
+		if m.SelfDelegation.IsZero() {
+			return sdkerrors.Wrap(ErrInvalidSelfDelegation, "self delegation is zero")
+		}
+	}
+	return nil
+}
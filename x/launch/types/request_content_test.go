@@ -56,6 +56,16 @@ func TestRequestContent_Validate(t *testing.T) {
 		require.NoError(t, requestContent.Validate())
 	})
 
+	t.Run("should validate request with valid genesis account update", func(t *testing.T) {
+		requestContent := types.NewGenesisAccountUpdate(launchID, address, coins, nil)
+		require.NoError(t, requestContent.Validate())
+	})
+
+	t.Run("should validate request with valid genesis validator update", func(t *testing.T) {
+		requestContent := types.NewGenesisValidatorPeerUpdate(launchID, address, peer)
+		require.NoError(t, requestContent.Validate())
+	})
+
 	t.Run("should prevent validate request with unrecognized content", func(t *testing.T) {
 		// request with no content
 		requestContent := types.RequestContent{}
@@ -63,6 +73,19 @@ func TestRequestContent_Validate(t *testing.T) {
 	})
 }
 
+func TestRequestContent_Type(t *testing.T) {
+	launchID := uint64(0)
+	contents := sample.AllRequestContents(r, launchID, sample.Address(r), sample.Address(r), sample.Address(r))
+	contentTypes := make([]string, len(contents))
+	for i, content := range contents {
+		contentTypes[i] = content.Type()
+		require.True(t, types.IsValidRequestContentType(contentTypes[i]))
+	}
+	require.Subset(t, contentTypes, types.RequestContentTypes)
+	require.Empty(t, types.RequestContent{}.Type())
+	require.False(t, types.IsValidRequestContentType("invalid"))
+}
+
 func TestNewGenesisAccount(t *testing.T) {
 	launchID := uint64(0)
 	address := sample.Address(r)
@@ -461,3 +484,272 @@ func TestVestingAccount_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestNewGenesisAccountUpdate(t *testing.T) {
+	launchID := uint64(0)
+	address := sample.Address(r)
+	coins := sample.Coins(r)
+	vestingOptions := sample.VestingOptions(r)
+
+	t.Run("should create a new genesis account update", func(t *testing.T) {
+		requestContent := types.NewGenesisAccountUpdate(launchID, address, coins, &vestingOptions)
+
+		accountUpdate := requestContent.GetGenesisAccountUpdate()
+		require.NotNil(t, accountUpdate)
+		require.EqualValues(t, launchID, accountUpdate.LaunchID)
+		require.EqualValues(t, address, accountUpdate.Address)
+		require.True(t, coins.IsEqual(accountUpdate.Coins))
+		require.Equal(t, &vestingOptions, accountUpdate.VestingOptions)
+
+		require.Nil(t, requestContent.GetGenesisAccount())
+		require.Nil(t, requestContent.GetVestingAccount())
+		require.Nil(t, requestContent.GetAccountRemoval())
+	})
+}
+
+func TestGenesisAccountUpdate_Validate(t *testing.T) {
+	vestingOptions := sample.VestingOptions(r)
+	invalidVestingOptions := types.VestingOptions{}
+
+	tests := []struct {
+		name          string
+		accountUpdate types.GenesisAccountUpdate
+		valid         bool
+	}{
+		{
+			name: "should validate account update with coins",
+			accountUpdate: types.GenesisAccountUpdate{
+				Address: sample.Address(r),
+				Coins:   sample.Coins(r),
+			},
+			valid: true,
+		},
+		{
+			name: "should validate account update with vesting options",
+			accountUpdate: types.GenesisAccountUpdate{
+				Address:        sample.Address(r),
+				VestingOptions: &vestingOptions,
+			},
+			valid: true,
+		},
+		{
+			name: "should prevent validate account update with invalid address",
+			accountUpdate: types.GenesisAccountUpdate{
+				Address: "invalid_address",
+				Coins:   sample.Coins(r),
+			},
+			valid: false,
+		},
+		{
+			name: "should prevent validate account update with no coins and no vesting options",
+			accountUpdate: types.GenesisAccountUpdate{
+				Address: sample.Address(r),
+			},
+			valid: false,
+		},
+		{
+			name: "should prevent validate account update with both coins and vesting options",
+			accountUpdate: types.GenesisAccountUpdate{
+				Address:        sample.Address(r),
+				Coins:          sample.Coins(r),
+				VestingOptions: &vestingOptions,
+			},
+			valid: false,
+		},
+		{
+			name: "should prevent validate account update with invalid vesting options",
+			accountUpdate: types.GenesisAccountUpdate{
+				Address:        sample.Address(r),
+				VestingOptions: &invalidVestingOptions,
+			},
+			valid: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.accountUpdate.Validate()
+			if tt.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestNewGenesisValidatorUpdate(t *testing.T) {
+	launchID := uint64(0)
+	address := sample.Address(r)
+	gentTx := sample.Bytes(r, 300)
+	consPubKey := sample.Bytes(r, 30)
+	selfDelegation := sample.Coin(r)
+	peer := sample.GenesisValidatorPeer(r)
+
+	t.Run("should create a new genesis validator peer update", func(t *testing.T) {
+		requestContent := types.NewGenesisValidatorPeerUpdate(launchID, address, peer)
+
+		validatorUpdate := requestContent.GetGenesisValidatorUpdate()
+		require.NotNil(t, validatorUpdate)
+		require.EqualValues(t, launchID, validatorUpdate.LaunchID)
+		require.EqualValues(t, address, validatorUpdate.Address)
+		require.Equal(t, &peer, validatorUpdate.Peer)
+		require.True(t, validatorUpdate.IsPeerUpdate())
+		require.False(t, validatorUpdate.IsGentxUpdate())
+	})
+
+	t.Run("should create a new genesis validator gentx update", func(t *testing.T) {
+		requestContent := types.NewGenesisValidatorGentxUpdate(launchID, address, gentTx, consPubKey, selfDelegation)
+
+		validatorUpdate := requestContent.GetGenesisValidatorUpdate()
+		require.NotNil(t, validatorUpdate)
+		require.EqualValues(t, launchID, validatorUpdate.LaunchID)
+		require.EqualValues(t, address, validatorUpdate.Address)
+		require.Equal(t, gentTx, validatorUpdate.GenTx)
+		require.Equal(t, consPubKey, validatorUpdate.ConsPubKey)
+		require.Equal(t, &selfDelegation, validatorUpdate.SelfDelegation)
+		require.Nil(t, validatorUpdate.Peer)
+		require.False(t, validatorUpdate.IsPeerUpdate())
+		require.True(t, validatorUpdate.IsGentxUpdate())
+	})
+}
+
+func TestGenesisValidatorUpdate_Apply(t *testing.T) {
+	validator := sample.GenesisValidator(r, 0, sample.Address(r))
+
+	t.Run("should update the peer of the validator", func(t *testing.T) {
+		peer := sample.GenesisValidatorPeer(r)
+		content := types.NewGenesisValidatorPeerUpdate(0, validator.Address, peer)
+		update := content.GetGenesisValidatorUpdate()
+
+		want := validator
+		want.Peer = peer
+		require.Equal(t, want, update.Apply(validator))
+	})
+
+	t.Run("should update the gentx of the validator", func(t *testing.T) {
+		genTx, consPubKey, selfDelegation := sample.Bytes(r, 300), sample.Bytes(r, 30), sample.Coin(r)
+		content := types.NewGenesisValidatorGentxUpdate(0, validator.Address, genTx, consPubKey, selfDelegation)
+		update := content.GetGenesisValidatorUpdate()
+
+		want := validator
+		want.GenTx = genTx
+		want.ConsPubKey = consPubKey
+		want.SelfDelegation = selfDelegation
+		require.Equal(t, want, update.Apply(validator))
+	})
+}
+
+func TestGenesisValidatorUpdate_Validate(t *testing.T) {
+	var (
+		peer           = sample.GenesisValidatorPeer(r)
+		invalidPeer    = types.Peer{}
+		selfDelegation = sample.Coin(r)
+		zeroDelegation = sdk.NewCoin("stake", sdkmath.ZeroInt())
+	)
+
+	tests := []struct {
+		name            string
+		validatorUpdate types.GenesisValidatorUpdate
+		valid           bool
+	}{
+		{
+			name: "should validate peer update",
+			validatorUpdate: types.GenesisValidatorUpdate{
+				Address: sample.Address(r),
+				Peer:    &peer,
+			},
+			valid: true,
+		},
+		{
+			name: "should validate gentx update",
+			validatorUpdate: types.GenesisValidatorUpdate{
+				Address:        sample.Address(r),
+				GenTx:          sample.Bytes(r, 300),
+				ConsPubKey:     sample.Bytes(r, 30),
+				SelfDelegation: &selfDelegation,
+			},
+			valid: true,
+		},
+		{
+			name: "should validate peer and gentx update",
+			validatorUpdate: types.GenesisValidatorUpdate{
+				Address:        sample.Address(r),
+				Peer:           &peer,
+				GenTx:          sample.Bytes(r, 300),
+				ConsPubKey:     sample.Bytes(r, 30),
+				SelfDelegation: &selfDelegation,
+			},
+			valid: true,
+		},
+		{
+			name: "should prevent validate update with invalid address",
+			validatorUpdate: types.GenesisValidatorUpdate{
+				Address: "invalid_address",
+				Peer:    &peer,
+			},
+			valid: false,
+		},
+		{
+			name: "should prevent validate empty update",
+			validatorUpdate: types.GenesisValidatorUpdate{
+				Address: sample.Address(r),
+			},
+			valid: false,
+		},
+		{
+			name: "should prevent validate update with invalid peer",
+			validatorUpdate: types.GenesisValidatorUpdate{
+				Address: sample.Address(r),
+				Peer:    &invalidPeer,
+			},
+			valid: false,
+		},
+		{
+			name: "should prevent validate gentx update with no gentx",
+			validatorUpdate: types.GenesisValidatorUpdate{
+				Address:        sample.Address(r),
+				ConsPubKey:     sample.Bytes(r, 30),
+				SelfDelegation: &selfDelegation,
+			},
+			valid: false,
+		},
+		{
+			name: "should prevent validate gentx update with no consensus public key",
+			validatorUpdate: types.GenesisValidatorUpdate{
+				Address:        sample.Address(r),
+				GenTx:          sample.Bytes(r, 300),
+				SelfDelegation: &selfDelegation,
+			},
+			valid: false,
+		},
+		{
+			name: "should prevent validate gentx update with no self delegation",
+			validatorUpdate: types.GenesisValidatorUpdate{
+				Address:    sample.Address(r),
+				GenTx:      sample.Bytes(r, 300),
+				ConsPubKey: sample.Bytes(r, 30),
+			},
+			valid: false,
+		},
+		{
+			name: "should prevent validate gentx update with zero self delegation",
+			validatorUpdate: types.GenesisValidatorUpdate{
+				Address:        sample.Address(r),
+				GenTx:          sample.Bytes(r, 300),
+				ConsPubKey:     sample.Bytes(r, 30),
+				SelfDelegation: &zeroDelegation,
+			},
+			valid: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.validatorUpdate.Validate()
+			if tt.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
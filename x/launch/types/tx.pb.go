@@ -779,6 +779,134 @@ func (m *MsgRequestRemoveAccountResponse) GetAutoApproved() bool {
 	return false
 }
 
+type MsgRequestUpdateAccount struct {
+	Creator        string                                   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	LaunchID       uint64                                   `protobuf:"varint,2,opt,name=launchID,proto3" json:"launchID,omitempty"`
+	Address        string                                   `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Coins          github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=coins,proto3,casttype=github.com/cosmos/cosmos-sdk/types.Coin,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+	VestingOptions *VestingOptions                          `protobuf:"bytes,5,opt,name=vestingOptions,proto3" json:"vestingOptions,omitempty"`
+}
+
+func (m *MsgRequestUpdateAccount) Reset()         { *m = MsgRequestUpdateAccount{} }
+func (m *MsgRequestUpdateAccount) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestUpdateAccount) ProtoMessage()    {}
+func (*MsgRequestUpdateAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6adab5ffa522f022, []int{12}
+}
+func (m *MsgRequestUpdateAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRequestUpdateAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRequestUpdateAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRequestUpdateAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestUpdateAccount.Merge(m, src)
+}
+func (m *MsgRequestUpdateAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRequestUpdateAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestUpdateAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRequestUpdateAccount proto.InternalMessageInfo
+
+func (m *MsgRequestUpdateAccount) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgRequestUpdateAccount) GetLaunchID() uint64 {
+	if m != nil {
+		return m.LaunchID
+	}
+	return 0
+}
+
+func (m *MsgRequestUpdateAccount) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgRequestUpdateAccount) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
+
+func (m *MsgRequestUpdateAccount) GetVestingOptions() *VestingOptions {
+	if m != nil {
+		return m.VestingOptions
+	}
+	return nil
+}
+
+type MsgRequestUpdateAccountResponse struct {
+	RequestID    uint64 `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	AutoApproved bool   `protobuf:"varint,2,opt,name=autoApproved,proto3" json:"autoApproved,omitempty"`
+}
+
+func (m *MsgRequestUpdateAccountResponse) Reset()         { *m = MsgRequestUpdateAccountResponse{} }
+func (m *MsgRequestUpdateAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestUpdateAccountResponse) ProtoMessage()    {}
+func (*MsgRequestUpdateAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6adab5ffa522f022, []int{13}
+}
+func (m *MsgRequestUpdateAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRequestUpdateAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRequestUpdateAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRequestUpdateAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestUpdateAccountResponse.Merge(m, src)
+}
+func (m *MsgRequestUpdateAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRequestUpdateAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestUpdateAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRequestUpdateAccountResponse proto.InternalMessageInfo
+
+func (m *MsgRequestUpdateAccountResponse) GetRequestID() uint64 {
+	if m != nil {
+		return m.RequestID
+	}
+	return 0
+}
+
+func (m *MsgRequestUpdateAccountResponse) GetAutoApproved() bool {
+	if m != nil {
+		return m.AutoApproved
+	}
+	return false
+}
+
 type MsgRequestAddValidator struct {
 	Creator        string     `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	LaunchID       uint64     `protobuf:"varint,2,opt,name=launchID,proto3" json:"launchID,omitempty"`
@@ -793,7 +921,7 @@ func (m *MsgRequestAddValidator) Reset()         { *m = MsgRequestAddValidator{}
 func (m *MsgRequestAddValidator) String() string { return proto.CompactTextString(m) }
 func (*MsgRequestAddValidator) ProtoMessage()    {}
 func (*MsgRequestAddValidator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6adab5ffa522f022, []int{12}
+	return fileDescriptor_6adab5ffa522f022, []int{14}
 }
 func (m *MsgRequestAddValidator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -880,7 +1008,7 @@ func (m *MsgRequestAddValidatorResponse) Reset()         { *m = MsgRequestAddVal
 func (m *MsgRequestAddValidatorResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRequestAddValidatorResponse) ProtoMessage()    {}
 func (*MsgRequestAddValidatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6adab5ffa522f022, []int{13}
+	return fileDescriptor_6adab5ffa522f022, []int{15}
 }
 func (m *MsgRequestAddValidatorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -933,7 +1061,7 @@ func (m *MsgRequestRemoveValidator) Reset()         { *m = MsgRequestRemoveValid
 func (m *MsgRequestRemoveValidator) String() string { return proto.CompactTextString(m) }
 func (*MsgRequestRemoveValidator) ProtoMessage()    {}
 func (*MsgRequestRemoveValidator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6adab5ffa522f022, []int{14}
+	return fileDescriptor_6adab5ffa522f022, []int{16}
 }
 func (m *MsgRequestRemoveValidator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -992,7 +1120,7 @@ func (m *MsgRequestRemoveValidatorResponse) Reset()         { *m = MsgRequestRem
 func (m *MsgRequestRemoveValidatorResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRequestRemoveValidatorResponse) ProtoMessage()    {}
 func (*MsgRequestRemoveValidatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6adab5ffa522f022, []int{15}
+	return fileDescriptor_6adab5ffa522f022, []int{17}
 }
 func (m *MsgRequestRemoveValidatorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1035,25 +1163,25 @@ func (m *MsgRequestRemoveValidatorResponse) GetAutoApproved() bool {
 	return false
 }
 
-type MsgSettleRequest struct {
-	Signer    string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
-	LaunchID  uint64 `protobuf:"varint,2,opt,name=launchID,proto3" json:"launchID,omitempty"`
-	RequestID uint64 `protobuf:"varint,3,opt,name=requestID,proto3" json:"requestID,omitempty"`
-	Approve   bool   `protobuf:"varint,4,opt,name=approve,proto3" json:"approve,omitempty"`
+type MsgRequestUpdateValidatorPeer struct {
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	LaunchID   uint64 `protobuf:"varint,2,opt,name=launchID,proto3" json:"launchID,omitempty"`
+	ValAddress string `protobuf:"bytes,3,opt,name=valAddress,proto3" json:"valAddress,omitempty"`
+	Peer       Peer   `protobuf:"bytes,4,opt,name=peer,proto3" json:"peer"`
 }
 
-func (m *MsgSettleRequest) Reset()         { *m = MsgSettleRequest{} }
-func (m *MsgSettleRequest) String() string { return proto.CompactTextString(m) }
-func (*MsgSettleRequest) ProtoMessage()    {}
-func (*MsgSettleRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6adab5ffa522f022, []int{16}
+func (m *MsgRequestUpdateValidatorPeer) Reset()         { *m = MsgRequestUpdateValidatorPeer{} }
+func (m *MsgRequestUpdateValidatorPeer) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestUpdateValidatorPeer) ProtoMessage()    {}
+func (*MsgRequestUpdateValidatorPeer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6adab5ffa522f022, []int{18}
 }
-func (m *MsgSettleRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgRequestUpdateValidatorPeer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSettleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRequestUpdateValidatorPeer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSettleRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRequestUpdateValidatorPeer.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1063,61 +1191,63 @@ func (m *MsgSettleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *MsgSettleRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSettleRequest.Merge(m, src)
+func (m *MsgRequestUpdateValidatorPeer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestUpdateValidatorPeer.Merge(m, src)
 }
-func (m *MsgSettleRequest) XXX_Size() int {
+func (m *MsgRequestUpdateValidatorPeer) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSettleRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSettleRequest.DiscardUnknown(m)
+func (m *MsgRequestUpdateValidatorPeer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestUpdateValidatorPeer.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSettleRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgRequestUpdateValidatorPeer proto.InternalMessageInfo
 
-func (m *MsgSettleRequest) GetSigner() string {
+func (m *MsgRequestUpdateValidatorPeer) GetCreator() string {
 	if m != nil {
-		return m.Signer
+		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgSettleRequest) GetLaunchID() uint64 {
+func (m *MsgRequestUpdateValidatorPeer) GetLaunchID() uint64 {
 	if m != nil {
 		return m.LaunchID
 	}
 	return 0
 }
 
-func (m *MsgSettleRequest) GetRequestID() uint64 {
+func (m *MsgRequestUpdateValidatorPeer) GetValAddress() string {
 	if m != nil {
-		return m.RequestID
+		return m.ValAddress
 	}
-	return 0
+	return ""
 }
 
-func (m *MsgSettleRequest) GetApprove() bool {
+func (m *MsgRequestUpdateValidatorPeer) GetPeer() Peer {
 	if m != nil {
-		return m.Approve
+		return m.Peer
 	}
-	return false
+	return Peer{}
 }
 
-type MsgSettleRequestResponse struct {
+type MsgRequestUpdateValidatorPeerResponse struct {
+	RequestID    uint64 `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	AutoApproved bool   `protobuf:"varint,2,opt,name=autoApproved,proto3" json:"autoApproved,omitempty"`
 }
 
-func (m *MsgSettleRequestResponse) Reset()         { *m = MsgSettleRequestResponse{} }
-func (m *MsgSettleRequestResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgSettleRequestResponse) ProtoMessage()    {}
-func (*MsgSettleRequestResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6adab5ffa522f022, []int{17}
+func (m *MsgRequestUpdateValidatorPeerResponse) Reset()         { *m = MsgRequestUpdateValidatorPeerResponse{} }
+func (m *MsgRequestUpdateValidatorPeerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestUpdateValidatorPeerResponse) ProtoMessage()    {}
+func (*MsgRequestUpdateValidatorPeerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6adab5ffa522f022, []int{19}
 }
-func (m *MsgSettleRequestResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgRequestUpdateValidatorPeerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSettleRequestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRequestUpdateValidatorPeerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSettleRequestResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRequestUpdateValidatorPeerResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1127,36 +1257,53 @@ func (m *MsgSettleRequestResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *MsgSettleRequestResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSettleRequestResponse.Merge(m, src)
+func (m *MsgRequestUpdateValidatorPeerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestUpdateValidatorPeerResponse.Merge(m, src)
 }
-func (m *MsgSettleRequestResponse) XXX_Size() int {
+func (m *MsgRequestUpdateValidatorPeerResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSettleRequestResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSettleRequestResponse.DiscardUnknown(m)
+func (m *MsgRequestUpdateValidatorPeerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestUpdateValidatorPeerResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSettleRequestResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgRequestUpdateValidatorPeerResponse proto.InternalMessageInfo
 
-type MsgTriggerLaunch struct {
-	Coordinator string    `protobuf:"bytes,1,opt,name=coordinator,proto3" json:"coordinator,omitempty"`
-	LaunchID    uint64    `protobuf:"varint,2,opt,name=launchID,proto3" json:"launchID,omitempty"`
-	LaunchTime  time.Time `protobuf:"bytes,3,opt,name=launchTime,proto3,stdtime" json:"launchTime"`
+func (m *MsgRequestUpdateValidatorPeerResponse) GetRequestID() uint64 {
+	if m != nil {
+		return m.RequestID
+	}
+	return 0
 }
 
-func (m *MsgTriggerLaunch) Reset()         { *m = MsgTriggerLaunch{} }
-func (m *MsgTriggerLaunch) String() string { return proto.CompactTextString(m) }
-func (*MsgTriggerLaunch) ProtoMessage()    {}
-func (*MsgTriggerLaunch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6adab5ffa522f022, []int{18}
+func (m *MsgRequestUpdateValidatorPeerResponse) GetAutoApproved() bool {
+	if m != nil {
+		return m.AutoApproved
+	}
+	return false
 }
-func (m *MsgTriggerLaunch) XXX_Unmarshal(b []byte) error {
+
+type MsgRequestUpdateValidatorGentx struct {
+	Creator        string     `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	LaunchID       uint64     `protobuf:"varint,2,opt,name=launchID,proto3" json:"launchID,omitempty"`
+	ValAddress     string     `protobuf:"bytes,3,opt,name=valAddress,proto3" json:"valAddress,omitempty"`
+	GenTx          []byte     `protobuf:"bytes,4,opt,name=genTx,proto3" json:"genTx,omitempty"`
+	ConsPubKey     []byte     `protobuf:"bytes,5,opt,name=consPubKey,proto3" json:"consPubKey,omitempty"`
+	SelfDelegation types.Coin `protobuf:"bytes,6,opt,name=selfDelegation,proto3" json:"selfDelegation"`
+}
+
+func (m *MsgRequestUpdateValidatorGentx) Reset()         { *m = MsgRequestUpdateValidatorGentx{} }
+func (m *MsgRequestUpdateValidatorGentx) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestUpdateValidatorGentx) ProtoMessage()    {}
+func (*MsgRequestUpdateValidatorGentx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6adab5ffa522f022, []int{20}
+}
+func (m *MsgRequestUpdateValidatorGentx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgTriggerLaunch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRequestUpdateValidatorGentx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgTriggerLaunch.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRequestUpdateValidatorGentx.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1166,92 +1313,79 @@ func (m *MsgTriggerLaunch) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *MsgTriggerLaunch) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgTriggerLaunch.Merge(m, src)
+func (m *MsgRequestUpdateValidatorGentx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestUpdateValidatorGentx.Merge(m, src)
 }
-func (m *MsgTriggerLaunch) XXX_Size() int {
+func (m *MsgRequestUpdateValidatorGentx) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgTriggerLaunch) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgTriggerLaunch.DiscardUnknown(m)
+func (m *MsgRequestUpdateValidatorGentx) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestUpdateValidatorGentx.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgTriggerLaunch proto.InternalMessageInfo
+var xxx_messageInfo_MsgRequestUpdateValidatorGentx proto.InternalMessageInfo
 
-func (m *MsgTriggerLaunch) GetCoordinator() string {
+func (m *MsgRequestUpdateValidatorGentx) GetCreator() string {
 	if m != nil {
-		return m.Coordinator
+		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgTriggerLaunch) GetLaunchID() uint64 {
+func (m *MsgRequestUpdateValidatorGentx) GetLaunchID() uint64 {
 	if m != nil {
 		return m.LaunchID
 	}
 	return 0
 }
 
-func (m *MsgTriggerLaunch) GetLaunchTime() time.Time {
+func (m *MsgRequestUpdateValidatorGentx) GetValAddress() string {
 	if m != nil {
-		return m.LaunchTime
+		return m.ValAddress
 	}
-	return time.Time{}
+	return ""
 }
 
-type MsgTriggerLaunchResponse struct {
+func (m *MsgRequestUpdateValidatorGentx) GetGenTx() []byte {
+	if m != nil {
+		return m.GenTx
+	}
+	return nil
 }
 
-func (m *MsgTriggerLaunchResponse) Reset()         { *m = MsgTriggerLaunchResponse{} }
-func (m *MsgTriggerLaunchResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgTriggerLaunchResponse) ProtoMessage()    {}
-func (*MsgTriggerLaunchResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6adab5ffa522f022, []int{19}
-}
-func (m *MsgTriggerLaunchResponse) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *MsgTriggerLaunchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_MsgTriggerLaunchResponse.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *MsgRequestUpdateValidatorGentx) GetConsPubKey() []byte {
+	if m != nil {
+		return m.ConsPubKey
 	}
-}
-func (m *MsgTriggerLaunchResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgTriggerLaunchResponse.Merge(m, src)
-}
-func (m *MsgTriggerLaunchResponse) XXX_Size() int {
-	return m.Size()
-}
-func (m *MsgTriggerLaunchResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgTriggerLaunchResponse.DiscardUnknown(m)
+	return nil
 }
 
-var xxx_messageInfo_MsgTriggerLaunchResponse proto.InternalMessageInfo
+func (m *MsgRequestUpdateValidatorGentx) GetSelfDelegation() types.Coin {
+	if m != nil {
+		return m.SelfDelegation
+	}
+	return types.Coin{}
+}
 
-type MsgRevertLaunch struct {
-	Coordinator string `protobuf:"bytes,1,opt,name=coordinator,proto3" json:"coordinator,omitempty"`
-	LaunchID    uint64 `protobuf:"varint,2,opt,name=launchID,proto3" json:"launchID,omitempty"`
+type MsgRequestUpdateValidatorGentxResponse struct {
+	RequestID    uint64 `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	AutoApproved bool   `protobuf:"varint,2,opt,name=autoApproved,proto3" json:"autoApproved,omitempty"`
 }
 
-func (m *MsgRevertLaunch) Reset()         { *m = MsgRevertLaunch{} }
-func (m *MsgRevertLaunch) String() string { return proto.CompactTextString(m) }
-func (*MsgRevertLaunch) ProtoMessage()    {}
-func (*MsgRevertLaunch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6adab5ffa522f022, []int{20}
+func (m *MsgRequestUpdateValidatorGentxResponse) Reset() {
+	*m = MsgRequestUpdateValidatorGentxResponse{}
 }
-func (m *MsgRevertLaunch) XXX_Unmarshal(b []byte) error {
+func (m *MsgRequestUpdateValidatorGentxResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestUpdateValidatorGentxResponse) ProtoMessage()    {}
+func (*MsgRequestUpdateValidatorGentxResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6adab5ffa522f022, []int{21}
+}
+func (m *MsgRequestUpdateValidatorGentxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRevertLaunch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRequestUpdateValidatorGentxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRevertLaunch.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRequestUpdateValidatorGentxResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1261,47 +1395,51 @@ func (m *MsgRevertLaunch) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *MsgRevertLaunch) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRevertLaunch.Merge(m, src)
+func (m *MsgRequestUpdateValidatorGentxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestUpdateValidatorGentxResponse.Merge(m, src)
 }
-func (m *MsgRevertLaunch) XXX_Size() int {
+func (m *MsgRequestUpdateValidatorGentxResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRevertLaunch) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRevertLaunch.DiscardUnknown(m)
+func (m *MsgRequestUpdateValidatorGentxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestUpdateValidatorGentxResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRevertLaunch proto.InternalMessageInfo
+var xxx_messageInfo_MsgRequestUpdateValidatorGentxResponse proto.InternalMessageInfo
 
-func (m *MsgRevertLaunch) GetCoordinator() string {
+func (m *MsgRequestUpdateValidatorGentxResponse) GetRequestID() uint64 {
 	if m != nil {
-		return m.Coordinator
+		return m.RequestID
 	}
-	return ""
+	return 0
 }
 
-func (m *MsgRevertLaunch) GetLaunchID() uint64 {
+func (m *MsgRequestUpdateValidatorGentxResponse) GetAutoApproved() bool {
 	if m != nil {
-		return m.LaunchID
+		return m.AutoApproved
 	}
-	return 0
+	return false
 }
 
-type MsgRevertLaunchResponse struct {
+type MsgSettleRequest struct {
+	Signer    string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	LaunchID  uint64 `protobuf:"varint,2,opt,name=launchID,proto3" json:"launchID,omitempty"`
+	RequestID uint64 `protobuf:"varint,3,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	Approve   bool   `protobuf:"varint,4,opt,name=approve,proto3" json:"approve,omitempty"`
 }
 
-func (m *MsgRevertLaunchResponse) Reset()         { *m = MsgRevertLaunchResponse{} }
-func (m *MsgRevertLaunchResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgRevertLaunchResponse) ProtoMessage()    {}
-func (*MsgRevertLaunchResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6adab5ffa522f022, []int{21}
+func (m *MsgSettleRequest) Reset()         { *m = MsgSettleRequest{} }
+func (m *MsgSettleRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgSettleRequest) ProtoMessage()    {}
+func (*MsgSettleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6adab5ffa522f022, []int{22}
 }
-func (m *MsgRevertLaunchResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgSettleRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRevertLaunchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSettleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRevertLaunchResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSettleRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1311,1958 +1449,6135 @@ func (m *MsgRevertLaunchResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgRevertLaunchResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRevertLaunchResponse.Merge(m, src)
+func (m *MsgSettleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSettleRequest.Merge(m, src)
 }
-func (m *MsgRevertLaunchResponse) XXX_Size() int {
+func (m *MsgSettleRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRevertLaunchResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRevertLaunchResponse.DiscardUnknown(m)
+func (m *MsgSettleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSettleRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRevertLaunchResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgSettleRequest proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgCreateChain)(nil), "tendermint.spn.launch.MsgCreateChain")
-	proto.RegisterType((*MsgCreateChainResponse)(nil), "tendermint.spn.launch.MsgCreateChainResponse")
-	proto.RegisterType((*MsgEditChain)(nil), "tendermint.spn.launch.MsgEditChain")
-	proto.RegisterType((*MsgEditChainResponse)(nil), "tendermint.spn.launch.MsgEditChainResponse")
-	proto.RegisterType((*MsgUpdateLaunchInformation)(nil), "tendermint.spn.launch.MsgUpdateLaunchInformation")
-	proto.RegisterType((*MsgUpdateLaunchInformationResponse)(nil), "tendermint.spn.launch.MsgUpdateLaunchInformationResponse")
-	proto.RegisterType((*MsgRequestAddAccount)(nil), "tendermint.spn.launch.MsgRequestAddAccount")
-	proto.RegisterType((*MsgRequestAddAccountResponse)(nil), "tendermint.spn.launch.MsgRequestAddAccountResponse")
-	proto.RegisterType((*MsgRequestAddVestingAccount)(nil), "tendermint.spn.launch.MsgRequestAddVestingAccount")
-	proto.RegisterType((*MsgRequestAddVestingAccountResponse)(nil), "tendermint.spn.launch.MsgRequestAddVestingAccountResponse")
-	proto.RegisterType((*MsgRequestRemoveAccount)(nil), "tendermint.spn.launch.MsgRequestRemoveAccount")
-	proto.RegisterType((*MsgRequestRemoveAccountResponse)(nil), "tendermint.spn.launch.MsgRequestRemoveAccountResponse")
-	proto.RegisterType((*MsgRequestAddValidator)(nil), "tendermint.spn.launch.MsgRequestAddValidator")
-	proto.RegisterType((*MsgRequestAddValidatorResponse)(nil), "tendermint.spn.launch.MsgRequestAddValidatorResponse")
-	proto.RegisterType((*MsgRequestRemoveValidator)(nil), "tendermint.spn.launch.MsgRequestRemoveValidator")
-	proto.RegisterType((*MsgRequestRemoveValidatorResponse)(nil), "tendermint.spn.launch.MsgRequestRemoveValidatorResponse")
-	proto.RegisterType((*MsgSettleRequest)(nil), "tendermint.spn.launch.MsgSettleRequest")
-	proto.RegisterType((*MsgSettleRequestResponse)(nil), "tendermint.spn.launch.MsgSettleRequestResponse")
-	proto.RegisterType((*MsgTriggerLaunch)(nil), "tendermint.spn.launch.MsgTriggerLaunch")
-	proto.RegisterType((*MsgTriggerLaunchResponse)(nil), "tendermint.spn.launch.MsgTriggerLaunchResponse")
-	proto.RegisterType((*MsgRevertLaunch)(nil), "tendermint.spn.launch.MsgRevertLaunch")
-	proto.RegisterType((*MsgRevertLaunchResponse)(nil), "tendermint.spn.launch.MsgRevertLaunchResponse")
+func (m *MsgSettleRequest) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("launch/tx.proto", fileDescriptor_6adab5ffa522f022) }
-
-var fileDescriptor_6adab5ffa522f022 = []byte{
-	// 1259 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0xc6, 0x4e, 0x93, 0xbc, 0xa4, 0x69, 0x59, 0x42, 0xbb, 0xd9, 0x46, 0xb6, 0xd9, 0x96,
-	0xd6, 0x02, 0x65, 0xb7, 0x75, 0x29, 0x82, 0xde, 0xe2, 0xa4, 0x2a, 0x11, 0xb5, 0xa8, 0xb6, 0x29,
-	0x07, 0x10, 0x2a, 0xe3, 0xdd, 0xc9, 0x66, 0x85, 0xbd, 0xb3, 0xec, 0x8c, 0xad, 0x56, 0x48, 0x9c,
-	0x90, 0x90, 0x10, 0x12, 0xbd, 0x01, 0x07, 0xf8, 0x00, 0x1c, 0x10, 0x07, 0xce, 0x9c, 0x7b, 0x42,
-	0x15, 0x27, 0xb8, 0xb4, 0xa8, 0xfd, 0x16, 0x9c, 0xd0, 0xce, 0xcc, 0xae, 0x77, 0x6d, 0xaf, 0x6b,
-	0x8b, 0x14, 0x4e, 0xf1, 0xbc, 0xf9, 0xbd, 0x7f, 0xbf, 0xf7, 0xe6, 0xcd, 0x6c, 0xe0, 0x44, 0x07,
-	0xf5, 0x02, 0xe7, 0xd0, 0x62, 0x77, 0xcd, 0x30, 0x22, 0x8c, 0xa8, 0x2f, 0x31, 0x1c, 0xb8, 0x38,
-	0xea, 0xfa, 0x01, 0x33, 0x69, 0x18, 0x98, 0x62, 0x5f, 0x5f, 0xf7, 0x88, 0x47, 0x38, 0xc2, 0x8a,
-	0x7f, 0x09, 0xb0, 0x5e, 0xf5, 0x08, 0xf1, 0x3a, 0xd8, 0xe2, 0xab, 0x76, 0xef, 0xc0, 0x62, 0x7e,
-	0x17, 0x53, 0x86, 0xba, 0xa1, 0x04, 0x54, 0x1c, 0x42, 0xbb, 0x84, 0x5a, 0x6d, 0x44, 0xb1, 0xd5,
-	0xbf, 0xd4, 0xc6, 0x0c, 0x5d, 0xb2, 0x1c, 0xe2, 0x07, 0x72, 0x7f, 0x43, 0xec, 0xdf, 0x11, 0x96,
-	0xc5, 0x42, 0x6e, 0xa9, 0x32, 0x32, 0xe7, 0x10, 0xa5, 0xf0, 0x4d, 0x29, 0xeb, 0x63, 0xca, 0xfc,
-	0xc0, 0xbb, 0x83, 0x1c, 0x87, 0xf4, 0x02, 0x96, 0x38, 0x93, 0xbb, 0x1e, 0x0e, 0x30, 0xf5, 0xe9,
-	0x9d, 0x3e, 0xea, 0xf8, 0x2e, 0x62, 0x24, 0x12, 0xfb, 0xc6, 0x9f, 0x25, 0x58, 0x6b, 0x51, 0x6f,
-	0x27, 0xc2, 0x88, 0xe1, 0x9d, 0xd8, 0xac, 0x5a, 0x83, 0x15, 0x87, 0x90, 0xc8, 0xf5, 0x83, 0x18,
-	0xa7, 0x29, 0x35, 0xa5, 0xbe, 0x6c, 0x67, 0x45, 0xea, 0x79, 0x58, 0x93, 0xf6, 0xb8, 0xc6, 0xde,
-	0xae, 0x36, 0xcf, 0x41, 0x43, 0x52, 0x75, 0x13, 0x96, 0x29, 0xe9, 0x45, 0x0e, 0xbe, 0x6d, 0xdf,
-	0xd0, 0x4a, 0x1c, 0x32, 0x10, 0xa8, 0x15, 0x00, 0xb1, 0x78, 0x1b, 0xd1, 0x43, 0xad, 0xcc, 0xb7,
-	0x33, 0x92, 0x78, 0x5f, 0xda, 0x8b, 0xd5, 0x17, 0xc4, 0xfe, 0x40, 0x12, 0xc7, 0x29, 0x57, 0xdc,
-	0xc0, 0x31, 0x11, 0x67, 0x46, 0x14, 0x23, 0x0e, 0x11, 0xdd, 0x41, 0xdd, 0x10, 0xf9, 0x5e, 0xa0,
-	0x2d, 0xd6, 0x94, 0xfa, 0x92, 0x9d, 0x15, 0xc5, 0x3e, 0x1c, 0xf9, 0x7b, 0x6f, 0x57, 0x5b, 0xaa,
-	0x29, 0xf5, 0xb2, 0x9d, 0x91, 0xa8, 0x3f, 0x28, 0xb0, 0xb6, 0x2d, 0x08, 0x6d, 0xa2, 0x0e, 0x0a,
-	0x1c, 0xac, 0x2d, 0xd7, 0x4a, 0xf5, 0x95, 0xc6, 0x86, 0x29, 0x0b, 0x13, 0x57, 0xd1, 0x94, 0x55,
-	0x34, 0x77, 0x88, 0x1f, 0x34, 0x3f, 0x78, 0xf0, 0xa8, 0x3a, 0xf7, 0xf7, 0xa3, 0xea, 0x05, 0xcf,
-	0x67, 0x87, 0xbd, 0xb6, 0xe9, 0x90, 0xae, 0xac, 0xa2, 0xfc, 0xb3, 0x45, 0xdd, 0x8f, 0x2d, 0x76,
-	0x2f, 0xc4, 0x94, 0x2b, 0xfc, 0xf8, 0xb8, 0x5a, 0x9f, 0x12, 0x4a, 0xed, 0xa1, 0x68, 0x54, 0x1d,
-	0x96, 0xba, 0x98, 0x21, 0x17, 0x31, 0xa4, 0x41, 0x4d, 0xa9, 0xaf, 0xda, 0xe9, 0xda, 0x78, 0x1d,
-	0x4e, 0xe5, 0x4b, 0x6b, 0x63, 0x1a, 0x92, 0x80, 0x72, 0x2d, 0xd1, 0x17, 0x7b, 0xbb, 0xbc, 0xbe,
-	0x65, 0x3b, 0x5d, 0x1b, 0x3f, 0x29, 0xb0, 0xda, 0xa2, 0xde, 0x35, 0xd7, 0x67, 0xd3, 0xf6, 0x43,
-	0xd6, 0xdc, 0x7c, 0xde, 0x9c, 0x7a, 0x0e, 0x8e, 0x53, 0xcc, 0x76, 0x06, 0x24, 0x97, 0x78, 0x15,
-	0xf2, 0xc2, 0xa1, 0x3a, 0x94, 0x47, 0xea, 0x90, 0x4d, 0x73, 0x61, 0x28, 0xcd, 0x53, 0xb0, 0x9e,
-	0x8d, 0x37, 0x49, 0xd2, 0xf8, 0x7a, 0x1e, 0xf4, 0x16, 0xf5, 0x6e, 0x87, 0x2e, 0x62, 0xf8, 0x86,
-	0x88, 0x27, 0x38, 0x20, 0x51, 0x17, 0x31, 0x9f, 0xfc, 0xdb, 0xb4, 0x46, 0x8f, 0x40, 0xe9, 0xd9,
-	0x47, 0xa0, 0x3c, 0xf9, 0x08, 0x2c, 0x8c, 0x1c, 0x81, 0x16, 0xac, 0xf9, 0x81, 0xcf, 0x7c, 0xd4,
-	0xb9, 0x2e, 0xcc, 0xf2, 0x2e, 0x5f, 0x69, 0xbc, 0x62, 0x8e, 0x9d, 0x48, 0xe6, 0x5e, 0x0e, 0x6c,
-	0x0f, 0x29, 0x1b, 0xe7, 0xc0, 0x28, 0x26, 0x24, 0xcb, 0x5b, 0x4c, 0xa8, 0x8d, 0x3f, 0xe9, 0x61,
-	0xca, 0xb6, 0x5d, 0x57, 0xb6, 0x9c, 0xaa, 0xc1, 0xa2, 0x13, 0x37, 0x53, 0xca, 0x56, 0xb2, 0x9c,
-	0xc8, 0x54, 0x03, 0x16, 0x91, 0xeb, 0x46, 0x98, 0x52, 0x41, 0x51, 0x53, 0xfb, 0xfd, 0x97, 0xad,
-	0x75, 0x79, 0x7a, 0xb6, 0xc5, 0xce, 0x2d, 0x16, 0xf9, 0x81, 0x67, 0x27, 0x40, 0xf5, 0x2b, 0x05,
-	0x16, 0xe2, 0x89, 0x48, 0xb5, 0xf2, 0xff, 0x7a, 0xda, 0x44, 0x10, 0xc6, 0x47, 0xb0, 0x39, 0x8e,
-	0x90, 0xf4, 0x38, 0x6d, 0xc2, 0x72, 0x24, 0x36, 0xd3, 0xf3, 0x34, 0x10, 0xa8, 0x06, 0xac, 0xa2,
-	0x1e, 0x23, 0xdb, 0x61, 0x18, 0x91, 0x3e, 0x76, 0x39, 0x41, 0x4b, 0x76, 0x4e, 0x66, 0xfc, 0xa6,
-	0xc0, 0x99, 0x9c, 0x8b, 0xf7, 0xc4, 0x34, 0xff, 0xef, 0xa9, 0xbf, 0x06, 0x8b, 0x24, 0x8c, 0xfb,
-	0x81, 0xf2, 0x76, 0x2d, 0xee, 0x35, 0x19, 0xe1, 0xbb, 0x02, 0xdc, 0x2c, 0xc7, 0x75, 0xb0, 0x13,
-	0x5d, 0xc3, 0x83, 0xb3, 0x13, 0xf2, 0x39, 0x42, 0xe6, 0xbe, 0x57, 0xe0, 0xf4, 0xc0, 0x93, 0x8d,
-	0xbb, 0xa4, 0x8f, 0x13, 0xd6, 0x1a, 0x43, 0xac, 0x4d, 0xca, 0xff, 0x39, 0xf1, 0x69, 0x38, 0x50,
-	0x2d, 0x08, 0xef, 0x08, 0x49, 0xf8, 0x75, 0x9e, 0x8f, 0xfa, 0x0c, 0xdd, 0xc9, 0x35, 0x7f, 0xe4,
-	0x1c, 0x54, 0x00, 0xfa, 0xa8, 0xb3, 0x9d, 0xa5, 0xc1, 0xce, 0x48, 0xd4, 0x75, 0x58, 0xf0, 0x70,
-	0xb0, 0x7f, 0x97, 0x77, 0xcf, 0xaa, 0x2d, 0x16, 0x7c, 0xbe, 0x93, 0x80, 0xde, 0xec, 0xb5, 0xdf,
-	0xc1, 0xf7, 0xe4, 0x04, 0xcf, 0x48, 0xd4, 0xeb, 0xb0, 0x46, 0x71, 0xe7, 0x60, 0x17, 0x77, 0xb0,
-	0xc7, 0xa7, 0x91, 0x1c, 0x74, 0x13, 0x0e, 0xbe, 0x68, 0xb8, 0x21, 0x35, 0xf5, 0x0a, 0x94, 0x43,
-	0x8c, 0x23, 0x7e, 0xd7, 0xaf, 0x34, 0xce, 0x14, 0xf4, 0xee, 0x4d, 0x8c, 0x23, 0x69, 0x80, 0xc3,
-	0x8d, 0x36, 0x54, 0xc6, 0xf3, 0x77, 0x84, 0x45, 0xfa, 0x46, 0x81, 0x8d, 0xe1, 0x56, 0x78, 0x7e,
-	0x75, 0x7a, 0x15, 0x4e, 0xa6, 0x6f, 0xbd, 0x7c, 0xb5, 0x46, 0xe4, 0x06, 0x86, 0x97, 0x0b, 0x03,
-	0x3b, 0x42, 0x02, 0xbe, 0x55, 0xe0, 0x64, 0x8b, 0x7a, 0xb7, 0x30, 0x63, 0x1d, 0x2c, 0xbd, 0xa9,
-	0x17, 0xe1, 0x18, 0xf5, 0xbd, 0x00, 0x3f, 0x3b, 0x6d, 0x89, 0x9b, 0x98, 0x75, 0x2e, 0xc8, 0xd2,
-	0x70, 0x90, 0x1a, 0x2c, 0x22, 0x11, 0x0c, 0xef, 0xce, 0x25, 0x3b, 0x59, 0x1a, 0x3a, 0x68, 0xc3,
-	0x91, 0xa5, 0xf7, 0xe1, 0xcf, 0x22, 0xec, 0xfd, 0xc8, 0xf7, 0x3c, 0x1c, 0x89, 0x7b, 0x53, 0xbd,
-	0x3a, 0xe6, 0xf5, 0x30, 0x21, 0xf6, 0xa9, 0xdf, 0x15, 0xbb, 0x00, 0xe2, 0xf7, 0xbe, 0xdf, 0xc5,
-	0x3c, 0x83, 0x95, 0x86, 0x6e, 0x8a, 0x4f, 0x0a, 0x33, 0xf9, 0xa4, 0x30, 0xf7, 0x93, 0x4f, 0x8a,
-	0xe6, 0x52, 0xdc, 0xc4, 0xf7, 0x1f, 0x57, 0x15, 0x3b, 0xa3, 0x27, 0xd3, 0xc9, 0x45, 0x9c, 0xa6,
-	0xe3, 0xc3, 0x09, 0x5e, 0xec, 0x3e, 0x8e, 0xd8, 0xf3, 0x4d, 0xc6, 0xd8, 0x90, 0xa3, 0x79, 0xe0,
-	0x2a, 0x89, 0xa2, 0xf1, 0x1d, 0x40, 0xa9, 0x45, 0x3d, 0xd5, 0x81, 0x95, 0xec, 0xb7, 0x47, 0xd1,
-	0x65, 0x93, 0x7f, 0xc7, 0xea, 0x5b, 0x53, 0xc1, 0xd2, 0xd6, 0xfd, 0x10, 0x96, 0x07, 0xcf, 0xd9,
-	0xb3, 0xc5, 0xba, 0x29, 0x48, 0x7f, 0x6d, 0x0a, 0x50, 0x6a, 0xfe, 0x0b, 0x05, 0x4e, 0x17, 0xbd,
-	0x32, 0x2f, 0x15, 0x1b, 0x2a, 0x50, 0xd1, 0xdf, 0x9a, 0x59, 0x25, 0x8d, 0xa4, 0x07, 0x2f, 0x8c,
-	0x3e, 0xdb, 0x26, 0xe4, 0x32, 0x02, 0xd6, 0x2f, 0xcf, 0x00, 0x4e, 0xdd, 0x7e, 0xa9, 0x80, 0x56,
-	0xf8, 0x74, 0x69, 0x4c, 0x63, 0x31, 0xaf, 0xa3, 0x5f, 0x9d, 0x5d, 0x27, 0x0d, 0xe6, 0x33, 0x58,
-	0x1f, 0xfb, 0x18, 0x30, 0x9f, 0x69, 0x33, 0x87, 0xd7, 0xdf, 0x98, 0x0d, 0x9f, 0xfa, 0xff, 0x14,
-	0x5e, 0x1c, 0x77, 0x0f, 0x6f, 0x4d, 0x95, 0x52, 0x02, 0xd7, 0xaf, 0xcc, 0x04, 0x4f, 0x9d, 0x7f,
-	0xae, 0xc0, 0xa9, 0x82, 0x0b, 0xe6, 0xe2, 0x94, 0xf9, 0x0c, 0x62, 0x78, 0x73, 0x56, 0x8d, 0x34,
-	0x0c, 0x1f, 0x8e, 0xe7, 0xa7, 0xfc, 0x85, 0x62, 0x53, 0x39, 0xa0, 0x6e, 0x4d, 0x09, 0xcc, 0xba,
-	0xca, 0x4f, 0xe6, 0x09, 0xae, 0x72, 0xc0, 0x49, 0xae, 0xc6, 0x4e, 0x4e, 0xf5, 0x00, 0x56, 0x73,
-	0x63, 0xf3, 0xfc, 0x24, 0x7e, 0x06, 0x38, 0xdd, 0x9c, 0x0e, 0x97, 0xf8, 0x69, 0x36, 0x1f, 0x3c,
-	0xa9, 0x28, 0x0f, 0x9f, 0x54, 0x94, 0xbf, 0x9e, 0x54, 0x94, 0xfb, 0x4f, 0x2b, 0x73, 0x0f, 0x9f,
-	0x56, 0xe6, 0xfe, 0x78, 0x5a, 0x99, 0x7b, 0x3f, 0xfb, 0xe5, 0x32, 0xb0, 0x69, 0xd1, 0x30, 0xb0,
-	0xee, 0x5a, 0xc9, 0x7f, 0xad, 0xe2, 0xef, 0x97, 0xf6, 0x31, 0x7e, 0x57, 0x5c, 0xfe, 0x27, 0x00,
-	0x00, 0xff, 0xff, 0x97, 0xdd, 0x47, 0xe1, 0xcc, 0x12, 0x00, 0x00,
+func (m *MsgSettleRequest) GetLaunchID() uint64 {
+	if m != nil {
+		return m.LaunchID
+	}
+	return 0
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *MsgSettleRequest) GetRequestID() uint64 {
+	if m != nil {
+		return m.RequestID
+	}
+	return 0
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *MsgSettleRequest) GetApprove() bool {
+	if m != nil {
+		return m.Approve
+	}
+	return false
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// this line is used by starport scaffolding # proto/tx/rpc
-	CreateChain(ctx context.Context, in *MsgCreateChain, opts ...grpc.CallOption) (*MsgCreateChainResponse, error)
-	EditChain(ctx context.Context, in *MsgEditChain, opts ...grpc.CallOption) (*MsgEditChainResponse, error)
-	UpdateLaunchInformation(ctx context.Context, in *MsgUpdateLaunchInformation, opts ...grpc.CallOption) (*MsgUpdateLaunchInformationResponse, error)
-	RequestAddAccount(ctx context.Context, in *MsgRequestAddAccount, opts ...grpc.CallOption) (*MsgRequestAddAccountResponse, error)
-	RequestAddVestingAccount(ctx context.Context, in *MsgRequestAddVestingAccount, opts ...grpc.CallOption) (*MsgRequestAddVestingAccountResponse, error)
-	RequestRemoveAccount(ctx context.Context, in *MsgRequestRemoveAccount, opts ...grpc.CallOption) (*MsgRequestRemoveAccountResponse, error)
-	RequestAddValidator(ctx context.Context, in *MsgRequestAddValidator, opts ...grpc.CallOption) (*MsgRequestAddValidatorResponse, error)
-	RequestRemoveValidator(ctx context.Context, in *MsgRequestRemoveValidator, opts ...grpc.CallOption) (*MsgRequestRemoveValidatorResponse, error)
-	SettleRequest(ctx context.Context, in *MsgSettleRequest, opts ...grpc.CallOption) (*MsgSettleRequestResponse, error)
-	TriggerLaunch(ctx context.Context, in *MsgTriggerLaunch, opts ...grpc.CallOption) (*MsgTriggerLaunchResponse, error)
-	RevertLaunch(ctx context.Context, in *MsgRevertLaunch, opts ...grpc.CallOption) (*MsgRevertLaunchResponse, error)
+type MsgSettleRequestResponse struct {
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgSettleRequestResponse) Reset()         { *m = MsgSettleRequestResponse{} }
+func (m *MsgSettleRequestResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSettleRequestResponse) ProtoMessage()    {}
+func (*MsgSettleRequestResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6adab5ffa522f022, []int{23}
+}
+func (m *MsgSettleRequestResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSettleRequestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSettleRequestResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSettleRequestResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSettleRequestResponse.Merge(m, src)
+}
+func (m *MsgSettleRequestResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSettleRequestResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSettleRequestResponse.DiscardUnknown(m)
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+var xxx_messageInfo_MsgSettleRequestResponse proto.InternalMessageInfo
+
+type MsgSettleRequests struct {
+	Signer      string              `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	LaunchID    uint64              `protobuf:"varint,2,opt,name=launchID,proto3" json:"launchID,omitempty"`
+	Settlements []RequestSettlement `protobuf:"bytes,3,rep,name=settlements,proto3" json:"settlements"`
+	// requestIDRange settles all the pending requests of the range instead of a list of settlements
+	RequestIDRange *RequestIDRange `protobuf:"bytes,4,opt,name=requestIDRange,proto3" json:"requestIDRange,omitempty"`
+	// bestEffort settles the valid requests and reports the failed ones instead of reverting all settlements
+	BestEffort bool `protobuf:"varint,5,opt,name=bestEffort,proto3" json:"bestEffort,omitempty"`
 }
 
-func (c *msgClient) CreateChain(ctx context.Context, in *MsgCreateChain, opts ...grpc.CallOption) (*MsgCreateChainResponse, error) {
-	out := new(MsgCreateChainResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.spn.launch.Msg/CreateChain", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSettleRequests) Reset()         { *m = MsgSettleRequests{} }
+func (m *MsgSettleRequests) String() string { return proto.CompactTextString(m) }
+func (*MsgSettleRequests) ProtoMessage()    {}
+func (*MsgSettleRequests) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6adab5ffa522f022, []int{24}
+}
+func (m *MsgSettleRequests) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSettleRequests) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSettleRequests.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSettleRequests) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSettleRequests.Merge(m, src)
+}
+func (m *MsgSettleRequests) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSettleRequests) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSettleRequests.DiscardUnknown(m)
 }
 
-func (c *msgClient) EditChain(ctx context.Context, in *MsgEditChain, opts ...grpc.CallOption) (*MsgEditChainResponse, error) {
-	out := new(MsgEditChainResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.spn.launch.Msg/EditChain", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSettleRequests proto.InternalMessageInfo
+
+func (m *MsgSettleRequests) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateLaunchInformation(ctx context.Context, in *MsgUpdateLaunchInformation, opts ...grpc.CallOption) (*MsgUpdateLaunchInformationResponse, error) {
-	out := new(MsgUpdateLaunchInformationResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.spn.launch.Msg/UpdateLaunchInformation", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSettleRequests) GetLaunchID() uint64 {
+	if m != nil {
+		return m.LaunchID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) RequestAddAccount(ctx context.Context, in *MsgRequestAddAccount, opts ...grpc.CallOption) (*MsgRequestAddAccountResponse, error) {
-	out := new(MsgRequestAddAccountResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.spn.launch.Msg/RequestAddAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSettleRequests) GetSettlements() []RequestSettlement {
+	if m != nil {
+		return m.Settlements
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) RequestAddVestingAccount(ctx context.Context, in *MsgRequestAddVestingAccount, opts ...grpc.CallOption) (*MsgRequestAddVestingAccountResponse, error) {
-	out := new(MsgRequestAddVestingAccountResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.spn.launch.Msg/RequestAddVestingAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSettleRequests) GetRequestIDRange() *RequestIDRange {
+	if m != nil {
+		return m.RequestIDRange
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) RequestRemoveAccount(ctx context.Context, in *MsgRequestRemoveAccount, opts ...grpc.CallOption) (*MsgRequestRemoveAccountResponse, error) {
-	out := new(MsgRequestRemoveAccountResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.spn.launch.Msg/RequestRemoveAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSettleRequests) GetBestEffort() bool {
+	if m != nil {
+		return m.BestEffort
 	}
-	return out, nil
+	return false
 }
 
-func (c *msgClient) RequestAddValidator(ctx context.Context, in *MsgRequestAddValidator, opts ...grpc.CallOption) (*MsgRequestAddValidatorResponse, error) {
-	out := new(MsgRequestAddValidatorResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.spn.launch.Msg/RequestAddValidator", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgSettleRequestsResponse struct {
+	SettledRequestIDs []uint64                   `protobuf:"varint,1,rep,packed,name=settledRequestIDs,proto3" json:"settledRequestIDs,omitempty"`
+	Failures          []RequestSettlementFailure `protobuf:"bytes,2,rep,name=failures,proto3" json:"failures"`
 }
 
-func (c *msgClient) RequestRemoveValidator(ctx context.Context, in *MsgRequestRemoveValidator, opts ...grpc.CallOption) (*MsgRequestRemoveValidatorResponse, error) {
-	out := new(MsgRequestRemoveValidatorResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.spn.launch.Msg/RequestRemoveValidator", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSettleRequestsResponse) Reset()         { *m = MsgSettleRequestsResponse{} }
+func (m *MsgSettleRequestsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSettleRequestsResponse) ProtoMessage()    {}
+func (*MsgSettleRequestsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6adab5ffa522f022, []int{25}
+}
+func (m *MsgSettleRequestsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSettleRequestsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSettleRequestsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSettleRequestsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSettleRequestsResponse.Merge(m, src)
+}
+func (m *MsgSettleRequestsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSettleRequestsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSettleRequestsResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) SettleRequest(ctx context.Context, in *MsgSettleRequest, opts ...grpc.CallOption) (*MsgSettleRequestResponse, error) {
-	out := new(MsgSettleRequestResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.spn.launch.Msg/SettleRequest", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSettleRequestsResponse proto.InternalMessageInfo
+
+func (m *MsgSettleRequestsResponse) GetSettledRequestIDs() []uint64 {
+	if m != nil {
+		return m.SettledRequestIDs
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) TriggerLaunch(ctx context.Context, in *MsgTriggerLaunch, opts ...grpc.CallOption) (*MsgTriggerLaunchResponse, error) {
-	out := new(MsgTriggerLaunchResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.spn.launch.Msg/TriggerLaunch", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSettleRequestsResponse) GetFailures() []RequestSettlementFailure {
+	if m != nil {
+		return m.Failures
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) RevertLaunch(ctx context.Context, in *MsgRevertLaunch, opts ...grpc.CallOption) (*MsgRevertLaunchResponse, error) {
-	out := new(MsgRevertLaunchResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.spn.launch.Msg/RevertLaunch", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// RequestSettlement is the approval or rejection of a request
+type RequestSettlement struct {
+	RequestID uint64 `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	Approve   bool   `protobuf:"varint,2,opt,name=approve,proto3" json:"approve,omitempty"`
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// this line is used by starport scaffolding # proto/tx/rpc
-	CreateChain(context.Context, *MsgCreateChain) (*MsgCreateChainResponse, error)
-	EditChain(context.Context, *MsgEditChain) (*MsgEditChainResponse, error)
-	UpdateLaunchInformation(context.Context, *MsgUpdateLaunchInformation) (*MsgUpdateLaunchInformationResponse, error)
-	RequestAddAccount(context.Context, *MsgRequestAddAccount) (*MsgRequestAddAccountResponse, error)
-	RequestAddVestingAccount(context.Context, *MsgRequestAddVestingAccount) (*MsgRequestAddVestingAccountResponse, error)
-	RequestRemoveAccount(context.Context, *MsgRequestRemoveAccount) (*MsgRequestRemoveAccountResponse, error)
-	RequestAddValidator(context.Context, *MsgRequestAddValidator) (*MsgRequestAddValidatorResponse, error)
-	RequestRemoveValidator(context.Context, *MsgRequestRemoveValidator) (*MsgRequestRemoveValidatorResponse, error)
-	SettleRequest(context.Context, *MsgSettleRequest) (*MsgSettleRequestResponse, error)
-	TriggerLaunch(context.Context, *MsgTriggerLaunch) (*MsgTriggerLaunchResponse, error)
-	RevertLaunch(context.Context, *MsgRevertLaunch) (*MsgRevertLaunchResponse, error)
+func (m *RequestSettlement) Reset()         { *m = RequestSettlement{} }
+func (m *RequestSettlement) String() string { return proto.CompactTextString(m) }
+func (*RequestSettlement) ProtoMessage()    {}
+func (*RequestSettlement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6adab5ffa522f022, []int{26}
 }
-
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *RequestSettlement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (*UnimplementedMsgServer) CreateChain(ctx context.Context, req *MsgCreateChain) (*MsgCreateChainResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateChain not implemented")
+func (m *RequestSettlement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RequestSettlement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) EditChain(ctx context.Context, req *MsgEditChain) (*MsgEditChainResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EditChain not implemented")
+func (m *RequestSettlement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestSettlement.Merge(m, src)
 }
-func (*UnimplementedMsgServer) UpdateLaunchInformation(ctx context.Context, req *MsgUpdateLaunchInformation) (*MsgUpdateLaunchInformationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateLaunchInformation not implemented")
+func (m *RequestSettlement) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) RequestAddAccount(ctx context.Context, req *MsgRequestAddAccount) (*MsgRequestAddAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RequestAddAccount not implemented")
+func (m *RequestSettlement) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestSettlement.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) RequestAddVestingAccount(ctx context.Context, req *MsgRequestAddVestingAccount) (*MsgRequestAddVestingAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RequestAddVestingAccount not implemented")
+
+var xxx_messageInfo_RequestSettlement proto.InternalMessageInfo
+
+func (m *RequestSettlement) GetRequestID() uint64 {
+	if m != nil {
+		return m.RequestID
+	}
+	return 0
 }
-func (*UnimplementedMsgServer) RequestRemoveAccount(ctx context.Context, req *MsgRequestRemoveAccount) (*MsgRequestRemoveAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RequestRemoveAccount not implemented")
+
+func (m *RequestSettlement) GetApprove() bool {
+	if m != nil {
+		return m.Approve
+	}
+	return false
 }
-func (*UnimplementedMsgServer) RequestAddValidator(ctx context.Context, req *MsgRequestAddValidator) (*MsgRequestAddValidatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RequestAddValidator not implemented")
+
+// RequestIDRange is a range of request IDs with bounds included
+type RequestIDRange struct {
+	Start   uint64 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End     uint64 `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	Approve bool   `protobuf:"varint,3,opt,name=approve,proto3" json:"approve,omitempty"`
 }
-func (*UnimplementedMsgServer) RequestRemoveValidator(ctx context.Context, req *MsgRequestRemoveValidator) (*MsgRequestRemoveValidatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RequestRemoveValidator not implemented")
+
+func (m *RequestIDRange) Reset()         { *m = RequestIDRange{} }
+func (m *RequestIDRange) String() string { return proto.CompactTextString(m) }
+func (*RequestIDRange) ProtoMessage()    {}
+func (*RequestIDRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6adab5ffa522f022, []int{27}
 }
-func (*UnimplementedMsgServer) SettleRequest(ctx context.Context, req *MsgSettleRequest) (*MsgSettleRequestResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SettleRequest not implemented")
+func (m *RequestIDRange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) TriggerLaunch(ctx context.Context, req *MsgTriggerLaunch) (*MsgTriggerLaunchResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TriggerLaunch not implemented")
+func (m *RequestIDRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RequestIDRange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) RevertLaunch(ctx context.Context, req *MsgRevertLaunch) (*MsgRevertLaunchResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RevertLaunch not implemented")
+func (m *RequestIDRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestIDRange.Merge(m, src)
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *RequestIDRange) XXX_Size() int {
+	return m.Size()
+}
+func (m *RequestIDRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestIDRange.DiscardUnknown(m)
 }
 
-func _Msg_CreateChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateChain)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateChain(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.spn.launch.Msg/CreateChain",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateChain(ctx, req.(*MsgCreateChain))
+var xxx_messageInfo_RequestIDRange proto.InternalMessageInfo
+
+func (m *RequestIDRange) GetStart() uint64 {
+	if m != nil {
+		return m.Start
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_EditChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgEditChain)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).EditChain(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.spn.launch.Msg/EditChain",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).EditChain(ctx, req.(*MsgEditChain))
+func (m *RequestIDRange) GetEnd() uint64 {
+	if m != nil {
+		return m.End
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_UpdateLaunchInformation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateLaunchInformation)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateLaunchInformation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.spn.launch.Msg/UpdateLaunchInformation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateLaunchInformation(ctx, req.(*MsgUpdateLaunchInformation))
+func (m *RequestIDRange) GetApprove() bool {
+	if m != nil {
+		return m.Approve
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Msg_RequestAddAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRequestAddAccount)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RequestAddAccount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.spn.launch.Msg/RequestAddAccount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RequestAddAccount(ctx, req.(*MsgRequestAddAccount))
-	}
-	return interceptor(ctx, in, info, handler)
+// RequestSettlementFailure reports a request that failed to be settled in best effort mode
+type RequestSettlementFailure struct {
+	RequestID uint64 `protobuf:"varint,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	Error     string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func _Msg_RequestAddVestingAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRequestAddVestingAccount)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RequestAddVestingAccount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.spn.launch.Msg/RequestAddVestingAccount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RequestAddVestingAccount(ctx, req.(*MsgRequestAddVestingAccount))
+func (m *RequestSettlementFailure) Reset()         { *m = RequestSettlementFailure{} }
+func (m *RequestSettlementFailure) String() string { return proto.CompactTextString(m) }
+func (*RequestSettlementFailure) ProtoMessage()    {}
+func (*RequestSettlementFailure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6adab5ffa522f022, []int{28}
+}
+func (m *RequestSettlementFailure) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RequestSettlementFailure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RequestSettlementFailure.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *RequestSettlementFailure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestSettlementFailure.Merge(m, src)
+}
+func (m *RequestSettlementFailure) XXX_Size() int {
+	return m.Size()
+}
+func (m *RequestSettlementFailure) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestSettlementFailure.DiscardUnknown(m)
 }
 
-func _Msg_RequestRemoveAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRequestRemoveAccount)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RequestRemoveAccount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.spn.launch.Msg/RequestRemoveAccount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RequestRemoveAccount(ctx, req.(*MsgRequestRemoveAccount))
+var xxx_messageInfo_RequestSettlementFailure proto.InternalMessageInfo
+
+func (m *RequestSettlementFailure) GetRequestID() uint64 {
+	if m != nil {
+		return m.RequestID
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_RequestAddValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRequestAddValidator)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *RequestSettlementFailure) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).RequestAddValidator(ctx, in)
+	return ""
+}
+
+type MsgCancelRequest struct {
+	Signer    string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	LaunchID  uint64 `protobuf:"varint,2,opt,name=launchID,proto3" json:"launchID,omitempty"`
+	RequestID uint64 `protobuf:"varint,3,opt,name=requestID,proto3" json:"requestID,omitempty"`
+}
+
+func (m *MsgCancelRequest) Reset()         { *m = MsgCancelRequest{} }
+func (m *MsgCancelRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelRequest) ProtoMessage()    {}
+func (*MsgCancelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6adab5ffa522f022, []int{29}
+}
+func (m *MsgCancelRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.spn.launch.Msg/RequestAddValidator",
+}
+func (m *MsgCancelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelRequest.Merge(m, src)
+}
+func (m *MsgCancelRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelRequest proto.InternalMessageInfo
+
+func (m *MsgCancelRequest) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RequestAddValidator(ctx, req.(*MsgRequestAddValidator))
+	return ""
+}
+
+func (m *MsgCancelRequest) GetLaunchID() uint64 {
+	if m != nil {
+		return m.LaunchID
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_RequestRemoveValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRequestRemoveValidator)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgCancelRequest) GetRequestID() uint64 {
+	if m != nil {
+		return m.RequestID
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).RequestRemoveValidator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.spn.launch.Msg/RequestRemoveValidator",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RequestRemoveValidator(ctx, req.(*MsgRequestRemoveValidator))
-	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_SettleRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSettleRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SettleRequest(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.spn.launch.Msg/SettleRequest",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SettleRequest(ctx, req.(*MsgSettleRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgCancelRequestResponse struct {
 }
 
-func _Msg_TriggerLaunch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgTriggerLaunch)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).TriggerLaunch(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.spn.launch.Msg/TriggerLaunch",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).TriggerLaunch(ctx, req.(*MsgTriggerLaunch))
+func (m *MsgCancelRequestResponse) Reset()         { *m = MsgCancelRequestResponse{} }
+func (m *MsgCancelRequestResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelRequestResponse) ProtoMessage()    {}
+func (*MsgCancelRequestResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6adab5ffa522f022, []int{30}
+}
+func (m *MsgCancelRequestResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelRequestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelRequestResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgCancelRequestResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelRequestResponse.Merge(m, src)
+}
+func (m *MsgCancelRequestResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelRequestResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelRequestResponse.DiscardUnknown(m)
 }
 
-func _Msg_RevertLaunch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRevertLaunch)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCancelRequestResponse proto.InternalMessageInfo
+
+type MsgTriggerLaunch struct {
+	Coordinator string    `protobuf:"bytes,1,opt,name=coordinator,proto3" json:"coordinator,omitempty"`
+	LaunchID    uint64    `protobuf:"varint,2,opt,name=launchID,proto3" json:"launchID,omitempty"`
+	LaunchTime  time.Time `protobuf:"bytes,3,opt,name=launchTime,proto3,stdtime" json:"launchTime"`
+}
+
+func (m *MsgTriggerLaunch) Reset()         { *m = MsgTriggerLaunch{} }
+func (m *MsgTriggerLaunch) String() string { return proto.CompactTextString(m) }
+func (*MsgTriggerLaunch) ProtoMessage()    {}
+func (*MsgTriggerLaunch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6adab5ffa522f022, []int{31}
+}
+func (m *MsgTriggerLaunch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTriggerLaunch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTriggerLaunch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).RevertLaunch(ctx, in)
+}
+func (m *MsgTriggerLaunch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTriggerLaunch.Merge(m, src)
+}
+func (m *MsgTriggerLaunch) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTriggerLaunch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTriggerLaunch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTriggerLaunch proto.InternalMessageInfo
+
+func (m *MsgTriggerLaunch) GetCoordinator() string {
+	if m != nil {
+		return m.Coordinator
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.spn.launch.Msg/RevertLaunch",
+	return ""
+}
+
+func (m *MsgTriggerLaunch) GetLaunchID() uint64 {
+	if m != nil {
+		return m.LaunchID
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RevertLaunch(ctx, req.(*MsgRevertLaunch))
+	return 0
+}
+
+func (m *MsgTriggerLaunch) GetLaunchTime() time.Time {
+	if m != nil {
+		return m.LaunchTime
 	}
-	return interceptor(ctx, in, info, handler)
+	return time.Time{}
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "tendermint.spn.launch.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateChain",
-			Handler:    _Msg_CreateChain_Handler,
-		},
-		{
-			MethodName: "EditChain",
-			Handler:    _Msg_EditChain_Handler,
-		},
-		{
-			MethodName: "UpdateLaunchInformation",
-			Handler:    _Msg_UpdateLaunchInformation_Handler,
-		},
-		{
-			MethodName: "RequestAddAccount",
-			Handler:    _Msg_RequestAddAccount_Handler,
-		},
-		{
-			MethodName: "RequestAddVestingAccount",
-			Handler:    _Msg_RequestAddVestingAccount_Handler,
-		},
-		{
-			MethodName: "RequestRemoveAccount",
-			Handler:    _Msg_RequestRemoveAccount_Handler,
-		},
-		{
-			MethodName: "RequestAddValidator",
-			Handler:    _Msg_RequestAddValidator_Handler,
-		},
-		{
-			MethodName: "RequestRemoveValidator",
-			Handler:    _Msg_RequestRemoveValidator_Handler,
-		},
-		{
-			MethodName: "SettleRequest",
-			Handler:    _Msg_SettleRequest_Handler,
-		},
-		{
-			MethodName: "TriggerLaunch",
-			Handler:    _Msg_TriggerLaunch_Handler,
-		},
-		{
-			MethodName: "RevertLaunch",
-			Handler:    _Msg_RevertLaunch_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "launch/tx.proto",
+type MsgTriggerLaunchResponse struct {
 }
 
-func (m *MsgCreateChain) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgTriggerLaunchResponse) Reset()         { *m = MsgTriggerLaunchResponse{} }
+func (m *MsgTriggerLaunchResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTriggerLaunchResponse) ProtoMessage()    {}
+func (*MsgTriggerLaunchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6adab5ffa522f022, []int{32}
+}
+func (m *MsgTriggerLaunchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTriggerLaunchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTriggerLaunchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgTriggerLaunchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTriggerLaunchResponse.Merge(m, src)
+}
+func (m *MsgTriggerLaunchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTriggerLaunchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTriggerLaunchResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateChain) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgTriggerLaunchResponse proto.InternalMessageInfo
+
+type MsgRevertLaunch struct {
+	Coordinator string `protobuf:"bytes,1,opt,name=coordinator,proto3" json:"coordinator,omitempty"`
+	LaunchID    uint64 `protobuf:"varint,2,opt,name=launchID,proto3" json:"launchID,omitempty"`
 }
 
-func (m *MsgCreateChain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Metadata) > 0 {
-		i -= len(m.Metadata)
-		copy(dAtA[i:], m.Metadata)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Metadata)))
-		i--
-		dAtA[i] = 0x52
+func (m *MsgRevertLaunch) Reset()         { *m = MsgRevertLaunch{} }
+func (m *MsgRevertLaunch) String() string { return proto.CompactTextString(m) }
+func (*MsgRevertLaunch) ProtoMessage()    {}
+func (*MsgRevertLaunch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6adab5ffa522f022, []int{33}
+}
+func (m *MsgRevertLaunch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevertLaunch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevertLaunch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.AccountBalance) > 0 {
-		for iNdEx := len(m.AccountBalance) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AccountBalance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4a
-		}
-	}
-	if m.CampaignID != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.CampaignID))
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.HasCampaign {
-		i--
-		if m.HasCampaign {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.GenesisHash) > 0 {
-		i -= len(m.GenesisHash)
-		copy(dAtA[i:], m.GenesisHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.GenesisHash)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.GenesisURL) > 0 {
-		i -= len(m.GenesisURL)
-		copy(dAtA[i:], m.GenesisURL)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.GenesisURL)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.SourceHash) > 0 {
-		i -= len(m.SourceHash)
-		copy(dAtA[i:], m.SourceHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SourceHash)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.SourceURL) > 0 {
-		i -= len(m.SourceURL)
-		copy(dAtA[i:], m.SourceURL)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SourceURL)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.GenesisChainID) > 0 {
-		i -= len(m.GenesisChainID)
-		copy(dAtA[i:], m.GenesisChainID)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.GenesisChainID)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Coordinator) > 0 {
-		i -= len(m.Coordinator)
-		copy(dAtA[i:], m.Coordinator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Coordinator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgRevertLaunch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevertLaunch.Merge(m, src)
+}
+func (m *MsgRevertLaunch) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevertLaunch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevertLaunch.DiscardUnknown(m)
 }
 
-func (m *MsgCreateChainResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRevertLaunch proto.InternalMessageInfo
+
+func (m *MsgRevertLaunch) GetCoordinator() string {
+	if m != nil {
+		return m.Coordinator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCreateChainResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRevertLaunch) GetLaunchID() uint64 {
+	if m != nil {
+		return m.LaunchID
+	}
+	return 0
 }
 
-func (m *MsgCreateChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LaunchID != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.LaunchID))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+type MsgRevertLaunchResponse struct {
 }
 
-func (m *MsgEditChain) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRevertLaunchResponse) Reset()         { *m = MsgRevertLaunchResponse{} }
+func (m *MsgRevertLaunchResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevertLaunchResponse) ProtoMessage()    {}
+func (*MsgRevertLaunchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6adab5ffa522f022, []int{34}
+}
+func (m *MsgRevertLaunchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevertLaunchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevertLaunchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgRevertLaunchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevertLaunchResponse.Merge(m, src)
+}
+func (m *MsgRevertLaunchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevertLaunchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevertLaunchResponse.DiscardUnknown(m)
 }
 
-func (m *MsgEditChain) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRevertLaunchResponse proto.InternalMessageInfo
+
+type MsgSetApprovalPolicy struct {
+	Coordinator       string                                   `protobuf:"bytes,1,opt,name=coordinator,proto3" json:"coordinator,omitempty"`
+	LaunchID          uint64                                   `protobuf:"varint,2,opt,name=launchID,proto3" json:"launchID,omitempty"`
+	MaxAccountCoins   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=maxAccountCoins,proto3,casttype=github.com/cosmos/cosmos-sdk/types.Coin,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"maxAccountCoins"`
+	MinSelfDelegation *types.Coin                              `protobuf:"bytes,4,opt,name=minSelfDelegation,proto3" json:"minSelfDelegation,omitempty"`
+	Allowlist         []string                                 `protobuf:"bytes,5,rep,name=allowlist,proto3" json:"allowlist,omitempty"`
 }
 
-func (m *MsgEditChain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Metadata) > 0 {
-		i -= len(m.Metadata)
-		copy(dAtA[i:], m.Metadata)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Metadata)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.CampaignID != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.CampaignID))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.SetCampaignID {
-		i--
-		if m.SetCampaignID {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *MsgSetApprovalPolicy) Reset()         { *m = MsgSetApprovalPolicy{} }
+func (m *MsgSetApprovalPolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgSetApprovalPolicy) ProtoMessage()    {}
+func (*MsgSetApprovalPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6adab5ffa522f022, []int{35}
+}
+func (m *MsgSetApprovalPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetApprovalPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetApprovalPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.LaunchID != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.LaunchID))
-		i--
-		dAtA[i] = 0x10
+		return b[:n], nil
 	}
-	if len(m.Coordinator) > 0 {
-		i -= len(m.Coordinator)
-		copy(dAtA[i:], m.Coordinator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Coordinator)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgSetApprovalPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetApprovalPolicy.Merge(m, src)
+}
+func (m *MsgSetApprovalPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetApprovalPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetApprovalPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetApprovalPolicy proto.InternalMessageInfo
+
+func (m *MsgSetApprovalPolicy) GetCoordinator() string {
+	if m != nil {
+		return m.Coordinator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgEditChainResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetApprovalPolicy) GetLaunchID() uint64 {
+	if m != nil {
+		return m.LaunchID
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgEditChainResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetApprovalPolicy) GetMaxAccountCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MaxAccountCoins
+	}
+	return nil
 }
 
-func (m *MsgEditChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSetApprovalPolicy) GetMinSelfDelegation() *types.Coin {
+	if m != nil {
+		return m.MinSelfDelegation
+	}
+	return nil
 }
 
-func (m *MsgUpdateLaunchInformation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetApprovalPolicy) GetAllowlist() []string {
+	if m != nil {
+		return m.Allowlist
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgUpdateLaunchInformation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgSetApprovalPolicyResponse struct {
 }
 
-func (m *MsgUpdateLaunchInformation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.InitialGenesis != nil {
-		{
-			size, err := m.InitialGenesis.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.SourceHash) > 0 {
-		i -= len(m.SourceHash)
-		copy(dAtA[i:], m.SourceHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SourceHash)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.SourceURL) > 0 {
-		i -= len(m.SourceURL)
-		copy(dAtA[i:], m.SourceURL)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SourceURL)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.GenesisChainID) > 0 {
-		i -= len(m.GenesisChainID)
-		copy(dAtA[i:], m.GenesisChainID)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.GenesisChainID)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.LaunchID != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.LaunchID))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Coordinator) > 0 {
-		i -= len(m.Coordinator)
-		copy(dAtA[i:], m.Coordinator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Coordinator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgSetApprovalPolicyResponse) Reset()         { *m = MsgSetApprovalPolicyResponse{} }
+func (m *MsgSetApprovalPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetApprovalPolicyResponse) ProtoMessage()    {}
+func (*MsgSetApprovalPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6adab5ffa522f022, []int{36}
 }
-
-func (m *MsgUpdateLaunchInformationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetApprovalPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetApprovalPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetApprovalPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgUpdateLaunchInformationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetApprovalPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetApprovalPolicyResponse.Merge(m, src)
 }
-
-func (m *MsgUpdateLaunchInformationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSetApprovalPolicyResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgRequestAddAccount) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSetApprovalPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetApprovalPolicyResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRequestAddAccount) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetApprovalPolicyResponse proto.InternalMessageInfo
+
+type MsgUpdateParams struct {
+	// authority is the address of the governance account
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the parameters to update, all the parameters must be provided
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 }
 
-func (m *MsgRequestAddAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Coins) > 0 {
-		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6adab5ffa522f022, []int{37}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.LaunchID != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.LaunchID))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgRequestAddAccountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
 }
-
-func (m *MsgRequestAddAccountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
 }
 
-func (m *MsgRequestAddAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AutoApproved {
-		i--
-		if m.AutoApproved {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.RequestID != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.RequestID))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgRequestAddVestingAccount) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	return dAtA[:n], nil
+	return Params{}
 }
 
-func (m *MsgRequestAddVestingAccount) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgUpdateParamsResponse struct {
 }
 
-func (m *MsgRequestAddVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Options.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6adab5ffa522f022, []int{38}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.LaunchID != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.LaunchID))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRequestAddVestingAccountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgCreateChain)(nil), "tendermint.spn.launch.MsgCreateChain")
+	proto.RegisterType((*MsgCreateChainResponse)(nil), "tendermint.spn.launch.MsgCreateChainResponse")
+	proto.RegisterType((*MsgEditChain)(nil), "tendermint.spn.launch.MsgEditChain")
+	proto.RegisterType((*MsgEditChainResponse)(nil), "tendermint.spn.launch.MsgEditChainResponse")
+	proto.RegisterType((*MsgUpdateLaunchInformation)(nil), "tendermint.spn.launch.MsgUpdateLaunchInformation")
+	proto.RegisterType((*MsgUpdateLaunchInformationResponse)(nil), "tendermint.spn.launch.MsgUpdateLaunchInformationResponse")
+	proto.RegisterType((*MsgRequestAddAccount)(nil), "tendermint.spn.launch.MsgRequestAddAccount")
+	proto.RegisterType((*MsgRequestAddAccountResponse)(nil), "tendermint.spn.launch.MsgRequestAddAccountResponse")
+	proto.RegisterType((*MsgRequestAddVestingAccount)(nil), "tendermint.spn.launch.MsgRequestAddVestingAccount")
+	proto.RegisterType((*MsgRequestAddVestingAccountResponse)(nil), "tendermint.spn.launch.MsgRequestAddVestingAccountResponse")
+	proto.RegisterType((*MsgRequestRemoveAccount)(nil), "tendermint.spn.launch.MsgRequestRemoveAccount")
+	proto.RegisterType((*MsgRequestRemoveAccountResponse)(nil), "tendermint.spn.launch.MsgRequestRemoveAccountResponse")
+	proto.RegisterType((*MsgRequestUpdateAccount)(nil), "tendermint.spn.launch.MsgRequestUpdateAccount")
+	proto.RegisterType((*MsgRequestUpdateAccountResponse)(nil), "tendermint.spn.launch.MsgRequestUpdateAccountResponse")
+	proto.RegisterType((*MsgRequestAddValidator)(nil), "tendermint.spn.launch.MsgRequestAddValidator")
+	proto.RegisterType((*MsgRequestAddValidatorResponse)(nil), "tendermint.spn.launch.MsgRequestAddValidatorResponse")
+	proto.RegisterType((*MsgRequestRemoveValidator)(nil), "tendermint.spn.launch.MsgRequestRemoveValidator")
+	proto.RegisterType((*MsgRequestRemoveValidatorResponse)(nil), "tendermint.spn.launch.MsgRequestRemoveValidatorResponse")
+	proto.RegisterType((*MsgRequestUpdateValidatorPeer)(nil), "tendermint.spn.launch.MsgRequestUpdateValidatorPeer")
+	proto.RegisterType((*MsgRequestUpdateValidatorPeerResponse)(nil), "tendermint.spn.launch.MsgRequestUpdateValidatorPeerResponse")
+	proto.RegisterType((*MsgRequestUpdateValidatorGentx)(nil), "tendermint.spn.launch.MsgRequestUpdateValidatorGentx")
+	proto.RegisterType((*MsgRequestUpdateValidatorGentxResponse)(nil), "tendermint.spn.launch.MsgRequestUpdateValidatorGentxResponse")
+	proto.RegisterType((*MsgSettleRequest)(nil), "tendermint.spn.launch.MsgSettleRequest")
+	proto.RegisterType((*MsgSettleRequestResponse)(nil), "tendermint.spn.launch.MsgSettleRequestResponse")
+	proto.RegisterType((*MsgSettleRequests)(nil), "tendermint.spn.launch.MsgSettleRequests")
+	proto.RegisterType((*MsgSettleRequestsResponse)(nil), "tendermint.spn.launch.MsgSettleRequestsResponse")
+	proto.RegisterType((*RequestSettlement)(nil), "tendermint.spn.launch.RequestSettlement")
+	proto.RegisterType((*RequestIDRange)(nil), "tendermint.spn.launch.RequestIDRange")
+	proto.RegisterType((*RequestSettlementFailure)(nil), "tendermint.spn.launch.RequestSettlementFailure")
+	proto.RegisterType((*MsgCancelRequest)(nil), "tendermint.spn.launch.MsgCancelRequest")
+	proto.RegisterType((*MsgCancelRequestResponse)(nil), "tendermint.spn.launch.MsgCancelRequestResponse")
+	proto.RegisterType((*MsgTriggerLaunch)(nil), "tendermint.spn.launch.MsgTriggerLaunch")
+	proto.RegisterType((*MsgTriggerLaunchResponse)(nil), "tendermint.spn.launch.MsgTriggerLaunchResponse")
+	proto.RegisterType((*MsgRevertLaunch)(nil), "tendermint.spn.launch.MsgRevertLaunch")
+	proto.RegisterType((*MsgRevertLaunchResponse)(nil), "tendermint.spn.launch.MsgRevertLaunchResponse")
+	proto.RegisterType((*MsgSetApprovalPolicy)(nil), "tendermint.spn.launch.MsgSetApprovalPolicy")
+	proto.RegisterType((*MsgSetApprovalPolicyResponse)(nil), "tendermint.spn.launch.MsgSetApprovalPolicyResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "tendermint.spn.launch.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "tendermint.spn.launch.MsgUpdateParamsResponse")
 }
 
-func (m *MsgRequestAddVestingAccountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() { proto.RegisterFile("launch/tx.proto", fileDescriptor_6adab5ffa522f022) }
+
+var fileDescriptor_6adab5ffa522f022 = []byte{
+	// 1804 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcd, 0x6f, 0x23, 0x49,
+	0x15, 0x4f, 0xdb, 0x4e, 0xe2, 0x3c, 0x67, 0x33, 0x49, 0x6f, 0xc8, 0x74, 0x7a, 0xb2, 0x4e, 0xe8,
+	0xdd, 0xcd, 0x5a, 0x2c, 0xb1, 0x67, 0x3c, 0x3b, 0xa3, 0x65, 0x81, 0x43, 0x3e, 0x86, 0x10, 0xed,
+	0x06, 0x42, 0x27, 0xcb, 0x01, 0x84, 0x86, 0x72, 0xbb, 0xd2, 0x69, 0x68, 0x77, 0x9b, 0xae, 0xb2,
+	0x49, 0x84, 0xb4, 0x27, 0x04, 0x12, 0x42, 0x62, 0x04, 0x07, 0x38, 0x00, 0x42, 0xdc, 0xe0, 0x80,
+	0x38, 0x70, 0xe6, 0xbc, 0x27, 0xb4, 0xe2, 0x04, 0x97, 0xdd, 0xd5, 0xcc, 0x7f, 0xb1, 0x5c, 0x50,
+	0x57, 0x95, 0xdb, 0x5d, 0x6d, 0xbb, 0xdd, 0x5e, 0x1c, 0x32, 0xa7, 0xb8, 0xaa, 0x7e, 0xef, 0xa3,
+	0x7e, 0xef, 0xbd, 0xaa, 0xea, 0xa7, 0xc0, 0x2d, 0x17, 0x75, 0x3c, 0xeb, 0xa2, 0x46, 0x2f, 0xab,
+	0xed, 0xc0, 0xa7, 0xbe, 0xfa, 0x19, 0x8a, 0xbd, 0x26, 0x0e, 0x5a, 0x8e, 0x47, 0xab, 0xa4, 0xed,
+	0x55, 0xf9, 0xba, 0xbe, 0x6a, 0xfb, 0xb6, 0xcf, 0x10, 0xb5, 0xf0, 0x17, 0x07, 0xeb, 0x9b, 0xb6,
+	0xef, 0xdb, 0x2e, 0xae, 0xb1, 0x51, 0xa3, 0x73, 0x5e, 0xa3, 0x4e, 0x0b, 0x13, 0x8a, 0x5a, 0x6d,
+	0x01, 0x28, 0x5b, 0x3e, 0x69, 0xf9, 0xa4, 0xd6, 0x40, 0x04, 0xd7, 0xba, 0xf7, 0x1a, 0x98, 0xa2,
+	0x7b, 0x35, 0xcb, 0x77, 0x3c, 0xb1, 0xbe, 0xce, 0xd7, 0x1f, 0x73, 0xcd, 0x7c, 0x20, 0x96, 0x54,
+	0xe1, 0x99, 0x75, 0x81, 0x22, 0xf8, 0x86, 0x98, 0xeb, 0x62, 0x42, 0x1d, 0xcf, 0x7e, 0x8c, 0x2c,
+	0xcb, 0xef, 0x78, 0xb4, 0x67, 0x4c, 0xac, 0xda, 0xd8, 0xc3, 0xc4, 0x21, 0x8f, 0xbb, 0xc8, 0x75,
+	0x9a, 0x88, 0xfa, 0x81, 0x58, 0x7f, 0x51, 0xac, 0xb7, 0x51, 0x80, 0x5a, 0xc2, 0x8c, 0xf1, 0xef,
+	0x3c, 0x2c, 0x1d, 0x13, 0x7b, 0x3f, 0xc0, 0x88, 0xe2, 0xfd, 0xd0, 0x96, 0xba, 0x05, 0x25, 0xcb,
+	0xf7, 0x83, 0xa6, 0xe3, 0x85, 0xc2, 0x9a, 0xb2, 0xa5, 0x54, 0x16, 0xcc, 0xf8, 0x94, 0xba, 0x0d,
+	0x4b, 0xc2, 0x08, 0x93, 0x38, 0x3a, 0xd0, 0x72, 0x0c, 0x94, 0x98, 0x55, 0x37, 0x60, 0x81, 0xf8,
+	0x9d, 0xc0, 0xc2, 0xef, 0x9a, 0xef, 0x68, 0x79, 0x06, 0xe9, 0x4f, 0xa8, 0x65, 0x00, 0x3e, 0xf8,
+	0x2a, 0x22, 0x17, 0x5a, 0x81, 0x2d, 0xc7, 0x66, 0xc2, 0x75, 0xa1, 0x2f, 0x14, 0x9f, 0xe5, 0xeb,
+	0xfd, 0x99, 0xd0, 0x4f, 0x31, 0x62, 0x0a, 0xe6, 0xb8, 0x9f, 0xb1, 0xa9, 0x10, 0x71, 0x81, 0xc8,
+	0x3e, 0x6a, 0xb5, 0x91, 0x63, 0x7b, 0xda, 0xfc, 0x96, 0x52, 0x29, 0x9a, 0xf1, 0xa9, 0xd0, 0x86,
+	0x25, 0x7e, 0x1f, 0x1d, 0x68, 0xc5, 0x2d, 0xa5, 0x52, 0x30, 0x63, 0x33, 0xea, 0xef, 0x15, 0x58,
+	0xda, 0xe5, 0x2c, 0xef, 0x21, 0x17, 0x79, 0x16, 0xd6, 0x16, 0xb6, 0xf2, 0x95, 0x52, 0x7d, 0xbd,
+	0x2a, 0xa2, 0x15, 0x86, 0xb6, 0x2a, 0x42, 0x5b, 0xdd, 0xf7, 0x1d, 0x6f, 0xef, 0xdb, 0xef, 0x7f,
+	0xb8, 0x39, 0xf3, 0xc9, 0x87, 0x9b, 0xaf, 0xd9, 0x0e, 0xbd, 0xe8, 0x34, 0xaa, 0x96, 0xdf, 0x12,
+	0xa1, 0x15, 0x7f, 0x76, 0x48, 0xf3, 0xfb, 0x35, 0x7a, 0xd5, 0xc6, 0x84, 0x09, 0xfc, 0xf9, 0xa3,
+	0xcd, 0x4a, 0x46, 0x28, 0x31, 0x13, 0xde, 0xa8, 0x3a, 0x14, 0x5b, 0x98, 0xa2, 0x26, 0xa2, 0x48,
+	0x83, 0x2d, 0xa5, 0xb2, 0x68, 0x46, 0x63, 0xe3, 0x0d, 0x58, 0x93, 0x43, 0x6b, 0x62, 0xd2, 0xf6,
+	0x3d, 0xc2, 0xa4, 0x78, 0x32, 0x1c, 0x1d, 0xb0, 0xf8, 0x16, 0xcc, 0x68, 0x6c, 0xfc, 0x45, 0x81,
+	0xc5, 0x63, 0x62, 0x3f, 0x6a, 0x3a, 0x34, 0x6b, 0x3e, 0xc4, 0xd5, 0xe5, 0x64, 0x75, 0xea, 0x2b,
+	0xf0, 0x02, 0xc1, 0x74, 0xbf, 0x4f, 0x72, 0x9e, 0x45, 0x41, 0x9e, 0x4c, 0xc4, 0xa1, 0x30, 0x10,
+	0x87, 0xf8, 0x36, 0x67, 0x13, 0xdb, 0x5c, 0x83, 0xd5, 0xb8, 0xbf, 0xbd, 0x4d, 0x1a, 0xbf, 0xc8,
+	0x81, 0x7e, 0x4c, 0xec, 0x77, 0xdb, 0x4d, 0x44, 0xf1, 0x3b, 0xdc, 0x1f, 0xef, 0xdc, 0x0f, 0x5a,
+	0x88, 0x3a, 0xfe, 0xff, 0xba, 0xad, 0xc1, 0x12, 0xc8, 0x8f, 0x2f, 0x81, 0x42, 0x7a, 0x09, 0xcc,
+	0x0e, 0x94, 0xc0, 0x31, 0x2c, 0x39, 0x9e, 0x43, 0x1d, 0xe4, 0x1e, 0x72, 0xb5, 0x2c, 0xcb, 0x4b,
+	0xf5, 0x57, 0xab, 0x43, 0x8f, 0xa9, 0xea, 0x91, 0x04, 0x36, 0x13, 0xc2, 0xc6, 0x2b, 0x60, 0x8c,
+	0x26, 0x24, 0xce, 0x5b, 0x48, 0xa8, 0x89, 0x7f, 0xd0, 0xc1, 0x84, 0xee, 0x36, 0x9b, 0x22, 0xe5,
+	0x54, 0x0d, 0xe6, 0xad, 0x00, 0xc7, 0xd8, 0xea, 0x0d, 0x53, 0x99, 0xaa, 0xc3, 0x3c, 0x6a, 0x36,
+	0x03, 0x4c, 0x08, 0xa7, 0x68, 0x4f, 0xfb, 0xe7, 0xdf, 0x76, 0x56, 0x45, 0xf5, 0xec, 0xf2, 0x95,
+	0x53, 0x1a, 0x38, 0x9e, 0x6d, 0xf6, 0x80, 0xea, 0xcf, 0x15, 0x98, 0x0d, 0x8f, 0x49, 0xa2, 0x15,
+	0x6e, 0xb4, 0xda, 0xb8, 0x13, 0xc6, 0x77, 0x61, 0x63, 0x18, 0x21, 0x51, 0x39, 0x6d, 0xc0, 0x42,
+	0xc0, 0x17, 0xa3, 0x7a, 0xea, 0x4f, 0xa8, 0x06, 0x2c, 0xa2, 0x0e, 0xf5, 0x77, 0xdb, 0xed, 0xc0,
+	0xef, 0xe2, 0x26, 0x23, 0xa8, 0x68, 0x4a, 0x73, 0xc6, 0x3f, 0x14, 0xb8, 0x23, 0x99, 0xf8, 0x26,
+	0x3f, 0xe2, 0xff, 0xff, 0xd4, 0x3f, 0x82, 0x79, 0xbf, 0x1d, 0xe6, 0x03, 0xd1, 0x0a, 0xa9, 0xb9,
+	0x26, 0x3c, 0xfc, 0x3a, 0x07, 0xef, 0x15, 0xc2, 0x38, 0x98, 0x3d, 0x59, 0xc3, 0x86, 0x97, 0x53,
+	0xf6, 0x33, 0x45, 0xe6, 0x7e, 0xa7, 0xc0, 0xed, 0xbe, 0x25, 0x13, 0xb7, 0xfc, 0x2e, 0xee, 0xb1,
+	0x56, 0x4f, 0xb0, 0x96, 0xb6, 0xff, 0x6b, 0xe2, 0xd3, 0xb0, 0x60, 0x73, 0x84, 0x7b, 0x53, 0x24,
+	0xe1, 0x3f, 0xb9, 0x38, 0x09, 0xbc, 0xc0, 0x9f, 0x23, 0x12, 0x9e, 0xb3, 0x7a, 0x0e, 0x8f, 0xd5,
+	0xae, 0x94, 0xbd, 0xda, 0xec, 0x04, 0xa9, 0x6e, 0x26, 0x84, 0xe5, 0x10, 0x4b, 0xe4, 0x4f, 0x31,
+	0xc4, 0x7f, 0xcf, 0xc1, 0x5a, 0xdf, 0x4a, 0x58, 0x51, 0xbd, 0xe7, 0xdd, 0xd4, 0x23, 0x5c, 0x06,
+	0xe8, 0x22, 0x77, 0x37, 0x1e, 0x64, 0x33, 0x36, 0xa3, 0xae, 0xc2, 0xac, 0x8d, 0xbd, 0xb3, 0x4b,
+	0x76, 0x40, 0x2c, 0x9a, 0x7c, 0x10, 0x4a, 0x59, 0xbe, 0x47, 0x4e, 0x3a, 0x8d, 0xb7, 0xf1, 0x95,
+	0xb8, 0xa4, 0x63, 0x33, 0xea, 0x21, 0x2c, 0x11, 0xec, 0x9e, 0x1f, 0x60, 0x17, 0xdb, 0xec, 0xc2,
+	0x11, 0x77, 0x59, 0x4a, 0x2e, 0xf0, 0x33, 0x25, 0x21, 0xa6, 0x3e, 0x80, 0x42, 0x1b, 0xe3, 0x80,
+	0x3d, 0xe7, 0x4a, 0xf5, 0x3b, 0x23, 0x62, 0x76, 0x82, 0x71, 0x20, 0x14, 0x30, 0xb8, 0xd1, 0x80,
+	0xf2, 0x70, 0xfe, 0xa6, 0x18, 0xa4, 0x5f, 0x2b, 0xb0, 0x9e, 0xac, 0xf6, 0xeb, 0x8b, 0xd3, 0xe7,
+	0x60, 0x39, 0x7a, 0xe3, 0xcb, 0xd1, 0x1a, 0x98, 0x37, 0x30, 0x7c, 0x76, 0xa4, 0x63, 0x53, 0x24,
+	0xe0, 0x63, 0x05, 0x5e, 0x4a, 0xd6, 0x42, 0x64, 0x27, 0x0c, 0xc9, 0xd4, 0x49, 0x78, 0x73, 0x30,
+	0x59, 0x53, 0x54, 0xc6, 0xd3, 0xb8, 0x97, 0x47, 0x85, 0xc9, 0xf2, 0xc8, 0x81, 0x57, 0x53, 0x77,
+	0x38, 0x45, 0x36, 0xff, 0x94, 0x83, 0xf2, 0x48, 0x5b, 0x87, 0xd8, 0xa3, 0x97, 0xcf, 0x11, 0x9d,
+	0x37, 0x7b, 0x2a, 0x18, 0xdf, 0x83, 0xed, 0x74, 0xaa, 0xa6, 0x18, 0x97, 0xdf, 0x28, 0xb0, 0x7c,
+	0x4c, 0xec, 0x53, 0x4c, 0xa9, 0x8b, 0x85, 0x49, 0xf5, 0x2e, 0xcc, 0x11, 0xc7, 0xf6, 0xf0, 0xf8,
+	0x40, 0x08, 0x5c, 0x6a, 0x1c, 0x24, 0x27, 0xf3, 0x49, 0x27, 0x35, 0x98, 0x47, 0xdc, 0x19, 0xc6,
+	0x76, 0xd1, 0xec, 0x0d, 0x0d, 0x1d, 0xb4, 0xa4, 0x67, 0xd1, 0xc3, 0xfe, 0x8f, 0x39, 0x58, 0x49,
+	0x2e, 0x92, 0x29, 0xfb, 0x7d, 0x02, 0x25, 0xc2, 0xf4, 0xb7, 0xb0, 0x47, 0xc3, 0x04, 0x0a, 0xaf,
+	0xfb, 0xca, 0x88, 0xda, 0x12, 0x3e, 0x9c, 0x46, 0x02, 0x22, 0xb6, 0x71, 0x15, 0xe1, 0x65, 0x1d,
+	0x6d, 0xdc, 0x44, 0x9e, 0x8d, 0xc7, 0xbc, 0x4b, 0x4d, 0x09, 0x6c, 0x26, 0x84, 0xc3, 0x84, 0x6c,
+	0x60, 0x42, 0x1f, 0x9d, 0x9f, 0xfb, 0x01, 0x65, 0x09, 0x59, 0x34, 0x63, 0x33, 0xc6, 0x6f, 0xf9,
+	0x11, 0x2e, 0x93, 0x14, 0xe5, 0xce, 0xe7, 0x61, 0x85, 0xfb, 0xd6, 0x8c, 0xcc, 0x10, 0x4d, 0xd9,
+	0xca, 0x57, 0x0a, 0xe6, 0xe0, 0x82, 0xfa, 0x0d, 0x28, 0x9e, 0x23, 0xc7, 0xed, 0x04, 0x98, 0x68,
+	0x39, 0xc6, 0x44, 0x2d, 0x2b, 0x13, 0x5f, 0xe1, 0x72, 0x82, 0x90, 0x48, 0x8d, 0xf1, 0x36, 0xac,
+	0x0c, 0x60, 0xc7, 0x64, 0x74, 0x2c, 0x59, 0x72, 0x72, 0xb2, 0x98, 0xb0, 0x24, 0xb3, 0x15, 0x16,
+	0x31, 0xa1, 0x28, 0xa0, 0x42, 0x0b, 0x1f, 0xa8, 0xcb, 0x90, 0xc7, 0x5e, 0x53, 0xc4, 0x3a, 0xfc,
+	0x19, 0xd7, 0x99, 0x97, 0x75, 0x7e, 0x0d, 0xb4, 0x51, 0x9b, 0x19, 0xe3, 0xe7, 0x2a, 0xcc, 0xe2,
+	0x20, 0xf0, 0x03, 0xd1, 0x4c, 0xe2, 0x03, 0xe3, 0x3d, 0x56, 0x6a, 0xfb, 0xc8, 0xb3, 0xb0, 0x7b,
+	0x03, 0xa5, 0x26, 0x0a, 0x4a, 0xb2, 0x1f, 0x15, 0xd4, 0x5f, 0xf9, 0x39, 0x70, 0x16, 0x38, 0xb6,
+	0x8d, 0x03, 0xfe, 0x45, 0xad, 0xbe, 0x35, 0xa4, 0xaf, 0x90, 0xe2, 0x61, 0xe6, 0x8e, 0xc3, 0x01,
+	0x00, 0xff, 0x7d, 0xe6, 0xb4, 0x38, 0xeb, 0xa5, 0xba, 0x5e, 0xe5, 0x1d, 0xc8, 0x6a, 0xaf, 0x03,
+	0x59, 0x3d, 0xeb, 0x75, 0x20, 0xf7, 0x8a, 0x61, 0xe6, 0x3c, 0xf9, 0x68, 0x53, 0x31, 0x63, 0x72,
+	0x62, 0x3b, 0x92, 0xc7, 0xd1, 0x76, 0x1c, 0xb8, 0xc5, 0x8e, 0xd0, 0x2e, 0x0e, 0xe8, 0xf5, 0x6e,
+	0xc6, 0x58, 0x87, 0xdb, 0x09, 0x53, 0x91, 0x17, 0xbf, 0xcc, 0xb3, 0xf6, 0xc3, 0x29, 0xa6, 0xfc,
+	0xbc, 0x45, 0xee, 0x89, 0xef, 0x3a, 0xd6, 0xd5, 0xb5, 0x11, 0xfb, 0x07, 0x05, 0x6e, 0xb5, 0xd0,
+	0xa5, 0x78, 0xb2, 0xb3, 0x0f, 0x05, 0x71, 0x6e, 0xdd, 0xd4, 0x67, 0x4a, 0xd2, 0x1d, 0xf5, 0x10,
+	0x56, 0x5a, 0x8e, 0x77, 0x2a, 0x5f, 0x94, 0x85, 0x31, 0x17, 0xa5, 0x39, 0x28, 0xa3, 0x3e, 0x84,
+	0x05, 0xe4, 0xba, 0xfe, 0x0f, 0x5d, 0x87, 0x84, 0x87, 0x5f, 0x3e, 0x95, 0xc1, 0x3e, 0xd4, 0x28,
+	0xc3, 0xc6, 0xb0, 0x98, 0x44, 0x41, 0xfb, 0x89, 0xc2, 0x72, 0x87, 0xdf, 0xbb, 0x27, 0xac, 0xc1,
+	0xcc, 0x6c, 0x75, 0xe8, 0x85, 0x1f, 0x38, 0xf4, 0x6a, 0x6c, 0xb4, 0xfa, 0x50, 0xf5, 0x8b, 0x30,
+	0xc7, 0x5b, 0xd4, 0x2c, 0x52, 0xa5, 0xfa, 0x4b, 0xa3, 0x5e, 0x66, 0x0c, 0x24, 0x4e, 0x48, 0x21,
+	0x22, 0x12, 0x2b, 0xee, 0x47, 0xcf, 0xc7, 0xfa, 0x27, 0xcb, 0x90, 0x3f, 0x26, 0xb6, 0x6a, 0x41,
+	0x29, 0xde, 0xee, 0x1e, 0x75, 0x8f, 0xc8, 0xad, 0x53, 0x7d, 0x27, 0x13, 0x2c, 0xba, 0x28, 0xbe,
+	0x03, 0x0b, 0xfd, 0x0e, 0xea, 0xcb, 0xa3, 0x65, 0x23, 0x90, 0xfe, 0x7a, 0x06, 0x50, 0xa4, 0xfe,
+	0xa7, 0x0a, 0xdc, 0x1e, 0xd5, 0xd8, 0xbc, 0x37, 0x5a, 0xd1, 0x08, 0x11, 0xfd, 0x0b, 0x13, 0x8b,
+	0x44, 0x9e, 0x74, 0x60, 0x65, 0xa0, 0x31, 0xa6, 0xa6, 0xec, 0x65, 0x00, 0xac, 0xdf, 0x9f, 0x00,
+	0x1c, 0x99, 0xfd, 0x99, 0x02, 0x5a, 0xec, 0x5b, 0x4e, 0xee, 0x96, 0xd5, 0xb3, 0x68, 0x94, 0x65,
+	0xf4, 0xb7, 0x26, 0x97, 0x89, 0x9c, 0x79, 0x0f, 0x56, 0x87, 0xf6, 0x9f, 0xaa, 0x63, 0x75, 0x4a,
+	0x78, 0xfd, 0xe1, 0x64, 0xf8, 0x21, 0xf6, 0xe5, 0xd6, 0xcf, 0x78, 0xfb, 0x12, 0x5e, 0x7f, 0x38,
+	0x19, 0x3e, 0xb2, 0xff, 0x23, 0x78, 0x71, 0x58, 0x5f, 0x62, 0x27, 0x13, 0xa5, 0x3d, 0xb8, 0xfe,
+	0x60, 0x22, 0x78, 0x64, 0xfc, 0xc7, 0x0a, 0xac, 0x8d, 0xf8, 0xe0, 0xbe, 0x9b, 0x91, 0xcf, 0xbe,
+	0x0f, 0x6f, 0x4e, 0x2a, 0x11, 0xb9, 0xf1, 0x44, 0x01, 0x3d, 0xe5, 0xb3, 0xf7, 0x8d, 0x8c, 0xd4,
+	0x4a, 0x52, 0xfa, 0x97, 0x3e, 0x8d, 0x54, 0xe4, 0xd2, 0xaf, 0x14, 0xb8, 0x93, 0xf6, 0xed, 0xf8,
+	0x60, 0x52, 0xed, 0x4c, 0x4c, 0xff, 0xf2, 0xa7, 0x12, 0x8b, 0xbc, 0x72, 0xe0, 0x05, 0xf9, 0xc3,
+	0xe9, 0xb5, 0xd1, 0xfa, 0x24, 0xa0, 0x5e, 0xcb, 0x08, 0x8c, 0x4c, 0xb9, 0xb0, 0x94, 0xf8, 0xd8,
+	0xa9, 0x64, 0x54, 0x41, 0xf4, 0xbb, 0x59, 0x91, 0xf1, 0x8d, 0xc9, 0xcf, 0xd4, 0x94, 0x8d, 0x49,
+	0x40, 0xbd, 0x96, 0x11, 0x18, 0x37, 0x25, 0x3f, 0x3a, 0x53, 0x4c, 0x49, 0x40, 0xbd, 0x96, 0x11,
+	0x18, 0x99, 0x3a, 0x87, 0x45, 0xe9, 0x45, 0xb8, 0x9d, 0x16, 0xfd, 0x3e, 0x4e, 0xaf, 0x66, 0xc3,
+	0xc5, 0xef, 0x91, 0xc1, 0x27, 0xdf, 0xeb, 0xa9, 0x41, 0x90, 0xc1, 0xfa, 0xfd, 0x09, 0xc0, 0xf1,
+	0xed, 0x49, 0x8f, 0x96, 0xed, 0x71, 0x37, 0x21, 0xc7, 0xe9, 0xd5, 0x6c, 0xb8, 0x9e, 0x9d, 0xbd,
+	0xbd, 0xf7, 0x9f, 0x96, 0x95, 0x0f, 0x9e, 0x96, 0x95, 0x8f, 0x9f, 0x96, 0x95, 0x27, 0xcf, 0xca,
+	0x33, 0x1f, 0x3c, 0x2b, 0xcf, 0xfc, 0xeb, 0x59, 0x79, 0xe6, 0x5b, 0xf1, 0x67, 0x61, 0x5f, 0x67,
+	0x8d, 0xb4, 0xbd, 0xda, 0x65, 0xad, 0xf7, 0xef, 0x09, 0xe1, 0xe3, 0xb0, 0x31, 0xc7, 0x5e, 0xf9,
+	0xf7, 0xff, 0x3b, 0x00, 0x54, 0xd3, 0x82, 0x80, 0xb5, 0x20, 0x00, 0x00,
 }
 
-func (m *MsgRequestAddVestingAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AutoApproved {
-		i--
-		if m.AutoApproved {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.RequestID != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.RequestID))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// this line is used by starport scaffolding # proto/tx/rpc
+	CreateChain(ctx context.Context, in *MsgCreateChain, opts ...grpc.CallOption) (*MsgCreateChainResponse, error)
+	EditChain(ctx context.Context, in *MsgEditChain, opts ...grpc.CallOption) (*MsgEditChainResponse, error)
+	UpdateLaunchInformation(ctx context.Context, in *MsgUpdateLaunchInformation, opts ...grpc.CallOption) (*MsgUpdateLaunchInformationResponse, error)
+	RequestAddAccount(ctx context.Context, in *MsgRequestAddAccount, opts ...grpc.CallOption) (*MsgRequestAddAccountResponse, error)
+	RequestAddVestingAccount(ctx context.Context, in *MsgRequestAddVestingAccount, opts ...grpc.CallOption) (*MsgRequestAddVestingAccountResponse, error)
+	RequestRemoveAccount(ctx context.Context, in *MsgRequestRemoveAccount, opts ...grpc.CallOption) (*MsgRequestRemoveAccountResponse, error)
+	RequestUpdateAccount(ctx context.Context, in *MsgRequestUpdateAccount, opts ...grpc.CallOption) (*MsgRequestUpdateAccountResponse, error)
+	RequestAddValidator(ctx context.Context, in *MsgRequestAddValidator, opts ...grpc.CallOption) (*MsgRequestAddValidatorResponse, error)
+	RequestRemoveValidator(ctx context.Context, in *MsgRequestRemoveValidator, opts ...grpc.CallOption) (*MsgRequestRemoveValidatorResponse, error)
+	RequestUpdateValidatorPeer(ctx context.Context, in *MsgRequestUpdateValidatorPeer, opts ...grpc.CallOption) (*MsgRequestUpdateValidatorPeerResponse, error)
+	RequestUpdateValidatorGentx(ctx context.Context, in *MsgRequestUpdateValidatorGentx, opts ...grpc.CallOption) (*MsgRequestUpdateValidatorGentxResponse, error)
+	SettleRequest(ctx context.Context, in *MsgSettleRequest, opts ...grpc.CallOption) (*MsgSettleRequestResponse, error)
+	SettleRequests(ctx context.Context, in *MsgSettleRequests, opts ...grpc.CallOption) (*MsgSettleRequestsResponse, error)
+	CancelRequest(ctx context.Context, in *MsgCancelRequest, opts ...grpc.CallOption) (*MsgCancelRequestResponse, error)
+	TriggerLaunch(ctx context.Context, in *MsgTriggerLaunch, opts ...grpc.CallOption) (*MsgTriggerLaunchResponse, error)
+	RevertLaunch(ctx context.Context, in *MsgRevertLaunch, opts ...grpc.CallOption) (*MsgRevertLaunchResponse, error)
+	SetApprovalPolicy(ctx context.Context, in *MsgSetApprovalPolicy, opts ...grpc.CallOption) (*MsgSetApprovalPolicyResponse, error)
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
-func (m *MsgRequestRemoveAccount) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreateChain(ctx context.Context, in *MsgCreateChain, opts ...grpc.CallOption) (*MsgCreateChainResponse, error) {
+	out := new(MsgCreateChainResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.launch.Msg/CreateChain", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgRequestRemoveAccount) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) EditChain(ctx context.Context, in *MsgEditChain, opts ...grpc.CallOption) (*MsgEditChainResponse, error) {
+	out := new(MsgEditChainResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.launch.Msg/EditChain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRequestRemoveAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.LaunchID != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.LaunchID))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) UpdateLaunchInformation(ctx context.Context, in *MsgUpdateLaunchInformation, opts ...grpc.CallOption) (*MsgUpdateLaunchInformationResponse, error) {
+	out := new(MsgUpdateLaunchInformationResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.launch.Msg/UpdateLaunchInformation", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgRequestRemoveAccountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RequestAddAccount(ctx context.Context, in *MsgRequestAddAccount, opts ...grpc.CallOption) (*MsgRequestAddAccountResponse, error) {
+	out := new(MsgRequestAddAccountResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.launch.Msg/RequestAddAccount", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgRequestRemoveAccountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RequestAddVestingAccount(ctx context.Context, in *MsgRequestAddVestingAccount, opts ...grpc.CallOption) (*MsgRequestAddVestingAccountResponse, error) {
+	out := new(MsgRequestAddVestingAccountResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.launch.Msg/RequestAddVestingAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRequestRemoveAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AutoApproved {
-		i--
-		if m.AutoApproved {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.RequestID != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.RequestID))
-		i--
-		dAtA[i] = 0x8
+func (c *msgClient) RequestRemoveAccount(ctx context.Context, in *MsgRequestRemoveAccount, opts ...grpc.CallOption) (*MsgRequestRemoveAccountResponse, error) {
+	out := new(MsgRequestRemoveAccountResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.launch.Msg/RequestRemoveAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgRequestAddValidator) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RequestUpdateAccount(ctx context.Context, in *MsgRequestUpdateAccount, opts ...grpc.CallOption) (*MsgRequestUpdateAccountResponse, error) {
+	out := new(MsgRequestUpdateAccountResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.launch.Msg/RequestUpdateAccount", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgRequestAddValidator) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RequestAddValidator(ctx context.Context, in *MsgRequestAddValidator, opts ...grpc.CallOption) (*MsgRequestAddValidatorResponse, error) {
+	out := new(MsgRequestAddValidatorResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.launch.Msg/RequestAddValidator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRequestAddValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Peer.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size, err := m.SelfDelegation.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	if len(m.ConsPubKey) > 0 {
-		i -= len(m.ConsPubKey)
-		copy(dAtA[i:], m.ConsPubKey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ConsPubKey)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.GenTx) > 0 {
-		i -= len(m.GenTx)
-		copy(dAtA[i:], m.GenTx)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.GenTx)))
-		i--
-		dAtA[i] = 0x22
+func (c *msgClient) RequestRemoveValidator(ctx context.Context, in *MsgRequestRemoveValidator, opts ...grpc.CallOption) (*MsgRequestRemoveValidatorResponse, error) {
+	out := new(MsgRequestRemoveValidatorResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.launch.Msg/RequestRemoveValidator", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.ValAddress) > 0 {
-		i -= len(m.ValAddress)
-		copy(dAtA[i:], m.ValAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ValAddress)))
-		i--
-		dAtA[i] = 0x1a
+	return out, nil
+}
+
+func (c *msgClient) RequestUpdateValidatorPeer(ctx context.Context, in *MsgRequestUpdateValidatorPeer, opts ...grpc.CallOption) (*MsgRequestUpdateValidatorPeerResponse, error) {
+	out := new(MsgRequestUpdateValidatorPeerResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.launch.Msg/RequestUpdateValidatorPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.LaunchID != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.LaunchID))
-		i--
-		dAtA[i] = 0x10
+	return out, nil
+}
+
+func (c *msgClient) RequestUpdateValidatorGentx(ctx context.Context, in *MsgRequestUpdateValidatorGentx, opts ...grpc.CallOption) (*MsgRequestUpdateValidatorGentxResponse, error) {
+	out := new(MsgRequestUpdateValidatorGentxResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.launch.Msg/RequestUpdateValidatorGentx", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) SettleRequest(ctx context.Context, in *MsgSettleRequest, opts ...grpc.CallOption) (*MsgSettleRequestResponse, error) {
+	out := new(MsgSettleRequestResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.launch.Msg/SettleRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgRequestAddValidatorResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SettleRequests(ctx context.Context, in *MsgSettleRequests, opts ...grpc.CallOption) (*MsgSettleRequestsResponse, error) {
+	out := new(MsgSettleRequestsResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.launch.Msg/SettleRequests", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgRequestAddValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CancelRequest(ctx context.Context, in *MsgCancelRequest, opts ...grpc.CallOption) (*MsgCancelRequestResponse, error) {
+	out := new(MsgCancelRequestResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.launch.Msg/CancelRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRequestAddValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AutoApproved {
-		i--
-		if m.AutoApproved {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.RequestID != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.RequestID))
-		i--
-		dAtA[i] = 0x8
+func (c *msgClient) TriggerLaunch(ctx context.Context, in *MsgTriggerLaunch, opts ...grpc.CallOption) (*MsgTriggerLaunchResponse, error) {
+	out := new(MsgTriggerLaunchResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.launch.Msg/TriggerLaunch", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgRequestRemoveValidator) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RevertLaunch(ctx context.Context, in *MsgRevertLaunch, opts ...grpc.CallOption) (*MsgRevertLaunchResponse, error) {
+	out := new(MsgRevertLaunchResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.launch.Msg/RevertLaunch", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgRequestRemoveValidator) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgRequestRemoveValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.LaunchID != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.LaunchID))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) SetApprovalPolicy(ctx context.Context, in *MsgSetApprovalPolicy, opts ...grpc.CallOption) (*MsgSetApprovalPolicyResponse, error) {
+	out := new(MsgSetApprovalPolicyResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.launch.Msg/SetApprovalPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgRequestRemoveValidatorResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.launch.Msg/UpdateParams", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgRequestRemoveValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// this line is used by starport scaffolding # proto/tx/rpc
+	CreateChain(context.Context, *MsgCreateChain) (*MsgCreateChainResponse, error)
+	EditChain(context.Context, *MsgEditChain) (*MsgEditChainResponse, error)
+	UpdateLaunchInformation(context.Context, *MsgUpdateLaunchInformation) (*MsgUpdateLaunchInformationResponse, error)
+	RequestAddAccount(context.Context, *MsgRequestAddAccount) (*MsgRequestAddAccountResponse, error)
+	RequestAddVestingAccount(context.Context, *MsgRequestAddVestingAccount) (*MsgRequestAddVestingAccountResponse, error)
+	RequestRemoveAccount(context.Context, *MsgRequestRemoveAccount) (*MsgRequestRemoveAccountResponse, error)
+	RequestUpdateAccount(context.Context, *MsgRequestUpdateAccount) (*MsgRequestUpdateAccountResponse, error)
+	RequestAddValidator(context.Context, *MsgRequestAddValidator) (*MsgRequestAddValidatorResponse, error)
+	RequestRemoveValidator(context.Context, *MsgRequestRemoveValidator) (*MsgRequestRemoveValidatorResponse, error)
+	RequestUpdateValidatorPeer(context.Context, *MsgRequestUpdateValidatorPeer) (*MsgRequestUpdateValidatorPeerResponse, error)
+	RequestUpdateValidatorGentx(context.Context, *MsgRequestUpdateValidatorGentx) (*MsgRequestUpdateValidatorGentxResponse, error)
+	SettleRequest(context.Context, *MsgSettleRequest) (*MsgSettleRequestResponse, error)
+	SettleRequests(context.Context, *MsgSettleRequests) (*MsgSettleRequestsResponse, error)
+	CancelRequest(context.Context, *MsgCancelRequest) (*MsgCancelRequestResponse, error)
+	TriggerLaunch(context.Context, *MsgTriggerLaunch) (*MsgTriggerLaunchResponse, error)
+	RevertLaunch(context.Context, *MsgRevertLaunch) (*MsgRevertLaunchResponse, error)
+	SetApprovalPolicy(context.Context, *MsgSetApprovalPolicy) (*MsgSetApprovalPolicyResponse, error)
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
-func (m *MsgRequestRemoveValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AutoApproved {
-		i--
-		if m.AutoApproved {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.RequestID != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.RequestID))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgSettleRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) CreateChain(ctx context.Context, req *MsgCreateChain) (*MsgCreateChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateChain not implemented")
+}
+func (*UnimplementedMsgServer) EditChain(ctx context.Context, req *MsgEditChain) (*MsgEditChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditChain not implemented")
+}
+func (*UnimplementedMsgServer) UpdateLaunchInformation(ctx context.Context, req *MsgUpdateLaunchInformation) (*MsgUpdateLaunchInformationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLaunchInformation not implemented")
+}
+func (*UnimplementedMsgServer) RequestAddAccount(ctx context.Context, req *MsgRequestAddAccount) (*MsgRequestAddAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestAddAccount not implemented")
+}
+func (*UnimplementedMsgServer) RequestAddVestingAccount(ctx context.Context, req *MsgRequestAddVestingAccount) (*MsgRequestAddVestingAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestAddVestingAccount not implemented")
+}
+func (*UnimplementedMsgServer) RequestRemoveAccount(ctx context.Context, req *MsgRequestRemoveAccount) (*MsgRequestRemoveAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestRemoveAccount not implemented")
+}
+func (*UnimplementedMsgServer) RequestUpdateAccount(ctx context.Context, req *MsgRequestUpdateAccount) (*MsgRequestUpdateAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestUpdateAccount not implemented")
+}
+func (*UnimplementedMsgServer) RequestAddValidator(ctx context.Context, req *MsgRequestAddValidator) (*MsgRequestAddValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestAddValidator not implemented")
+}
+func (*UnimplementedMsgServer) RequestRemoveValidator(ctx context.Context, req *MsgRequestRemoveValidator) (*MsgRequestRemoveValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestRemoveValidator not implemented")
+}
+func (*UnimplementedMsgServer) RequestUpdateValidatorPeer(ctx context.Context, req *MsgRequestUpdateValidatorPeer) (*MsgRequestUpdateValidatorPeerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestUpdateValidatorPeer not implemented")
+}
+func (*UnimplementedMsgServer) RequestUpdateValidatorGentx(ctx context.Context, req *MsgRequestUpdateValidatorGentx) (*MsgRequestUpdateValidatorGentxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestUpdateValidatorGentx not implemented")
+}
+func (*UnimplementedMsgServer) SettleRequest(ctx context.Context, req *MsgSettleRequest) (*MsgSettleRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettleRequest not implemented")
+}
+func (*UnimplementedMsgServer) SettleRequests(ctx context.Context, req *MsgSettleRequests) (*MsgSettleRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettleRequests not implemented")
+}
+func (*UnimplementedMsgServer) CancelRequest(ctx context.Context, req *MsgCancelRequest) (*MsgCancelRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRequest not implemented")
+}
+func (*UnimplementedMsgServer) TriggerLaunch(ctx context.Context, req *MsgTriggerLaunch) (*MsgTriggerLaunchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TriggerLaunch not implemented")
+}
+func (*UnimplementedMsgServer) RevertLaunch(ctx context.Context, req *MsgRevertLaunch) (*MsgRevertLaunchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertLaunch not implemented")
+}
+func (*UnimplementedMsgServer) SetApprovalPolicy(ctx context.Context, req *MsgSetApprovalPolicy) (*MsgSetApprovalPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetApprovalPolicy not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
 
-func (m *MsgSettleRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func (m *MsgSettleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Approve {
-		i--
-		if m.Approve {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
+func _Msg_CreateChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateChain)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.RequestID != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.RequestID))
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(MsgServer).CreateChain(ctx, in)
 	}
-	if m.LaunchID != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.LaunchID))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.launch.Msg/CreateChain",
 	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateChain(ctx, req.(*MsgCreateChain))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSettleRequestResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_EditChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEditChain)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgSettleRequestResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgSettleRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).EditChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.launch.Msg/EditChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EditChain(ctx, req.(*MsgEditChain))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgTriggerLaunch) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_UpdateLaunchInformation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateLaunchInformation)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgTriggerLaunch) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgTriggerLaunch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LaunchTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LaunchTime):])
-	if err5 != nil {
-		return 0, err5
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateLaunchInformation(ctx, in)
 	}
-	i -= n5
-	i = encodeVarintTx(dAtA, i, uint64(n5))
-	i--
-	dAtA[i] = 0x1a
-	if m.LaunchID != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.LaunchID))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.launch.Msg/UpdateLaunchInformation",
 	}
-	if len(m.Coordinator) > 0 {
-		i -= len(m.Coordinator)
-		copy(dAtA[i:], m.Coordinator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Coordinator)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateLaunchInformation(ctx, req.(*MsgUpdateLaunchInformation))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgTriggerLaunchResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_RequestAddAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRequestAddAccount)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgTriggerLaunchResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgTriggerLaunchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).RequestAddAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.launch.Msg/RequestAddAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RequestAddAccount(ctx, req.(*MsgRequestAddAccount))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRevertLaunch) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_RequestAddVestingAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRequestAddVestingAccount)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgRevertLaunch) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgRevertLaunch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LaunchID != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.LaunchID))
-		i--
-		dAtA[i] = 0x10
+	if interceptor == nil {
+		return srv.(MsgServer).RequestAddVestingAccount(ctx, in)
 	}
-	if len(m.Coordinator) > 0 {
-		i -= len(m.Coordinator)
-		copy(dAtA[i:], m.Coordinator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Coordinator)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.launch.Msg/RequestAddVestingAccount",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RequestAddVestingAccount(ctx, req.(*MsgRequestAddVestingAccount))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRevertLaunchResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_RequestRemoveAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRequestRemoveAccount)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgRevertLaunchResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgRevertLaunchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).RequestRemoveAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.launch.Msg/RequestRemoveAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RequestRemoveAccount(ctx, req.(*MsgRequestRemoveAccount))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func _Msg_RequestUpdateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRequestUpdateAccount)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgCreateChain) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).RequestUpdateAccount(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Coordinator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.launch.Msg/RequestUpdateAccount",
 	}
-	l = len(m.GenesisChainID)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RequestUpdateAccount(ctx, req.(*MsgRequestUpdateAccount))
 	}
-	l = len(m.SourceURL)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RequestAddValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRequestAddValidator)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.SourceHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).RequestAddValidator(ctx, in)
 	}
-	l = len(m.GenesisURL)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.launch.Msg/RequestAddValidator",
 	}
-	l = len(m.GenesisHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RequestAddValidator(ctx, req.(*MsgRequestAddValidator))
 	}
-	if m.HasCampaign {
-		n += 2
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RequestRemoveValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRequestRemoveValidator)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.CampaignID != 0 {
-		n += 1 + sovTx(uint64(m.CampaignID))
+	if interceptor == nil {
+		return srv.(MsgServer).RequestRemoveValidator(ctx, in)
 	}
-	if len(m.AccountBalance) > 0 {
-		for _, e := range m.AccountBalance {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.launch.Msg/RequestRemoveValidator",
 	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RequestRemoveValidator(ctx, req.(*MsgRequestRemoveValidator))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateChainResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RequestUpdateValidatorPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRequestUpdateValidatorPeer)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.LaunchID != 0 {
-		n += 1 + sovTx(uint64(m.LaunchID))
+	if interceptor == nil {
+		return srv.(MsgServer).RequestUpdateValidatorPeer(ctx, in)
 	}
-	return n
-}
-
-func (m *MsgEditChain) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.launch.Msg/RequestUpdateValidatorPeer",
 	}
-	var l int
-	_ = l
-	l = len(m.Coordinator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RequestUpdateValidatorPeer(ctx, req.(*MsgRequestUpdateValidatorPeer))
 	}
-	if m.LaunchID != 0 {
-		n += 1 + sovTx(uint64(m.LaunchID))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RequestUpdateValidatorGentx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRequestUpdateValidatorGentx)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.SetCampaignID {
-		n += 2
+	if interceptor == nil {
+		return srv.(MsgServer).RequestUpdateValidatorGentx(ctx, in)
 	}
-	if m.CampaignID != 0 {
-		n += 1 + sovTx(uint64(m.CampaignID))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.launch.Msg/RequestUpdateValidatorGentx",
 	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RequestUpdateValidatorGentx(ctx, req.(*MsgRequestUpdateValidatorGentx))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgEditChainResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgUpdateLaunchInformation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Coordinator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.LaunchID != 0 {
-		n += 1 + sovTx(uint64(m.LaunchID))
-	}
-	l = len(m.GenesisChainID)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.SourceURL)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func _Msg_SettleRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSettleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.SourceHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).SettleRequest(ctx, in)
 	}
-	if m.InitialGenesis != nil {
-		l = m.InitialGenesis.Size()
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.launch.Msg/SettleRequest",
 	}
-	return n
-}
-
-func (m *MsgUpdateLaunchInformationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SettleRequest(ctx, req.(*MsgSettleRequest))
 	}
-	var l int
-	_ = l
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRequestAddAccount) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.LaunchID != 0 {
-		n += 1 + sovTx(uint64(m.LaunchID))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+func _Msg_SettleRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSettleRequests)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
-}
-
-func (m *MsgRequestAddAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).SettleRequests(ctx, in)
 	}
-	var l int
-	_ = l
-	if m.RequestID != 0 {
-		n += 1 + sovTx(uint64(m.RequestID))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.launch.Msg/SettleRequests",
 	}
-	if m.AutoApproved {
-		n += 2
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SettleRequests(ctx, req.(*MsgSettleRequests))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRequestAddVestingAccount) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.LaunchID != 0 {
-		n += 1 + sovTx(uint64(m.LaunchID))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func _Msg_CancelRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = m.Options.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
-
-func (m *MsgRequestAddVestingAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).CancelRequest(ctx, in)
 	}
-	var l int
-	_ = l
-	if m.RequestID != 0 {
-		n += 1 + sovTx(uint64(m.RequestID))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.launch.Msg/CancelRequest",
 	}
-	if m.AutoApproved {
-		n += 2
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelRequest(ctx, req.(*MsgCancelRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRequestRemoveAccount) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.LaunchID != 0 {
-		n += 1 + sovTx(uint64(m.LaunchID))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func _Msg_TriggerLaunch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTriggerLaunch)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
-}
-
-func (m *MsgRequestRemoveAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).TriggerLaunch(ctx, in)
 	}
-	var l int
-	_ = l
-	if m.RequestID != 0 {
-		n += 1 + sovTx(uint64(m.RequestID))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.launch.Msg/TriggerLaunch",
 	}
-	if m.AutoApproved {
-		n += 2
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TriggerLaunch(ctx, req.(*MsgTriggerLaunch))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRequestAddValidator) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.LaunchID != 0 {
-		n += 1 + sovTx(uint64(m.LaunchID))
+func _Msg_RevertLaunch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevertLaunch)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.ValAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).RevertLaunch(ctx, in)
 	}
-	l = len(m.GenTx)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.launch.Msg/RevertLaunch",
 	}
-	l = len(m.ConsPubKey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevertLaunch(ctx, req.(*MsgRevertLaunch))
 	}
-	l = m.SelfDelegation.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Peer.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRequestAddValidatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SetApprovalPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetApprovalPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.RequestID != 0 {
-		n += 1 + sovTx(uint64(m.RequestID))
+	if interceptor == nil {
+		return srv.(MsgServer).SetApprovalPolicy(ctx, in)
 	}
-	if m.AutoApproved {
-		n += 2
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.launch.Msg/SetApprovalPolicy",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetApprovalPolicy(ctx, req.(*MsgSetApprovalPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRequestRemoveValidator) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
 	}
-	if m.LaunchID != 0 {
-		n += 1 + sovTx(uint64(m.LaunchID))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.launch.Msg/UpdateParams",
 	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRequestRemoveValidatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.RequestID != 0 {
-		n += 1 + sovTx(uint64(m.RequestID))
-	}
-	if m.AutoApproved {
-		n += 2
-	}
-	return n
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "tendermint.spn.launch.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateChain",
+			Handler:    _Msg_CreateChain_Handler,
+		},
+		{
+			MethodName: "EditChain",
+			Handler:    _Msg_EditChain_Handler,
+		},
+		{
+			MethodName: "UpdateLaunchInformation",
+			Handler:    _Msg_UpdateLaunchInformation_Handler,
+		},
+		{
+			MethodName: "RequestAddAccount",
+			Handler:    _Msg_RequestAddAccount_Handler,
+		},
+		{
+			MethodName: "RequestAddVestingAccount",
+			Handler:    _Msg_RequestAddVestingAccount_Handler,
+		},
+		{
+			MethodName: "RequestRemoveAccount",
+			Handler:    _Msg_RequestRemoveAccount_Handler,
+		},
+		{
+			MethodName: "RequestUpdateAccount",
+			Handler:    _Msg_RequestUpdateAccount_Handler,
+		},
+		{
+			MethodName: "RequestAddValidator",
+			Handler:    _Msg_RequestAddValidator_Handler,
+		},
+		{
+			MethodName: "RequestRemoveValidator",
+			Handler:    _Msg_RequestRemoveValidator_Handler,
+		},
+		{
+			MethodName: "RequestUpdateValidatorPeer",
+			Handler:    _Msg_RequestUpdateValidatorPeer_Handler,
+		},
+		{
+			MethodName: "RequestUpdateValidatorGentx",
+			Handler:    _Msg_RequestUpdateValidatorGentx_Handler,
+		},
+		{
+			MethodName: "SettleRequest",
+			Handler:    _Msg_SettleRequest_Handler,
+		},
+		{
+			MethodName: "SettleRequests",
+			Handler:    _Msg_SettleRequests_Handler,
+		},
+		{
+			MethodName: "CancelRequest",
+			Handler:    _Msg_CancelRequest_Handler,
+		},
+		{
+			MethodName: "TriggerLaunch",
+			Handler:    _Msg_TriggerLaunch_Handler,
+		},
+		{
+			MethodName: "RevertLaunch",
+			Handler:    _Msg_RevertLaunch_Handler,
+		},
+		{
+			MethodName: "SetApprovalPolicy",
+			Handler:    _Msg_SetApprovalPolicy_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "launch/tx.proto",
 }
 
-func (m *MsgSettleRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreateChain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateChain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateChain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x52
 	}
-	if m.LaunchID != 0 {
-		n += 1 + sovTx(uint64(m.LaunchID))
+	if len(m.AccountBalance) > 0 {
+		for iNdEx := len(m.AccountBalance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccountBalance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
 	}
-	if m.RequestID != 0 {
-		n += 1 + sovTx(uint64(m.RequestID))
+	if m.CampaignID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CampaignID))
+		i--
+		dAtA[i] = 0x40
 	}
-	if m.Approve {
-		n += 2
+	if m.HasCampaign {
+		i--
+		if m.HasCampaign {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
 	}
-	return n
-}
-
-func (m *MsgSettleRequestResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.GenesisHash) > 0 {
+		i -= len(m.GenesisHash)
+		copy(dAtA[i:], m.GenesisHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GenesisHash)))
+		i--
+		dAtA[i] = 0x32
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgTriggerLaunch) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.GenesisURL) > 0 {
+		i -= len(m.GenesisURL)
+		copy(dAtA[i:], m.GenesisURL)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GenesisURL)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	var l int
-	_ = l
-	l = len(m.Coordinator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.SourceHash) > 0 {
+		i -= len(m.SourceHash)
+		copy(dAtA[i:], m.SourceHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SourceHash)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.LaunchID != 0 {
-		n += 1 + sovTx(uint64(m.LaunchID))
+	if len(m.SourceURL) > 0 {
+		i -= len(m.SourceURL)
+		copy(dAtA[i:], m.SourceURL)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SourceURL)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LaunchTime)
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	if len(m.GenesisChainID) > 0 {
+		i -= len(m.GenesisChainID)
+		copy(dAtA[i:], m.GenesisChainID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GenesisChainID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Coordinator) > 0 {
+		i -= len(m.Coordinator)
+		copy(dAtA[i:], m.Coordinator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Coordinator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgTriggerLaunchResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreateChainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgRevertLaunch) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgCreateChainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Coordinator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
 	if m.LaunchID != 0 {
-		n += 1 + sovTx(uint64(m.LaunchID))
+		i = encodeVarintTx(dAtA, i, uint64(m.LaunchID))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRevertLaunchResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgEditChain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *MsgEditChain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *MsgCreateChain) Unmarshal(dAtA []byte) error {
+
+func (m *MsgEditChain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.CampaignID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CampaignID))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SetCampaignID {
+		i--
+		if m.SetCampaignID {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LaunchID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LaunchID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Coordinator) > 0 {
+		i -= len(m.Coordinator)
+		copy(dAtA[i:], m.Coordinator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Coordinator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEditChainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEditChainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEditChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateLaunchInformation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateLaunchInformation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateLaunchInformation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InitialGenesis != nil {
+		{
+			size, err := m.InitialGenesis.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.SourceHash) > 0 {
+		i -= len(m.SourceHash)
+		copy(dAtA[i:], m.SourceHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SourceHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SourceURL) > 0 {
+		i -= len(m.SourceURL)
+		copy(dAtA[i:], m.SourceURL)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SourceURL)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.GenesisChainID) > 0 {
+		i -= len(m.GenesisChainID)
+		copy(dAtA[i:], m.GenesisChainID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GenesisChainID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.LaunchID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LaunchID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Coordinator) > 0 {
+		i -= len(m.Coordinator)
+		copy(dAtA[i:], m.Coordinator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Coordinator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateLaunchInformationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateLaunchInformationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateLaunchInformationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestAddAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestAddAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestAddAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.LaunchID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LaunchID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestAddAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestAddAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestAddAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AutoApproved {
+		i--
+		if m.AutoApproved {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RequestID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RequestID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestAddVestingAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestAddVestingAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestAddVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Options.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.LaunchID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LaunchID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestAddVestingAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestAddVestingAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestAddVestingAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AutoApproved {
+		i--
+		if m.AutoApproved {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RequestID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RequestID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestRemoveAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestRemoveAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestRemoveAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.LaunchID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LaunchID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestRemoveAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestRemoveAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestRemoveAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AutoApproved {
+		i--
+		if m.AutoApproved {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RequestID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RequestID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestUpdateAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestUpdateAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestUpdateAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VestingOptions != nil {
+		{
+			size, err := m.VestingOptions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.LaunchID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LaunchID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestUpdateAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestUpdateAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestUpdateAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AutoApproved {
+		i--
+		if m.AutoApproved {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RequestID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RequestID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestAddValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestAddValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestAddValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Peer.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.SelfDelegation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.ConsPubKey) > 0 {
+		i -= len(m.ConsPubKey)
+		copy(dAtA[i:], m.ConsPubKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConsPubKey)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.GenTx) > 0 {
+		i -= len(m.GenTx)
+		copy(dAtA[i:], m.GenTx)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GenTx)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ValAddress) > 0 {
+		i -= len(m.ValAddress)
+		copy(dAtA[i:], m.ValAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.LaunchID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LaunchID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestAddValidatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestAddValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestAddValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AutoApproved {
+		i--
+		if m.AutoApproved {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RequestID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RequestID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestRemoveValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestRemoveValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestRemoveValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.LaunchID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LaunchID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestRemoveValidatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestRemoveValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestRemoveValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AutoApproved {
+		i--
+		if m.AutoApproved {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RequestID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RequestID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestUpdateValidatorPeer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestUpdateValidatorPeer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestUpdateValidatorPeer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Peer.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ValAddress) > 0 {
+		i -= len(m.ValAddress)
+		copy(dAtA[i:], m.ValAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.LaunchID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LaunchID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestUpdateValidatorPeerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestUpdateValidatorPeerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestUpdateValidatorPeerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AutoApproved {
+		i--
+		if m.AutoApproved {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RequestID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RequestID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestUpdateValidatorGentx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestUpdateValidatorGentx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestUpdateValidatorGentx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SelfDelegation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.ConsPubKey) > 0 {
+		i -= len(m.ConsPubKey)
+		copy(dAtA[i:], m.ConsPubKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConsPubKey)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.GenTx) > 0 {
+		i -= len(m.GenTx)
+		copy(dAtA[i:], m.GenTx)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GenTx)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ValAddress) > 0 {
+		i -= len(m.ValAddress)
+		copy(dAtA[i:], m.ValAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.LaunchID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LaunchID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestUpdateValidatorGentxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestUpdateValidatorGentxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestUpdateValidatorGentxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AutoApproved {
+		i--
+		if m.AutoApproved {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RequestID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RequestID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSettleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSettleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSettleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Approve {
+		i--
+		if m.Approve {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RequestID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RequestID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LaunchID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LaunchID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSettleRequestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSettleRequestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSettleRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSettleRequests) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSettleRequests) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSettleRequests) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BestEffort {
+		i--
+		if m.BestEffort {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.RequestIDRange != nil {
+		{
+			size, err := m.RequestIDRange.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Settlements) > 0 {
+		for iNdEx := len(m.Settlements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Settlements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.LaunchID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LaunchID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSettleRequestsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSettleRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSettleRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Failures) > 0 {
+		for iNdEx := len(m.Failures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Failures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.SettledRequestIDs) > 0 {
+		dAtA10 := make([]byte, len(m.SettledRequestIDs)*10)
+		var j9 int
+		for _, num := range m.SettledRequestIDs {
+			for num >= 1<<7 {
+				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j9++
+			}
+			dAtA10[j9] = uint8(num)
+			j9++
+		}
+		i -= j9
+		copy(dAtA[i:], dAtA10[:j9])
+		i = encodeVarintTx(dAtA, i, uint64(j9))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RequestSettlement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestSettlement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestSettlement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Approve {
+		i--
+		if m.Approve {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RequestID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RequestID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RequestIDRange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestIDRange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestIDRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Approve {
+		i--
+		if m.Approve {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.End != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.End))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Start != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Start))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RequestSettlementFailure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestSettlementFailure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestSettlementFailure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.RequestID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RequestID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RequestID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RequestID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LaunchID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LaunchID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelRequestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRequestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTriggerLaunch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTriggerLaunch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTriggerLaunch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LaunchTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LaunchTime):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintTx(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x1a
+	if m.LaunchID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LaunchID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Coordinator) > 0 {
+		i -= len(m.Coordinator)
+		copy(dAtA[i:], m.Coordinator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Coordinator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTriggerLaunchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTriggerLaunchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTriggerLaunchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevertLaunch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevertLaunch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevertLaunch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LaunchID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LaunchID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Coordinator) > 0 {
+		i -= len(m.Coordinator)
+		copy(dAtA[i:], m.Coordinator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Coordinator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevertLaunchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevertLaunchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevertLaunchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetApprovalPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetApprovalPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetApprovalPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Allowlist) > 0 {
+		for iNdEx := len(m.Allowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Allowlist[iNdEx])
+			copy(dAtA[i:], m.Allowlist[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Allowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.MinSelfDelegation != nil {
+		{
+			size, err := m.MinSelfDelegation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MaxAccountCoins) > 0 {
+		for iNdEx := len(m.MaxAccountCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxAccountCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.LaunchID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LaunchID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Coordinator) > 0 {
+		i -= len(m.Coordinator)
+		copy(dAtA[i:], m.Coordinator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Coordinator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetApprovalPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetApprovalPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetApprovalPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateChain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Coordinator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GenesisChainID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SourceURL)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SourceHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GenesisURL)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GenesisHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.HasCampaign {
+		n += 2
+	}
+	if m.CampaignID != 0 {
+		n += 1 + sovTx(uint64(m.CampaignID))
+	}
+	if len(m.AccountBalance) > 0 {
+		for _, e := range m.AccountBalance {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LaunchID != 0 {
+		n += 1 + sovTx(uint64(m.LaunchID))
+	}
+	return n
+}
+
+func (m *MsgEditChain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Coordinator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LaunchID != 0 {
+		n += 1 + sovTx(uint64(m.LaunchID))
+	}
+	if m.SetCampaignID {
+		n += 2
+	}
+	if m.CampaignID != 0 {
+		n += 1 + sovTx(uint64(m.CampaignID))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgEditChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateLaunchInformation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Coordinator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LaunchID != 0 {
+		n += 1 + sovTx(uint64(m.LaunchID))
+	}
+	l = len(m.GenesisChainID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SourceURL)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SourceHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.InitialGenesis != nil {
+		l = m.InitialGenesis.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateLaunchInformationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRequestAddAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LaunchID != 0 {
+		n += 1 + sovTx(uint64(m.LaunchID))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRequestAddAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequestID != 0 {
+		n += 1 + sovTx(uint64(m.RequestID))
+	}
+	if m.AutoApproved {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgRequestAddVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LaunchID != 0 {
+		n += 1 + sovTx(uint64(m.LaunchID))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Options.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRequestAddVestingAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequestID != 0 {
+		n += 1 + sovTx(uint64(m.RequestID))
+	}
+	if m.AutoApproved {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgRequestRemoveAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LaunchID != 0 {
+		n += 1 + sovTx(uint64(m.LaunchID))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRequestRemoveAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequestID != 0 {
+		n += 1 + sovTx(uint64(m.RequestID))
+	}
+	if m.AutoApproved {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgRequestUpdateAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LaunchID != 0 {
+		n += 1 + sovTx(uint64(m.LaunchID))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.VestingOptions != nil {
+		l = m.VestingOptions.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRequestUpdateAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequestID != 0 {
+		n += 1 + sovTx(uint64(m.RequestID))
+	}
+	if m.AutoApproved {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgRequestAddValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LaunchID != 0 {
+		n += 1 + sovTx(uint64(m.LaunchID))
+	}
+	l = len(m.ValAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GenTx)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConsPubKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.SelfDelegation.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Peer.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRequestAddValidatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequestID != 0 {
+		n += 1 + sovTx(uint64(m.RequestID))
+	}
+	if m.AutoApproved {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgRequestRemoveValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LaunchID != 0 {
+		n += 1 + sovTx(uint64(m.LaunchID))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRequestRemoveValidatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequestID != 0 {
+		n += 1 + sovTx(uint64(m.RequestID))
+	}
+	if m.AutoApproved {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgRequestUpdateValidatorPeer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LaunchID != 0 {
+		n += 1 + sovTx(uint64(m.LaunchID))
+	}
+	l = len(m.ValAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Peer.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRequestUpdateValidatorPeerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequestID != 0 {
+		n += 1 + sovTx(uint64(m.RequestID))
+	}
+	if m.AutoApproved {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgRequestUpdateValidatorGentx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LaunchID != 0 {
+		n += 1 + sovTx(uint64(m.LaunchID))
+	}
+	l = len(m.ValAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GenTx)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConsPubKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.SelfDelegation.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRequestUpdateValidatorGentxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequestID != 0 {
+		n += 1 + sovTx(uint64(m.RequestID))
+	}
+	if m.AutoApproved {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSettleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LaunchID != 0 {
+		n += 1 + sovTx(uint64(m.LaunchID))
+	}
+	if m.RequestID != 0 {
+		n += 1 + sovTx(uint64(m.RequestID))
+	}
+	if m.Approve {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSettleRequestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSettleRequests) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LaunchID != 0 {
+		n += 1 + sovTx(uint64(m.LaunchID))
+	}
+	if len(m.Settlements) > 0 {
+		for _, e := range m.Settlements {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.RequestIDRange != nil {
+		l = m.RequestIDRange.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BestEffort {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSettleRequestsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SettledRequestIDs) > 0 {
+		l = 0
+		for _, e := range m.SettledRequestIDs {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.Failures) > 0 {
+		for _, e := range m.Failures {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RequestSettlement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequestID != 0 {
+		n += 1 + sovTx(uint64(m.RequestID))
+	}
+	if m.Approve {
+		n += 2
+	}
+	return n
+}
+
+func (m *RequestIDRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Start != 0 {
+		n += 1 + sovTx(uint64(m.Start))
+	}
+	if m.End != 0 {
+		n += 1 + sovTx(uint64(m.End))
+	}
+	if m.Approve {
+		n += 2
+	}
+	return n
+}
+
+func (m *RequestSettlementFailure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequestID != 0 {
+		n += 1 + sovTx(uint64(m.RequestID))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LaunchID != 0 {
+		n += 1 + sovTx(uint64(m.LaunchID))
+	}
+	if m.RequestID != 0 {
+		n += 1 + sovTx(uint64(m.RequestID))
+	}
+	return n
+}
+
+func (m *MsgCancelRequestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTriggerLaunch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Coordinator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LaunchID != 0 {
+		n += 1 + sovTx(uint64(m.LaunchID))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LaunchTime)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgTriggerLaunchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevertLaunch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Coordinator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LaunchID != 0 {
+		n += 1 + sovTx(uint64(m.LaunchID))
+	}
+	return n
+}
+
+func (m *MsgRevertLaunchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetApprovalPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Coordinator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LaunchID != 0 {
+		n += 1 + sovTx(uint64(m.LaunchID))
+	}
+	if len(m.MaxAccountCoins) > 0 {
+		for _, e := range m.MaxAccountCoins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.MinSelfDelegation != nil {
+		l = m.MinSelfDelegation.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Allowlist) > 0 {
+		for _, s := range m.Allowlist {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetApprovalPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateChain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateChain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateChain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coordinator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coordinator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GenesisChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GenesisURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GenesisHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasCampaign", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasCampaign = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignID", wireType)
+			}
+			m.CampaignID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountBalance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountBalance = append(m.AccountBalance, github_com_cosmos_cosmos_sdk_types.Coin{})
+			if err := m.AccountBalance[len(m.AccountBalance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = append(m.Metadata[:0], dAtA[iNdEx:postIndex]...)
+			if m.Metadata == nil {
+				m.Metadata = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateChainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateChainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LaunchID", wireType)
+			}
+			m.LaunchID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LaunchID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEditChain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditChain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditChain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coordinator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coordinator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LaunchID", wireType)
+			}
+			m.LaunchID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LaunchID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetCampaignID", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SetCampaignID = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignID", wireType)
+			}
+			m.CampaignID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = append(m.Metadata[:0], dAtA[iNdEx:postIndex]...)
+			if m.Metadata == nil {
+				m.Metadata = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEditChainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditChainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateLaunchInformation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateLaunchInformation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateLaunchInformation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coordinator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coordinator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LaunchID", wireType)
+			}
+			m.LaunchID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LaunchID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GenesisChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialGenesis", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InitialGenesis == nil {
+				m.InitialGenesis = &InitialGenesis{}
+			}
+			if err := m.InitialGenesis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateLaunchInformationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateLaunchInformationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateLaunchInformationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRequestAddAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestAddAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestAddAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LaunchID", wireType)
+			}
+			m.LaunchID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LaunchID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, github_com_cosmos_cosmos_sdk_types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRequestAddAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestAddAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestAddAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
+			}
+			m.RequestID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoApproved", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoApproved = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRequestAddVestingAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestAddVestingAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestAddVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LaunchID", wireType)
+			}
+			m.LaunchID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LaunchID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Options.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRequestAddVestingAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestAddVestingAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestAddVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
+			}
+			m.RequestID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoApproved", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoApproved = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRequestRemoveAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestRemoveAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestRemoveAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LaunchID", wireType)
+			}
+			m.LaunchID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LaunchID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRequestRemoveAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestRemoveAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestRemoveAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
+			}
+			m.RequestID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoApproved", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoApproved = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRequestUpdateAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestUpdateAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestUpdateAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LaunchID", wireType)
+			}
+			m.LaunchID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LaunchID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, github_com_cosmos_cosmos_sdk_types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VestingOptions == nil {
+				m.VestingOptions = &VestingOptions{}
+			}
+			if err := m.VestingOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRequestUpdateAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestUpdateAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestUpdateAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
+			}
+			m.RequestID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoApproved", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoApproved = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRequestAddValidator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3285,15 +7600,15 @@ func (m *MsgCreateChain) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateChain: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRequestAddValidator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateChain: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRequestAddValidator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coordinator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3321,11 +7636,30 @@ func (m *MsgCreateChain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coordinator = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LaunchID", wireType)
+			}
+			m.LaunchID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LaunchID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GenesisChainID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3353,13 +7687,13 @@ func (m *MsgCreateChain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GenesisChainID = string(dAtA[iNdEx:postIndex])
+			m.ValAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GenTx", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3369,29 +7703,31 @@ func (m *MsgCreateChain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceURL = string(dAtA[iNdEx:postIndex])
+			m.GenTx = append(m.GenTx[:0], dAtA[iNdEx:postIndex]...)
+			if m.GenTx == nil {
+				m.GenTx = []byte{}
+			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsPubKey", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3401,29 +7737,31 @@ func (m *MsgCreateChain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceHash = string(dAtA[iNdEx:postIndex])
+			m.ConsPubKey = append(m.ConsPubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.ConsPubKey == nil {
+				m.ConsPubKey = []byte{}
+			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GenesisURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfDelegation", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3433,29 +7771,30 @@ func (m *MsgCreateChain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GenesisURL = string(dAtA[iNdEx:postIndex])
+			if err := m.SelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GenesisHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3465,29 +7804,80 @@ func (m *MsgCreateChain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GenesisHash = string(dAtA[iNdEx:postIndex])
+			if err := m.Peer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRequestAddValidatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestAddValidatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestAddValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasCampaign", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
 			}
-			var v int
+			m.RequestID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3497,17 +7887,16 @@ func (m *MsgCreateChain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.RequestID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.HasCampaign = bool(v != 0)
-		case 8:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CampaignID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoApproved", wireType)
 			}
-			m.CampaignID = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3517,16 +7906,99 @@ func (m *MsgCreateChain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CampaignID |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+			m.AutoApproved = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRequestRemoveValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestRemoveValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestRemoveValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LaunchID", wireType)
 			}
-			var msglen int
+			m.LaunchID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3536,31 +8008,16 @@ func (m *MsgCreateChain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LaunchID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AccountBalance = append(m.AccountBalance, github_com_cosmos_cosmos_sdk_types.Coin{})
-			if err := m.AccountBalance[len(m.AccountBalance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 10:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3570,25 +8027,23 @@ func (m *MsgCreateChain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = append(m.Metadata[:0], dAtA[iNdEx:postIndex]...)
-			if m.Metadata == nil {
-				m.Metadata = []byte{}
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3611,7 +8066,7 @@ func (m *MsgCreateChain) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateChainResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRequestRemoveValidatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3634,17 +8089,17 @@ func (m *MsgCreateChainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateChainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRequestRemoveValidatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRequestRemoveValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LaunchID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
 			}
-			m.LaunchID = 0
+			m.RequestID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3654,11 +8109,31 @@ func (m *MsgCreateChainResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LaunchID |= uint64(b&0x7F) << shift
+				m.RequestID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoApproved", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.AutoApproved = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3680,7 +8155,7 @@ func (m *MsgCreateChainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEditChain) Unmarshal(dAtA []byte) error {
+func (m *MsgRequestUpdateValidatorPeer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3703,15 +8178,15 @@ func (m *MsgEditChain) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditChain: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRequestUpdateValidatorPeer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditChain: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRequestUpdateValidatorPeer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coordinator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3739,7 +8214,7 @@ func (m *MsgEditChain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coordinator = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
@@ -3761,10 +8236,10 @@ func (m *MsgEditChain) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SetCampaignID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValAddress", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3774,36 +8249,29 @@ func (m *MsgEditChain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SetCampaignID = bool(v != 0)
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CampaignID", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.CampaignID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CampaignID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-		case 5:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3813,24 +8281,23 @@ func (m *MsgEditChain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = append(m.Metadata[:0], dAtA[iNdEx:postIndex]...)
-			if m.Metadata == nil {
-				m.Metadata = []byte{}
+			if err := m.Peer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -3854,7 +8321,7 @@ func (m *MsgEditChain) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEditChainResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRequestUpdateValidatorPeerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3877,12 +8344,51 @@ func (m *MsgEditChainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditChainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRequestUpdateValidatorPeerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRequestUpdateValidatorPeerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
+			}
+			m.RequestID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoApproved", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoApproved = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3904,7 +8410,7 @@ func (m *MsgEditChainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateLaunchInformation) Unmarshal(dAtA []byte) error {
+func (m *MsgRequestUpdateValidatorGentx) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3927,15 +8433,15 @@ func (m *MsgUpdateLaunchInformation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateLaunchInformation: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRequestUpdateValidatorGentx: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateLaunchInformation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRequestUpdateValidatorGentx: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coordinator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3963,7 +8469,7 @@ func (m *MsgUpdateLaunchInformation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coordinator = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
@@ -3986,7 +8492,7 @@ func (m *MsgUpdateLaunchInformation) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GenesisChainID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4014,13 +8520,13 @@ func (m *MsgUpdateLaunchInformation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GenesisChainID = string(dAtA[iNdEx:postIndex])
+			m.ValAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GenTx", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4030,29 +8536,31 @@ func (m *MsgUpdateLaunchInformation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceURL = string(dAtA[iNdEx:postIndex])
+			m.GenTx = append(m.GenTx[:0], dAtA[iNdEx:postIndex]...)
+			if m.GenTx == nil {
+				m.GenTx = []byte{}
+			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsPubKey", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4062,27 +8570,29 @@ func (m *MsgUpdateLaunchInformation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceHash = string(dAtA[iNdEx:postIndex])
+			m.ConsPubKey = append(m.ConsPubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.ConsPubKey == nil {
+				m.ConsPubKey = []byte{}
+			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialGenesis", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfDelegation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4109,10 +8619,7 @@ func (m *MsgUpdateLaunchInformation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InitialGenesis == nil {
-				m.InitialGenesis = &InitialGenesis{}
-			}
-			if err := m.InitialGenesis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4137,7 +8644,7 @@ func (m *MsgUpdateLaunchInformation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateLaunchInformationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRequestUpdateValidatorGentxResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4160,12 +8667,51 @@ func (m *MsgUpdateLaunchInformationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateLaunchInformationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRequestUpdateValidatorGentxResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateLaunchInformationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRequestUpdateValidatorGentxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
+			}
+			m.RequestID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoApproved", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoApproved = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4187,7 +8733,7 @@ func (m *MsgUpdateLaunchInformationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRequestAddAccount) Unmarshal(dAtA []byte) error {
+func (m *MsgSettleRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4210,15 +8756,15 @@ func (m *MsgRequestAddAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRequestAddAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSettleRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRequestAddAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSettleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4246,7 +8792,7 @@ func (m *MsgRequestAddAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
@@ -4268,10 +8814,10 @@ func (m *MsgRequestAddAccount) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
 			}
-			var stringLen uint64
+			m.RequestID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4281,29 +8827,16 @@ func (m *MsgRequestAddAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.RequestID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approve", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4313,26 +8846,12 @@ func (m *MsgRequestAddAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Coins = append(m.Coins, github_com_cosmos_cosmos_sdk_types.Coin{})
-			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Approve = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4354,7 +8873,7 @@ func (m *MsgRequestAddAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRequestAddAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSettleRequestResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4377,51 +8896,12 @@ func (m *MsgRequestAddAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRequestAddAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSettleRequestResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRequestAddAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSettleRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
-			}
-			m.RequestID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RequestID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AutoApproved", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.AutoApproved = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4443,7 +8923,7 @@ func (m *MsgRequestAddAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRequestAddVestingAccount) Unmarshal(dAtA []byte) error {
+func (m *MsgSettleRequests) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4466,15 +8946,15 @@ func (m *MsgRequestAddVestingAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRequestAddVestingAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSettleRequests: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRequestAddVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSettleRequests: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4502,7 +8982,7 @@ func (m *MsgRequestAddVestingAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
@@ -4525,9 +9005,9 @@ func (m *MsgRequestAddVestingAccount) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Settlements", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4537,27 +9017,29 @@ func (m *MsgRequestAddVestingAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Settlements = append(m.Settlements, RequestSettlement{})
+			if err := m.Settlements[len(m.Settlements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestIDRange", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4584,10 +9066,33 @@ func (m *MsgRequestAddVestingAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Options.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.RequestIDRange == nil {
+				m.RequestIDRange = &RequestIDRange{}
+			}
+			if err := m.RequestIDRange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BestEffort", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BestEffort = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4609,7 +9114,7 @@ func (m *MsgRequestAddVestingAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRequestAddVestingAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSettleRequestsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4632,36 +9137,93 @@ func (m *MsgRequestAddVestingAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRequestAddVestingAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSettleRequestsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRequestAddVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSettleRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
-			}
-			m.RequestID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SettledRequestIDs = append(m.SettledRequestIDs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RequestID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SettledRequestIDs) == 0 {
+					m.SettledRequestIDs = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SettledRequestIDs = append(m.SettledRequestIDs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettledRequestIDs", wireType)
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AutoApproved", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failures", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4671,12 +9233,26 @@ func (m *MsgRequestAddVestingAccountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AutoApproved = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Failures = append(m.Failures, RequestSettlementFailure{})
+			if err := m.Failures[len(m.Failures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4698,7 +9274,7 @@ func (m *MsgRequestAddVestingAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRequestRemoveAccount) Unmarshal(dAtA []byte) error {
+func (m *RequestSettlement) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4721,17 +9297,17 @@ func (m *MsgRequestRemoveAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRequestRemoveAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: RequestSettlement: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRequestRemoveAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RequestSettlement: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
 			}
-			var stringLen uint64
+			m.RequestID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4741,75 +9317,31 @@ func (m *MsgRequestRemoveAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.RequestID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LaunchID", wireType)
-			}
-			m.LaunchID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LaunchID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Approve", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Approve = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4831,7 +9363,7 @@ func (m *MsgRequestRemoveAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRequestRemoveAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *RequestIDRange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4854,17 +9386,17 @@ func (m *MsgRequestRemoveAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRequestRemoveAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RequestIDRange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRequestRemoveAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RequestIDRange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
 			}
-			m.RequestID = 0
+			m.Start = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4874,14 +9406,33 @@ func (m *MsgRequestRemoveAccountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestID |= uint64(b&0x7F) << shift
+				m.Start |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AutoApproved", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			m.End = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.End |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approve", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -4898,7 +9449,7 @@ func (m *MsgRequestRemoveAccountResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.AutoApproved = bool(v != 0)
+			m.Approve = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4920,7 +9471,7 @@ func (m *MsgRequestRemoveAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRequestAddValidator) Unmarshal(dAtA []byte) error {
+func (m *RequestSettlementFailure) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4943,49 +9494,17 @@ func (m *MsgRequestAddValidator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRequestAddValidator: wiretype end group for non-group")
+			return fmt.Errorf("proto: RequestSettlementFailure: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRequestAddValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RequestSettlementFailure: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LaunchID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
 			}
-			m.LaunchID = 0
+			m.RequestID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4995,14 +9514,14 @@ func (m *MsgRequestAddValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LaunchID |= uint64(b&0x7F) << shift
+				m.RequestID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5030,47 +9549,63 @@ func (m *MsgRequestAddValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValAddress = string(dAtA[iNdEx:postIndex])
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GenTx", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if byteLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GenTx = append(m.GenTx[:0], dAtA[iNdEx:postIndex]...)
-			if m.GenTx == nil {
-				m.GenTx = []byte{}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsPubKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5080,31 +9615,29 @@ func (m *MsgRequestAddValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsPubKey = append(m.ConsPubKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.ConsPubKey == nil {
-				m.ConsPubKey = []byte{}
-			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SelfDelegation", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LaunchID", wireType)
 			}
-			var msglen int
+			m.LaunchID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5114,30 +9647,16 @@ func (m *MsgRequestAddValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LaunchID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.SelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
 			}
-			var msglen int
+			m.RequestID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5147,25 +9666,11 @@ func (m *MsgRequestAddValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.RequestID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Peer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5187,7 +9692,7 @@ func (m *MsgRequestAddValidator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRequestAddValidatorResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelRequestResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5197,64 +9702,25 @@ func (m *MsgRequestAddValidatorResponse) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowTx
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRequestAddValidatorResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRequestAddValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
-			}
-			m.RequestID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RequestID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AutoApproved", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.AutoApproved = bool(v != 0)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelRequestResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5276,7 +9742,7 @@ func (m *MsgRequestAddValidatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRequestRemoveValidator) Unmarshal(dAtA []byte) error {
+func (m *MsgTriggerLaunch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5299,15 +9765,15 @@ func (m *MsgRequestRemoveValidator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRequestRemoveValidator: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTriggerLaunch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRequestRemoveValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTriggerLaunch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Coordinator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5335,7 +9801,7 @@ func (m *MsgRequestRemoveValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Coordinator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
@@ -5358,9 +9824,9 @@ func (m *MsgRequestRemoveValidator) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LaunchTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5370,23 +9836,24 @@ func (m *MsgRequestRemoveValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LaunchTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5409,7 +9876,7 @@ func (m *MsgRequestRemoveValidator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRequestRemoveValidatorResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTriggerLaunchResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5432,51 +9899,12 @@ func (m *MsgRequestRemoveValidatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRequestRemoveValidatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTriggerLaunchResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRequestRemoveValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTriggerLaunchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
-			}
-			m.RequestID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RequestID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AutoApproved", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.AutoApproved = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5498,7 +9926,7 @@ func (m *MsgRequestRemoveValidatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSettleRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgRevertLaunch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5521,15 +9949,15 @@ func (m *MsgSettleRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSettleRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevertLaunch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSettleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevertLaunch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Coordinator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5557,7 +9985,7 @@ func (m *MsgSettleRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.Coordinator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
@@ -5578,45 +10006,6 @@ func (m *MsgSettleRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
-			}
-			m.RequestID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RequestID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Approve", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Approve = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5638,7 +10027,7 @@ func (m *MsgSettleRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSettleRequestResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevertLaunchResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5661,10 +10050,10 @@ func (m *MsgSettleRequestResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSettleRequestResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevertLaunchResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSettleRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevertLaunchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5688,7 +10077,7 @@ func (m *MsgSettleRequestResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTriggerLaunch) Unmarshal(dAtA []byte) error {
+func (m *MsgSetApprovalPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5711,10 +10100,10 @@ func (m *MsgTriggerLaunch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTriggerLaunch: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetApprovalPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTriggerLaunch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetApprovalPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5770,7 +10159,7 @@ func (m *MsgTriggerLaunch) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LaunchTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAccountCoins", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5797,10 +10186,79 @@ func (m *MsgTriggerLaunch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LaunchTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.MaxAccountCoins = append(m.MaxAccountCoins, github_com_cosmos_cosmos_sdk_types.Coin{})
+			if err := m.MaxAccountCoins[len(m.MaxAccountCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MinSelfDelegation == nil {
+				m.MinSelfDelegation = &types.Coin{}
+			}
+			if err := m.MinSelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowlist = append(m.Allowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5822,7 +10280,7 @@ func (m *MsgTriggerLaunch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTriggerLaunchResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetApprovalPolicyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5845,10 +10303,10 @@ func (m *MsgTriggerLaunchResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTriggerLaunchResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetApprovalPolicyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTriggerLaunchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetApprovalPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5872,7 +10330,7 @@ func (m *MsgTriggerLaunchResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRevertLaunch) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5895,15 +10353,15 @@ func (m *MsgRevertLaunch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRevertLaunch: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRevertLaunch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coordinator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5931,13 +10389,13 @@ func (m *MsgRevertLaunch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coordinator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LaunchID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			m.LaunchID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5947,11 +10405,25 @@ func (m *MsgRevertLaunch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LaunchID |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5973,7 +10445,7 @@ func (m *MsgRevertLaunch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRevertLaunchResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5996,10 +10468,10 @@ func (m *MsgRevertLaunchResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRevertLaunchResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRevertLaunchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -89,6 +89,8 @@ func (m *VestingAccount) GetVestingOptions() VestingOptions {
 type VestingOptions struct {
 	// Types that are valid to be assigned to Options:
 	//	*VestingOptions_DelayedVesting
+	//	*VestingOptions_ContinuousVesting
+	//	*VestingOptions_PeriodicVesting
 	Options isVestingOptions_Options `protobuf_oneof:"options"`
 }
 
@@ -134,8 +136,16 @@ type isVestingOptions_Options interface {
 type VestingOptions_DelayedVesting struct {
 	DelayedVesting *DelayedVesting `protobuf:"bytes,1,opt,name=delayedVesting,proto3,oneof" json:"delayedVesting,omitempty"`
 }
+type VestingOptions_ContinuousVesting struct {
+	ContinuousVesting *ContinuousVesting `protobuf:"bytes,2,opt,name=continuousVesting,proto3,oneof" json:"continuousVesting,omitempty"`
+}
+type VestingOptions_PeriodicVesting struct {
+	PeriodicVesting *PeriodicVesting `protobuf:"bytes,3,opt,name=periodicVesting,proto3,oneof" json:"periodicVesting,omitempty"`
+}
 
-func (*VestingOptions_DelayedVesting) isVestingOptions_Options() {}
+func (*VestingOptions_DelayedVesting) isVestingOptions_Options()    {}
+func (*VestingOptions_ContinuousVesting) isVestingOptions_Options() {}
+func (*VestingOptions_PeriodicVesting) isVestingOptions_Options()   {}
 
 func (m *VestingOptions) GetOptions() isVestingOptions_Options {
 	if m != nil {
@@ -151,10 +161,26 @@ func (m *VestingOptions) GetDelayedVesting() *DelayedVesting {
 	return nil
 }
 
+func (m *VestingOptions) GetContinuousVesting() *ContinuousVesting {
+	if x, ok := m.GetOptions().(*VestingOptions_ContinuousVesting); ok {
+		return x.ContinuousVesting
+	}
+	return nil
+}
+
+func (m *VestingOptions) GetPeriodicVesting() *PeriodicVesting {
+	if x, ok := m.GetOptions().(*VestingOptions_PeriodicVesting); ok {
+		return x.PeriodicVesting
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*VestingOptions) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*VestingOptions_DelayedVesting)(nil),
+		(*VestingOptions_ContinuousVesting)(nil),
+		(*VestingOptions_PeriodicVesting)(nil),
 	}
 }
 
@@ -221,43 +247,243 @@ func (m *DelayedVesting) GetEndTime() int64 {
 	return 0
 }
 
+// ContinuousVesting represents options for continuous vesting
+// Continuous vesting is the type of vesting where vesting coins are vested
+// linearly between start time and end time
+type ContinuousVesting struct {
+	TotalBalance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=totalBalance,proto3,casttype=github.com/cosmos/cosmos-sdk/types.Coin,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"totalBalance"`
+	Vesting      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=vesting,proto3,casttype=github.com/cosmos/cosmos-sdk/types.Coin,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"vesting"`
+	StartTime    int64                                    `protobuf:"varint,3,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime      int64                                    `protobuf:"varint,4,opt,name=endTime,proto3" json:"endTime,omitempty"`
+}
+
+func (m *ContinuousVesting) Reset()         { *m = ContinuousVesting{} }
+func (m *ContinuousVesting) String() string { return proto.CompactTextString(m) }
+func (*ContinuousVesting) ProtoMessage()    {}
+func (*ContinuousVesting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_afe88fc74ba91b11, []int{3}
+}
+func (m *ContinuousVesting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContinuousVesting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContinuousVesting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContinuousVesting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContinuousVesting.Merge(m, src)
+}
+func (m *ContinuousVesting) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContinuousVesting) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContinuousVesting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContinuousVesting proto.InternalMessageInfo
+
+func (m *ContinuousVesting) GetTotalBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalBalance
+	}
+	return nil
+}
+
+func (m *ContinuousVesting) GetVesting() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Vesting
+	}
+	return nil
+}
+
+func (m *ContinuousVesting) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *ContinuousVesting) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+// PeriodicVesting represents options for periodic vesting
+// Periodic vesting is the type of vesting where vesting coins are vested
+// at the end of each period, periods start at start time
+type PeriodicVesting struct {
+	TotalBalance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=totalBalance,proto3,casttype=github.com/cosmos/cosmos-sdk/types.Coin,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"totalBalance"`
+	StartTime    int64                                    `protobuf:"varint,2,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	Periods      []VestingPeriod                          `protobuf:"bytes,3,rep,name=periods,proto3" json:"periods"`
+}
+
+func (m *PeriodicVesting) Reset()         { *m = PeriodicVesting{} }
+func (m *PeriodicVesting) String() string { return proto.CompactTextString(m) }
+func (*PeriodicVesting) ProtoMessage()    {}
+func (*PeriodicVesting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_afe88fc74ba91b11, []int{4}
+}
+func (m *PeriodicVesting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PeriodicVesting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PeriodicVesting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PeriodicVesting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeriodicVesting.Merge(m, src)
+}
+func (m *PeriodicVesting) XXX_Size() int {
+	return m.Size()
+}
+func (m *PeriodicVesting) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeriodicVesting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeriodicVesting proto.InternalMessageInfo
+
+func (m *PeriodicVesting) GetTotalBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalBalance
+	}
+	return nil
+}
+
+func (m *PeriodicVesting) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *PeriodicVesting) GetPeriods() []VestingPeriod {
+	if m != nil {
+		return m.Periods
+	}
+	return nil
+}
+
+// VestingPeriod represents a period of a periodic vesting, length is in seconds
+type VestingPeriod struct {
+	Length int64                                    `protobuf:"varint,1,opt,name=length,proto3" json:"length,omitempty"`
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,casttype=github.com/cosmos/cosmos-sdk/types.Coin,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *VestingPeriod) Reset()         { *m = VestingPeriod{} }
+func (m *VestingPeriod) String() string { return proto.CompactTextString(m) }
+func (*VestingPeriod) ProtoMessage()    {}
+func (*VestingPeriod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_afe88fc74ba91b11, []int{5}
+}
+func (m *VestingPeriod) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VestingPeriod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VestingPeriod.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VestingPeriod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VestingPeriod.Merge(m, src)
+}
+func (m *VestingPeriod) XXX_Size() int {
+	return m.Size()
+}
+func (m *VestingPeriod) XXX_DiscardUnknown() {
+	xxx_messageInfo_VestingPeriod.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VestingPeriod proto.InternalMessageInfo
+
+func (m *VestingPeriod) GetLength() int64 {
+	if m != nil {
+		return m.Length
+	}
+	return 0
+}
+
+func (m *VestingPeriod) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*VestingAccount)(nil), "tendermint.spn.launch.VestingAccount")
 	proto.RegisterType((*VestingOptions)(nil), "tendermint.spn.launch.VestingOptions")
 	proto.RegisterType((*DelayedVesting)(nil), "tendermint.spn.launch.DelayedVesting")
+	proto.RegisterType((*ContinuousVesting)(nil), "tendermint.spn.launch.ContinuousVesting")
+	proto.RegisterType((*PeriodicVesting)(nil), "tendermint.spn.launch.PeriodicVesting")
+	proto.RegisterType((*VestingPeriod)(nil), "tendermint.spn.launch.VestingPeriod")
 }
 
 func init() { proto.RegisterFile("launch/vesting_account.proto", fileDescriptor_afe88fc74ba91b11) }
 
 var fileDescriptor_afe88fc74ba91b11 = []byte{
-	// 430 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x53, 0xc1, 0x6e, 0xd3, 0x30,
-	0x18, 0x8e, 0xdb, 0x89, 0x30, 0x0f, 0xe5, 0x60, 0x0d, 0x29, 0xab, 0x50, 0x1a, 0x4d, 0x42, 0xe4,
-	0x32, 0x5b, 0x2b, 0x4f, 0xb0, 0xb0, 0x03, 0x9c, 0x26, 0x65, 0x68, 0x07, 0x38, 0x4c, 0x4e, 0x62,
-	0x65, 0x16, 0x89, 0x1d, 0xc5, 0x6e, 0x45, 0xdf, 0xa2, 0x67, 0x1e, 0x81, 0x33, 0xbc, 0x43, 0x8f,
-	0x15, 0x27, 0xc4, 0xa1, 0xa0, 0xf6, 0x2d, 0x38, 0xa1, 0xc6, 0x6e, 0x21, 0x15, 0x48, 0xdc, 0x76,
-	0x4a, 0xfe, 0x7c, 0xdf, 0xef, 0xef, 0xfb, 0xff, 0x2f, 0x86, 0x4f, 0x4a, 0x3a, 0x16, 0xd9, 0x1d,
-	0x99, 0x30, 0xa5, 0xb9, 0x28, 0x6e, 0x69, 0x96, 0xc9, 0xb1, 0xd0, 0xb8, 0x6e, 0xa4, 0x96, 0xe8,
-	0xb1, 0x66, 0x22, 0x67, 0x4d, 0xc5, 0x85, 0xc6, 0xaa, 0x16, 0xd8, 0x90, 0x07, 0xc7, 0x85, 0x2c,
-	0x64, 0xcb, 0x20, 0x9b, 0x37, 0x43, 0x1e, 0x04, 0x99, 0x54, 0x95, 0x54, 0x24, 0xa5, 0x8a, 0x91,
-	0xc9, 0x79, 0xca, 0x34, 0x3d, 0x27, 0x99, 0xe4, 0xc2, 0xe2, 0x27, 0x06, 0xbf, 0x35, 0x8d, 0xa6,
-	0x30, 0xd0, 0xe9, 0x67, 0x00, 0xbd, 0x1b, 0xe3, 0xe0, 0xc2, 0x18, 0x40, 0x03, 0xf8, 0xd0, 0xa8,
-	0xbd, 0xba, 0xf4, 0x41, 0x08, 0xa2, 0x83, 0x64, 0x57, 0xa3, 0x11, 0x74, 0x69, 0x9e, 0x37, 0x4c,
-	0x29, 0xbf, 0x17, 0x82, 0xe8, 0x30, 0xf6, 0xbf, 0x7c, 0x3a, 0x3b, 0xb6, 0x27, 0x5e, 0x18, 0xe4,
-	0x5a, 0x37, 0x5c, 0x14, 0xc9, 0x96, 0x88, 0xae, 0xa1, 0x67, 0x67, 0xbc, 0xaa, 0x35, 0x97, 0x42,
-	0xf9, 0xfd, 0x10, 0x44, 0x47, 0xa3, 0xa7, 0xf8, 0xaf, 0x33, 0xe2, 0x9b, 0x0e, 0x39, 0x3e, 0x98,
-	0x2f, 0x87, 0x4e, 0xb2, 0x77, 0xc4, 0x69, 0xb9, 0xb3, 0x6d, 0xbf, 0xa0, 0x2b, 0xe8, 0xe5, 0xac,
-	0xa4, 0x53, 0x96, 0x5b, 0xa0, 0x35, 0xff, 0x6f, 0x99, 0xcb, 0x0e, 0xf9, 0xa5, 0x93, 0xec, 0xb5,
-	0xc7, 0x87, 0xd0, 0x95, 0x56, 0xed, 0x5b, 0x0f, 0x7a, 0x5d, 0x3e, 0xfa, 0x00, 0xe0, 0x23, 0x2d,
-	0x35, 0x2d, 0x63, 0x5a, 0x52, 0x91, 0x31, 0x1f, 0x84, 0xfd, 0xe8, 0x68, 0x74, 0x82, 0xed, 0x32,
-	0x36, 0x59, 0x60, 0x9b, 0x05, 0x7e, 0x21, 0xb9, 0x88, 0xdf, 0x6e, 0x06, 0xf9, 0xb9, 0x1c, 0x3e,
-	0x2b, 0xb8, 0xbe, 0x1b, 0xa7, 0x38, 0x93, 0x95, 0xcd, 0xc2, 0x3e, 0xce, 0x54, 0xfe, 0x8e, 0xe8,
-	0x69, 0xcd, 0x54, 0xdb, 0xf0, 0xf1, 0xfb, 0x30, 0xfa, 0x4f, 0xaa, 0x4a, 0x3a, 0x5e, 0xd0, 0x0c,
-	0x40, 0xd7, 0x2e, 0xcc, 0xef, 0xdd, 0xab, 0xaf, 0xad, 0x0d, 0xe4, 0x43, 0x97, 0x89, 0xfc, 0x35,
-	0xaf, 0x58, 0x1b, 0x7f, 0x3f, 0xd9, 0x96, 0x71, 0x3c, 0x5f, 0x05, 0x60, 0xb1, 0x0a, 0xc0, 0x8f,
-	0x55, 0x00, 0x66, 0xeb, 0xc0, 0x59, 0xac, 0x03, 0xe7, 0xeb, 0x3a, 0x70, 0xde, 0xfc, 0x29, 0xf3,
-	0x3b, 0x44, 0xa2, 0x6a, 0x41, 0xde, 0x13, 0x7b, 0x7d, 0x5a, 0xb1, 0xf4, 0x41, 0xfb, 0x37, 0x3f,
-	0xff, 0x15, 0x00, 0x00, 0xff, 0xff, 0x84, 0xe2, 0xd4, 0xe5, 0x55, 0x03, 0x00, 0x00,
+	// 582 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x55, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0xf5, 0x38, 0x55, 0xf2, 0x65, 0xfa, 0x91, 0xaa, 0xa3, 0x82, 0xdc, 0xa8, 0x72, 0xa2, 0x88,
+	0x1f, 0x6f, 0x6a, 0xab, 0xe1, 0x09, 0xea, 0x66, 0x11, 0x56, 0x45, 0x2e, 0xaa, 0x10, 0x2c, 0x2a,
+	0xc7, 0x1e, 0x39, 0x23, 0x92, 0x19, 0xcb, 0x33, 0x89, 0xe8, 0x4b, 0xa0, 0x8a, 0x65, 0x1f, 0x81,
+	0x35, 0x48, 0x3c, 0x42, 0x97, 0x15, 0x2b, 0xc4, 0xa2, 0xa0, 0xe4, 0x2d, 0x60, 0x83, 0xec, 0x19,
+	0xa7, 0xb1, 0x4b, 0x2b, 0x58, 0x95, 0x05, 0xab, 0xe4, 0xfa, 0x9e, 0x7b, 0x7c, 0xee, 0x99, 0xeb,
+	0x3b, 0x70, 0x6b, 0xe4, 0x4f, 0x68, 0x30, 0x74, 0xa6, 0x98, 0x0b, 0x42, 0xa3, 0x23, 0x3f, 0x08,
+	0xd8, 0x84, 0x0a, 0x3b, 0x4e, 0x98, 0x60, 0xe8, 0xae, 0xc0, 0x34, 0xc4, 0xc9, 0x98, 0x50, 0x61,
+	0xf3, 0x98, 0xda, 0x12, 0xdc, 0xdc, 0x88, 0x58, 0xc4, 0x32, 0x84, 0x93, 0xfe, 0x93, 0xe0, 0xa6,
+	0x19, 0x30, 0x3e, 0x66, 0xdc, 0x19, 0xf8, 0x1c, 0x3b, 0xd3, 0x9d, 0x01, 0x16, 0xfe, 0x8e, 0x13,
+	0x30, 0x42, 0x55, 0x7e, 0x53, 0xe6, 0x8f, 0x64, 0xa1, 0x0c, 0x64, 0xaa, 0xf3, 0x01, 0xc0, 0xc6,
+	0xa1, 0x54, 0xb0, 0x2b, 0x05, 0xa0, 0x26, 0xfc, 0x4f, 0xbe, 0xed, 0x49, 0xcf, 0x00, 0x6d, 0x60,
+	0xad, 0x78, 0x8b, 0x18, 0x75, 0x61, 0xcd, 0x0f, 0xc3, 0x04, 0x73, 0x6e, 0xe8, 0x6d, 0x60, 0xd5,
+	0x5d, 0xe3, 0xd3, 0xfb, 0xed, 0x0d, 0xc5, 0xb8, 0x2b, 0x33, 0x07, 0x22, 0x21, 0x34, 0xf2, 0x72,
+	0x20, 0x3a, 0x80, 0x0d, 0xd5, 0xe3, 0x7e, 0x2c, 0x08, 0xa3, 0xdc, 0xa8, 0xb4, 0x81, 0xb5, 0xda,
+	0x7d, 0x60, 0xff, 0xb2, 0x47, 0xfb, 0xb0, 0x00, 0x76, 0x57, 0xce, 0x2e, 0x5a, 0x9a, 0x57, 0xa2,
+	0xe8, 0x9c, 0xea, 0x0b, 0xdd, 0xea, 0x11, 0xda, 0x87, 0x8d, 0x10, 0x8f, 0xfc, 0x63, 0x1c, 0xaa,
+	0x84, 0x01, 0x6e, 0x7c, 0x4f, 0xaf, 0x00, 0xee, 0x6b, 0x5e, 0xa9, 0x1c, 0x3d, 0x87, 0xeb, 0x01,
+	0xa3, 0x82, 0xd0, 0x09, 0x9b, 0xf0, 0x9c, 0x53, 0xcf, 0x38, 0xad, 0x6b, 0x38, 0xf7, 0xca, 0xf8,
+	0xbe, 0xe6, 0x5d, 0x25, 0x41, 0x1e, 0x5c, 0x8b, 0x71, 0x42, 0x58, 0x48, 0x82, 0x9c, 0x57, 0x7a,
+	0xf2, 0xf0, 0x1a, 0xde, 0xa7, 0x45, 0x74, 0x5f, 0xf3, 0xca, 0x04, 0x6e, 0x1d, 0xd6, 0x98, 0x32,
+	0xe7, 0x8b, 0x0e, 0x1b, 0xc5, 0xee, 0xd0, 0x29, 0x80, 0xff, 0x0b, 0x26, 0xfc, 0x91, 0xeb, 0x8f,
+	0x7c, 0x1a, 0x60, 0x03, 0xb4, 0x2b, 0xd6, 0x6a, 0x77, 0xd3, 0x56, 0x67, 0x97, 0x8e, 0x8e, 0xad,
+	0x46, 0xc7, 0xde, 0x63, 0x84, 0xba, 0x2f, 0x53, 0xdf, 0xbf, 0x5f, 0xb4, 0x1e, 0x45, 0x44, 0x0c,
+	0x27, 0x03, 0x3b, 0x60, 0x63, 0x35, 0x3a, 0xea, 0x67, 0x9b, 0x87, 0xaf, 0x1c, 0x71, 0x1c, 0x63,
+	0x9e, 0x15, 0xbc, 0xfb, 0xda, 0xb2, 0x7e, 0x13, 0xca, 0xbd, 0x82, 0x16, 0x74, 0x02, 0x60, 0x6d,
+	0xba, 0xf0, 0xf7, 0x36, 0x75, 0xe5, 0x32, 0x90, 0x01, 0x6b, 0x98, 0x86, 0xcf, 0xc8, 0x18, 0x67,
+	0x27, 0x53, 0xf1, 0xf2, 0xb0, 0xf3, 0x43, 0x87, 0xeb, 0x57, 0x8e, 0xf9, 0x9f, 0xbf, 0x7f, 0xe8,
+	0xef, 0x16, 0xac, 0x73, 0xe1, 0x27, 0x62, 0xc9, 0xe1, 0xcb, 0x07, 0xcb, 0xee, 0xaf, 0x14, 0xdd,
+	0x7f, 0xab, 0xc3, 0xb5, 0xd2, 0xc7, 0xf0, 0x77, 0x7b, 0x5f, 0x68, 0x54, 0x2f, 0x37, 0xda, 0x83,
+	0x35, 0xf9, 0x1d, 0xa7, 0x4b, 0x31, 0x15, 0x7d, 0xff, 0xe6, 0xa5, 0x28, 0x5b, 0x57, 0x3b, 0x31,
+	0x2f, 0xed, 0x7c, 0x04, 0xf0, 0x4e, 0x01, 0x80, 0xee, 0xc1, 0xea, 0x08, 0xd3, 0x48, 0x0c, 0xb3,
+	0x1d, 0x58, 0xf1, 0x54, 0x84, 0xde, 0x00, 0x58, 0xf5, 0xc7, 0xe9, 0x9a, 0xbf, 0xe5, 0x41, 0x50,
+	0x2a, 0x5c, 0xf7, 0x6c, 0x66, 0x82, 0xf3, 0x99, 0x09, 0xbe, 0xcd, 0x4c, 0x70, 0x32, 0x37, 0xb5,
+	0xf3, 0xb9, 0xa9, 0x7d, 0x9e, 0x9b, 0xda, 0x8b, 0x65, 0xae, 0x4b, 0x4f, 0x1c, 0x1e, 0x53, 0xe7,
+	0xb5, 0xa3, 0xee, 0xce, 0x8c, 0x71, 0x50, 0xcd, 0xae, 0xb2, 0xc7, 0x3f, 0x07, 0x00, 0xa8, 0xd9,
+	0x81, 0xae, 0x52, 0x07, 0x00, 0x00,
 }
 
 func (m *VestingAccount) Marshal() (dAtA []byte, err error) {
@@ -358,6 +584,48 @@ func (m *VestingOptions_DelayedVesting) MarshalToSizedBuffer(dAtA []byte) (int,
 	}
 	return len(dAtA) - i, nil
 }
+func (m *VestingOptions_ContinuousVesting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VestingOptions_ContinuousVesting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ContinuousVesting != nil {
+		{
+			size, err := m.ContinuousVesting.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintVestingAccount(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *VestingOptions_PeriodicVesting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VestingOptions_PeriodicVesting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.PeriodicVesting != nil {
+		{
+			size, err := m.PeriodicVesting.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintVestingAccount(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *DelayedVesting) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -414,90 +682,631 @@ func (m *DelayedVesting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintVestingAccount(dAtA []byte, offset int, v uint64) int {
-	offset -= sovVestingAccount(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *VestingAccount) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.LaunchID != 0 {
-		n += 1 + sovVestingAccount(uint64(m.LaunchID))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovVestingAccount(uint64(l))
+func (m *ContinuousVesting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.VestingOptions.Size()
-	n += 1 + l + sovVestingAccount(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *VestingOptions) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Options != nil {
-		n += m.Options.Size()
-	}
-	return n
+func (m *ContinuousVesting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *VestingOptions_DelayedVesting) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ContinuousVesting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.DelayedVesting != nil {
-		l = m.DelayedVesting.Size()
-		n += 1 + l + sovVestingAccount(uint64(l))
+	if m.EndTime != 0 {
+		i = encodeVarintVestingAccount(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x20
 	}
-	return n
-}
-func (m *DelayedVesting) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.StartTime != 0 {
+		i = encodeVarintVestingAccount(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x18
 	}
-	var l int
-	_ = l
-	if len(m.TotalBalance) > 0 {
-		for _, e := range m.TotalBalance {
-			l = e.Size()
-			n += 1 + l + sovVestingAccount(uint64(l))
+	if len(m.Vesting) > 0 {
+		for iNdEx := len(m.Vesting) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Vesting[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVestingAccount(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.Vesting) > 0 {
-		for _, e := range m.Vesting {
-			l = e.Size()
-			n += 1 + l + sovVestingAccount(uint64(l))
+	if len(m.TotalBalance) > 0 {
+		for iNdEx := len(m.TotalBalance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalBalance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVestingAccount(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.EndTime != 0 {
+	return len(dAtA) - i, nil
+}
+
+func (m *PeriodicVesting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PeriodicVesting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PeriodicVesting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Periods) > 0 {
+		for iNdEx := len(m.Periods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Periods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVestingAccount(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintVestingAccount(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TotalBalance) > 0 {
+		for iNdEx := len(m.TotalBalance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalBalance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVestingAccount(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VestingPeriod) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VestingPeriod) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VestingPeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVestingAccount(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Length != 0 {
+		i = encodeVarintVestingAccount(dAtA, i, uint64(m.Length))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintVestingAccount(dAtA []byte, offset int, v uint64) int {
+	offset -= sovVestingAccount(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *VestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LaunchID != 0 {
+		n += 1 + sovVestingAccount(uint64(m.LaunchID))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovVestingAccount(uint64(l))
+	}
+	l = m.VestingOptions.Size()
+	n += 1 + l + sovVestingAccount(uint64(l))
+	return n
+}
+
+func (m *VestingOptions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Options != nil {
+		n += m.Options.Size()
+	}
+	return n
+}
+
+func (m *VestingOptions_DelayedVesting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DelayedVesting != nil {
+		l = m.DelayedVesting.Size()
+		n += 1 + l + sovVestingAccount(uint64(l))
+	}
+	return n
+}
+func (m *VestingOptions_ContinuousVesting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContinuousVesting != nil {
+		l = m.ContinuousVesting.Size()
+		n += 1 + l + sovVestingAccount(uint64(l))
+	}
+	return n
+}
+func (m *VestingOptions_PeriodicVesting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PeriodicVesting != nil {
+		l = m.PeriodicVesting.Size()
+		n += 1 + l + sovVestingAccount(uint64(l))
+	}
+	return n
+}
+func (m *DelayedVesting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TotalBalance) > 0 {
+		for _, e := range m.TotalBalance {
+			l = e.Size()
+			n += 1 + l + sovVestingAccount(uint64(l))
+		}
+	}
+	if len(m.Vesting) > 0 {
+		for _, e := range m.Vesting {
+			l = e.Size()
+			n += 1 + l + sovVestingAccount(uint64(l))
+		}
+	}
+	if m.EndTime != 0 {
 		n += 1 + sovVestingAccount(uint64(m.EndTime))
 	}
 	return n
 }
 
+func (m *ContinuousVesting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TotalBalance) > 0 {
+		for _, e := range m.TotalBalance {
+			l = e.Size()
+			n += 1 + l + sovVestingAccount(uint64(l))
+		}
+	}
+	if len(m.Vesting) > 0 {
+		for _, e := range m.Vesting {
+			l = e.Size()
+			n += 1 + l + sovVestingAccount(uint64(l))
+		}
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovVestingAccount(uint64(m.StartTime))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovVestingAccount(uint64(m.EndTime))
+	}
+	return n
+}
+
+func (m *PeriodicVesting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TotalBalance) > 0 {
+		for _, e := range m.TotalBalance {
+			l = e.Size()
+			n += 1 + l + sovVestingAccount(uint64(l))
+		}
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovVestingAccount(uint64(m.StartTime))
+	}
+	if len(m.Periods) > 0 {
+		for _, e := range m.Periods {
+			l = e.Size()
+			n += 1 + l + sovVestingAccount(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VestingPeriod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Length != 0 {
+		n += 1 + sovVestingAccount(uint64(m.Length))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovVestingAccount(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovVestingAccount(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozVestingAccount(x uint64) (n int) {
 	return sovVestingAccount(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *VestingAccount) Unmarshal(dAtA []byte) error {
+func (m *VestingAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVestingAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VestingAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LaunchID", wireType)
+			}
+			m.LaunchID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LaunchID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVestingAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVestingAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVestingAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVestingAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VestingOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVestingAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVestingAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VestingOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVestingAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VestingOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VestingOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayedVesting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVestingAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVestingAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &DelayedVesting{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Options = &VestingOptions_DelayedVesting{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinuousVesting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVestingAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVestingAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ContinuousVesting{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Options = &VestingOptions_ContinuousVesting{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodicVesting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVestingAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVestingAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &PeriodicVesting{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Options = &VestingOptions_PeriodicVesting{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVestingAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVestingAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelayedVesting) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -520,36 +1329,17 @@ func (m *VestingAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VestingAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelayedVesting: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelayedVesting: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LaunchID", wireType)
-			}
-			m.LaunchID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowVestingAccount
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LaunchID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBalance", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVestingAccount
@@ -559,27 +1349,29 @@ func (m *VestingAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthVestingAccount
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthVestingAccount
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.TotalBalance = append(m.TotalBalance, github_com_cosmos_cosmos_sdk_types.Coin{})
+			if err := m.TotalBalance[len(m.TotalBalance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VestingOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vesting", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -606,10 +1398,30 @@ func (m *VestingAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.VestingOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Vesting = append(m.Vesting, github_com_cosmos_cosmos_sdk_types.Coin{})
+			if err := m.Vesting[len(m.Vesting)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVestingAccount(dAtA[iNdEx:])
@@ -631,7 +1443,7 @@ func (m *VestingAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VestingOptions) Unmarshal(dAtA []byte) error {
+func (m *ContinuousVesting) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -654,15 +1466,15 @@ func (m *VestingOptions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VestingOptions: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContinuousVesting: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VestingOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContinuousVesting: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelayedVesting", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBalance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -689,12 +1501,83 @@ func (m *VestingOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &DelayedVesting{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TotalBalance = append(m.TotalBalance, github_com_cosmos_cosmos_sdk_types.Coin{})
+			if err := m.TotalBalance[len(m.TotalBalance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vesting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVestingAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVestingAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vesting = append(m.Vesting, github_com_cosmos_cosmos_sdk_types.Coin{})
+			if err := m.Vesting[len(m.Vesting)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Options = &VestingOptions_DelayedVesting{v}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVestingAccount(dAtA[iNdEx:])
@@ -716,7 +1599,7 @@ func (m *VestingOptions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelayedVesting) Unmarshal(dAtA []byte) error {
+func (m *PeriodicVesting) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -739,10 +1622,10 @@ func (m *DelayedVesting) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelayedVesting: wiretype end group for non-group")
+			return fmt.Errorf("proto: PeriodicVesting: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelayedVesting: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PeriodicVesting: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -780,8 +1663,27 @@ func (m *DelayedVesting) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vesting", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Periods", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -808,16 +1710,66 @@ func (m *DelayedVesting) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Vesting = append(m.Vesting, github_com_cosmos_cosmos_sdk_types.Coin{})
-			if err := m.Vesting[len(m.Vesting)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Periods = append(m.Periods, VestingPeriod{})
+			if err := m.Periods[len(m.Periods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVestingAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVestingAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VestingPeriod) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVestingAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VestingPeriod: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VestingPeriod: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
 			}
-			m.EndTime = 0
+			m.Length = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVestingAccount
@@ -827,11 +1779,45 @@ func (m *DelayedVesting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EndTime |= int64(b&0x7F) << shift
+				m.Length |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthVestingAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVestingAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, github_com_cosmos_cosmos_sdk_types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVestingAccount(dAtA[iNdEx:])
@@ -4,7 +4,10 @@ import (
 	"errors"
 	"fmt"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	campaigntypes "github.com/tendermint/spn/x/campaign/types"
 )
 
 func NewDelayedVesting(totalBalance, vesting sdk.Coins, endTime int64) *VestingOptions {
@@ -19,7 +22,179 @@ func NewDelayedVesting(totalBalance, vesting sdk.Coins, endTime int64) *VestingO
 	}
 }
 
-// Validate check the DelayedVesting object
+// NewContinuousVesting returns vesting options for a continuous vesting
+func NewContinuousVesting(totalBalance, vesting sdk.Coins, startTime, endTime int64) *VestingOptions {
+	return &VestingOptions{
+		Options: &VestingOptions_ContinuousVesting{
+			ContinuousVesting: &ContinuousVesting{
+				TotalBalance: totalBalance,
+				Vesting:      vesting,
+				StartTime:    startTime,
+				EndTime:      endTime,
+			},
+		},
+	}
+}
+
+// NewPeriodicVesting returns vesting options for a periodic vesting
+func NewPeriodicVesting(totalBalance sdk.Coins, startTime int64, periods []VestingPeriod) *VestingOptions {
+	return &VestingOptions{
+		Options: &VestingOptions_PeriodicVesting{
+			PeriodicVesting: &PeriodicVesting{
+				TotalBalance: totalBalance,
+				StartTime:    startTime,
+				Periods:      periods,
+			},
+		},
+	}
+}
+
+// NewVestingOptionsFromShares returns the vesting options in coins of share vesting options
+// from the total supply of a campaign
+// The amount of each period of a periodic vesting is rounded down, the rounding remainder is added to the last period
+func NewVestingOptionsFromShares(
+	options campaigntypes.ShareVestingOptions,
+	totalSupply sdk.Coins,
+	totalShareNumber uint64,
+) (VestingOptions, error) {
+	switch opt := options.Options.(type) {
+	case *campaigntypes.ShareVestingOptions_DelayedVesting:
+		dv := opt.DelayedVesting
+		totalBalance, err := dv.TotalShares.CoinsFromTotalSupply(totalSupply, totalShareNumber)
+		if err != nil {
+			return VestingOptions{}, err
+		}
+		vesting, err := dv.Vesting.CoinsFromTotalSupply(totalSupply, totalShareNumber)
+		if err != nil {
+			return VestingOptions{}, err
+		}
+		return *NewDelayedVesting(totalBalance, vesting, dv.EndTime), nil
+	case *campaigntypes.ShareVestingOptions_ContinuousVesting:
+		cv := opt.ContinuousVesting
+		totalBalance, err := cv.TotalShares.CoinsFromTotalSupply(totalSupply, totalShareNumber)
+		if err != nil {
+			return VestingOptions{}, err
+		}
+		vesting, err := cv.Vesting.CoinsFromTotalSupply(totalSupply, totalShareNumber)
+		if err != nil {
+			return VestingOptions{}, err
+		}
+		return *NewContinuousVesting(totalBalance, vesting, cv.StartTime, cv.EndTime), nil
+	case *campaigntypes.ShareVestingOptions_PeriodicVesting:
+		pv := opt.PeriodicVesting
+		totalBalance, err := pv.TotalShares.CoinsFromTotalSupply(totalSupply, totalShareNumber)
+		if err != nil {
+			return VestingOptions{}, err
+		}
+		vesting, err := pv.Vesting().CoinsFromTotalSupply(totalSupply, totalShareNumber)
+		if err != nil {
+			return VestingOptions{}, err
+		}
+		periods := make([]VestingPeriod, 0, len(pv.Periods))
+		distributed := sdk.NewCoins()
+		for i, period := range pv.Periods {
+			amount, err := period.Shares.CoinsFromTotalSupply(totalSupply, totalShareNumber)
+			if err != nil {
+				return VestingOptions{}, err
+			}
+			if i == len(pv.Periods)-1 {
+				amount = vesting.Sub(distributed...)
+			}
+			if amount.IsZero() {
+				return VestingOptions{}, fmt.Errorf("no coin vested for period %d of PeriodicVesting", i)
+			}
+			distributed = distributed.Add(amount...)
+			periods = append(periods, VestingPeriod{
+				Length: period.Length,
+				Amount: amount,
+			})
+		}
+		return *NewPeriodicVesting(totalBalance, pv.StartTime, periods), nil
+	default:
+		return VestingOptions{}, errors.New("unrecognized vesting options")
+	}
+}
+
+// TotalBalance returns the total balance of the account with the vesting options
+func (m VestingOptions) TotalBalance() sdk.Coins {
+	switch opt := m.Options.(type) {
+	case *VestingOptions_DelayedVesting:
+		return opt.DelayedVesting.TotalBalance
+	case *VestingOptions_ContinuousVesting:
+		return opt.ContinuousVesting.TotalBalance
+	case *VestingOptions_PeriodicVesting:
+		return opt.PeriodicVesting.TotalBalance
+	default:
+		return nil
+	}
+}
+
+// Vesting returns the total vesting coins of the periodic vesting
+func (m PeriodicVesting) Vesting() sdk.Coins {
+	vesting := sdk.NewCoins()
+	for _, period := range m.Periods {
+		vesting = vesting.Add(period.Amount...)
+	}
+	return vesting
+}
+
+// EndTime returns the time when all coins of the periodic vesting are vested
+func (m PeriodicVesting) EndTime() int64 {
+	endTime := m.StartTime
+	for _, period := range m.Periods {
+		endTime += period.Length
+	}
+	return endTime
+}
+
+// WithBalance returns the vesting options with the total balance and the vesting coins set to balance
+// the balance is split evenly among the periods of a periodic vesting, the last period gets the remainder
+// an error is returned if the balance of a denom is too small to give coins to each period
+func (m VestingOptions) WithBalance(balance sdk.Coins) (VestingOptions, error) {
+	switch opt := m.Options.(type) {
+	case *VestingOptions_DelayedVesting:
+		return *NewDelayedVesting(balance, balance, opt.DelayedVesting.EndTime), nil
+	case *VestingOptions_ContinuousVesting:
+		cv := opt.ContinuousVesting
+		return *NewContinuousVesting(balance, balance, cv.StartTime, cv.EndTime), nil
+	case *VestingOptions_PeriodicVesting:
+		pv := opt.PeriodicVesting
+		if len(pv.Periods) == 0 {
+			return *NewPeriodicVesting(balance, pv.StartTime, nil), nil
+		}
+		periodNb := sdkmath.NewInt(int64(len(pv.Periods)))
+		for _, coin := range balance {
+			if coin.Amount.LT(periodNb) {
+				return VestingOptions{}, fmt.Errorf(
+					"balance %s can't be split among the %d periods of PeriodicVesting",
+					coin.String(),
+					len(pv.Periods),
+				)
+			}
+		}
+		periods := make([]VestingPeriod, len(pv.Periods))
+		remaining := balance
+		for i, period := range pv.Periods {
+			amount := sdk.NewCoins()
+			for _, coin := range balance {
+				amount = amount.Add(sdk.NewCoin(coin.Denom, coin.Amount.Quo(periodNb)))
+			}
+			if i == len(pv.Periods)-1 {
+				amount = remaining
+			}
+			remaining = remaining.Sub(amount...)
+			periods[i] = VestingPeriod{
+				Length: period.Length,
+				Amount: amount,
+			}
+		}
+		return *NewPeriodicVesting(balance, pv.StartTime, periods), nil
+	default:
+		return m, nil
+	}
+}
+
+// Validate check the vesting options
 func (m VestingOptions) Validate() error {
 	switch vestionOptions := m.Options.(type) {
 	case *VestingOptions_DelayedVesting:
@@ -42,6 +217,65 @@ func (m VestingOptions) Validate() error {
 		if dv.EndTime == 0 {
 			return errors.New("end time for DelayedVesting cannot be 0")
 		}
+	case *VestingOptions_ContinuousVesting:
+		cv := vestionOptions.ContinuousVesting
+		if cv.Vesting.Empty() {
+			return errors.New("empty vesting coins for ContinuousVesting")
+		}
+		if !cv.Vesting.IsValid() {
+			return fmt.Errorf("invalid vesting coins for ContinuousVesting: %s", cv.Vesting.String())
+		}
+
+		if !cv.TotalBalance.IsValid() {
+			return fmt.Errorf("invalid total balance for ContinuousVesting: %s", cv.TotalBalance.String())
+		}
+
+		if !cv.Vesting.IsAllLTE(cv.TotalBalance) {
+			return errors.New("vesting is not a subset of the total balance")
+		}
+
+		if cv.StartTime == 0 {
+			return errors.New("start time for ContinuousVesting cannot be 0")
+		}
+		if cv.EndTime <= cv.StartTime {
+			return fmt.Errorf(
+				"end time for ContinuousVesting must be after start time: %d <= %d",
+				cv.EndTime,
+				cv.StartTime,
+			)
+		}
+	case *VestingOptions_PeriodicVesting:
+		pv := vestionOptions.PeriodicVesting
+		if len(pv.Periods) == 0 {
+			return errors.New("no period for PeriodicVesting")
+		}
+		for i, period := range pv.Periods {
+			if period.Length <= 0 {
+				return fmt.Errorf("length of period %d for PeriodicVesting must be positive: %d", i, period.Length)
+			}
+			if period.Amount.Empty() {
+				return fmt.Errorf("empty amount for period %d of PeriodicVesting", i)
+			}
+			if !period.Amount.IsValid() {
+				return fmt.Errorf(
+					"invalid amount for period %d of PeriodicVesting: %s",
+					i,
+					period.Amount.String(),
+				)
+			}
+		}
+
+		if !pv.TotalBalance.IsValid() {
+			return fmt.Errorf("invalid total balance for PeriodicVesting: %s", pv.TotalBalance.String())
+		}
+
+		if !pv.Vesting().IsAllLTE(pv.TotalBalance) {
+			return errors.New("vesting is not a subset of the total balance")
+		}
+
+		if pv.StartTime == 0 {
+			return errors.New("start time for PeriodicVesting cannot be 0")
+		}
 	default:
 		return errors.New("unrecognized vesting options")
 	}
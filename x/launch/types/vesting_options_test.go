@@ -10,6 +10,7 @@ import (
 
 	tc "github.com/tendermint/spn/testutil/constructor"
 	"github.com/tendermint/spn/testutil/sample"
+	campaigntypes "github.com/tendermint/spn/x/campaign/types"
 	"github.com/tendermint/spn/x/launch/types"
 )
 
@@ -131,3 +132,337 @@ func TestDelayedVesting_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestContinuousVesting_Validate(t *testing.T) {
+	sampleTotalBalance := tc.Coins(t, "1000foo,500bar,1000toto")
+	sampleVesting := tc.Coins(t, "500foo,500bar")
+	startTime := time.Now().Unix()
+	endTime := startTime + 1000
+
+	tests := []struct {
+		name   string
+		option types.VestingOptions
+		valid  bool
+	}{
+		{
+			name:   "should prevent validate continuous vesting with no vesting",
+			option: *types.NewContinuousVesting(sampleTotalBalance, nil, startTime, endTime),
+			valid:  false,
+		},
+		{
+			name: "should prevent validate continuous vesting with invalid vesting",
+			option: *types.NewContinuousVesting(
+				sampleTotalBalance,
+				sdk.Coins{sdk.Coin{Denom: "", Amount: sdkmath.NewInt(10)}},
+				startTime,
+				endTime,
+			),
+			valid: false,
+		},
+		{
+			name: "should prevent validate continuous vesting with invalid total balance",
+			option: *types.NewContinuousVesting(
+				sdk.Coins{sdk.Coin{Denom: "", Amount: sdkmath.NewInt(10)}},
+				sampleVesting,
+				startTime,
+				endTime,
+			),
+			valid: false,
+		},
+		{
+			name: "should prevent validate continuous vesting with total balance smaller than vesting",
+			option: *types.NewContinuousVesting(
+				tc.Coins(t, "1000foo,500bar"),
+				tc.Coins(t, "1000foo,501bar"),
+				startTime,
+				endTime,
+			),
+			valid: false,
+		},
+		{
+			name:   "should prevent validate continuous vesting with no start time",
+			option: *types.NewContinuousVesting(sampleTotalBalance, sampleVesting, 0, endTime),
+			valid:  false,
+		},
+		{
+			name:   "should prevent validate continuous vesting with end time before start time",
+			option: *types.NewContinuousVesting(sampleTotalBalance, sampleVesting, endTime, startTime),
+			valid:  false,
+		},
+		{
+			name:   "should prevent validate continuous vesting with end time equal to start time",
+			option: *types.NewContinuousVesting(sampleTotalBalance, sampleVesting, startTime, startTime),
+			valid:  false,
+		},
+		{
+			name:   "should validate valid continuous vesting",
+			option: *types.NewContinuousVesting(sampleTotalBalance, sampleVesting, startTime, endTime),
+			valid:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.option.Validate()
+			if tt.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestPeriodicVesting_Validate(t *testing.T) {
+	sampleTotalBalance := tc.Coins(t, "1000foo,500bar,1000toto")
+	samplePeriods := []types.VestingPeriod{
+		{Length: 100, Amount: tc.Coins(t, "500foo")},
+		{Length: 200, Amount: tc.Coins(t, "500foo,500bar")},
+	}
+	startTime := time.Now().Unix()
+
+	tests := []struct {
+		name   string
+		option types.VestingOptions
+		valid  bool
+	}{
+		{
+			name:   "should prevent validate periodic vesting with no period",
+			option: *types.NewPeriodicVesting(sampleTotalBalance, startTime, nil),
+			valid:  false,
+		},
+		{
+			name: "should prevent validate periodic vesting with a period with no length",
+			option: *types.NewPeriodicVesting(sampleTotalBalance, startTime, []types.VestingPeriod{
+				{Length: 0, Amount: tc.Coins(t, "500foo")},
+			}),
+			valid: false,
+		},
+		{
+			name: "should prevent validate periodic vesting with a period with no amount",
+			option: *types.NewPeriodicVesting(sampleTotalBalance, startTime, []types.VestingPeriod{
+				{Length: 100, Amount: nil},
+			}),
+			valid: false,
+		},
+		{
+			name: "should prevent validate periodic vesting with a period with invalid amount",
+			option: *types.NewPeriodicVesting(sampleTotalBalance, startTime, []types.VestingPeriod{
+				{Length: 100, Amount: sdk.Coins{sdk.Coin{Denom: "", Amount: sdkmath.NewInt(10)}}},
+			}),
+			valid: false,
+		},
+		{
+			name: "should prevent validate periodic vesting with invalid total balance",
+			option: *types.NewPeriodicVesting(
+				sdk.Coins{sdk.Coin{Denom: "", Amount: sdkmath.NewInt(10)}},
+				startTime,
+				samplePeriods,
+			),
+			valid: false,
+		},
+		{
+			name:   "should prevent validate periodic vesting with total balance smaller than vesting",
+			option: *types.NewPeriodicVesting(tc.Coins(t, "999foo,500bar"), startTime, samplePeriods),
+			valid:  false,
+		},
+		{
+			name:   "should prevent validate periodic vesting with no start time",
+			option: *types.NewPeriodicVesting(sampleTotalBalance, 0, samplePeriods),
+			valid:  false,
+		},
+		{
+			name:   "should validate valid periodic vesting",
+			option: *types.NewPeriodicVesting(sampleTotalBalance, startTime, samplePeriods),
+			valid:  true,
+		},
+		{
+			name:   "should validate periodic vesting with vesting equal to total balance",
+			option: *types.NewPeriodicVesting(tc.Coins(t, "1000foo,500bar"), startTime, samplePeriods),
+			valid:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.option.Validate()
+			if tt.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestPeriodicVesting(t *testing.T) {
+	pv := types.PeriodicVesting{
+		TotalBalance: tc.Coins(t, "1000foo,1000bar"),
+		StartTime:    1000,
+		Periods: []types.VestingPeriod{
+			{Length: 100, Amount: tc.Coins(t, "500foo")},
+			{Length: 200, Amount: tc.Coins(t, "500foo,500bar")},
+		},
+	}
+
+	t.Run("should return the total vesting of the periods", func(t *testing.T) {
+		require.Equal(t, tc.Coins(t, "1000foo,500bar"), pv.Vesting())
+	})
+
+	t.Run("should return the end time of the last period", func(t *testing.T) {
+		require.EqualValues(t, 1300, pv.EndTime())
+	})
+}
+
+func TestVestingOptions_WithBalance(t *testing.T) {
+	balance := tc.Coins(t, "1000foo,10bar")
+
+	tests := []struct {
+		name    string
+		options types.VestingOptions
+		want    types.VestingOptions
+	}{
+		{
+			name:    "should set the balance of a delayed vesting",
+			options: *types.NewDelayedVesting(tc.Coins(t, "100foo"), tc.Coins(t, "100foo"), 1000),
+			want:    *types.NewDelayedVesting(balance, balance, 1000),
+		},
+		{
+			name:    "should set the balance of a continuous vesting",
+			options: *types.NewContinuousVesting(tc.Coins(t, "100foo"), tc.Coins(t, "100foo"), 1000, 2000),
+			want:    *types.NewContinuousVesting(balance, balance, 1000, 2000),
+		},
+		{
+			name: "should split the balance among the periods of a periodic vesting",
+			options: *types.NewPeriodicVesting(tc.Coins(t, "100foo"), 1000, []types.VestingPeriod{
+				{Length: 100, Amount: tc.Coins(t, "20foo")},
+				{Length: 200, Amount: tc.Coins(t, "30foo")},
+				{Length: 300, Amount: tc.Coins(t, "50foo")},
+			}),
+			want: *types.NewPeriodicVesting(balance, 1000, []types.VestingPeriod{
+				{Length: 100, Amount: tc.Coins(t, "333foo,3bar")},
+				{Length: 200, Amount: tc.Coins(t, "333foo,3bar")},
+				{Length: 300, Amount: tc.Coins(t, "334foo,4bar")},
+			}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.options.WithBalance(balance)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+			require.Equal(t, balance, got.TotalBalance())
+			require.NoError(t, got.Validate())
+		})
+	}
+
+	t.Run("should prevent splitting a balance smaller than the number of periods", func(t *testing.T) {
+		options := *types.NewPeriodicVesting(tc.Coins(t, "100foo"), 1000, []types.VestingPeriod{
+			{Length: 100, Amount: tc.Coins(t, "20foo")},
+			{Length: 200, Amount: tc.Coins(t, "30foo")},
+			{Length: 300, Amount: tc.Coins(t, "50foo")},
+		})
+		_, err := options.WithBalance(tc.Coins(t, "1000foo,2bar"))
+		require.Error(t, err)
+	})
+}
+
+func TestNewVestingOptionsFromShares(t *testing.T) {
+	var (
+		totalSupply      = tc.Coins(t, "1000foo,100bar,15baz")
+		totalShareNumber = uint64(100)
+	)
+
+	tests := []struct {
+		name    string
+		options campaigntypes.ShareVestingOptions
+		want    types.VestingOptions
+		wantErr bool
+	}{
+		{
+			name: "should convert share delayed vesting",
+			options: *campaigntypes.NewShareDelayedVesting(
+				tc.Shares(t, "50foo,10bar"),
+				tc.Shares(t, "10foo"),
+				1000,
+			),
+			want: *types.NewDelayedVesting(tc.Coins(t, "500foo,10bar"), tc.Coins(t, "100foo"), 1000),
+		},
+		{
+			name: "should convert share continuous vesting",
+			options: *campaigntypes.NewShareContinuousVesting(
+				tc.Shares(t, "50foo,10bar"),
+				tc.Shares(t, "10foo"),
+				1000,
+				2000,
+			),
+			want: *types.NewContinuousVesting(tc.Coins(t, "500foo,10bar"), tc.Coins(t, "100foo"), 1000, 2000),
+		},
+		{
+			name: "should convert share periodic vesting",
+			options: *campaigntypes.NewSharePeriodicVesting(
+				tc.Shares(t, "50foo,10bar"),
+				1000,
+				[]campaigntypes.ShareVestingPeriod{
+					{Length: 100, Shares: tc.Shares(t, "10foo")},
+					{Length: 200, Shares: tc.Shares(t, "10foo,10bar")},
+				},
+			),
+			want: *types.NewPeriodicVesting(tc.Coins(t, "500foo,10bar"), 1000, []types.VestingPeriod{
+				{Length: 100, Amount: tc.Coins(t, "100foo")},
+				{Length: 200, Amount: tc.Coins(t, "100foo,10bar")},
+			}),
+		},
+		{
+			name: "should add the rounding remainder to the last period of a periodic vesting",
+			options: *campaigntypes.NewSharePeriodicVesting(
+				tc.Shares(t, "20baz"),
+				1000,
+				[]campaigntypes.ShareVestingPeriod{
+					{Length: 100, Shares: tc.Shares(t, "10baz")},
+					{Length: 100, Shares: tc.Shares(t, "10baz")},
+				},
+			),
+			want: *types.NewPeriodicVesting(tc.Coins(t, "3baz"), 1000, []types.VestingPeriod{
+				{Length: 100, Amount: tc.Coins(t, "1baz")},
+				{Length: 100, Amount: tc.Coins(t, "2baz")},
+			}),
+		},
+		{
+			name: "should prevent converting a periodic vesting with an empty period",
+			options: *campaigntypes.NewSharePeriodicVesting(
+				tc.Shares(t, "10foo,1baz"),
+				1000,
+				[]campaigntypes.ShareVestingPeriod{
+					{Length: 100, Shares: tc.Shares(t, "1baz")},
+					{Length: 100, Shares: tc.Shares(t, "10foo")},
+				},
+			),
+			wantErr: true,
+		},
+		{
+			name: "should prevent converting shares greater than total share number",
+			options: *campaigntypes.NewShareContinuousVesting(
+				tc.Shares(t, "101foo"),
+				tc.Shares(t, "101foo"),
+				1000,
+				2000,
+			),
+			wantErr: true,
+		},
+		{
+			name:    "should prevent converting unrecognized vesting options",
+			options: campaigntypes.ShareVestingOptions{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := types.NewVestingOptionsFromShares(tt.options, totalSupply, totalShareNumber)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -27,6 +27,7 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		CmdShowLaunchIDFromChannelID(),
 		CmdListLaunchIDFromChannelID(),
 		CmdShowMonitoringHistory(),
+		CmdShowMonitoringClientStatus(),
 		CmdQueryParams(),
 	)
 
@@ -20,6 +20,8 @@ func GetTxCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdCreateClient())
+	cmd.AddCommand(CmdRecoverMonitoringClient())
+	cmd.AddCommand(CmdCloseRewardPool())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/ibc-go/v5/modules/core/exported"
+
+	"github.com/tendermint/spn/x/monitoringc/types"
+)
+
+// GetClientStatus returns the status of an IBC client, Unknown is returned if the client doesn't exist
+func (k Keeper) GetClientStatus(ctx sdk.Context, clientID string) exported.Status {
+	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
+	if !found {
+		return exported.Unknown
+	}
+	return clientState.Status(ctx, k.clientKeeper.ClientStore(ctx, clientID), k.cdc)
+}
+
+// GetMonitoringClientStatuses returns the status of the verified clients of a chain
+func (k Keeper) GetMonitoringClientStatuses(ctx sdk.Context, launchID uint64) ([]types.MonitoringClientStatus, bool) {
+	verifiedClientID, found := k.GetVerifiedClientID(ctx, launchID)
+	if !found {
+		return nil, false
+	}
+	providerClientID, hasProvider := k.GetProviderClientID(ctx, launchID)
+
+	statuses := make([]types.MonitoringClientStatus, 0, len(verifiedClientID.ClientIDs))
+	for _, clientID := range verifiedClientID.ClientIDs {
+		statuses = append(statuses, types.MonitoringClientStatus{
+			ClientID: clientID,
+			Status:   k.GetClientStatus(ctx, clientID).String(),
+			Provider: hasProvider && providerClientID.ClientID == clientID,
+		})
+	}
+	return statuses, true
+}
+
+// GetProviderClientStatus returns the status of the client of the provider connection of a chain
+// The monitoring of the chain is inactive while the client is frozen or expired
+func (k Keeper) GetProviderClientStatus(ctx sdk.Context, launchID uint64) (exported.Status, bool) {
+	providerClientID, found := k.GetProviderClientID(ctx, launchID)
+	if !found {
+		return exported.Unknown, false
+	}
+	return k.GetClientStatus(ctx, providerClientID.ClientID), true
+}
@@ -0,0 +1,189 @@
+package keeper_test
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v5/modules/core/exported"
+	ibctmtypes "github.com/cosmos/ibc-go/v5/modules/light-clients/07-tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	spntypes "github.com/tendermint/spn/pkg/types"
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/sample"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+	"github.com/tendermint/spn/x/monitoringc/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+)
+
+const verifiedClientConsPubKey = "jP0v8F0e2kSAS367V/QAikddQPze+V36v7lhkv1Iqgg="
+
+// verifiedClientConsensusState returns a consensus state matching the validator set of the verified client
+func verifiedClientConsensusState(timestamp string) spntypes.ConsensusState {
+	return spntypes.NewConsensusState(
+		timestamp,
+		"A13E761948413E405EA4F09BEC9F37632F739404108FE1635CB3529B61DA9FD7",
+		"47DEQpj8HBSa+/TImW+5JCeuQeRkm5NMpJWZG3hSuFU=",
+	)
+}
+
+// verifiedClientValidatorSet returns the validator set of the verified client
+func verifiedClientValidatorSet() spntypes.ValidatorSet {
+	return spntypes.NewValidatorSet(spntypes.NewValidator(verifiedClientConsPubKey, 0, 100))
+}
+
+// createVerifiedClient creates a launched chain with a verified client and returns the launch ID and the client ID
+func createVerifiedClient(
+	t *testing.T,
+	sdkCtx sdk.Context,
+	ts testkeeper.TestMsgServers,
+	coordAddr string,
+) (uint64, string) {
+	ctx := sdk.WrapSDKContext(sdkCtx)
+
+	selfDelegation, err := sdk.ParseCoinNormalized("1000stake")
+	require.NoError(t, err)
+	consPubKey, err := base64.StdEncoding.DecodeString(verifiedClientConsPubKey)
+	require.NoError(t, err)
+
+	_, err = ts.ProfileSrv.CreateCoordinator(ctx, profiletypes.NewMsgCreateCoordinator(coordAddr, "", "", ""))
+	require.NoError(t, err)
+	resCreateChain, err := ts.LaunchSrv.CreateChain(ctx, launchtypes.NewMsgCreateChain(
+		coordAddr,
+		"orbit-1",
+		sample.String(r, 10),
+		sample.String(r, 10),
+		"",
+		"",
+		false,
+		0,
+		sample.Coins(r),
+		sample.Metadata(r, 20),
+	))
+	require.NoError(t, err)
+	_, err = ts.LaunchSrv.RequestAddValidator(ctx, launchtypes.NewMsgRequestAddValidator(
+		coordAddr,
+		resCreateChain.LaunchID,
+		sample.Address(r),
+		sample.Bytes(r, 100),
+		consPubKey,
+		selfDelegation,
+		sample.GenesisValidatorPeer(r),
+	))
+	require.NoError(t, err)
+	_, err = ts.LaunchSrv.TriggerLaunch(ctx, launchtypes.NewMsgTriggerLaunch(
+		coordAddr,
+		resCreateChain.LaunchID,
+		sdkCtx.BlockTime().Add(launchtypes.DefaultMinLaunchTime),
+	))
+	require.NoError(t, err)
+
+	resCreateClient, err := ts.MonitoringcSrv.CreateClient(ctx, types.NewMsgCreateClient(
+		sample.Address(r),
+		resCreateChain.LaunchID,
+		verifiedClientConsensusState("2022-02-08T15:12:36.161481Z"),
+		verifiedClientValidatorSet(),
+		spntypes.DefaultUnbondingPeriod,
+		spntypes.DefaultRevisionHeight,
+	))
+	require.NoError(t, err)
+
+	return resCreateChain.LaunchID, resCreateClient.ClientID
+}
+
+// freezeClient freezes an IBC client as if a misbehaviour was submitted
+func freezeClient(t *testing.T, ctx sdk.Context, tk testkeeper.TestKeepers, clientID string) {
+	clientState, found := tk.IBCKeeper.ClientKeeper.GetClientState(ctx, clientID)
+	require.True(t, found)
+	tmClientState, ok := clientState.(*ibctmtypes.ClientState)
+	require.True(t, ok)
+	tmClientState.FrozenHeight = clienttypes.NewHeight(0, 1)
+	tk.IBCKeeper.ClientKeeper.SetClientState(ctx, clientID, tmClientState)
+}
+
+func TestKeeper_GetClientStatus(t *testing.T) {
+	ctx, tk, ts := testkeeper.NewTestSetup(t)
+	_, clientID := createVerifiedClient(t, ctx, ts, sample.Address(r))
+
+	t.Run("should return unknown for a non existing client", func(t *testing.T) {
+		require.Equal(t, exported.Unknown, tk.MonitoringConsumerKeeper.GetClientStatus(ctx, "07-tendermint-1000"))
+	})
+
+	t.Run("should return active for a client within its trusting period", func(t *testing.T) {
+		require.Equal(t, exported.Active, tk.MonitoringConsumerKeeper.GetClientStatus(ctx, clientID))
+	})
+
+	t.Run("should return expired for a client after its trusting period", func(t *testing.T) {
+		expiredCtx := ctx.WithBlockTime(time.Date(2022, time.March, 10, 0, 0, 0, 0, time.UTC))
+		require.Equal(t, exported.Expired, tk.MonitoringConsumerKeeper.GetClientStatus(expiredCtx, clientID))
+	})
+
+	t.Run("should return frozen for a client frozen after a misbehaviour", func(t *testing.T) {
+		freezeClient(t, ctx, tk, clientID)
+		require.Equal(t, exported.Frozen, tk.MonitoringConsumerKeeper.GetClientStatus(ctx, clientID))
+	})
+}
+
+func TestKeeper_GetMonitoringClientStatuses(t *testing.T) {
+	ctx, tk, ts := testkeeper.NewTestSetup(t)
+	launchID, clientID := createVerifiedClient(t, ctx, ts, sample.Address(r))
+
+	t.Run("should return not found for a chain without verified clients", func(t *testing.T) {
+		_, found := tk.MonitoringConsumerKeeper.GetMonitoringClientStatuses(ctx, launchID+1)
+		require.False(t, found)
+	})
+
+	t.Run("should return the status of the verified clients", func(t *testing.T) {
+		statuses, found := tk.MonitoringConsumerKeeper.GetMonitoringClientStatuses(ctx, launchID)
+		require.True(t, found)
+		require.EqualValues(t, []types.MonitoringClientStatus{
+			{
+				ClientID: clientID,
+				Status:   exported.Active.String(),
+				Provider: false,
+			},
+		}, statuses)
+	})
+
+	t.Run("should report the client used by the provider connection", func(t *testing.T) {
+		tk.MonitoringConsumerKeeper.SetProviderClientID(ctx, types.ProviderClientID{
+			LaunchID: launchID,
+			ClientID: clientID,
+		})
+		statuses, found := tk.MonitoringConsumerKeeper.GetMonitoringClientStatuses(ctx, launchID)
+		require.True(t, found)
+		require.Len(t, statuses, 1)
+		require.True(t, statuses[0].Provider)
+	})
+}
+
+func TestKeeper_GetProviderClientStatus(t *testing.T) {
+	var (
+		ctx, tk, ts        = testkeeper.NewTestSetup(t)
+		launchID, clientID = createVerifiedClient(t, ctx, ts, sample.Address(r))
+		expiredCtx         = ctx.WithBlockTime(time.Date(2022, time.March, 10, 0, 0, 0, 0, time.UTC))
+	)
+
+	t.Run("should return not found for a chain without provider client", func(t *testing.T) {
+		_, found := tk.MonitoringConsumerKeeper.GetProviderClientStatus(ctx, launchID)
+		require.False(t, found)
+	})
+
+	tk.MonitoringConsumerKeeper.SetProviderClientID(ctx, types.ProviderClientID{
+		LaunchID: launchID,
+		ClientID: clientID,
+	})
+
+	t.Run("should return the status of the provider client", func(t *testing.T) {
+		status, found := tk.MonitoringConsumerKeeper.GetProviderClientStatus(ctx, launchID)
+		require.True(t, found)
+		require.Equal(t, exported.Active, status)
+
+		status, found = tk.MonitoringConsumerKeeper.GetProviderClientStatus(expiredCtx, launchID)
+		require.True(t, found)
+		require.Equal(t, exported.Expired, status)
+	})
+}
@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/tendermint/spn/x/monitoringc/types"
+)
+
+func (k Keeper) MonitoringClientStatus(
+	goCtx context.Context,
+	req *types.QueryGetMonitoringClientStatusRequest,
+) (*types.QueryGetMonitoringClientStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	clientStatuses, found := k.GetMonitoringClientStatuses(ctx, req.LaunchID)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "launch id not found %d", req.LaunchID)
+	}
+
+	return &types.QueryGetMonitoringClientStatusResponse{
+		ClientStatuses: clientStatuses,
+	}, nil
+}
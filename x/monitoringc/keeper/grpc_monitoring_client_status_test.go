@@ -0,0 +1,64 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/ibc-go/v5/modules/core/exported"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/nullify"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/monitoringc/types"
+)
+
+func TestMonitoringClientStatus(t *testing.T) {
+	ctx, tk, ts := testkeeper.NewTestSetup(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	launchID, clientID := createVerifiedClient(t, ctx, ts, sample.Address(r))
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryGetMonitoringClientStatusRequest
+		response *types.QueryGetMonitoringClientStatusResponse
+		err      error
+	}{
+		{
+			desc: "found",
+			request: &types.QueryGetMonitoringClientStatusRequest{
+				LaunchID: launchID,
+			},
+			response: &types.QueryGetMonitoringClientStatusResponse{
+				ClientStatuses: []types.MonitoringClientStatus{
+					{ClientID: clientID, Status: exported.Active.String()},
+				},
+			},
+		},
+		{
+			desc: "key not found",
+			request: &types.QueryGetMonitoringClientStatusRequest{
+				LaunchID: 100000,
+			},
+			err: status.Error(codes.NotFound, "launch id not found 100000"),
+		},
+		{
+			desc: "invalid request",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := tk.MonitoringConsumerKeeper.MonitoringClientStatus(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response),
+				)
+			}
+		})
+	}
+}
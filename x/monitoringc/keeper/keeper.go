@@ -22,8 +22,10 @@ type (
 		storeKey         storetypes.StoreKey
 		memKey           storetypes.StoreKey
 		paramstore       paramtypes.Subspace
+		authority        string
 		scopedKeeper     capabilitykeeper.ScopedKeeper
 		launchKeeper     types.LaunchKeeper
+		profileKeeper    types.ProfileKeeper
 		rewardKeeper     types.RewardKeeper
 		clientKeeper     types.ClientKeeper
 		portKeeper       types.PortKeeper
@@ -43,7 +45,9 @@ func NewKeeper(
 	portKeeper types.PortKeeper,
 	scopedKeeper capabilitykeeper.ScopedKeeper,
 	launchKeeper types.LaunchKeeper,
+	profileKeeper types.ProfileKeeper,
 	rewardKeeper types.RewardKeeper,
+	authority string,
 ) *Keeper {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
@@ -56,8 +60,10 @@ func NewKeeper(
 		storeKey:         storeKey,
 		memKey:           memKey,
 		paramstore:       ps,
+		authority:        authority,
 		scopedKeeper:     scopedKeeper,
 		launchKeeper:     launchKeeper,
+		profileKeeper:    profileKeeper,
 		rewardKeeper:     rewardKeeper,
 		clientKeeper:     clientKeeper,
 		portKeeper:       portKeeper,
@@ -70,6 +76,11 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
+// GetAuthority returns the address allowed to update the module parameters
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // IsBound checks if the module is already bound to the desired port
 func (k Keeper) IsBound(ctx sdk.Context, portID string) bool {
 	_, ok := k.scopedKeeper.GetCapability(ctx, host.PortPath(portID))
@@ -1,9 +1,9 @@
 package keeper
 
 import (
-	"errors"
 	"fmt"
 
+	sdkerrors "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
 
@@ -35,12 +35,14 @@ func (k Keeper) OnRecvMonitoringPacket(
 	})
 
 	// distribute reward from the signature count
+	// the monitoring packets can be sent periodically, the reward pool is closed once the last reward height
+	// is reached or when the provider sends its last round if its last block height is below the last reward height
 	err = k.rewardKeeper.DistributeRewards(
 		ctx,
 		lidFromCid.LaunchID,
 		data.SignatureCounts,
 		data.BlockHeight,
-		true,
+		data.LastRound,
 	)
 
 	return packetAck, err
@@ -48,20 +50,22 @@ func (k Keeper) OnRecvMonitoringPacket(
 
 // OnAcknowledgementMonitoringPacket responds to the the success or failure of a packet
 // acknowledgement written on the receiving chain.
+// The monitoring packets are only sent by the provider chain, the consumer chain never receives acknowledgements
 func (k Keeper) OnAcknowledgementMonitoringPacket(
 	_ sdk.Context,
 	_ channeltypes.Packet,
 	_ spntypes.MonitoringPacket,
 	_ channeltypes.Acknowledgement,
 ) error {
-	return errors.New("not implemented")
+	return sdkerrors.Wrap(types.ErrUnexpectedPacket, "unexpected acknowledgement")
 }
 
 // OnTimeoutMonitoringPacket responds to the case where a packet has not been transmitted because of a timeout
+// The monitoring packets are only sent by the provider chain, the consumer chain never receives timeouts
 func (k Keeper) OnTimeoutMonitoringPacket(
 	_ sdk.Context,
 	_ channeltypes.Packet,
 	_ spntypes.MonitoringPacket,
 ) error {
-	return errors.New("not implemented")
+	return sdkerrors.Wrap(types.ErrUnexpectedPacket, "unexpected timeout")
 }
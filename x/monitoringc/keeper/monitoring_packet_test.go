@@ -135,6 +135,72 @@ func Test_OnRecvMonitoringPacket(t *testing.T) {
 			require.NoError(t, err)
 		})
 	}
+
+	periodicChain := sample.Chain(r, 0, 0)
+	periodicChain.LaunchID = tk.LaunchKeeper.AppendChain(ctx, periodicChain)
+	tk.MonitoringConsumerKeeper.SetLaunchIDFromChannelID(ctx, types.LaunchIDFromChannelID{
+		ChannelID: "periodic",
+		LaunchID:  periodicChain.LaunchID,
+	})
+	tk.RewardKeeper.SetRewardPool(ctx, rewardtypes.RewardPool{
+		LaunchID:         periodicChain.LaunchID,
+		Provider:         sample.Address(r),
+		InitialCoins:     coins,
+		RemainingCoins:   coins,
+		LastRewardHeight: 100,
+	})
+	require.NoError(t, tk.BankKeeper.MintCoins(ctx, rewardtypes.ModuleName, coins))
+
+	t.Run("should keep the reward pool open until the last reward height is reached", func(t *testing.T) {
+		_, err := tk.MonitoringConsumerKeeper.OnRecvMonitoringPacket(ctx, channeltypes.Packet{
+			DestinationChannel: "periodic",
+		}, spntypes.MonitoringPacket{
+			BlockHeight: 10,
+			SignatureCounts: tc.SignatureCounts(10,
+				tc.SignatureCount(t, valOpAddrFoo, "0.5"),
+				tc.SignatureCount(t, valOpAddrBar, "0.5"),
+			),
+		})
+		require.NoError(t, err)
+
+		rewardPool, found := tk.RewardKeeper.GetRewardPool(ctx, periodicChain.LaunchID)
+		require.True(t, found)
+		require.False(t, rewardPool.Closed)
+		require.EqualValues(t, 10, rewardPool.CurrentRewardHeight)
+	})
+	t.Run("should accept a packet without monitored blocks", func(t *testing.T) {
+		_, err := tk.MonitoringConsumerKeeper.OnRecvMonitoringPacket(ctx, channeltypes.Packet{
+			DestinationChannel: "periodic",
+		}, spntypes.MonitoringPacket{
+			BlockHeight:     15,
+			SignatureCounts: tc.SignatureCounts(0),
+		})
+		require.NoError(t, err)
+
+		rewardPool, found := tk.RewardKeeper.GetRewardPool(ctx, periodicChain.LaunchID)
+		require.True(t, found)
+		require.False(t, rewardPool.Closed)
+		require.EqualValues(t, 15, rewardPool.CurrentRewardHeight)
+	})
+	t.Run("should close the reward pool on the last round of the provider", func(t *testing.T) {
+		_, err := tk.MonitoringConsumerKeeper.OnRecvMonitoringPacket(ctx, channeltypes.Packet{
+			DestinationChannel: "periodic",
+		}, spntypes.MonitoringPacket{
+			BlockHeight: 20,
+			SignatureCounts: tc.SignatureCounts(10,
+				tc.SignatureCount(t, valOpAddrFoo, "0.5"),
+				tc.SignatureCount(t, valOpAddrBar, "0.5"),
+			),
+			LastRound: true,
+		})
+		require.NoError(t, err)
+
+		// the last block height of the provider is below the last reward height of the pool
+		rewardPool, found := tk.RewardKeeper.GetRewardPool(ctx, periodicChain.LaunchID)
+		require.True(t, found)
+		require.True(t, rewardPool.Closed)
+		require.True(t, rewardPool.RemainingCoins.IsZero())
+	})
 }
 
 func Test_OnAcknowledgementMonitoringPacket(t *testing.T) {
@@ -145,7 +211,7 @@ func Test_OnAcknowledgementMonitoringPacket(t *testing.T) {
 		spntypes.MonitoringPacket{},
 		channeltypes.Acknowledgement{},
 	)
-	require.EqualError(t, err, "not implemented")
+	require.ErrorIs(t, err, types.ErrUnexpectedPacket)
 }
 
 func Test_OnTimeoutMonitoringPacket(t *testing.T) {
@@ -155,5 +221,5 @@ func Test_OnTimeoutMonitoringPacket(t *testing.T) {
 		channeltypes.Packet{},
 		spntypes.MonitoringPacket{},
 	)
-	require.EqualError(t, err, "not implemented")
+	require.ErrorIs(t, err, types.ErrUnexpectedPacket)
 }
@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/ibc-go/v5/modules/core/exported"
+	ignterrors "github.com/ignite/modules/errors"
+
+	"github.com/tendermint/spn/x/monitoringc/types"
+	rewardtypes "github.com/tendermint/spn/x/reward/types"
+)
+
+const (
+	// RewardPoolCloseDelay is the number of blocks of the monitored chain after the last reward height
+	// during which the last monitoring packet can still be relayed before the reward pool can be closed
+	RewardPoolCloseDelay = 100000
+
+	// RewardPoolConnectionTimeout is the duration on SPN after the launch of the chain during which
+	// the monitoring connection can be established before the reward pool can be closed
+	RewardPoolConnectionTimeout = 30 * 24 * time.Hour
+)
+
+func (k msgServer) CloseRewardPool(
+	goCtx context.Context,
+	msg *types.MsgCloseRewardPool,
+) (*types.MsgCloseRewardPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	rewardPool, found := k.rewardKeeper.GetRewardPool(ctx, msg.LaunchID)
+	if !found {
+		return nil, sdkerrors.Wrapf(rewardtypes.ErrRewardPoolNotFound, "%d", msg.LaunchID)
+	}
+	if rewardPool.Closed {
+		return nil, sdkerrors.Wrapf(rewardtypes.ErrRewardPoolClosed, "%d", msg.LaunchID)
+	}
+
+	if err := k.checkMonitoringPeriodEnded(ctx, rewardPool); err != nil {
+		return nil, err
+	}
+
+	if err := k.rewardKeeper.CloseRewardPool(ctx, msg.LaunchID); err != nil {
+		return nil, ignterrors.Criticalf("reward pool can't be closed %s", err.Error())
+	}
+
+	return &types.MsgCloseRewardPoolResponse{}, nil
+}
+
+// checkMonitoringPeriodEnded checks no more monitoring packet can be received for the reward pool
+// the period is ended if the monitored chain reached the close height, if the monitoring is inactive
+// or if no monitoring connection has been established after the connection timeout
+func (k msgServer) checkMonitoringPeriodEnded(ctx sdk.Context, rewardPool rewardtypes.RewardPool) error {
+	launchID := rewardPool.LaunchID
+
+	providerClientID, found := k.GetProviderClientID(ctx, launchID)
+	if !found {
+		chain, found := k.launchKeeper.GetChain(ctx, launchID)
+		if !found {
+			return ignterrors.Criticalf("chain %d of the reward pool not found", launchID)
+		}
+		if !chain.LaunchTriggered {
+			return sdkerrors.Wrapf(
+				types.ErrMonitoringPeriodNotEnded,
+				"the chain %d is not launched",
+				launchID,
+			)
+		}
+		timeout := chain.LaunchTime.Add(RewardPoolConnectionTimeout)
+		if ctx.BlockTime().Before(timeout) {
+			return sdkerrors.Wrapf(
+				types.ErrMonitoringPeriodNotEnded,
+				"no monitoring connection established for the chain %d, the reward pool can be closed at %s",
+				launchID,
+				timeout.String(),
+			)
+		}
+		return nil
+	}
+
+	// the monitored chain can no longer be tracked with an inactive provider client
+	status := k.GetClientStatus(ctx, providerClientID.ClientID)
+	if status == exported.Frozen || status == exported.Expired {
+		return nil
+	}
+
+	// the height of the monitored chain is known from the provider client
+	clientState, found := k.clientKeeper.GetClientState(ctx, providerClientID.ClientID)
+	if !found {
+		return ignterrors.Criticalf("client state of the provider client %s not found", providerClientID.ClientID)
+	}
+	height := clientState.GetLatestHeight().GetRevisionHeight()
+	closeHeight := uint64(rewardPool.LastRewardHeight) + RewardPoolCloseDelay
+	if height < closeHeight {
+		return sdkerrors.Wrapf(
+			types.ErrMonitoringPeriodNotEnded,
+			"the reward pool of the chain %d can be closed at height %d, current height is %d",
+			launchID,
+			closeHeight,
+			height,
+		)
+	}
+	return nil
+}
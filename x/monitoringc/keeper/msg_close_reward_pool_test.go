@@ -0,0 +1,160 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	spntypes "github.com/tendermint/spn/pkg/types"
+	tc "github.com/tendermint/spn/testutil/constructor"
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/sample"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+	"github.com/tendermint/spn/x/monitoringc/keeper"
+	"github.com/tendermint/spn/x/monitoringc/types"
+	rewardtypes "github.com/tendermint/spn/x/reward/types"
+)
+
+func Test_msgServer_CloseRewardPool(t *testing.T) {
+	var (
+		coordAddr                  = sample.Address(r)
+		provider                   = sample.Address(r)
+		sdkCtx, tk, ts             = testkeeper.NewTestSetup(t)
+		launchID, clientID         = createVerifiedClient(t, sdkCtx, ts, coordAddr)
+		noConnectionLaunchID, _    = createVerifiedClient(t, sdkCtx, ts, sample.Address(r))
+		frozenLaunchID, frozenID   = createVerifiedClient(t, sdkCtx, ts, sample.Address(r))
+		expiredLaunchID, expiredID = createVerifiedClient(t, sdkCtx, ts, sample.Address(r))
+		expiredCtx                 = sdkCtx.WithBlockTime(time.Date(2022, time.March, 10, 0, 0, 0, 0, time.UTC))
+		timeoutCtx                 = sdkCtx.WithBlockTime(sdkCtx.BlockTime().Add(
+			launchtypes.DefaultMinLaunchTime + keeper.RewardPoolConnectionTimeout,
+		))
+		coins                  = tc.Coins(t, "100aaa,100bbb")
+		lastRewardHeight int64 = 10
+	)
+
+	// the reward pools are funded and the monitoring connection is established for the chains
+	for id, p := range map[uint64]string{
+		launchID:             provider,
+		noConnectionLaunchID: sample.Address(r),
+		frozenLaunchID:       sample.Address(r),
+		expiredLaunchID:      sample.Address(r),
+	} {
+		tk.RewardKeeper.SetRewardPool(sdkCtx, rewardtypes.RewardPool{
+			LaunchID:         id,
+			Provider:         p,
+			InitialCoins:     coins,
+			RemainingCoins:   coins,
+			LastRewardHeight: lastRewardHeight,
+		})
+		require.NoError(t, tk.BankKeeper.MintCoins(sdkCtx, rewardtypes.ModuleName, coins))
+	}
+	for id, cID := range map[uint64]string{
+		launchID:        clientID,
+		frozenLaunchID:  frozenID,
+		expiredLaunchID: expiredID,
+	} {
+		tk.MonitoringConsumerKeeper.SetProviderClientID(sdkCtx, types.ProviderClientID{
+			LaunchID: id,
+			ClientID: cID,
+		})
+	}
+	freezeClient(t, sdkCtx, tk, frozenID)
+
+	t.Run("should prevent closing a non existent reward pool", func(t *testing.T) {
+		_, err := ts.MonitoringcSrv.CloseRewardPool(
+			sdk.WrapSDKContext(sdkCtx),
+			types.NewMsgCloseRewardPool(sample.Address(r), 1000),
+		)
+		require.ErrorIs(t, err, rewardtypes.ErrRewardPoolNotFound)
+	})
+
+	t.Run("should prevent closing a reward pool without monitoring connection", func(t *testing.T) {
+		_, err := ts.MonitoringcSrv.CloseRewardPool(
+			sdk.WrapSDKContext(sdkCtx),
+			types.NewMsgCloseRewardPool(sample.Address(r), noConnectionLaunchID),
+		)
+		require.ErrorIs(t, err, types.ErrMonitoringPeriodNotEnded)
+	})
+
+	t.Run("should allow closing a reward pool without monitoring connection after the connection timeout", func(t *testing.T) {
+		_, err := ts.MonitoringcSrv.CloseRewardPool(
+			sdk.WrapSDKContext(timeoutCtx),
+			types.NewMsgCloseRewardPool(sample.Address(r), noConnectionLaunchID),
+		)
+		require.NoError(t, err)
+
+		rewardPool, found := tk.RewardKeeper.GetRewardPool(timeoutCtx, noConnectionLaunchID)
+		require.True(t, found)
+		require.True(t, rewardPool.Closed)
+	})
+
+	t.Run("should allow closing a reward pool with a frozen provider client", func(t *testing.T) {
+		_, err := ts.MonitoringcSrv.CloseRewardPool(
+			sdk.WrapSDKContext(sdkCtx),
+			types.NewMsgCloseRewardPool(sample.Address(r), frozenLaunchID),
+		)
+		require.NoError(t, err)
+
+		rewardPool, found := tk.RewardKeeper.GetRewardPool(sdkCtx, frozenLaunchID)
+		require.True(t, found)
+		require.True(t, rewardPool.Closed)
+	})
+
+	t.Run("should allow closing a reward pool with an expired provider client", func(t *testing.T) {
+		_, err := ts.MonitoringcSrv.CloseRewardPool(
+			sdk.WrapSDKContext(expiredCtx),
+			types.NewMsgCloseRewardPool(sample.Address(r), expiredLaunchID),
+		)
+		require.NoError(t, err)
+
+		rewardPool, found := tk.RewardKeeper.GetRewardPool(expiredCtx, expiredLaunchID)
+		require.True(t, found)
+		require.True(t, rewardPool.Closed)
+	})
+
+	t.Run("should prevent closing a reward pool before the close delay", func(t *testing.T) {
+		_, err := ts.MonitoringcSrv.CloseRewardPool(
+			sdk.WrapSDKContext(sdkCtx),
+			types.NewMsgCloseRewardPool(sample.Address(r), launchID),
+		)
+		require.ErrorIs(t, err, types.ErrMonitoringPeriodNotEnded)
+	})
+
+	t.Run("should allow closing a reward pool after the close delay", func(t *testing.T) {
+		// the provider client is updated to a height after the close delay
+		_, err := ts.MonitoringcSrv.RecoverMonitoringClient(sdk.WrapSDKContext(expiredCtx), types.NewMsgRecoverMonitoringClient(
+			coordAddr,
+			launchID,
+			clientID,
+			verifiedClientConsensusState("2022-03-09T15:12:36.161481Z"),
+			verifiedClientValidatorSet(),
+			spntypes.DefaultUnbondingPeriod,
+			uint64(lastRewardHeight)+keeper.RewardPoolCloseDelay,
+		))
+		require.NoError(t, err)
+
+		_, err = ts.MonitoringcSrv.CloseRewardPool(
+			sdk.WrapSDKContext(expiredCtx),
+			types.NewMsgCloseRewardPool(sample.Address(r), launchID),
+		)
+		require.NoError(t, err)
+
+		rewardPool, found := tk.RewardKeeper.GetRewardPool(expiredCtx, launchID)
+		require.True(t, found)
+		require.True(t, rewardPool.Closed)
+		require.True(t, rewardPool.RemainingCoins.IsZero())
+
+		balance := tk.BankKeeper.GetAllBalances(expiredCtx, sdk.MustAccAddressFromBech32(provider))
+		require.True(t, balance.IsEqual(coins), balance.String())
+	})
+
+	t.Run("should prevent closing a closed reward pool", func(t *testing.T) {
+		_, err := ts.MonitoringcSrv.CloseRewardPool(
+			sdk.WrapSDKContext(expiredCtx),
+			types.NewMsgCloseRewardPool(sample.Address(r), launchID),
+		)
+		require.ErrorIs(t, err, rewardtypes.ErrRewardPoolClosed)
+	})
+}
@@ -24,6 +24,9 @@ func (k msgServer) CreateClient(goCtx context.Context, msg *types.MsgCreateClien
 	if !found {
 		return nil, sdkerrors.Wrapf(launchtypes.ErrChainNotFound, "invalid launch ID %d", msg.LaunchID)
 	}
+	if chain.Archived {
+		return nil, sdkerrors.Wrapf(launchtypes.ErrChainArchived, "%d", msg.LaunchID)
+	}
 
 	// initialize the client state
 	clientState, err := k.initializeClientState(
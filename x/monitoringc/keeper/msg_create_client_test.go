@@ -66,6 +66,9 @@ func Test_msgServer_CreateClient(t *testing.T) {
 	chainWithInvalidChainID := sample.Chain(r, resCreateChain.LaunchID+1, resCoord.CoordinatorID)
 	chainWithInvalidChainID.GenesisChainID = "invalid_chain_id"
 	tk.LaunchKeeper.SetChain(sdkCtx, chainWithInvalidChainID)
+	archivedChain := sample.Chain(r, resCreateChain.LaunchID+2, resCoord.CoordinatorID)
+	archivedChain.Archived = true
+	tk.LaunchKeeper.SetChain(sdkCtx, archivedChain)
 	_, err = ts.LaunchSrv.RequestAddValidator(ctx, launchtypes.NewMsgRequestAddValidator(
 		coordAddr,
 		resCreateChain.LaunchID,
@@ -140,6 +143,18 @@ func Test_msgServer_CreateClient(t *testing.T) {
 			),
 			err: launchtypes.ErrChainNotFound,
 		},
+		{
+			name: "archived chain",
+			msg: *types.NewMsgCreateClient(
+				sample.Address(r),
+				archivedChain.LaunchID,
+				cs,
+				vs,
+				spntypes.DefaultUnbondingPeriod,
+				spntypes.DefaultRevisionHeight,
+			),
+			err: launchtypes.ErrChainArchived,
+		},
 		{
 			name: "empty validator set",
 			msg: *types.NewMsgCreateClient(
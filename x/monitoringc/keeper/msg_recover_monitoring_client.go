@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"context"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v5/modules/core/exported"
+	ignterrors "github.com/ignite/modules/errors"
+
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+	"github.com/tendermint/spn/x/monitoringc/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+)
+
+func (k msgServer) RecoverMonitoringClient(
+	goCtx context.Context,
+	msg *types.MsgRecoverMonitoringClient,
+) (*types.MsgRecoverMonitoringClientResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	chain, found := k.launchKeeper.GetChain(ctx, msg.LaunchID)
+	if !found {
+		return nil, sdkerrors.Wrapf(launchtypes.ErrChainNotFound, "invalid launch ID %d", msg.LaunchID)
+	}
+
+	err := k.profileKeeper.CheckCoordinatorPermission(
+		ctx,
+		msg.Coordinator,
+		chain.CoordinatorID,
+		profiletypes.CoordinatorPermission_EDIT_CHAIN,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// the subject client must be an expired verified client of the chain
+	// a client frozen for misbehaviour can only be recovered through governance
+	launchIDFromClientID, found := k.GetLaunchIDFromVerifiedClientID(ctx, msg.SubjectClientID)
+	if !found || launchIDFromClientID.LaunchID != msg.LaunchID {
+		return nil, sdkerrors.Wrapf(
+			types.ErrClientNotVerified,
+			"client %s is not a verified client of the chain %d",
+			msg.SubjectClientID,
+			msg.LaunchID,
+		)
+	}
+	if status := k.GetClientStatus(ctx, msg.SubjectClientID); status != exported.Expired {
+		return nil, sdkerrors.Wrapf(types.ErrClientNotExpired, "client %s status is %s", msg.SubjectClientID, status)
+	}
+
+	// initialize the substitute client state
+	clientState, err := k.initializeClientState(
+		chain.GenesisChainID,
+		msg.UnbondingPeriod,
+		msg.RevisionHeight,
+	)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidClientState, err.Error())
+	}
+	if err := clientState.Validate(); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidClientState, err.Error())
+	}
+
+	// verify the validator set, the monitoring can already be connected for the chain
+	tmValidatorSet, err := msg.ValidatorSet.ToTendermintValidatorSet()
+	if err != nil {
+		return nil, ignterrors.Criticalf("validated validator can't be converted %s", err.Error())
+	}
+	if err := k.launchKeeper.CheckValidatorSetSelfDelegation(ctx, msg.LaunchID, tmValidatorSet); err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidValidatorSet, "validator set can't be verified %s", err.Error())
+	}
+
+	// create the substitute client and update the subject client with its state
+	tmConsensusState, err := msg.ConsensusState.ToTendermintConsensusState()
+	if err != nil {
+		return nil, ignterrors.Criticalf("validated consensus state can't be converted %s", err.Error())
+	}
+	substituteClientID, err := k.clientKeeper.CreateClient(ctx, clientState, &tmConsensusState)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrClientCreationFailure, err.Error())
+	}
+	err = k.clientKeeper.ClientUpdateProposal(ctx, &clienttypes.ClientUpdateProposal{
+		SubjectClientId:    msg.SubjectClientID,
+		SubstituteClientId: substituteClientID,
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrClientRecoveryFailure, err.Error())
+	}
+
+	// the monitoring is resumed if the subject client is the provider client of the chain
+	providerClientID, found := k.GetProviderClientID(ctx, msg.LaunchID)
+	monitoringResumed := found && providerClientID.ClientID == msg.SubjectClientID
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventMonitoringClientRecovered{
+		LaunchID:           msg.LaunchID,
+		SubjectClientID:    msg.SubjectClientID,
+		SubstituteClientID: substituteClientID,
+		MonitoringResumed:  monitoringResumed,
+	})
+
+	return &types.MsgRecoverMonitoringClientResponse{
+		SubstituteClientID: substituteClientID,
+	}, err
+}
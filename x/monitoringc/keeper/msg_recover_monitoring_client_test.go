@@ -0,0 +1,155 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v5/modules/core/exported"
+	"github.com/stretchr/testify/require"
+
+	spntypes "github.com/tendermint/spn/pkg/types"
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/sample"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+	"github.com/tendermint/spn/x/monitoringc/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+)
+
+func Test_msgServer_RecoverMonitoringClient(t *testing.T) {
+	var (
+		coordAddr                      = sample.Address(r)
+		sdkCtx, tk, ts                 = testkeeper.NewTestSetup(t)
+		launchID, clientID             = createVerifiedClient(t, sdkCtx, ts, coordAddr)
+		_, otherClientID               = createVerifiedClient(t, sdkCtx, ts, sample.Address(r))
+		frozenCoordAddr                = sample.Address(r)
+		frozenLaunchID, frozenClientID = createVerifiedClient(t, sdkCtx, ts, frozenCoordAddr)
+		expiredCtx                     = sdkCtx.WithBlockTime(time.Date(2022, time.March, 10, 0, 0, 0, 0, time.UTC))
+		substituteCS                   = verifiedClientConsensusState("2022-03-09T15:12:36.161481Z")
+		substituteHeight               = uint64(spntypes.DefaultRevisionHeight + 10)
+		newMsgRecoverForClient         = func(coordinator, subjectClientID string) *types.MsgRecoverMonitoringClient {
+			return types.NewMsgRecoverMonitoringClient(
+				coordinator,
+				launchID,
+				subjectClientID,
+				substituteCS,
+				verifiedClientValidatorSet(),
+				spntypes.DefaultUnbondingPeriod,
+				substituteHeight,
+			)
+		}
+	)
+
+	freezeClient(t, sdkCtx, tk, frozenClientID)
+
+	tests := []struct {
+		name string
+		ctx  sdk.Context
+		msg  *types.MsgRecoverMonitoringClient
+		err  error
+	}{
+		{
+			name: "should prevent recovering a client of a non existing chain",
+			ctx:  expiredCtx,
+			msg: types.NewMsgRecoverMonitoringClient(
+				coordAddr,
+				1000,
+				clientID,
+				substituteCS,
+				verifiedClientValidatorSet(),
+				spntypes.DefaultUnbondingPeriod,
+				substituteHeight,
+			),
+			err: launchtypes.ErrChainNotFound,
+		},
+		{
+			name: "should prevent recovering a client if the signer is not the coordinator",
+			ctx:  expiredCtx,
+			msg:  newMsgRecoverForClient(sample.Address(r), clientID),
+			err:  profiletypes.ErrCoordAddressNotFound,
+		},
+		{
+			name: "should prevent recovering a client not verified for the chain",
+			ctx:  expiredCtx,
+			msg:  newMsgRecoverForClient(coordAddr, otherClientID),
+			err:  types.ErrClientNotVerified,
+		},
+		{
+			name: "should prevent recovering an active client",
+			ctx:  sdkCtx,
+			msg:  newMsgRecoverForClient(coordAddr, clientID),
+			err:  types.ErrClientNotExpired,
+		},
+		{
+			name: "should prevent recovering a frozen client",
+			ctx:  sdkCtx,
+			msg: types.NewMsgRecoverMonitoringClient(
+				frozenCoordAddr,
+				frozenLaunchID,
+				frozenClientID,
+				substituteCS,
+				verifiedClientValidatorSet(),
+				spntypes.DefaultUnbondingPeriod,
+				substituteHeight,
+			),
+			err: types.ErrClientNotExpired,
+		},
+		{
+			name: "should prevent recovering a client with a validator set not in genesis",
+			ctx:  expiredCtx,
+			msg: types.NewMsgRecoverMonitoringClient(
+				coordAddr,
+				launchID,
+				clientID,
+				sample.ConsensusState(0),
+				sample.ValidatorSet(0),
+				spntypes.DefaultUnbondingPeriod,
+				substituteHeight,
+			),
+			err: types.ErrInvalidValidatorSet,
+		},
+		{
+			name: "should prevent recovering a client with a different unbonding period",
+			ctx:  expiredCtx,
+			msg: types.NewMsgRecoverMonitoringClient(
+				coordAddr,
+				launchID,
+				clientID,
+				substituteCS,
+				verifiedClientValidatorSet(),
+				spntypes.DefaultUnbondingPeriod+1,
+				substituteHeight,
+			),
+			err: types.ErrClientRecoveryFailure,
+		},
+		{
+			name: "should allow recovering an expired client",
+			ctx:  expiredCtx,
+			msg:  newMsgRecoverForClient(coordAddr, clientID),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wCtx := sdk.WrapSDKContext(tt.ctx)
+			res, err := ts.MonitoringcSrv.RecoverMonitoringClient(wCtx, tt.msg)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.NotEqual(t, tt.msg.SubjectClientID, res.SubstituteClientID)
+
+			// the subject client is active again with the state of the substitute
+			require.Equal(t, exported.Active, tk.MonitoringConsumerKeeper.GetClientStatus(tt.ctx, tt.msg.SubjectClientID))
+			clientState, found := tk.IBCKeeper.ClientKeeper.GetClientState(tt.ctx, tt.msg.SubjectClientID)
+			require.True(t, found)
+			require.EqualValues(t, tt.msg.RevisionHeight, clientState.GetLatestHeight().(clienttypes.Height).RevisionHeight)
+
+			// the subject client remains the verified client of the chain
+			launchIDFromClientID, found := tk.MonitoringConsumerKeeper.GetLaunchIDFromVerifiedClientID(tt.ctx, tt.msg.SubjectClientID)
+			require.True(t, found)
+			require.EqualValues(t, tt.msg.LaunchID, launchIDFromClientID.LaunchID)
+		})
+	}
+}
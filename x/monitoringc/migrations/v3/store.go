@@ -0,0 +1,12 @@
+package v3
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MigrateStore performs in-place store migrations from v2 to v3 of the monitoringc module
+// v3 only adds the messages to recover the monitoring clients and close the reward pools,
+// the provider client IDs and the verified client IDs are stored as in v2
+func MigrateStore(_ sdk.Context) error {
+	return nil
+}
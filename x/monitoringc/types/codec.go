@@ -9,12 +9,18 @@ import (
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCreateClient{}, "monitoringc/CreateClient", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "monitoringc/UpdateParams", nil)
+	cdc.RegisterConcrete(&MsgRecoverMonitoringClient{}, "monitoringc/RecoverMonitoringClient", nil)
+	cdc.RegisterConcrete(&MsgCloseRewardPool{}, "monitoringc/CloseRewardPool", nil)
 	// this line is used by starport scaffolding # 2
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgCreateClient{},
+		&MsgUpdateParams{},
+		&MsgRecoverMonitoringClient{},
+		&MsgCloseRewardPool{},
 	)
 	// this line is used by starport scaffolding # 3
 
@@ -20,4 +20,8 @@ var (
 	ErrInvalidUnbondingPeriod       = sdkerrors.Register(ModuleName, 11, "invalid unbonding period")
 	ErrInvalidRevisionHeight        = sdkerrors.Register(ModuleName, 12, "invalid revision height")
 	ErrVerifiedClientIDsNotFound    = sdkerrors.Register(ModuleName, 13, "verified client IDs not found")
+	ErrUnexpectedPacket             = sdkerrors.Register(ModuleName, 14, "monitoring packets are not sent by the consumer chain")
+	ErrClientNotExpired             = sdkerrors.Register(ModuleName, 15, "ibc client not expired")
+	ErrClientRecoveryFailure        = sdkerrors.Register(ModuleName, 16, "failed to recover IBC client")
+	ErrMonitoringPeriodNotEnded     = sdkerrors.Register(ModuleName, 17, "monitoring period not ended")
 )
@@ -0,0 +1,447 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: monitoringc/events.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type EventMonitoringClientRecovered struct {
+	LaunchID           uint64 `protobuf:"varint,1,opt,name=launchID,proto3" json:"launchID,omitempty"`
+	SubjectClientID    string `protobuf:"bytes,2,opt,name=subjectClientID,proto3" json:"subjectClientID,omitempty"`
+	SubstituteClientID string `protobuf:"bytes,3,opt,name=substituteClientID,proto3" json:"substituteClientID,omitempty"`
+	MonitoringResumed  bool   `protobuf:"varint,4,opt,name=monitoringResumed,proto3" json:"monitoringResumed,omitempty"`
+}
+
+func (m *EventMonitoringClientRecovered) Reset()         { *m = EventMonitoringClientRecovered{} }
+func (m *EventMonitoringClientRecovered) String() string { return proto.CompactTextString(m) }
+func (*EventMonitoringClientRecovered) ProtoMessage()    {}
+func (*EventMonitoringClientRecovered) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6de8cf2bc3e8d5d0, []int{0}
+}
+func (m *EventMonitoringClientRecovered) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMonitoringClientRecovered) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMonitoringClientRecovered.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMonitoringClientRecovered) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMonitoringClientRecovered.Merge(m, src)
+}
+func (m *EventMonitoringClientRecovered) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMonitoringClientRecovered) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMonitoringClientRecovered.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMonitoringClientRecovered proto.InternalMessageInfo
+
+func (m *EventMonitoringClientRecovered) GetLaunchID() uint64 {
+	if m != nil {
+		return m.LaunchID
+	}
+	return 0
+}
+
+func (m *EventMonitoringClientRecovered) GetSubjectClientID() string {
+	if m != nil {
+		return m.SubjectClientID
+	}
+	return ""
+}
+
+func (m *EventMonitoringClientRecovered) GetSubstituteClientID() string {
+	if m != nil {
+		return m.SubstituteClientID
+	}
+	return ""
+}
+
+func (m *EventMonitoringClientRecovered) GetMonitoringResumed() bool {
+	if m != nil {
+		return m.MonitoringResumed
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*EventMonitoringClientRecovered)(nil), "tendermint.spn.monitoringc.EventMonitoringClientRecovered")
+}
+
+func init() { proto.RegisterFile("monitoringc/events.proto", fileDescriptor_6de8cf2bc3e8d5d0) }
+
+var fileDescriptor_6de8cf2bc3e8d5d0 = []byte{
+	// 241 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xb1, 0x4e, 0xc3, 0x30,
+	0x10, 0x86, 0x63, 0xa8, 0x50, 0xf1, 0x82, 0xf0, 0x14, 0x75, 0xb0, 0x22, 0xa6, 0x0c, 0x60, 0x0f,
+	0xbc, 0x01, 0x14, 0xa1, 0x0e, 0x2c, 0x1e, 0xd9, 0x88, 0x73, 0x6a, 0x8d, 0x9a, 0x73, 0x64, 0x9f,
+	0x2b, 0x78, 0x0b, 0x1e, 0x8a, 0x81, 0xb1, 0x23, 0x23, 0x4a, 0x5e, 0x04, 0x51, 0xa4, 0x34, 0x82,
+	0x8e, 0xf7, 0xff, 0xdf, 0x49, 0xbf, 0x3e, 0x9e, 0x37, 0x1e, 0x1d, 0xf9, 0xe0, 0x70, 0x69, 0x35,
+	0x6c, 0x00, 0x29, 0xaa, 0x36, 0x78, 0xf2, 0x62, 0x46, 0x80, 0x35, 0x84, 0xc6, 0x21, 0xa9, 0xd8,
+	0xa2, 0x1a, 0x81, 0x17, 0xef, 0x8c, 0xcb, 0xbb, 0x1f, 0xf8, 0x61, 0x08, 0x6f, 0xd7, 0x0e, 0x90,
+	0x0c, 0x58, 0xbf, 0x81, 0x00, 0xb5, 0x98, 0xf1, 0xe9, 0xfa, 0x29, 0xa1, 0x5d, 0x2d, 0xe6, 0x39,
+	0x2b, 0x58, 0x39, 0x31, 0xc3, 0x2d, 0x4a, 0x7e, 0x16, 0x53, 0xf5, 0x0c, 0x96, 0x7e, 0xbf, 0x16,
+	0xf3, 0xfc, 0xa8, 0x60, 0xe5, 0xa9, 0xf9, 0x1b, 0x0b, 0xc5, 0x45, 0x4c, 0x55, 0x24, 0x47, 0x89,
+	0x60, 0x80, 0x8f, 0x77, 0xf0, 0x81, 0x46, 0x5c, 0xf2, 0xf3, 0xfd, 0x4e, 0x03, 0x31, 0x35, 0x50,
+	0xe7, 0x93, 0x82, 0x95, 0x53, 0xf3, 0xbf, 0xb8, 0xb9, 0xff, 0xe8, 0x24, 0xdb, 0x76, 0x92, 0x7d,
+	0x75, 0x92, 0xbd, 0xf5, 0x32, 0xdb, 0xf6, 0x32, 0xfb, 0xec, 0x65, 0xf6, 0x78, 0xb5, 0x74, 0xb4,
+	0x4a, 0x95, 0xb2, 0xbe, 0xd1, 0x7b, 0x0f, 0x3a, 0xb6, 0xa8, 0x5f, 0xf4, 0x58, 0x19, 0xbd, 0xb6,
+	0x10, 0xab, 0x93, 0x9d, 0xb2, 0xeb, 0xef, 0x01, 0x00, 0xd0, 0x1b, 0x7b, 0xf0, 0x4e, 0x01, 0x00,
+	0x00,
+}
+
+func (m *EventMonitoringClientRecovered) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMonitoringClientRecovered) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMonitoringClientRecovered) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MonitoringResumed {
+		i--
+		if m.MonitoringResumed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.SubstituteClientID) > 0 {
+		i -= len(m.SubstituteClientID)
+		copy(dAtA[i:], m.SubstituteClientID)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SubstituteClientID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SubjectClientID) > 0 {
+		i -= len(m.SubjectClientID)
+		copy(dAtA[i:], m.SubjectClientID)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SubjectClientID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.LaunchID != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.LaunchID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventMonitoringClientRecovered) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LaunchID != 0 {
+		n += 1 + sovEvents(uint64(m.LaunchID))
+	}
+	l = len(m.SubjectClientID)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.SubstituteClientID)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.MonitoringResumed {
+		n += 2
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventMonitoringClientRecovered) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMonitoringClientRecovered: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMonitoringClientRecovered: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LaunchID", wireType)
+			}
+			m.LaunchID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LaunchID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubjectClientID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubjectClientID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubstituteClientID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubstituteClientID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonitoringResumed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MonitoringResumed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvents(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvents
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvents
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvents
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvents        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvents          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvents = fmt.Errorf("proto: unexpected end of group")
+)
@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v5/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
 	"github.com/cosmos/ibc-go/v5/modules/core/exported"
@@ -11,6 +12,8 @@ import (
 
 	spntypes "github.com/tendermint/spn/pkg/types"
 	launchtypes "github.com/tendermint/spn/x/launch/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+	rewardtypes "github.com/tendermint/spn/x/reward/types"
 )
 
 type LaunchKeeper interface {
@@ -22,6 +25,20 @@ type LaunchKeeper interface {
 		chainID string,
 		validatorSet tmtypes.ValidatorSet,
 	) error
+	CheckValidatorSetSelfDelegation(
+		ctx sdk.Context,
+		launchID uint64,
+		validatorSet tmtypes.ValidatorSet,
+	) error
+}
+
+type ProfileKeeper interface {
+	CheckCoordinatorPermission(
+		ctx sdk.Context,
+		address string,
+		coordinatorID uint64,
+		permission profiletypes.CoordinatorPermission,
+	) error
 }
 
 type RewardKeeper interface {
@@ -32,6 +49,8 @@ type RewardKeeper interface {
 		lastBlockHeight int64,
 		closeRewardPool bool,
 	) error
+	GetRewardPool(ctx sdk.Context, launchID uint64) (rewardtypes.RewardPool, bool)
+	CloseRewardPool(ctx sdk.Context, launchID uint64) error
 }
 
 // AccountKeeper defines the expected account keeper used for simulations (noalias)
@@ -44,11 +63,14 @@ type BankKeeper interface {
 	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 }
 
-// ClientKeeper is imported to add the ability to create IBC Client from the module
+// ClientKeeper is imported to add the ability to create, query and recover IBC Client from the module
 type ClientKeeper interface {
 	CreateClient(
 		ctx sdk.Context, clientState exported.ClientState, consensusState exported.ConsensusState,
 	) (string, error)
+	GetClientState(ctx sdk.Context, clientID string) (exported.ClientState, bool)
+	ClientStore(ctx sdk.Context, clientID string) sdk.KVStore
+	ClientUpdateProposal(ctx sdk.Context, p *clienttypes.ClientUpdateProposal) error
 }
 
 // ConnectionKeeper is imported to check client ID during IBC handshake
@@ -0,0 +1,46 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgCloseRewardPool = "close_reward_pool"
+
+var _ sdk.Msg = &MsgCloseRewardPool{}
+
+func NewMsgCloseRewardPool(sender string, launchID uint64) *MsgCloseRewardPool {
+	return &MsgCloseRewardPool{
+		Sender:   sender,
+		LaunchID: launchID,
+	}
+}
+
+func (msg *MsgCloseRewardPool) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCloseRewardPool) Type() string {
+	return TypeMsgCloseRewardPool
+}
+
+func (msg *MsgCloseRewardPool) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+func (msg *MsgCloseRewardPool) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCloseRewardPool) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrortypes.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkerrortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/monitoringc/types"
+)
+
+func TestMsgCloseRewardPool_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  types.MsgCloseRewardPool
+		err  error
+	}{
+		{
+			name: "valid message",
+			msg: types.MsgCloseRewardPool{
+				Sender:   sample.Address(r),
+				LaunchID: 0,
+			},
+		},
+		{
+			name: "invalid address",
+			msg: types.MsgCloseRewardPool{
+				Sender:   "invalid_address",
+				LaunchID: 0,
+			},
+			err: sdkerrortypes.ErrInvalidAddress,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
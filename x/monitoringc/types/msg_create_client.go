@@ -57,8 +57,18 @@ func (msg *MsgCreateClient) ValidateBasic() error {
 		return sdkerrors.Wrapf(sdkerrortypes.ErrInvalidAddress, "invalid creator address (%s)", err)
 	}
 
+	return validateClientParams(msg.ConsensusState, msg.ValidatorSet, msg.UnbondingPeriod, msg.RevisionHeight)
+}
+
+// validateClientParams validates the parameters used to create a verified client
+func validateClientParams(
+	consensusState spntypes.ConsensusState,
+	validatorSet spntypes.ValidatorSet,
+	unbondingPeriod int64,
+	revisionHeight uint64,
+) error {
 	// validate consensus state
-	tmConsensusState, err := msg.ConsensusState.ToTendermintConsensusState()
+	tmConsensusState, err := consensusState.ToTendermintConsensusState()
 	if err != nil {
 		return sdkerrors.Wrapf(ErrInvalidConsensusState, err.Error())
 	}
@@ -67,7 +77,7 @@ func (msg *MsgCreateClient) ValidateBasic() error {
 	}
 
 	// validate validator set
-	tmValidatorSet, err := msg.ValidatorSet.ToTendermintValidatorSet()
+	tmValidatorSet, err := validatorSet.ToTendermintValidatorSet()
 	if err != nil {
 		return sdkerrors.Wrapf(ErrInvalidValidatorSet, err.Error())
 	}
@@ -82,12 +92,12 @@ func (msg *MsgCreateClient) ValidateBasic() error {
 
 	// unbonding period must greater than 1 because trusting period for the IBC client is unbonding period - 1
 	// and trusting period can't be 0
-	if msg.UnbondingPeriod < spntypes.MinimalUnbondingPeriod {
+	if unbondingPeriod < spntypes.MinimalUnbondingPeriod {
 		return sdkerrors.Wrapf(ErrInvalidUnbondingPeriod, "unbonding period must be greater than 1")
 	}
 
 	// check revision height is non-null
-	if msg.RevisionHeight == 0 {
+	if revisionHeight == 0 {
 		return sdkerrors.Wrapf(ErrInvalidRevisionHeight, "revision height must be non-null")
 	}
 
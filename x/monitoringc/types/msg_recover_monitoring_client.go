@@ -0,0 +1,68 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
+
+	spntypes "github.com/tendermint/spn/pkg/types"
+)
+
+const TypeMsgRecoverMonitoringClient = "recover_monitoring_client"
+
+var _ sdk.Msg = &MsgRecoverMonitoringClient{}
+
+func NewMsgRecoverMonitoringClient(
+	coordinator string,
+	launchID uint64,
+	subjectClientID string,
+	consensusState spntypes.ConsensusState,
+	validatorSet spntypes.ValidatorSet,
+	unbondingPeriod int64,
+	revisionHeight uint64,
+) *MsgRecoverMonitoringClient {
+	return &MsgRecoverMonitoringClient{
+		Coordinator:     coordinator,
+		LaunchID:        launchID,
+		SubjectClientID: subjectClientID,
+		ConsensusState:  consensusState,
+		ValidatorSet:    validatorSet,
+		UnbondingPeriod: unbondingPeriod,
+		RevisionHeight:  revisionHeight,
+	}
+}
+
+func (msg *MsgRecoverMonitoringClient) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRecoverMonitoringClient) Type() string {
+	return TypeMsgRecoverMonitoringClient
+}
+
+func (msg *MsgRecoverMonitoringClient) GetSigners() []sdk.AccAddress {
+	coordinator, err := sdk.AccAddressFromBech32(msg.Coordinator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{coordinator}
+}
+
+func (msg *MsgRecoverMonitoringClient) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRecoverMonitoringClient) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Coordinator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrortypes.ErrInvalidAddress, "invalid coordinator address (%s)", err)
+	}
+
+	if err := host.ClientIdentifierValidator(msg.SubjectClientID); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidClientState, "invalid subject client ID (%s)", err)
+	}
+
+	return validateClientParams(msg.ConsensusState, msg.ValidatorSet, msg.UnbondingPeriod, msg.RevisionHeight)
+}
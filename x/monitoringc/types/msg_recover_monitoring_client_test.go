@@ -0,0 +1,102 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkerrortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	spntypes "github.com/tendermint/spn/pkg/types"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/monitoringc/types"
+)
+
+func TestMsgRecoverMonitoringClient_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  types.MsgRecoverMonitoringClient
+		err  error
+	}{
+		{
+			name: "valid message",
+			msg: types.MsgRecoverMonitoringClient{
+				Coordinator:     sample.Address(r),
+				SubjectClientID: "07-tendermint-0",
+				ConsensusState:  sample.ConsensusState(0),
+				ValidatorSet:    sample.ValidatorSet(0),
+				UnbondingPeriod: spntypes.DefaultUnbondingPeriod,
+				RevisionHeight:  spntypes.DefaultRevisionHeight,
+			},
+		},
+		{
+			name: "invalid address",
+			msg: types.MsgRecoverMonitoringClient{
+				Coordinator:     "invalid_address",
+				SubjectClientID: "07-tendermint-0",
+				ConsensusState:  sample.ConsensusState(0),
+				ValidatorSet:    sample.ValidatorSet(0),
+				UnbondingPeriod: spntypes.DefaultUnbondingPeriod,
+				RevisionHeight:  spntypes.DefaultRevisionHeight,
+			},
+			err: sdkerrortypes.ErrInvalidAddress,
+		},
+		{
+			name: "invalid subject client ID",
+			msg: types.MsgRecoverMonitoringClient{
+				Coordinator:     sample.Address(r),
+				SubjectClientID: "",
+				ConsensusState:  sample.ConsensusState(0),
+				ValidatorSet:    sample.ValidatorSet(0),
+				UnbondingPeriod: spntypes.DefaultUnbondingPeriod,
+				RevisionHeight:  spntypes.DefaultRevisionHeight,
+			},
+			err: types.ErrInvalidClientState,
+		},
+		{
+			name: "validator set not matching consensus state",
+			msg: types.MsgRecoverMonitoringClient{
+				Coordinator:     sample.Address(r),
+				SubjectClientID: "07-tendermint-0",
+				ConsensusState:  sample.ConsensusState(0),
+				ValidatorSet:    sample.ValidatorSet(1),
+				UnbondingPeriod: spntypes.DefaultUnbondingPeriod,
+				RevisionHeight:  spntypes.DefaultRevisionHeight,
+			},
+			err: types.ErrInvalidValidatorSetHash,
+		},
+		{
+			name: "invalid unbonding period",
+			msg: types.MsgRecoverMonitoringClient{
+				Coordinator:     sample.Address(r),
+				SubjectClientID: "07-tendermint-0",
+				ConsensusState:  sample.ConsensusState(0),
+				ValidatorSet:    sample.ValidatorSet(0),
+				UnbondingPeriod: spntypes.MinimalUnbondingPeriod - 1,
+				RevisionHeight:  spntypes.DefaultRevisionHeight,
+			},
+			err: types.ErrInvalidUnbondingPeriod,
+		},
+		{
+			name: "invalid revision height",
+			msg: types.MsgRecoverMonitoringClient{
+				Coordinator:     sample.Address(r),
+				SubjectClientID: "07-tendermint-0",
+				ConsensusState:  sample.ConsensusState(0),
+				ValidatorSet:    sample.ValidatorSet(0),
+				UnbondingPeriod: spntypes.DefaultUnbondingPeriod,
+				RevisionHeight:  0,
+			},
+			err: types.ErrInvalidRevisionHeight,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
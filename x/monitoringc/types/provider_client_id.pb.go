@@ -83,7 +83,7 @@ func init() {
 }
 
 var fileDescriptor_2afc3f894325fb0c = []byte{
-	// 190 bytes of a gzipped FileDescriptorProto
+	// 187 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xc9, 0xcd, 0xcf, 0xcb,
 	0x2c, 0xc9, 0x2f, 0xca, 0xcc, 0x4b, 0x4f, 0xd6, 0x2f, 0x28, 0xca, 0x2f, 0xcb, 0x4c, 0x49, 0x2d,
 	0x8a, 0x4f, 0xce, 0xc9, 0x4c, 0xcd, 0x2b, 0x89, 0xcf, 0x4c, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
@@ -95,7 +95,7 @@ var fileDescriptor_2afc3f894325fb0c = []byte{
 	0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0xd2, 0x4d,
 	0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x47, 0x38, 0x46, 0xbf, 0xb8, 0x20,
 	0x4f, 0xbf, 0x42, 0x1f, 0xd9, 0x0f, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60, 0x77, 0x1b,
-	0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x4f, 0x8a, 0x6d, 0xde, 0xdf, 0x00, 0x00, 0x00,
+	0x03, 0x06, 0x00, 0x4f, 0x8a, 0x6d, 0xde, 0xdf, 0x00, 0x00, 0x00,
 }
 
 func (m *ProviderClientID) Marshal() (dAtA []byte, err error) {
@@ -574,6 +574,96 @@ func (m *QueryGetMonitoringHistoryResponse) GetMonitoringHistory() MonitoringHis
 	return MonitoringHistory{}
 }
 
+type QueryGetMonitoringClientStatusRequest struct {
+	LaunchID uint64 `protobuf:"varint,1,opt,name=launchID,proto3" json:"launchID,omitempty"`
+}
+
+func (m *QueryGetMonitoringClientStatusRequest) Reset()         { *m = QueryGetMonitoringClientStatusRequest{} }
+func (m *QueryGetMonitoringClientStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetMonitoringClientStatusRequest) ProtoMessage()    {}
+func (*QueryGetMonitoringClientStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecb4a38bab58f58d, []int{12}
+}
+func (m *QueryGetMonitoringClientStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetMonitoringClientStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetMonitoringClientStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetMonitoringClientStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetMonitoringClientStatusRequest.Merge(m, src)
+}
+func (m *QueryGetMonitoringClientStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetMonitoringClientStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetMonitoringClientStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetMonitoringClientStatusRequest proto.InternalMessageInfo
+
+func (m *QueryGetMonitoringClientStatusRequest) GetLaunchID() uint64 {
+	if m != nil {
+		return m.LaunchID
+	}
+	return 0
+}
+
+type QueryGetMonitoringClientStatusResponse struct {
+	ClientStatuses []MonitoringClientStatus `protobuf:"bytes,1,rep,name=clientStatuses,proto3" json:"clientStatuses"`
+}
+
+func (m *QueryGetMonitoringClientStatusResponse) Reset() {
+	*m = QueryGetMonitoringClientStatusResponse{}
+}
+func (m *QueryGetMonitoringClientStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetMonitoringClientStatusResponse) ProtoMessage()    {}
+func (*QueryGetMonitoringClientStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecb4a38bab58f58d, []int{13}
+}
+func (m *QueryGetMonitoringClientStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetMonitoringClientStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetMonitoringClientStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetMonitoringClientStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetMonitoringClientStatusResponse.Merge(m, src)
+}
+func (m *QueryGetMonitoringClientStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetMonitoringClientStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetMonitoringClientStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetMonitoringClientStatusResponse proto.InternalMessageInfo
+
+func (m *QueryGetMonitoringClientStatusResponse) GetClientStatuses() []MonitoringClientStatus {
+	if m != nil {
+		return m.ClientStatuses
+	}
+	return nil
+}
+
 // QueryParamsRequest is request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -582,7 +672,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ecb4a38bab58f58d, []int{12}
+	return fileDescriptor_ecb4a38bab58f58d, []int{14}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -620,7 +710,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ecb4a38bab58f58d, []int{13}
+	return fileDescriptor_ecb4a38bab58f58d, []int{15}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -669,6 +759,8 @@ func init() {
 	proto.RegisterType((*QueryAllLaunchIDFromChannelIDResponse)(nil), "tendermint.spn.monitoringc.QueryAllLaunchIDFromChannelIDResponse")
 	proto.RegisterType((*QueryGetMonitoringHistoryRequest)(nil), "tendermint.spn.monitoringc.QueryGetMonitoringHistoryRequest")
 	proto.RegisterType((*QueryGetMonitoringHistoryResponse)(nil), "tendermint.spn.monitoringc.QueryGetMonitoringHistoryResponse")
+	proto.RegisterType((*QueryGetMonitoringClientStatusRequest)(nil), "tendermint.spn.monitoringc.QueryGetMonitoringClientStatusRequest")
+	proto.RegisterType((*QueryGetMonitoringClientStatusResponse)(nil), "tendermint.spn.monitoringc.QueryGetMonitoringClientStatusResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "tendermint.spn.monitoringc.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "tendermint.spn.monitoringc.QueryParamsResponse")
 }
@@ -676,60 +768,66 @@ func init() {
 func init() { proto.RegisterFile("monitoringc/query.proto", fileDescriptor_ecb4a38bab58f58d) }
 
 var fileDescriptor_ecb4a38bab58f58d = []byte{
-	// 847 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x4f, 0xd4, 0x40,
-	0x14, 0xde, 0x01, 0x24, 0x32, 0x5c, 0x60, 0x80, 0xb8, 0x69, 0xc8, 0x82, 0x0d, 0x2a, 0x41, 0x69,
-	0x05, 0xa2, 0x46, 0x01, 0xc3, 0x02, 0x01, 0x49, 0x34, 0xc1, 0x3d, 0x68, 0xe2, 0xc1, 0x4d, 0x77,
-	0x77, 0xe8, 0x4e, 0xd2, 0xce, 0x94, 0xb6, 0x4b, 0x24, 0x84, 0x0b, 0x07, 0xcf, 0x26, 0x46, 0xff,
-	0x13, 0xff, 0x07, 0x12, 0x2f, 0x24, 0x78, 0xf0, 0x84, 0x06, 0x4c, 0xfc, 0x37, 0xcc, 0x4e, 0xa7,
-	0xb4, 0xbb, 0xfd, 0xb1, 0x15, 0xf6, 0xb6, 0x3b, 0x7d, 0xef, 0x7b, 0xef, 0xfb, 0xe6, 0xf5, 0x7b,
-	0x85, 0xb7, 0x4c, 0x46, 0x89, 0xcb, 0x6c, 0x42, 0xf5, 0xaa, 0xba, 0xdb, 0xc0, 0xf6, 0xbe, 0x62,
-	0xd9, 0xcc, 0x65, 0x48, 0x72, 0x31, 0xad, 0x61, 0xdb, 0x24, 0xd4, 0x55, 0x1c, 0x8b, 0x2a, 0xa1,
-	0x38, 0x69, 0x54, 0x67, 0x3a, 0xe3, 0x61, 0x6a, 0xf3, 0x97, 0x97, 0x21, 0x8d, 0xeb, 0x8c, 0xe9,
-	0x06, 0x56, 0x35, 0x8b, 0xa8, 0x1a, 0xa5, 0xcc, 0xd5, 0x5c, 0xc2, 0xa8, 0x23, 0x9e, 0xce, 0x54,
-	0x99, 0x63, 0x32, 0x47, 0xad, 0x68, 0x0e, 0xf6, 0x0a, 0xa9, 0x7b, 0x73, 0x15, 0xec, 0x6a, 0x73,
-	0xaa, 0xa5, 0xe9, 0x84, 0xf2, 0x60, 0x11, 0x9b, 0x0f, 0x37, 0x65, 0x69, 0xb6, 0x66, 0xfa, 0x28,
-	0x53, 0x2d, 0x4f, 0x6c, 0xb6, 0x47, 0x6a, 0xd8, 0x2e, 0x57, 0x0d, 0x82, 0xa9, 0x5b, 0x26, 0x35,
-	0x11, 0xb5, 0x10, 0x8e, 0x32, 0xb4, 0x06, 0xad, 0xd6, 0xcb, 0xa4, 0x56, 0xde, 0xb1, 0x99, 0x59,
-	0xde, 0xc3, 0x36, 0xd9, 0x21, 0xb8, 0x16, 0x49, 0xba, 0x9f, 0x92, 0x54, 0xad, 0x6b, 0x94, 0x62,
-	0x23, 0x08, 0x6e, 0xe9, 0x23, 0xf8, 0x5d, 0xae, 0x13, 0xc7, 0x65, 0xbe, 0x86, 0xf2, 0x73, 0x38,
-	0xf9, 0xba, 0xc9, 0x74, 0x13, 0xbb, 0x6f, 0x44, 0xd9, 0x35, 0x5e, 0x75, 0xab, 0xe6, 0x94, 0xf0,
-	0x6e, 0x03, 0x3b, 0x2e, 0x92, 0xe0, 0x4d, 0xaf, 0xd8, 0xd6, 0x7a, 0x1e, 0x4c, 0x82, 0xe9, 0xbe,
-	0xd2, 0xe5, 0x7f, 0xb9, 0x08, 0x6f, 0xa7, 0xe4, 0x3b, 0x16, 0xa3, 0x0e, 0x46, 0xe3, 0x70, 0xa0,
-	0xea, 0x1f, 0xe6, 0xc1, 0x64, 0xef, 0xf4, 0x40, 0x29, 0x38, 0x90, 0x97, 0xe1, 0x84, 0x0f, 0xb1,
-	0x2d, 0xe4, 0x12, 0x10, 0xeb, 0x59, 0x3a, 0x38, 0x02, 0x01, 0x85, 0x68, 0xbe, 0xe8, 0xe0, 0x3d,
-	0x1c, 0xb2, 0xda, 0x9e, 0x71, 0xa0, 0xc1, 0xf9, 0x07, 0x4a, 0xf2, 0x14, 0x29, 0xed, 0x78, 0xab,
-	0x7d, 0xc7, 0x67, 0x13, 0xb9, 0x52, 0x04, 0x4b, 0x26, 0x82, 0x43, 0xd1, 0x30, 0x92, 0x38, 0x6c,
-	0x40, 0x18, 0x4c, 0x91, 0x28, 0x7e, 0x57, 0xf1, 0x46, 0x4e, 0x69, 0x8e, 0x9c, 0xe2, 0xcd, 0xb6,
-	0x18, 0x39, 0x65, 0x5b, 0xd3, 0xb1, 0xc8, 0x2d, 0x85, 0x32, 0xe5, 0xef, 0x3e, 0xdf, 0xd8, 0x5a,
-	0xa9, 0x7c, 0x7b, 0xbb, 0xc5, 0x17, 0x6d, 0xb6, 0x90, 0xe9, 0xe1, 0x64, 0xee, 0x75, 0x24, 0xe3,
-	0x35, 0xd7, 0xc2, 0x66, 0x1d, 0x4e, 0xf9, 0x97, 0xf7, 0x52, 0xdc, 0xe8, 0x86, 0xcd, 0xcc, 0x35,
-	0x6f, 0x9a, 0x03, 0xf5, 0x9a, 0x23, 0xe4, 0x9f, 0x71, 0xf1, 0x9a, 0x23, 0xe4, 0x1f, 0xc8, 0x5f,
-	0x01, 0xbc, 0xd3, 0x01, 0x46, 0x08, 0x63, 0xc2, 0x31, 0x23, 0x2e, 0x40, 0x5c, 0xc8, 0x5c, 0x9a,
-	0x3a, 0xb1, 0xc8, 0x42, 0xa2, 0x78, 0x54, 0x99, 0x0a, 0x7a, 0x45, 0xc3, 0x48, 0xa5, 0xd7, 0xad,
-	0xe1, 0xf8, 0xe5, 0x0b, 0x91, 0x5c, 0xb0, 0xb3, 0x10, 0xbd, 0xdd, 0x17, 0xa2, 0x7b, 0x03, 0x13,
-	0x32, 0xac, 0x57, 0x97, 0x2d, 0xbd, 0xf0, 0x3c, 0x2d, 0x8b, 0x5d, 0x7c, 0x04, 0x81, 0x63, 0xc5,
-	0x00, 0x08, 0x75, 0x34, 0x38, 0x6c, 0xb6, 0x3f, 0x14, 0xd7, 0x32, 0x9b, 0xa6, 0x4c, 0x04, 0x51,
-	0xa8, 0x12, 0x45, 0x93, 0x47, 0x21, 0xe2, 0x7d, 0x6c, 0xf3, 0xe5, 0x21, 0x5a, 0x97, 0xdf, 0xc2,
-	0x91, 0x96, 0x53, 0xd1, 0xcf, 0x0a, 0xec, 0xf7, 0x96, 0x8c, 0x68, 0x42, 0x4e, 0x7d, 0x8b, 0x79,
-	0xa4, 0xa8, 0x2c, 0xf2, 0xe6, 0xbf, 0x0d, 0xc2, 0x1b, 0x1c, 0x19, 0xfd, 0x00, 0x70, 0x38, 0xe2,
-	0xd5, 0x68, 0x29, 0x0d, 0xb1, 0xd3, 0x8a, 0x90, 0x96, 0xaf, 0x98, 0xed, 0xd1, 0x93, 0x57, 0x8f,
-	0x4e, 0xff, 0x7c, 0xee, 0x59, 0x42, 0xcf, 0xd4, 0x00, 0x46, 0x75, 0x2c, 0xaa, 0x86, 0x77, 0x58,
-	0x74, 0x2d, 0x3a, 0xea, 0x81, 0x7f, 0xaf, 0x87, 0xe8, 0x04, 0xc0, 0xa1, 0x76, 0xff, 0x42, 0x8b,
-	0x59, 0xfa, 0x4a, 0x70, 0x6c, 0x69, 0xe9, 0x6a, 0xc9, 0x82, 0x53, 0x91, 0x73, 0x5a, 0x44, 0x4f,
-	0xd3, 0x38, 0x45, 0xbf, 0x0f, 0xc2, 0x94, 0x8e, 0x01, 0x1c, 0x69, 0xc7, 0x2f, 0x1a, 0x46, 0x06,
-	0x56, 0xc9, 0x7b, 0x28, 0x03, 0xab, 0x94, 0xc5, 0x22, 0x3f, 0xe6, 0xac, 0x1e, 0x22, 0xe5, 0xff,
-	0x58, 0xa1, 0xbf, 0x00, 0x8e, 0xc5, 0xda, 0x06, 0x5a, 0xc9, 0xa2, 0x72, 0x9a, 0x79, 0x4a, 0xc5,
-	0x6b, 0x20, 0x08, 0x5a, 0x5b, 0x9c, 0xd6, 0x1a, 0x2a, 0xa6, 0xd1, 0x4a, 0xfc, 0xe2, 0x52, 0x0f,
-	0x2e, 0x57, 0xd1, 0x21, 0x3a, 0x03, 0x30, 0x1f, 0x5b, 0xac, 0x79, 0x73, 0x2b, 0x59, 0xc4, 0xbf,
-	0x26, 0xd9, 0x4e, 0xd6, 0x2f, 0x2f, 0x73, 0xb2, 0x4f, 0xd0, 0xa3, 0x2b, 0x91, 0x45, 0xa7, 0x00,
-	0x0e, 0x47, 0x7c, 0x2e, 0x9b, 0x7f, 0x24, 0x39, 0x76, 0x36, 0xff, 0x48, 0xb4, 0xeb, 0x6c, 0xef,
-	0x5a, 0xf4, 0x1b, 0x38, 0xfc, 0xae, 0x7d, 0x01, 0xb0, 0xdf, 0x33, 0x4e, 0xa4, 0x74, 0x6c, 0xa6,
-	0xc5, 0xb3, 0x25, 0x35, 0x73, 0xbc, 0x68, 0x77, 0x86, 0xb7, 0x3b, 0x85, 0xe4, 0xd4, 0x97, 0xc8,
-	0x73, 0xf1, 0xcd, 0xe3, 0xf3, 0x02, 0x38, 0x39, 0x2f, 0x80, 0xdf, 0xe7, 0x05, 0xf0, 0xe9, 0xa2,
-	0x90, 0x3b, 0xb9, 0x28, 0xe4, 0x7e, 0x5e, 0x14, 0x72, 0xef, 0x66, 0x75, 0xe2, 0xd6, 0x1b, 0x15,
-	0xa5, 0xca, 0xcc, 0x76, 0x9c, 0x0f, 0x2d, 0x48, 0xee, 0xbe, 0x85, 0x9d, 0x4a, 0x3f, 0xff, 0xe0,
-	0x5f, 0xf8, 0x17, 0x00, 0x00, 0xff, 0xff, 0x2b, 0x7c, 0xff, 0x8f, 0x4f, 0x0d, 0x00, 0x00,
+	// 935 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x97, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0xc7, 0x33, 0xdb, 0xa5, 0xa2, 0x83, 0x40, 0xdb, 0xd9, 0x5d, 0x88, 0xac, 0x55, 0x36, 0x6b,
+	0x95, 0x65, 0x55, 0x58, 0x9b, 0x76, 0x05, 0x08, 0x36, 0x81, 0xba, 0xa9, 0x36, 0x54, 0x02, 0xa9,
+	0x04, 0x09, 0x24, 0x0e, 0x04, 0xc7, 0x99, 0x75, 0x2c, 0xd9, 0x33, 0x5e, 0xdb, 0xa9, 0x58, 0xad,
+	0x7a, 0xe9, 0x81, 0x03, 0x27, 0x24, 0x04, 0x67, 0xfe, 0x9c, 0x4a, 0x5c, 0x2a, 0x95, 0x03, 0xa7,
+	0x82, 0x5a, 0x24, 0xf8, 0x33, 0x50, 0xc6, 0xcf, 0xb5, 0x1d, 0xff, 0x88, 0x9b, 0xe6, 0x96, 0x8c,
+	0xdf, 0xfb, 0xce, 0xfb, 0xbc, 0x79, 0xf3, 0x9e, 0x8d, 0xdf, 0x70, 0x38, 0xb3, 0x02, 0xee, 0x59,
+	0xcc, 0x34, 0xd4, 0x67, 0x63, 0xea, 0x3d, 0x57, 0x5c, 0x8f, 0x07, 0x9c, 0x48, 0x01, 0x65, 0x43,
+	0xea, 0x39, 0x16, 0x0b, 0x14, 0xdf, 0x65, 0x4a, 0xc2, 0x4e, 0xba, 0x65, 0x72, 0x93, 0x0b, 0x33,
+	0x75, 0xf2, 0x2b, 0xf4, 0x90, 0xee, 0x98, 0x9c, 0x9b, 0x36, 0x55, 0x75, 0xd7, 0x52, 0x75, 0xc6,
+	0x78, 0xa0, 0x07, 0x16, 0x67, 0x3e, 0x3c, 0x5d, 0x37, 0xb8, 0xef, 0x70, 0x5f, 0x1d, 0xe8, 0x3e,
+	0x0d, 0x37, 0x52, 0xf7, 0x37, 0x06, 0x34, 0xd0, 0x37, 0x54, 0x57, 0x37, 0x2d, 0x26, 0x8c, 0xc1,
+	0xb6, 0x9e, 0x0c, 0xca, 0xd5, 0x3d, 0xdd, 0x89, 0x54, 0xd6, 0x52, 0x4f, 0x3c, 0xbe, 0x6f, 0x0d,
+	0xa9, 0xd7, 0x37, 0x6c, 0x8b, 0xb2, 0xa0, 0x6f, 0x0d, 0xc1, 0xea, 0x51, 0xd2, 0xca, 0xd6, 0xc7,
+	0xcc, 0x18, 0xf5, 0xad, 0x61, 0xff, 0xa9, 0xc7, 0x9d, 0xfe, 0x3e, 0xf5, 0xac, 0xa7, 0x16, 0x1d,
+	0x66, 0x9c, 0xde, 0x2e, 0x71, 0x32, 0x46, 0x3a, 0x63, 0xd4, 0x8e, 0x8d, 0x53, 0x71, 0xc4, 0xbf,
+	0xfb, 0x23, 0xcb, 0x0f, 0x78, 0x94, 0x43, 0x69, 0xbd, 0xc0, 0x0a, 0xb6, 0xf6, 0x03, 0x3d, 0x18,
+	0x03, 0x99, 0xfc, 0x31, 0x6e, 0x7e, 0x31, 0xc9, 0x4a, 0x97, 0x06, 0x5f, 0x41, 0x88, 0x1d, 0x61,
+	0xb6, 0x3b, 0xf4, 0x7b, 0xf4, 0xd9, 0x98, 0xfa, 0x01, 0x91, 0xf0, 0xcb, 0x61, 0x60, 0xbb, 0x3b,
+	0x75, 0xd4, 0x44, 0x0f, 0xae, 0xf7, 0x2e, 0xfe, 0xcb, 0x1a, 0xbe, 0x57, 0xe2, 0xef, 0xbb, 0x9c,
+	0xf9, 0x94, 0xdc, 0xc1, 0x2b, 0x46, 0xb4, 0x58, 0x47, 0xcd, 0xa5, 0x07, 0x2b, 0xbd, 0x78, 0x41,
+	0x6e, 0xe3, 0xbb, 0x91, 0xc4, 0x1e, 0xa4, 0x16, 0x24, 0x76, 0xaa, 0x44, 0x70, 0x88, 0x70, 0xb3,
+	0xd8, 0x1f, 0x22, 0xf8, 0x16, 0xdf, 0x70, 0xa7, 0x9e, 0x09, 0xa1, 0x57, 0x36, 0xdf, 0x51, 0x8a,
+	0x2b, 0x4e, 0x99, 0xd6, 0xdb, 0xbe, 0x7e, 0x74, 0x7a, 0xb7, 0xd6, 0xcb, 0x68, 0xc9, 0x16, 0x30,
+	0x68, 0xb6, 0x5d, 0xc4, 0xf0, 0x04, 0xe3, 0xb8, 0xe2, 0x60, 0xf3, 0xfb, 0x4a, 0x58, 0x9e, 0xca,
+	0xa4, 0x3c, 0x95, 0xf0, 0x1e, 0x40, 0x79, 0x2a, 0x7b, 0xba, 0x49, 0xc1, 0xb7, 0x97, 0xf0, 0x94,
+	0x7f, 0x8f, 0x78, 0x73, 0xf7, 0x2a, 0xe5, 0x5d, 0x5a, 0x14, 0x2f, 0xe9, 0xa6, 0x60, 0xae, 0x09,
+	0x98, 0xb7, 0x66, 0xc2, 0x84, 0xc1, 0xa5, 0x68, 0x76, 0xf0, 0x5a, 0x74, 0x78, 0x9f, 0xc1, 0x89,
+	0x3e, 0xf1, 0xb8, 0xd3, 0x09, 0x2b, 0x3f, 0xce, 0xde, 0xa4, 0x84, 0xa2, 0x35, 0x91, 0xbc, 0x95,
+	0x5e, 0xbc, 0x20, 0xff, 0x8a, 0xf0, 0x9b, 0x33, 0x64, 0x20, 0x31, 0x0e, 0xbe, 0x6d, 0xe7, 0x19,
+	0xc0, 0x81, 0x6c, 0x94, 0x65, 0x27, 0x57, 0x19, 0x52, 0x94, 0xaf, 0x2a, 0x33, 0xc0, 0xd3, 0x6c,
+	0xbb, 0x14, 0x6f, 0x51, 0xc5, 0xf1, 0x57, 0x94, 0x88, 0xe2, 0x0d, 0x67, 0x27, 0x62, 0x69, 0xf1,
+	0x89, 0x58, 0x5c, 0xc1, 0x24, 0x1a, 0xd6, 0xe7, 0x17, 0x21, 0x7d, 0x1a, 0xf6, 0xbf, 0x2a, 0xed,
+	0xe2, 0x07, 0x84, 0xef, 0x95, 0x08, 0x40, 0x76, 0x74, 0xbc, 0xea, 0x4c, 0x3f, 0x84, 0x63, 0x79,
+	0x58, 0x96, 0x99, 0x8c, 0x22, 0x64, 0x25, 0xab, 0x26, 0x77, 0xe2, 0x92, 0x8d, 0xbd, 0xc2, 0x0b,
+	0xf6, 0xa5, 0xe8, 0xd0, 0x55, 0x68, 0x7e, 0x44, 0xf8, 0xfe, 0x2c, 0x15, 0x40, 0xfa, 0x0e, 0xbf,
+	0x66, 0x24, 0xd6, 0xa9, 0x0f, 0x27, 0xbd, 0x59, 0x8d, 0x27, 0xa9, 0x09, 0x50, 0x53, 0x7a, 0xf2,
+	0x2d, 0x4c, 0x44, 0x2c, 0x7b, 0x62, 0x74, 0x42, 0xf8, 0xf2, 0xd7, 0xf8, 0x66, 0x6a, 0x15, 0xc2,
+	0xd9, 0xc2, 0xcb, 0xe1, 0x88, 0x85, 0xb4, 0xca, 0xa5, 0x7d, 0x49, 0x58, 0xc2, 0xb6, 0xe0, 0xb7,
+	0xf9, 0xdb, 0xab, 0xf8, 0x25, 0xa1, 0x4c, 0xfe, 0x40, 0x78, 0x35, 0x33, 0x7d, 0x48, 0xab, 0x4c,
+	0x71, 0xd6, 0xd0, 0x93, 0xda, 0x73, 0x7a, 0x87, 0x78, 0xf2, 0xf6, 0xe1, 0xc9, 0x3f, 0x3f, 0x5f,
+	0x6b, 0x91, 0x8f, 0xd4, 0x58, 0x46, 0xf5, 0x5d, 0xa6, 0x26, 0x67, 0x73, 0xf6, 0xa5, 0xc0, 0x57,
+	0x5f, 0x44, 0x67, 0x7b, 0x40, 0x8e, 0x11, 0xbe, 0x31, 0xdd, 0x91, 0xc9, 0xe3, 0x2a, 0x71, 0x15,
+	0xcc, 0x20, 0xa9, 0x35, 0x9f, 0x33, 0x30, 0x69, 0x82, 0xe9, 0x31, 0xf9, 0xb0, 0x8c, 0x29, 0xfb,
+	0x76, 0x94, 0x44, 0x3a, 0x42, 0xf8, 0xe6, 0xb4, 0xbe, 0x66, 0xdb, 0x15, 0xa8, 0x8a, 0x27, 0xab,
+	0xd4, 0x9a, 0xcf, 0x19, 0xa8, 0xde, 0x17, 0x54, 0xef, 0x12, 0xe5, 0x72, 0x54, 0xe4, 0x5f, 0x84,
+	0x6f, 0xe7, 0x36, 0x42, 0xb2, 0x55, 0x25, 0xcb, 0x65, 0xe3, 0x40, 0xd2, 0xae, 0xa0, 0x00, 0x58,
+	0xbb, 0x02, 0xab, 0x43, 0xb4, 0x32, 0xac, 0xc2, 0xf7, 0x4d, 0xf5, 0xc5, 0xc5, 0x70, 0x3d, 0x20,
+	0xa7, 0x08, 0xd7, 0x73, 0x37, 0x9b, 0x9c, 0xdc, 0x56, 0x95, 0xe4, 0x5f, 0x11, 0x76, 0xd6, 0x30,
+	0x93, 0xdb, 0x02, 0xf6, 0x03, 0xf2, 0xde, 0x5c, 0xb0, 0xe4, 0x04, 0xe1, 0xd5, 0x4c, 0xe7, 0xae,
+	0xd6, 0x3f, 0x8a, 0x66, 0x90, 0xd4, 0x9e, 0xd3, 0xfb, 0x32, 0x77, 0x2d, 0xfb, 0x05, 0x90, 0xbc,
+	0x6b, 0xff, 0x21, 0xfc, 0x7a, 0x7e, 0xff, 0x26, 0xda, 0xe5, 0x82, 0xcb, 0x99, 0x4a, 0xd2, 0xf6,
+	0x55, 0x24, 0x00, 0xb2, 0x2b, 0x20, 0x35, 0xf2, 0x49, 0x45, 0xc8, 0xd4, 0x07, 0x4c, 0x12, 0xf5,
+	0x17, 0x84, 0x97, 0xc3, 0x19, 0x41, 0x94, 0x99, 0x71, 0xa5, 0xc6, 0x93, 0xa4, 0x56, 0xb6, 0x87,
+	0xa0, 0xd7, 0x45, 0xd0, 0x6b, 0x44, 0x2e, 0xed, 0x17, 0xe1, 0xc0, 0xea, 0x1e, 0x9d, 0x35, 0xd0,
+	0xf1, 0x59, 0x03, 0xfd, 0x7d, 0xd6, 0x40, 0x3f, 0x9d, 0x37, 0x6a, 0xc7, 0xe7, 0x8d, 0xda, 0x9f,
+	0xe7, 0x8d, 0xda, 0x37, 0x0f, 0x4d, 0x2b, 0x18, 0x8d, 0x07, 0x8a, 0xc1, 0x9d, 0x69, 0x9d, 0xef,
+	0x53, 0x4a, 0xc1, 0x73, 0x97, 0xfa, 0x83, 0x65, 0xf1, 0xb5, 0xf6, 0xe8, 0xff, 0x01, 0x00, 0x45,
+	0x32, 0x3d, 0xb0, 0x38, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -756,6 +854,8 @@ type QueryClient interface {
 	LaunchIDFromChannelIDAll(ctx context.Context, in *QueryAllLaunchIDFromChannelIDRequest, opts ...grpc.CallOption) (*QueryAllLaunchIDFromChannelIDResponse, error)
 	// Queries a MonitoringHistory by launch id.
 	MonitoringHistory(ctx context.Context, in *QueryGetMonitoringHistoryRequest, opts ...grpc.CallOption) (*QueryGetMonitoringHistoryResponse, error)
+	// Queries the status of the verified clients of a chain.
+	MonitoringClientStatus(ctx context.Context, in *QueryGetMonitoringClientStatusRequest, opts ...grpc.CallOption) (*QueryGetMonitoringClientStatusResponse, error)
 	// Params queries the parameters of the module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
@@ -822,6 +922,15 @@ func (c *queryClient) MonitoringHistory(ctx context.Context, in *QueryGetMonitor
 	return out, nil
 }
 
+func (c *queryClient) MonitoringClientStatus(ctx context.Context, in *QueryGetMonitoringClientStatusRequest, opts ...grpc.CallOption) (*QueryGetMonitoringClientStatusResponse, error) {
+	out := new(QueryGetMonitoringClientStatusResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.monitoringc.Query/MonitoringClientStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/tendermint.spn.monitoringc.Query/Params", in, out, opts...)
@@ -845,6 +954,8 @@ type QueryServer interface {
 	LaunchIDFromChannelIDAll(context.Context, *QueryAllLaunchIDFromChannelIDRequest) (*QueryAllLaunchIDFromChannelIDResponse, error)
 	// Queries a MonitoringHistory by launch id.
 	MonitoringHistory(context.Context, *QueryGetMonitoringHistoryRequest) (*QueryGetMonitoringHistoryResponse, error)
+	// Queries the status of the verified clients of a chain.
+	MonitoringClientStatus(context.Context, *QueryGetMonitoringClientStatusRequest) (*QueryGetMonitoringClientStatusResponse, error)
 	// Params queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
@@ -871,6 +982,9 @@ func (*UnimplementedQueryServer) LaunchIDFromChannelIDAll(ctx context.Context, r
 func (*UnimplementedQueryServer) MonitoringHistory(ctx context.Context, req *QueryGetMonitoringHistoryRequest) (*QueryGetMonitoringHistoryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MonitoringHistory not implemented")
 }
+func (*UnimplementedQueryServer) MonitoringClientStatus(ctx context.Context, req *QueryGetMonitoringClientStatusRequest) (*QueryGetMonitoringClientStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MonitoringClientStatus not implemented")
+}
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -987,6 +1101,24 @@ func _Query_MonitoringHistory_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_MonitoringClientStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetMonitoringClientStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MonitoringClientStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.monitoringc.Query/MonitoringClientStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MonitoringClientStatus(ctx, req.(*QueryGetMonitoringClientStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -1033,6 +1165,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "MonitoringHistory",
 			Handler:    _Query_MonitoringHistory_Handler,
 		},
+		{
+			MethodName: "MonitoringClientStatus",
+			Handler:    _Query_MonitoringClientStatus_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -1455,6 +1591,71 @@ func (m *QueryGetMonitoringHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryGetMonitoringClientStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMonitoringClientStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMonitoringClientStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LaunchID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LaunchID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMonitoringClientStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMonitoringClientStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMonitoringClientStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientStatuses) > 0 {
+		for iNdEx := len(m.ClientStatuses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClientStatuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1683,6 +1884,33 @@ func (m *QueryGetMonitoringHistoryResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryGetMonitoringClientStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LaunchID != 0 {
+		n += 1 + sovQuery(uint64(m.LaunchID))
+	}
+	return n
+}
+
+func (m *QueryGetMonitoringClientStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ClientStatuses) > 0 {
+		for _, e := range m.ClientStatuses {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *QueryParamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2741,6 +2969,159 @@ func (m *QueryGetMonitoringHistoryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryGetMonitoringClientStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMonitoringClientStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMonitoringClientStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LaunchID", wireType)
+			}
+			m.LaunchID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LaunchID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMonitoringClientStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMonitoringClientStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMonitoringClientStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientStatuses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientStatuses = append(m.ClientStatuses, MonitoringClientStatus{})
+			if err := m.ClientStatuses[len(m.ClientStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -158,41 +159,381 @@ func (m *MsgCreateClientResponse) GetClientID() string {
 	return ""
 }
 
+type MsgUpdateParams struct {
+	// authority is the address of the governance account
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the parameters to update, all the parameters must be provided
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d32526277234083, []int{2}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d32526277234083, []int{3}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+// MsgRecoverMonitoringClient substitutes an expired verified client of a chain with a new client
+type MsgRecoverMonitoringClient struct {
+	Coordinator     string               `protobuf:"bytes,1,opt,name=coordinator,proto3" json:"coordinator,omitempty"`
+	LaunchID        uint64               `protobuf:"varint,2,opt,name=launchID,proto3" json:"launchID,omitempty"`
+	SubjectClientID string               `protobuf:"bytes,3,opt,name=subjectClientID,proto3" json:"subjectClientID,omitempty"`
+	ConsensusState  types.ConsensusState `protobuf:"bytes,4,opt,name=consensusState,proto3" json:"consensusState"`
+	ValidatorSet    types.ValidatorSet   `protobuf:"bytes,5,opt,name=validatorSet,proto3" json:"validatorSet"`
+	UnbondingPeriod int64                `protobuf:"varint,6,opt,name=unbondingPeriod,proto3" json:"unbondingPeriod,omitempty"`
+	RevisionHeight  uint64               `protobuf:"varint,7,opt,name=revisionHeight,proto3" json:"revisionHeight,omitempty"`
+}
+
+func (m *MsgRecoverMonitoringClient) Reset()         { *m = MsgRecoverMonitoringClient{} }
+func (m *MsgRecoverMonitoringClient) String() string { return proto.CompactTextString(m) }
+func (*MsgRecoverMonitoringClient) ProtoMessage()    {}
+func (*MsgRecoverMonitoringClient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d32526277234083, []int{4}
+}
+func (m *MsgRecoverMonitoringClient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRecoverMonitoringClient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRecoverMonitoringClient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRecoverMonitoringClient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRecoverMonitoringClient.Merge(m, src)
+}
+func (m *MsgRecoverMonitoringClient) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRecoverMonitoringClient) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRecoverMonitoringClient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRecoverMonitoringClient proto.InternalMessageInfo
+
+func (m *MsgRecoverMonitoringClient) GetCoordinator() string {
+	if m != nil {
+		return m.Coordinator
+	}
+	return ""
+}
+
+func (m *MsgRecoverMonitoringClient) GetLaunchID() uint64 {
+	if m != nil {
+		return m.LaunchID
+	}
+	return 0
+}
+
+func (m *MsgRecoverMonitoringClient) GetSubjectClientID() string {
+	if m != nil {
+		return m.SubjectClientID
+	}
+	return ""
+}
+
+func (m *MsgRecoverMonitoringClient) GetConsensusState() types.ConsensusState {
+	if m != nil {
+		return m.ConsensusState
+	}
+	return types.ConsensusState{}
+}
+
+func (m *MsgRecoverMonitoringClient) GetValidatorSet() types.ValidatorSet {
+	if m != nil {
+		return m.ValidatorSet
+	}
+	return types.ValidatorSet{}
+}
+
+func (m *MsgRecoverMonitoringClient) GetUnbondingPeriod() int64 {
+	if m != nil {
+		return m.UnbondingPeriod
+	}
+	return 0
+}
+
+func (m *MsgRecoverMonitoringClient) GetRevisionHeight() uint64 {
+	if m != nil {
+		return m.RevisionHeight
+	}
+	return 0
+}
+
+type MsgRecoverMonitoringClientResponse struct {
+	SubstituteClientID string `protobuf:"bytes,1,opt,name=substituteClientID,proto3" json:"substituteClientID,omitempty"`
+}
+
+func (m *MsgRecoverMonitoringClientResponse) Reset()         { *m = MsgRecoverMonitoringClientResponse{} }
+func (m *MsgRecoverMonitoringClientResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRecoverMonitoringClientResponse) ProtoMessage()    {}
+func (*MsgRecoverMonitoringClientResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d32526277234083, []int{5}
+}
+func (m *MsgRecoverMonitoringClientResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRecoverMonitoringClientResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRecoverMonitoringClientResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRecoverMonitoringClientResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRecoverMonitoringClientResponse.Merge(m, src)
+}
+func (m *MsgRecoverMonitoringClientResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRecoverMonitoringClientResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRecoverMonitoringClientResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRecoverMonitoringClientResponse proto.InternalMessageInfo
+
+func (m *MsgRecoverMonitoringClientResponse) GetSubstituteClientID() string {
+	if m != nil {
+		return m.SubstituteClientID
+	}
+	return ""
+}
+
+// MsgCloseRewardPool closes the reward pool of a chain whose monitoring ended without receiving the last
+// monitoring packet, the remaining coins are refunded to the contributors
+type MsgCloseRewardPool struct {
+	Sender   string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	LaunchID uint64 `protobuf:"varint,2,opt,name=launchID,proto3" json:"launchID,omitempty"`
+}
+
+func (m *MsgCloseRewardPool) Reset()         { *m = MsgCloseRewardPool{} }
+func (m *MsgCloseRewardPool) String() string { return proto.CompactTextString(m) }
+func (*MsgCloseRewardPool) ProtoMessage()    {}
+func (*MsgCloseRewardPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d32526277234083, []int{6}
+}
+func (m *MsgCloseRewardPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCloseRewardPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCloseRewardPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCloseRewardPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCloseRewardPool.Merge(m, src)
+}
+func (m *MsgCloseRewardPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCloseRewardPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCloseRewardPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCloseRewardPool proto.InternalMessageInfo
+
+func (m *MsgCloseRewardPool) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgCloseRewardPool) GetLaunchID() uint64 {
+	if m != nil {
+		return m.LaunchID
+	}
+	return 0
+}
+
+type MsgCloseRewardPoolResponse struct {
+}
+
+func (m *MsgCloseRewardPoolResponse) Reset()         { *m = MsgCloseRewardPoolResponse{} }
+func (m *MsgCloseRewardPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCloseRewardPoolResponse) ProtoMessage()    {}
+func (*MsgCloseRewardPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d32526277234083, []int{7}
+}
+func (m *MsgCloseRewardPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCloseRewardPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCloseRewardPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCloseRewardPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCloseRewardPoolResponse.Merge(m, src)
+}
+func (m *MsgCloseRewardPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCloseRewardPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCloseRewardPoolResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCloseRewardPoolResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateClient)(nil), "tendermint.spn.monitoringc.MsgCreateClient")
 	proto.RegisterType((*MsgCreateClientResponse)(nil), "tendermint.spn.monitoringc.MsgCreateClientResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "tendermint.spn.monitoringc.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "tendermint.spn.monitoringc.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgRecoverMonitoringClient)(nil), "tendermint.spn.monitoringc.MsgRecoverMonitoringClient")
+	proto.RegisterType((*MsgRecoverMonitoringClientResponse)(nil), "tendermint.spn.monitoringc.MsgRecoverMonitoringClientResponse")
+	proto.RegisterType((*MsgCloseRewardPool)(nil), "tendermint.spn.monitoringc.MsgCloseRewardPool")
+	proto.RegisterType((*MsgCloseRewardPoolResponse)(nil), "tendermint.spn.monitoringc.MsgCloseRewardPoolResponse")
 }
 
 func init() { proto.RegisterFile("monitoringc/tx.proto", fileDescriptor_6d32526277234083) }
 
 var fileDescriptor_6d32526277234083 = []byte{
-	// 407 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xd1, 0x6a, 0x14, 0x31,
-	0x14, 0xdd, 0x74, 0xd7, 0xaa, 0xb1, 0x74, 0x21, 0x14, 0x1c, 0xe6, 0x61, 0x5c, 0x16, 0x91, 0x41,
-	0x31, 0xa1, 0x2d, 0xfe, 0x40, 0xb7, 0xa0, 0x05, 0x0b, 0x32, 0x05, 0x1f, 0x7c, 0xcb, 0x64, 0x2e,
-	0xd9, 0xc0, 0xce, 0xcd, 0x98, 0x64, 0xd6, 0xf6, 0x2f, 0xfc, 0x1f, 0x7f, 0xa0, 0x8f, 0x7d, 0xf4,
-	0x49, 0x64, 0xf7, 0x47, 0x64, 0xa6, 0xdb, 0xba, 0x33, 0xe8, 0x43, 0xdf, 0xee, 0x39, 0x9c, 0x9c,
-	0x7b, 0xef, 0xc9, 0xa5, 0x07, 0xa5, 0x45, 0x13, 0xac, 0x33, 0xa8, 0x95, 0x08, 0x97, 0xbc, 0x72,
-	0x36, 0x58, 0x16, 0x07, 0xc0, 0x02, 0x5c, 0x69, 0x30, 0x70, 0x5f, 0x21, 0xdf, 0x12, 0xc5, 0xaf,
-	0x95, 0xf5, 0xa5, 0xf5, 0x22, 0x97, 0x1e, 0xc4, 0xd7, 0x1a, 0xdc, 0x95, 0x58, 0x1e, 0xe6, 0x10,
-	0xe4, 0xa1, 0xa8, 0xa4, 0x36, 0x28, 0x83, 0xb1, 0x78, 0xeb, 0x13, 0x1f, 0x68, 0xab, 0x6d, 0x5b,
-	0x8a, 0xa6, 0xda, 0xb0, 0xe3, 0x70, 0x55, 0x81, 0x17, 0x26, 0x57, 0xb7, 0xc4, 0xf4, 0xc7, 0x0e,
-	0x1d, 0x9f, 0x7b, 0x3d, 0x73, 0x20, 0x03, 0xcc, 0x16, 0x06, 0x30, 0xb0, 0x88, 0x3e, 0x56, 0x0d,
-	0xb6, 0x2e, 0x22, 0x13, 0x92, 0x3e, 0xcd, 0xee, 0x20, 0x8b, 0xe9, 0x93, 0x85, 0xac, 0x51, 0xcd,
-	0xcf, 0x4e, 0xa3, 0x9d, 0x09, 0x49, 0x47, 0xd9, 0x3d, 0x66, 0x19, 0xdd, 0x57, 0x16, 0x3d, 0xa0,
-	0xaf, 0xfd, 0x45, 0x90, 0x01, 0xa2, 0xe1, 0x84, 0xa4, 0xcf, 0x8e, 0x5e, 0xf2, 0xde, 0x46, 0xed,
-	0x08, 0x7c, 0xd6, 0xd1, 0x9e, 0x8c, 0xae, 0x7f, 0xbd, 0x18, 0x64, 0x3d, 0x07, 0xf6, 0x91, 0xee,
-	0x2d, 0xe5, 0xc2, 0x14, 0x4d, 0xf3, 0x0b, 0x08, 0xd1, 0xa8, 0x75, 0x9c, 0xfe, 0xdb, 0xf1, 0xf3,
-	0x96, 0x72, 0xe3, 0xd7, 0x79, 0xcd, 0x52, 0x3a, 0xae, 0x31, 0xb7, 0x58, 0x18, 0xd4, 0x9f, 0xc0,
-	0x19, 0x5b, 0x44, 0x8f, 0x26, 0x24, 0x1d, 0x66, 0x7d, 0x9a, 0xbd, 0xa2, 0xfb, 0x0e, 0x96, 0xc6,
-	0x1b, 0x8b, 0x1f, 0xc0, 0xe8, 0x79, 0x88, 0x76, 0xdb, 0x6d, 0x7b, 0xec, 0xf4, 0x1d, 0x7d, 0xde,
-	0x0b, 0x2f, 0x03, 0x5f, 0x35, 0x3b, 0x34, 0x51, 0xa9, 0x96, 0x39, 0x3b, 0xdd, 0xa4, 0x78, 0x8f,
-	0x8f, 0xbe, 0xd1, 0xe1, 0xb9, 0xd7, 0xac, 0xa2, 0x7b, 0x9d, 0xdc, 0xdf, 0xf0, 0xff, 0xff, 0x3d,
-	0xef, 0xf5, 0x89, 0x8f, 0x1f, 0x20, 0xbe, 0x1b, 0xea, 0xe4, 0xfd, 0xf5, 0x2a, 0x21, 0x37, 0xab,
-	0x84, 0xfc, 0x5e, 0x25, 0xe4, 0xfb, 0x3a, 0x19, 0xdc, 0xac, 0x93, 0xc1, 0xcf, 0x75, 0x32, 0xf8,
-	0xf2, 0x56, 0x9b, 0x30, 0xaf, 0x73, 0xae, 0x6c, 0x29, 0xfe, 0x1a, 0x0b, 0x5f, 0xa1, 0xb8, 0x14,
-	0x9d, 0x43, 0x6d, 0xb2, 0xce, 0x77, 0xdb, 0xeb, 0x39, 0xfe, 0x13, 0x00, 0x00, 0xff, 0xff, 0x0c,
-	0x8f, 0xb3, 0x08, 0xc4, 0x02, 0x00, 0x00,
+	// 680 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0x8d, 0x9b, 0x34, 0xfd, 0x7a, 0x5b, 0x35, 0xd2, 0xa8, 0x52, 0x5d, 0xeb, 0x53, 0x88, 0x2c,
+	0x84, 0x22, 0x50, 0x6d, 0xda, 0x8a, 0x2e, 0x58, 0x20, 0x68, 0x2a, 0x41, 0x25, 0x22, 0x55, 0x2e,
+	0xb0, 0x60, 0x83, 0xc6, 0xf6, 0xc8, 0x19, 0x94, 0xcc, 0x98, 0x99, 0x71, 0x68, 0x9e, 0x81, 0x0d,
+	0xf0, 0x2c, 0xec, 0x78, 0x81, 0x2e, 0x2b, 0x56, 0xac, 0x10, 0x6a, 0x5f, 0x82, 0x25, 0xf2, 0x4f,
+	0xdc, 0xd8, 0xa4, 0x09, 0x45, 0xdd, 0xe5, 0xde, 0x39, 0xf7, 0x67, 0xce, 0x39, 0x13, 0xc3, 0xfa,
+	0x80, 0x33, 0xaa, 0xb8, 0xa0, 0x2c, 0xf0, 0x6c, 0x75, 0x62, 0x85, 0x82, 0x2b, 0x8e, 0x0c, 0x45,
+	0x98, 0x4f, 0xc4, 0x80, 0x32, 0x65, 0xc9, 0x90, 0x59, 0x13, 0x20, 0xe3, 0xae, 0xc7, 0xe5, 0x80,
+	0x4b, 0xdb, 0xc5, 0x92, 0xd8, 0xef, 0x22, 0x22, 0x46, 0xf6, 0x70, 0xdb, 0x25, 0x0a, 0x6f, 0xdb,
+	0x21, 0x0e, 0x28, 0xc3, 0x8a, 0x72, 0x96, 0xf6, 0x31, 0xd6, 0x03, 0x1e, 0xf0, 0xe4, 0xa7, 0x1d,
+	0xff, 0xca, 0xb2, 0x0d, 0x35, 0x0a, 0x89, 0xb4, 0xa9, 0xeb, 0x65, 0x89, 0xcd, 0xb4, 0xe5, 0x9b,
+	0x14, 0x99, 0x06, 0xd9, 0x91, 0x3e, 0xb9, 0x5f, 0x88, 0x05, 0x1e, 0x64, 0x27, 0xe6, 0xd7, 0x05,
+	0x68, 0x74, 0x65, 0xd0, 0x11, 0x04, 0x2b, 0xd2, 0xe9, 0x53, 0xc2, 0x14, 0xd2, 0x61, 0xc9, 0x8b,
+	0x63, 0x2e, 0x74, 0xad, 0xa5, 0xb5, 0x97, 0x9d, 0x71, 0x88, 0x0c, 0xf8, 0xaf, 0x8f, 0x23, 0xe6,
+	0xf5, 0x0e, 0x0f, 0xf4, 0x85, 0x96, 0xd6, 0xae, 0x39, 0x79, 0x8c, 0x1c, 0x58, 0xf3, 0x38, 0x93,
+	0x84, 0xc9, 0x48, 0x1e, 0x2b, 0xac, 0x88, 0x5e, 0x6d, 0x69, 0xed, 0x95, 0x9d, 0xdb, 0x56, 0x89,
+	0x86, 0x64, 0x6f, 0xab, 0x53, 0xc0, 0xee, 0xd7, 0x4e, 0x7f, 0xdc, 0xaa, 0x38, 0xa5, 0x0e, 0xe8,
+	0x39, 0xac, 0x0e, 0x71, 0x9f, 0xfa, 0xf1, 0xf0, 0x63, 0xa2, 0xf4, 0x5a, 0xd2, 0xd1, 0x9c, 0xde,
+	0xf1, 0xd5, 0x04, 0x32, 0xeb, 0x57, 0xa8, 0x46, 0x6d, 0x68, 0x44, 0xcc, 0xe5, 0xcc, 0xa7, 0x2c,
+	0x38, 0x22, 0x82, 0x72, 0x5f, 0x5f, 0x6c, 0x69, 0xed, 0xaa, 0x53, 0x4e, 0xa3, 0x3b, 0xb0, 0x26,
+	0xc8, 0x90, 0x4a, 0xca, 0xd9, 0x33, 0x42, 0x83, 0x9e, 0xd2, 0xeb, 0xc9, 0x6d, 0x4b, 0x59, 0xf3,
+	0x01, 0x6c, 0x94, 0xc8, 0x73, 0x88, 0x0c, 0xe3, 0x3b, 0xc4, 0x54, 0x79, 0x49, 0xe6, 0xf0, 0x20,
+	0x63, 0x31, 0x8f, 0xcd, 0x0f, 0x5a, 0x42, 0xfa, 0xcb, 0xd0, 0xc7, 0x8a, 0x1c, 0x25, 0x72, 0xa0,
+	0x3d, 0x58, 0xc6, 0x91, 0xea, 0x71, 0x41, 0xd5, 0x28, 0x2d, 0xd8, 0xd7, 0xbf, 0x7d, 0xd9, 0x5a,
+	0xcf, 0x74, 0x7c, 0xe2, 0xfb, 0x82, 0x48, 0x79, 0xac, 0x62, 0x0d, 0x9d, 0x4b, 0x28, 0x7a, 0x0c,
+	0xf5, 0x54, 0x50, 0x7d, 0x61, 0x3a, 0x39, 0x13, 0xd2, 0x5b, 0xe9, 0xac, 0x8c, 0x9c, 0xac, 0xce,
+	0xdc, 0x84, 0x8d, 0xd2, 0x32, 0xe3, 0x4b, 0x98, 0x9f, 0xab, 0x60, 0x74, 0x65, 0xe0, 0x10, 0x8f,
+	0x0f, 0x89, 0xe8, 0xe6, 0x9d, 0x32, 0xa3, 0x3c, 0x84, 0x15, 0x8f, 0x73, 0xe1, 0x53, 0x76, 0x69,
+	0x96, 0x19, 0x5b, 0x4f, 0x82, 0x67, 0x5a, 0xa9, 0x0d, 0x0d, 0x19, 0xb9, 0x6f, 0x89, 0xa7, 0x3a,
+	0x63, 0x0a, 0xab, 0x09, 0x85, 0xe5, 0xf4, 0x14, 0xd3, 0xd5, 0x6e, 0xdc, 0x74, 0x8b, 0x37, 0x6d,
+	0xba, 0xfa, 0xdf, 0x9a, 0x6e, 0x69, 0xaa, 0xe9, 0x5e, 0x80, 0x79, 0xb5, 0x26, 0xb9, 0xff, 0x2c,
+	0x40, 0x32, 0x72, 0xa5, 0xa2, 0x2a, 0x1a, 0x7b, 0x33, 0x77, 0xe2, 0x94, 0x13, 0xd3, 0x05, 0x14,
+	0x5b, 0xb9, 0xcf, 0x25, 0x71, 0xc8, 0x7b, 0x2c, 0xfc, 0x23, 0xce, 0xfb, 0xe8, 0x3e, 0xd4, 0x65,
+	0x72, 0xed, 0xb9, 0xe2, 0x66, 0xb8, 0x59, 0xba, 0x9a, 0xff, 0x83, 0xf1, 0xe7, 0x8c, 0xf1, 0xc6,
+	0x3b, 0xbf, 0xaa, 0x50, 0xed, 0xca, 0x00, 0x85, 0xb0, 0x5a, 0xf8, 0x3b, 0xba, 0x37, 0xcb, 0xd1,
+	0xa5, 0xe7, 0x67, 0xec, 0x5e, 0x03, 0x9c, 0x73, 0x15, 0xc2, 0x6a, 0xe1, 0x2d, 0xce, 0x9b, 0x38,
+	0x09, 0x36, 0x76, 0xaf, 0x01, 0xce, 0x27, 0x7e, 0xd2, 0x60, 0xe3, 0xaa, 0x57, 0xb5, 0x37, 0xa7,
+	0xe1, 0x15, 0x75, 0xc6, 0xa3, 0x7f, 0xab, 0xcb, 0x77, 0x1a, 0x41, 0xa3, 0x2c, 0xbf, 0x35, 0x8f,
+	0xcd, 0x22, 0xde, 0xd8, 0xbb, 0x1e, 0x7e, 0x3c, 0x7a, 0xff, 0xe9, 0xe9, 0x79, 0x53, 0x3b, 0x3b,
+	0x6f, 0x6a, 0x3f, 0xcf, 0x9b, 0xda, 0xc7, 0x8b, 0x66, 0xe5, 0xec, 0xa2, 0x59, 0xf9, 0x7e, 0xd1,
+	0xac, 0xbc, 0xde, 0x0a, 0xa8, 0xea, 0x45, 0xae, 0xe5, 0xf1, 0x81, 0x7d, 0xd9, 0xdb, 0x96, 0x21,
+	0xb3, 0x4f, 0xec, 0xc2, 0x57, 0x37, 0x7e, 0x8e, 0x6e, 0x3d, 0xf9, 0xaa, 0xed, 0xfe, 0x1e, 0x00,
+	0x8f, 0x18, 0xdd, 0xbb, 0x91, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -208,6 +549,9 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type MsgClient interface {
 	CreateClient(ctx context.Context, in *MsgCreateClient, opts ...grpc.CallOption) (*MsgCreateClientResponse, error)
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	RecoverMonitoringClient(ctx context.Context, in *MsgRecoverMonitoringClient, opts ...grpc.CallOption) (*MsgRecoverMonitoringClientResponse, error)
+	CloseRewardPool(ctx context.Context, in *MsgCloseRewardPool, opts ...grpc.CallOption) (*MsgCloseRewardPoolResponse, error)
 }
 
 type msgClient struct {
@@ -227,9 +571,39 @@ func (c *msgClient) CreateClient(ctx context.Context, in *MsgCreateClient, opts
 	return out, nil
 }
 
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.monitoringc.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RecoverMonitoringClient(ctx context.Context, in *MsgRecoverMonitoringClient, opts ...grpc.CallOption) (*MsgRecoverMonitoringClientResponse, error) {
+	out := new(MsgRecoverMonitoringClientResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.monitoringc.Msg/RecoverMonitoringClient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CloseRewardPool(ctx context.Context, in *MsgCloseRewardPool, opts ...grpc.CallOption) (*MsgCloseRewardPoolResponse, error) {
+	out := new(MsgCloseRewardPoolResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.monitoringc.Msg/CloseRewardPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	CreateClient(context.Context, *MsgCreateClient) (*MsgCreateClientResponse, error)
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	RecoverMonitoringClient(context.Context, *MsgRecoverMonitoringClient) (*MsgRecoverMonitoringClientResponse, error)
+	CloseRewardPool(context.Context, *MsgCloseRewardPool) (*MsgCloseRewardPoolResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -239,6 +613,15 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) CreateClient(ctx context.Context, req *MsgCreateClient) (*MsgCreateClientResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateClient not implemented")
 }
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) RecoverMonitoringClient(ctx context.Context, req *MsgRecoverMonitoringClient) (*MsgRecoverMonitoringClientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecoverMonitoringClient not implemented")
+}
+func (*UnimplementedMsgServer) CloseRewardPool(ctx context.Context, req *MsgCloseRewardPool) (*MsgCloseRewardPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseRewardPool not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -262,6 +645,60 @@ func _Msg_CreateClient_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.monitoringc.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RecoverMonitoringClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRecoverMonitoringClient)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RecoverMonitoringClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.monitoringc.Msg/RecoverMonitoringClient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RecoverMonitoringClient(ctx, req.(*MsgRecoverMonitoringClient))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CloseRewardPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCloseRewardPool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CloseRewardPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.monitoringc.Msg/CloseRewardPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CloseRewardPool(ctx, req.(*MsgCloseRewardPool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "tendermint.spn.monitoringc.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -270,10 +707,22 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateClient",
 			Handler:    _Msg_CreateClient_Handler,
 		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "monitoringc/tx.proto",
-}
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "RecoverMonitoringClient",
+			Handler:    _Msg_RecoverMonitoringClient_Handler,
+		},
+		{
+			MethodName: "CloseRewardPool",
+			Handler:    _Msg_CloseRewardPool_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "monitoringc/tx.proto",
+}
 
 func (m *MsgCreateClient) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -370,6 +819,229 @@ func (m *MsgCreateClientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRecoverMonitoringClient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRecoverMonitoringClient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRecoverMonitoringClient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RevisionHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RevisionHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.UnbondingPeriod != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UnbondingPeriod))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.ValidatorSet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.ConsensusState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.SubjectClientID) > 0 {
+		i -= len(m.SubjectClientID)
+		copy(dAtA[i:], m.SubjectClientID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SubjectClientID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.LaunchID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LaunchID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Coordinator) > 0 {
+		i -= len(m.Coordinator)
+		copy(dAtA[i:], m.Coordinator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Coordinator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRecoverMonitoringClientResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRecoverMonitoringClientResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRecoverMonitoringClientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SubstituteClientID) > 0 {
+		i -= len(m.SubstituteClientID)
+		copy(dAtA[i:], m.SubstituteClientID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SubstituteClientID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCloseRewardPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCloseRewardPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCloseRewardPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LaunchID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LaunchID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCloseRewardPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCloseRewardPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCloseRewardPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -404,29 +1076,573 @@ func (m *MsgCreateClient) Size() (n int) {
 	if m.RevisionHeight != 0 {
 		n += 1 + sovTx(uint64(m.RevisionHeight))
 	}
-	return n
-}
+	return n
+}
+
+func (m *MsgCreateClientResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRecoverMonitoringClient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Coordinator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LaunchID != 0 {
+		n += 1 + sovTx(uint64(m.LaunchID))
+	}
+	l = len(m.SubjectClientID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ConsensusState.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ValidatorSet.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.UnbondingPeriod != 0 {
+		n += 1 + sovTx(uint64(m.UnbondingPeriod))
+	}
+	if m.RevisionHeight != 0 {
+		n += 1 + sovTx(uint64(m.RevisionHeight))
+	}
+	return n
+}
+
+func (m *MsgRecoverMonitoringClientResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SubstituteClientID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCloseRewardPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LaunchID != 0 {
+		n += 1 + sovTx(uint64(m.LaunchID))
+	}
+	return n
+}
+
+func (m *MsgCloseRewardPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateClient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateClient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateClient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LaunchID", wireType)
+			}
+			m.LaunchID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LaunchID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConsensusState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingPeriod", wireType)
+			}
+			m.UnbondingPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingPeriod |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevisionHeight", wireType)
+			}
+			m.RevisionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevisionHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateClientResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateClientResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgCreateClientResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ClientID)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgCreateClient) Unmarshal(dAtA []byte) error {
+func (m *MsgRecoverMonitoringClient) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -449,15 +1665,15 @@ func (m *MsgCreateClient) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateClient: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRecoverMonitoringClient: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateClient: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRecoverMonitoringClient: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Coordinator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -485,7 +1701,7 @@ func (m *MsgCreateClient) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Coordinator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
@@ -507,6 +1723,38 @@ func (m *MsgCreateClient) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubjectClientID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubjectClientID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusState", wireType)
 			}
@@ -539,7 +1787,7 @@ func (m *MsgCreateClient) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSet", wireType)
 			}
@@ -572,7 +1820,7 @@ func (m *MsgCreateClient) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingPeriod", wireType)
 			}
@@ -591,7 +1839,7 @@ func (m *MsgCreateClient) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 6:
+		case 7:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field RevisionHeight", wireType)
 			}
@@ -631,7 +1879,7 @@ func (m *MsgCreateClient) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateClientResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRecoverMonitoringClientResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -654,15 +1902,15 @@ func (m *MsgCreateClientResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateClientResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRecoverMonitoringClientResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRecoverMonitoringClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubstituteClientID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -690,8 +1938,159 @@ func (m *MsgCreateClientResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientID = string(dAtA[iNdEx:postIndex])
+			m.SubstituteClientID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCloseRewardPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCloseRewardPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCloseRewardPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LaunchID", wireType)
+			}
+			m.LaunchID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LaunchID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCloseRewardPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCloseRewardPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCloseRewardPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -17,8 +17,32 @@ const (
 	// The timeout is set to one year
 	// This is an arbitrarily chosen value that should never be reached in practice
 	MonitoringPacketTimeoutDelay = time.Hour * 8760
+
+	// MonitoringPacketMaxRetries is the maximum number of consecutive retries of a failed monitoring packet
+	// Once reached, the signatures are only transmitted again at the end of the next reward period
+	// or retried with a backoff once the last block height is reached
+	MonitoringPacketMaxRetries = 10
+
+	// MonitoringPacketMaxRetryDelay is the maximum number of blocks between two retries of the last monitoring packet
+	// The last monitoring packet is retried until delivered, the delay doubles after each failure past the max retries
+	MonitoringPacketMaxRetryDelay = 1000
 )
 
+// lastRoundRetryDelay returns the number of blocks to wait before retrying the failed last monitoring packet
+func lastRoundRetryDelay(retries uint64) int64 {
+	if retries <= MonitoringPacketMaxRetries {
+		return 0
+	}
+	exponent := retries - MonitoringPacketMaxRetries
+	if exponent >= 10 {
+		return MonitoringPacketMaxRetryDelay
+	}
+	if delay := int64(1) << exponent; delay < MonitoringPacketMaxRetryDelay {
+		return delay
+	}
+	return MonitoringPacketMaxRetryDelay
+}
+
 // ReportBlockSignatures gets signatures from blocks and update monitoring info
 func (k Keeper) ReportBlockSignatures(ctx sdk.Context, lastCommit abci.LastCommitInfo, blockHeight int64) error {
 	// skip first block because it is not signed
@@ -63,30 +87,51 @@ func (k Keeper) ReportBlockSignatures(ctx sdk.Context, lastCommit abci.LastCommi
 
 // TransmitSignatures transmits over IBC the signatures to consumer if height is reached
 // and signatures are not yet transmitted
+// If a reward period is set, the signatures are also transmitted at the end of each period
+// before the last block height, and the signature counts are reset for the next period
+// If the last monitoring packet failed to be delivered, the transmission is retried
+// The packet of the last round is never dropped and retried with a backoff once the max retries is reached
 func (k Keeper) TransmitSignatures(ctx sdk.Context, blockHeight int64) error {
 	// check condition to transmit packet
 	// IBC connection to consumer must be established
-	// last block height must be reached
+	// last block height or the end of a reward period must be reached or the last packet must have failed
 	// monitoring info must exist
 	// signatures must not yet be transmitted
-	if blockHeight < k.LastBlockHeight(ctx) {
+	mi, miFound := k.GetMonitoringInfo(ctx)
+	rewardPeriod := k.RewardPeriod(ctx)
+	isLastRound := blockHeight >= k.LastBlockHeight(ctx)
+	isPeriodEnd := rewardPeriod > 0 && blockHeight%rewardPeriod == 0
+	canRetry := mi.LastPacketStatus.Retries <= MonitoringPacketMaxRetries
+	isRetry := mi.LastPacketStatus.IsFailed() && canRetry
+	if !isLastRound && !isPeriodEnd && !isRetry {
 		return nil
 	}
+	if isLastRound && mi.LastPacketStatus.IsFailed() && !canRetry {
+		nextRetryHeight := mi.LastPacketStatus.BlockHeight + lastRoundRetryDelay(mi.LastPacketStatus.Retries)
+		if blockHeight < nextRetryHeight {
+			return nil
+		}
+	}
 	cid, cidFound := k.GetConnectionChannelID(ctx)
 	if !cidFound {
 		return nil
 	}
-	mi, miFound := k.GetMonitoringInfo(ctx)
 	if !miFound || mi.Transmitted {
 		return nil
 	}
 
+	// no signature to transmit for the period
+	if !isLastRound && mi.SignatureCounts.BlockCount == 0 {
+		return nil
+	}
+
 	// transmit signature packet
 	err := k.TransmitMonitoringPacket(
 		ctx,
 		spntypes.MonitoringPacket{
 			BlockHeight:     blockHeight,
 			SignatureCounts: mi.SignatureCounts,
+			LastRound:       isLastRound,
 		},
 		types.PortID,
 		cid.ChannelID,
@@ -94,14 +139,25 @@ func (k Keeper) TransmitSignatures(ctx sdk.Context, blockHeight int64) error {
 		uint64(ctx.BlockTime().Add(MonitoringPacketTimeoutDelay).UnixNano()),
 	)
 	if err != nil {
-		k.SetConsumerClientID(ctx, types.ConsumerClientID{
-			ClientID: err.Error(),
-		})
+		mi.LastPacketStatus = types.MonitoringPacketStatus{
+			Status:      types.MonitoringPacketStatus_FAILED,
+			BlockHeight: blockHeight,
+			Error:       err.Error(),
+			Retries:     mi.LastPacketStatus.Retries + 1,
+		}
+		k.SetMonitoringInfo(ctx, mi)
 		return err
 	}
 
-	// signatures have been transmitted
-	mi.Transmitted = true
+	// signatures have been transmitted, the counts are reset for the next period
+	// the signatures are transmitted for the last time if the last block height is reached
+	mi.Transmitted = isLastRound
+	mi.SignatureCounts = spntypes.NewSignatureCounts()
+	mi.LastPacketStatus = types.MonitoringPacketStatus{
+		Status:      types.MonitoringPacketStatus_SENT,
+		BlockHeight: blockHeight,
+		Retries:     mi.LastPacketStatus.Retries,
+	}
 	k.SetMonitoringInfo(ctx, mi)
 	return nil
 }
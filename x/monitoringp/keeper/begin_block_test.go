@@ -9,6 +9,7 @@ import (
 	tc "github.com/tendermint/spn/testutil/constructor"
 	testkeeper "github.com/tendermint/spn/testutil/keeper"
 	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/monitoringp/keeper"
 	"github.com/tendermint/spn/x/monitoringp/types"
 )
 
@@ -266,6 +267,7 @@ func TestKeeper_TransmitSignatures(t *testing.T) {
 		monitoringInfoExist         bool
 		inputMonitoringInfo         types.MonitoringInfo
 		lastBlockHeight             int64
+		rewardPeriod                int64
 		currentBlockHeight          int64
 		channelIDExist              bool
 		channelID                   types.ConnectionChannelID
@@ -311,12 +313,148 @@ func TestKeeper_TransmitSignatures(t *testing.T) {
 			channelID:          types.ConnectionChannelID{ChannelID: "channelID"},
 			wantErr:            true,
 		},
+		{
+			name:                "currentBlockHeight not at the end of a reward period returns nil",
+			monitoringInfoExist: true,
+			inputMonitoringInfo: tc.MonitoringInfo(1,
+				tc.SignatureCount(t,
+					valFoo.OperatorAddress,
+					"1")),
+			lastBlockHeight:             100,
+			rewardPeriod:                10,
+			currentBlockHeight:          15,
+			channelIDExist:              true,
+			channelID:                   types.ConnectionChannelID{ChannelID: "channelID"},
+			expectedMonitoringInfoFound: true,
+			expectedMonitoringInfo: tc.MonitoringInfo(1,
+				tc.SignatureCount(t,
+					valFoo.OperatorAddress,
+					"1")),
+		},
+		{
+			name:                        "no signature counts at the end of a reward period returns nil",
+			monitoringInfoExist:         true,
+			inputMonitoringInfo:         tc.MonitoringInfo(0),
+			lastBlockHeight:             100,
+			rewardPeriod:                10,
+			currentBlockHeight:          20,
+			channelIDExist:              true,
+			channelID:                   types.ConnectionChannelID{ChannelID: "channelID"},
+			expectedMonitoringInfoFound: true,
+			expectedMonitoringInfo:      tc.MonitoringInfo(0),
+		},
+		{
+			name:                "end of a reward period transmits the signatures",
+			monitoringInfoExist: true,
+			inputMonitoringInfo: tc.MonitoringInfo(1,
+				tc.SignatureCount(t,
+					valFoo.OperatorAddress,
+					"1")),
+			lastBlockHeight:    100,
+			rewardPeriod:       10,
+			currentBlockHeight: 20,
+			channelIDExist:     true,
+			channelID:          types.ConnectionChannelID{ChannelID: "channelID"},
+			wantErr:            true,
+		},
+		{
+			name:                "failed monitoring packet is transmitted again",
+			monitoringInfoExist: true,
+			inputMonitoringInfo: types.MonitoringInfo{
+				SignatureCounts: tc.SignatureCounts(1,
+					tc.SignatureCount(t,
+						valFoo.OperatorAddress,
+						"1")),
+				LastPacketStatus: types.MonitoringPacketStatus{
+					Status:  types.MonitoringPacketStatus_FAILED,
+					Retries: 1,
+				},
+			},
+			lastBlockHeight:    100,
+			rewardPeriod:       10,
+			currentBlockHeight: 15,
+			channelIDExist:     true,
+			channelID:          types.ConnectionChannelID{ChannelID: "channelID"},
+			wantErr:            true,
+		},
+		{
+			name:                "failed last monitoring packet is not retried before the delay once the max retries is reached",
+			monitoringInfoExist: true,
+			inputMonitoringInfo: types.MonitoringInfo{
+				SignatureCounts: tc.SignatureCounts(1,
+					tc.SignatureCount(t,
+						valFoo.OperatorAddress,
+						"1")),
+				LastPacketStatus: types.MonitoringPacketStatus{
+					Status:      types.MonitoringPacketStatus_FAILED,
+					BlockHeight: 10,
+					Retries:     keeper.MonitoringPacketMaxRetries + 1,
+				},
+			},
+			lastBlockHeight:             10,
+			currentBlockHeight:          11,
+			channelIDExist:              true,
+			channelID:                   types.ConnectionChannelID{ChannelID: "channelID"},
+			expectedMonitoringInfoFound: true,
+			expectedMonitoringInfo: types.MonitoringInfo{
+				SignatureCounts: tc.SignatureCounts(1,
+					tc.SignatureCount(t,
+						valFoo.OperatorAddress,
+						"1")),
+				LastPacketStatus: types.MonitoringPacketStatus{
+					Status:      types.MonitoringPacketStatus_FAILED,
+					BlockHeight: 10,
+					Retries:     keeper.MonitoringPacketMaxRetries + 1,
+				},
+			},
+		},
+		{
+			name:                "failed last monitoring packet is retried after the delay once the max retries is reached",
+			monitoringInfoExist: true,
+			inputMonitoringInfo: types.MonitoringInfo{
+				SignatureCounts: tc.SignatureCounts(1,
+					tc.SignatureCount(t,
+						valFoo.OperatorAddress,
+						"1")),
+				LastPacketStatus: types.MonitoringPacketStatus{
+					Status:      types.MonitoringPacketStatus_FAILED,
+					BlockHeight: 10,
+					Retries:     keeper.MonitoringPacketMaxRetries + 1,
+				},
+			},
+			lastBlockHeight:    10,
+			currentBlockHeight: 12,
+			channelIDExist:     true,
+			channelID:          types.ConnectionChannelID{ChannelID: "channelID"},
+			wantErr:            true,
+		},
+		{
+			name:                "failed last monitoring packet is retried after the max delay",
+			monitoringInfoExist: true,
+			inputMonitoringInfo: types.MonitoringInfo{
+				SignatureCounts: tc.SignatureCounts(1,
+					tc.SignatureCount(t,
+						valFoo.OperatorAddress,
+						"1")),
+				LastPacketStatus: types.MonitoringPacketStatus{
+					Status:      types.MonitoringPacketStatus_FAILED,
+					BlockHeight: 10,
+					Retries:     keeper.MonitoringPacketMaxRetries + 100,
+				},
+			},
+			lastBlockHeight:    10,
+			currentBlockHeight: 10 + keeper.MonitoringPacketMaxRetryDelay,
+			channelIDExist:     true,
+			channelID:          types.ConnectionChannelID{ChannelID: "channelID"},
+			wantErr:            true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// set keeper values
 			params := tk.MonitoringProviderKeeper.GetParams(ctx)
 			params.LastBlockHeight = tt.lastBlockHeight
+			params.RewardPeriod = tt.rewardPeriod
 			tk.MonitoringProviderKeeper.SetParams(ctx, params)
 			if tt.monitoringInfoExist {
 				tk.MonitoringProviderKeeper.SetMonitoringInfo(ctx, tt.inputMonitoringInfo)
@@ -28,3 +28,11 @@ func TestConnectionChannelIDGet(t *testing.T) {
 		nullify.Fill(&rst),
 	)
 }
+
+func TestConnectionChannelIDRemove(t *testing.T) {
+	ctx, tk, _ := testkeeper.NewTestSetupWithMonitoringp(t)
+	createTestConnectionChannelID(ctx, tk.MonitoringProviderKeeper)
+	tk.MonitoringProviderKeeper.RemoveConnectionChannelID(ctx)
+	_, found := tk.MonitoringProviderKeeper.GetConnectionChannelID(ctx)
+	require.False(t, found)
+}
@@ -24,6 +24,7 @@ func TestKeeper_InitializeConsumerClient(t *testing.T) {
 			sample.ConsensusState(0),
 			spntypes.DefaultUnbondingPeriod,
 			spntypes.DefaultRevisionHeight,
+			types.DefaultRewardPeriod,
 		))
 		clientID, err := tk.MonitoringProviderKeeper.InitializeConsumerClient(ctx)
 		require.NoError(t, err)
@@ -22,6 +22,7 @@ type (
 		storeKey         storetypes.StoreKey
 		memKey           storetypes.StoreKey
 		paramstore       paramtypes.Subspace
+		authority        string
 		stakingKeeper    types.StakingKeeper
 		scopedKeeper     capabilitykeeper.ScopedKeeper
 		clientKeeper     types.ClientKeeper
@@ -42,6 +43,7 @@ func NewKeeper(
 	channelKeeper types.ChannelKeeper,
 	portKeeper types.PortKeeper,
 	scopedKeeper capabilitykeeper.ScopedKeeper,
+	authority string,
 ) *Keeper {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
@@ -54,6 +56,7 @@ func NewKeeper(
 		storeKey:         storeKey,
 		memKey:           memKey,
 		paramstore:       ps,
+		authority:        authority,
 		stakingKeeper:    stakingKeeper,
 		scopedKeeper:     scopedKeeper,
 		clientKeeper:     clientKeeper,
@@ -67,6 +70,11 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
+// GetAuthority returns the address allowed to update the module parameters
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // IsBound checks if the module is already bound to the desired port
 func (k Keeper) IsBound(ctx sdk.Context, portID string) bool {
 	_, ok := k.scopedKeeper.GetCapability(ctx, host.PortPath(portID))
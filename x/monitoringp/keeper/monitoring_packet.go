@@ -83,12 +83,13 @@ func (k Keeper) OnRecvMonitoringPacket(
 // acknowledgement written on the receiving chain.
 func (k Keeper) OnAcknowledgementMonitoringPacket(
 	ctx sdk.Context,
-	packet channeltypes.Packet,
+	_ channeltypes.Packet,
 	data spntypes.MonitoringPacket,
 	ack channeltypes.Acknowledgement,
 ) error {
 	switch dispatchedAck := ack.Response.(type) {
 	case *channeltypes.Acknowledgement_Error:
+		k.onMonitoringPacketFailure(ctx, data, types.MonitoringPacketStatus_FAILED, dispatchedAck.Error)
 		return nil
 	case *channeltypes.Acknowledgement_Result:
 		// Decode the packet acknowledgment
@@ -99,6 +100,12 @@ func (k Keeper) OnAcknowledgementMonitoringPacket(
 			return errors.New("cannot unmarshal acknowledgment")
 		}
 
+		mi := k.getOrInitMonitoringInfo(ctx)
+		mi.LastPacketStatus = types.MonitoringPacketStatus{
+			Status:      types.MonitoringPacketStatus_ACKNOWLEDGED,
+			BlockHeight: data.BlockHeight,
+		}
+		k.SetMonitoringInfo(ctx, mi)
 		return nil
 	default:
 		// The counter-party module doesn't implement the correct acknowledgment format
@@ -108,9 +115,45 @@ func (k Keeper) OnAcknowledgementMonitoringPacket(
 
 // OnTimeoutMonitoringPacket responds to the case where a packet has not been transmitted because of a timeout
 func (k Keeper) OnTimeoutMonitoringPacket(
-	_ sdk.Context,
+	ctx sdk.Context,
 	_ channeltypes.Packet,
-	_ spntypes.MonitoringPacket,
+	data spntypes.MonitoringPacket,
 ) error {
-	return errors.New("not implemented")
+	// the monitoring channel is ordered and therefore closed on timeout
+	// the connection channel ID is removed to allow the consumer chain to open a new channel
+	k.RemoveConnectionChannelID(ctx)
+
+	k.onMonitoringPacketFailure(ctx, data, types.MonitoringPacketStatus_TIMED_OUT, "packet timed out")
+	return nil
+}
+
+// onMonitoringPacketFailure records the failure of a monitoring packet
+// the signatures of the packet are added back to the monitoring info to be transmitted again
+func (k Keeper) onMonitoringPacketFailure(
+	ctx sdk.Context,
+	data spntypes.MonitoringPacket,
+	status types.MonitoringPacketStatus_Status,
+	errMsg string,
+) {
+	mi := k.getOrInitMonitoringInfo(ctx)
+	mi.SignatureCounts.Merge(data.SignatureCounts)
+	mi.Transmitted = false
+	mi.LastPacketStatus = types.MonitoringPacketStatus{
+		Status:      status,
+		BlockHeight: data.BlockHeight,
+		Error:       errMsg,
+		Retries:     mi.LastPacketStatus.Retries + 1,
+	}
+	k.SetMonitoringInfo(ctx, mi)
+}
+
+// getOrInitMonitoringInfo returns the monitoring info or initializes it if it doesn't exist
+func (k Keeper) getOrInitMonitoringInfo(ctx sdk.Context) types.MonitoringInfo {
+	mi, found := k.GetMonitoringInfo(ctx)
+	if !found {
+		mi = types.MonitoringInfo{
+			SignatureCounts: spntypes.NewSignatureCounts(),
+		}
+	}
+	return mi
 }
@@ -0,0 +1,126 @@
+package keeper_test
+
+import (
+	"testing"
+
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	spntypes "github.com/tendermint/spn/pkg/types"
+	tc "github.com/tendermint/spn/testutil/constructor"
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/monitoringp/types"
+)
+
+func Test_OnAcknowledgementMonitoringPacket(t *testing.T) {
+	var (
+		ctx, tk, _ = testkeeper.NewTestSetupWithMonitoringp(t)
+		opAddr     = sample.Address(r)
+		data       = spntypes.MonitoringPacket{
+			BlockHeight:     10,
+			SignatureCounts: tc.SignatureCounts(2, tc.SignatureCount(t, opAddr, "1")),
+		}
+	)
+
+	t.Run("should record the acknowledgement of a monitoring packet", func(t *testing.T) {
+		tk.MonitoringProviderKeeper.SetMonitoringInfo(ctx, types.MonitoringInfo{
+			Transmitted: true,
+			LastPacketStatus: types.MonitoringPacketStatus{
+				Status:      types.MonitoringPacketStatus_SENT,
+				BlockHeight: 10,
+				Retries:     2,
+			},
+		})
+
+		err := tk.MonitoringProviderKeeper.OnAcknowledgementMonitoringPacket(
+			ctx,
+			channeltypes.Packet{},
+			data,
+			channeltypes.NewResultAcknowledgement([]byte("{}")),
+		)
+		require.NoError(t, err)
+
+		mi, found := tk.MonitoringProviderKeeper.GetMonitoringInfo(ctx)
+		require.True(t, found)
+		require.True(t, mi.Transmitted)
+		require.Equal(t, types.MonitoringPacketStatus{
+			Status:      types.MonitoringPacketStatus_ACKNOWLEDGED,
+			BlockHeight: 10,
+		}, mi.LastPacketStatus)
+	})
+
+	t.Run("should record the error acknowledgement and report the signatures again", func(t *testing.T) {
+		tk.MonitoringProviderKeeper.SetMonitoringInfo(ctx, types.MonitoringInfo{
+			Transmitted:     true,
+			SignatureCounts: tc.SignatureCounts(1, tc.SignatureCount(t, opAddr, "0.5")),
+		})
+
+		err := tk.MonitoringProviderKeeper.OnAcknowledgementMonitoringPacket(
+			ctx,
+			channeltypes.Packet{},
+			data,
+			channeltypes.Acknowledgement{
+				Response: &channeltypes.Acknowledgement_Error{Error: "foo"},
+			},
+		)
+		require.NoError(t, err)
+
+		mi, found := tk.MonitoringProviderKeeper.GetMonitoringInfo(ctx)
+		require.True(t, found)
+		require.False(t, mi.Transmitted)
+		require.EqualValues(t, 3, mi.SignatureCounts.BlockCount)
+		require.True(t, tc.Dec(t, "1.5").Equal(mi.SignatureCounts.Counts[0].RelativeSignatures))
+		require.Equal(t, types.MonitoringPacketStatus{
+			Status:      types.MonitoringPacketStatus_FAILED,
+			BlockHeight: 10,
+			Error:       "foo",
+			Retries:     1,
+		}, mi.LastPacketStatus)
+	})
+
+	t.Run("should prevent acknowledging with an invalid acknowledgement", func(t *testing.T) {
+		err := tk.MonitoringProviderKeeper.OnAcknowledgementMonitoringPacket(
+			ctx,
+			channeltypes.Packet{},
+			data,
+			channeltypes.NewResultAcknowledgement([]byte("foo")),
+		)
+		require.Error(t, err)
+
+		err = tk.MonitoringProviderKeeper.OnAcknowledgementMonitoringPacket(
+			ctx,
+			channeltypes.Packet{},
+			data,
+			channeltypes.Acknowledgement{},
+		)
+		require.Error(t, err)
+	})
+}
+
+func Test_OnTimeoutMonitoringPacket(t *testing.T) {
+	var (
+		ctx, tk, _ = testkeeper.NewTestSetupWithMonitoringp(t)
+		opAddr     = sample.Address(r)
+		data       = spntypes.MonitoringPacket{
+			BlockHeight:     10,
+			SignatureCounts: tc.SignatureCounts(2, tc.SignatureCount(t, opAddr, "1")),
+		}
+	)
+	tk.MonitoringProviderKeeper.SetConnectionChannelID(ctx, types.ConnectionChannelID{ChannelID: "foo"})
+
+	err := tk.MonitoringProviderKeeper.OnTimeoutMonitoringPacket(ctx, channeltypes.Packet{}, data)
+	require.NoError(t, err)
+
+	// the closed channel is released
+	_, found := tk.MonitoringProviderKeeper.GetConnectionChannelID(ctx)
+	require.False(t, found)
+
+	mi, found := tk.MonitoringProviderKeeper.GetMonitoringInfo(ctx)
+	require.True(t, found)
+	require.False(t, mi.Transmitted)
+	require.EqualValues(t, data.SignatureCounts, mi.SignatureCounts)
+	require.Equal(t, types.MonitoringPacketStatus_TIMED_OUT, mi.LastPacketStatus.Status)
+	require.EqualValues(t, 10, mi.LastPacketStatus.BlockHeight)
+	require.EqualValues(t, 1, mi.LastPacketStatus.Retries)
+}
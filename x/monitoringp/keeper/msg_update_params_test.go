@@ -0,0 +1,90 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	spntypes "github.com/tendermint/spn/pkg/types"
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/monitoringp/types"
+)
+
+func TestMsgUpdateParams(t *testing.T) {
+	var (
+		sdkCtx, tk, ts = testkeeper.NewTestSetupWithMonitoringp(t)
+		ctx            = sdk.WrapSDKContext(sdkCtx)
+		authority      = tk.MonitoringProviderKeeper.GetAuthority()
+	)
+
+	t.Run("should prevent updating params from an account other than authority", func(t *testing.T) {
+		_, err := ts.MonitoringpSrv.UpdateParams(ctx, &types.MsgUpdateParams{
+			Authority: sample.Address(r),
+			Params:    sample.MonitoringpParams(r),
+		})
+		require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+	})
+
+	for _, tt := range []struct {
+		name   string
+		update func(*types.Params)
+	}{
+		{
+			name: "should prevent updating params with a zero last block height",
+			update: func(params *types.Params) {
+				params.LastBlockHeight = 0
+			},
+		},
+		{
+			name: "should prevent updating params with an invalid consumer chain ID",
+			update: func(params *types.Params) {
+				params.ConsumerChainID = "foo"
+			},
+		},
+		{
+			name: "should prevent updating params with a consumer unbonding period below the minimum",
+			update: func(params *types.Params) {
+				params.ConsumerUnbondingPeriod = spntypes.MinimalUnbondingPeriod - 1
+			},
+		},
+		{
+			name: "should prevent updating params with a zero consumer revision height",
+			update: func(params *types.Params) {
+				params.ConsumerRevisionHeight = 0
+			},
+		},
+		{
+			name: "should prevent updating params with a negative reward period",
+			update: func(params *types.Params) {
+				params.RewardPeriod = -1
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			current := tk.MonitoringProviderKeeper.GetParams(sdkCtx)
+			invalidParams := sample.MonitoringpParams(r)
+			tt.update(&invalidParams)
+
+			_, err := ts.MonitoringpSrv.UpdateParams(ctx, &types.MsgUpdateParams{
+				Authority: authority,
+				Params:    invalidParams,
+			})
+			require.ErrorIs(t, err, sdkerrortypes.ErrInvalidRequest)
+			require.EqualValues(t, current, tk.MonitoringProviderKeeper.GetParams(sdkCtx))
+		})
+	}
+
+	t.Run("should allow updating params from authority", func(t *testing.T) {
+		params := sample.MonitoringpParams(r)
+		_, err := ts.MonitoringpSrv.UpdateParams(ctx, &types.MsgUpdateParams{
+			Authority: authority,
+			Params:    params,
+		})
+		require.NoError(t, err)
+		require.EqualValues(t, params, tk.MonitoringProviderKeeper.GetParams(sdkCtx))
+	})
+}
@@ -37,6 +37,12 @@ func (k Keeper) ConsumerRevisionHeight(ctx sdk.Context) (res uint64) {
 	return
 }
 
+// RewardPeriod returns the reward period in blocks
+func (k Keeper) RewardPeriod(ctx sdk.Context) (res int64) {
+	k.paramstore.Get(ctx, types.KeyRewardPeriod, &res)
+	return
+}
+
 // GetParams get all parameters as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	return types.NewParams(
@@ -45,6 +51,7 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 		k.ConsumerConsensusState(ctx),
 		k.ConsumerUnbondingPeriod(ctx),
 		k.ConsumerRevisionHeight(ctx),
+		k.RewardPeriod(ctx),
 	)
 }
 
@@ -20,6 +20,7 @@ func TestGetParams(t *testing.T) {
 	require.EqualValues(t, types.DefaultConsumerChainID, tk.MonitoringProviderKeeper.ConsumerChainID(ctx))
 	require.EqualValues(t, spntypes.DefaultUnbondingPeriod, tk.MonitoringProviderKeeper.ConsumerUnbondingPeriod(ctx))
 	require.EqualValues(t, spntypes.DefaultRevisionHeight, tk.MonitoringProviderKeeper.ConsumerRevisionHeight(ctx))
+	require.EqualValues(t, types.DefaultRewardPeriod, tk.MonitoringProviderKeeper.RewardPeriod(ctx))
 
 	chainID := sample.GenesisChainID(r)
 	cs := sample.ConsensusState(0)
@@ -29,6 +30,7 @@ func TestGetParams(t *testing.T) {
 		cs,
 		10,
 		20,
+		100,
 	)
 	tk.MonitoringProviderKeeper.SetParams(ctx, params)
 	require.EqualValues(t, params, tk.MonitoringProviderKeeper.GetParams(ctx))
@@ -37,4 +39,5 @@ func TestGetParams(t *testing.T) {
 	require.EqualValues(t, chainID, tk.MonitoringProviderKeeper.ConsumerChainID(ctx))
 	require.EqualValues(t, 10, tk.MonitoringProviderKeeper.ConsumerUnbondingPeriod(ctx))
 	require.EqualValues(t, 20, tk.MonitoringProviderKeeper.ConsumerRevisionHeight(ctx))
+	require.EqualValues(t, 100, tk.MonitoringProviderKeeper.RewardPeriod(ctx))
 }
@@ -0,0 +1,17 @@
+package v3
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/tendermint/spn/x/monitoringp/types"
+)
+
+// MigrateStore performs in-place store migrations from v2 to v3 of the monitoringp module:
+//   - the reward period parameter is set to its default value
+func MigrateStore(ctx sdk.Context, paramSpace paramtypes.Subspace) error {
+	if !paramSpace.Has(ctx, types.KeyRewardPeriod) {
+		paramSpace.Set(ctx, types.KeyRewardPeriod, types.DefaultRewardPeriod)
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package v3_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+
+	v3 "github.com/tendermint/spn/x/monitoringp/migrations/v3"
+	"github.com/tendermint/spn/x/monitoringp/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	var (
+		cdc        = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+		paramsKey  = sdk.NewKVStoreKey(paramtypes.StoreKey)
+		paramsTKey = sdk.NewTransientStoreKey(paramtypes.TStoreKey)
+		ctx        = testutil.DefaultContext(paramsKey, paramsTKey)
+		paramSpace = paramtypes.NewSubspace(cdc, codec.NewLegacyAmino(), paramsKey, paramsTKey, types.ModuleName).
+				WithKeyTable(types.ParamKeyTable())
+	)
+
+	// v2 fixture: the reward period param is not set
+	require.False(t, paramSpace.Has(ctx, types.KeyRewardPeriod))
+
+	require.NoError(t, v3.MigrateStore(ctx, paramSpace))
+
+	var rewardPeriod int64
+	paramSpace.Get(ctx, types.KeyRewardPeriod, &rewardPeriod)
+	require.Equal(t, types.DefaultRewardPeriod, rewardPeriod)
+}
@@ -54,6 +54,9 @@ func (am AppModule) RandomizedParams(r *rand.Rand) []simtypes.ParamChange {
 		simulation.NewSimParamChange(types.ModuleName, string(types.KeyConsumerRevisionHeight), func(r *rand.Rand) string {
 			return string(types.Amino.MustMarshalJSON(monitoringpParams.ConsumerRevisionHeight))
 		}),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyRewardPeriod), func(r *rand.Rand) string {
+			return string(types.Amino.MustMarshalJSON(monitoringpParams.RewardPeriod))
+		}),
 	}
 }
 
@@ -51,6 +51,7 @@ func TestGenesisState_Validate(t *testing.T) {
 					sample.ConsensusState(0),
 					spntypes.DefaultUnbondingPeriod,
 					1,
+					types.DefaultRewardPeriod,
 				),
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
@@ -0,0 +1,6 @@
+package types
+
+// IsFailed returns true if the last monitoring packet failed to be delivered to the consumer chain
+func (m MonitoringPacketStatus) IsFailed() bool {
+	return m.Status == MonitoringPacketStatus_FAILED || m.Status == MonitoringPacketStatus_TIMED_OUT
+}
@@ -24,9 +24,44 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type MonitoringPacketStatus_Status int32
+
+const (
+	MonitoringPacketStatus_NONE         MonitoringPacketStatus_Status = 0
+	MonitoringPacketStatus_SENT         MonitoringPacketStatus_Status = 1
+	MonitoringPacketStatus_ACKNOWLEDGED MonitoringPacketStatus_Status = 2
+	MonitoringPacketStatus_FAILED       MonitoringPacketStatus_Status = 3
+	MonitoringPacketStatus_TIMED_OUT    MonitoringPacketStatus_Status = 4
+)
+
+var MonitoringPacketStatus_Status_name = map[int32]string{
+	0: "NONE",
+	1: "SENT",
+	2: "ACKNOWLEDGED",
+	3: "FAILED",
+	4: "TIMED_OUT",
+}
+
+var MonitoringPacketStatus_Status_value = map[string]int32{
+	"NONE":         0,
+	"SENT":         1,
+	"ACKNOWLEDGED": 2,
+	"FAILED":       3,
+	"TIMED_OUT":    4,
+}
+
+func (x MonitoringPacketStatus_Status) String() string {
+	return proto.EnumName(MonitoringPacketStatus_Status_name, int32(x))
+}
+
+func (MonitoringPacketStatus_Status) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8dc3781ef8b7f8cf, []int{1, 0}
+}
+
 type MonitoringInfo struct {
-	Transmitted     bool                  `protobuf:"varint,1,opt,name=transmitted,proto3" json:"transmitted,omitempty"`
-	SignatureCounts types.SignatureCounts `protobuf:"bytes,2,opt,name=signatureCounts,proto3" json:"signatureCounts"`
+	Transmitted      bool                   `protobuf:"varint,1,opt,name=transmitted,proto3" json:"transmitted,omitempty"`
+	SignatureCounts  types.SignatureCounts  `protobuf:"bytes,2,opt,name=signatureCounts,proto3" json:"signatureCounts"`
+	LastPacketStatus MonitoringPacketStatus `protobuf:"bytes,3,opt,name=lastPacketStatus,proto3" json:"lastPacketStatus"`
 }
 
 func (m *MonitoringInfo) Reset()         { *m = MonitoringInfo{} }
@@ -76,29 +111,118 @@ func (m *MonitoringInfo) GetSignatureCounts() types.SignatureCounts {
 	return types.SignatureCounts{}
 }
 
+func (m *MonitoringInfo) GetLastPacketStatus() MonitoringPacketStatus {
+	if m != nil {
+		return m.LastPacketStatus
+	}
+	return MonitoringPacketStatus{}
+}
+
+// MonitoringPacketStatus is the status of the last monitoring packet transmitted to the consumer chain
+type MonitoringPacketStatus struct {
+	Status      MonitoringPacketStatus_Status `protobuf:"varint,1,opt,name=status,proto3,enum=tendermint.spn.monitoringp.MonitoringPacketStatus_Status" json:"status,omitempty"`
+	BlockHeight int64                         `protobuf:"varint,2,opt,name=blockHeight,proto3" json:"blockHeight,omitempty"`
+	Error       string                        `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	Retries     uint64                        `protobuf:"varint,4,opt,name=retries,proto3" json:"retries,omitempty"`
+}
+
+func (m *MonitoringPacketStatus) Reset()         { *m = MonitoringPacketStatus{} }
+func (m *MonitoringPacketStatus) String() string { return proto.CompactTextString(m) }
+func (*MonitoringPacketStatus) ProtoMessage()    {}
+func (*MonitoringPacketStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8dc3781ef8b7f8cf, []int{1}
+}
+func (m *MonitoringPacketStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MonitoringPacketStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MonitoringPacketStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MonitoringPacketStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MonitoringPacketStatus.Merge(m, src)
+}
+func (m *MonitoringPacketStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *MonitoringPacketStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_MonitoringPacketStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MonitoringPacketStatus proto.InternalMessageInfo
+
+func (m *MonitoringPacketStatus) GetStatus() MonitoringPacketStatus_Status {
+	if m != nil {
+		return m.Status
+	}
+	return MonitoringPacketStatus_NONE
+}
+
+func (m *MonitoringPacketStatus) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *MonitoringPacketStatus) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *MonitoringPacketStatus) GetRetries() uint64 {
+	if m != nil {
+		return m.Retries
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("tendermint.spn.monitoringp.MonitoringPacketStatus_Status", MonitoringPacketStatus_Status_name, MonitoringPacketStatus_Status_value)
 	proto.RegisterType((*MonitoringInfo)(nil), "tendermint.spn.monitoringp.MonitoringInfo")
+	proto.RegisterType((*MonitoringPacketStatus)(nil), "tendermint.spn.monitoringp.MonitoringPacketStatus")
 }
 
 func init() { proto.RegisterFile("monitoringp/monitoring_info.proto", fileDescriptor_8dc3781ef8b7f8cf) }
 
 var fileDescriptor_8dc3781ef8b7f8cf = []byte{
-	// 235 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcc, 0xcd, 0xcf, 0xcb,
-	0x2c, 0xc9, 0x2f, 0xca, 0xcc, 0x4b, 0x2f, 0xd0, 0x47, 0xb0, 0xe3, 0x33, 0xf3, 0xd2, 0xf2, 0xf5,
-	0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0xa4, 0x4a, 0x52, 0xf3, 0x52, 0x52, 0x8b, 0x72, 0x33, 0xf3,
-	0x4a, 0xf4, 0x8a, 0x0b, 0xf2, 0xf4, 0x90, 0x74, 0x48, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x95,
-	0xe9, 0x83, 0x58, 0x10, 0x1d, 0x52, 0x62, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x48, 0xc6, 0x41, 0xc4,
-	0x95, 0x3a, 0x19, 0xb9, 0xf8, 0x7c, 0xe1, 0x82, 0x9e, 0x79, 0x69, 0xf9, 0x42, 0x0a, 0x5c, 0xdc,
-	0x25, 0x45, 0x89, 0x79, 0xc5, 0xb9, 0x99, 0x25, 0x25, 0xa9, 0x29, 0x12, 0x8c, 0x0a, 0x8c, 0x1a,
-	0x1c, 0x41, 0xc8, 0x42, 0x42, 0xa1, 0x5c, 0xfc, 0xc5, 0x99, 0xe9, 0x79, 0x89, 0x25, 0xa5, 0x45,
-	0xa9, 0xce, 0xf9, 0xa5, 0x79, 0x25, 0xc5, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0xaa, 0x7a,
-	0x68, 0x0e, 0x03, 0xdb, 0xaa, 0x17, 0x8c, 0xaa, 0xd8, 0x89, 0xe5, 0xc4, 0x3d, 0x79, 0x86, 0x20,
-	0x74, 0x33, 0x9c, 0xdc, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39,
-	0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x37,
-	0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0x61, 0x83, 0x7e, 0x71, 0x41,
-	0x9e, 0x7e, 0x85, 0x3e, 0x72, 0x70, 0x81, 0xed, 0x4b, 0x62, 0x03, 0xfb, 0xcd, 0x18, 0x10, 0x00,
-	0x00, 0xff, 0xff, 0x39, 0x54, 0xd6, 0xef, 0x4a, 0x01, 0x00, 0x00,
+	// 415 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0xf5, 0x26, 0xc6, 0xb4, 0x5b, 0x28, 0xd6, 0xaa, 0xaa, 0xac, 0x1c, 0x8c, 0x89, 0x84, 0x94,
+	0x0b, 0x6b, 0x29, 0x9c, 0x38, 0xb6, 0xb5, 0x29, 0x16, 0xad, 0x03, 0x4e, 0x2a, 0x24, 0x2e, 0x95,
+	0x93, 0x6c, 0xdd, 0x55, 0xeb, 0x5d, 0x6b, 0x77, 0x2c, 0xc1, 0x1f, 0x70, 0xe4, 0xb3, 0x7a, 0xcc,
+	0x91, 0x13, 0x42, 0xc9, 0x07, 0xf0, 0x0b, 0xc8, 0x76, 0x20, 0x26, 0xc0, 0x81, 0xd3, 0xce, 0x3c,
+	0xcd, 0x7b, 0x6f, 0x66, 0x76, 0xf0, 0x93, 0x5c, 0x0a, 0x0e, 0x52, 0x71, 0x91, 0x15, 0xfe, 0x26,
+	0xbe, 0xe4, 0xe2, 0x4a, 0xd2, 0x42, 0x49, 0x90, 0xa4, 0x07, 0x4c, 0xcc, 0x99, 0xca, 0xb9, 0x00,
+	0xaa, 0x0b, 0x41, 0x5b, 0x8c, 0xde, 0x41, 0x26, 0x33, 0x59, 0x97, 0xf9, 0x55, 0xd4, 0x30, 0x7a,
+	0x87, 0xf0, 0xb1, 0x60, 0xba, 0x25, 0xd7, 0xe0, 0xfd, 0xef, 0x08, 0xef, 0x9f, 0xff, 0x02, 0x23,
+	0x71, 0x25, 0x89, 0x87, 0xf7, 0x40, 0xa5, 0x42, 0xe7, 0x1c, 0x80, 0xcd, 0x1d, 0xe4, 0xa1, 0xc1,
+	0x4e, 0xd2, 0x86, 0xc8, 0x05, 0x7e, 0xa4, 0x79, 0x26, 0x52, 0x28, 0x15, 0x3b, 0x91, 0xa5, 0x00,
+	0xed, 0x74, 0x3c, 0x34, 0xd8, 0x1b, 0x3e, 0xa5, 0x5b, 0x8d, 0xd5, 0xae, 0x74, 0xfc, 0x7b, 0xf1,
+	0xb1, 0x79, 0xf7, 0xf5, 0xb1, 0x91, 0x6c, 0x6b, 0x90, 0x39, 0xb6, 0x6f, 0x53, 0x0d, 0x6f, 0xd2,
+	0xd9, 0x0d, 0x83, 0x31, 0xa4, 0x50, 0x6a, 0xa7, 0x5b, 0xeb, 0x0e, 0xe9, 0xbf, 0x07, 0xa6, 0x9b,
+	0xf6, 0xdb, 0xcc, 0xb5, 0xc9, 0x1f, 0x8a, 0xfd, 0x4f, 0x1d, 0x7c, 0xf8, 0x77, 0x0a, 0x79, 0x8b,
+	0x2d, 0xdd, 0xd8, 0x56, 0x43, 0xef, 0x0f, 0x5f, 0xfc, 0xbf, 0x2d, 0x6d, 0x9e, 0x64, 0x2d, 0x54,
+	0x2d, 0x73, 0x7a, 0x2b, 0x67, 0x37, 0xaf, 0x18, 0xcf, 0xae, 0xa1, 0x5e, 0x53, 0x37, 0x69, 0x43,
+	0xe4, 0x00, 0xdf, 0x63, 0x4a, 0x49, 0x55, 0x8f, 0xba, 0x9b, 0x34, 0x09, 0x71, 0xf0, 0x7d, 0xc5,
+	0x40, 0x71, 0xa6, 0x1d, 0xd3, 0x43, 0x03, 0x33, 0xf9, 0x99, 0xf6, 0x23, 0x6c, 0xad, 0xdb, 0xdd,
+	0xc1, 0x66, 0x3c, 0x8a, 0x43, 0xdb, 0xa8, 0xa2, 0x71, 0x18, 0x4f, 0x6c, 0x44, 0x6c, 0xfc, 0xe0,
+	0xe8, 0xe4, 0x75, 0x3c, 0x7a, 0x77, 0x16, 0x06, 0xa7, 0x61, 0x60, 0x77, 0x08, 0xc6, 0xd6, 0xcb,
+	0xa3, 0xe8, 0x2c, 0x0c, 0xec, 0x2e, 0x79, 0x88, 0x77, 0x27, 0xd1, 0x79, 0x18, 0x5c, 0x8e, 0x2e,
+	0x26, 0xb6, 0x79, 0x7c, 0x7a, 0xb7, 0x74, 0xd1, 0x62, 0xe9, 0xa2, 0x6f, 0x4b, 0x17, 0x7d, 0x5e,
+	0xb9, 0xc6, 0x62, 0xe5, 0x1a, 0x5f, 0x56, 0xae, 0xf1, 0xfe, 0x59, 0xc6, 0xe1, 0xba, 0x9c, 0xd2,
+	0x99, 0xcc, 0xfd, 0xcd, 0x0e, 0x7c, 0x5d, 0x08, 0xff, 0x83, 0xdf, 0xbe, 0xcf, 0xfa, 0x83, 0xa7,
+	0x56, 0x7d, 0x4c, 0xcf, 0x7f, 0x0c, 0x00, 0x5b, 0xc3, 0xd4, 0x46, 0xbb, 0x02, 0x00, 0x00,
 }
 
 func (m *MonitoringInfo) Marshal() (dAtA []byte, err error) {
@@ -121,6 +245,16 @@ func (m *MonitoringInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.LastPacketStatus.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMonitoringInfo(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size, err := m.SignatureCounts.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -144,6 +278,51 @@ func (m *MonitoringInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MonitoringPacketStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MonitoringPacketStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MonitoringPacketStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Retries != 0 {
+		i = encodeVarintMonitoringInfo(dAtA, i, uint64(m.Retries))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintMonitoringInfo(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintMonitoringInfo(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Status != 0 {
+		i = encodeVarintMonitoringInfo(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMonitoringInfo(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMonitoringInfo(v)
 	base := offset
@@ -166,6 +345,30 @@ func (m *MonitoringInfo) Size() (n int) {
 	}
 	l = m.SignatureCounts.Size()
 	n += 1 + l + sovMonitoringInfo(uint64(l))
+	l = m.LastPacketStatus.Size()
+	n += 1 + l + sovMonitoringInfo(uint64(l))
+	return n
+}
+
+func (m *MonitoringPacketStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovMonitoringInfo(uint64(m.Status))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovMonitoringInfo(uint64(m.BlockHeight))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovMonitoringInfo(uint64(l))
+	}
+	if m.Retries != 0 {
+		n += 1 + sovMonitoringInfo(uint64(m.Retries))
+	}
 	return n
 }
 
@@ -257,6 +460,178 @@ func (m *MonitoringInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPacketStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMonitoringInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMonitoringInfo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMonitoringInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastPacketStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMonitoringInfo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMonitoringInfo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MonitoringPacketStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMonitoringInfo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MonitoringPacketStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MonitoringPacketStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMonitoringInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= MonitoringPacketStatus_Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMonitoringInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMonitoringInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMonitoringInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMonitoringInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
+			}
+			m.Retries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMonitoringInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Retries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMonitoringInfo(dAtA[iNdEx:])
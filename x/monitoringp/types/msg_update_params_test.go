@@ -0,0 +1,56 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkerrortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/monitoringp/types"
+)
+
+func TestMsgUpdateParams_ValidateBasic(t *testing.T) {
+	invalidParams := sample.MonitoringpParams(r)
+	invalidParams.LastBlockHeight = 0
+
+	tests := []struct {
+		name string
+		msg  types.MsgUpdateParams
+		err  error
+	}{
+		{
+			name: "should prevent validate message with invalid authority address",
+			msg: types.MsgUpdateParams{
+				Authority: "invalid_address",
+				Params:    sample.MonitoringpParams(r),
+			},
+			err: sdkerrortypes.ErrInvalidAddress,
+		},
+		{
+			name: "should prevent validate message with invalid params",
+			msg: types.MsgUpdateParams{
+				Authority: sample.Address(r),
+				Params:    invalidParams,
+			},
+			err: sdkerrortypes.ErrInvalidRequest,
+		},
+		{
+			name: "should validate valid message",
+			msg: types.MsgUpdateParams{
+				Authority: sample.Address(r),
+				Params:    sample.MonitoringpParams(r),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -17,9 +17,14 @@ var (
 	KeyConsumerChainID         = []byte("ConsumerChainID")
 	KeyConsumerUnbondingPeriod = []byte("ConsumerUnbondingPeriod")
 	KeyConsumerRevisionHeight  = []byte("RevisionHeight")
+	KeyRewardPeriod            = []byte("RewardPeriod")
 
 	DefaultLastBlockHeight int64 = 1
 	DefaultConsumerChainID       = "spn-1"
+
+	// DefaultRewardPeriod is the default reward period
+	// A reward period of 0 means the signatures are transmitted once at the last block height
+	DefaultRewardPeriod int64 = 0
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -36,6 +41,7 @@ func NewParams(
 	ccs spntypes.ConsensusState,
 	consumerUnbondingpPeriod int64,
 	consumerRevisionHeight uint64,
+	rewardPeriod int64,
 ) Params {
 	return Params{
 		LastBlockHeight:         lastBlockHeight,
@@ -43,6 +49,7 @@ func NewParams(
 		ConsumerChainID:         consumerChainID,
 		ConsumerUnbondingPeriod: consumerUnbondingpPeriod,
 		ConsumerRevisionHeight:  consumerRevisionHeight,
+		RewardPeriod:            rewardPeriod,
 	}
 }
 
@@ -54,6 +61,7 @@ func DefaultParams() Params {
 		spntypes.ConsensusState{},
 		spntypes.DefaultUnbondingPeriod,
 		spntypes.DefaultRevisionHeight,
+		DefaultRewardPeriod,
 	)
 }
 
@@ -85,6 +93,11 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 			&p.ConsumerRevisionHeight,
 			validateConsumerRevisionHeight,
 		),
+		paramtypes.NewParamSetPair(
+			KeyRewardPeriod,
+			&p.RewardPeriod,
+			validateRewardPeriod,
+		),
 	}
 }
 
@@ -102,7 +115,10 @@ func (p Params) Validate() error {
 	if err := validateConsumerUnbondingPeriod(p.ConsumerUnbondingPeriod); err != nil {
 		return err
 	}
-	return validateConsumerRevisionHeight(p.ConsumerRevisionHeight)
+	if err := validateConsumerRevisionHeight(p.ConsumerRevisionHeight); err != nil {
+		return err
+	}
+	return validateRewardPeriod(p.RewardPeriod)
 }
 
 // String implements the Stringer interface.
@@ -187,3 +203,17 @@ func validateConsumerRevisionHeight(i interface{}) error {
 
 	return nil
 }
+
+// validateRewardPeriod validates reward period
+func validateRewardPeriod(i interface{}) error {
+	rewardPeriod, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if rewardPeriod < 0 {
+		return errors.New("reward period can't be negative")
+	}
+
+	return nil
+}
@@ -31,6 +31,7 @@ type Params struct {
 	ConsumerConsensusState  types.ConsensusState `protobuf:"bytes,3,opt,name=consumerConsensusState,proto3" json:"consumerConsensusState"`
 	ConsumerUnbondingPeriod int64                `protobuf:"varint,4,opt,name=consumerUnbondingPeriod,proto3" json:"consumerUnbondingPeriod,omitempty"`
 	ConsumerRevisionHeight  uint64               `protobuf:"varint,5,opt,name=consumerRevisionHeight,proto3" json:"consumerRevisionHeight,omitempty"`
+	RewardPeriod            int64                `protobuf:"varint,6,opt,name=rewardPeriod,proto3" json:"rewardPeriod,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -100,6 +101,13 @@ func (m *Params) GetConsumerRevisionHeight() uint64 {
 	return 0
 }
 
+func (m *Params) GetRewardPeriod() int64 {
+	if m != nil {
+		return m.RewardPeriod
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "tendermint.spn.monitoringp.Params")
 }
@@ -107,27 +115,28 @@ func init() {
 func init() { proto.RegisterFile("monitoringp/params.proto", fileDescriptor_14b90118a5ef3574) }
 
 var fileDescriptor_14b90118a5ef3574 = []byte{
-	// 314 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x31, 0x4b, 0xc3, 0x40,
-	0x1c, 0xc5, 0x73, 0x6d, 0x2c, 0x18, 0x87, 0x42, 0x10, 0x0d, 0x1d, 0xd2, 0x20, 0x0e, 0x59, 0xbc,
-	0x03, 0x05, 0x11, 0xc7, 0x2a, 0xa8, 0x5b, 0x89, 0xb8, 0xb8, 0x25, 0xe9, 0x71, 0x3d, 0x6c, 0xfe,
-	0xff, 0xe3, 0xee, 0x2a, 0xfa, 0x2d, 0x1c, 0x1d, 0xfd, 0x18, 0x7e, 0x84, 0x8e, 0x1d, 0x9d, 0x44,
-	0xda, 0x2f, 0x22, 0xbd, 0xaa, 0xad, 0x85, 0x6c, 0xc7, 0x7b, 0xef, 0xfe, 0xbc, 0x1f, 0x2f, 0x88,
-	0x2a, 0x04, 0x69, 0x51, 0x4b, 0x10, 0x8a, 0xa9, 0x5c, 0xe7, 0x95, 0xa1, 0x4a, 0xa3, 0xc5, 0xb0,
-	0x63, 0x39, 0x0c, 0xb8, 0xae, 0x24, 0x58, 0x6a, 0x14, 0xd0, 0xb5, 0x60, 0x67, 0x57, 0xa0, 0x40,
-	0x17, 0x63, 0x8b, 0xd7, 0xf2, 0x47, 0xa7, 0x6d, 0x9f, 0x15, 0x37, 0x4c, 0x16, 0xe5, 0x52, 0x38,
-	0x78, 0x6f, 0x04, 0xad, 0xbe, 0xbb, 0x19, 0xa6, 0x41, 0x7b, 0x94, 0x1b, 0xdb, 0x1b, 0x61, 0xf9,
-	0x70, 0xcd, 0xa5, 0x18, 0xda, 0x88, 0x24, 0x24, 0x6d, 0x66, 0x9b, 0xf2, 0x22, 0x59, 0x22, 0x98,
-	0x71, 0xc5, 0xf5, 0xc5, 0x30, 0x97, 0x70, 0x73, 0x19, 0x35, 0x12, 0x92, 0x6e, 0x67, 0x9b, 0x72,
-	0x58, 0x04, 0x7b, 0x7f, 0x12, 0x82, 0xe1, 0x60, 0xc6, 0xe6, 0xd6, 0xe6, 0x96, 0x47, 0xcd, 0x84,
-	0xa4, 0x3b, 0xc7, 0x87, 0x74, 0x03, 0xc1, 0xf5, 0xa3, 0xff, 0xb3, 0x3d, 0x7f, 0xf2, 0xd9, 0xf5,
-	0xb2, 0x9a, 0x4b, 0xe1, 0x59, 0xb0, 0xff, 0xeb, 0xdc, 0x41, 0x81, 0x30, 0x90, 0x20, 0xfa, 0x5c,
-	0x4b, 0x1c, 0x44, 0xbe, 0xeb, 0x5f, 0x67, 0x87, 0xa7, 0xab, 0x76, 0x19, 0x7f, 0x94, 0x46, 0x22,
-	0xfc, 0x80, 0x6f, 0x25, 0x24, 0xf5, 0xb3, 0x1a, 0xf7, 0xdc, 0x7f, 0x7d, 0xeb, 0x7a, 0xbd, 0xab,
-	0xc9, 0x2c, 0x26, 0xd3, 0x59, 0x4c, 0xbe, 0x66, 0x31, 0x79, 0x99, 0xc7, 0xde, 0x74, 0x1e, 0x7b,
-	0x1f, 0xf3, 0xd8, 0xbb, 0x3f, 0x12, 0xd2, 0x0e, 0xc7, 0x05, 0x2d, 0xb1, 0x62, 0x2b, 0x3e, 0x66,
-	0x14, 0xb0, 0x27, 0xb6, 0xbe, 0xa6, 0xa3, 0x2d, 0x5a, 0x6e, 0x8a, 0x93, 0xef, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0xab, 0xe8, 0xcf, 0x71, 0xe9, 0x01, 0x00, 0x00,
+	// 327 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x3d, 0x4b, 0x3b, 0x41,
+	0x10, 0xc6, 0x6f, 0x93, 0xfb, 0x07, 0xfe, 0xa7, 0x10, 0x38, 0x44, 0x8f, 0x14, 0x97, 0x23, 0x58,
+	0x5c, 0xe3, 0x2d, 0x28, 0x88, 0x58, 0x46, 0x41, 0xed, 0xc2, 0x89, 0x8d, 0xdd, 0xbd, 0x2c, 0x9b,
+	0xc5, 0xdc, 0xcc, 0xb2, 0xbb, 0xf1, 0xe5, 0x5b, 0x58, 0x5a, 0xfa, 0x71, 0x52, 0xa6, 0xb4, 0x10,
+	0x91, 0xe4, 0x8b, 0x48, 0x36, 0xd1, 0xbc, 0x40, 0xba, 0xe5, 0xf7, 0x3c, 0x3b, 0xf3, 0xcc, 0x8c,
+	0x17, 0x54, 0x08, 0xc2, 0xa0, 0x12, 0xc0, 0x25, 0x95, 0x99, 0xca, 0x2a, 0x9d, 0x48, 0x85, 0x06,
+	0xfd, 0x96, 0x61, 0x50, 0x32, 0x55, 0x09, 0x30, 0x89, 0x96, 0x90, 0xac, 0x18, 0x5b, 0x7b, 0x1c,
+	0x39, 0x5a, 0x1b, 0x9d, 0xbd, 0xe6, 0x3f, 0x5a, 0x4d, 0xf3, 0x22, 0x99, 0xa6, 0x22, 0x2f, 0xe6,
+	0xa0, 0xf3, 0x59, 0xf3, 0x1a, 0x3d, 0x5b, 0xd3, 0x8f, 0xbd, 0xe6, 0x20, 0xd3, 0xa6, 0x3b, 0xc0,
+	0xe2, 0xe1, 0x9a, 0x09, 0xde, 0x37, 0x01, 0x89, 0x48, 0x5c, 0x4f, 0x37, 0xf1, 0xcc, 0x59, 0x20,
+	0xe8, 0x61, 0xc5, 0xd4, 0x45, 0x3f, 0x13, 0x70, 0x73, 0x19, 0xd4, 0x22, 0x12, 0xff, 0x4f, 0x37,
+	0xb1, 0x9f, 0x7b, 0xfb, 0x7f, 0x08, 0x41, 0x33, 0xd0, 0x43, 0x7d, 0x6b, 0x32, 0xc3, 0x82, 0x7a,
+	0x44, 0xe2, 0x9d, 0xe3, 0xc3, 0x64, 0x63, 0x04, 0x9b, 0x2f, 0x59, 0xf7, 0x76, 0xdd, 0xd1, 0x57,
+	0xdb, 0x49, 0xb7, 0x54, 0xf2, 0xcf, 0xbc, 0x83, 0x5f, 0xe5, 0x0e, 0x72, 0x84, 0x52, 0x00, 0xef,
+	0x31, 0x25, 0xb0, 0x0c, 0x5c, 0x9b, 0x7f, 0x9b, 0xec, 0x9f, 0x2e, 0xd3, 0xa5, 0xec, 0x51, 0x68,
+	0x81, 0xb0, 0x18, 0xfc, 0x5f, 0x44, 0x62, 0x37, 0xdd, 0xa2, 0xfa, 0x1d, 0x6f, 0x57, 0xb1, 0xa7,
+	0x4c, 0x95, 0x8b, 0x36, 0x0d, 0xdb, 0x66, 0x8d, 0x9d, 0xbb, 0x6f, 0xef, 0x6d, 0xa7, 0x7b, 0x35,
+	0x9a, 0x84, 0x64, 0x3c, 0x09, 0xc9, 0xf7, 0x24, 0x24, 0xaf, 0xd3, 0xd0, 0x19, 0x4f, 0x43, 0xe7,
+	0x63, 0x1a, 0x3a, 0xf7, 0x47, 0x5c, 0x98, 0xfe, 0x30, 0x4f, 0x0a, 0xac, 0xe8, 0x72, 0x07, 0x54,
+	0x4b, 0xa0, 0xcf, 0x74, 0xf5, 0xe2, 0x76, 0x23, 0x79, 0xc3, 0x9e, 0xeb, 0xe4, 0x67, 0x00, 0xbf,
+	0xa4, 0xce, 0x0d, 0x0d, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -150,6 +159,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RewardPeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RewardPeriod))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.ConsumerRevisionHeight != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.ConsumerRevisionHeight))
 		i--
@@ -217,6 +231,9 @@ func (m *Params) Size() (n int) {
 	if m.ConsumerRevisionHeight != 0 {
 		n += 1 + sovParams(uint64(m.ConsumerRevisionHeight))
 	}
+	if m.RewardPeriod != 0 {
+		n += 1 + sovParams(uint64(m.RewardPeriod))
+	}
 	return n
 }
 
@@ -377,6 +394,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPeriod", wireType)
+			}
+			m.RewardPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardPeriod |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
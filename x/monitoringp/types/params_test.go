@@ -97,6 +97,18 @@ func TestParamsValidate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "should prevent invalid reward period",
+			params: Params{
+				LastBlockHeight:         1000,
+				ConsumerChainID:         chainID,
+				ConsumerConsensusState:  consensusState,
+				ConsumerUnbondingPeriod: spntypes.DefaultUnbondingPeriod,
+				ConsumerRevisionHeight:  spntypes.DefaultRevisionHeight,
+				RewardPeriod:            -1,
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -145,3 +157,10 @@ func TestValidateConsumerRevisionHeight(t *testing.T) {
 	require.Error(t, validateConsumerRevisionHeight(uint64(0)), "should prevent using 0")
 	require.NoError(t, validateConsumerRevisionHeight(uint64(1)))
 }
+
+func TestValidateRewardPeriod(t *testing.T) {
+	require.Error(t, validateRewardPeriod("foo"), "should expect a int64")
+	require.Error(t, validateRewardPeriod(int64(-1)), "should prevent negative value")
+	require.NoError(t, validateRewardPeriod(int64(0)))
+	require.NoError(t, validateRewardPeriod(int64(100)))
+}
@@ -18,6 +18,7 @@ type (
 		storeKey          storetypes.StoreKey
 		memKey            storetypes.StoreKey
 		paramstore        paramtypes.Subspace
+		authority         string
 		fundraisingKeeper types.FundraisingKeeper
 		stakingKeeper     types.StakingKeeper
 	}
@@ -30,6 +31,7 @@ func NewKeeper(
 	ps paramtypes.Subspace,
 	fundraisingKeeper types.FundraisingKeeper,
 	stakingKeeper types.StakingKeeper,
+	authority string,
 ) *Keeper {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
@@ -41,6 +43,7 @@ func NewKeeper(
 		storeKey:          storeKey,
 		memKey:            memKey,
 		paramstore:        ps,
+		authority:         authority,
 		fundraisingKeeper: fundraisingKeeper,
 		stakingKeeper:     stakingKeeper,
 	}
@@ -49,3 +52,8 @@ func NewKeeper(
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
+
+// GetAuthority returns the address allowed to update the module parameters
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
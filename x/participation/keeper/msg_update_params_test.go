@@ -0,0 +1,90 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/participation/types"
+)
+
+func TestMsgUpdateParams(t *testing.T) {
+	var (
+		sdkCtx, tk, ts = testkeeper.NewTestSetup(t)
+		ctx            = sdk.WrapSDKContext(sdkCtx)
+		authority      = tk.ParticipationKeeper.GetAuthority()
+	)
+
+	t.Run("should prevent updating params from an account other than authority", func(t *testing.T) {
+		_, err := ts.ParticipationSrv.UpdateParams(ctx, &types.MsgUpdateParams{
+			Authority: sample.Address(r),
+			Params:    sample.ParticipationParams(r),
+		})
+		require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+	})
+
+	for _, tt := range []struct {
+		name   string
+		update func(*types.Params)
+	}{
+		{
+			name: "should prevent updating params with a zero allocation price",
+			update: func(params *types.Params) {
+				params.AllocationPrice = types.AllocationPrice{Bonded: sdkmath.ZeroInt()}
+			},
+		},
+		{
+			name: "should prevent updating params with duplicated tier IDs",
+			update: func(params *types.Params) {
+				params.ParticipationTierList = append(params.ParticipationTierList, params.ParticipationTierList[0])
+			},
+		},
+		{
+			name: "should prevent updating params with a tier without required allocations",
+			update: func(params *types.Params) {
+				params.ParticipationTierList[0].RequiredAllocations = sdkmath.ZeroInt()
+			},
+		},
+		{
+			name: "should prevent updating params with a zero registration period",
+			update: func(params *types.Params) {
+				params.RegistrationPeriod = 0
+			},
+		},
+		{
+			name: "should prevent updating params with a zero withdrawal delay",
+			update: func(params *types.Params) {
+				params.WithdrawalDelay = 0
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			current := tk.ParticipationKeeper.GetParams(sdkCtx)
+			invalidParams := sample.ParticipationParams(r)
+			tt.update(&invalidParams)
+
+			_, err := ts.ParticipationSrv.UpdateParams(ctx, &types.MsgUpdateParams{
+				Authority: authority,
+				Params:    invalidParams,
+			})
+			require.ErrorIs(t, err, sdkerrortypes.ErrInvalidRequest)
+			require.EqualValues(t, current, tk.ParticipationKeeper.GetParams(sdkCtx))
+		})
+	}
+
+	t.Run("should allow updating params from authority", func(t *testing.T) {
+		params := sample.ParticipationParams(r)
+		_, err := ts.ParticipationSrv.UpdateParams(ctx, &types.MsgUpdateParams{
+			Authority: authority,
+			Params:    params,
+		})
+		require.NoError(t, err)
+		require.EqualValues(t, params, tk.ParticipationKeeper.GetParams(sdkCtx))
+	})
+}
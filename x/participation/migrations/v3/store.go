@@ -0,0 +1,11 @@
+package v3
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MigrateStore performs in-place store migrations from v2 to v3 of the participation module
+// v3 only adds the governance message to update the params, the params are stored as in v2
+func MigrateStore(_ sdk.Context) error {
+	return nil
+}
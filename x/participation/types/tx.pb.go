@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
 	grpc "google.golang.org/grpc"
@@ -211,35 +213,136 @@ func (m *MsgWithdrawAllocationsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgWithdrawAllocationsResponse proto.InternalMessageInfo
 
+type MsgUpdateParams struct {
+	// authority is the address of the governance account
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the parameters to update, all the parameters must be provided
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ba05d42ce1a8b62, []int{4}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ba05d42ce1a8b62, []int{5}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgParticipate)(nil), "tendermint.spn.participation.MsgParticipate")
 	proto.RegisterType((*MsgParticipateResponse)(nil), "tendermint.spn.participation.MsgParticipateResponse")
 	proto.RegisterType((*MsgWithdrawAllocations)(nil), "tendermint.spn.participation.MsgWithdrawAllocations")
 	proto.RegisterType((*MsgWithdrawAllocationsResponse)(nil), "tendermint.spn.participation.MsgWithdrawAllocationsResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "tendermint.spn.participation.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "tendermint.spn.participation.MsgUpdateParamsResponse")
 }
 
 func init() { proto.RegisterFile("participation/tx.proto", fileDescriptor_1ba05d42ce1a8b62) }
 
 var fileDescriptor_1ba05d42ce1a8b62 = []byte{
-	// 286 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x2b, 0x48, 0x2c, 0x2a,
-	0xc9, 0x4c, 0xce, 0x2c, 0x48, 0x2c, 0xc9, 0xcc, 0xcf, 0xd3, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca,
-	0x2f, 0xc9, 0x17, 0x92, 0x29, 0x49, 0xcd, 0x4b, 0x49, 0x2d, 0xca, 0xcd, 0xcc, 0x2b, 0xd1, 0x2b,
-	0x2e, 0xc8, 0xd3, 0x43, 0x51, 0xa6, 0x94, 0xc1, 0xc5, 0xe7, 0x5b, 0x9c, 0x1e, 0x00, 0x17, 0x4b,
-	0x15, 0x52, 0xe0, 0xe2, 0x86, 0x2b, 0xc9, 0x2b, 0x91, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x0c, 0x42,
-	0x16, 0x12, 0x92, 0xe1, 0xe2, 0x4c, 0x2c, 0x4d, 0x06, 0x69, 0xf7, 0x74, 0x91, 0x60, 0x52, 0x60,
-	0xd4, 0x60, 0x09, 0x42, 0x08, 0x08, 0x89, 0x71, 0xb1, 0x95, 0x64, 0xa6, 0x16, 0x79, 0xba, 0x48,
-	0x30, 0x83, 0xa5, 0xa0, 0x3c, 0x25, 0x09, 0x2e, 0x31, 0x54, 0x9b, 0x82, 0x52, 0x8b, 0x0b, 0xf2,
-	0xf3, 0x8a, 0x53, 0x95, 0x22, 0xc0, 0x32, 0xe1, 0x99, 0x25, 0x19, 0x29, 0x45, 0x89, 0xe5, 0x8e,
-	0x39, 0x39, 0xf9, 0xc9, 0x60, 0xc7, 0x15, 0x53, 0xea, 0x16, 0x25, 0x05, 0x2e, 0x39, 0xec, 0x26,
-	0xc3, 0xec, 0x36, 0x6a, 0x61, 0xe2, 0x62, 0xf6, 0x2d, 0x4e, 0x17, 0x2a, 0xe4, 0xe2, 0x46, 0x0e,
-	0x04, 0x1d, 0x3d, 0x7c, 0xa1, 0xa6, 0x87, 0xea, 0x11, 0x29, 0x13, 0x52, 0x54, 0xc3, 0xac, 0x16,
-	0xea, 0x64, 0xe4, 0x12, 0xc6, 0xe6, 0x69, 0xc2, 0xa6, 0x61, 0xd1, 0x25, 0x65, 0x43, 0x8e, 0x2e,
-	0x98, 0x5b, 0x9c, 0x3c, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39,
-	0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x3f,
-	0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0x61, 0x83, 0x7e, 0x71, 0x41,
-	0x9e, 0x7e, 0x85, 0x3e, 0x5a, 0x92, 0xab, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0x27, 0x3b, 0x63,
-	0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x59, 0xbc, 0x6a, 0x25, 0x90, 0x02, 0x00, 0x00,
+	// 422 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xcd, 0xaa, 0xda, 0x40,
+	0x18, 0xcd, 0xdc, 0x7b, 0x11, 0xee, 0x58, 0x5a, 0x98, 0x5e, 0x6c, 0x0c, 0x92, 0x86, 0xd0, 0x85,
+	0x8b, 0x9a, 0x80, 0xfd, 0x59, 0x75, 0x63, 0x70, 0xe3, 0x42, 0x90, 0x94, 0xd2, 0xd2, 0x4d, 0x89,
+	0xc9, 0x90, 0x0c, 0x98, 0x99, 0xe9, 0xcc, 0x48, 0xf5, 0x11, 0xba, 0x28, 0xf4, 0x61, 0xfa, 0x10,
+	0x2e, 0xa5, 0xab, 0xae, 0x4a, 0xd1, 0xb7, 0xe8, 0xaa, 0x98, 0xc4, 0x68, 0x44, 0x14, 0x7b, 0x77,
+	0x33, 0x73, 0xce, 0x77, 0xce, 0xe1, 0x3b, 0x0c, 0x6c, 0xf0, 0x40, 0x28, 0x12, 0x12, 0x1e, 0x28,
+	0xc2, 0xa8, 0xab, 0x66, 0x0e, 0x17, 0x4c, 0x31, 0xd4, 0x52, 0x98, 0x46, 0x58, 0xa4, 0x84, 0x2a,
+	0x47, 0x72, 0xea, 0x54, 0x68, 0xc6, 0x5d, 0xcc, 0x62, 0x96, 0x11, 0xdd, 0xcd, 0x29, 0x9f, 0x31,
+	0x9a, 0x21, 0x93, 0x29, 0x93, 0x9f, 0x72, 0x20, 0xbf, 0x14, 0x90, 0x51, 0xb5, 0xe1, 0x81, 0x08,
+	0xd2, 0x02, 0xb3, 0x13, 0xf8, 0x70, 0x28, 0xe3, 0x51, 0x49, 0xc0, 0xc8, 0x82, 0xf5, 0x92, 0x4f,
+	0x95, 0x0e, 0x2c, 0xd0, 0xbe, 0xf5, 0xf7, 0x9f, 0x50, 0x0b, 0xde, 0x06, 0xd3, 0x70, 0xa3, 0x35,
+	0xe8, 0xeb, 0x57, 0x16, 0x68, 0xdf, 0xf8, 0xbb, 0x07, 0xd4, 0x80, 0x35, 0x45, 0xb0, 0x18, 0xf4,
+	0xf5, 0xeb, 0x0c, 0x2a, 0x6e, 0xb6, 0x0e, 0x1b, 0x55, 0x27, 0x1f, 0x4b, 0xce, 0xa8, 0xc4, 0xf6,
+	0x87, 0x0c, 0x79, 0x4f, 0x54, 0x12, 0x89, 0xe0, 0x4b, 0x6f, 0x32, 0x61, 0x61, 0x96, 0x54, 0xde,
+	0x37, 0x8b, 0x6d, 0x41, 0xf3, 0xb8, 0x72, 0xe9, 0xfd, 0x0d, 0xc0, 0x47, 0x43, 0x19, 0xbf, 0xe3,
+	0x51, 0xa0, 0xf0, 0x28, 0xdb, 0x0c, 0x7a, 0xbd, 0xd1, 0x54, 0x09, 0x13, 0x44, 0xcd, 0x73, 0x4f,
+	0x4f, 0xff, 0xf9, 0xa3, 0x73, 0x57, 0x2c, 0xb5, 0x17, 0x45, 0x02, 0x4b, 0xf9, 0x56, 0x09, 0x42,
+	0x63, 0x7f, 0x47, 0x45, 0x1e, 0xac, 0xe5, 0xbb, 0xcd, 0x82, 0xd4, 0xbb, 0xcf, 0x9c, 0x53, 0x3d,
+	0x3a, 0xb9, 0x9b, 0x77, 0xb3, 0xf8, 0xfd, 0x54, 0xf3, 0x8b, 0x49, 0xbb, 0x09, 0x9f, 0x1c, 0xc4,
+	0xd9, 0x46, 0xed, 0xfe, 0xbd, 0x82, 0xd7, 0x43, 0x19, 0xa3, 0xcf, 0xb0, 0xbe, 0xdf, 0xd7, 0xf3,
+	0xd3, 0x2e, 0xd5, 0x9d, 0x1b, 0x2f, 0x2f, 0x61, 0x6f, 0xad, 0xd1, 0x57, 0x00, 0x1f, 0x1f, 0xeb,
+	0xe7, 0xbc, 0xda, 0x91, 0x29, 0xe3, 0xcd, 0xff, 0x4c, 0x95, 0x59, 0x14, 0x7c, 0x50, 0x69, 0xab,
+	0x73, 0x56, 0x6d, 0x9f, 0x6e, 0xbc, 0xba, 0x88, 0xbe, 0x75, 0xf5, 0x06, 0x8b, 0x95, 0x09, 0x96,
+	0x2b, 0x13, 0xfc, 0x59, 0x99, 0xe0, 0xfb, 0xda, 0xd4, 0x96, 0x6b, 0x53, 0xfb, 0xb5, 0x36, 0xb5,
+	0x8f, 0x6e, 0x4c, 0x54, 0x32, 0x1d, 0x3b, 0x21, 0x4b, 0xdd, 0x9d, 0xb4, 0x2b, 0x39, 0x75, 0x67,
+	0xee, 0xc1, 0x07, 0x9f, 0x73, 0x2c, 0xc7, 0xb5, 0xec, 0xe7, 0xbd, 0xf8, 0x37, 0x00, 0x1d, 0x60,
+	0x05, 0x0e, 0xfe, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -256,6 +359,7 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	Participate(ctx context.Context, in *MsgParticipate, opts ...grpc.CallOption) (*MsgParticipateResponse, error)
 	WithdrawAllocations(ctx context.Context, in *MsgWithdrawAllocations, opts ...grpc.CallOption) (*MsgWithdrawAllocationsResponse, error)
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -284,10 +388,20 @@ func (c *msgClient) WithdrawAllocations(ctx context.Context, in *MsgWithdrawAllo
 	return out, nil
 }
 
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.participation.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	Participate(context.Context, *MsgParticipate) (*MsgParticipateResponse, error)
 	WithdrawAllocations(context.Context, *MsgWithdrawAllocations) (*MsgWithdrawAllocationsResponse, error)
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -300,6 +414,9 @@ func (*UnimplementedMsgServer) Participate(ctx context.Context, req *MsgParticip
 func (*UnimplementedMsgServer) WithdrawAllocations(ctx context.Context, req *MsgWithdrawAllocations) (*MsgWithdrawAllocationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WithdrawAllocations not implemented")
 }
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -341,6 +458,24 @@ func _Msg_WithdrawAllocations_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.participation.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "tendermint.spn.participation.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -353,6 +488,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "WithdrawAllocations",
 			Handler:    _Msg_WithdrawAllocations_Handler,
 		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "participation/tx.proto",
@@ -479,6 +618,69 @@ func (m *MsgWithdrawAllocationsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -543,6 +745,30 @@ func (m *MsgWithdrawAllocationsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -870,6 +1096,171 @@ func (m *MsgWithdrawAllocationsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
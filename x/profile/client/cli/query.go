@@ -27,6 +27,7 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		CmdShowCoordinator(),
 		CmdListCoordinator(),
 		CmdShowCoordinatorByAddress(),
+		CmdShowCoordinatorStats(),
 	)
 
 	// this line is used by starport scaffolding # 1
@@ -0,0 +1,45 @@
+package cli
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/tendermint/spn/x/profile/types"
+)
+
+func CmdShowCoordinatorStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-coordinator-stats [coordinator-id]",
+		Short: "Shows the launch history statistics of a coordinator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			coordinatorID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryGetCoordinatorStatsRequest{
+				CoordinatorID: coordinatorID,
+			}
+
+			res, err := queryClient.CoordinatorStats(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
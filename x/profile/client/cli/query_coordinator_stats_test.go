@@ -0,0 +1,61 @@
+package cli_test
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	"github.com/stretchr/testify/require"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/tendermint/spn/x/profile/client/cli"
+	"github.com/tendermint/spn/x/profile/types"
+)
+
+func (suite *QueryTestSuite) TestShowCoordinatorStats() {
+	ctx := suite.Network.Validators[0].ClientCtx
+	objs := suite.ProfileState.CoordinatorStatsList
+
+	common := []string{
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+	for _, tc := range []struct {
+		desc string
+		id   string
+		args []string
+		err  error
+		obj  types.CoordinatorStats
+	}{
+		{
+			desc: "should show the stats of an existing coordinator",
+			id:   strconv.FormatUint(objs[0].CoordinatorID, 10),
+			args: common,
+			obj:  objs[0],
+		},
+		{
+			desc: "should send error for a non existing coordinator",
+			id:   "1000",
+			args: common,
+			err:  status.Error(codes.NotFound, "not found"),
+		},
+	} {
+		suite.T().Run(tc.desc, func(t *testing.T) {
+			args := []string{tc.id}
+			args = append(args, tc.args...)
+			out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdShowCoordinatorStats(), args)
+			if tc.err != nil {
+				stat, ok := status.FromError(tc.err)
+				require.True(t, ok)
+				require.ErrorIs(t, stat.Err(), tc.err)
+			} else {
+				require.NoError(t, err)
+				var resp types.QueryGetCoordinatorStatsResponse
+				require.NoError(t, suite.Network.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+				require.Equal(t, tc.obj, resp.CoordinatorStats)
+			}
+		})
+	}
+}
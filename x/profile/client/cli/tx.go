@@ -22,10 +22,14 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(
 		CmdUpdateValidatorDescription(),
 		CmdAddValidatorOperatorAddress(),
+		CmdRemoveValidatorOperatorAddress(),
+		CmdUpdateValidatorAddress(),
 		CmdCreateCoordinator(),
 		CmdUpdateCoordinatorDescription(),
 		CmdUpdateCoordinatorAddress(),
 		CmdDisableCoordinator(),
+		CmdAddCoordinatorOperator(),
+		CmdRemoveCoordinatorOperator(),
 	)
 
 	// this line is used by starport scaffolding # 1
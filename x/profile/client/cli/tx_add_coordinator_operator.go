@@ -0,0 +1,57 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/tendermint/spn/x/profile/types"
+)
+
+func CmdAddCoordinatorOperator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-coordinator-operator [operator] [permissions]",
+		Short: "Add an operator allowed to act on behalf of the coordinator",
+		Long: fmt.Sprintf("Add an operator allowed to act on behalf of the coordinator, "+
+			"permissions are a comma separated list of: %s", permissionNames()),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var permissions []types.CoordinatorPermission
+			for _, name := range strings.Split(args[1], ",") {
+				permission, ok := types.CoordinatorPermission_value[strings.ToUpper(strings.TrimSpace(name))]
+				if !ok {
+					return fmt.Errorf("invalid permission %s", name)
+				}
+				permissions = append(permissions, types.CoordinatorPermission(permission))
+			}
+
+			msg := types.NewMsgAddCoordinatorOperator(clientCtx.GetFromAddress().String(), args[0], permissions)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// permissionNames returns the comma separated list of the coordinator permissions
+func permissionNames() string {
+	names := make([]string, len(types.CoordinatorPermission_name))
+	for i := range names {
+		names[i] = types.CoordinatorPermission_name[int32(i)]
+	}
+	return strings.Join(names, ",")
+}
@@ -0,0 +1,69 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/tendermint/spn/x/profile/types"
+)
+
+func CmdUpdateValidatorAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-validator-address [new-address]",
+		Short: "Update the address of a validator profile",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			address := clientCtx.GetFromAddress().String()
+			newAddress := args[0]
+
+			msg := types.NewMsgUpdateValidatorAddress(address, newAddress)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			// initialize tx
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			txBuilder, err := txf.BuildUnsignedTx(msg)
+			if err != nil {
+				return err
+			}
+
+			// double sign with the new address
+			if err := addSignature(clientCtx, txf, txBuilder, address); err != nil {
+				return err
+			}
+			if err := addSignature(clientCtx, txf, txBuilder, newAddress); err != nil {
+				return err
+			}
+
+			// encode tx
+			encoder := clientCtx.TxConfig.TxEncoder()
+			encodedTx, err := encoder(txBuilder.GetTx())
+			if err != nil {
+				return err
+			}
+
+			// broadcast tx
+			resp, err := clientCtx.BroadcastTxSync(encodedTx)
+			if err != nil {
+				return err
+			}
+			fmt.Print(resp.String())
+
+			return nil
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -34,6 +34,11 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	for _, elem := range genState.CoordinatorByAddressList {
 		k.SetCoordinatorByAddress(ctx, elem)
 	}
+
+	// Set all the coordinatorStats
+	for _, elem := range genState.CoordinatorStatsList {
+		k.SetCoordinatorStats(ctx, elem)
+	}
 }
 
 // ExportGenesis returns the profile module's exported genesis.
@@ -43,6 +48,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.CoordinatorList = k.GetAllCoordinator(ctx)
 	genesis.CoordinatorCounter = k.GetCoordinatorCounter(ctx)
 	genesis.CoordinatorByAddressList = k.GetAllCoordinatorByAddress(ctx)
+	genesis.CoordinatorStatsList = k.GetAllCoordinatorStats(ctx)
 	genesis.ValidatorList = k.GetAllValidator(ctx)
 	genesis.ValidatorByOperatorAddressList = k.GetAllValidatorByOperatorAddress(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
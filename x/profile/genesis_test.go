@@ -29,6 +29,7 @@ func TestGenesis(t *testing.T) {
 		require.ElementsMatch(t, genesisState.ValidatorByOperatorAddressList, got.ValidatorByOperatorAddressList)
 		require.ElementsMatch(t, genesisState.CoordinatorList, got.CoordinatorList)
 		require.ElementsMatch(t, genesisState.CoordinatorByAddressList, got.CoordinatorByAddressList)
+		require.ElementsMatch(t, genesisState.CoordinatorStatsList, got.CoordinatorStatsList)
 		require.Equal(t, genesisState.CoordinatorCounter, got.CoordinatorCounter)
 	})
 
@@ -90,3 +90,34 @@ func (k Keeper) GetCoordinatorByAddress(ctx sdk.Context, address string) (types.
 
 	return coordByAddress, nil
 }
+
+// CheckCoordinatorPermission checks if the address can perform an action requiring the permission on behalf of the
+// coordinator, the address must be either the coordinator address or an operator granted with the permission
+func (k Keeper) CheckCoordinatorPermission(
+	ctx sdk.Context,
+	address string,
+	coordinatorID uint64,
+	permission types.CoordinatorPermission,
+) error {
+	coord, found := k.GetCoordinator(ctx, coordinatorID)
+	if found && coord.Active {
+		if coord.HasPermission(address, permission) {
+			return nil
+		}
+		if _, isOperator := coord.GetOperator(address); isOperator {
+			return sdkerrors.Wrapf(types.ErrCoordInvalid, "operator %s of coordinator %d doesn't have permission %s",
+				address, coordinatorID, permission.String())
+		}
+	}
+
+	coordID, err := k.CoordinatorIDFromAddress(ctx, address)
+	if err != nil {
+		return err
+	}
+	if coordID != coordinatorID {
+		return sdkerrors.Wrapf(types.ErrCoordInvalid, "coordinator is %d", coordinatorID)
+	}
+
+	// the address is associated to the coordinator but the coordinator is not found or inactive
+	return ignterrors.Criticalf("coordinator address %s is associated to an invalid coordinator %d", address, coordinatorID)
+}
@@ -147,3 +147,69 @@ func TestActiveCoordinatorByAddressGet(t *testing.T) {
 		require.ErrorIs(t, err, types.ErrCoordAddressNotFound)
 	})
 }
+
+func TestKeeper_CheckCoordinatorPermission(t *testing.T) {
+	var (
+		sdkCtx, tk, ts = testkeeper.NewTestSetup(t)
+		wCtx           = sdk.WrapSDKContext(sdkCtx)
+		msgCoord       = sample.MsgCreateCoordinator(sample.Address(r))
+		msgOtherCoord  = sample.MsgCreateCoordinator(sample.Address(r))
+		operator       = sample.Address(r)
+	)
+	res, err := ts.ProfileSrv.CreateCoordinator(wCtx, &msgCoord)
+	require.NoError(t, err)
+	coordID := res.CoordinatorID
+	_, err = ts.ProfileSrv.CreateCoordinator(wCtx, &msgOtherCoord)
+	require.NoError(t, err)
+	_, err = ts.ProfileSrv.AddCoordinatorOperator(wCtx, types.NewMsgAddCoordinatorOperator(
+		msgCoord.Address,
+		operator,
+		[]types.CoordinatorPermission{types.CoordinatorPermission_SETTLE_REQUESTS},
+	))
+	require.NoError(t, err)
+
+	for _, tt := range []struct {
+		name       string
+		address    string
+		permission types.CoordinatorPermission
+		err        error
+	}{
+		{
+			name:       "should allow the coordinator address for any permission",
+			address:    msgCoord.Address,
+			permission: types.CoordinatorPermission_MINT_VOUCHERS,
+		},
+		{
+			name:       "should allow an operator with the permission",
+			address:    operator,
+			permission: types.CoordinatorPermission_SETTLE_REQUESTS,
+		},
+		{
+			name:       "should prevent an operator without the permission",
+			address:    operator,
+			permission: types.CoordinatorPermission_TRIGGER_LAUNCH,
+			err:        types.ErrCoordInvalid,
+		},
+		{
+			name:       "should prevent the address of another coordinator",
+			address:    msgOtherCoord.Address,
+			permission: types.CoordinatorPermission_SETTLE_REQUESTS,
+			err:        types.ErrCoordInvalid,
+		},
+		{
+			name:       "should prevent an address not associated to a coordinator",
+			address:    sample.Address(r),
+			permission: types.CoordinatorPermission_SETTLE_REQUESTS,
+			err:        types.ErrCoordAddressNotFound,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tk.ProfileKeeper.CheckCoordinatorPermission(sdkCtx, tt.address, coordID, tt.permission)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
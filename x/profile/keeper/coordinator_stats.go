@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tendermint/spn/x/profile/types"
+)
+
+// SetCoordinatorStats set the statistics of a coordinator in the store
+func (k Keeper) SetCoordinatorStats(ctx sdk.Context, stats types.CoordinatorStats) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CoordinatorStatsKeyPrefix))
+	b := k.cdc.MustMarshal(&stats)
+	store.Set(GetCoordinatorIDBytes(stats.CoordinatorID), b)
+}
+
+// GetCoordinatorStats returns the statistics of a coordinator from its id
+func (k Keeper) GetCoordinatorStats(ctx sdk.Context, coordinatorID uint64) (val types.CoordinatorStats, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CoordinatorStatsKeyPrefix))
+	b := store.Get(GetCoordinatorIDBytes(coordinatorID))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllCoordinatorStats returns the statistics of all coordinators
+func (k Keeper) GetAllCoordinatorStats(ctx sdk.Context) (list []types.CoordinatorStats) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CoordinatorStatsKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.CoordinatorStats
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// IncrementChainsCreated increments the number of chains created by the coordinator
+func (k Keeper) IncrementChainsCreated(ctx sdk.Context, coordinatorID uint64) {
+	k.updateCoordinatorStats(ctx, coordinatorID, func(stats *types.CoordinatorStats) {
+		stats.ChainsCreated++
+	})
+}
+
+// IncrementChainsLaunched increments the number of chains launched by the coordinator
+func (k Keeper) IncrementChainsLaunched(ctx sdk.Context, coordinatorID uint64) {
+	k.updateCoordinatorStats(ctx, coordinatorID, func(stats *types.CoordinatorStats) {
+		stats.ChainsLaunched++
+	})
+}
+
+// IncrementLaunchesReverted increments the number of launches reverted by the coordinator
+func (k Keeper) IncrementLaunchesReverted(ctx sdk.Context, coordinatorID uint64) {
+	k.updateCoordinatorStats(ctx, coordinatorID, func(stats *types.CoordinatorStats) {
+		stats.LaunchesReverted++
+	})
+}
+
+// IncrementCampaignsCreated increments the number of campaigns created by the coordinator
+func (k Keeper) IncrementCampaignsCreated(ctx sdk.Context, coordinatorID uint64) {
+	k.updateCoordinatorStats(ctx, coordinatorID, func(stats *types.CoordinatorStats) {
+		stats.CampaignsCreated++
+	})
+}
+
+// IncrementMainnetsInitialized increments the number of mainnets initialized by the coordinator
+func (k Keeper) IncrementMainnetsInitialized(ctx sdk.Context, coordinatorID uint64) {
+	k.updateCoordinatorStats(ctx, coordinatorID, func(stats *types.CoordinatorStats) {
+		stats.MainnetsInitialized++
+	})
+}
+
+// AddRewardsDistributed adds rewards distributed to the validators of the coordinator chains
+func (k Keeper) AddRewardsDistributed(ctx sdk.Context, coordinatorID uint64, rewards sdk.Coins) {
+	k.updateCoordinatorStats(ctx, coordinatorID, func(stats *types.CoordinatorStats) {
+		stats.RewardsDistributed = stats.RewardsDistributed.Add(rewards...)
+	})
+}
+
+// updateCoordinatorStats applies the update to the statistics of the coordinator,
+// the statistics are initialized if they don't exist yet
+func (k Keeper) updateCoordinatorStats(ctx sdk.Context, coordinatorID uint64, update func(*types.CoordinatorStats)) {
+	stats, found := k.GetCoordinatorStats(ctx, coordinatorID)
+	if !found {
+		stats = types.NewCoordinatorStats(coordinatorID)
+	}
+	update(&stats)
+	k.SetCoordinatorStats(ctx, stats)
+}
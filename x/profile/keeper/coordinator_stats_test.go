@@ -0,0 +1,94 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	tc "github.com/tendermint/spn/testutil/constructor"
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/profile/keeper"
+	"github.com/tendermint/spn/x/profile/types"
+)
+
+func createNCoordinatorStats(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.CoordinatorStats {
+	items := make([]types.CoordinatorStats, n)
+	for i := range items {
+		items[i] = sample.CoordinatorStats(r, uint64(i))
+		keeper.SetCoordinatorStats(ctx, items[i])
+	}
+	return items
+}
+
+func TestCoordinatorStatsGet(t *testing.T) {
+	ctx, tk, _ := testkeeper.NewTestSetup(t)
+	items := createNCoordinatorStats(tk.ProfileKeeper, ctx, 10)
+
+	t.Run("should allow getting coordinator stats", func(t *testing.T) {
+		for _, item := range items {
+			stats, found := tk.ProfileKeeper.GetCoordinatorStats(ctx, item.CoordinatorID)
+			require.True(t, found)
+			require.Equal(t, item, stats)
+		}
+	})
+
+	t.Run("should return false for non existing coordinator stats", func(t *testing.T) {
+		_, found := tk.ProfileKeeper.GetCoordinatorStats(ctx, 1000)
+		require.False(t, found)
+	})
+}
+
+func TestCoordinatorStatsGetAll(t *testing.T) {
+	ctx, tk, _ := testkeeper.NewTestSetup(t)
+	items := createNCoordinatorStats(tk.ProfileKeeper, ctx, 10)
+
+	t.Run("should allow getting all coordinator stats", func(t *testing.T) {
+		require.ElementsMatch(t, items, tk.ProfileKeeper.GetAllCoordinatorStats(ctx))
+	})
+}
+
+func TestCoordinatorStatsUpdate(t *testing.T) {
+	ctx, tk, _ := testkeeper.NewTestSetup(t)
+	coordID := uint64(0)
+
+	t.Run("should initialize the stats of a coordinator on first update", func(t *testing.T) {
+		tk.ProfileKeeper.IncrementChainsCreated(ctx, coordID)
+
+		stats, found := tk.ProfileKeeper.GetCoordinatorStats(ctx, coordID)
+		require.True(t, found)
+		require.Equal(t, types.CoordinatorStats{
+			CoordinatorID: coordID,
+			ChainsCreated: 1,
+		}, stats)
+	})
+
+	t.Run("should allow updating the stats of a coordinator", func(t *testing.T) {
+		tk.ProfileKeeper.IncrementChainsCreated(ctx, coordID)
+		tk.ProfileKeeper.IncrementChainsLaunched(ctx, coordID)
+		tk.ProfileKeeper.IncrementChainsLaunched(ctx, coordID)
+		tk.ProfileKeeper.IncrementLaunchesReverted(ctx, coordID)
+		tk.ProfileKeeper.IncrementCampaignsCreated(ctx, coordID)
+		tk.ProfileKeeper.IncrementMainnetsInitialized(ctx, coordID)
+		tk.ProfileKeeper.AddRewardsDistributed(ctx, coordID, tc.Coins(t, "100foo,50bar"))
+		tk.ProfileKeeper.AddRewardsDistributed(ctx, coordID, tc.Coins(t, "20foo"))
+
+		stats, found := tk.ProfileKeeper.GetCoordinatorStats(ctx, coordID)
+		require.True(t, found)
+		require.Equal(t, types.CoordinatorStats{
+			CoordinatorID:       coordID,
+			ChainsCreated:       2,
+			ChainsLaunched:      2,
+			LaunchesReverted:    1,
+			CampaignsCreated:    1,
+			MainnetsInitialized: 1,
+			RewardsDistributed:  tc.Coins(t, "120foo,50bar"),
+		}, stats)
+	})
+
+	t.Run("should not update the stats of other coordinators", func(t *testing.T) {
+		_, found := tk.ProfileKeeper.GetCoordinatorStats(ctx, coordID+1)
+		require.False(t, found)
+	})
+}
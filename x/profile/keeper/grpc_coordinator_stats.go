@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/tendermint/spn/x/profile/types"
+)
+
+func (k Keeper) CoordinatorStats(c context.Context, req *types.QueryGetCoordinatorStatsRequest) (*types.QueryGetCoordinatorStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := k.GetCoordinator(ctx, req.CoordinatorID); !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	// a coordinator without statistics has no recorded activity yet
+	stats, found := k.GetCoordinatorStats(ctx, req.CoordinatorID)
+	if !found {
+		stats = types.NewCoordinatorStats(req.CoordinatorID)
+	}
+
+	return &types.QueryGetCoordinatorStatsResponse{CoordinatorStats: stats}, nil
+}
@@ -0,0 +1,61 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/profile/types"
+)
+
+func TestCoordinatorStatsQuery(t *testing.T) {
+	ctx, tk, _ := testkeeper.NewTestSetup(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	coords := createNCoordinator(tk.ProfileKeeper, ctx, 2)
+	stats := sample.CoordinatorStats(r, coords[0].CoordinatorID)
+	tk.ProfileKeeper.SetCoordinatorStats(ctx, stats)
+
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryGetCoordinatorStatsRequest
+		response *types.QueryGetCoordinatorStatsResponse
+		err      error
+	}{
+		{
+			desc:     "should allow querying coordinator stats",
+			request:  &types.QueryGetCoordinatorStatsRequest{CoordinatorID: coords[0].CoordinatorID},
+			response: &types.QueryGetCoordinatorStatsResponse{CoordinatorStats: stats},
+		},
+		{
+			desc:    "should return empty stats for a coordinator without activity",
+			request: &types.QueryGetCoordinatorStatsRequest{CoordinatorID: coords[1].CoordinatorID},
+			response: &types.QueryGetCoordinatorStatsResponse{
+				CoordinatorStats: types.NewCoordinatorStats(coords[1].CoordinatorID),
+			},
+		},
+		{
+			desc:    "should prevent querying stats of non existing coordinator",
+			request: &types.QueryGetCoordinatorStatsRequest{CoordinatorID: 1000},
+			err:     status.Error(codes.NotFound, "not found"),
+		},
+		{
+			desc: "should prevent querying with invalid request",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := tk.ProfileKeeper.CoordinatorStats(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.response, response)
+			}
+		})
+	}
+}
@@ -8,12 +8,35 @@ import (
 	"github.com/tendermint/spn/x/profile/types"
 )
 
-const coordinatorIDNotFoundRoute = "coordinator-id-not-found"
+const (
+	coordinatorIDNotFoundRoute     = "coordinator-id-not-found"
+	validatorByOpAddrInvalidRoute  = "validator-by-operator-address-invalid"
+	validatorOpAddrNotIndexedRoute = "validator-operator-address-not-indexed"
+)
 
 // RegisterInvariants registers all module invariants
 func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 	ir.RegisterRoute(types.ModuleName, coordinatorIDNotFoundRoute,
 		CoordinatorAddrNotFoundInvariant(k))
+	ir.RegisterRoute(types.ModuleName, validatorByOpAddrInvalidRoute,
+		ValidatorByOperatorAddressInvariant(k))
+	ir.RegisterRoute(types.ModuleName, validatorOpAddrNotIndexedRoute,
+		ValidatorOperatorAddressIndexedInvariant(k))
+}
+
+// AllInvariants runs all invariants of the module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := CoordinatorAddrNotFoundInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		res, stop = ValidatorByOperatorAddressInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return ValidatorOperatorAddressIndexedInvariant(k)(ctx)
+	}
 }
 
 // CoordinatorAddrNotFoundInvariant invariant that checks if
@@ -33,3 +56,56 @@ func CoordinatorAddrNotFoundInvariant(k Keeper) sdk.Invariant {
 		return "", false
 	}
 }
+
+// ValidatorByOperatorAddressInvariant invariant that checks if
+// each `ValidatorByOperatorAddress` is associated with a validator
+// that contains the operator address in its profile
+func ValidatorByOperatorAddressInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		all := k.GetAllValidatorByOperatorAddress(ctx)
+		for _, valByOpAddr := range all {
+			validator, found := k.GetValidator(ctx, valByOpAddr.ValidatorAddress)
+			if !found {
+				return sdk.FormatInvariant(
+					types.ModuleName, validatorByOpAddrInvalidRoute,
+					fmt.Sprintf("%s: %s", types.ErrValidatorNotFound, valByOpAddr.ValidatorAddress),
+				), true
+			}
+			if !validator.HasOperatorAddress(valByOpAddr.OperatorAddress) {
+				return sdk.FormatInvariant(
+					types.ModuleName, validatorByOpAddrInvalidRoute,
+					fmt.Sprintf("%s: %s is not an operator address of %s",
+						types.ErrOperatorAddrNotFound,
+						valByOpAddr.OperatorAddress,
+						valByOpAddr.ValidatorAddress,
+					),
+				), true
+			}
+		}
+		return "", false
+	}
+}
+
+// ValidatorOperatorAddressIndexedInvariant invariant that checks if
+// each operator address of a validator is indexed in `ValidatorByOperatorAddress`
+// and points back to the validator
+func ValidatorOperatorAddressIndexedInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		all := k.GetAllValidator(ctx)
+		for _, validator := range all {
+			for _, opAddr := range validator.OperatorAddresses {
+				valByOpAddr, found := k.GetValidatorByOperatorAddress(ctx, opAddr)
+				if !found || valByOpAddr.ValidatorAddress != validator.Address {
+					return sdk.FormatInvariant(
+						types.ModuleName, validatorOpAddrNotIndexedRoute,
+						fmt.Sprintf("operator address %s of validator %s is not indexed",
+							opAddr,
+							validator.Address,
+						),
+					), true
+				}
+			}
+		}
+		return "", false
+	}
+}
@@ -34,3 +34,79 @@ func TestCoordinatorAddrNotFoundInvariant(t *testing.T) {
 		require.True(t, broken, msg)
 	})
 }
+
+func TestValidatorByOperatorAddressInvariant(t *testing.T) {
+	ctx, tk, _ := testkeeper.NewTestSetup(t)
+	valAddr := sample.Address(r)
+	opAddr := sample.Address(r)
+
+	t.Run("should not break with valid state", func(t *testing.T) {
+		tk.ProfileKeeper.SetValidator(ctx, types.Validator{
+			Address:           valAddr,
+			OperatorAddresses: []string{opAddr},
+		})
+		tk.ProfileKeeper.SetValidatorByOperatorAddress(ctx, types.ValidatorByOperatorAddress{
+			OperatorAddress:  opAddr,
+			ValidatorAddress: valAddr,
+		})
+		msg, broken := keeper.ValidatorByOperatorAddressInvariant(*tk.ProfileKeeper)(ctx)
+		require.False(t, broken, msg)
+	})
+
+	t.Run("should break with operator address not in the validator profile", func(t *testing.T) {
+		tk.ProfileKeeper.SetValidatorByOperatorAddress(ctx, types.ValidatorByOperatorAddress{
+			OperatorAddress:  sample.Address(r),
+			ValidatorAddress: valAddr,
+		})
+		msg, broken := keeper.ValidatorByOperatorAddressInvariant(*tk.ProfileKeeper)(ctx)
+		require.True(t, broken, msg)
+	})
+
+	t.Run("should break with validator not found from validator by operator address", func(t *testing.T) {
+		ctx, tk, _ := testkeeper.NewTestSetup(t)
+		tk.ProfileKeeper.SetValidatorByOperatorAddress(ctx, types.ValidatorByOperatorAddress{
+			OperatorAddress:  sample.Address(r),
+			ValidatorAddress: sample.Address(r),
+		})
+		msg, broken := keeper.ValidatorByOperatorAddressInvariant(*tk.ProfileKeeper)(ctx)
+		require.True(t, broken, msg)
+	})
+}
+
+func TestValidatorOperatorAddressIndexedInvariant(t *testing.T) {
+	ctx, tk, _ := testkeeper.NewTestSetup(t)
+	valAddr := sample.Address(r)
+	opAddr := sample.Address(r)
+
+	t.Run("should not break with valid state", func(t *testing.T) {
+		tk.ProfileKeeper.SetValidator(ctx, types.Validator{
+			Address:           valAddr,
+			OperatorAddresses: []string{opAddr},
+		})
+		tk.ProfileKeeper.SetValidatorByOperatorAddress(ctx, types.ValidatorByOperatorAddress{
+			OperatorAddress:  opAddr,
+			ValidatorAddress: valAddr,
+		})
+		msg, broken := keeper.ValidatorOperatorAddressIndexedInvariant(*tk.ProfileKeeper)(ctx)
+		require.False(t, broken, msg)
+	})
+
+	t.Run("should break with operator address indexed to another validator", func(t *testing.T) {
+		tk.ProfileKeeper.SetValidator(ctx, types.Validator{
+			Address:           sample.Address(r),
+			OperatorAddresses: []string{opAddr},
+		})
+		msg, broken := keeper.ValidatorOperatorAddressIndexedInvariant(*tk.ProfileKeeper)(ctx)
+		require.True(t, broken, msg)
+	})
+
+	t.Run("should break with operator address not indexed", func(t *testing.T) {
+		ctx, tk, _ := testkeeper.NewTestSetup(t)
+		tk.ProfileKeeper.SetValidator(ctx, types.Validator{
+			Address:           sample.Address(r),
+			OperatorAddresses: []string{sample.Address(r)},
+		})
+		msg, broken := keeper.ValidatorOperatorAddressIndexedInvariant(*tk.ProfileKeeper)(ctx)
+		require.True(t, broken, msg)
+	})
+}
@@ -16,6 +16,10 @@ type (
 		cdc      codec.BinaryCodec
 		storeKey storetypes.StoreKey
 		memKey   storetypes.StoreKey
+
+		// these keepers are only used to build the coordinator stats during the store migration
+		launchKeeper   types.LaunchKeeper
+		campaignKeeper types.CampaignKeeper
 	}
 )
 
@@ -31,6 +35,22 @@ func NewKeeper(
 	}
 }
 
+// SetLaunchKeeper sets the launch keeper interface of the module
+func (k *Keeper) SetLaunchKeeper(launchKeeper types.LaunchKeeper) {
+	if k.launchKeeper != nil {
+		panic("launch keeper already set for profile module")
+	}
+	k.launchKeeper = launchKeeper
+}
+
+// SetCampaignKeeper sets the campaign keeper interface of the module
+func (k *Keeper) SetCampaignKeeper(campaignKeeper types.CampaignKeeper) {
+	if k.campaignKeeper != nil {
+		panic("campaign keeper already set for profile module")
+	}
+	k.campaignKeeper = campaignKeeper
+}
+
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
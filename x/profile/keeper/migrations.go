@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v2 "github.com/tendermint/spn/x/profile/migrations/v2"
+)
+
+// Migrator is a struct for handling in-place store migrations
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the store from consensus version 1 to 2
+// the coordinator stats introduced in v2 are built from the chains and campaigns in the store
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	if m.keeper.launchKeeper == nil || m.keeper.campaignKeeper == nil {
+		return errors.New("launch and campaign keepers must be set to migrate the profile module")
+	}
+	return v2.MigrateStore(
+		ctx,
+		m.keeper.storeKey,
+		m.keeper.cdc,
+		m.keeper.launchKeeper.CountCoordinatorChains(ctx),
+		m.keeper.campaignKeeper.CountCoordinatorCampaigns(ctx),
+	)
+}
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"context"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ignterrors "github.com/ignite/modules/errors"
+
+	"github.com/tendermint/spn/x/profile/types"
+)
+
+func (k msgServer) AddCoordinatorOperator(
+	goCtx context.Context,
+	msg *types.MsgAddCoordinatorOperator,
+) (*types.MsgAddCoordinatorOperatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	coordByAddress, err := k.GetCoordinatorByAddress(ctx, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	coord, found := k.GetCoordinator(ctx, coordByAddress.CoordinatorID)
+	if !found {
+		return nil, ignterrors.Criticalf("a coordinator address is associated to a non-existent coordinator ID: %d",
+			coordByAddress.CoordinatorID)
+	}
+
+	// the permissions of the operator are replaced if it already exists
+	coord = coord.SetOperator(types.CoordinatorOperator{
+		Address:     msg.Operator,
+		Permissions: msg.Permissions,
+	})
+	if err := coord.ValidateOperators(); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidCoordOperator, err.Error())
+	}
+	k.SetCoordinator(ctx, coord)
+
+	return &types.MsgAddCoordinatorOperatorResponse{},
+		ctx.EventManager().EmitTypedEvent(
+			&types.EventCoordinatorOperatorAdded{
+				CoordinatorID: coord.CoordinatorID,
+				Operator:      msg.Operator,
+				Permissions:   msg.Permissions,
+			})
+}
@@ -0,0 +1,66 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/profile/types"
+)
+
+func TestMsgAddCoordinatorOperator(t *testing.T) {
+	var (
+		operator       = sample.Address(r)
+		msgCoord       = sample.MsgCreateCoordinator(sample.Address(r))
+		sdkCtx, tk, ts = testkeeper.NewTestSetup(t)
+		wCtx           = sdk.WrapSDKContext(sdkCtx)
+	)
+	res, err := ts.ProfileSrv.CreateCoordinator(wCtx, &msgCoord)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		msg  types.MsgAddCoordinatorOperator
+		err  error
+	}{
+		{
+			name: "should allow adding an operator",
+			msg: *types.NewMsgAddCoordinatorOperator(msgCoord.Address, operator, []types.CoordinatorPermission{
+				types.CoordinatorPermission_SETTLE_REQUESTS,
+			}),
+		},
+		{
+			name: "should allow updating the permissions of an operator",
+			msg: *types.NewMsgAddCoordinatorOperator(msgCoord.Address, operator, []types.CoordinatorPermission{
+				types.CoordinatorPermission_TRIGGER_LAUNCH,
+				types.CoordinatorPermission_EDIT_CHAIN,
+			}),
+		},
+		{
+			name: "should prevent adding an operator for a non existing coordinator",
+			msg: *types.NewMsgAddCoordinatorOperator(sample.Address(r), operator, []types.CoordinatorPermission{
+				types.CoordinatorPermission_SETTLE_REQUESTS,
+			}),
+			err: types.ErrCoordAddressNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ts.ProfileSrv.AddCoordinatorOperator(wCtx, &tt.msg)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+
+			coord, found := tk.ProfileKeeper.GetCoordinator(sdkCtx, res.CoordinatorID)
+			require.True(t, found)
+			got, found := coord.GetOperator(tt.msg.Operator)
+			require.True(t, found)
+			require.Equal(t, tt.msg.Permissions, got.Permissions)
+		})
+	}
+}
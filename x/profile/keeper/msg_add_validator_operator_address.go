@@ -29,6 +29,22 @@ func (k msgServer) AddValidatorOperatorAddress(
 		validator = validatorStore.AddValidatorOperatorAddress(opAddr)
 	}
 
+	// the operator address can only be associated to a single validator
+	prevValByOpAddr, found := k.GetValidatorByOperatorAddress(ctx, opAddr)
+	if found && prevValByOpAddr.ValidatorAddress != valAddr {
+		if prevValidator, found := k.GetValidator(ctx, prevValByOpAddr.ValidatorAddress); found {
+			prevValidator = prevValidator.RemoveValidatorOperatorAddress(opAddr)
+			k.SetValidator(ctx, prevValidator)
+			if err := ctx.EventManager().EmitTypedEvent(
+				&types.EventValidatorOperatorAddressesUpdated{
+					Address:           prevValidator.Address,
+					OperatorAddresses: prevValidator.OperatorAddresses,
+				}); err != nil {
+				return &types.MsgAddValidatorOperatorAddressResponse{}, err
+			}
+		}
+	}
+
 	// store validator information
 	k.SetValidator(ctx, validator)
 	k.SetValidatorByOperatorAddress(ctx, types.ValidatorByOperatorAddress{
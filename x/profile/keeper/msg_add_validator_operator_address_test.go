@@ -76,3 +76,32 @@ func TestMsgAddValidatorOperatorAddress(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgAddValidatorOperatorAddressMovedOperator(t *testing.T) {
+	var (
+		ctx, tk, ts = testkeeper.NewTestSetup(t)
+		wCtx        = sdk.WrapSDKContext(ctx)
+		valAddr1    = sample.Address(r)
+		valAddr2    = sample.Address(r)
+		opAddr      = sample.Address(r)
+	)
+
+	t.Run("should remove the operator address from the previous validator", func(t *testing.T) {
+		_, err := ts.ProfileSrv.AddValidatorOperatorAddress(wCtx, types.NewMsgSAddValidatorOperatorAddress(valAddr1, opAddr))
+		require.NoError(t, err)
+		_, err = ts.ProfileSrv.AddValidatorOperatorAddress(wCtx, types.NewMsgSAddValidatorOperatorAddress(valAddr2, opAddr))
+		require.NoError(t, err)
+
+		validator1, found := tk.ProfileKeeper.GetValidator(ctx, valAddr1)
+		require.True(t, found)
+		require.False(t, validator1.HasOperatorAddress(opAddr))
+
+		validator2, found := tk.ProfileKeeper.GetValidator(ctx, valAddr2)
+		require.True(t, found)
+		require.True(t, validator2.HasOperatorAddress(opAddr))
+
+		valByOpAddr, found := tk.ProfileKeeper.GetValidatorByOperatorAddress(ctx, opAddr)
+		require.True(t, found)
+		require.Equal(t, valAddr2, valByOpAddr.ValidatorAddress)
+	})
+}
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ignterrors "github.com/ignite/modules/errors"
+
+	"github.com/tendermint/spn/x/profile/types"
+)
+
+func (k msgServer) RemoveCoordinatorOperator(
+	goCtx context.Context,
+	msg *types.MsgRemoveCoordinatorOperator,
+) (*types.MsgRemoveCoordinatorOperatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	coordByAddress, err := k.GetCoordinatorByAddress(ctx, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	coord, found := k.GetCoordinator(ctx, coordByAddress.CoordinatorID)
+	if !found {
+		return nil, ignterrors.Criticalf("a coordinator address is associated to a non-existent coordinator ID: %d",
+			coordByAddress.CoordinatorID)
+	}
+
+	if _, found := coord.GetOperator(msg.Operator); !found {
+		return nil, sdkerrors.Wrapf(types.ErrCoordOperatorNotFound, "operator %s not found for coordinator %d",
+			msg.Operator, coord.CoordinatorID)
+	}
+
+	coord = coord.RemoveOperator(msg.Operator)
+	k.SetCoordinator(ctx, coord)
+
+	return &types.MsgRemoveCoordinatorOperatorResponse{},
+		ctx.EventManager().EmitTypedEvent(
+			&types.EventCoordinatorOperatorRemoved{
+				CoordinatorID: coord.CoordinatorID,
+				Operator:      msg.Operator,
+			})
+}
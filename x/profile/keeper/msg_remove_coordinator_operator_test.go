@@ -0,0 +1,65 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/profile/types"
+)
+
+func TestMsgRemoveCoordinatorOperator(t *testing.T) {
+	var (
+		operator       = sample.Address(r)
+		msgCoord       = sample.MsgCreateCoordinator(sample.Address(r))
+		sdkCtx, tk, ts = testkeeper.NewTestSetup(t)
+		wCtx           = sdk.WrapSDKContext(sdkCtx)
+	)
+	res, err := ts.ProfileSrv.CreateCoordinator(wCtx, &msgCoord)
+	require.NoError(t, err)
+	_, err = ts.ProfileSrv.AddCoordinatorOperator(wCtx, types.NewMsgAddCoordinatorOperator(
+		msgCoord.Address,
+		operator,
+		[]types.CoordinatorPermission{types.CoordinatorPermission_SETTLE_REQUESTS},
+	))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		msg  types.MsgRemoveCoordinatorOperator
+		err  error
+	}{
+		{
+			name: "should prevent removing an operator for a non existing coordinator",
+			msg:  *types.NewMsgRemoveCoordinatorOperator(sample.Address(r), operator),
+			err:  types.ErrCoordAddressNotFound,
+		},
+		{
+			name: "should prevent removing a non existing operator",
+			msg:  *types.NewMsgRemoveCoordinatorOperator(msgCoord.Address, sample.Address(r)),
+			err:  types.ErrCoordOperatorNotFound,
+		},
+		{
+			name: "should allow removing an operator",
+			msg:  *types.NewMsgRemoveCoordinatorOperator(msgCoord.Address, operator),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ts.ProfileSrv.RemoveCoordinatorOperator(wCtx, &tt.msg)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+
+			coord, found := tk.ProfileKeeper.GetCoordinator(sdkCtx, res.CoordinatorID)
+			require.True(t, found)
+			_, found = coord.GetOperator(tt.msg.Operator)
+			require.False(t, found)
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"context"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ignterrors "github.com/ignite/modules/errors"
+
+	"github.com/tendermint/spn/x/profile/types"
+)
+
+func (k msgServer) RemoveValidatorOperatorAddress(
+	goCtx context.Context,
+	msg *types.MsgRemoveValidatorOperatorAddress,
+) (*types.MsgRemoveValidatorOperatorAddressResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	valAddr := msg.ValidatorAddress
+	opAddr := msg.OperatorAddress
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return &types.MsgRemoveValidatorOperatorAddressResponse{},
+			sdkerrors.Wrap(types.ErrValidatorNotFound, valAddr)
+	}
+
+	if !validator.HasOperatorAddress(opAddr) {
+		return &types.MsgRemoveValidatorOperatorAddressResponse{},
+			sdkerrors.Wrapf(types.ErrOperatorAddrNotFound, "%s is not an operator address of %s", opAddr, valAddr)
+	}
+
+	validatorByOpAddr, found := k.GetValidatorByOperatorAddress(ctx, opAddr)
+	if !found || validatorByOpAddr.ValidatorAddress != valAddr {
+		return &types.MsgRemoveValidatorOperatorAddressResponse{},
+			ignterrors.Criticalf("operator address %s of validator %s is not indexed", opAddr, valAddr)
+	}
+
+	validator = validator.RemoveValidatorOperatorAddress(opAddr)
+	k.SetValidator(ctx, validator)
+	k.RemoveValidatorByOperatorAddress(ctx, opAddr)
+
+	return &types.MsgRemoveValidatorOperatorAddressResponse{},
+		ctx.EventManager().EmitTypedEvent(
+			&types.EventValidatorOperatorAddressesUpdated{
+				Address:           validator.Address,
+				OperatorAddresses: validator.OperatorAddresses,
+			})
+}
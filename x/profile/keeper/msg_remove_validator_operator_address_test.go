@@ -0,0 +1,78 @@
+package keeper_test
+
+import (
+	"testing"
+
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/profile/types"
+)
+
+func TestMsgRemoveValidatorOperatorAddress(t *testing.T) {
+	var (
+		ctx, tk, ts = testkeeper.NewTestSetup(t)
+		wCtx        = sdk.WrapSDKContext(ctx)
+		valAddr     = sample.Address(r)
+		opAddr1     = sample.Address(r)
+		opAddr2     = sample.Address(r)
+	)
+
+	for _, opAddr := range []string{opAddr1, opAddr2} {
+		_, err := ts.ProfileSrv.AddValidatorOperatorAddress(wCtx, &types.MsgAddValidatorOperatorAddress{
+			ValidatorAddress: valAddr,
+			OperatorAddress:  opAddr,
+		})
+		require.NoError(t, err)
+	}
+
+	tests := []struct {
+		name string
+		msg  *types.MsgRemoveValidatorOperatorAddress
+		err  error
+	}{
+		{
+			name: "should prevent removing an operator address from a non existing validator",
+			msg:  types.NewMsgRemoveValidatorOperatorAddress(sample.Address(r), opAddr1),
+			err:  types.ErrValidatorNotFound,
+		},
+		{
+			name: "should prevent removing an operator address not associated to the validator",
+			msg:  types.NewMsgRemoveValidatorOperatorAddress(valAddr, sample.Address(r)),
+			err:  types.ErrOperatorAddrNotFound,
+		},
+		{
+			name: "should allow removing an operator address",
+			msg:  types.NewMsgRemoveValidatorOperatorAddress(valAddr, opAddr1),
+		},
+		{
+			name: "should prevent removing an operator address already removed",
+			msg:  types.NewMsgRemoveValidatorOperatorAddress(valAddr, opAddr1),
+			err:  types.ErrOperatorAddrNotFound,
+		},
+		{
+			name: "should allow removing the last operator address",
+			msg:  types.NewMsgRemoveValidatorOperatorAddress(valAddr, opAddr2),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ts.ProfileSrv.RemoveValidatorOperatorAddress(wCtx, tt.msg)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+
+			validator, found := tk.ProfileKeeper.GetValidator(ctx, tt.msg.ValidatorAddress)
+			require.True(t, found)
+			require.False(t, validator.HasOperatorAddress(tt.msg.OperatorAddress))
+
+			_, found = tk.ProfileKeeper.GetValidatorByOperatorAddress(ctx, tt.msg.OperatorAddress)
+			require.False(t, found, "validator by operator address was not removed")
+		})
+	}
+}
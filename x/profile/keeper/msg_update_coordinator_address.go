@@ -45,6 +45,8 @@ func (k msgServer) UpdateCoordinatorAddress(
 				coordByAddress.CoordinatorID)
 	}
 
+	// the coordinator address can't be one of its operators
+	coord = coord.RemoveOperator(msg.NewAddress)
 	coord.Address = msg.NewAddress
 
 	// Remove the old coordinator by address and create a new one
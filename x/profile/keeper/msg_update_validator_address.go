@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrortypes "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tendermint/spn/x/profile/types"
+)
+
+func (k msgServer) UpdateValidatorAddress(
+	goCtx context.Context,
+	msg *types.MsgUpdateValidatorAddress,
+) (*types.MsgUpdateValidatorAddressResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	validator, found := k.GetValidator(ctx, msg.Address)
+	if !found {
+		return &types.MsgUpdateValidatorAddressResponse{},
+			sdkerrors.Wrap(types.ErrValidatorNotFound, msg.Address)
+	}
+
+	// Check if the new validator address already has a profile
+	if _, found := k.GetValidator(ctx, msg.NewAddress); found {
+		return &types.MsgUpdateValidatorAddressResponse{},
+			sdkerrors.Wrap(types.ErrValidatorAlreadyExist, msg.NewAddress)
+	}
+
+	// the validator address can't be one of its operator addresses
+	if validator.HasOperatorAddress(msg.NewAddress) {
+		return &types.MsgUpdateValidatorAddressResponse{},
+			sdkerrors.Wrapf(sdkerrortypes.ErrInvalidAddress,
+				"new address %s is an operator address of the validator", msg.NewAddress)
+	}
+
+	// Move the validator profile and point its operator addresses to the new address
+	k.RemoveValidator(ctx, msg.Address)
+	validator.Address = msg.NewAddress
+	k.SetValidator(ctx, validator)
+	for _, opAddr := range validator.OperatorAddresses {
+		k.SetValidatorByOperatorAddress(ctx, types.ValidatorByOperatorAddress{
+			OperatorAddress:  opAddr,
+			ValidatorAddress: msg.NewAddress,
+		})
+	}
+
+	return &types.MsgUpdateValidatorAddressResponse{},
+		ctx.EventManager().EmitTypedEvent(
+			&types.EventValidatorAddressUpdated{
+				Address:           msg.Address,
+				NewAddress:        msg.NewAddress,
+				OperatorAddresses: validator.OperatorAddresses,
+			})
+}
@@ -0,0 +1,97 @@
+package keeper_test
+
+import (
+	"testing"
+
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/profile/types"
+)
+
+func TestMsgUpdateValidatorAddress(t *testing.T) {
+	var (
+		ctx, tk, ts = testkeeper.NewTestSetup(t)
+		wCtx        = sdk.WrapSDKContext(ctx)
+		valAddr1    = sample.Address(r)
+		valAddr2    = sample.Address(r)
+		newAddr     = sample.Address(r)
+		opAddr1     = sample.Address(r)
+		opAddr2     = sample.Address(r)
+		opAddr3     = sample.Address(r)
+	)
+
+	for _, msg := range []*types.MsgAddValidatorOperatorAddress{
+		types.NewMsgSAddValidatorOperatorAddress(valAddr1, opAddr1),
+		types.NewMsgSAddValidatorOperatorAddress(valAddr1, opAddr2),
+		types.NewMsgSAddValidatorOperatorAddress(valAddr2, opAddr3),
+	} {
+		_, err := ts.ProfileSrv.AddValidatorOperatorAddress(wCtx, msg)
+		require.NoError(t, err)
+	}
+	desc := sample.ValidatorDescription(sample.String(r, 10))
+	_, err := ts.ProfileSrv.UpdateValidatorDescription(wCtx, &types.MsgUpdateValidatorDescription{
+		Address:     valAddr1,
+		Description: desc,
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		msg  *types.MsgUpdateValidatorAddress
+		err  error
+	}{
+		{
+			name: "should prevent updating a non existing validator",
+			msg:  types.NewMsgUpdateValidatorAddress(sample.Address(r), sample.Address(r)),
+			err:  types.ErrValidatorNotFound,
+		},
+		{
+			name: "should prevent updating to an address already associated to a validator",
+			msg:  types.NewMsgUpdateValidatorAddress(valAddr1, valAddr2),
+			err:  types.ErrValidatorAlreadyExist,
+		},
+		{
+			name: "should prevent updating to an operator address of the validator",
+			msg:  types.NewMsgUpdateValidatorAddress(valAddr1, opAddr1),
+			err:  sdkerrortypes.ErrInvalidAddress,
+		},
+		{
+			name: "should allow updating the validator address",
+			msg:  types.NewMsgUpdateValidatorAddress(valAddr1, newAddr),
+		},
+		{
+			name: "should prevent updating from the previous validator address",
+			msg:  types.NewMsgUpdateValidatorAddress(valAddr1, sample.Address(r)),
+			err:  types.ErrValidatorNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ts.ProfileSrv.UpdateValidatorAddress(wCtx, tt.msg)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+
+			_, found := tk.ProfileKeeper.GetValidator(ctx, tt.msg.Address)
+			require.False(t, found, "old validator address was not removed")
+
+			validator, found := tk.ProfileKeeper.GetValidator(ctx, tt.msg.NewAddress)
+			require.True(t, found, "validator not found with the new address")
+			require.EqualValues(t, desc, validator.Description)
+			require.ElementsMatch(t, []string{opAddr1, opAddr2}, validator.OperatorAddresses)
+
+			for _, opAddr := range validator.OperatorAddresses {
+				valByOpAddr, found := tk.ProfileKeeper.GetValidatorByOperatorAddress(ctx, opAddr)
+				require.True(t, found)
+				require.EqualValues(t, tt.msg.NewAddress, valByOpAddr.ValidatorAddress)
+			}
+		})
+	}
+}
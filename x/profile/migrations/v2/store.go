@@ -0,0 +1,75 @@
+package v2
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	spntypes "github.com/tendermint/spn/pkg/types"
+	"github.com/tendermint/spn/x/profile/types"
+)
+
+// MigrateStore performs in-place store migrations from v1 to v2 of the profile module:
+//   - the coordinator stats are built from the chains and campaigns counted by the launch and campaign modules
+//   - the number of launches reverted and the rewards distributed can't be derived and are left unchanged
+func MigrateStore(
+	ctx sdk.Context,
+	storeKey storetypes.StoreKey,
+	cdc codec.BinaryCodec,
+	chainStats,
+	campaignStats []types.CoordinatorStats,
+) error {
+	store := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.CoordinatorStatsKeyPrefix))
+
+	// the counted stats of the existing stats are reset before being set from the chains and campaigns
+	var coordinatorIDs []uint64
+	stats := make(map[uint64]*types.CoordinatorStats)
+	addStats := func(s types.CoordinatorStats) *types.CoordinatorStats {
+		s.ChainsCreated = 0
+		s.ChainsLaunched = 0
+		s.CampaignsCreated = 0
+		s.MainnetsInitialized = 0
+		stats[s.CoordinatorID] = &s
+		coordinatorIDs = append(coordinatorIDs, s.CoordinatorID)
+		return &s
+	}
+	getStats := func(coordinatorID uint64) *types.CoordinatorStats {
+		if s, ok := stats[coordinatorID]; ok {
+			return s
+		}
+		return addStats(types.NewCoordinatorStats(coordinatorID))
+	}
+
+	iterator := store.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		var s types.CoordinatorStats
+		if err := cdc.Unmarshal(iterator.Value(), &s); err != nil {
+			iterator.Close()
+			return err
+		}
+		addStats(s)
+	}
+	iterator.Close()
+
+	for _, chainStat := range chainStats {
+		s := getStats(chainStat.CoordinatorID)
+		s.ChainsCreated = chainStat.ChainsCreated
+		s.ChainsLaunched = chainStat.ChainsLaunched
+	}
+	for _, campaignStat := range campaignStats {
+		s := getStats(campaignStat.CoordinatorID)
+		s.CampaignsCreated = campaignStat.CampaignsCreated
+		s.MainnetsInitialized = campaignStat.MainnetsInitialized
+	}
+
+	for _, coordinatorID := range coordinatorIDs {
+		bz, err := cdc.Marshal(stats[coordinatorID])
+		if err != nil {
+			return err
+		}
+		store.Set(spntypes.UintBytes(coordinatorID), bz)
+	}
+
+	return nil
+}
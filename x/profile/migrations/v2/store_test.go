@@ -0,0 +1,81 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	spntypes "github.com/tendermint/spn/pkg/types"
+	tc "github.com/tendermint/spn/testutil/constructor"
+	v2 "github.com/tendermint/spn/x/profile/migrations/v2"
+	"github.com/tendermint/spn/x/profile/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	var (
+		cdc      = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+		storeKey = sdk.NewKVStoreKey(types.StoreKey)
+		tKey     = sdk.NewTransientStoreKey("transient_test")
+		ctx      = testutil.DefaultContext(storeKey, tKey)
+		store    = prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.CoordinatorStatsKeyPrefix))
+	)
+
+	// v1 fixture: the stats of the coordinators 0 and 3 are recorded with counts to rebuild
+	existing := types.CoordinatorStats{
+		CoordinatorID:       0,
+		ChainsCreated:       10,
+		ChainsLaunched:      10,
+		LaunchesReverted:    2,
+		CampaignsCreated:    10,
+		MainnetsInitialized: 10,
+		RewardsDistributed:  tc.Coins(t, "100foo"),
+	}
+	store.Set(spntypes.UintBytes(existing.CoordinatorID), cdc.MustMarshal(&existing))
+	stale := types.CoordinatorStats{
+		CoordinatorID: 3,
+		ChainsCreated: 5,
+	}
+	store.Set(spntypes.UintBytes(stale.CoordinatorID), cdc.MustMarshal(&stale))
+
+	chainStats := []types.CoordinatorStats{
+		{CoordinatorID: 0, ChainsCreated: 3, ChainsLaunched: 2},
+		{CoordinatorID: 1, ChainsCreated: 1},
+	}
+	campaignStats := []types.CoordinatorStats{
+		{CoordinatorID: 0, CampaignsCreated: 2, MainnetsInitialized: 1},
+		{CoordinatorID: 2, CampaignsCreated: 1},
+	}
+
+	require.NoError(t, v2.MigrateStore(ctx, storeKey, cdc, chainStats, campaignStats))
+
+	getStats := func(coordinatorID uint64) (stats types.CoordinatorStats) {
+		bz := store.Get(spntypes.UintBytes(coordinatorID))
+		require.NotNil(t, bz)
+		cdc.MustUnmarshal(bz, &stats)
+		return stats
+	}
+
+	t.Run("should set the counted stats and keep the other existing stats", func(t *testing.T) {
+		stats := getStats(0)
+		require.EqualValues(t, 3, stats.ChainsCreated)
+		require.EqualValues(t, 2, stats.ChainsLaunched)
+		require.EqualValues(t, 2, stats.LaunchesReverted)
+		require.EqualValues(t, 2, stats.CampaignsCreated)
+		require.EqualValues(t, 1, stats.MainnetsInitialized)
+		require.True(t, stats.RewardsDistributed.IsEqual(existing.RewardsDistributed))
+	})
+
+	t.Run("should create the stats of the coordinators with chains or campaigns", func(t *testing.T) {
+		require.Equal(t, types.CoordinatorStats{CoordinatorID: 1, ChainsCreated: 1}, getStats(1))
+		require.Equal(t, types.CoordinatorStats{CoordinatorID: 2, CampaignsCreated: 1}, getStats(2))
+	})
+
+	t.Run("should reset the counted stats of the coordinators without chains nor campaigns", func(t *testing.T) {
+		require.Equal(t, types.CoordinatorStats{CoordinatorID: 3}, getStats(3))
+	})
+}
@@ -12,10 +12,14 @@ import (
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateValidatorDescription{}, "profile/UpdateValidatorDescription", nil)
 	cdc.RegisterConcrete(&MsgAddValidatorOperatorAddress{}, "profile/AddValidatorOperatorAddress", nil)
+	cdc.RegisterConcrete(&MsgRemoveValidatorOperatorAddress{}, "profile/RemoveValidatorOperatorAddress", nil)
+	cdc.RegisterConcrete(&MsgUpdateValidatorAddress{}, "profile/UpdateValidatorAddress", nil)
 	cdc.RegisterConcrete(&MsgCreateCoordinator{}, "profile/CreateCoordinator", nil)
 	cdc.RegisterConcrete(&MsgUpdateCoordinatorDescription{}, "profile/UpdateCoordinatorDescription", nil)
 	cdc.RegisterConcrete(&MsgUpdateCoordinatorAddress{}, "profile/UpdateCoordinatorAddress", nil)
 	cdc.RegisterConcrete(&MsgDisableCoordinator{}, "profile/DisableCoordinator", nil)
+	cdc.RegisterConcrete(&MsgAddCoordinatorOperator{}, "profile/AddCoordinatorOperator", nil)
+	cdc.RegisterConcrete(&MsgRemoveCoordinatorOperator{}, "profile/RemoveCoordinatorOperator", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -23,10 +27,14 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateValidatorDescription{},
 		&MsgAddValidatorOperatorAddress{},
+		&MsgRemoveValidatorOperatorAddress{},
+		&MsgUpdateValidatorAddress{},
 		&MsgCreateCoordinator{},
 		&MsgUpdateCoordinatorDescription{},
 		&MsgUpdateCoordinatorAddress{},
 		&MsgDisableCoordinator{},
+		&MsgAddCoordinatorOperator{},
+		&MsgRemoveCoordinatorOperator{},
 	)
 	// this line is used by starport scaffolding # 3
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
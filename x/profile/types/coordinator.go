@@ -0,0 +1,104 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetOperator returns the operator of the coordinator with the specific address
+func (c Coordinator) GetOperator(address string) (CoordinatorOperator, bool) {
+	for _, operator := range c.Operators {
+		if operator.Address == address {
+			return operator, true
+		}
+	}
+	return CoordinatorOperator{}, false
+}
+
+// SetOperator adds the operator to the coordinator or replaces its permissions if it already exists
+func (c Coordinator) SetOperator(operator CoordinatorOperator) Coordinator {
+	operators := make([]CoordinatorOperator, 0, len(c.Operators)+1)
+	for _, op := range c.Operators {
+		if op.Address != operator.Address {
+			operators = append(operators, op)
+		}
+	}
+	c.Operators = append(operators, operator)
+	return c
+}
+
+// RemoveOperator removes the operator with the specific address from the coordinator
+func (c Coordinator) RemoveOperator(address string) Coordinator {
+	operators := make([]CoordinatorOperator, 0, len(c.Operators))
+	for _, op := range c.Operators {
+		if op.Address != address {
+			operators = append(operators, op)
+		}
+	}
+	c.Operators = operators
+	return c
+}
+
+// HasPermission checks if the address can perform an action on behalf of the coordinator,
+// the coordinator address has all the permissions
+func (c Coordinator) HasPermission(address string, permission CoordinatorPermission) bool {
+	if address == c.Address {
+		return true
+	}
+	operator, found := c.GetOperator(address)
+	return found && operator.HasPermission(permission)
+}
+
+// HasPermission checks if the operator has the specific permission
+func (o CoordinatorOperator) HasPermission(permission CoordinatorPermission) bool {
+	for _, p := range o.Permissions {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate checks the coordinator operator is valid
+func (o CoordinatorOperator) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(o.Address); err != nil {
+		return fmt.Errorf("invalid operator address %s: %s", o.Address, err.Error())
+	}
+
+	if len(o.Permissions) == 0 {
+		return errors.New("operator has no permission")
+	}
+
+	permissions := make(map[CoordinatorPermission]struct{})
+	for _, p := range o.Permissions {
+		if _, ok := CoordinatorPermission_name[int32(p)]; !ok {
+			return fmt.Errorf("invalid permission %d", p)
+		}
+		if _, ok := permissions[p]; ok {
+			return fmt.Errorf("duplicated permission %s", p.String())
+		}
+		permissions[p] = struct{}{}
+	}
+
+	return nil
+}
+
+// ValidateOperators checks the operators of the coordinator are valid
+func (c Coordinator) ValidateOperators() error {
+	operators := make(map[string]struct{})
+	for _, operator := range c.Operators {
+		if err := operator.Validate(); err != nil {
+			return err
+		}
+		if operator.Address == c.Address {
+			return errors.New("coordinator address can't be an operator")
+		}
+		if _, ok := operators[operator.Address]; ok {
+			return fmt.Errorf("duplicated operator %s", operator.Address)
+		}
+		operators[operator.Address] = struct{}{}
+	}
+	return nil
+}
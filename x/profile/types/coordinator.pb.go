@@ -6,6 +6,8 @@ package types
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -24,11 +26,53 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// CoordinatorPermission defines an action an operator can perform on behalf of the coordinator
+type CoordinatorPermission int32
+
+const (
+	// settle the requests of the coordinator chains
+	CoordinatorPermission_SETTLE_REQUESTS CoordinatorPermission = 0
+	// trigger or revert the launch of the coordinator chains
+	CoordinatorPermission_TRIGGER_LAUNCH CoordinatorPermission = 1
+	// edit the information of the coordinator chains
+	CoordinatorPermission_EDIT_CHAIN CoordinatorPermission = 2
+	// edit the coordinator campaigns
+	CoordinatorPermission_EDIT_CAMPAIGN CoordinatorPermission = 3
+	// mint vouchers of the coordinator campaigns
+	CoordinatorPermission_MINT_VOUCHERS CoordinatorPermission = 4
+)
+
+var CoordinatorPermission_name = map[int32]string{
+	0: "SETTLE_REQUESTS",
+	1: "TRIGGER_LAUNCH",
+	2: "EDIT_CHAIN",
+	3: "EDIT_CAMPAIGN",
+	4: "MINT_VOUCHERS",
+}
+
+var CoordinatorPermission_value = map[string]int32{
+	"SETTLE_REQUESTS": 0,
+	"TRIGGER_LAUNCH":  1,
+	"EDIT_CHAIN":      2,
+	"EDIT_CAMPAIGN":   3,
+	"MINT_VOUCHERS":   4,
+}
+
+func (x CoordinatorPermission) String() string {
+	return proto.EnumName(CoordinatorPermission_name, int32(x))
+}
+
+func (CoordinatorPermission) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8b9115302ae8cca0, []int{0}
+}
+
 type Coordinator struct {
 	CoordinatorID uint64                 `protobuf:"varint,1,opt,name=coordinatorID,proto3" json:"coordinatorID,omitempty"`
 	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 	Description   CoordinatorDescription `protobuf:"bytes,3,opt,name=description,proto3" json:"description"`
 	Active        bool                   `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"`
+	// operators are the addresses allowed to act on behalf of the coordinator with scoped permissions
+	Operators []CoordinatorOperator `protobuf:"bytes,5,rep,name=operators,proto3" json:"operators"`
 }
 
 func (m *Coordinator) Reset()         { *m = Coordinator{} }
@@ -92,6 +136,65 @@ func (m *Coordinator) GetActive() bool {
 	return false
 }
 
+func (m *Coordinator) GetOperators() []CoordinatorOperator {
+	if m != nil {
+		return m.Operators
+	}
+	return nil
+}
+
+type CoordinatorOperator struct {
+	Address     string                  `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Permissions []CoordinatorPermission `protobuf:"varint,2,rep,packed,name=permissions,proto3,enum=tendermint.spn.profile.CoordinatorPermission" json:"permissions,omitempty"`
+}
+
+func (m *CoordinatorOperator) Reset()         { *m = CoordinatorOperator{} }
+func (m *CoordinatorOperator) String() string { return proto.CompactTextString(m) }
+func (*CoordinatorOperator) ProtoMessage()    {}
+func (*CoordinatorOperator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8b9115302ae8cca0, []int{1}
+}
+func (m *CoordinatorOperator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CoordinatorOperator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CoordinatorOperator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CoordinatorOperator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CoordinatorOperator.Merge(m, src)
+}
+func (m *CoordinatorOperator) XXX_Size() int {
+	return m.Size()
+}
+func (m *CoordinatorOperator) XXX_DiscardUnknown() {
+	xxx_messageInfo_CoordinatorOperator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CoordinatorOperator proto.InternalMessageInfo
+
+func (m *CoordinatorOperator) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *CoordinatorOperator) GetPermissions() []CoordinatorPermission {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
 type CoordinatorDescription struct {
 	Identity string `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
 	Website  string `protobuf:"bytes,2,opt,name=website,proto3" json:"website,omitempty"`
@@ -102,7 +205,7 @@ func (m *CoordinatorDescription) Reset()         { *m = CoordinatorDescription{}
 func (m *CoordinatorDescription) String() string { return proto.CompactTextString(m) }
 func (*CoordinatorDescription) ProtoMessage()    {}
 func (*CoordinatorDescription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8b9115302ae8cca0, []int{1}
+	return fileDescriptor_8b9115302ae8cca0, []int{2}
 }
 func (m *CoordinatorDescription) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -161,7 +264,7 @@ func (m *CoordinatorByAddress) Reset()         { *m = CoordinatorByAddress{} }
 func (m *CoordinatorByAddress) String() string { return proto.CompactTextString(m) }
 func (*CoordinatorByAddress) ProtoMessage()    {}
 func (*CoordinatorByAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8b9115302ae8cca0, []int{2}
+	return fileDescriptor_8b9115302ae8cca0, []int{3}
 }
 func (m *CoordinatorByAddress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -204,38 +307,157 @@ func (m *CoordinatorByAddress) GetCoordinatorID() uint64 {
 	return 0
 }
 
+// CoordinatorStats tracks the record of a coordinator on the chains and campaigns it manages
+type CoordinatorStats struct {
+	CoordinatorID uint64 `protobuf:"varint,1,opt,name=coordinatorID,proto3" json:"coordinatorID,omitempty"`
+	ChainsCreated uint64 `protobuf:"varint,2,opt,name=chainsCreated,proto3" json:"chainsCreated,omitempty"`
+	// a chain is counted once even if its launch is reverted and triggered again
+	ChainsLaunched   uint64 `protobuf:"varint,3,opt,name=chainsLaunched,proto3" json:"chainsLaunched,omitempty"`
+	LaunchesReverted uint64 `protobuf:"varint,4,opt,name=launchesReverted,proto3" json:"launchesReverted,omitempty"`
+	CampaignsCreated uint64 `protobuf:"varint,5,opt,name=campaignsCreated,proto3" json:"campaignsCreated,omitempty"`
+	// a campaign is counted once even if its mainnet is reset and initialized again
+	MainnetsInitialized uint64                                   `protobuf:"varint,6,opt,name=mainnetsInitialized,proto3" json:"mainnetsInitialized,omitempty"`
+	RewardsDistributed  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=rewardsDistributed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewardsDistributed"`
+}
+
+func (m *CoordinatorStats) Reset()         { *m = CoordinatorStats{} }
+func (m *CoordinatorStats) String() string { return proto.CompactTextString(m) }
+func (*CoordinatorStats) ProtoMessage()    {}
+func (*CoordinatorStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8b9115302ae8cca0, []int{4}
+}
+func (m *CoordinatorStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CoordinatorStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CoordinatorStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CoordinatorStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CoordinatorStats.Merge(m, src)
+}
+func (m *CoordinatorStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *CoordinatorStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_CoordinatorStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CoordinatorStats proto.InternalMessageInfo
+
+func (m *CoordinatorStats) GetCoordinatorID() uint64 {
+	if m != nil {
+		return m.CoordinatorID
+	}
+	return 0
+}
+
+func (m *CoordinatorStats) GetChainsCreated() uint64 {
+	if m != nil {
+		return m.ChainsCreated
+	}
+	return 0
+}
+
+func (m *CoordinatorStats) GetChainsLaunched() uint64 {
+	if m != nil {
+		return m.ChainsLaunched
+	}
+	return 0
+}
+
+func (m *CoordinatorStats) GetLaunchesReverted() uint64 {
+	if m != nil {
+		return m.LaunchesReverted
+	}
+	return 0
+}
+
+func (m *CoordinatorStats) GetCampaignsCreated() uint64 {
+	if m != nil {
+		return m.CampaignsCreated
+	}
+	return 0
+}
+
+func (m *CoordinatorStats) GetMainnetsInitialized() uint64 {
+	if m != nil {
+		return m.MainnetsInitialized
+	}
+	return 0
+}
+
+func (m *CoordinatorStats) GetRewardsDistributed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RewardsDistributed
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("tendermint.spn.profile.CoordinatorPermission", CoordinatorPermission_name, CoordinatorPermission_value)
 	proto.RegisterType((*Coordinator)(nil), "tendermint.spn.profile.Coordinator")
+	proto.RegisterType((*CoordinatorOperator)(nil), "tendermint.spn.profile.CoordinatorOperator")
 	proto.RegisterType((*CoordinatorDescription)(nil), "tendermint.spn.profile.CoordinatorDescription")
 	proto.RegisterType((*CoordinatorByAddress)(nil), "tendermint.spn.profile.CoordinatorByAddress")
+	proto.RegisterType((*CoordinatorStats)(nil), "tendermint.spn.profile.CoordinatorStats")
 }
 
 func init() { proto.RegisterFile("profile/coordinator.proto", fileDescriptor_8b9115302ae8cca0) }
 
 var fileDescriptor_8b9115302ae8cca0 = []byte{
-	// 350 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xbd, 0x4e, 0xc3, 0x30,
-	0x18, 0x8c, 0x4b, 0xd5, 0x1f, 0x57, 0x2c, 0x56, 0x55, 0xa5, 0x1d, 0x42, 0x54, 0x31, 0x84, 0x01,
-	0x47, 0x2a, 0x4f, 0x40, 0xda, 0x85, 0xd5, 0x48, 0x0c, 0x2c, 0x28, 0x8d, 0x4d, 0x6a, 0xa9, 0xb5,
-	0x2d, 0xdb, 0xfc, 0xf4, 0x2d, 0x78, 0x18, 0x1e, 0xa2, 0x63, 0xc5, 0x02, 0x13, 0x42, 0xed, 0x8b,
-	0xa0, 0xe6, 0x87, 0x06, 0xd1, 0x81, 0x2d, 0x97, 0x3b, 0xdf, 0xf7, 0xdd, 0xe9, 0x83, 0x7d, 0xa5,
-	0xe5, 0x3d, 0x9f, 0xb3, 0x30, 0x91, 0x52, 0x53, 0x2e, 0x62, 0x2b, 0x35, 0x56, 0x5a, 0x5a, 0x89,
-	0x7a, 0x96, 0x09, 0xca, 0xf4, 0x82, 0x0b, 0x8b, 0x8d, 0x12, 0xb8, 0x50, 0x0e, 0xba, 0xa9, 0x4c,
-	0x65, 0x26, 0x09, 0x77, 0x5f, 0xb9, 0x7a, 0xd0, 0x4f, 0xa4, 0x59, 0x48, 0x73, 0x97, 0x13, 0x39,
-	0xc8, 0xa9, 0xe1, 0x3b, 0x80, 0x9d, 0xf1, 0xde, 0x1e, 0x9d, 0xc2, 0xe3, 0xca, 0xb4, 0xab, 0x89,
-	0x0b, 0x7c, 0x10, 0xd4, 0xc9, 0xef, 0x9f, 0x68, 0x04, 0x9b, 0x31, 0xa5, 0x9a, 0x19, 0xe3, 0xd6,
-	0x7c, 0x10, 0xb4, 0x23, 0xf7, 0xed, 0xf5, 0xbc, 0x5b, 0x18, 0x5f, 0xe6, 0xcc, 0xb5, 0xd5, 0x5c,
-	0xa4, 0xa4, 0x14, 0xa2, 0x1b, 0xd8, 0xa1, 0xcc, 0x24, 0x9a, 0x2b, 0xcb, 0xa5, 0x70, 0x8f, 0x7c,
-	0x10, 0x74, 0x46, 0x18, 0x1f, 0x0e, 0x82, 0x2b, 0x3b, 0x4d, 0xf6, 0xaf, 0xa2, 0xfa, 0xea, 0xf3,
-	0xc4, 0x21, 0x55, 0x23, 0xd4, 0x83, 0x8d, 0x38, 0xb1, 0xfc, 0x91, 0xb9, 0x75, 0x1f, 0x04, 0x2d,
-	0x52, 0xa0, 0xe1, 0x0c, 0xf6, 0x0e, 0x9b, 0xa0, 0x01, 0x6c, 0x71, 0xca, 0x84, 0xe5, 0x76, 0x99,
-	0xc5, 0x6b, 0x93, 0x1f, 0x8c, 0x5c, 0xd8, 0x7c, 0x62, 0x53, 0xc3, 0x2d, 0xcb, 0x93, 0x91, 0x12,
-	0xee, 0x18, 0xca, 0x6c, 0xcc, 0xe7, 0x26, 0xdb, 0xbd, 0x4d, 0x4a, 0x38, 0x54, 0xb0, 0x5b, 0x99,
-	0x14, 0x2d, 0x8b, 0x02, 0xaa, 0x2d, 0x81, 0xff, 0xb6, 0xf4, 0xa7, 0xff, 0xda, 0x81, 0xfe, 0xa3,
-	0xf1, 0x6a, 0xe3, 0x81, 0xf5, 0xc6, 0x03, 0x5f, 0x1b, 0x0f, 0xbc, 0x6c, 0x3d, 0x67, 0xbd, 0xf5,
-	0x9c, 0x8f, 0xad, 0xe7, 0xdc, 0x9e, 0xa5, 0xdc, 0xce, 0x1e, 0xa6, 0x38, 0x91, 0x8b, 0x70, 0x5f,
-	0x6d, 0x68, 0x94, 0x08, 0x9f, 0xc3, 0xf2, 0x9e, 0xec, 0x52, 0x31, 0x33, 0x6d, 0x64, 0x17, 0x70,
-	0xf1, 0x1d, 0x00, 0x00, 0xff, 0xff, 0x97, 0x1b, 0x37, 0x3c, 0x67, 0x02, 0x00, 0x00,
+	// 682 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcd, 0x4e, 0xdb, 0x4a,
+	0x14, 0x8e, 0x93, 0xf0, 0x37, 0x11, 0xb9, 0xb9, 0x03, 0x17, 0x19, 0x16, 0x21, 0x8a, 0xae, 0xae,
+	0x72, 0xa9, 0xb0, 0x21, 0x7d, 0x82, 0xc4, 0xb1, 0xc0, 0x12, 0x24, 0x74, 0x12, 0x58, 0x74, 0x13,
+	0x4d, 0xec, 0x69, 0x32, 0x6a, 0x32, 0x63, 0xcd, 0x0c, 0xa1, 0xb4, 0xcf, 0x50, 0xa9, 0xeb, 0x3e,
+	0x41, 0xd5, 0x75, 0x1f, 0x82, 0x25, 0xea, 0xaa, 0xab, 0xb6, 0x82, 0x17, 0xa9, 0xfc, 0x13, 0xe2,
+	0x82, 0x17, 0x51, 0x57, 0xf6, 0xf9, 0xce, 0x77, 0xce, 0x77, 0xce, 0x99, 0x33, 0x03, 0xb6, 0x7d,
+	0xc1, 0x5f, 0xd1, 0x31, 0x31, 0x5d, 0xce, 0x85, 0x47, 0x19, 0x56, 0x5c, 0x18, 0xbe, 0xe0, 0x8a,
+	0xc3, 0x2d, 0x45, 0x98, 0x47, 0xc4, 0x84, 0x32, 0x65, 0x48, 0x9f, 0x19, 0x31, 0x73, 0x67, 0x73,
+	0xc8, 0x87, 0x3c, 0xa4, 0x98, 0xc1, 0x5f, 0xc4, 0xde, 0xd9, 0x76, 0xb9, 0x9c, 0x70, 0xd9, 0x8f,
+	0x1c, 0x91, 0x11, 0xbb, 0xca, 0x91, 0x65, 0x0e, 0xb0, 0x24, 0xe6, 0xf4, 0x70, 0x40, 0x14, 0x3e,
+	0x34, 0x5d, 0x4e, 0x59, 0xe4, 0xaf, 0x7e, 0xca, 0x82, 0x82, 0x35, 0x97, 0x87, 0xff, 0x82, 0xf5,
+	0x44, 0x35, 0x4e, 0x4b, 0xd7, 0x2a, 0x5a, 0x2d, 0x8f, 0x7e, 0x07, 0x61, 0x1d, 0xac, 0x60, 0xcf,
+	0x13, 0x44, 0x4a, 0x3d, 0x5b, 0xd1, 0x6a, 0x6b, 0x4d, 0xfd, 0xeb, 0x97, 0xfd, 0xcd, 0x58, 0xb8,
+	0x11, 0x79, 0xba, 0x4a, 0x50, 0x36, 0x44, 0x33, 0x22, 0xbc, 0x00, 0x05, 0x8f, 0x48, 0x57, 0x50,
+	0x5f, 0x51, 0xce, 0xf4, 0x5c, 0x45, 0xab, 0x15, 0xea, 0x86, 0x91, 0xde, 0xa8, 0x91, 0xa8, 0xa9,
+	0x35, 0x8f, 0x6a, 0xe6, 0x6f, 0xbe, 0xef, 0x66, 0x50, 0x32, 0x11, 0xdc, 0x02, 0xcb, 0xd8, 0x55,
+	0x74, 0x4a, 0xf4, 0x7c, 0x45, 0xab, 0xad, 0xa2, 0xd8, 0x82, 0x1d, 0xb0, 0xc6, 0x7d, 0x22, 0x82,
+	0x0c, 0x52, 0x5f, 0xaa, 0xe4, 0x6a, 0x85, 0xfa, 0xb3, 0x05, 0xd4, 0x3a, 0x71, 0x4c, 0x2c, 0x35,
+	0xcf, 0x51, 0xfd, 0xa8, 0x81, 0x8d, 0x14, 0x62, 0x72, 0x18, 0xda, 0xa2, 0xc3, 0xe8, 0x80, 0x82,
+	0x1f, 0xd4, 0x21, 0x25, 0xe5, 0x2c, 0x18, 0x62, 0xae, 0x56, 0xac, 0xef, 0x2f, 0x50, 0xde, 0xd9,
+	0x43, 0x14, 0x4a, 0x66, 0xa8, 0x8e, 0xc0, 0x56, 0xfa, 0xc8, 0xe0, 0x0e, 0x58, 0xa5, 0x1e, 0x61,
+	0x8a, 0xaa, 0xeb, 0xa8, 0x3e, 0xf4, 0x60, 0x43, 0x1d, 0xac, 0x5c, 0x91, 0x81, 0xa4, 0x8a, 0x44,
+	0xe7, 0x88, 0x66, 0x66, 0xe0, 0xf1, 0x88, 0xc2, 0x74, 0x2c, 0xc3, 0x93, 0x5a, 0x43, 0x33, 0xb3,
+	0xea, 0x83, 0xcd, 0x84, 0x52, 0xf3, 0x3a, 0xee, 0xf0, 0x8f, 0xc6, 0xf0, 0x64, 0xdb, 0xb2, 0x29,
+	0xdb, 0x56, 0x7d, 0x9f, 0x03, 0xa5, 0x84, 0x64, 0x57, 0x61, 0x25, 0x17, 0x5c, 0xd4, 0x80, 0x35,
+	0xc2, 0x94, 0x49, 0x4b, 0x10, 0xac, 0x88, 0xf7, 0x20, 0x90, 0x04, 0xe1, 0x7f, 0xa0, 0x18, 0x01,
+	0x27, 0xf8, 0x92, 0xb9, 0x23, 0xe2, 0x85, 0x3d, 0xe7, 0xd1, 0x23, 0x14, 0xee, 0x81, 0xd2, 0x38,
+	0xfa, 0x97, 0x88, 0x4c, 0x89, 0x08, 0x12, 0xe6, 0x43, 0xe6, 0x13, 0x3c, 0xe0, 0xba, 0x78, 0xe2,
+	0x63, 0x3a, 0x9c, 0x8b, 0x2f, 0x45, 0xdc, 0xc7, 0x38, 0x3c, 0x00, 0x1b, 0x13, 0x4c, 0x19, 0x23,
+	0x4a, 0x3a, 0x8c, 0x2a, 0x8a, 0xc7, 0xf4, 0x2d, 0xf1, 0xf4, 0xe5, 0x90, 0x9e, 0xe6, 0x82, 0xef,
+	0x00, 0x14, 0xe4, 0x0a, 0x0b, 0x4f, 0xb6, 0xa8, 0x54, 0x82, 0x0e, 0x2e, 0x83, 0xfc, 0x2b, 0xe1,
+	0x96, 0x6f, 0x1b, 0xf1, 0xd0, 0x83, 0x3b, 0x6f, 0xc4, 0x77, 0xde, 0xb0, 0x38, 0x65, 0xcd, 0x83,
+	0x60, 0xa7, 0x3f, 0xff, 0xd8, 0xad, 0x0d, 0xa9, 0x1a, 0x5d, 0x0e, 0x0c, 0x97, 0x4f, 0xe2, 0xe7,
+	0x22, 0xfe, 0xec, 0x4b, 0xef, 0xb5, 0xa9, 0xae, 0x7d, 0x22, 0xc3, 0x00, 0x89, 0x52, 0x64, 0xf6,
+	0xa6, 0xe0, 0x9f, 0xd4, 0x8d, 0x84, 0x1b, 0xe0, 0xaf, 0xae, 0xdd, 0xeb, 0x9d, 0xd8, 0x7d, 0x64,
+	0xbf, 0x38, 0xb7, 0xbb, 0xbd, 0x6e, 0x29, 0x03, 0x21, 0x28, 0xf6, 0x90, 0x73, 0x74, 0x64, 0xa3,
+	0xfe, 0x49, 0xe3, 0xbc, 0x6d, 0x1d, 0x97, 0x34, 0x58, 0x04, 0xc0, 0x6e, 0x39, 0xbd, 0xbe, 0x75,
+	0xdc, 0x70, 0xda, 0xa5, 0x2c, 0xfc, 0x1b, 0xac, 0x47, 0x76, 0xe3, 0xf4, 0xac, 0xe1, 0x1c, 0xb5,
+	0x4b, 0xb9, 0x00, 0x3a, 0x75, 0xda, 0xbd, 0xfe, 0x45, 0xe7, 0xdc, 0x3a, 0xb6, 0x51, 0xb7, 0x94,
+	0x6f, 0x5a, 0x37, 0x77, 0x65, 0xed, 0xf6, 0xae, 0xac, 0xfd, 0xbc, 0x2b, 0x6b, 0x1f, 0xee, 0xcb,
+	0x99, 0xdb, 0xfb, 0x72, 0xe6, 0xdb, 0x7d, 0x39, 0xf3, 0xf2, 0xff, 0x44, 0x3f, 0xf3, 0x3b, 0x64,
+	0x4a, 0x9f, 0x99, 0x6f, 0xcc, 0xd9, 0x2b, 0x1b, 0xb6, 0x35, 0x58, 0x0e, 0xdf, 0xbd, 0xe7, 0xbf,
+	0x06, 0x00, 0x69, 0xf9, 0xb6, 0x55, 0x7d, 0x05, 0x00, 0x00,
 }
 
 func (m *Coordinator) Marshal() (dAtA []byte, err error) {
@@ -258,6 +480,20 @@ func (m *Coordinator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Operators) > 0 {
+		for iNdEx := len(m.Operators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Operators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCoordinator(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.Active {
 		i--
 		if m.Active {
@@ -293,6 +529,54 @@ func (m *Coordinator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CoordinatorOperator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CoordinatorOperator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CoordinatorOperator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Permissions) > 0 {
+		dAtA3 := make([]byte, len(m.Permissions)*10)
+		var j2 int
+		for _, num := range m.Permissions {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintCoordinator(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintCoordinator(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *CoordinatorDescription) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -372,6 +656,73 @@ func (m *CoordinatorByAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CoordinatorStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CoordinatorStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CoordinatorStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardsDistributed) > 0 {
+		for iNdEx := len(m.RewardsDistributed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardsDistributed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCoordinator(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.MainnetsInitialized != 0 {
+		i = encodeVarintCoordinator(dAtA, i, uint64(m.MainnetsInitialized))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.CampaignsCreated != 0 {
+		i = encodeVarintCoordinator(dAtA, i, uint64(m.CampaignsCreated))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LaunchesReverted != 0 {
+		i = encodeVarintCoordinator(dAtA, i, uint64(m.LaunchesReverted))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ChainsLaunched != 0 {
+		i = encodeVarintCoordinator(dAtA, i, uint64(m.ChainsLaunched))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ChainsCreated != 0 {
+		i = encodeVarintCoordinator(dAtA, i, uint64(m.ChainsCreated))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CoordinatorID != 0 {
+		i = encodeVarintCoordinator(dAtA, i, uint64(m.CoordinatorID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintCoordinator(dAtA []byte, offset int, v uint64) int {
 	offset -= sovCoordinator(v)
 	base := offset
@@ -401,6 +752,32 @@ func (m *Coordinator) Size() (n int) {
 	if m.Active {
 		n += 2
 	}
+	if len(m.Operators) > 0 {
+		for _, e := range m.Operators {
+			l = e.Size()
+			n += 1 + l + sovCoordinator(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CoordinatorOperator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovCoordinator(uint64(l))
+	}
+	if len(m.Permissions) > 0 {
+		l = 0
+		for _, e := range m.Permissions {
+			l += sovCoordinator(uint64(e))
+		}
+		n += 1 + sovCoordinator(uint64(l)) + l
+	}
 	return n
 }
 
@@ -441,6 +818,39 @@ func (m *CoordinatorByAddress) Size() (n int) {
 	return n
 }
 
+func (m *CoordinatorStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CoordinatorID != 0 {
+		n += 1 + sovCoordinator(uint64(m.CoordinatorID))
+	}
+	if m.ChainsCreated != 0 {
+		n += 1 + sovCoordinator(uint64(m.ChainsCreated))
+	}
+	if m.ChainsLaunched != 0 {
+		n += 1 + sovCoordinator(uint64(m.ChainsLaunched))
+	}
+	if m.LaunchesReverted != 0 {
+		n += 1 + sovCoordinator(uint64(m.LaunchesReverted))
+	}
+	if m.CampaignsCreated != 0 {
+		n += 1 + sovCoordinator(uint64(m.CampaignsCreated))
+	}
+	if m.MainnetsInitialized != 0 {
+		n += 1 + sovCoordinator(uint64(m.MainnetsInitialized))
+	}
+	if len(m.RewardsDistributed) > 0 {
+		for _, e := range m.RewardsDistributed {
+			l = e.Size()
+			n += 1 + l + sovCoordinator(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovCoordinator(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -580,6 +990,40 @@ func (m *Coordinator) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Active = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoordinator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoordinator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoordinator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operators = append(m.Operators, CoordinatorOperator{})
+			if err := m.Operators[len(m.Operators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCoordinator(dAtA[iNdEx:])
@@ -601,7 +1045,7 @@ func (m *Coordinator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CoordinatorDescription) Unmarshal(dAtA []byte) error {
+func (m *CoordinatorOperator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -624,15 +1068,166 @@ func (m *CoordinatorDescription) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CoordinatorDescription: wiretype end group for non-group")
+			return fmt.Errorf("proto: CoordinatorOperator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CoordinatorDescription: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CoordinatorOperator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoordinator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoordinator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoordinator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v CoordinatorPermission
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCoordinator
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= CoordinatorPermission(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Permissions = append(m.Permissions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCoordinator
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthCoordinator
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthCoordinator
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Permissions) == 0 {
+					m.Permissions = make([]CoordinatorPermission, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v CoordinatorPermission
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCoordinator
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= CoordinatorPermission(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Permissions = append(m.Permissions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCoordinator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCoordinator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CoordinatorDescription) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCoordinator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CoordinatorDescription: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CoordinatorDescription: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -848,6 +1443,204 @@ func (m *CoordinatorByAddress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CoordinatorStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCoordinator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CoordinatorStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CoordinatorStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoordinatorID", wireType)
+			}
+			m.CoordinatorID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoordinator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CoordinatorID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainsCreated", wireType)
+			}
+			m.ChainsCreated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoordinator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainsCreated |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainsLaunched", wireType)
+			}
+			m.ChainsLaunched = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoordinator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainsLaunched |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LaunchesReverted", wireType)
+			}
+			m.LaunchesReverted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoordinator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LaunchesReverted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignsCreated", wireType)
+			}
+			m.CampaignsCreated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoordinator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignsCreated |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MainnetsInitialized", wireType)
+			}
+			m.MainnetsInitialized = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoordinator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MainnetsInitialized |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsDistributed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoordinator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoordinator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoordinator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardsDistributed = append(m.RewardsDistributed, types.Coin{})
+			if err := m.RewardsDistributed[len(m.RewardsDistributed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCoordinator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCoordinator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipCoordinator(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
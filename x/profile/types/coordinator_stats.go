@@ -0,0 +1,18 @@
+package types
+
+import (
+	"fmt"
+)
+
+// NewCoordinatorStats returns empty statistics for the coordinator
+func NewCoordinatorStats(coordinatorID uint64) CoordinatorStats {
+	return CoordinatorStats{CoordinatorID: coordinatorID}
+}
+
+// Validate checks the coordinator statistics are valid
+func (s CoordinatorStats) Validate() error {
+	if err := s.RewardsDistributed.Validate(); err != nil {
+		return fmt.Errorf("invalid rewards distributed: %s", err.Error())
+	}
+	return nil
+}
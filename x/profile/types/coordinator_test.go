@@ -0,0 +1,130 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/profile/types"
+)
+
+func TestCoordinator_Operators(t *testing.T) {
+	var (
+		coordAddr = sample.Address(r)
+		op1       = types.CoordinatorOperator{
+			Address:     sample.Address(r),
+			Permissions: []types.CoordinatorPermission{types.CoordinatorPermission_SETTLE_REQUESTS},
+		}
+		op2 = types.CoordinatorOperator{
+			Address:     sample.Address(r),
+			Permissions: []types.CoordinatorPermission{types.CoordinatorPermission_MINT_VOUCHERS},
+		}
+		coord = types.Coordinator{Address: coordAddr}
+	)
+
+	t.Run("should add operators", func(t *testing.T) {
+		coord = coord.SetOperator(op1).SetOperator(op2)
+		require.Equal(t, []types.CoordinatorOperator{op1, op2}, coord.Operators)
+	})
+
+	t.Run("should replace the permissions of an existing operator", func(t *testing.T) {
+		op1.Permissions = []types.CoordinatorPermission{types.CoordinatorPermission_EDIT_CHAIN}
+		coord = coord.SetOperator(op1)
+		got, found := coord.GetOperator(op1.Address)
+		require.True(t, found)
+		require.Equal(t, op1, got)
+		require.Len(t, coord.Operators, 2)
+	})
+
+	t.Run("should check the permissions", func(t *testing.T) {
+		require.True(t, coord.HasPermission(coordAddr, types.CoordinatorPermission_TRIGGER_LAUNCH))
+		require.True(t, coord.HasPermission(op1.Address, types.CoordinatorPermission_EDIT_CHAIN))
+		require.False(t, coord.HasPermission(op1.Address, types.CoordinatorPermission_SETTLE_REQUESTS))
+		require.True(t, coord.HasPermission(op2.Address, types.CoordinatorPermission_MINT_VOUCHERS))
+		require.False(t, coord.HasPermission(sample.Address(r), types.CoordinatorPermission_MINT_VOUCHERS))
+	})
+
+	t.Run("should remove an operator", func(t *testing.T) {
+		coord = coord.RemoveOperator(op1.Address)
+		_, found := coord.GetOperator(op1.Address)
+		require.False(t, found)
+		require.Equal(t, []types.CoordinatorOperator{op2}, coord.Operators)
+	})
+}
+
+func TestCoordinator_ValidateOperators(t *testing.T) {
+	var (
+		coordAddr = sample.Address(r)
+		opAddr    = sample.Address(r)
+		perms     = []types.CoordinatorPermission{types.CoordinatorPermission_SETTLE_REQUESTS}
+	)
+
+	for _, tt := range []struct {
+		name      string
+		operators []types.CoordinatorOperator
+		valid     bool
+	}{
+		{
+			name:  "should validate no operators",
+			valid: true,
+		},
+		{
+			name: "should validate valid operators",
+			operators: []types.CoordinatorOperator{
+				{Address: opAddr, Permissions: perms},
+				{Address: sample.Address(r), Permissions: []types.CoordinatorPermission{
+					types.CoordinatorPermission_EDIT_CAMPAIGN,
+					types.CoordinatorPermission_MINT_VOUCHERS,
+				}},
+			},
+			valid: true,
+		},
+		{
+			name:      "should prevent validate operator with invalid address",
+			operators: []types.CoordinatorOperator{{Address: "invalid", Permissions: perms}},
+		},
+		{
+			name:      "should prevent validate operator without permission",
+			operators: []types.CoordinatorOperator{{Address: opAddr}},
+		},
+		{
+			name: "should prevent validate operator with invalid permission",
+			operators: []types.CoordinatorOperator{{
+				Address:     opAddr,
+				Permissions: []types.CoordinatorPermission{types.CoordinatorPermission(1000)},
+			}},
+		},
+		{
+			name: "should prevent validate operator with duplicated permission",
+			operators: []types.CoordinatorOperator{{
+				Address: opAddr,
+				Permissions: []types.CoordinatorPermission{
+					types.CoordinatorPermission_SETTLE_REQUESTS,
+					types.CoordinatorPermission_SETTLE_REQUESTS,
+				},
+			}},
+		},
+		{
+			name:      "should prevent validate the coordinator address as operator",
+			operators: []types.CoordinatorOperator{{Address: coordAddr, Permissions: perms}},
+		},
+		{
+			name: "should prevent validate duplicated operators",
+			operators: []types.CoordinatorOperator{
+				{Address: opAddr, Permissions: perms},
+				{Address: opAddr, Permissions: perms},
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			coord := types.Coordinator{Address: coordAddr, Operators: tt.operators}
+			err := coord.ValidateOperators()
+			if !tt.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -8,10 +8,15 @@ import (
 
 // x/profile module sentinel errors
 var (
-	ErrCoordAlreadyExist    = sdkerrors.Register(ModuleName, 2, "coordinator address already exist")
-	ErrCoordAddressNotFound = sdkerrors.Register(ModuleName, 3, "coordinator address not found")
-	ErrCoordInvalid         = sdkerrors.Register(ModuleName, 4, "invalid coordinator")
-	ErrEmptyDescription     = sdkerrors.Register(ModuleName, 5, "you must provide at least one description parameter")
-	ErrDupAddress           = sdkerrors.Register(ModuleName, 6, "address is duplicated")
-	ErrCoordInactive        = sdkerrors.Register(ModuleName, 7, "inactive coordinator")
+	ErrCoordAlreadyExist     = sdkerrors.Register(ModuleName, 2, "coordinator address already exist")
+	ErrCoordAddressNotFound  = sdkerrors.Register(ModuleName, 3, "coordinator address not found")
+	ErrCoordInvalid          = sdkerrors.Register(ModuleName, 4, "invalid coordinator")
+	ErrEmptyDescription      = sdkerrors.Register(ModuleName, 5, "you must provide at least one description parameter")
+	ErrDupAddress            = sdkerrors.Register(ModuleName, 6, "address is duplicated")
+	ErrCoordInactive         = sdkerrors.Register(ModuleName, 7, "inactive coordinator")
+	ErrInvalidCoordOperator  = sdkerrors.Register(ModuleName, 8, "invalid coordinator operator")
+	ErrCoordOperatorNotFound = sdkerrors.Register(ModuleName, 9, "coordinator operator not found")
+	ErrValidatorNotFound     = sdkerrors.Register(ModuleName, 10, "validator not found")
+	ErrOperatorAddrNotFound  = sdkerrors.Register(ModuleName, 11, "validator operator address not found")
+	ErrValidatorAlreadyExist = sdkerrors.Register(ModuleName, 12, "validator address already exist")
 )
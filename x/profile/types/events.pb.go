@@ -180,6 +180,118 @@ func (m *EventCoordinatorDisabled) GetAddress() string {
 	return ""
 }
 
+type EventCoordinatorOperatorAdded struct {
+	CoordinatorID uint64                  `protobuf:"varint,1,opt,name=coordinatorID,proto3" json:"coordinatorID,omitempty"`
+	Operator      string                  `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Permissions   []CoordinatorPermission `protobuf:"varint,3,rep,packed,name=permissions,proto3,enum=tendermint.spn.profile.CoordinatorPermission" json:"permissions,omitempty"`
+}
+
+func (m *EventCoordinatorOperatorAdded) Reset()         { *m = EventCoordinatorOperatorAdded{} }
+func (m *EventCoordinatorOperatorAdded) String() string { return proto.CompactTextString(m) }
+func (*EventCoordinatorOperatorAdded) ProtoMessage()    {}
+func (*EventCoordinatorOperatorAdded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f195f0d2c25dc7b, []int{3}
+}
+func (m *EventCoordinatorOperatorAdded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCoordinatorOperatorAdded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCoordinatorOperatorAdded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCoordinatorOperatorAdded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCoordinatorOperatorAdded.Merge(m, src)
+}
+func (m *EventCoordinatorOperatorAdded) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCoordinatorOperatorAdded) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCoordinatorOperatorAdded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCoordinatorOperatorAdded proto.InternalMessageInfo
+
+func (m *EventCoordinatorOperatorAdded) GetCoordinatorID() uint64 {
+	if m != nil {
+		return m.CoordinatorID
+	}
+	return 0
+}
+
+func (m *EventCoordinatorOperatorAdded) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *EventCoordinatorOperatorAdded) GetPermissions() []CoordinatorPermission {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
+type EventCoordinatorOperatorRemoved struct {
+	CoordinatorID uint64 `protobuf:"varint,1,opt,name=coordinatorID,proto3" json:"coordinatorID,omitempty"`
+	Operator      string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+}
+
+func (m *EventCoordinatorOperatorRemoved) Reset()         { *m = EventCoordinatorOperatorRemoved{} }
+func (m *EventCoordinatorOperatorRemoved) String() string { return proto.CompactTextString(m) }
+func (*EventCoordinatorOperatorRemoved) ProtoMessage()    {}
+func (*EventCoordinatorOperatorRemoved) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f195f0d2c25dc7b, []int{4}
+}
+func (m *EventCoordinatorOperatorRemoved) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCoordinatorOperatorRemoved) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCoordinatorOperatorRemoved.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCoordinatorOperatorRemoved) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCoordinatorOperatorRemoved.Merge(m, src)
+}
+func (m *EventCoordinatorOperatorRemoved) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCoordinatorOperatorRemoved) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCoordinatorOperatorRemoved.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCoordinatorOperatorRemoved proto.InternalMessageInfo
+
+func (m *EventCoordinatorOperatorRemoved) GetCoordinatorID() uint64 {
+	if m != nil {
+		return m.CoordinatorID
+	}
+	return 0
+}
+
+func (m *EventCoordinatorOperatorRemoved) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
 type EventValidatorCreated struct {
 	Address           string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	OperatorAddresses []string `protobuf:"bytes,2,rep,name=operatorAddresses,proto3" json:"operatorAddresses,omitempty"`
@@ -189,7 +301,7 @@ func (m *EventValidatorCreated) Reset()         { *m = EventValidatorCreated{} }
 func (m *EventValidatorCreated) String() string { return proto.CompactTextString(m) }
 func (*EventValidatorCreated) ProtoMessage()    {}
 func (*EventValidatorCreated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f195f0d2c25dc7b, []int{3}
+	return fileDescriptor_2f195f0d2c25dc7b, []int{5}
 }
 func (m *EventValidatorCreated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -243,7 +355,7 @@ func (m *EventValidatorOperatorAddressesUpdated) Reset() {
 func (m *EventValidatorOperatorAddressesUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventValidatorOperatorAddressesUpdated) ProtoMessage()    {}
 func (*EventValidatorOperatorAddressesUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f195f0d2c25dc7b, []int{4}
+	return fileDescriptor_2f195f0d2c25dc7b, []int{6}
 }
 func (m *EventValidatorOperatorAddressesUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -286,40 +398,109 @@ func (m *EventValidatorOperatorAddressesUpdated) GetOperatorAddresses() []string
 	return nil
 }
 
+type EventValidatorAddressUpdated struct {
+	Address           string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	NewAddress        string   `protobuf:"bytes,2,opt,name=newAddress,proto3" json:"newAddress,omitempty"`
+	OperatorAddresses []string `protobuf:"bytes,3,rep,name=operatorAddresses,proto3" json:"operatorAddresses,omitempty"`
+}
+
+func (m *EventValidatorAddressUpdated) Reset()         { *m = EventValidatorAddressUpdated{} }
+func (m *EventValidatorAddressUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventValidatorAddressUpdated) ProtoMessage()    {}
+func (*EventValidatorAddressUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f195f0d2c25dc7b, []int{7}
+}
+func (m *EventValidatorAddressUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventValidatorAddressUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventValidatorAddressUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventValidatorAddressUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventValidatorAddressUpdated.Merge(m, src)
+}
+func (m *EventValidatorAddressUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventValidatorAddressUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventValidatorAddressUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventValidatorAddressUpdated proto.InternalMessageInfo
+
+func (m *EventValidatorAddressUpdated) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EventValidatorAddressUpdated) GetNewAddress() string {
+	if m != nil {
+		return m.NewAddress
+	}
+	return ""
+}
+
+func (m *EventValidatorAddressUpdated) GetOperatorAddresses() []string {
+	if m != nil {
+		return m.OperatorAddresses
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*EventCoordinatorCreated)(nil), "tendermint.spn.profile.EventCoordinatorCreated")
 	proto.RegisterType((*EventCoordinatorAddressUpdated)(nil), "tendermint.spn.profile.EventCoordinatorAddressUpdated")
 	proto.RegisterType((*EventCoordinatorDisabled)(nil), "tendermint.spn.profile.EventCoordinatorDisabled")
+	proto.RegisterType((*EventCoordinatorOperatorAdded)(nil), "tendermint.spn.profile.EventCoordinatorOperatorAdded")
+	proto.RegisterType((*EventCoordinatorOperatorRemoved)(nil), "tendermint.spn.profile.EventCoordinatorOperatorRemoved")
 	proto.RegisterType((*EventValidatorCreated)(nil), "tendermint.spn.profile.EventValidatorCreated")
 	proto.RegisterType((*EventValidatorOperatorAddressesUpdated)(nil), "tendermint.spn.profile.EventValidatorOperatorAddressesUpdated")
+	proto.RegisterType((*EventValidatorAddressUpdated)(nil), "tendermint.spn.profile.EventValidatorAddressUpdated")
 }
 
 func init() { proto.RegisterFile("profile/events.proto", fileDescriptor_2f195f0d2c25dc7b) }
 
 var fileDescriptor_2f195f0d2c25dc7b = []byte{
-	// 345 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x29, 0x28, 0xca, 0x4f,
-	0xcb, 0xcc, 0x49, 0xd5, 0x4f, 0x2d, 0x4b, 0xcd, 0x2b, 0x29, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x12, 0x2b, 0x49, 0xcd, 0x4b, 0x49, 0x2d, 0xca, 0xcd, 0xcc, 0x2b, 0xd1, 0x2b, 0x2e, 0xc8,
-	0xd3, 0x83, 0x2a, 0x92, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b, 0xd1, 0x07, 0xb1, 0x20, 0xaa,
-	0xa5, 0x24, 0x93, 0xf3, 0x8b, 0x73, 0xf3, 0x8b, 0xe3, 0x21, 0x12, 0x10, 0x0e, 0x4c, 0x0a, 0x66,
-	0x7c, 0x72, 0x7e, 0x7e, 0x51, 0x4a, 0x66, 0x5e, 0x62, 0x49, 0x7e, 0x11, 0x54, 0x4a, 0x1c, 0x26,
-	0x55, 0x96, 0x98, 0x93, 0x99, 0x82, 0x90, 0x50, 0x2a, 0xe6, 0x12, 0x77, 0x05, 0x39, 0xc6, 0x19,
-	0xa1, 0xc5, 0xb9, 0x28, 0x35, 0xb1, 0x24, 0x35, 0x45, 0x48, 0x85, 0x8b, 0x17, 0xc9, 0x20, 0x4f,
-	0x17, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x96, 0x20, 0x54, 0x41, 0x21, 0x23, 0x2e, 0xf6, 0xc4, 0x94,
-	0x94, 0xa2, 0xd4, 0xe2, 0x62, 0x09, 0x26, 0x05, 0x46, 0x0d, 0x4e, 0x27, 0x89, 0x4b, 0x5b, 0x74,
-	0x45, 0xa0, 0xee, 0x72, 0x84, 0xc8, 0x04, 0x97, 0x14, 0x65, 0xe6, 0xa5, 0x07, 0xc1, 0x14, 0x2a,
-	0x35, 0x30, 0x72, 0xc9, 0xa1, 0xdb, 0x0a, 0x55, 0x1a, 0x5a, 0x90, 0x42, 0x82, 0xe5, 0x16, 0x5c,
-	0x5c, 0x79, 0xa9, 0xe5, 0x8e, 0x44, 0xda, 0x8f, 0xa4, 0x56, 0xa9, 0x84, 0x4b, 0x02, 0xdd, 0x05,
-	0x2e, 0x99, 0xc5, 0x89, 0x49, 0x39, 0x34, 0xf5, 0x78, 0x25, 0x97, 0x28, 0xd8, 0xd6, 0x30, 0x58,
-	0x2c, 0xc0, 0xc2, 0x1a, 0xc9, 0x30, 0x46, 0x22, 0x0d, 0x13, 0xd2, 0xe1, 0x12, 0xcc, 0x2f, 0x48,
-	0x2d, 0x42, 0x0a, 0xbc, 0x54, 0x90, 0x53, 0x98, 0x35, 0x38, 0x83, 0x30, 0x25, 0x94, 0xba, 0x18,
-	0xb9, 0xd4, 0x50, 0xed, 0xf6, 0x47, 0x57, 0x03, 0x0b, 0x7b, 0x9a, 0x3b, 0xc6, 0xc9, 0xf9, 0xc4,
-	0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1,
-	0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x34, 0xd3, 0x33, 0x4b, 0x32, 0x4a, 0x93,
-	0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x11, 0xf9, 0x42, 0xbf, 0xb8, 0x20, 0x4f, 0xbf, 0x42, 0x1f, 0x96,
-	0x88, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x29, 0xd8, 0x18, 0x10, 0x00, 0x00, 0xff,
-	0xff, 0xe2, 0x71, 0xbb, 0x3a, 0x56, 0x03, 0x00, 0x00,
+	// 436 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcd, 0xce, 0xd2, 0x40,
+	0x14, 0x65, 0xac, 0x51, 0xb9, 0x46, 0x13, 0x1b, 0x94, 0x4a, 0xb4, 0x92, 0xc6, 0x98, 0x9a, 0x48,
+	0x9b, 0xa0, 0x0b, 0xb7, 0xfc, 0xb8, 0x70, 0x85, 0xa9, 0xd1, 0x85, 0x1b, 0x53, 0xe8, 0x58, 0x27,
+	0xa1, 0x33, 0x93, 0x99, 0x11, 0x65, 0x61, 0xe2, 0xda, 0x95, 0x0f, 0xe3, 0xca, 0x27, 0x70, 0xe1,
+	0x82, 0xb8, 0x72, 0x69, 0xe0, 0x45, 0x4c, 0x69, 0x87, 0x96, 0x0a, 0xf9, 0xf8, 0x48, 0xd8, 0xc1,
+	0x9c, 0x73, 0xef, 0x39, 0xb7, 0xf7, 0xcc, 0x40, 0x83, 0x0b, 0xf6, 0x8e, 0x4c, 0xb1, 0x8f, 0x67,
+	0x98, 0x2a, 0xe9, 0x71, 0xc1, 0x14, 0x33, 0x6f, 0x29, 0x4c, 0x23, 0x2c, 0x12, 0x42, 0x95, 0x27,
+	0x39, 0xf5, 0x72, 0x52, 0xab, 0x11, 0xb3, 0x98, 0xad, 0x29, 0x7e, 0xfa, 0x2b, 0x63, 0xb7, 0x6e,
+	0x4f, 0x98, 0x4c, 0x98, 0x7c, 0x9b, 0x01, 0xd9, 0x1f, 0x0d, 0xe9, 0xf6, 0x13, 0xc6, 0x44, 0x44,
+	0x68, 0xa8, 0x98, 0xc8, 0xa1, 0xa6, 0x86, 0x66, 0xe1, 0x94, 0x44, 0x05, 0xe0, 0x48, 0x68, 0x3e,
+	0x4b, 0xcd, 0x0c, 0x8a, 0x92, 0x81, 0xc0, 0xa1, 0xc2, 0x91, 0x79, 0x1f, 0xae, 0x95, 0x1a, 0x3d,
+	0x1f, 0x5a, 0xa8, 0x8d, 0xdc, 0x8b, 0xc1, 0xf6, 0xa1, 0xd9, 0x85, 0xcb, 0x61, 0x14, 0x09, 0x2c,
+	0xa5, 0x75, 0xa1, 0x8d, 0xdc, 0x7a, 0xdf, 0xfa, 0xfd, 0xbd, 0xd3, 0xc8, 0x7d, 0xf5, 0x32, 0xe4,
+	0xa5, 0x12, 0x84, 0xc6, 0x81, 0x26, 0x3a, 0x5f, 0x10, 0xd8, 0x55, 0xd5, 0x9c, 0xfa, 0x8a, 0x47,
+	0xe7, 0x10, 0x7f, 0x0a, 0x40, 0xf1, 0xc7, 0xde, 0x81, 0xfa, 0x25, 0xae, 0xa3, 0xc0, 0xaa, 0x3a,
+	0x18, 0x12, 0x19, 0x8e, 0xa7, 0x27, 0x1d, 0xfc, 0x17, 0x82, 0xbb, 0x55, 0xd9, 0x11, 0xc7, 0x22,
+	0xff, 0x00, 0x07, 0x6b, 0x3f, 0x81, 0x2b, 0x2c, 0x2f, 0x3b, 0x53, 0x7c, 0xc3, 0x34, 0x47, 0x70,
+	0x95, 0xa7, 0x39, 0x93, 0x92, 0x30, 0x2a, 0x2d, 0xa3, 0x6d, 0xb8, 0xd7, 0xbb, 0x1d, 0x6f, 0x77,
+	0xfc, 0xbc, 0x92, 0xc5, 0x17, 0x9b, 0xaa, 0xa0, 0xdc, 0xc1, 0xf9, 0x0c, 0xf7, 0xf6, 0x4d, 0x13,
+	0xe0, 0x84, 0xcd, 0x4e, 0x3b, 0x8f, 0x33, 0x87, 0x9b, 0x6b, 0xf9, 0xd7, 0x3a, 0xd3, 0x3a, 0xb9,
+	0xa5, 0xd5, 0xa0, 0x03, 0x57, 0x63, 0x3e, 0x82, 0x1b, 0xac, 0xd8, 0x44, 0x7a, 0x84, 0xd3, 0xc5,
+	0x1a, 0x6e, 0x3d, 0xf8, 0x1f, 0x70, 0xbe, 0x22, 0x78, 0xb0, 0xad, 0x3d, 0xaa, 0x72, 0x74, 0x92,
+	0x4f, 0x6f, 0xe6, 0x07, 0x82, 0x3b, 0xdb, 0x66, 0x2a, 0x97, 0xe9, 0x18, 0x0b, 0x47, 0x5f, 0xad,
+	0xdd, 0xe6, 0x8d, 0x3d, 0xe6, 0xfb, 0x83, 0x9f, 0x4b, 0x1b, 0x2d, 0x96, 0x36, 0xfa, 0xbb, 0xb4,
+	0xd1, 0xb7, 0x95, 0x5d, 0x5b, 0xac, 0xec, 0xda, 0x9f, 0x95, 0x5d, 0x7b, 0xf3, 0x30, 0x26, 0xea,
+	0xfd, 0x87, 0xb1, 0x37, 0x61, 0x89, 0x5f, 0x64, 0xd4, 0x97, 0x9c, 0xfa, 0x9f, 0x7c, 0xfd, 0x9e,
+	0xa9, 0x39, 0xc7, 0x72, 0x7c, 0x69, 0xfd, 0x98, 0x3d, 0xfe, 0x37, 0x00, 0xa3, 0xb1, 0x07, 0xb1,
+	0x61, 0x05, 0x00, 0x00,
 }
 
 func (m *EventCoordinatorCreated) Marshal() (dAtA []byte, err error) {
@@ -427,6 +608,94 @@ func (m *EventCoordinatorDisabled) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *EventCoordinatorOperatorAdded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCoordinatorOperatorAdded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCoordinatorOperatorAdded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Permissions) > 0 {
+		dAtA2 := make([]byte, len(m.Permissions)*10)
+		var j1 int
+		for _, num := range m.Permissions {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintEvents(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CoordinatorID != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.CoordinatorID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCoordinatorOperatorRemoved) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCoordinatorOperatorRemoved) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCoordinatorOperatorRemoved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CoordinatorID != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.CoordinatorID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventValidatorCreated) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -505,6 +774,52 @@ func (m *EventValidatorOperatorAddressesUpdated) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
+func (m *EventValidatorAddressUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventValidatorAddressUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventValidatorAddressUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OperatorAddresses) > 0 {
+		for iNdEx := len(m.OperatorAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OperatorAddresses[iNdEx])
+			copy(dAtA[i:], m.OperatorAddresses[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.OperatorAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.NewAddress) > 0 {
+		i -= len(m.NewAddress)
+		copy(dAtA[i:], m.NewAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -564,6 +879,45 @@ func (m *EventCoordinatorDisabled) Size() (n int) {
 	return n
 }
 
+func (m *EventCoordinatorOperatorAdded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CoordinatorID != 0 {
+		n += 1 + sovEvents(uint64(m.CoordinatorID))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Permissions) > 0 {
+		l = 0
+		for _, e := range m.Permissions {
+			l += sovEvents(uint64(e))
+		}
+		n += 1 + sovEvents(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *EventCoordinatorOperatorRemoved) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CoordinatorID != 0 {
+		n += 1 + sovEvents(uint64(m.CoordinatorID))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func (m *EventValidatorCreated) Size() (n int) {
 	if m == nil {
 		return 0
@@ -580,35 +934,260 @@ func (m *EventValidatorCreated) Size() (n int) {
 			n += 1 + l + sovEvents(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func (m *EventValidatorOperatorAddressesUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.OperatorAddresses) > 0 {
+		for _, s := range m.OperatorAddresses {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventValidatorAddressUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NewAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.OperatorAddresses) > 0 {
+		for _, s := range m.OperatorAddresses {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventCoordinatorCreated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCoordinatorCreated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCoordinatorCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoordinatorID", wireType)
+			}
+			m.CoordinatorID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CoordinatorID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCoordinatorAddressUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCoordinatorAddressUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCoordinatorAddressUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoordinatorID", wireType)
+			}
+			m.CoordinatorID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CoordinatorID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *EventValidatorOperatorAddressesUpdated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if len(m.OperatorAddresses) > 0 {
-		for _, s := range m.OperatorAddresses {
-			l = len(s)
-			n += 1 + l + sovEvents(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *EventCoordinatorCreated) Unmarshal(dAtA []byte) error {
+func (m *EventCoordinatorDisabled) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -631,10 +1210,10 @@ func (m *EventCoordinatorCreated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCoordinatorCreated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCoordinatorDisabled: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCoordinatorCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCoordinatorDisabled: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -709,7 +1288,7 @@ func (m *EventCoordinatorCreated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCoordinatorAddressUpdated) Unmarshal(dAtA []byte) error {
+func (m *EventCoordinatorOperatorAdded) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -732,10 +1311,10 @@ func (m *EventCoordinatorAddressUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCoordinatorAddressUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCoordinatorOperatorAdded: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCoordinatorAddressUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCoordinatorOperatorAdded: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -759,7 +1338,7 @@ func (m *EventCoordinatorAddressUpdated) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -787,8 +1366,77 @@ func (m *EventCoordinatorAddressUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewAddress = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v CoordinatorPermission
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= CoordinatorPermission(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Permissions = append(m.Permissions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEvents
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEvents
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Permissions) == 0 {
+					m.Permissions = make([]CoordinatorPermission, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v CoordinatorPermission
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= CoordinatorPermission(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Permissions = append(m.Permissions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -810,7 +1458,7 @@ func (m *EventCoordinatorAddressUpdated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCoordinatorDisabled) Unmarshal(dAtA []byte) error {
+func (m *EventCoordinatorOperatorRemoved) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -833,10 +1481,10 @@ func (m *EventCoordinatorDisabled) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCoordinatorDisabled: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCoordinatorOperatorRemoved: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCoordinatorDisabled: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCoordinatorOperatorRemoved: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -860,7 +1508,7 @@ func (m *EventCoordinatorDisabled) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -888,7 +1536,7 @@ func (m *EventCoordinatorDisabled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1139,6 +1787,152 @@ func (m *EventValidatorOperatorAddressesUpdated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventValidatorAddressUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventValidatorAddressUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventValidatorAddressUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddresses = append(m.OperatorAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -12,3 +12,11 @@ type AccountKeeper interface {
 type BankKeeper interface {
 	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 }
+
+type LaunchKeeper interface {
+	CountCoordinatorChains(ctx sdk.Context) []CoordinatorStats
+}
+
+type CampaignKeeper interface {
+	CountCoordinatorCampaigns(ctx sdk.Context) []CoordinatorStats
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	"github.com/pkg/errors"
 	// this line is used by starport scaffolding # genesis/types/import
 )
@@ -14,6 +16,7 @@ func DefaultGenesis() *GenesisState {
 		CoordinatorList:                []Coordinator{},
 		CoordinatorCounter:             1,
 		CoordinatorByAddressList:       []CoordinatorByAddress{},
+		CoordinatorStatsList:           []CoordinatorStats{},
 	}
 }
 
@@ -41,7 +44,7 @@ func (gs GenesisState) ValidateValidators() error {
 	}
 
 	// Check for duplicated index in validatorByOperatorAddress
-	validatorByOperatorAddressIndexMap := make(map[string]struct{})
+	validatorByOperatorAddressIndexMap := make(map[string]string)
 	for _, elem := range gs.ValidatorByOperatorAddressList {
 		index := string(CoordinatorByAddressKey(elem.OperatorAddress))
 		if _, ok := validatorByOperatorAddressIndexMap[index]; ok {
@@ -55,7 +58,17 @@ func (gs GenesisState) ValidateValidators() error {
 		if !validator.HasOperatorAddress(elem.OperatorAddress) {
 			return errors.New("operator address not found in the Validator operator address list")
 		}
-		validatorByOperatorAddressIndexMap[index] = struct{}{}
+		validatorByOperatorAddressIndexMap[index] = elem.ValidatorAddress
+	}
+
+	// Check each operator address of the validators is indexed
+	for _, elem := range gs.ValidatorList {
+		for _, opAddr := range elem.OperatorAddresses {
+			index := string(CoordinatorByAddressKey(opAddr))
+			if valAddr, ok := validatorByOperatorAddressIndexMap[index]; !ok || valAddr != elem.Address {
+				return errors.New("validator operator address not found in validatorByOperatorAddress")
+			}
+		}
 	}
 
 	return nil
@@ -82,6 +95,9 @@ func (gs GenesisState) ValidateCoordinators() error {
 		if elem.CoordinatorID >= counter {
 			return errors.New("coordinator id should be lower or equal than the last id")
 		}
+		if err := elem.ValidateOperators(); err != nil {
+			return errors.Wrapf(err, "invalid operators for coordinator %d", elem.CoordinatorID)
+		}
 		index := string(CoordinatorByAddressKey(elem.Address))
 		_, found := coordinatorByAddressIndexMap[index]
 
@@ -101,5 +117,20 @@ func (gs GenesisState) ValidateCoordinators() error {
 	if len(coordinatorByAddressIndexMap) > 0 {
 		return errors.New("coordinator address not found for coordinatorID")
 	}
+
+	// Check for duplicated ID in coordinator stats and if the coordinator exists
+	coordinatorStatsIDMap := make(map[uint64]struct{})
+	for _, elem := range gs.CoordinatorStatsList {
+		if _, ok := coordinatorStatsIDMap[elem.CoordinatorID]; ok {
+			return errors.New("duplicated id for coordinator stats")
+		}
+		if _, ok := coordinatorIDMap[elem.CoordinatorID]; !ok {
+			return fmt.Errorf("coordinator %d not found for coordinator stats", elem.CoordinatorID)
+		}
+		if err := elem.Validate(); err != nil {
+			return errors.Wrapf(err, "invalid stats for coordinator %d", elem.CoordinatorID)
+		}
+		coordinatorStatsIDMap[elem.CoordinatorID] = struct{}{}
+	}
 	return nil
 }
@@ -30,6 +30,7 @@ type GenesisState struct {
 	CoordinatorList                []Coordinator                `protobuf:"bytes,3,rep,name=coordinatorList,proto3" json:"coordinatorList"`
 	CoordinatorCounter             uint64                       `protobuf:"varint,4,opt,name=coordinatorCounter,proto3" json:"coordinatorCounter,omitempty"`
 	CoordinatorByAddressList       []CoordinatorByAddress       `protobuf:"bytes,5,rep,name=coordinatorByAddressList,proto3" json:"coordinatorByAddressList"`
+	CoordinatorStatsList           []CoordinatorStats           `protobuf:"bytes,6,rep,name=coordinatorStatsList,proto3" json:"coordinatorStatsList"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -100,6 +101,13 @@ func (m *GenesisState) GetCoordinatorByAddressList() []CoordinatorByAddress {
 	return nil
 }
 
+func (m *GenesisState) GetCoordinatorStatsList() []CoordinatorStats {
+	if m != nil {
+		return m.CoordinatorStatsList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "tendermint.spn.profile.GenesisState")
 }
@@ -107,28 +115,29 @@ func init() {
 func init() { proto.RegisterFile("profile/genesis.proto", fileDescriptor_db4bc1562021cf42) }
 
 var fileDescriptor_db4bc1562021cf42 = []byte{
-	// 321 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x2d, 0x28, 0xca, 0x4f,
-	0xcb, 0xcc, 0x49, 0xd5, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x12, 0x2b, 0x49, 0xcd, 0x4b, 0x49, 0x2d, 0xca, 0xcd, 0xcc, 0x2b, 0xd1, 0x2b, 0x2e,
-	0xc8, 0xd3, 0x83, 0xaa, 0x92, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b, 0xd1, 0x07, 0xb1, 0x20,
-	0xaa, 0xa5, 0xc4, 0x61, 0x86, 0x94, 0x25, 0xe6, 0x64, 0xa6, 0x24, 0x96, 0xe4, 0x17, 0x41, 0x25,
-	0x24, 0x61, 0x12, 0xc9, 0xf9, 0xf9, 0x45, 0x29, 0x99, 0x79, 0x08, 0x29, 0xa5, 0xab, 0xcc, 0x5c,
-	0x3c, 0xee, 0x10, 0x3b, 0x83, 0x4b, 0x12, 0x4b, 0x52, 0x85, 0x7c, 0xb9, 0x78, 0xe1, 0xda, 0x7d,
-	0x32, 0x8b, 0x4b, 0x24, 0x18, 0x15, 0x98, 0x35, 0xb8, 0x8d, 0x14, 0xf5, 0xb0, 0x3b, 0x45, 0x2f,
-	0x0c, 0xa6, 0xd8, 0x89, 0xe5, 0xc4, 0x3d, 0x79, 0x86, 0x20, 0x54, 0xdd, 0x42, 0x0d, 0x8c, 0x5c,
-	0x72, 0x70, 0x11, 0xa7, 0x4a, 0xff, 0x82, 0xd4, 0x22, 0x10, 0xcb, 0x31, 0x25, 0xa5, 0x28, 0xb5,
-	0xb8, 0x18, 0x6c, 0x01, 0x13, 0xd8, 0x02, 0x23, 0xc2, 0x16, 0xa0, 0xeb, 0x86, 0xda, 0x48, 0xc0,
-	0x7c, 0xa1, 0x60, 0x2e, 0x7e, 0x24, 0x7f, 0x83, 0xad, 0x64, 0x06, 0x5b, 0xa9, 0x8c, 0xcb, 0x4a,
-	0x67, 0x84, 0x72, 0xa8, 0x1d, 0xe8, 0x26, 0x08, 0xe9, 0x71, 0x09, 0x21, 0x09, 0x39, 0xe7, 0x97,
-	0xe6, 0x95, 0xa4, 0x16, 0x49, 0xb0, 0x28, 0x30, 0x6a, 0xb0, 0x04, 0x61, 0x91, 0x11, 0xca, 0xe3,
-	0x92, 0x40, 0x12, 0x75, 0xaa, 0x44, 0x0e, 0x00, 0x56, 0xb0, 0x6b, 0x74, 0x88, 0x71, 0x4d, 0x25,
-	0xaa, 0xd7, 0x71, 0x9a, 0xe9, 0xe4, 0x7c, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f,
-	0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c,
-	0x51, 0x9a, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x08, 0x1b, 0xf5,
-	0x8b, 0x0b, 0xf2, 0xf4, 0x2b, 0xf4, 0x61, 0x09, 0xa5, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d,
-	0x9c, 0x46, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x9d, 0x21, 0xcb, 0x65, 0x9e, 0x02, 0x00,
-	0x00,
+	// 340 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x4f, 0x4f, 0xf2, 0x30,
+	0x1c, 0xc7, 0xd7, 0x87, 0x3d, 0x1c, 0xaa, 0xc6, 0xa4, 0x41, 0x45, 0x0e, 0x15, 0xf5, 0x82, 0x89,
+	0x69, 0x13, 0x7c, 0x05, 0x8e, 0x83, 0x17, 0x8d, 0x09, 0x24, 0x1e, 0xbc, 0x01, 0xab, 0xb3, 0x09,
+	0xb4, 0x4d, 0x5b, 0x8c, 0xbb, 0xf9, 0x12, 0x7c, 0x3b, 0xbe, 0x03, 0x8e, 0x1c, 0x3d, 0x19, 0x03,
+	0x6f, 0xc4, 0x50, 0x36, 0x36, 0xc8, 0x08, 0xdc, 0x9a, 0xdf, 0xf7, 0xcf, 0xe7, 0xd7, 0xad, 0xf0,
+	0x48, 0x69, 0xf9, 0xc2, 0x07, 0x8c, 0x46, 0x4c, 0x30, 0xc3, 0x0d, 0x51, 0x5a, 0x5a, 0x89, 0x8e,
+	0x2d, 0x13, 0x21, 0xd3, 0x43, 0x2e, 0x2c, 0x31, 0x4a, 0x90, 0xc4, 0x55, 0xab, 0x44, 0x32, 0x92,
+	0xce, 0x42, 0xe7, 0xa7, 0x85, 0xbb, 0x76, 0x92, 0x96, 0xbc, 0x75, 0x07, 0x3c, 0xec, 0x5a, 0xa9,
+	0x13, 0xe1, 0x34, 0x15, 0xfa, 0x52, 0xea, 0x90, 0x8b, 0x4c, 0xba, 0xf8, 0xf2, 0xe1, 0xfe, 0xdd,
+	0x82, 0xd9, 0xb1, 0x5d, 0xcb, 0xd0, 0x03, 0x3c, 0x58, 0xc6, 0xef, 0xb9, 0xb1, 0x55, 0x50, 0x2f,
+	0x35, 0xf6, 0x9a, 0xe7, 0xa4, 0x78, 0x15, 0xf2, 0x94, 0x9a, 0x03, 0x7f, 0xfc, 0x73, 0xe6, 0xb5,
+	0x57, 0xd3, 0xe8, 0x03, 0x40, 0xbc, 0x9c, 0x04, 0xf1, 0xa3, 0x62, 0x7a, 0x7e, 0xba, 0x0d, 0x43,
+	0xcd, 0x8c, 0x71, 0x80, 0x7f, 0x0e, 0xd0, 0xdc, 0x0e, 0x58, 0x4f, 0x27, 0xc4, 0x2d, 0xfd, 0xa8,
+	0x03, 0x0f, 0x73, 0xf7, 0x76, 0xc8, 0x92, 0x43, 0x5e, 0x6e, 0x42, 0xb6, 0x32, 0x7b, 0xc2, 0x58,
+	0x6f, 0x40, 0x04, 0xa2, 0xdc, 0xa8, 0x25, 0x47, 0xc2, 0x32, 0x5d, 0xf5, 0xeb, 0xa0, 0xe1, 0xb7,
+	0x0b, 0x14, 0x24, 0x60, 0x35, 0x37, 0x0d, 0xe2, 0xfc, 0x07, 0xf8, 0xef, 0xb6, 0xb9, 0xde, 0x65,
+	0x9b, 0x78, 0xf5, 0xea, 0x1b, 0x3b, 0x51, 0x0f, 0x56, 0x72, 0xda, 0xfc, 0xd7, 0x2e, 0x58, 0x65,
+	0xc7, 0x6a, 0xec, 0xc0, 0x72, 0x99, 0x84, 0x53, 0xd8, 0x15, 0xb4, 0xc6, 0x53, 0x0c, 0x26, 0x53,
+	0x0c, 0x7e, 0xa7, 0x18, 0x7c, 0xce, 0xb0, 0x37, 0x99, 0x61, 0xef, 0x7b, 0x86, 0xbd, 0xe7, 0xab,
+	0x88, 0xdb, 0xd7, 0x51, 0x8f, 0xf4, 0xe5, 0x90, 0x66, 0x24, 0x6a, 0x94, 0xa0, 0xef, 0x34, 0x7d,
+	0x8c, 0x36, 0x56, 0xcc, 0xf4, 0xca, 0xee, 0x1d, 0xde, 0xfc, 0x0d, 0x00, 0x6a, 0xd6, 0xc3, 0x9e,
+	0x02, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -151,6 +160,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CoordinatorStatsList) > 0 {
+		for iNdEx := len(m.CoordinatorStatsList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CoordinatorStatsList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.CoordinatorByAddressList) > 0 {
 		for iNdEx := len(m.CoordinatorByAddressList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -259,6 +282,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.CoordinatorStatsList) > 0 {
+		for _, e := range m.CoordinatorStatsList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -452,6 +481,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoordinatorStatsList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CoordinatorStatsList = append(m.CoordinatorStatsList, CoordinatorStats{})
+			if err := m.CoordinatorStatsList[len(m.CoordinatorStatsList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,8 +3,9 @@ package types_test
 import (
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/pkg/errors"
-
 	"github.com/stretchr/testify/require"
 
 	"github.com/tendermint/spn/testutil/sample"
@@ -171,6 +172,31 @@ func TestGenesisStateValidateValidator(t *testing.T) {
 			},
 			err: errors.New("operator address not found in the Validator operator address list"),
 		},
+		{
+			name: "should prevent validate validator operator address not indexed",
+			genState: &types.GenesisState{
+				ValidatorList: []types.Validator{
+					{Address: addr1, OperatorAddresses: []string{opAddr1, opAddr2}},
+				},
+				ValidatorByOperatorAddressList: []types.ValidatorByOperatorAddress{
+					{OperatorAddress: opAddr1, ValidatorAddress: addr1},
+				},
+			},
+			err: errors.New("validator operator address not found in validatorByOperatorAddress"),
+		},
+		{
+			name: "should prevent validate validator operator address indexed to another validator",
+			genState: &types.GenesisState{
+				ValidatorList: []types.Validator{
+					{Address: addr1, OperatorAddresses: []string{opAddr1}},
+					{Address: addr2, OperatorAddresses: []string{opAddr1}},
+				},
+				ValidatorByOperatorAddressList: []types.ValidatorByOperatorAddress{
+					{OperatorAddress: opAddr1, ValidatorAddress: addr1},
+				},
+			},
+			err: errors.New("validator operator address not found in validatorByOperatorAddress"),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -318,6 +344,85 @@ func TestGenesisStateValidateCoordinator(t *testing.T) {
 			},
 			err: errors.New("coordinator found by CoordinatorByAddress should not be inactive"),
 		},
+		{
+			name: "should prevent validate coordinator with the coordinator address as operator",
+			genState: &types.GenesisState{
+				CoordinatorByAddressList: []types.CoordinatorByAddress{
+					{CoordinatorID: 0, Address: addr1},
+				},
+				CoordinatorList: []types.Coordinator{
+					{CoordinatorID: 0, Address: addr1, Active: true, Operators: []types.CoordinatorOperator{
+						{Address: addr1, Permissions: []types.CoordinatorPermission{types.CoordinatorPermission_SETTLE_REQUESTS}},
+					}},
+				},
+				CoordinatorCounter: 1,
+			},
+			err: errors.New("invalid operators for coordinator 0: coordinator address can't be an operator"),
+		},
+		{
+			name: "should validate genesis with coordinator stats",
+			genState: &types.GenesisState{
+				CoordinatorByAddressList: []types.CoordinatorByAddress{
+					{CoordinatorID: 0, Address: addr1},
+				},
+				CoordinatorList: []types.Coordinator{
+					{CoordinatorID: 0, Address: addr1, Active: true},
+				},
+				CoordinatorCounter: 1,
+				CoordinatorStatsList: []types.CoordinatorStats{
+					{CoordinatorID: 0, ChainsCreated: 2, RewardsDistributed: sdk.NewCoins(sdk.NewInt64Coin("foo", 10))},
+				},
+			},
+		},
+		{
+			name: "should prevent validate duplicated coordinator stats",
+			genState: &types.GenesisState{
+				CoordinatorByAddressList: []types.CoordinatorByAddress{
+					{CoordinatorID: 0, Address: addr1},
+				},
+				CoordinatorList: []types.Coordinator{
+					{CoordinatorID: 0, Address: addr1, Active: true},
+				},
+				CoordinatorCounter: 1,
+				CoordinatorStatsList: []types.CoordinatorStats{
+					{CoordinatorID: 0},
+					{CoordinatorID: 0},
+				},
+			},
+			err: errors.New("duplicated id for coordinator stats"),
+		},
+		{
+			name: "should prevent validate coordinator stats for non existing coordinator",
+			genState: &types.GenesisState{
+				CoordinatorByAddressList: []types.CoordinatorByAddress{
+					{CoordinatorID: 0, Address: addr1},
+				},
+				CoordinatorList: []types.Coordinator{
+					{CoordinatorID: 0, Address: addr1, Active: true},
+				},
+				CoordinatorCounter: 1,
+				CoordinatorStatsList: []types.CoordinatorStats{
+					{CoordinatorID: 1},
+				},
+			},
+			err: errors.New("coordinator 1 not found for coordinator stats"),
+		},
+		{
+			name: "should prevent validate coordinator stats with invalid rewards",
+			genState: &types.GenesisState{
+				CoordinatorByAddressList: []types.CoordinatorByAddress{
+					{CoordinatorID: 0, Address: addr1},
+				},
+				CoordinatorList: []types.Coordinator{
+					{CoordinatorID: 0, Address: addr1, Active: true},
+				},
+				CoordinatorCounter: 1,
+				CoordinatorStatsList: []types.CoordinatorStats{
+					{CoordinatorID: 0, RewardsDistributed: sdk.Coins{sdk.Coin{Denom: "foo", Amount: sdkmath.NewInt(-1)}}},
+				},
+			},
+			err: errors.New("invalid stats for coordinator 0: invalid rewards distributed: coin -1foo amount is not positive"),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
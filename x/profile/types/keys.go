@@ -25,6 +25,9 @@ const (
 	// CoordinatorByAddressKeyPrefix is the prefix to retrieve all CoordinatorByAddress
 	CoordinatorByAddressKeyPrefix = "CoordinatorByAddress/value/"
 
+	// CoordinatorStatsKeyPrefix is the prefix to retrieve all CoordinatorStats
+	CoordinatorStatsKeyPrefix = "CoordinatorStats/value/"
+
 	// ValidatorKeyPrefix is the prefix to retrieve all Validator
 	ValidatorKeyPrefix = "Validator/value/"
 
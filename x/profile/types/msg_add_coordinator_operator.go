@@ -0,0 +1,60 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgAddCoordinatorOperator = "add_coordinator_operator"
+
+var _ sdk.Msg = &MsgAddCoordinatorOperator{}
+
+func NewMsgAddCoordinatorOperator(address, operator string, permissions []CoordinatorPermission) *MsgAddCoordinatorOperator {
+	return &MsgAddCoordinatorOperator{
+		Address:     address,
+		Operator:    operator,
+		Permissions: permissions,
+	}
+}
+
+func (msg *MsgAddCoordinatorOperator) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAddCoordinatorOperator) Type() string {
+	return TypeMsgAddCoordinatorOperator
+}
+
+func (msg *MsgAddCoordinatorOperator) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgAddCoordinatorOperator) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgAddCoordinatorOperator) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrortypes.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if msg.Address == msg.Operator {
+		return sdkerrors.Wrapf(ErrInvalidCoordOperator, "coordinator address can't be an operator (%s)", msg.Address)
+	}
+
+	operator := CoordinatorOperator{
+		Address:     msg.Operator,
+		Permissions: msg.Permissions,
+	}
+	if err := operator.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidCoordOperator, err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkerrortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/profile/types"
+)
+
+func TestMsgAddCoordinatorOperator_ValidateBasic(t *testing.T) {
+	var (
+		addr  = sample.Address(r)
+		perms = []types.CoordinatorPermission{types.CoordinatorPermission_SETTLE_REQUESTS}
+	)
+	tests := []struct {
+		name string
+		msg  types.MsgAddCoordinatorOperator
+		err  error
+	}{
+		{
+			name: "should validate valid message",
+			msg:  *types.NewMsgAddCoordinatorOperator(addr, sample.Address(r), perms),
+		},
+		{
+			name: "should prevent validate message with invalid address",
+			msg:  *types.NewMsgAddCoordinatorOperator("invalid_address", sample.Address(r), perms),
+			err:  sdkerrortypes.ErrInvalidAddress,
+		},
+		{
+			name: "should prevent validate message with invalid operator address",
+			msg:  *types.NewMsgAddCoordinatorOperator(addr, "invalid_address", perms),
+			err:  types.ErrInvalidCoordOperator,
+		},
+		{
+			name: "should prevent validate message with the coordinator address as operator",
+			msg:  *types.NewMsgAddCoordinatorOperator(addr, addr, perms),
+			err:  types.ErrInvalidCoordOperator,
+		},
+		{
+			name: "should prevent validate message without permission",
+			msg:  *types.NewMsgAddCoordinatorOperator(addr, sample.Address(r), nil),
+			err:  types.ErrInvalidCoordOperator,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
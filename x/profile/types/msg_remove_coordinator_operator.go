@@ -0,0 +1,51 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgRemoveCoordinatorOperator = "remove_coordinator_operator"
+
+var _ sdk.Msg = &MsgRemoveCoordinatorOperator{}
+
+func NewMsgRemoveCoordinatorOperator(address, operator string) *MsgRemoveCoordinatorOperator {
+	return &MsgRemoveCoordinatorOperator{
+		Address:  address,
+		Operator: operator,
+	}
+}
+
+func (msg *MsgRemoveCoordinatorOperator) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRemoveCoordinatorOperator) Type() string {
+	return TypeMsgRemoveCoordinatorOperator
+}
+
+func (msg *MsgRemoveCoordinatorOperator) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRemoveCoordinatorOperator) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRemoveCoordinatorOperator) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrortypes.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrortypes.ErrInvalidAddress, "invalid operator address (%s)", err)
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkerrortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/profile/types"
+)
+
+func TestMsgRemoveCoordinatorOperator_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  types.MsgRemoveCoordinatorOperator
+		err  error
+	}{
+		{
+			name: "should validate valid message",
+			msg:  *types.NewMsgRemoveCoordinatorOperator(sample.Address(r), sample.Address(r)),
+		},
+		{
+			name: "should prevent validate message with invalid address",
+			msg:  *types.NewMsgRemoveCoordinatorOperator("invalid_address", sample.Address(r)),
+			err:  sdkerrortypes.ErrInvalidAddress,
+		},
+		{
+			name: "should prevent validate message with invalid operator address",
+			msg:  *types.NewMsgRemoveCoordinatorOperator(sample.Address(r), "invalid_address"),
+			err:  sdkerrortypes.ErrInvalidAddress,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgRemoveValidatorOperatorAddress = "remove_validator_operator_address"
+
+var _ sdk.Msg = &MsgRemoveValidatorOperatorAddress{}
+
+func NewMsgRemoveValidatorOperatorAddress(
+	validatorAddress,
+	operatorAddress string,
+) *MsgRemoveValidatorOperatorAddress {
+	return &MsgRemoveValidatorOperatorAddress{
+		ValidatorAddress: validatorAddress,
+		OperatorAddress:  operatorAddress,
+	}
+}
+
+func (msg *MsgRemoveValidatorOperatorAddress) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRemoveValidatorOperatorAddress) Type() string {
+	return TypeMsgRemoveValidatorOperatorAddress
+}
+
+func (msg *MsgRemoveValidatorOperatorAddress) GetSigners() []sdk.AccAddress {
+	// only the validator signs, the operator key may be compromised
+	validatorAddress, err := sdk.AccAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{validatorAddress}
+}
+
+func (msg *MsgRemoveValidatorOperatorAddress) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRemoveValidatorOperatorAddress) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.Wrapf(sdkerrortypes.ErrInvalidAddress, "invalid validator address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.OperatorAddress); err != nil {
+		return sdkerrors.Wrapf(sdkerrortypes.ErrInvalidAddress, "invalid validator operator address (%s)", err)
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkerrortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/spn/testutil/sample"
+	profile "github.com/tendermint/spn/x/profile/types"
+)
+
+func TestMsgRemoveValidatorOperatorAddress_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  profile.MsgRemoveValidatorOperatorAddress
+		err  error
+	}{
+		{
+			name: "should validate valid message",
+			msg: profile.MsgRemoveValidatorOperatorAddress{
+				ValidatorAddress: sample.Address(r),
+				OperatorAddress:  sample.Address(r),
+			},
+		},
+		{
+			name: "should prevent validate invalid validator address",
+			msg: profile.MsgRemoveValidatorOperatorAddress{
+				ValidatorAddress: "invalid_address",
+				OperatorAddress:  sample.Address(r),
+			},
+			err: sdkerrortypes.ErrInvalidAddress,
+		},
+		{
+			name: "should prevent validate invalid operator address",
+			msg: profile.MsgRemoveValidatorOperatorAddress{
+				ValidatorAddress: sample.Address(r),
+				OperatorAddress:  "invalid_address",
+			},
+			err: sdkerrortypes.ErrInvalidAddress,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
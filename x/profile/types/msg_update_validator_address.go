@@ -0,0 +1,61 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgUpdateValidatorAddress = "update_validator_address"
+
+var _ sdk.Msg = &MsgUpdateValidatorAddress{}
+
+func NewMsgUpdateValidatorAddress(address, newAddress string) *MsgUpdateValidatorAddress {
+	return &MsgUpdateValidatorAddress{
+		Address:    address,
+		NewAddress: newAddress,
+	}
+}
+
+func (msg *MsgUpdateValidatorAddress) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateValidatorAddress) Type() string {
+	return TypeMsgUpdateValidatorAddress
+}
+
+func (msg *MsgUpdateValidatorAddress) GetSigners() []sdk.AccAddress {
+	address, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		panic(err)
+	}
+	newAddress, err := sdk.AccAddressFromBech32(msg.NewAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	// validator must prove ownership of both address
+	return []sdk.AccAddress{address, newAddress}
+}
+
+func (msg *MsgUpdateValidatorAddress) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateValidatorAddress) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrortypes.ErrInvalidAddress, "invalid address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromBech32(msg.NewAddress)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrortypes.ErrInvalidAddress, "invalid new address (%s)", err)
+	}
+	if msg.Address == msg.NewAddress {
+		return sdkerrors.Wrapf(sdkerrortypes.ErrInvalidAddress,
+			"address are equal of new address (%s)", msg.Address)
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkerrortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/spn/testutil/sample"
+	profile "github.com/tendermint/spn/x/profile/types"
+)
+
+func TestMsgUpdateValidatorAddress_GetSigners(t *testing.T) {
+	// should contain two signers
+	addr := sample.AccAddress(r)
+	newAddr := sample.AccAddress(r)
+	msg := profile.NewMsgUpdateValidatorAddress(addr.String(), newAddr.String())
+	signers := msg.GetSigners()
+	require.Len(t, signers, 2)
+	require.Contains(t, signers, addr)
+	require.Contains(t, signers, newAddr)
+}
+
+func TestMsgUpdateValidatorAddress_ValidateBasic(t *testing.T) {
+	addr := sample.Address(r)
+	tests := []struct {
+		name string
+		msg  profile.MsgUpdateValidatorAddress
+		err  error
+	}{
+		{
+			name: "should prevent validate invalid validator address",
+			msg: profile.MsgUpdateValidatorAddress{
+				Address:    "invalid address",
+				NewAddress: sample.Address(r),
+			},
+			err: sdkerrortypes.ErrInvalidAddress,
+		},
+		{
+			name: "should prevent validate invalid new address",
+			msg: profile.MsgUpdateValidatorAddress{
+				Address:    sample.Address(r),
+				NewAddress: "invalid address",
+			},
+			err: sdkerrortypes.ErrInvalidAddress,
+		},
+		{
+			name: "should prevent validate similar new address",
+			msg: profile.MsgUpdateValidatorAddress{
+				Address:    addr,
+				NewAddress: addr,
+			},
+			err: sdkerrortypes.ErrInvalidAddress,
+		},
+		{
+			name: "should validate different addresses",
+			msg: profile.MsgUpdateValidatorAddress{
+				Address:    sample.Address(r),
+				NewAddress: sample.Address(r),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -582,6 +582,94 @@ func (m *QueryGetCoordinatorByAddressResponse) GetCoordinatorByAddress() Coordin
 	return CoordinatorByAddress{}
 }
 
+type QueryGetCoordinatorStatsRequest struct {
+	CoordinatorID uint64 `protobuf:"varint,1,opt,name=coordinatorID,proto3" json:"coordinatorID,omitempty"`
+}
+
+func (m *QueryGetCoordinatorStatsRequest) Reset()         { *m = QueryGetCoordinatorStatsRequest{} }
+func (m *QueryGetCoordinatorStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetCoordinatorStatsRequest) ProtoMessage()    {}
+func (*QueryGetCoordinatorStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_882bdd73bbc62204, []int{12}
+}
+func (m *QueryGetCoordinatorStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetCoordinatorStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetCoordinatorStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetCoordinatorStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetCoordinatorStatsRequest.Merge(m, src)
+}
+func (m *QueryGetCoordinatorStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetCoordinatorStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetCoordinatorStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetCoordinatorStatsRequest proto.InternalMessageInfo
+
+func (m *QueryGetCoordinatorStatsRequest) GetCoordinatorID() uint64 {
+	if m != nil {
+		return m.CoordinatorID
+	}
+	return 0
+}
+
+type QueryGetCoordinatorStatsResponse struct {
+	CoordinatorStats CoordinatorStats `protobuf:"bytes,1,opt,name=coordinatorStats,proto3" json:"coordinatorStats"`
+}
+
+func (m *QueryGetCoordinatorStatsResponse) Reset()         { *m = QueryGetCoordinatorStatsResponse{} }
+func (m *QueryGetCoordinatorStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetCoordinatorStatsResponse) ProtoMessage()    {}
+func (*QueryGetCoordinatorStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_882bdd73bbc62204, []int{13}
+}
+func (m *QueryGetCoordinatorStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetCoordinatorStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetCoordinatorStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetCoordinatorStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetCoordinatorStatsResponse.Merge(m, src)
+}
+func (m *QueryGetCoordinatorStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetCoordinatorStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetCoordinatorStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetCoordinatorStatsResponse proto.InternalMessageInfo
+
+func (m *QueryGetCoordinatorStatsResponse) GetCoordinatorStats() CoordinatorStats {
+	if m != nil {
+		return m.CoordinatorStats
+	}
+	return CoordinatorStats{}
+}
+
 func init() {
 	proto.RegisterType((*QueryGetValidatorRequest)(nil), "tendermint.spn.profile.QueryGetValidatorRequest")
 	proto.RegisterType((*QueryGetValidatorResponse)(nil), "tendermint.spn.profile.QueryGetValidatorResponse")
@@ -595,60 +683,66 @@ func init() {
 	proto.RegisterType((*QueryAllCoordinatorResponse)(nil), "tendermint.spn.profile.QueryAllCoordinatorResponse")
 	proto.RegisterType((*QueryGetCoordinatorByAddressRequest)(nil), "tendermint.spn.profile.QueryGetCoordinatorByAddressRequest")
 	proto.RegisterType((*QueryGetCoordinatorByAddressResponse)(nil), "tendermint.spn.profile.QueryGetCoordinatorByAddressResponse")
+	proto.RegisterType((*QueryGetCoordinatorStatsRequest)(nil), "tendermint.spn.profile.QueryGetCoordinatorStatsRequest")
+	proto.RegisterType((*QueryGetCoordinatorStatsResponse)(nil), "tendermint.spn.profile.QueryGetCoordinatorStatsResponse")
 }
 
 func init() { proto.RegisterFile("profile/query.proto", fileDescriptor_882bdd73bbc62204) }
 
 var fileDescriptor_882bdd73bbc62204 = []byte{
-	// 754 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4d, 0x4f, 0xd4, 0x50,
-	0x14, 0x9d, 0x07, 0xf8, 0xc1, 0xc5, 0x8f, 0xe4, 0x49, 0x14, 0xaa, 0x19, 0xa5, 0x83, 0xca, 0x87,
-	0xb6, 0x32, 0xa3, 0x89, 0x11, 0x13, 0x9d, 0x41, 0x25, 0xc4, 0x05, 0x3a, 0x89, 0x2e, 0xdc, 0x90,
-	0x76, 0xe6, 0x51, 0x6b, 0x4a, 0x5f, 0x69, 0x0b, 0x61, 0x42, 0xd8, 0xf8, 0x03, 0x8c, 0x89, 0x7b,
-	0x77, 0xb2, 0x71, 0xc9, 0x9f, 0x60, 0x49, 0xc2, 0xc6, 0x95, 0x31, 0xe0, 0x4f, 0x70, 0xe9, 0xc2,
-	0xb4, 0x73, 0x3b, 0xd3, 0x76, 0x5a, 0x3a, 0x83, 0xec, 0x4a, 0xdf, 0x3d, 0xf7, 0x9d, 0x73, 0xee,
-	0xe9, 0x65, 0xe0, 0x92, 0x65, 0xf3, 0x65, 0xdd, 0x60, 0xf2, 0xea, 0x1a, 0xb3, 0x1b, 0x92, 0x65,
-	0x73, 0x97, 0xd3, 0xcb, 0x2e, 0x33, 0xeb, 0xcc, 0x5e, 0xd1, 0x4d, 0x57, 0x72, 0x2c, 0x53, 0xc2,
-	0x1a, 0x61, 0x58, 0xe3, 0x1a, 0xf7, 0x4b, 0x64, 0xef, 0xa9, 0x59, 0x2d, 0x5c, 0xd3, 0x38, 0xd7,
-	0x0c, 0x26, 0x2b, 0x96, 0x2e, 0x2b, 0xa6, 0xc9, 0x5d, 0xc5, 0xd5, 0xb9, 0xe9, 0xe0, 0xe9, 0x54,
-	0x8d, 0x3b, 0x2b, 0xdc, 0x91, 0x55, 0xc5, 0xc1, 0x4b, 0xe4, 0xf5, 0x19, 0x95, 0xb9, 0xca, 0x8c,
-	0x6c, 0x29, 0x9a, 0x6e, 0xfa, 0xc5, 0x58, 0x7b, 0x25, 0x20, 0xb3, 0xae, 0x18, 0x7a, 0x5d, 0x71,
-	0xb9, 0x8d, 0x07, 0xa3, 0xc1, 0x41, 0x8d, 0x73, 0xbb, 0xee, 0x81, 0x82, 0x23, 0xf1, 0x3e, 0x8c,
-	0xbc, 0xf6, 0xba, 0xce, 0x33, 0xf7, 0x6d, 0x80, 0xaa, 0xb2, 0xd5, 0x35, 0xe6, 0xb8, 0x74, 0x04,
-	0xce, 0x28, 0xf5, 0xba, 0xcd, 0x1c, 0x67, 0x84, 0xdc, 0x20, 0x13, 0x83, 0xd5, 0xe0, 0x4f, 0x51,
-	0x85, 0xd1, 0x04, 0x94, 0x63, 0x71, 0xd3, 0x61, 0xf4, 0x39, 0x0c, 0xb6, 0x08, 0xf8, 0xc0, 0xa1,
-	0xe2, 0x98, 0x94, 0x6c, 0x89, 0xd4, 0x42, 0x57, 0x06, 0x76, 0x7f, 0x5e, 0xcf, 0x55, 0xdb, 0x48,
-	0x51, 0x45, 0x66, 0x65, 0xc3, 0xe8, 0x60, 0xf6, 0x02, 0xa0, 0xad, 0x1e, 0xef, 0xb8, 0x25, 0x35,
-	0xad, 0x92, 0x3c, 0xab, 0xa4, 0xe6, 0x3c, 0xd0, 0x2a, 0xe9, 0x95, 0xa2, 0x31, 0xc4, 0x56, 0x43,
-	0x48, 0xf1, 0x3b, 0x41, 0x21, 0xd1, 0x4b, 0x92, 0x85, 0xf4, 0x1f, 0x4f, 0x08, 0x9d, 0x8f, 0x90,
-	0xed, 0xf3, 0xc9, 0xde, 0xce, 0x24, 0xdb, 0xe4, 0x10, 0x61, 0xfb, 0x06, 0x26, 0x3b, 0x5c, 0xaf,
-	0x34, 0x16, 0x2d, 0x66, 0x7b, 0x4f, 0xe5, 0xe6, 0x6c, 0x02, 0x8b, 0x26, 0xe0, 0x22, 0x8f, 0x9e,
-	0xe0, 0x10, 0xe3, 0xaf, 0xc5, 0x6d, 0x02, 0x53, 0xdd, 0xf4, 0x45, 0x57, 0x36, 0x40, 0x58, 0x4f,
-	0xad, 0xc2, 0x59, 0x14, 0xb3, 0x6d, 0x8a, 0x23, 0xd1, 0xb7, 0x23, 0x7a, 0x8b, 0x15, 0x10, 0x02,
-	0x9e, 0x73, 0xed, 0x20, 0x07, 0x82, 0xc7, 0xe1, 0x7c, 0x28, 0xde, 0x0b, 0xcf, 0x7c, 0x2a, 0x03,
-	0xd5, 0xe8, 0x4b, 0xf1, 0x03, 0x5c, 0x4d, 0xec, 0x81, 0xe2, 0x5e, 0xc2, 0x50, 0xa8, 0x1e, 0xd5,
-	0x14, 0xd2, 0xd4, 0x84, 0x3a, 0x20, 0xfd, 0x30, 0x5a, 0xac, 0x23, 0xdf, 0xb2, 0x61, 0x24, 0xf0,
-	0x3d, 0xa9, 0x0c, 0xef, 0x10, 0x94, 0x14, 0xbf, 0x26, 0x4d, 0x52, 0xff, 0xf1, 0x25, 0x9d, 0x5c,
-	0x96, 0x9f, 0x40, 0x21, 0x61, 0x0e, 0x95, 0x46, 0x2c, 0xc5, 0xe9, 0x2b, 0xe8, 0x13, 0x81, 0xf1,
-	0xa3, 0x3b, 0xa0, 0xfe, 0x65, 0x18, 0xae, 0x25, 0x9c, 0xa3, 0xe3, 0x77, 0xba, 0x31, 0xa2, 0x11,
-	0xcd, 0x68, 0x62, 0xbf, 0xe2, 0xdf, 0xb3, 0x70, 0xca, 0x27, 0x44, 0xbf, 0x11, 0x18, 0x6c, 0x05,
-	0x9d, 0xde, 0x4b, 0xbb, 0x21, 0x6d, 0xef, 0x0a, 0x33, 0x3d, 0x20, 0x9a, 0x22, 0xc5, 0xd2, 0xc7,
-	0xfd, 0xdf, 0x5f, 0xfa, 0xee, 0xd2, 0x69, 0xb9, 0x0d, 0x95, 0x1d, 0xcb, 0x94, 0x3b, 0xfe, 0x25,
-	0xc8, 0x9b, 0xe8, 0xe0, 0x16, 0xfd, 0x4a, 0xe0, 0x5c, 0xab, 0x55, 0xd9, 0x30, 0x32, 0xa8, 0x26,
-	0x2c, 0xe2, 0x0c, 0xaa, 0x49, 0x5b, 0x55, 0x9c, 0xf4, 0xa9, 0x16, 0xe8, 0x58, 0x26, 0x55, 0xfa,
-	0x87, 0x80, 0x90, 0xbe, 0x31, 0x68, 0xb9, 0x6b, 0x9f, 0xd2, 0xb6, 0xa4, 0x50, 0xf9, 0x9f, 0x16,
-	0x28, 0x68, 0xd1, 0x17, 0xb4, 0x40, 0xe7, 0x33, 0x05, 0x2d, 0xa9, 0x8d, 0xa5, 0x60, 0xfb, 0x2e,
-	0xe1, 0x18, 0xe4, 0xcd, 0xd8, 0x3e, 0xde, 0xa2, 0x3b, 0x04, 0x86, 0x42, 0xf1, 0xa3, 0xc5, 0x2c,
-	0x92, 0x9d, 0xdb, 0x45, 0x28, 0xf5, 0x84, 0x41, 0x25, 0xb3, 0xbe, 0x92, 0x07, 0xb4, 0x94, 0xa6,
-	0x24, 0x14, 0x7c, 0x79, 0x33, 0xb2, 0x58, 0xb7, 0xe8, 0x36, 0x81, 0x0b, 0xa1, 0xa6, 0x5e, 0x9e,
-	0x8a, 0x59, 0xe9, 0xe8, 0x99, 0x78, 0xf2, 0x8e, 0x13, 0xa7, 0x7d, 0xe2, 0x37, 0x69, 0xa1, 0x0b,
-	0xe2, 0x74, 0x9f, 0xc0, 0x70, 0xd2, 0xd7, 0x4d, 0x67, 0x7b, 0xf0, 0x2c, 0xbe, 0xa9, 0x84, 0xc7,
-	0xc7, 0x03, 0xa3, 0x80, 0xa7, 0xbe, 0x80, 0x47, 0xf4, 0x61, 0x17, 0x02, 0xbc, 0x14, 0xb5, 0xc2,
-	0x13, 0x7c, 0xcc, 0x95, 0xb9, 0xdd, 0x83, 0x3c, 0xd9, 0x3b, 0xc8, 0x93, 0x5f, 0x07, 0x79, 0xf2,
-	0xf9, 0x30, 0x9f, 0xdb, 0x3b, 0xcc, 0xe7, 0x7e, 0x1c, 0xe6, 0x73, 0xef, 0x26, 0x35, 0xdd, 0x7d,
-	0xbf, 0xa6, 0x4a, 0x35, 0xbe, 0x12, 0xef, 0xbe, 0xd1, 0xea, 0xef, 0x36, 0x2c, 0xe6, 0xa8, 0xa7,
-	0xfd, 0x1f, 0x85, 0xa5, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x1b, 0xf9, 0x02, 0xa8, 0xd7, 0x0a,
-	0x00, 0x00,
+	// 820 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x5f, 0x4f, 0xd3, 0x5e,
+	0x18, 0xde, 0x01, 0x7e, 0xbf, 0x5f, 0x78, 0xf9, 0xa9, 0xe4, 0x48, 0x14, 0x8a, 0x19, 0xd0, 0xa1,
+	0xf2, 0x47, 0x5b, 0xb7, 0x69, 0x44, 0x31, 0xd1, 0x0d, 0x75, 0x21, 0x5e, 0xa0, 0x33, 0x7a, 0xc1,
+	0x0d, 0xe9, 0xb6, 0x32, 0x6b, 0x4a, 0x4f, 0x69, 0x0b, 0x61, 0x21, 0x78, 0xe1, 0x07, 0x30, 0x26,
+	0xde, 0x7b, 0x27, 0x37, 0x5e, 0xf2, 0x21, 0xe4, 0x92, 0x84, 0x1b, 0xaf, 0x8c, 0x01, 0x3f, 0x82,
+	0x1f, 0xc0, 0xac, 0x7b, 0xbb, 0x75, 0xdd, 0x29, 0x6b, 0x91, 0xbb, 0xae, 0xe7, 0x7d, 0x9e, 0xf3,
+	0x3c, 0xef, 0x79, 0xfa, 0x9e, 0xc1, 0x45, 0xd3, 0x62, 0xab, 0x9a, 0xae, 0xca, 0xeb, 0x1b, 0xaa,
+	0x55, 0x93, 0x4c, 0x8b, 0x39, 0x8c, 0x5e, 0x72, 0x54, 0xa3, 0xa2, 0x5a, 0x6b, 0x9a, 0xe1, 0x48,
+	0xb6, 0x69, 0x48, 0x58, 0x23, 0x0c, 0x55, 0x59, 0x95, 0xb9, 0x25, 0x72, 0xfd, 0xa9, 0x51, 0x2d,
+	0x5c, 0xa9, 0x32, 0x56, 0xd5, 0x55, 0x59, 0x31, 0x35, 0x59, 0x31, 0x0c, 0xe6, 0x28, 0x8e, 0xc6,
+	0x0c, 0x1b, 0x57, 0x67, 0xca, 0xcc, 0x5e, 0x63, 0xb6, 0x5c, 0x52, 0x6c, 0xdc, 0x44, 0xde, 0x4c,
+	0x97, 0x54, 0x47, 0x49, 0xcb, 0xa6, 0x52, 0xd5, 0x0c, 0xb7, 0x18, 0x6b, 0x2f, 0x7b, 0x62, 0x36,
+	0x15, 0x5d, 0xab, 0x28, 0x0e, 0xb3, 0x70, 0x61, 0xc4, 0x5b, 0x28, 0x33, 0x66, 0x55, 0x34, 0xa3,
+	0xb5, 0x24, 0xde, 0x86, 0xe1, 0x17, 0x75, 0xd6, 0x82, 0xea, 0xbc, 0xf6, 0x50, 0x45, 0x75, 0x7d,
+	0x43, 0xb5, 0x1d, 0x3a, 0x0c, 0xff, 0x29, 0x95, 0x8a, 0xa5, 0xda, 0xf6, 0x30, 0x19, 0x27, 0x53,
+	0xfd, 0x45, 0xef, 0xa7, 0x58, 0x82, 0x11, 0x0e, 0xca, 0x36, 0x99, 0x61, 0xab, 0xf4, 0x09, 0xf4,
+	0x37, 0x05, 0xb8, 0xc0, 0x81, 0xcc, 0x84, 0xc4, 0x6f, 0x89, 0xd4, 0x44, 0xe7, 0xfb, 0xf6, 0x7f,
+	0x8c, 0x25, 0x8a, 0x2d, 0xa4, 0x58, 0x42, 0x65, 0x39, 0x5d, 0xef, 0x50, 0xf6, 0x14, 0xa0, 0xe5,
+	0x1e, 0xf7, 0xb8, 0x26, 0x35, 0x5a, 0x25, 0xd5, 0x5b, 0x25, 0x35, 0xce, 0x03, 0x5b, 0x25, 0x3d,
+	0x57, 0xaa, 0x2a, 0x62, 0x8b, 0x3e, 0xa4, 0xf8, 0x95, 0xc0, 0x08, 0x67, 0x13, 0xbe, 0x91, 0xde,
+	0xd3, 0x19, 0xa1, 0x85, 0x36, 0xb1, 0x3d, 0xae, 0xd8, 0xeb, 0x5d, 0xc5, 0x36, 0x34, 0xb4, 0xa9,
+	0x7d, 0x05, 0xd3, 0x1d, 0x5d, 0xcf, 0xd7, 0x96, 0x4c, 0xd5, 0xaa, 0x3f, 0xe5, 0x1a, 0x67, 0xe3,
+	0xb5, 0x68, 0x0a, 0x2e, 0xb0, 0xf6, 0x15, 0x3c, 0xc4, 0xe0, 0x6b, 0x71, 0x97, 0xc0, 0x4c, 0x14,
+	0x5e, 0xec, 0xca, 0x16, 0x08, 0x9b, 0xa1, 0x55, 0x78, 0x16, 0x99, 0xee, 0x6d, 0x0a, 0x22, 0xb1,
+	0x6f, 0x27, 0x70, 0x8b, 0x79, 0x10, 0x3c, 0x9d, 0x0b, 0xad, 0x20, 0x7b, 0x86, 0x27, 0xe1, 0x9c,
+	0x2f, 0xde, 0x8b, 0x8f, 0x5d, 0x29, 0x7d, 0xc5, 0xf6, 0x97, 0xe2, 0x5b, 0x18, 0xe5, 0x72, 0xa0,
+	0xb9, 0x67, 0x30, 0xe0, 0xab, 0x47, 0x37, 0xa9, 0x30, 0x37, 0x3e, 0x06, 0x94, 0xef, 0x47, 0x8b,
+	0x15, 0xd4, 0x9b, 0xd3, 0x75, 0x8e, 0xde, 0xb3, 0xca, 0xf0, 0x1e, 0x81, 0x51, 0xee, 0x36, 0x61,
+	0x96, 0x7a, 0x4f, 0x6f, 0xe9, 0xec, 0xb2, 0xfc, 0x10, 0x52, 0x9c, 0x73, 0xc8, 0xd7, 0x02, 0x29,
+	0x0e, 0x1f, 0x41, 0x1f, 0x08, 0x4c, 0x9e, 0xcc, 0x80, 0xfe, 0x57, 0x61, 0xa8, 0xcc, 0x59, 0xc7,
+	0x8e, 0xdf, 0x88, 0xd2, 0x88, 0x5a, 0x7b, 0x46, 0xb9, 0x7c, 0x62, 0x01, 0xc6, 0x38, 0x7a, 0x5e,
+	0x3a, 0x8a, 0x63, 0xc7, 0x8b, 0xe8, 0x3b, 0x18, 0x0f, 0x27, 0x42, 0x53, 0xcb, 0x30, 0x58, 0x0e,
+	0xac, 0xa1, 0xa1, 0xa9, 0x08, 0x86, 0xdc, 0x7a, 0x34, 0xd3, 0xc1, 0x93, 0xf9, 0x06, 0xf0, 0x8f,
+	0x2b, 0x80, 0x7e, 0x21, 0xd0, 0xdf, 0xfc, 0x62, 0xe9, 0xad, 0x30, 0xe6, 0xb0, 0x0b, 0x44, 0x48,
+	0xc7, 0x40, 0x34, 0x8c, 0x89, 0xd9, 0xf7, 0x87, 0xbf, 0x3e, 0xf5, 0xdc, 0xa4, 0xb3, 0x72, 0x0b,
+	0x2a, 0xdb, 0xa6, 0x21, 0x77, 0xdc, 0x6d, 0xf2, 0x36, 0x46, 0x61, 0x87, 0x7e, 0x26, 0xf0, 0x7f,
+	0x93, 0x2a, 0xa7, 0xeb, 0x5d, 0xa4, 0x72, 0x6e, 0x14, 0x21, 0x1d, 0x03, 0x81, 0x52, 0xa7, 0x5d,
+	0xa9, 0x29, 0x3a, 0xd1, 0x55, 0x2a, 0xfd, 0x4d, 0x40, 0x08, 0x1f, 0x7d, 0x34, 0x17, 0xb9, 0x4f,
+	0x61, 0xe3, 0x5e, 0xc8, 0xff, 0x0d, 0x05, 0x1a, 0x5a, 0x72, 0x0d, 0x2d, 0xd2, 0x42, 0x57, 0x43,
+	0x2b, 0xa5, 0xda, 0x8a, 0x77, 0x8d, 0xac, 0xe0, 0x31, 0xc8, 0xdb, 0x81, 0x8b, 0x65, 0x87, 0xee,
+	0x11, 0x18, 0xf0, 0xc5, 0x8e, 0x66, 0xba, 0x89, 0xec, 0x1c, 0x93, 0x42, 0x36, 0x16, 0x06, 0x9d,
+	0xcc, 0xbb, 0x4e, 0xee, 0xd0, 0x6c, 0x98, 0x13, 0x5f, 0xe8, 0xe5, 0xed, 0xb6, 0xcf, 0x6f, 0x87,
+	0xee, 0x12, 0x38, 0xef, 0x23, 0xad, 0xe7, 0x29, 0xd3, 0x2d, 0x1d, 0xb1, 0x85, 0xf3, 0x87, 0xb5,
+	0x38, 0xeb, 0x0a, 0xbf, 0x4a, 0x53, 0x11, 0x84, 0xd3, 0x43, 0x02, 0x43, 0xbc, 0x31, 0x45, 0xe7,
+	0x63, 0xf4, 0x2c, 0x38, 0x72, 0x85, 0x07, 0xa7, 0x03, 0xa3, 0x81, 0x47, 0xae, 0x81, 0xfb, 0x74,
+	0x2e, 0x82, 0x81, 0x7a, 0x8a, 0x9a, 0xe1, 0x69, 0x7e, 0xcc, 0xfb, 0x04, 0x06, 0x83, 0xb3, 0x8a,
+	0xde, 0x8d, 0x21, 0xca, 0x3f, 0x72, 0x85, 0xb9, 0xf8, 0x40, 0x74, 0x92, 0x73, 0x9d, 0xcc, 0xd3,
+	0x7b, 0x51, 0x9c, 0xd8, 0x75, 0x68, 0x30, 0x49, 0xf9, 0x85, 0xfd, 0xa3, 0x24, 0x39, 0x38, 0x4a,
+	0x92, 0x9f, 0x47, 0x49, 0xf2, 0xf1, 0x38, 0x99, 0x38, 0x38, 0x4e, 0x26, 0xbe, 0x1f, 0x27, 0x13,
+	0xcb, 0xd3, 0x55, 0xcd, 0x79, 0xb3, 0x51, 0x92, 0xca, 0x6c, 0x2d, 0x48, 0xbf, 0xd5, 0xdc, 0xc0,
+	0xa9, 0x99, 0xaa, 0x5d, 0xfa, 0xd7, 0xfd, 0xa3, 0x9e, 0xfd, 0x33, 0x00, 0x1f, 0x06, 0x00, 0xab,
+	0x6b, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -675,6 +769,8 @@ type QueryClient interface {
 	CoordinatorAll(ctx context.Context, in *QueryAllCoordinatorRequest, opts ...grpc.CallOption) (*QueryAllCoordinatorResponse, error)
 	// Queries a coordinatorByAddress by index.
 	CoordinatorByAddress(ctx context.Context, in *QueryGetCoordinatorByAddressRequest, opts ...grpc.CallOption) (*QueryGetCoordinatorByAddressResponse, error)
+	// Queries the statistics of a coordinator.
+	CoordinatorStats(ctx context.Context, in *QueryGetCoordinatorStatsRequest, opts ...grpc.CallOption) (*QueryGetCoordinatorStatsResponse, error)
 }
 
 type queryClient struct {
@@ -739,6 +835,15 @@ func (c *queryClient) CoordinatorByAddress(ctx context.Context, in *QueryGetCoor
 	return out, nil
 }
 
+func (c *queryClient) CoordinatorStats(ctx context.Context, in *QueryGetCoordinatorStatsRequest, opts ...grpc.CallOption) (*QueryGetCoordinatorStatsResponse, error) {
+	out := new(QueryGetCoordinatorStatsResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.profile.Query/CoordinatorStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Queries a validator by index.
@@ -753,6 +858,8 @@ type QueryServer interface {
 	CoordinatorAll(context.Context, *QueryAllCoordinatorRequest) (*QueryAllCoordinatorResponse, error)
 	// Queries a coordinatorByAddress by index.
 	CoordinatorByAddress(context.Context, *QueryGetCoordinatorByAddressRequest) (*QueryGetCoordinatorByAddressResponse, error)
+	// Queries the statistics of a coordinator.
+	CoordinatorStats(context.Context, *QueryGetCoordinatorStatsRequest) (*QueryGetCoordinatorStatsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -777,6 +884,9 @@ func (*UnimplementedQueryServer) CoordinatorAll(ctx context.Context, req *QueryA
 func (*UnimplementedQueryServer) CoordinatorByAddress(ctx context.Context, req *QueryGetCoordinatorByAddressRequest) (*QueryGetCoordinatorByAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CoordinatorByAddress not implemented")
 }
+func (*UnimplementedQueryServer) CoordinatorStats(ctx context.Context, req *QueryGetCoordinatorStatsRequest) (*QueryGetCoordinatorStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CoordinatorStats not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -890,6 +1000,24 @@ func _Query_CoordinatorByAddress_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CoordinatorStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetCoordinatorStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CoordinatorStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.profile.Query/CoordinatorStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CoordinatorStats(ctx, req.(*QueryGetCoordinatorStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "tendermint.spn.profile.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -918,6 +1046,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CoordinatorByAddress",
 			Handler:    _Query_CoordinatorByAddress_Handler,
 		},
+		{
+			MethodName: "CoordinatorStats",
+			Handler:    _Query_CoordinatorStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "profile/query.proto",
@@ -1341,6 +1473,67 @@ func (m *QueryGetCoordinatorByAddressResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryGetCoordinatorStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetCoordinatorStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCoordinatorStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CoordinatorID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CoordinatorID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetCoordinatorStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetCoordinatorStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCoordinatorStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CoordinatorStats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1511,6 +1704,29 @@ func (m *QueryGetCoordinatorByAddressResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryGetCoordinatorStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CoordinatorID != 0 {
+		n += 1 + sovQuery(uint64(m.CoordinatorID))
+	}
+	return n
+}
+
+func (m *QueryGetCoordinatorStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CoordinatorStats.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2576,6 +2792,158 @@ func (m *QueryGetCoordinatorByAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryGetCoordinatorStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetCoordinatorStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetCoordinatorStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoordinatorID", wireType)
+			}
+			m.CoordinatorID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CoordinatorID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetCoordinatorStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetCoordinatorStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetCoordinatorStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoordinatorStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CoordinatorStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
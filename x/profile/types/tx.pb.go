@@ -207,6 +207,186 @@ func (m *MsgAddValidatorOperatorAddressResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgAddValidatorOperatorAddressResponse proto.InternalMessageInfo
 
+type MsgRemoveValidatorOperatorAddress struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validatorAddress,proto3" json:"validatorAddress,omitempty"`
+	OperatorAddress  string `protobuf:"bytes,2,opt,name=operatorAddress,proto3" json:"operatorAddress,omitempty"`
+}
+
+func (m *MsgRemoveValidatorOperatorAddress) Reset()         { *m = MsgRemoveValidatorOperatorAddress{} }
+func (m *MsgRemoveValidatorOperatorAddress) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveValidatorOperatorAddress) ProtoMessage()    {}
+func (*MsgRemoveValidatorOperatorAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a471fea62152592e, []int{4}
+}
+func (m *MsgRemoveValidatorOperatorAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveValidatorOperatorAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveValidatorOperatorAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveValidatorOperatorAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveValidatorOperatorAddress.Merge(m, src)
+}
+func (m *MsgRemoveValidatorOperatorAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveValidatorOperatorAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveValidatorOperatorAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveValidatorOperatorAddress proto.InternalMessageInfo
+
+func (m *MsgRemoveValidatorOperatorAddress) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *MsgRemoveValidatorOperatorAddress) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
+	}
+	return ""
+}
+
+type MsgRemoveValidatorOperatorAddressResponse struct {
+}
+
+func (m *MsgRemoveValidatorOperatorAddressResponse) Reset() {
+	*m = MsgRemoveValidatorOperatorAddressResponse{}
+}
+func (m *MsgRemoveValidatorOperatorAddressResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgRemoveValidatorOperatorAddressResponse) ProtoMessage() {}
+func (*MsgRemoveValidatorOperatorAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a471fea62152592e, []int{5}
+}
+func (m *MsgRemoveValidatorOperatorAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveValidatorOperatorAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveValidatorOperatorAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveValidatorOperatorAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveValidatorOperatorAddressResponse.Merge(m, src)
+}
+func (m *MsgRemoveValidatorOperatorAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveValidatorOperatorAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveValidatorOperatorAddressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveValidatorOperatorAddressResponse proto.InternalMessageInfo
+
+type MsgUpdateValidatorAddress struct {
+	Address    string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	NewAddress string `protobuf:"bytes,2,opt,name=newAddress,proto3" json:"newAddress,omitempty"`
+}
+
+func (m *MsgUpdateValidatorAddress) Reset()         { *m = MsgUpdateValidatorAddress{} }
+func (m *MsgUpdateValidatorAddress) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateValidatorAddress) ProtoMessage()    {}
+func (*MsgUpdateValidatorAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a471fea62152592e, []int{6}
+}
+func (m *MsgUpdateValidatorAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateValidatorAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateValidatorAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateValidatorAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateValidatorAddress.Merge(m, src)
+}
+func (m *MsgUpdateValidatorAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateValidatorAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateValidatorAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateValidatorAddress proto.InternalMessageInfo
+
+func (m *MsgUpdateValidatorAddress) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgUpdateValidatorAddress) GetNewAddress() string {
+	if m != nil {
+		return m.NewAddress
+	}
+	return ""
+}
+
+type MsgUpdateValidatorAddressResponse struct {
+}
+
+func (m *MsgUpdateValidatorAddressResponse) Reset()         { *m = MsgUpdateValidatorAddressResponse{} }
+func (m *MsgUpdateValidatorAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateValidatorAddressResponse) ProtoMessage()    {}
+func (*MsgUpdateValidatorAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a471fea62152592e, []int{7}
+}
+func (m *MsgUpdateValidatorAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateValidatorAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateValidatorAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateValidatorAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateValidatorAddressResponse.Merge(m, src)
+}
+func (m *MsgUpdateValidatorAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateValidatorAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateValidatorAddressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateValidatorAddressResponse proto.InternalMessageInfo
+
 type MsgCreateCoordinator struct {
 	Address     string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	Description CoordinatorDescription `protobuf:"bytes,2,opt,name=description,proto3" json:"description"`
@@ -216,7 +396,7 @@ func (m *MsgCreateCoordinator) Reset()         { *m = MsgCreateCoordinator{} }
 func (m *MsgCreateCoordinator) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateCoordinator) ProtoMessage()    {}
 func (*MsgCreateCoordinator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a471fea62152592e, []int{4}
+	return fileDescriptor_a471fea62152592e, []int{8}
 }
 func (m *MsgCreateCoordinator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -267,7 +447,7 @@ func (m *MsgCreateCoordinatorResponse) Reset()         { *m = MsgCreateCoordinat
 func (m *MsgCreateCoordinatorResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateCoordinatorResponse) ProtoMessage()    {}
 func (*MsgCreateCoordinatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a471fea62152592e, []int{5}
+	return fileDescriptor_a471fea62152592e, []int{9}
 }
 func (m *MsgCreateCoordinatorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -312,7 +492,7 @@ func (m *MsgUpdateCoordinatorDescription) Reset()         { *m = MsgUpdateCoordi
 func (m *MsgUpdateCoordinatorDescription) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateCoordinatorDescription) ProtoMessage()    {}
 func (*MsgUpdateCoordinatorDescription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a471fea62152592e, []int{6}
+	return fileDescriptor_a471fea62152592e, []int{10}
 }
 func (m *MsgUpdateCoordinatorDescription) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -364,7 +544,7 @@ func (m *MsgUpdateCoordinatorDescriptionResponse) Reset() {
 func (m *MsgUpdateCoordinatorDescriptionResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateCoordinatorDescriptionResponse) ProtoMessage()    {}
 func (*MsgUpdateCoordinatorDescriptionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a471fea62152592e, []int{7}
+	return fileDescriptor_a471fea62152592e, []int{11}
 }
 func (m *MsgUpdateCoordinatorDescriptionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -402,7 +582,7 @@ func (m *MsgUpdateCoordinatorAddress) Reset()         { *m = MsgUpdateCoordinato
 func (m *MsgUpdateCoordinatorAddress) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateCoordinatorAddress) ProtoMessage()    {}
 func (*MsgUpdateCoordinatorAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a471fea62152592e, []int{8}
+	return fileDescriptor_a471fea62152592e, []int{12}
 }
 func (m *MsgUpdateCoordinatorAddress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -452,7 +632,7 @@ func (m *MsgUpdateCoordinatorAddressResponse) Reset()         { *m = MsgUpdateCo
 func (m *MsgUpdateCoordinatorAddressResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateCoordinatorAddressResponse) ProtoMessage()    {}
 func (*MsgUpdateCoordinatorAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a471fea62152592e, []int{9}
+	return fileDescriptor_a471fea62152592e, []int{13}
 }
 func (m *MsgUpdateCoordinatorAddressResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -489,7 +669,7 @@ func (m *MsgDisableCoordinator) Reset()         { *m = MsgDisableCoordinator{} }
 func (m *MsgDisableCoordinator) String() string { return proto.CompactTextString(m) }
 func (*MsgDisableCoordinator) ProtoMessage()    {}
 func (*MsgDisableCoordinator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a471fea62152592e, []int{10}
+	return fileDescriptor_a471fea62152592e, []int{14}
 }
 func (m *MsgDisableCoordinator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -533,7 +713,7 @@ func (m *MsgDisableCoordinatorResponse) Reset()         { *m = MsgDisableCoordin
 func (m *MsgDisableCoordinatorResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDisableCoordinatorResponse) ProtoMessage()    {}
 func (*MsgDisableCoordinatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a471fea62152592e, []int{11}
+	return fileDescriptor_a471fea62152592e, []int{15}
 }
 func (m *MsgDisableCoordinatorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -569,11 +749,199 @@ func (m *MsgDisableCoordinatorResponse) GetCoordinatorID() uint64 {
 	return 0
 }
 
+type MsgAddCoordinatorOperator struct {
+	Address     string                  `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Operator    string                  `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Permissions []CoordinatorPermission `protobuf:"varint,3,rep,packed,name=permissions,proto3,enum=tendermint.spn.profile.CoordinatorPermission" json:"permissions,omitempty"`
+}
+
+func (m *MsgAddCoordinatorOperator) Reset()         { *m = MsgAddCoordinatorOperator{} }
+func (m *MsgAddCoordinatorOperator) String() string { return proto.CompactTextString(m) }
+func (*MsgAddCoordinatorOperator) ProtoMessage()    {}
+func (*MsgAddCoordinatorOperator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a471fea62152592e, []int{16}
+}
+func (m *MsgAddCoordinatorOperator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddCoordinatorOperator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddCoordinatorOperator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddCoordinatorOperator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddCoordinatorOperator.Merge(m, src)
+}
+func (m *MsgAddCoordinatorOperator) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddCoordinatorOperator) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddCoordinatorOperator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddCoordinatorOperator proto.InternalMessageInfo
+
+func (m *MsgAddCoordinatorOperator) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgAddCoordinatorOperator) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *MsgAddCoordinatorOperator) GetPermissions() []CoordinatorPermission {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
+type MsgAddCoordinatorOperatorResponse struct {
+}
+
+func (m *MsgAddCoordinatorOperatorResponse) Reset()         { *m = MsgAddCoordinatorOperatorResponse{} }
+func (m *MsgAddCoordinatorOperatorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddCoordinatorOperatorResponse) ProtoMessage()    {}
+func (*MsgAddCoordinatorOperatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a471fea62152592e, []int{17}
+}
+func (m *MsgAddCoordinatorOperatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddCoordinatorOperatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddCoordinatorOperatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddCoordinatorOperatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddCoordinatorOperatorResponse.Merge(m, src)
+}
+func (m *MsgAddCoordinatorOperatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddCoordinatorOperatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddCoordinatorOperatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddCoordinatorOperatorResponse proto.InternalMessageInfo
+
+type MsgRemoveCoordinatorOperator struct {
+	Address  string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+}
+
+func (m *MsgRemoveCoordinatorOperator) Reset()         { *m = MsgRemoveCoordinatorOperator{} }
+func (m *MsgRemoveCoordinatorOperator) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveCoordinatorOperator) ProtoMessage()    {}
+func (*MsgRemoveCoordinatorOperator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a471fea62152592e, []int{18}
+}
+func (m *MsgRemoveCoordinatorOperator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveCoordinatorOperator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveCoordinatorOperator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveCoordinatorOperator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveCoordinatorOperator.Merge(m, src)
+}
+func (m *MsgRemoveCoordinatorOperator) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveCoordinatorOperator) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveCoordinatorOperator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveCoordinatorOperator proto.InternalMessageInfo
+
+func (m *MsgRemoveCoordinatorOperator) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgRemoveCoordinatorOperator) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+type MsgRemoveCoordinatorOperatorResponse struct {
+}
+
+func (m *MsgRemoveCoordinatorOperatorResponse) Reset()         { *m = MsgRemoveCoordinatorOperatorResponse{} }
+func (m *MsgRemoveCoordinatorOperatorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveCoordinatorOperatorResponse) ProtoMessage()    {}
+func (*MsgRemoveCoordinatorOperatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a471fea62152592e, []int{19}
+}
+func (m *MsgRemoveCoordinatorOperatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveCoordinatorOperatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveCoordinatorOperatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveCoordinatorOperatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveCoordinatorOperatorResponse.Merge(m, src)
+}
+func (m *MsgRemoveCoordinatorOperatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveCoordinatorOperatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveCoordinatorOperatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveCoordinatorOperatorResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateValidatorDescription)(nil), "tendermint.spn.profile.MsgUpdateValidatorDescription")
 	proto.RegisterType((*MsgUpdateValidatorDescriptionResponse)(nil), "tendermint.spn.profile.MsgUpdateValidatorDescriptionResponse")
 	proto.RegisterType((*MsgAddValidatorOperatorAddress)(nil), "tendermint.spn.profile.MsgAddValidatorOperatorAddress")
 	proto.RegisterType((*MsgAddValidatorOperatorAddressResponse)(nil), "tendermint.spn.profile.MsgAddValidatorOperatorAddressResponse")
+	proto.RegisterType((*MsgRemoveValidatorOperatorAddress)(nil), "tendermint.spn.profile.MsgRemoveValidatorOperatorAddress")
+	proto.RegisterType((*MsgRemoveValidatorOperatorAddressResponse)(nil), "tendermint.spn.profile.MsgRemoveValidatorOperatorAddressResponse")
+	proto.RegisterType((*MsgUpdateValidatorAddress)(nil), "tendermint.spn.profile.MsgUpdateValidatorAddress")
+	proto.RegisterType((*MsgUpdateValidatorAddressResponse)(nil), "tendermint.spn.profile.MsgUpdateValidatorAddressResponse")
 	proto.RegisterType((*MsgCreateCoordinator)(nil), "tendermint.spn.profile.MsgCreateCoordinator")
 	proto.RegisterType((*MsgCreateCoordinatorResponse)(nil), "tendermint.spn.profile.MsgCreateCoordinatorResponse")
 	proto.RegisterType((*MsgUpdateCoordinatorDescription)(nil), "tendermint.spn.profile.MsgUpdateCoordinatorDescription")
@@ -582,49 +950,65 @@ func init() {
 	proto.RegisterType((*MsgUpdateCoordinatorAddressResponse)(nil), "tendermint.spn.profile.MsgUpdateCoordinatorAddressResponse")
 	proto.RegisterType((*MsgDisableCoordinator)(nil), "tendermint.spn.profile.MsgDisableCoordinator")
 	proto.RegisterType((*MsgDisableCoordinatorResponse)(nil), "tendermint.spn.profile.MsgDisableCoordinatorResponse")
+	proto.RegisterType((*MsgAddCoordinatorOperator)(nil), "tendermint.spn.profile.MsgAddCoordinatorOperator")
+	proto.RegisterType((*MsgAddCoordinatorOperatorResponse)(nil), "tendermint.spn.profile.MsgAddCoordinatorOperatorResponse")
+	proto.RegisterType((*MsgRemoveCoordinatorOperator)(nil), "tendermint.spn.profile.MsgRemoveCoordinatorOperator")
+	proto.RegisterType((*MsgRemoveCoordinatorOperatorResponse)(nil), "tendermint.spn.profile.MsgRemoveCoordinatorOperatorResponse")
 }
 
 func init() { proto.RegisterFile("profile/tx.proto", fileDescriptor_a471fea62152592e) }
 
 var fileDescriptor_a471fea62152592e = []byte{
-	// 584 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x3f, 0x6f, 0xd3, 0x40,
-	0x14, 0xcf, 0x41, 0x55, 0xd4, 0x57, 0x21, 0x8a, 0x15, 0x20, 0xb8, 0xc5, 0xad, 0x0c, 0xa5, 0x29,
-	0x6a, 0x6d, 0x29, 0xa5, 0x30, 0x20, 0x40, 0xf9, 0xc3, 0x80, 0x90, 0x85, 0x64, 0xa0, 0x03, 0x0b,
-	0x72, 0xe2, 0xc3, 0x58, 0x4a, 0x7c, 0xe6, 0xee, 0x68, 0x0b, 0x9f, 0x81, 0x01, 0x21, 0x21, 0x31,
-	0xb3, 0x31, 0xb0, 0xf1, 0x21, 0x3a, 0x16, 0x26, 0x26, 0x84, 0x92, 0x2f, 0x82, 0xe2, 0xc4, 0x17,
-	0x07, 0x3b, 0x17, 0xc5, 0x2c, 0x9d, 0x12, 0xdf, 0xfb, 0xbd, 0xdf, 0xfb, 0xbd, 0x3f, 0xf7, 0x74,
-	0xb0, 0x14, 0x52, 0xf2, 0xd2, 0x6f, 0x63, 0x93, 0x1f, 0x1a, 0x21, 0x25, 0x9c, 0x28, 0x17, 0x39,
-	0x0e, 0x5c, 0x4c, 0x3b, 0x7e, 0xc0, 0x0d, 0x16, 0x06, 0xc6, 0x10, 0xa0, 0x16, 0x3d, 0xe2, 0x91,
-	0x08, 0x62, 0xf6, 0xff, 0x0d, 0xd0, 0xea, 0xe5, 0x16, 0x61, 0x1d, 0xc2, 0x5e, 0x0c, 0x0c, 0x83,
-	0x8f, 0xd8, 0x14, 0x53, 0xb7, 0x08, 0xa1, 0xae, 0x1f, 0x38, 0x9c, 0xd0, 0xa1, 0xe9, 0x52, 0x6c,
-	0xda, 0x77, 0xda, 0xbe, 0x3b, 0x32, 0xe8, 0x5f, 0x11, 0x5c, 0xb1, 0x98, 0xf7, 0x2c, 0x74, 0x1d,
-	0x8e, 0xf7, 0x62, 0x63, 0x03, 0xb3, 0x16, 0xf5, 0x43, 0xee, 0x93, 0x40, 0xa9, 0xc0, 0x19, 0xc7,
-	0x75, 0x29, 0x66, 0xac, 0x84, 0xd6, 0x50, 0x79, 0xa1, 0x56, 0xfa, 0xf9, 0x7d, 0xbb, 0x38, 0x0c,
-	0x5c, 0x1d, 0x58, 0x9e, 0x70, 0xea, 0x07, 0x9e, 0x1d, 0x03, 0x95, 0xa7, 0xb0, 0xe8, 0x8e, 0x28,
-	0x4a, 0xa7, 0xd6, 0x50, 0x79, 0xb1, 0xb2, 0x65, 0x64, 0x27, 0x6a, 0x64, 0x85, 0xad, 0xcd, 0x1d,
-	0xfd, 0x5e, 0x2d, 0xd8, 0x49, 0x1a, 0x7d, 0x03, 0xd6, 0xa5, 0x52, 0x6d, 0xcc, 0x42, 0x12, 0x30,
-	0xac, 0xef, 0x83, 0x66, 0x31, 0xaf, 0xea, 0xba, 0x02, 0xf5, 0x38, 0xc4, 0xb4, 0xff, 0x3b, 0xd4,
-	0xab, 0xdc, 0x80, 0x25, 0x51, 0x89, 0x6a, 0x32, 0x3b, 0x3b, 0x75, 0xae, 0x94, 0xe1, 0x1c, 0x19,
-	0x77, 0x8f, 0x12, 0x5a, 0xb0, 0xff, 0x3d, 0xd6, 0xcb, 0x70, 0x5d, 0x1e, 0x57, 0x28, 0xfc, 0x82,
-	0xa0, 0x68, 0x31, 0xaf, 0x4e, 0xb1, 0xc3, 0x71, 0x7d, 0xd4, 0xae, 0x5c, 0xd5, 0xde, 0xcb, 0xaa,
-	0xb6, 0x31, 0xa9, 0xda, 0x89, 0x68, 0x53, 0xea, 0xdd, 0x80, 0x95, 0x2c, 0x8d, 0x71, 0x12, 0xca,
-	0x35, 0x38, 0x9b, 0x98, 0xb4, 0x87, 0x8d, 0x48, 0xf1, 0x9c, 0x3d, 0x7e, 0xa8, 0x7f, 0x43, 0xb0,
-	0x2a, 0xda, 0x96, 0x1d, 0xfc, 0x44, 0x65, 0xbd, 0x09, 0x1b, 0x53, 0xe4, 0x8a, 0x2e, 0xbe, 0x86,
-	0xe5, 0x2c, 0x68, 0x3c, 0x38, 0x79, 0xb2, 0xd2, 0x00, 0x02, 0x7c, 0x30, 0x3e, 0x67, 0x89, 0x13,
-	0x7d, 0x1d, 0xae, 0x4a, 0x42, 0x0a, 0x65, 0x8f, 0xe0, 0x82, 0xc5, 0xbc, 0x86, 0xcf, 0x9c, 0x66,
-	0xfb, 0x7f, 0xe7, 0x4b, 0x7f, 0x10, 0xad, 0x88, 0x34, 0xd9, 0x6c, 0x83, 0x50, 0xf9, 0x31, 0x0f,
-	0xa7, 0x2d, 0xe6, 0x29, 0x1f, 0x11, 0xa8, 0x92, 0x7d, 0xb3, 0x3b, 0xa9, 0x85, 0xd2, 0xbb, 0xaf,
-	0xde, 0xcd, 0xe5, 0x26, 0x52, 0xf8, 0x84, 0x60, 0x59, 0xb6, 0x30, 0x6e, 0x49, 0xe8, 0x25, 0x7e,
-	0xea, 0xbd, 0x7c, 0x7e, 0x42, 0xd7, 0x01, 0x9c, 0x4f, 0x2f, 0x89, 0x2d, 0x09, 0x69, 0x0a, 0xad,
-	0xde, 0x9c, 0x05, 0x2d, 0x02, 0x7f, 0x46, 0xb0, 0x22, 0xbd, 0xb3, 0xb7, 0xa7, 0x16, 0x3c, 0xdb,
-	0x51, 0xbd, 0x9f, 0xd3, 0x51, 0x48, 0x7b, 0x8f, 0xa0, 0x34, 0xf1, 0xd2, 0xed, 0xcc, 0xc2, 0x1e,
-	0x77, 0xe9, 0x4e, 0x0e, 0x27, 0x21, 0xe7, 0x1d, 0x28, 0x19, 0x17, 0x6d, 0x5b, 0x42, 0x99, 0x86,
-	0xab, 0xbb, 0x33, 0xc1, 0xe3, 0xd8, 0xb5, 0xfa, 0x51, 0x57, 0x43, 0xc7, 0x5d, 0x0d, 0xfd, 0xe9,
-	0x6a, 0xe8, 0x43, 0x4f, 0x2b, 0x1c, 0xf7, 0xb4, 0xc2, 0xaf, 0x9e, 0x56, 0x78, 0xbe, 0xe9, 0xf9,
-	0xfc, 0xd5, 0x9b, 0xa6, 0xd1, 0x22, 0x1d, 0x73, 0x44, 0x6d, 0xb2, 0x30, 0x30, 0x0f, 0x4d, 0xf1,
-	0x06, 0x79, 0x1b, 0x62, 0xd6, 0x9c, 0x8f, 0x9e, 0x02, 0x3b, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff,
-	0xb8, 0xa7, 0xc9, 0x9a, 0x9b, 0x08, 0x00, 0x00,
+	// 772 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x97, 0xcf, 0x4f, 0xd4, 0x40,
+	0x14, 0xc7, 0x77, 0x84, 0xa8, 0xbc, 0x8d, 0x8a, 0x0d, 0xe2, 0x52, 0xa0, 0x40, 0xf9, 0xb5, 0x28,
+	0x74, 0xc3, 0x02, 0x2a, 0xf1, 0x57, 0x16, 0xd6, 0x83, 0x31, 0x1b, 0x4c, 0x55, 0x0e, 0x5e, 0xcc,
+	0xb2, 0x1d, 0x6b, 0x13, 0xb6, 0x53, 0x3b, 0x15, 0xd0, 0x3f, 0xc0, 0x68, 0x62, 0xa2, 0xd1, 0x68,
+	0x3c, 0x79, 0xf0, 0xe6, 0xc1, 0x9b, 0x7f, 0x04, 0x47, 0xe2, 0xc1, 0x78, 0x32, 0x06, 0xfe, 0x11,
+	0xc3, 0xee, 0xce, 0x6c, 0xd7, 0x6d, 0xa7, 0xb4, 0x1c, 0xf4, 0x04, 0xdb, 0xf7, 0xbe, 0xef, 0x7d,
+	0xde, 0xcc, 0x7b, 0x7d, 0x29, 0x74, 0x3b, 0x2e, 0x79, 0x68, 0xad, 0xe3, 0x9c, 0xb7, 0xa5, 0x39,
+	0x2e, 0xf1, 0x88, 0xd4, 0xeb, 0x61, 0xdb, 0xc0, 0x6e, 0xd5, 0xb2, 0x3d, 0x8d, 0x3a, 0xb6, 0xd6,
+	0x70, 0x90, 0x7b, 0x4c, 0x62, 0x92, 0x9a, 0x4b, 0x6e, 0xff, 0xbf, 0xba, 0xb7, 0xdc, 0x57, 0x21,
+	0xb4, 0x4a, 0xe8, 0x83, 0xba, 0xa1, 0xfe, 0x83, 0x99, 0x58, 0xe8, 0x0a, 0x21, 0xae, 0x61, 0xd9,
+	0x65, 0x8f, 0xb8, 0x0d, 0xd3, 0x59, 0x66, 0xda, 0x28, 0xaf, 0x5b, 0x46, 0xd3, 0xa0, 0x7e, 0x41,
+	0x30, 0x58, 0xa2, 0xe6, 0x3d, 0xc7, 0x28, 0x7b, 0x78, 0x95, 0x19, 0x8b, 0x98, 0x56, 0x5c, 0xcb,
+	0xf1, 0x2c, 0x62, 0x4b, 0x79, 0x38, 0x56, 0x36, 0x0c, 0x17, 0x53, 0x9a, 0x41, 0xc3, 0x28, 0xdb,
+	0xb5, 0x94, 0xf9, 0xfe, 0x6d, 0xa6, 0xa7, 0x91, 0xb8, 0x50, 0xb7, 0xdc, 0xf1, 0x5c, 0xcb, 0x36,
+	0x75, 0xe6, 0x28, 0xdd, 0x85, 0xb4, 0xd1, 0x0c, 0x91, 0x39, 0x32, 0x8c, 0xb2, 0xe9, 0xfc, 0xb4,
+	0x16, 0x5c, 0xa8, 0x16, 0x94, 0x76, 0xa9, 0x73, 0xfb, 0xd7, 0x50, 0x4a, 0xf7, 0x87, 0x51, 0x27,
+	0x61, 0x5c, 0x88, 0xaa, 0x63, 0xea, 0x10, 0x9b, 0x62, 0x75, 0x03, 0x94, 0x12, 0x35, 0x0b, 0x86,
+	0xc1, 0xbd, 0x56, 0x1c, 0xec, 0xee, 0xff, 0x6d, 0xf0, 0x4a, 0xe7, 0xa0, 0x9b, 0x9f, 0x44, 0xc1,
+	0x5f, 0x9d, 0xde, 0xf6, 0x5c, 0xca, 0xc2, 0x29, 0xd2, 0x2a, 0xaf, 0x15, 0xd4, 0xa5, 0xff, 0xfd,
+	0x58, 0xcd, 0xc2, 0x84, 0x38, 0x2f, 0x27, 0x7c, 0x87, 0x60, 0xa4, 0x44, 0x4d, 0x1d, 0x57, 0xc9,
+	0x06, 0x0e, 0xa5, 0x2c, 0x86, 0x51, 0x0a, 0xee, 0xe0, 0x30, 0xfc, 0xe7, 0x61, 0x2a, 0x12, 0x8a,
+	0x97, 0xf0, 0x12, 0x41, 0x5f, 0xfb, 0x75, 0xb0, 0xa4, 0x49, 0xba, 0xe6, 0x12, 0x80, 0x8d, 0x37,
+	0x5b, 0x18, 0x05, 0x32, 0x9f, 0xaf, 0x3a, 0x0a, 0x23, 0xa1, 0x28, 0x1c, 0xf8, 0x33, 0x82, 0x9e,
+	0x12, 0x35, 0x97, 0x5d, 0x5c, 0xf6, 0xf0, 0x72, 0x73, 0x44, 0x12, 0xb1, 0xae, 0x06, 0x75, 0xb8,
+	0x16, 0xd6, 0xe1, 0xbe, 0x6c, 0x11, 0x3d, 0x5e, 0x84, 0x81, 0x20, 0x46, 0x56, 0x84, 0x34, 0x06,
+	0x27, 0x7c, 0xd3, 0x7d, 0xb3, 0x58, 0x23, 0xee, 0xd4, 0x5b, 0x1f, 0xaa, 0x5f, 0x11, 0x0c, 0xf1,
+	0x03, 0x09, 0x4e, 0xfe, 0x5f, 0x55, 0x3d, 0x05, 0x93, 0x11, 0xb8, 0xfc, 0x16, 0x1f, 0x43, 0x7f,
+	0x90, 0xeb, 0x61, 0xfa, 0x4e, 0x69, 0xef, 0xbb, 0x96, 0xee, 0x1a, 0x87, 0x51, 0x41, 0x4a, 0x4e,
+	0x76, 0x0b, 0xce, 0x94, 0xa8, 0x59, 0xb4, 0x68, 0x79, 0x6d, 0xfd, 0xb0, 0xfd, 0xa5, 0xde, 0x80,
+	0xc1, 0xc0, 0x60, 0x31, 0x1b, 0xe1, 0x47, 0x7d, 0x48, 0x0b, 0x86, 0xe1, 0x8b, 0xc1, 0x26, 0x3a,
+	0xd1, 0x61, 0xcd, 0xc3, 0x71, 0xf6, 0xda, 0x88, 0x1c, 0x51, 0xee, 0x29, 0xad, 0x40, 0xda, 0xd9,
+	0xef, 0x10, 0x4a, 0x2d, 0x62, 0xd3, 0x4c, 0xc7, 0x70, 0x47, 0xf6, 0x64, 0x7e, 0xe6, 0x00, 0x8d,
+	0x73, 0x9b, 0xab, 0x74, 0x7f, 0x84, 0xc6, 0xc4, 0x07, 0xd7, 0xc5, 0x6f, 0xe4, 0x05, 0x82, 0x01,
+	0xfe, 0x42, 0xfb, 0xa7, 0x07, 0xa0, 0x4e, 0xc0, 0x98, 0x88, 0x84, 0x21, 0xe7, 0x3f, 0xa4, 0xa1,
+	0xa3, 0x44, 0x4d, 0xe9, 0x2d, 0x02, 0x59, 0xb0, 0x94, 0x17, 0xc2, 0x8e, 0x4e, 0xb8, 0x20, 0xe5,
+	0xab, 0x89, 0x64, 0xbc, 0xe7, 0xde, 0x23, 0xe8, 0x17, 0x6d, 0xd5, 0x0b, 0x82, 0xf0, 0x02, 0x9d,
+	0x7c, 0x2d, 0x99, 0x8e, 0x73, 0x7d, 0x42, 0xa0, 0x44, 0xac, 0xd2, 0x45, 0x41, 0x0a, 0xb1, 0x54,
+	0x2e, 0x24, 0x96, 0x72, 0xc0, 0xe7, 0x08, 0x7a, 0x43, 0x16, 0xe5, 0xec, 0xc1, 0xaf, 0x84, 0x01,
+	0x2d, 0xc6, 0x96, 0x70, 0x90, 0x4d, 0x38, 0xdd, 0xbe, 0xff, 0xa6, 0x05, 0xf1, 0xda, 0xbc, 0xe5,
+	0xf9, 0x38, 0xde, 0x3c, 0xf1, 0x47, 0x04, 0x03, 0xc2, 0x75, 0x74, 0x31, 0xb2, 0xa8, 0x60, 0xa1,
+	0x7c, 0x3d, 0xa1, 0x90, 0xa3, 0xbd, 0x42, 0x90, 0x09, 0xdd, 0x27, 0x73, 0x71, 0xa2, 0xb3, 0x0b,
+	0xba, 0x9c, 0x40, 0xc4, 0x71, 0x9e, 0x81, 0x14, 0xb0, 0x43, 0x66, 0x04, 0x21, 0xdb, 0xdd, 0xe5,
+	0x85, 0x58, 0xee, 0x2d, 0x7d, 0x1a, 0xb2, 0x2b, 0x66, 0xc5, 0x33, 0x1a, 0x20, 0x91, 0x17, 0x63,
+	0x4b, 0x38, 0xc8, 0x6b, 0x04, 0x7d, 0xe1, 0xaf, 0xed, 0xf9, 0xc8, 0x89, 0x0c, 0xc2, 0xb9, 0x92,
+	0x44, 0xc5, 0x88, 0x96, 0x96, 0xb7, 0x77, 0x15, 0xb4, 0xb3, 0xab, 0xa0, 0xdf, 0xbb, 0x0a, 0x7a,
+	0xb3, 0xa7, 0xa4, 0x76, 0xf6, 0x94, 0xd4, 0xcf, 0x3d, 0x25, 0x75, 0x7f, 0xca, 0xb4, 0xbc, 0x47,
+	0x4f, 0xd6, 0xb4, 0x0a, 0xa9, 0xe6, 0x9a, 0x19, 0x72, 0xd4, 0xb1, 0x73, 0x5b, 0x39, 0xfe, 0xb5,
+	0xf7, 0xd4, 0xc1, 0x74, 0xed, 0x68, 0xed, 0xa3, 0x6b, 0xee, 0xcf, 0x00, 0x58, 0xc5, 0x77, 0x01,
+	0x05, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -641,10 +1025,14 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	UpdateValidatorDescription(ctx context.Context, in *MsgUpdateValidatorDescription, opts ...grpc.CallOption) (*MsgUpdateValidatorDescriptionResponse, error)
 	AddValidatorOperatorAddress(ctx context.Context, in *MsgAddValidatorOperatorAddress, opts ...grpc.CallOption) (*MsgAddValidatorOperatorAddressResponse, error)
+	RemoveValidatorOperatorAddress(ctx context.Context, in *MsgRemoveValidatorOperatorAddress, opts ...grpc.CallOption) (*MsgRemoveValidatorOperatorAddressResponse, error)
+	UpdateValidatorAddress(ctx context.Context, in *MsgUpdateValidatorAddress, opts ...grpc.CallOption) (*MsgUpdateValidatorAddressResponse, error)
 	CreateCoordinator(ctx context.Context, in *MsgCreateCoordinator, opts ...grpc.CallOption) (*MsgCreateCoordinatorResponse, error)
 	UpdateCoordinatorDescription(ctx context.Context, in *MsgUpdateCoordinatorDescription, opts ...grpc.CallOption) (*MsgUpdateCoordinatorDescriptionResponse, error)
 	UpdateCoordinatorAddress(ctx context.Context, in *MsgUpdateCoordinatorAddress, opts ...grpc.CallOption) (*MsgUpdateCoordinatorAddressResponse, error)
 	DisableCoordinator(ctx context.Context, in *MsgDisableCoordinator, opts ...grpc.CallOption) (*MsgDisableCoordinatorResponse, error)
+	AddCoordinatorOperator(ctx context.Context, in *MsgAddCoordinatorOperator, opts ...grpc.CallOption) (*MsgAddCoordinatorOperatorResponse, error)
+	RemoveCoordinatorOperator(ctx context.Context, in *MsgRemoveCoordinatorOperator, opts ...grpc.CallOption) (*MsgRemoveCoordinatorOperatorResponse, error)
 }
 
 type msgClient struct {
@@ -673,6 +1061,24 @@ func (c *msgClient) AddValidatorOperatorAddress(ctx context.Context, in *MsgAddV
 	return out, nil
 }
 
+func (c *msgClient) RemoveValidatorOperatorAddress(ctx context.Context, in *MsgRemoveValidatorOperatorAddress, opts ...grpc.CallOption) (*MsgRemoveValidatorOperatorAddressResponse, error) {
+	out := new(MsgRemoveValidatorOperatorAddressResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.profile.Msg/RemoveValidatorOperatorAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateValidatorAddress(ctx context.Context, in *MsgUpdateValidatorAddress, opts ...grpc.CallOption) (*MsgUpdateValidatorAddressResponse, error) {
+	out := new(MsgUpdateValidatorAddressResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.profile.Msg/UpdateValidatorAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) CreateCoordinator(ctx context.Context, in *MsgCreateCoordinator, opts ...grpc.CallOption) (*MsgCreateCoordinatorResponse, error) {
 	out := new(MsgCreateCoordinatorResponse)
 	err := c.cc.Invoke(ctx, "/tendermint.spn.profile.Msg/CreateCoordinator", in, out, opts...)
@@ -709,14 +1115,36 @@ func (c *msgClient) DisableCoordinator(ctx context.Context, in *MsgDisableCoordi
 	return out, nil
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	UpdateValidatorDescription(context.Context, *MsgUpdateValidatorDescription) (*MsgUpdateValidatorDescriptionResponse, error)
+func (c *msgClient) AddCoordinatorOperator(ctx context.Context, in *MsgAddCoordinatorOperator, opts ...grpc.CallOption) (*MsgAddCoordinatorOperatorResponse, error) {
+	out := new(MsgAddCoordinatorOperatorResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.profile.Msg/AddCoordinatorOperator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveCoordinatorOperator(ctx context.Context, in *MsgRemoveCoordinatorOperator, opts ...grpc.CallOption) (*MsgRemoveCoordinatorOperatorResponse, error) {
+	out := new(MsgRemoveCoordinatorOperatorResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.profile.Msg/RemoveCoordinatorOperator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	UpdateValidatorDescription(context.Context, *MsgUpdateValidatorDescription) (*MsgUpdateValidatorDescriptionResponse, error)
 	AddValidatorOperatorAddress(context.Context, *MsgAddValidatorOperatorAddress) (*MsgAddValidatorOperatorAddressResponse, error)
+	RemoveValidatorOperatorAddress(context.Context, *MsgRemoveValidatorOperatorAddress) (*MsgRemoveValidatorOperatorAddressResponse, error)
+	UpdateValidatorAddress(context.Context, *MsgUpdateValidatorAddress) (*MsgUpdateValidatorAddressResponse, error)
 	CreateCoordinator(context.Context, *MsgCreateCoordinator) (*MsgCreateCoordinatorResponse, error)
 	UpdateCoordinatorDescription(context.Context, *MsgUpdateCoordinatorDescription) (*MsgUpdateCoordinatorDescriptionResponse, error)
 	UpdateCoordinatorAddress(context.Context, *MsgUpdateCoordinatorAddress) (*MsgUpdateCoordinatorAddressResponse, error)
 	DisableCoordinator(context.Context, *MsgDisableCoordinator) (*MsgDisableCoordinatorResponse, error)
+	AddCoordinatorOperator(context.Context, *MsgAddCoordinatorOperator) (*MsgAddCoordinatorOperatorResponse, error)
+	RemoveCoordinatorOperator(context.Context, *MsgRemoveCoordinatorOperator) (*MsgRemoveCoordinatorOperatorResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -729,6 +1157,12 @@ func (*UnimplementedMsgServer) UpdateValidatorDescription(ctx context.Context, r
 func (*UnimplementedMsgServer) AddValidatorOperatorAddress(ctx context.Context, req *MsgAddValidatorOperatorAddress) (*MsgAddValidatorOperatorAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddValidatorOperatorAddress not implemented")
 }
+func (*UnimplementedMsgServer) RemoveValidatorOperatorAddress(ctx context.Context, req *MsgRemoveValidatorOperatorAddress) (*MsgRemoveValidatorOperatorAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveValidatorOperatorAddress not implemented")
+}
+func (*UnimplementedMsgServer) UpdateValidatorAddress(ctx context.Context, req *MsgUpdateValidatorAddress) (*MsgUpdateValidatorAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateValidatorAddress not implemented")
+}
 func (*UnimplementedMsgServer) CreateCoordinator(ctx context.Context, req *MsgCreateCoordinator) (*MsgCreateCoordinatorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateCoordinator not implemented")
 }
@@ -741,6 +1175,12 @@ func (*UnimplementedMsgServer) UpdateCoordinatorAddress(ctx context.Context, req
 func (*UnimplementedMsgServer) DisableCoordinator(ctx context.Context, req *MsgDisableCoordinator) (*MsgDisableCoordinatorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DisableCoordinator not implemented")
 }
+func (*UnimplementedMsgServer) AddCoordinatorOperator(ctx context.Context, req *MsgAddCoordinatorOperator) (*MsgAddCoordinatorOperatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCoordinatorOperator not implemented")
+}
+func (*UnimplementedMsgServer) RemoveCoordinatorOperator(ctx context.Context, req *MsgRemoveCoordinatorOperator) (*MsgRemoveCoordinatorOperatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCoordinatorOperator not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -782,6 +1222,42 @@ func _Msg_AddValidatorOperatorAddress_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RemoveValidatorOperatorAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveValidatorOperatorAddress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveValidatorOperatorAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.profile.Msg/RemoveValidatorOperatorAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveValidatorOperatorAddress(ctx, req.(*MsgRemoveValidatorOperatorAddress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateValidatorAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateValidatorAddress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateValidatorAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.profile.Msg/UpdateValidatorAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateValidatorAddress(ctx, req.(*MsgUpdateValidatorAddress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_CreateCoordinator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgCreateCoordinator)
 	if err := dec(in); err != nil {
@@ -854,6 +1330,42 @@ func _Msg_DisableCoordinator_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AddCoordinatorOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddCoordinatorOperator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddCoordinatorOperator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.profile.Msg/AddCoordinatorOperator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddCoordinatorOperator(ctx, req.(*MsgAddCoordinatorOperator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveCoordinatorOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveCoordinatorOperator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveCoordinatorOperator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.profile.Msg/RemoveCoordinatorOperator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveCoordinatorOperator(ctx, req.(*MsgRemoveCoordinatorOperator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "tendermint.spn.profile.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -866,6 +1378,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AddValidatorOperatorAddress",
 			Handler:    _Msg_AddValidatorOperatorAddress_Handler,
 		},
+		{
+			MethodName: "RemoveValidatorOperatorAddress",
+			Handler:    _Msg_RemoveValidatorOperatorAddress_Handler,
+		},
+		{
+			MethodName: "UpdateValidatorAddress",
+			Handler:    _Msg_UpdateValidatorAddress_Handler,
+		},
 		{
 			MethodName: "CreateCoordinator",
 			Handler:    _Msg_CreateCoordinator_Handler,
@@ -882,6 +1402,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DisableCoordinator",
 			Handler:    _Msg_DisableCoordinator_Handler,
 		},
+		{
+			MethodName: "AddCoordinatorOperator",
+			Handler:    _Msg_AddCoordinatorOperator_Handler,
+		},
+		{
+			MethodName: "RemoveCoordinatorOperator",
+			Handler:    _Msg_RemoveCoordinatorOperator_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "profile/tx.proto",
@@ -1010,6 +1538,126 @@ func (m *MsgAddValidatorOperatorAddressResponse) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgRemoveValidatorOperatorAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveValidatorOperatorAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveValidatorOperatorAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveValidatorOperatorAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveValidatorOperatorAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveValidatorOperatorAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateValidatorAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateValidatorAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateValidatorAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewAddress) > 0 {
+		i -= len(m.NewAddress)
+		copy(dAtA[i:], m.NewAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateValidatorAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateValidatorAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateValidatorAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgCreateCoordinator) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1259,49 +1907,187 @@ func (m *MsgDisableCoordinatorResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgAddCoordinatorOperator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgUpdateValidatorDescription) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgAddCoordinatorOperator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddCoordinatorOperator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Permissions) > 0 {
+		dAtA5 := make([]byte, len(m.Permissions)*10)
+		var j4 int
+		for _, num := range m.Permissions {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintTx(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = m.Description.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateValidatorDescriptionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAddCoordinatorOperatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgAddValidatorOperatorAddress) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgAddCoordinatorOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddCoordinatorOperatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveCoordinatorOperator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveCoordinatorOperator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveCoordinatorOperator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveCoordinatorOperatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveCoordinatorOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveCoordinatorOperatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateValidatorDescription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Description.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateValidatorDescriptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddValidatorOperatorAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
 	l = len(m.OperatorAddress)
@@ -1320,6 +2106,58 @@ func (m *MsgAddValidatorOperatorAddressResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgRemoveValidatorOperatorAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveValidatorOperatorAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateValidatorAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateValidatorAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgCreateCoordinator) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1419,16 +2257,732 @@ func (m *MsgDisableCoordinatorResponse) Size() (n int) {
 	if m.CoordinatorID != 0 {
 		n += 1 + sovTx(uint64(m.CoordinatorID))
 	}
-	return n
-}
+	return n
+}
+
+func (m *MsgAddCoordinatorOperator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Permissions) > 0 {
+		l = 0
+		for _, e := range m.Permissions {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgAddCoordinatorOperatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveCoordinatorOperator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveCoordinatorOperatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateValidatorDescription) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateValidatorDescription: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateValidatorDescription: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateValidatorDescriptionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateValidatorDescriptionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateValidatorDescriptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddValidatorOperatorAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddValidatorOperatorAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddValidatorOperatorAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddValidatorOperatorAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddValidatorOperatorAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddValidatorOperatorAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveValidatorOperatorAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveValidatorOperatorAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveValidatorOperatorAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveValidatorOperatorAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveValidatorOperatorAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveValidatorOperatorAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateValidatorAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateValidatorAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateValidatorAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateValidatorAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateValidatorAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateValidatorAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgUpdateValidatorDescription) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateCoordinator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1451,10 +3005,10 @@ func (m *MsgUpdateValidatorDescription) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateValidatorDescription: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateCoordinator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateValidatorDescription: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateCoordinator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1543,7 +3097,7 @@ func (m *MsgUpdateValidatorDescription) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateValidatorDescriptionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateCoordinatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1566,12 +3120,31 @@ func (m *MsgUpdateValidatorDescriptionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateValidatorDescriptionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateCoordinatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateValidatorDescriptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateCoordinatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoordinatorID", wireType)
+			}
+			m.CoordinatorID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CoordinatorID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1593,7 +3166,7 @@ func (m *MsgUpdateValidatorDescriptionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddValidatorOperatorAddress) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateCoordinatorDescription) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1616,15 +3189,15 @@ func (m *MsgAddValidatorOperatorAddress) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddValidatorOperatorAddress: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateCoordinatorDescription: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddValidatorOperatorAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateCoordinatorDescription: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1652,13 +3225,13 @@ func (m *MsgAddValidatorOperatorAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1668,23 +3241,24 @@ func (m *MsgAddValidatorOperatorAddress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1707,7 +3281,7 @@ func (m *MsgAddValidatorOperatorAddress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddValidatorOperatorAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateCoordinatorDescriptionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1730,10 +3304,10 @@ func (m *MsgAddValidatorOperatorAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddValidatorOperatorAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateCoordinatorDescriptionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddValidatorOperatorAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateCoordinatorDescriptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1757,7 +3331,7 @@ func (m *MsgAddValidatorOperatorAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateCoordinator) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateCoordinatorAddress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1780,10 +3354,10 @@ func (m *MsgCreateCoordinator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateCoordinator: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateCoordinatorAddress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateCoordinator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateCoordinatorAddress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1820,9 +3394,9 @@ func (m *MsgCreateCoordinator) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1832,24 +3406,23 @@ func (m *MsgCreateCoordinator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NewAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1872,7 +3445,7 @@ func (m *MsgCreateCoordinator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateCoordinatorResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateCoordinatorAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1895,31 +3468,12 @@ func (m *MsgCreateCoordinatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateCoordinatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateCoordinatorAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateCoordinatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateCoordinatorAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CoordinatorID", wireType)
-			}
-			m.CoordinatorID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CoordinatorID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1941,7 +3495,7 @@ func (m *MsgCreateCoordinatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateCoordinatorDescription) Unmarshal(dAtA []byte) error {
+func (m *MsgDisableCoordinator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1964,10 +3518,10 @@ func (m *MsgUpdateCoordinatorDescription) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateCoordinatorDescription: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDisableCoordinator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateCoordinatorDescription: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDisableCoordinator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2002,39 +3556,6 @@ func (m *MsgUpdateCoordinatorDescription) Unmarshal(dAtA []byte) error {
 			}
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2056,7 +3577,7 @@ func (m *MsgUpdateCoordinatorDescription) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateCoordinatorDescriptionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDisableCoordinatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2079,12 +3600,31 @@ func (m *MsgUpdateCoordinatorDescriptionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateCoordinatorDescriptionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDisableCoordinatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateCoordinatorDescriptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDisableCoordinatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoordinatorID", wireType)
+			}
+			m.CoordinatorID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CoordinatorID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2106,7 +3646,7 @@ func (m *MsgUpdateCoordinatorDescriptionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateCoordinatorAddress) Unmarshal(dAtA []byte) error {
+func (m *MsgAddCoordinatorOperator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2129,10 +3669,10 @@ func (m *MsgUpdateCoordinatorAddress) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateCoordinatorAddress: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddCoordinatorOperator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateCoordinatorAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddCoordinatorOperator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2169,7 +3709,7 @@ func (m *MsgUpdateCoordinatorAddress) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2197,8 +3737,77 @@ func (m *MsgUpdateCoordinatorAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewAddress = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v CoordinatorPermission
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= CoordinatorPermission(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Permissions = append(m.Permissions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Permissions) == 0 {
+					m.Permissions = make([]CoordinatorPermission, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v CoordinatorPermission
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= CoordinatorPermission(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Permissions = append(m.Permissions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2220,7 +3829,7 @@ func (m *MsgUpdateCoordinatorAddress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateCoordinatorAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddCoordinatorOperatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2243,10 +3852,10 @@ func (m *MsgUpdateCoordinatorAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateCoordinatorAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddCoordinatorOperatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateCoordinatorAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddCoordinatorOperatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2270,7 +3879,7 @@ func (m *MsgUpdateCoordinatorAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDisableCoordinator) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveCoordinatorOperator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2293,10 +3902,10 @@ func (m *MsgDisableCoordinator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDisableCoordinator: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveCoordinatorOperator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDisableCoordinator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveCoordinatorOperator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2331,6 +3940,38 @@ func (m *MsgDisableCoordinator) Unmarshal(dAtA []byte) error {
 			}
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2352,7 +3993,7 @@ func (m *MsgDisableCoordinator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDisableCoordinatorResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveCoordinatorOperatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2375,31 +4016,12 @@ func (m *MsgDisableCoordinatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDisableCoordinatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveCoordinatorOperatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDisableCoordinatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveCoordinatorOperatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CoordinatorID", wireType)
-			}
-			m.CoordinatorID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CoordinatorID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -14,6 +14,18 @@ func (v Validator) AddValidatorOperatorAddress(operatorAddress string) Validator
 	return v
 }
 
+// RemoveValidatorOperatorAddress removes a specific operator address from the Validator and return it
+func (v Validator) RemoveValidatorOperatorAddress(operatorAddress string) Validator {
+	opAddresses := make([]string, 0, len(v.OperatorAddresses))
+	for _, opAddr := range v.OperatorAddresses {
+		if operatorAddress != opAddr {
+			opAddresses = append(opAddresses, opAddr)
+		}
+	}
+	v.OperatorAddresses = opAddresses
+	return v
+}
+
 // HasOperatorAddress checks if the validator has a specific operator address associated to it
 func (v Validator) HasOperatorAddress(operatorAddress string) bool {
 	for _, opAddr := range v.OperatorAddresses {
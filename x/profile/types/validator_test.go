@@ -52,6 +52,49 @@ func TestValidator_AddValidatorOperatorAddress(t *testing.T) {
 	}
 }
 
+func TestValidator_RemoveValidatorOperatorAddress(t *testing.T) {
+	var (
+		operatorAddress   = sample.Address(r)
+		operatorAddresses = []string{
+			sample.Address(r),
+			sample.Address(r),
+			sample.Address(r),
+		}
+	)
+	tests := []struct {
+		name              string
+		operatorAddress   string
+		operatorAddresses []string
+		want              []string
+	}{
+		{
+			name:              "should allow removing an operator address from an existing list",
+			operatorAddress:   operatorAddress,
+			operatorAddresses: []string{operatorAddresses[0], operatorAddress, operatorAddresses[1]},
+			want:              []string{operatorAddresses[0], operatorAddresses[1]},
+		},
+		{
+			name:              "should allow removing the last operator address",
+			operatorAddress:   operatorAddress,
+			operatorAddresses: []string{operatorAddress},
+			want:              []string{},
+		},
+		{
+			name:              "should keep the list unchanged if the operator address is not present",
+			operatorAddress:   operatorAddress,
+			operatorAddresses: operatorAddresses,
+			want:              operatorAddresses,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validator := types.Validator{OperatorAddresses: tt.operatorAddresses}
+			got := validator.RemoveValidatorOperatorAddress(tt.operatorAddress)
+			require.Equal(t, tt.want, got.OperatorAddresses)
+		})
+	}
+}
+
 func TestValidator_HasOperatorAddress(t *testing.T) {
 	var (
 		operatorAddress   = sample.Address(r)
@@ -20,6 +20,8 @@ func GetTxCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdSetRewards())
+	cmd.AddCommand(CmdAddRewards())
+	cmd.AddCommand(CmdCancelRewardPool())
 
 	// this line is used by starport scaffolding # 1
 
@@ -18,6 +18,7 @@ type (
 		storeKey      storetypes.StoreKey
 		memKey        storetypes.StoreKey
 		paramstore    paramtypes.Subspace
+		authority     string
 		authKeeper    types.AccountKeeper
 		bankKeeper    types.BankKeeper
 		profileKeeper types.ProfileKeeper
@@ -34,6 +35,7 @@ func NewKeeper(
 	bankKeeper types.BankKeeper,
 	profileKeeper types.ProfileKeeper,
 	launchKeeper types.LaunchKeeper,
+	authority string,
 ) *Keeper {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
@@ -44,6 +46,7 @@ func NewKeeper(
 		storeKey:      storeKey,
 		memKey:        memKey,
 		paramstore:    ps,
+		authority:     authority,
 		authKeeper:    authKeeper,
 		bankKeeper:    bankKeeper,
 		profileKeeper: profileKeeper,
@@ -55,6 +58,11 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
+// GetAuthority returns the address allowed to update the module parameters
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // GetProfileKeeper gets the profile keeper interface of the module
 func (k *Keeper) GetProfileKeeper() types.ProfileKeeper {
 	return k.profileKeeper
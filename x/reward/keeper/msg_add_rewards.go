@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	ignterrors "github.com/ignite/modules/errors"
+
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+	"github.com/tendermint/spn/x/reward/types"
+)
+
+func (k msgServer) AddRewards(goCtx context.Context, msg *types.MsgAddRewards) (*types.MsgAddRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	chain, found := k.launchKeeper.GetChain(ctx, msg.LaunchID)
+	if !found {
+		return nil, sdkerrors.Wrapf(launchtypes.ErrChainNotFound, "%d", msg.LaunchID)
+	}
+
+	// rewards can't be added once launch is triggered
+	if chain.LaunchTriggered {
+		return nil, sdkerrors.Wrapf(launchtypes.ErrTriggeredLaunch, "%d", msg.LaunchID)
+	}
+	// an archived chain can no longer be launched
+	if chain.Archived {
+		return nil, sdkerrors.Wrapf(launchtypes.ErrChainArchived, "%d", msg.LaunchID)
+	}
+
+	rewardPool, found := k.GetRewardPool(ctx, msg.LaunchID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrRewardPoolNotFound, "%d", msg.LaunchID)
+	}
+	if rewardPool.Closed {
+		return nil, sdkerrors.Wrapf(types.ErrRewardPoolClosed, "%d", msg.LaunchID)
+	}
+
+	contributor, err := sdk.AccAddressFromBech32(msg.Contributor)
+	if err != nil {
+		return nil, ignterrors.Criticalf("can't parse contributor address %s", err.Error())
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, contributor, types.ModuleName, msg.Coins); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrortypes.ErrInsufficientFunds, err.Error())
+	}
+
+	// add the coins to the contribution of the contributor
+	rewardPool.SetContribution(
+		msg.Contributor,
+		rewardPool.ContributionOf(msg.Contributor).Add(msg.Coins...),
+	)
+	rewardPool.RemainingCoins = rewardPool.RemainingCoins.Add(msg.Coins...)
+	k.SetRewardPool(ctx, rewardPool)
+
+	return &types.MsgAddRewardsResponse{}, ctx.EventManager().EmitTypedEvent(&types.EventRewardsAdded{
+		LaunchID:    msg.LaunchID,
+		Contributor: msg.Contributor,
+		Coins:       msg.Coins,
+	})
+}
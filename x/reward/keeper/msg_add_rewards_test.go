@@ -0,0 +1,108 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	tc "github.com/tendermint/spn/testutil/constructor"
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/sample"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+	"github.com/tendermint/spn/x/reward/types"
+)
+
+func TestMsgAddRewards(t *testing.T) {
+	var (
+		sdkCtx, tk, ts = testkeeper.NewTestSetup(t)
+		ctx            = sdk.WrapSDKContext(sdkCtx)
+		contributor    = sample.Address(r)
+		coins          = tc.Coins(t, "100foo,50bar")
+	)
+	tk.Mint(sdkCtx, contributor, coins.Add(coins...))
+
+	rewardPool := initRewardPool(t, sdkCtx, tk, ts)
+	closedRewardPool := initRewardPool(t, sdkCtx, tk, ts)
+	closedRewardPool.Closed = true
+	tk.RewardKeeper.SetRewardPool(sdkCtx, closedRewardPool)
+	launchedRewardPool := initRewardPool(t, sdkCtx, tk, ts)
+	launchedChain, found := tk.LaunchKeeper.GetChain(sdkCtx, launchedRewardPool.LaunchID)
+	require.True(t, found)
+	launchedChain.LaunchTriggered = true
+	tk.LaunchKeeper.SetChain(sdkCtx, launchedChain)
+	archivedRewardPool := initRewardPool(t, sdkCtx, tk, ts)
+	archivedChain, found := tk.LaunchKeeper.GetChain(sdkCtx, archivedRewardPool.LaunchID)
+	require.True(t, found)
+	archivedChain.Archived = true
+	tk.LaunchKeeper.SetChain(sdkCtx, archivedChain)
+	noPoolLaunchID := tk.LaunchKeeper.AppendChain(sdkCtx, sample.Chain(r, 0, 0))
+
+	tests := []struct {
+		name string
+		msg  types.MsgAddRewards
+		err  error
+	}{
+		{
+			name: "should prevent adding rewards to a non existing chain",
+			msg:  *types.NewMsgAddRewards(contributor, 1000, coins),
+			err:  launchtypes.ErrChainNotFound,
+		},
+		{
+			name: "should prevent adding rewards to a chain with launch triggered",
+			msg:  *types.NewMsgAddRewards(contributor, launchedRewardPool.LaunchID, coins),
+			err:  launchtypes.ErrTriggeredLaunch,
+		},
+		{
+			name: "should prevent adding rewards to an archived chain",
+			msg:  *types.NewMsgAddRewards(contributor, archivedRewardPool.LaunchID, coins),
+			err:  launchtypes.ErrChainArchived,
+		},
+		{
+			name: "should prevent adding rewards to a non existing reward pool",
+			msg:  *types.NewMsgAddRewards(contributor, noPoolLaunchID, coins),
+			err:  types.ErrRewardPoolNotFound,
+		},
+		{
+			name: "should prevent adding rewards to a closed reward pool",
+			msg:  *types.NewMsgAddRewards(contributor, closedRewardPool.LaunchID, coins),
+			err:  types.ErrRewardPoolClosed,
+		},
+		{
+			name: "should prevent adding rewards with insufficient funds",
+			msg:  *types.NewMsgAddRewards(sample.Address(r), rewardPool.LaunchID, coins),
+			err:  sdkerrortypes.ErrInsufficientFunds,
+		},
+		{
+			name: "should allow adding rewards to a reward pool",
+			msg:  *types.NewMsgAddRewards(contributor, rewardPool.LaunchID, coins),
+		},
+		{
+			name: "should allow adding rewards to a reward pool several times",
+			msg:  *types.NewMsgAddRewards(contributor, rewardPool.LaunchID, coins),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			previousPool, _ := tk.RewardKeeper.GetRewardPool(sdkCtx, tt.msg.LaunchID)
+
+			_, err := ts.RewardSrv.AddRewards(ctx, &tt.msg)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+
+			got, found := tk.RewardKeeper.GetRewardPool(sdkCtx, tt.msg.LaunchID)
+			require.True(t, found)
+			require.Equal(t, previousPool.InitialCoins.Add(tt.msg.Coins...), got.InitialCoins)
+			require.Equal(t, previousPool.RemainingCoins.Add(tt.msg.Coins...), got.RemainingCoins)
+			require.Equal(t,
+				previousPool.ContributionOf(tt.msg.Contributor).Add(tt.msg.Coins...),
+				got.ContributionOf(tt.msg.Contributor),
+			)
+			require.Equal(t, previousPool.ContributionOf(previousPool.Provider), got.ContributionOf(got.Provider))
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+	"github.com/tendermint/spn/x/reward/types"
+)
+
+func (k msgServer) CancelRewardPool(
+	goCtx context.Context,
+	msg *types.MsgCancelRewardPool,
+) (*types.MsgCancelRewardPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	chain, found := k.launchKeeper.GetChain(ctx, msg.LaunchID)
+	if !found {
+		return nil, sdkerrors.Wrapf(launchtypes.ErrChainNotFound, "%d", msg.LaunchID)
+	}
+
+	// check coordinator
+	coordID, err := k.profileKeeper.CoordinatorIDFromAddress(ctx, msg.Provider)
+	if err != nil {
+		return nil, err
+	}
+	if chain.CoordinatorID != coordID {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidCoordinatorID, "%d", coordID)
+	}
+
+	// reward pool can't be cancelled once launch is triggered
+	if chain.LaunchTriggered {
+		return nil, sdkerrors.Wrapf(launchtypes.ErrTriggeredLaunch, "%d", msg.LaunchID)
+	}
+
+	rewardPool, found := k.GetRewardPool(ctx, msg.LaunchID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrRewardPoolNotFound, "%d", msg.LaunchID)
+	}
+
+	// all the contributors are refunded
+	if err := k.RefundContributors(ctx, rewardPool, rewardPool.RemainingCoins); err != nil {
+		return nil, err
+	}
+	k.RemoveRewardPool(ctx, msg.LaunchID)
+
+	return &types.MsgCancelRewardPoolResponse{}, ctx.EventManager().EmitTypedEvent(&types.EventRewardPoolRemoved{
+		LaunchID: msg.LaunchID,
+	})
+}
@@ -0,0 +1,105 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	tc "github.com/tendermint/spn/testutil/constructor"
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/sample"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+	"github.com/tendermint/spn/x/reward/types"
+)
+
+func TestMsgCancelRewardPool(t *testing.T) {
+	var (
+		sdkCtx, tk, ts = testkeeper.NewTestSetup(t)
+		ctx            = sdk.WrapSDKContext(sdkCtx)
+		contributor    = sample.Address(r)
+		contributed    = tc.Coins(t, "100foo,50bar")
+	)
+	tk.Mint(sdkCtx, contributor, contributed)
+
+	rewardPool := initRewardPool(t, sdkCtx, tk, ts)
+	_, err := ts.RewardSrv.AddRewards(ctx, types.NewMsgAddRewards(contributor, rewardPool.LaunchID, contributed))
+	require.NoError(t, err)
+
+	launchedRewardPool := initRewardPool(t, sdkCtx, tk, ts)
+	launchedChain, found := tk.LaunchKeeper.GetChain(sdkCtx, launchedRewardPool.LaunchID)
+	require.True(t, found)
+	launchedChain.LaunchTriggered = true
+	tk.LaunchKeeper.SetChain(sdkCtx, launchedChain)
+
+	noPoolCoordID, noPoolCoordAddr := ts.CreateCoordinator(ctx, r)
+	noPoolLaunchID := tk.LaunchKeeper.AppendChain(sdkCtx, sample.Chain(r, 0, noPoolCoordID))
+
+	_, otherCoordAddr := ts.CreateCoordinator(ctx, r)
+
+	tests := []struct {
+		name string
+		msg  types.MsgCancelRewardPool
+		err  error
+	}{
+		{
+			name: "should prevent cancelling the reward pool of a non existing chain",
+			msg:  *types.NewMsgCancelRewardPool(rewardPool.Provider, 1000),
+			err:  launchtypes.ErrChainNotFound,
+		},
+		{
+			name: "should prevent cancelling a reward pool from a non coordinator address",
+			msg:  *types.NewMsgCancelRewardPool(sample.Address(r), rewardPool.LaunchID),
+			err:  profiletypes.ErrCoordAddressNotFound,
+		},
+		{
+			name: "should prevent cancelling a reward pool from another coordinator",
+			msg:  *types.NewMsgCancelRewardPool(otherCoordAddr.String(), rewardPool.LaunchID),
+			err:  types.ErrInvalidCoordinatorID,
+		},
+		{
+			name: "should prevent cancelling a reward pool with launch triggered",
+			msg:  *types.NewMsgCancelRewardPool(launchedRewardPool.Provider, launchedRewardPool.LaunchID),
+			err:  launchtypes.ErrTriggeredLaunch,
+		},
+		{
+			name: "should prevent cancelling a non existing reward pool",
+			msg:  *types.NewMsgCancelRewardPool(noPoolCoordAddr.String(), noPoolLaunchID),
+			err:  types.ErrRewardPoolNotFound,
+		},
+		{
+			name: "should allow cancelling a reward pool and refund all the contributors",
+			msg:  *types.NewMsgCancelRewardPool(rewardPool.Provider, rewardPool.LaunchID),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var previousBalances []sdk.Coins
+			pool, poolFound := tk.RewardKeeper.GetRewardPool(sdkCtx, tt.msg.LaunchID)
+			if poolFound {
+				for _, contribution := range pool.AllContributions() {
+					previousBalances = append(previousBalances, tk.BankKeeper.GetAllBalances(
+						sdkCtx,
+						sdk.MustAccAddressFromBech32(contribution.Contributor),
+					))
+				}
+			}
+
+			_, err := ts.RewardSrv.CancelRewardPool(ctx, &tt.msg)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+
+			_, found := tk.RewardKeeper.GetRewardPool(sdkCtx, tt.msg.LaunchID)
+			require.False(t, found)
+
+			for i, contribution := range pool.AllContributions() {
+				balance := tk.BankKeeper.GetAllBalances(sdkCtx, sdk.MustAccAddressFromBech32(contribution.Contributor))
+				require.Equal(t, previousBalances[i].Add(contribution.Coins...), balance)
+			}
+		})
+	}
+}
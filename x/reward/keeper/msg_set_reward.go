@@ -33,6 +33,10 @@ func (k msgServer) SetRewards(goCtx context.Context, msg *types.MsgSetRewards) (
 	if chain.LaunchTriggered {
 		return nil, sdkerrors.Wrapf(launchtypes.ErrTriggeredLaunch, "%d", msg.LaunchID)
 	}
+	// an archived chain can no longer be launched
+	if chain.Archived {
+		return nil, sdkerrors.Wrapf(launchtypes.ErrChainArchived, "%d", msg.LaunchID)
+	}
 
 	provider, err := sdk.AccAddressFromBech32(msg.Provider)
 	if err != nil {
@@ -45,28 +49,30 @@ func (k msgServer) SetRewards(goCtx context.Context, msg *types.MsgSetRewards) (
 	)
 	rewardPool, poolFound := k.GetRewardPool(ctx, msg.LaunchID)
 	if !poolFound {
-		// create the reward pool and transfer tokens if not created yet
-		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, provider, types.ModuleName, msg.Coins); err != nil {
-			return nil, sdkerrors.Wrap(sdkerrortypes.ErrInsufficientFunds, err.Error())
-		}
 		rewardPool = types.NewRewardPool(msg.LaunchID, 0)
 	} else {
 		previousCoins = rewardPool.RemainingCoins
 		previousLastRewardHeight = rewardPool.LastRewardHeight
-		if err := SetBalance(ctx, k.bankKeeper, provider, msg.Coins, rewardPool.RemainingCoins); err != nil {
-			return nil, err
-		}
 	}
 
+	// the coins replace the contribution of the provider to the reward pool
+	if err := SetBalance(ctx, k.bankKeeper, provider, msg.Coins, rewardPool.ContributionOf(msg.Provider)); err != nil {
+		return nil, err
+	}
+	rewardPool.SetContribution(msg.Provider, msg.Coins)
+
 	if msg.Coins.Empty() || msg.LastRewardHeight == 0 {
+		// the reward pool is removed and all the contributors are refunded
+		if err := k.RefundContributors(ctx, rewardPool, rewardPool.InitialCoins); err != nil {
+			return nil, err
+		}
 		rewardPool.InitialCoins = sdk.NewCoins()
 		rewardPool.RemainingCoins = sdk.NewCoins()
 		rewardPool.LastRewardHeight = 0
 		k.RemoveRewardPool(ctx, msg.LaunchID)
 		err = ctx.EventManager().EmitTypedEvent(&types.EventRewardPoolRemoved{LaunchID: msg.LaunchID})
 	} else {
-		rewardPool.InitialCoins = msg.Coins
-		rewardPool.RemainingCoins = msg.Coins
+		rewardPool.RemainingCoins = rewardPool.InitialCoins
 		rewardPool.Provider = msg.Provider
 		rewardPool.LastRewardHeight = msg.LastRewardHeight
 		k.SetRewardPool(ctx, rewardPool)
@@ -64,11 +64,13 @@ func TestMsgSetRewards(t *testing.T) {
 		emptyCoinsRewardPool       = initRewardPool(t, sdkCtx, tk, ts)
 		zeroRewardHeightRewardPool = initRewardPool(t, sdkCtx, tk, ts)
 		launchedRewardPool         = initRewardPool(t, sdkCtx, tk, ts)
+		archivedRewardPool         = initRewardPool(t, sdkCtx, tk, ts)
 	)
 	launchTriggeredChain, found := tk.LaunchKeeper.GetChain(sdkCtx, launchedRewardPool.LaunchID)
 	require.True(t, found)
 	launchTriggeredChain.LaunchTriggered = true
 	tk.LaunchKeeper.SetChain(sdkCtx, launchTriggeredChain)
+	require.NoError(t, tk.LaunchKeeper.ArchiveChain(sdkCtx, archivedRewardPool.LaunchID))
 
 	// setup a chain with no reward pool
 	noPoolCoordID, noPoolCoordAddr := ts.CreateCoordinator(ctx, r)
@@ -123,6 +125,16 @@ func TestMsgSetRewards(t *testing.T) {
 			},
 			err: launchtypes.ErrTriggeredLaunch,
 		},
+		{
+			name: "should prevent set rewards when the chain is archived",
+			msg: types.MsgSetRewards{
+				Provider:         archivedRewardPool.Provider,
+				LaunchID:         archivedRewardPool.LaunchID,
+				Coins:            archivedRewardPool.RemainingCoins,
+				LastRewardHeight: 1000,
+			},
+			err: launchtypes.ErrChainArchived,
+		},
 		{
 			name: "should prevent update rewards in existing pool when coordinator has insufficient funds",
 			msg: types.MsgSetRewards{
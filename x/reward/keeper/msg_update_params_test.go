@@ -0,0 +1,55 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/reward/types"
+)
+
+func TestMsgUpdateParams(t *testing.T) {
+	var (
+		sdkCtx, tk, ts = testkeeper.NewTestSetup(t)
+		ctx            = sdk.WrapSDKContext(sdkCtx)
+		authority      = tk.RewardKeeper.GetAuthority()
+		params         = types.NewParams()
+	)
+
+	tests := []struct {
+		name string
+		msg  types.MsgUpdateParams
+		err  error
+	}{
+		{
+			name: "should prevent updating params from an account other than authority",
+			msg: types.MsgUpdateParams{
+				Authority: sample.Address(r),
+				Params:    params,
+			},
+			err: govtypes.ErrInvalidSigner,
+		},
+		{
+			name: "should allow updating params from authority",
+			msg: types.MsgUpdateParams{
+				Authority: authority,
+				Params:    params,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ts.RewardSrv.UpdateParams(ctx, &tt.msg)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.EqualValues(t, tt.msg.Params, tk.RewardKeeper.GetParams(sdkCtx))
+		})
+	}
+}
@@ -40,11 +40,6 @@ func (k Keeper) DistributeRewards(
 		return sdkerrors.Wrapf(types.ErrRewardPoolClosed, "%d", launchID)
 	}
 
-	provider, err := sdk.AccAddressFromBech32(rewardPool.Provider)
-	if err != nil {
-		return ignterrors.Criticalf("can't parse the provider address %s", err.Error())
-	}
-
 	// lastBlockHeight must be strictly greater than the current reward height for the pool
 	if lastBlockHeight <= rewardPool.CurrentRewardHeight {
 		return sdkerrors.Wrapf(
@@ -55,6 +50,17 @@ func (k Keeper) DistributeRewards(
 		)
 	}
 
+	// if no block was monitored during the round, no reward is distributed and nothing is refunded,
+	// the coins of the round remain in the pool for the next rounds
+	if signatureCounts.BlockCount == 0 {
+		if closeRewardPool || lastBlockHeight >= rewardPool.LastRewardHeight {
+			return k.closeRewardPool(ctx, rewardPool)
+		}
+		rewardPool.CurrentRewardHeight = lastBlockHeight
+		k.SetRewardPool(ctx, rewardPool)
+		return nil
+	}
+
 	// only the monitored blocks relative to last reward height are rewarded
 	blockRatioNumerator := sdk.NewDec(lastBlockHeight).Sub(sdk.NewDec(rewardPool.CurrentRewardHeight))
 	blockRatioDenominator := sdk.NewDec(rewardPool.LastRewardHeight).Sub(sdk.NewDec(rewardPool.CurrentRewardHeight))
@@ -63,6 +69,9 @@ func (k Keeper) DistributeRewards(
 		blockRatio = sdk.OneDec()
 	}
 
+	// the rewards and the refund of the round are both relative to the coins of the pool at the beginning of the round
+	roundCoins := rewardPool.RemainingCoins
+
 	// store the total relative signature distributed to calculate the refund for the round
 	totalRelativeSignaturesDistributed := sdk.ZeroDec()
 
@@ -98,7 +107,7 @@ func (k Keeper) DistributeRewards(
 		signatureRatio := signatureCount.RelativeSignatures.Quo(
 			sdk.NewDecFromInt(sdkmath.NewIntFromUint64(signatureCounts.BlockCount)),
 		)
-		rewards, err := CalculateRewards(blockRatio, signatureRatio, rewardPool.RemainingCoins)
+		rewards, err := CalculateRewards(blockRatio, signatureRatio, roundCoins)
 		if err != nil {
 			return ignterrors.Criticalf("invalid reward: %s", err.Error())
 		}
@@ -107,6 +116,7 @@ func (k Keeper) DistributeRewards(
 	}
 
 	// distribute the rewards to validators
+	totalDistributed := sdk.NewCoins()
 	for address, rewards := range rewardsToDistribute {
 		coins, isNegative := rewardPool.RemainingCoins.SafeSub(rewards...)
 		if isNegative {
@@ -129,25 +139,18 @@ func (k Keeper) DistributeRewards(
 		}); err != nil {
 			return ignterrors.Criticalf("error emitting event: %s", err.Error())
 		}
+		totalDistributed = totalDistributed.Add(rewards...)
+	}
+
+	// record the rewards distributed in the statistics of the chain coordinator
+	if chain, found := k.launchKeeper.GetChain(ctx, launchID); found && !totalDistributed.Empty() {
+		k.profileKeeper.AddRewardsDistributed(ctx, chain.CoordinatorID, totalDistributed)
 	}
 
 	// if the reward pool is closed or last reward height is reached
-	// the remaining coins are refunded and reward pool is deleted
+	// the remaining coins are refunded to the contributors and reward pool is closed
 	if closeRewardPool || lastBlockHeight >= rewardPool.LastRewardHeight {
-		if err := k.bankKeeper.SendCoinsFromModuleToAccount(
-			ctx,
-			types.ModuleName,
-			provider,
-			rewardPool.RemainingCoins); err != nil {
-			return ignterrors.Criticalf("send rewards error: %s", err.Error())
-		}
-
-		// close the pool
-		rewardPool.Closed = true
-		rewardPool.RemainingCoins = rewardPool.RemainingCoins.Sub(rewardPool.RemainingCoins...) // sub coins transferred
-		k.SetRewardPool(ctx, rewardPool)
-
-		return nil
+		return k.closeRewardPool(ctx, rewardPool)
 	}
 
 	// Otherwise, the refund is relative to the block ratio and the reward pool is updated
@@ -156,12 +159,12 @@ func (k Keeper) DistributeRewards(
 	blockCount := sdk.NewDecFromInt(sdkmath.NewIntFromUint64(signatureCounts.BlockCount))
 	refundRatioNumerator := blockCount.Sub(totalRelativeSignaturesDistributed)
 	refundRatio := refundRatioNumerator.Quo(blockCount)
-	refund, err := CalculateRewards(blockRatio, refundRatio, rewardPool.RemainingCoins)
+	refund, err := CalculateRewards(blockRatio, refundRatio, roundCoins)
 	if err != nil {
 		return ignterrors.Criticalf("invalid reward: %s", err.Error())
 	}
 
-	// if refund is non-null, refund is sent to the provider
+	// if refund is non-null, refund is sent to the contributors
 	if !refund.IsZero() {
 		coins, isNegative := rewardPool.RemainingCoins.SafeSub(refund...)
 		if isNegative {
@@ -169,13 +172,8 @@ func (k Keeper) DistributeRewards(
 		}
 		rewardPool.RemainingCoins = coins
 
-		// send rewards to the address
-		if err := k.bankKeeper.SendCoinsFromModuleToAccount(
-			ctx,
-			types.ModuleName,
-			provider,
-			rewardPool.RemainingCoins); err != nil {
-			return ignterrors.Criticalf("send rewards error: %s", err.Error())
+		if err := k.RefundContributors(ctx, rewardPool, refund); err != nil {
+			return err
 		}
 	}
 
@@ -185,6 +183,46 @@ func (k Keeper) DistributeRewards(
 	return nil
 }
 
+// CloseRewardPool closes the reward pool of a chain and refunds its remaining coins to the contributors
+// It settles a reward pool for which the monitoring ended without the last monitoring packet being received
+func (k Keeper) CloseRewardPool(ctx sdk.Context, launchID uint64) error {
+	rewardPool, found := k.GetRewardPool(ctx, launchID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrRewardPoolNotFound, "%d", launchID)
+	}
+	if rewardPool.Closed {
+		return sdkerrors.Wrapf(types.ErrRewardPoolClosed, "%d", launchID)
+	}
+	return k.closeRewardPool(ctx, rewardPool)
+}
+
+// RefundRewardPool refunds the remaining coins of the reward pool of a chain to the contributors and closes the pool
+// Nothing is done if the chain has no reward pool or if its reward pool is already closed
+func (k Keeper) RefundRewardPool(ctx sdk.Context, launchID uint64) error {
+	rewardPool, found := k.GetRewardPool(ctx, launchID)
+	if !found || rewardPool.Closed {
+		return nil
+	}
+	return k.closeRewardPool(ctx, rewardPool)
+}
+
+// closeRewardPool refunds the remaining coins of the reward pool to the contributors and closes the pool
+func (k Keeper) closeRewardPool(ctx sdk.Context, rewardPool types.RewardPool) error {
+	refund := rewardPool.RemainingCoins
+	if err := k.RefundContributors(ctx, rewardPool, refund); err != nil {
+		return err
+	}
+
+	rewardPool.Closed = true
+	rewardPool.RemainingCoins = sdk.NewCoins()
+	k.SetRewardPool(ctx, rewardPool)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventRewardPoolClosed{
+		LaunchID: rewardPool.LaunchID,
+		Refund:   refund,
+	})
+}
+
 // CalculateRewards calculates the reward relative to the signature and block ratio
 func CalculateRewards(blockRatio, signatureRatio sdk.Dec, coins sdk.Coins) (sdk.Coins, error) {
 	// ratio can't be greater than one
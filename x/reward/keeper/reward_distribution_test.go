@@ -688,3 +688,243 @@ func TestKeeper_DistributeRewards(t *testing.T) {
 		})
 	}
 }
+
+func Test_DistributeRewardsPeriodically(t *testing.T) {
+	var (
+		ctx, tk, _  = testkeeper.NewTestSetup(t)
+		provider    = sample.Address(r)
+		contributor = sample.Address(r)
+		valFoo      = sample.Address(r)
+		valOpAddr   = sample.Address(r)
+		launchID    = uint64(1)
+		balance     = func(address string) sdk.Coins {
+			return tk.BankKeeper.GetAllBalances(ctx, sdk.MustAccAddressFromBech32(address))
+		}
+
+		providerContribution    = tc.Coins(t, "60aaa,100bbb")
+		contributorContribution = tc.Coins(t, "40aaa")
+		coins                   = providerContribution.Add(contributorContribution...)
+
+		// proRata returns the part of a refund given to a contribution
+		proRata = func(refund, contribution sdk.Coins) sdk.Coins {
+			part := sdk.NewCoins()
+			for _, coin := range refund {
+				amount := coin.Amount.Mul(contribution.AmountOf(coin.Denom)).Quo(coins.AmountOf(coin.Denom))
+				part = part.Add(sdk.NewCoin(coin.Denom, amount))
+			}
+			return part
+		}
+	)
+	tk.ProfileKeeper.SetValidatorByOperatorAddress(ctx, profiletypes.ValidatorByOperatorAddress{
+		ValidatorAddress: valFoo,
+		OperatorAddress:  valOpAddr,
+	})
+
+	tk.RewardKeeper.SetRewardPool(ctx, types.RewardPool{
+		LaunchID:         launchID,
+		Provider:         provider,
+		InitialCoins:     coins,
+		RemainingCoins:   coins,
+		LastRewardHeight: 10,
+		Contributions: []types.RewardContribution{
+			{Contributor: provider, Coins: providerContribution},
+			{Contributor: contributor, Coins: contributorContribution},
+		},
+	})
+	require.NoError(t, tk.BankKeeper.MintCoins(ctx, types.ModuleName, coins))
+
+	// the first round covers 4 blocks out of 10 and the validator signs half of the blocks of the round
+	// the coins of the round are split between the rewards for the signed blocks and the refund for the unsigned blocks
+	firstRoundCoins, err := keeper.CalculateRewards(sdk.NewDecWithPrec(4, 1), sdk.OneDec(), coins)
+	require.NoError(t, err)
+	firstRoundRewards, err := keeper.CalculateRewards(sdk.NewDecWithPrec(4, 1), sdk.NewDecWithPrec(5, 1), coins)
+	require.NoError(t, err)
+	firstRoundRefund := firstRoundCoins.Sub(firstRoundRewards...)
+
+	t.Run("should distribute the rewards of a round without closing the reward pool", func(t *testing.T) {
+		err := tk.RewardKeeper.DistributeRewards(ctx, launchID, tc.SignatureCounts(2,
+			tc.SignatureCount(t, valOpAddr, "1"),
+		), 4, false)
+		require.NoError(t, err)
+
+		require.True(t, balance(valFoo).IsEqual(firstRoundRewards), balance(valFoo).String())
+		require.True(t, balance(provider).IsEqual(proRata(firstRoundRefund, providerContribution)), balance(provider).String())
+		require.True(t, balance(contributor).IsEqual(proRata(firstRoundRefund, contributorContribution)), balance(contributor).String())
+
+		rewardPool, found := tk.RewardKeeper.GetRewardPool(ctx, launchID)
+		require.True(t, found)
+		require.False(t, rewardPool.Closed)
+		require.EqualValues(t, 4, rewardPool.CurrentRewardHeight)
+		require.True(t, rewardPool.RemainingCoins.IsEqual(coins.Sub(firstRoundRewards...).Sub(firstRoundRefund...)))
+	})
+
+	t.Run("should skip a round without monitored blocks", func(t *testing.T) {
+		err := tk.RewardKeeper.DistributeRewards(ctx, launchID, tc.SignatureCounts(0), 6, false)
+		require.NoError(t, err)
+
+		require.True(t, balance(valFoo).IsEqual(firstRoundRewards), balance(valFoo).String())
+		require.True(t, balance(provider).IsEqual(proRata(firstRoundRefund, providerContribution)), balance(provider).String())
+		require.True(t, balance(contributor).IsEqual(proRata(firstRoundRefund, contributorContribution)), balance(contributor).String())
+
+		rewardPool, found := tk.RewardKeeper.GetRewardPool(ctx, launchID)
+		require.True(t, found)
+		require.False(t, rewardPool.Closed)
+		require.EqualValues(t, 6, rewardPool.CurrentRewardHeight)
+		require.True(t, rewardPool.RemainingCoins.IsEqual(coins.Sub(firstRoundRewards...).Sub(firstRoundRefund...)))
+	})
+
+	t.Run("should close the reward pool when the last reward height is reached", func(t *testing.T) {
+		err := tk.RewardKeeper.DistributeRewards(ctx, launchID, tc.SignatureCounts(1,
+			tc.SignatureCount(t, valOpAddr, "1"),
+		), 10, false)
+		require.NoError(t, err)
+
+		// all the blocks of the last round are signed, the remaining coins are distributed as rewards
+		require.True(t, balance(valFoo).IsEqual(coins.Sub(firstRoundRefund...)), balance(valFoo).String())
+		require.True(t, balance(provider).IsEqual(proRata(firstRoundRefund, providerContribution)), balance(provider).String())
+		require.True(t, balance(contributor).IsEqual(proRata(firstRoundRefund, contributorContribution)), balance(contributor).String())
+
+		rewardPool, found := tk.RewardKeeper.GetRewardPool(ctx, launchID)
+		require.True(t, found)
+		require.True(t, rewardPool.Closed)
+		require.True(t, rewardPool.RemainingCoins.IsZero())
+	})
+}
+
+func TestKeeper_DistributeRewardsCoordinatorStats(t *testing.T) {
+	var (
+		ctx, tk, _ = testkeeper.NewTestSetup(t)
+		launchID   = uint64(1)
+		coordID    = uint64(2)
+		valFoo     = sample.Address(r)
+		valBar     = sample.Address(r)
+		rewardPool = types.RewardPool{
+			LaunchID:         launchID,
+			Provider:         sample.Address(r),
+			InitialCoins:     tc.Coins(t, "100aaa,100bbb"),
+			RemainingCoins:   tc.Coins(t, "100aaa,100bbb"),
+			LastRewardHeight: 20,
+		}
+	)
+	tk.LaunchKeeper.SetChain(ctx, sample.Chain(r, launchID, coordID))
+	tk.RewardKeeper.SetRewardPool(ctx, rewardPool)
+	require.NoError(t, tk.BankKeeper.MintCoins(ctx, types.ModuleName, rewardPool.RemainingCoins))
+
+	t.Run("should record the rewards distributed in the coordinator stats", func(t *testing.T) {
+		err := tk.RewardKeeper.DistributeRewards(
+			ctx,
+			launchID,
+			tc.SignatureCounts(1,
+				tc.SignatureCount(t, valFoo, "0.5"),
+				tc.SignatureCount(t, valBar, "0.3"),
+			),
+			10,
+			false,
+		)
+		require.NoError(t, err)
+
+		stats, found := tk.ProfileKeeper.GetCoordinatorStats(ctx, coordID)
+		require.True(t, found)
+		require.True(t, stats.RewardsDistributed.IsEqual(tc.Coins(t, "40aaa,40bbb")), stats.RewardsDistributed.String())
+	})
+
+	t.Run("should accumulate the rewards distributed in the coordinator stats", func(t *testing.T) {
+		err := tk.RewardKeeper.DistributeRewards(
+			ctx,
+			launchID,
+			tc.SignatureCounts(1,
+				tc.SignatureCount(t, valFoo, "1"),
+			),
+			20,
+			false,
+		)
+		require.NoError(t, err)
+
+		// the stats hold the total rewards received by the validators
+		distributed := tk.BankKeeper.GetAllBalances(ctx, sdk.MustAccAddressFromBech32(valFoo)).
+			Add(tk.BankKeeper.GetAllBalances(ctx, sdk.MustAccAddressFromBech32(valBar))...)
+		stats, found := tk.ProfileKeeper.GetCoordinatorStats(ctx, coordID)
+		require.True(t, found)
+		require.True(t, stats.RewardsDistributed.IsEqual(distributed), stats.RewardsDistributed.String())
+	})
+}
+
+func TestKeeper_CloseRewardPool(t *testing.T) {
+	var (
+		ctx, tk, _ = testkeeper.NewTestSetup(t)
+		provider   = sample.Address(r)
+		launchID   = uint64(1)
+		coins      = tc.Coins(t, "100aaa,100bbb")
+	)
+	tk.RewardKeeper.SetRewardPool(ctx, types.RewardPool{
+		LaunchID:         launchID,
+		Provider:         provider,
+		InitialCoins:     coins,
+		RemainingCoins:   coins,
+		LastRewardHeight: 10,
+	})
+	require.NoError(t, tk.BankKeeper.MintCoins(ctx, types.ModuleName, coins))
+
+	t.Run("should close the reward pool and refund the remaining coins", func(t *testing.T) {
+		err := tk.RewardKeeper.CloseRewardPool(ctx, launchID)
+		require.NoError(t, err)
+
+		balance := tk.BankKeeper.GetAllBalances(ctx, sdk.MustAccAddressFromBech32(provider))
+		require.True(t, balance.IsEqual(coins), balance.String())
+
+		rewardPool, found := tk.RewardKeeper.GetRewardPool(ctx, launchID)
+		require.True(t, found)
+		require.True(t, rewardPool.Closed)
+		require.True(t, rewardPool.RemainingCoins.IsZero())
+	})
+
+	t.Run("should prevent closing a closed reward pool", func(t *testing.T) {
+		err := tk.RewardKeeper.CloseRewardPool(ctx, launchID)
+		require.ErrorIs(t, err, types.ErrRewardPoolClosed)
+	})
+
+	t.Run("should prevent closing a non existent reward pool", func(t *testing.T) {
+		err := tk.RewardKeeper.CloseRewardPool(ctx, 1000)
+		require.ErrorIs(t, err, types.ErrRewardPoolNotFound)
+	})
+}
+
+func TestKeeper_RefundRewardPool(t *testing.T) {
+	var (
+		ctx, tk, _ = testkeeper.NewTestSetup(t)
+		provider   = sample.Address(r)
+		launchID   = uint64(1)
+		coins      = tc.Coins(t, "100aaa,100bbb")
+	)
+	tk.RewardKeeper.SetRewardPool(ctx, types.RewardPool{
+		LaunchID:         launchID,
+		Provider:         provider,
+		InitialCoins:     coins,
+		RemainingCoins:   coins,
+		LastRewardHeight: 10,
+		Contributions: []types.RewardContribution{
+			{Contributor: provider, Coins: coins},
+		},
+	})
+	require.NoError(t, tk.BankKeeper.MintCoins(ctx, types.ModuleName, coins))
+
+	t.Run("should refund the remaining coins and close the reward pool", func(t *testing.T) {
+		require.NoError(t, tk.RewardKeeper.RefundRewardPool(ctx, launchID))
+
+		balance := tk.BankKeeper.GetAllBalances(ctx, sdk.MustAccAddressFromBech32(provider))
+		require.True(t, balance.IsEqual(coins), balance.String())
+
+		rewardPool, found := tk.RewardKeeper.GetRewardPool(ctx, launchID)
+		require.True(t, found)
+		require.True(t, rewardPool.Closed)
+		require.True(t, rewardPool.RemainingCoins.IsZero())
+	})
+
+	t.Run("should do nothing for a closed or non existent reward pool", func(t *testing.T) {
+		require.NoError(t, tk.RewardKeeper.RefundRewardPool(ctx, launchID))
+		require.NoError(t, tk.RewardKeeper.RefundRewardPool(ctx, 1000))
+
+		balance := tk.BankKeeper.GetAllBalances(ctx, sdk.MustAccAddressFromBech32(provider))
+		require.True(t, balance.IsEqual(coins), balance.String())
+	})
+}
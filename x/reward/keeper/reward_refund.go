@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ignterrors "github.com/ignite/modules/errors"
+
+	"github.com/tendermint/spn/x/reward/types"
+)
+
+// RefundContributors sends a refund from the module account to the contributors of the reward pool
+// the refund is split pro-rata to the contributions of each contributor
+func (k Keeper) RefundContributors(ctx sdk.Context, rewardPool types.RewardPool, refund sdk.Coins) error {
+	if refund.IsZero() {
+		return nil
+	}
+
+	for _, share := range rewardPool.RefundShares(refund) {
+		contributor, err := sdk.AccAddressFromBech32(share.Contributor)
+		if err != nil {
+			return ignterrors.Criticalf("can't parse the contributor address %s", err.Error())
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, contributor, share.Coins); err != nil {
+			return ignterrors.Criticalf("send refund error: %s", err.Error())
+		}
+	}
+	return nil
+}
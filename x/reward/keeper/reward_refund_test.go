@@ -0,0 +1,38 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	tc "github.com/tendermint/spn/testutil/constructor"
+	testkeeper "github.com/tendermint/spn/testutil/keeper"
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/reward/types"
+)
+
+func TestKeeper_RefundContributors(t *testing.T) {
+	ctx, tk, _ := testkeeper.NewTestSetup(t)
+
+	t.Run("should refund the contributors pro-rata to their contributions", func(t *testing.T) {
+		provider, contributor := sample.Address(r), sample.Address(r)
+		rewardPool := types.RewardPool{
+			LaunchID:     1,
+			Provider:     provider,
+			InitialCoins: tc.Coins(t, "100foo"),
+			Contributions: []types.RewardContribution{
+				{Contributor: provider, Coins: tc.Coins(t, "75foo")},
+				{Contributor: contributor, Coins: tc.Coins(t, "25foo")},
+			},
+		}
+		refund := tc.Coins(t, "40foo")
+		require.NoError(t, tk.BankKeeper.MintCoins(ctx, types.ModuleName, refund))
+
+		require.NoError(t, tk.RewardKeeper.RefundContributors(ctx, rewardPool, refund))
+		balance := tk.BankKeeper.GetAllBalances(ctx, sdk.MustAccAddressFromBech32(provider))
+		require.True(t, balance.IsEqual(tc.Coins(t, "30foo")), balance.String())
+		balance = tk.BankKeeper.GetAllBalances(ctx, sdk.MustAccAddressFromBech32(contributor))
+		require.True(t, balance.IsEqual(tc.Coins(t, "10foo")), balance.String())
+	})
+}
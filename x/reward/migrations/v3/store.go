@@ -0,0 +1,53 @@
+package v3
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tendermint/spn/x/reward/types"
+)
+
+// MigrateStore performs in-place store migrations from v2 to v3 of the reward module:
+//   - the provider of the reward pools without contributions is recorded as their only contributor
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	rewardPoolStore := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.RewardPoolKeyPrefix))
+
+	// the reward pools are collected first since the reward pool store is updated during the migration
+	var rewardPools []types.RewardPool
+	iterator := rewardPoolStore.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		var rewardPool types.RewardPool
+		if err := cdc.Unmarshal(iterator.Value(), &rewardPool); err != nil {
+			iterator.Close()
+			return err
+		}
+		rewardPools = append(rewardPools, rewardPool)
+	}
+	iterator.Close()
+
+	for _, rewardPool := range rewardPools {
+		if len(rewardPool.Contributions) > 0 {
+			continue
+		}
+
+		// a pool without initial coins is credited with its remaining coins so they can be refunded
+		coins := rewardPool.InitialCoins
+		if coins.Empty() {
+			coins = rewardPool.RemainingCoins
+		}
+		if coins.Empty() {
+			continue
+		}
+		rewardPool.SetContribution(rewardPool.Provider, coins)
+
+		bz, err := cdc.Marshal(&rewardPool)
+		if err != nil {
+			return err
+		}
+		rewardPoolStore.Set(types.RewardPoolKey(rewardPool.LaunchID), bz)
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package v3_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	tc "github.com/tendermint/spn/testutil/constructor"
+	"github.com/tendermint/spn/testutil/sample"
+	v3 "github.com/tendermint/spn/x/reward/migrations/v3"
+	"github.com/tendermint/spn/x/reward/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	var (
+		r        = sample.Rand()
+		cdc      = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+		storeKey = sdk.NewKVStoreKey(types.StoreKey)
+		tKey     = sdk.NewTransientStoreKey("transient_test")
+		ctx      = testutil.DefaultContext(storeKey, tKey)
+		store    = prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.RewardPoolKeyPrefix))
+	)
+
+	// v2 fixture: the contributions of the reward pools are not recorded
+	provider, contributor := sample.Address(r), sample.Address(r)
+	rewardPools := []types.RewardPool{
+		{
+			LaunchID:       0,
+			Provider:       sample.Address(r),
+			InitialCoins:   tc.Coins(t, "100foo,50bar"),
+			RemainingCoins: tc.Coins(t, "40foo,20bar"),
+		},
+		{
+			LaunchID:       1,
+			Provider:       sample.Address(r),
+			InitialCoins:   sdk.NewCoins(),
+			RemainingCoins: tc.Coins(t, "30foo"),
+		},
+		{
+			LaunchID:       2,
+			Provider:       provider,
+			InitialCoins:   tc.Coins(t, "100foo"),
+			RemainingCoins: tc.Coins(t, "100foo"),
+			Contributions: []types.RewardContribution{
+				{Contributor: provider, Coins: tc.Coins(t, "75foo")},
+				{Contributor: contributor, Coins: tc.Coins(t, "25foo")},
+			},
+		},
+		{
+			LaunchID:       3,
+			Provider:       sample.Address(r),
+			InitialCoins:   sdk.NewCoins(),
+			RemainingCoins: sdk.NewCoins(),
+			Closed:         true,
+		},
+	}
+	for i := range rewardPools {
+		store.Set(types.RewardPoolKey(rewardPools[i].LaunchID), cdc.MustMarshal(&rewardPools[i]))
+	}
+
+	require.NoError(t, v3.MigrateStore(ctx, storeKey, cdc))
+
+	getRewardPool := func(launchID uint64) (rewardPool types.RewardPool) {
+		cdc.MustUnmarshal(store.Get(types.RewardPoolKey(launchID)), &rewardPool)
+		return rewardPool
+	}
+
+	t.Run("should record the provider as the contributor of the initial coins", func(t *testing.T) {
+		rewardPool := getRewardPool(0)
+		require.Equal(t, []types.RewardContribution{
+			{Contributor: rewardPools[0].Provider, Coins: rewardPools[0].InitialCoins},
+		}, rewardPool.Contributions)
+		require.True(t, rewardPool.InitialCoins.IsEqual(rewardPools[0].InitialCoins))
+		require.True(t, rewardPool.RemainingCoins.IsEqual(rewardPools[0].RemainingCoins))
+	})
+
+	t.Run("should record the provider as the contributor of the remaining coins of a pool without initial coins", func(t *testing.T) {
+		rewardPool := getRewardPool(1)
+		require.Equal(t, []types.RewardContribution{
+			{Contributor: rewardPools[1].Provider, Coins: rewardPools[1].RemainingCoins},
+		}, rewardPool.Contributions)
+		require.True(t, rewardPool.InitialCoins.IsEqual(rewardPools[1].RemainingCoins))
+	})
+
+	t.Run("should keep the recorded contributions", func(t *testing.T) {
+		require.Equal(t, rewardPools[2], getRewardPool(2))
+	})
+
+	t.Run("should keep an empty reward pool", func(t *testing.T) {
+		require.Empty(t, getRewardPool(3).Contributions)
+	})
+}
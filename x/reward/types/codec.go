@@ -9,12 +9,18 @@ import (
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSetRewards{}, "reward/SetRewards", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "reward/UpdateParams", nil)
+	cdc.RegisterConcrete(&MsgAddRewards{}, "reward/AddRewards", nil)
+	cdc.RegisterConcrete(&MsgCancelRewardPool{}, "reward/CancelRewardPool", nil)
 	// this line is used by starport scaffolding # 2
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSetRewards{},
+		&MsgUpdateParams{},
+		&MsgAddRewards{},
+		&MsgCancelRewardPool{},
 	)
 	// this line is used by starport scaffolding # 3
 
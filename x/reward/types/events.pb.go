@@ -121,6 +121,118 @@ func (m *EventRewardPoolRemoved) GetLaunchID() uint64 {
 	return 0
 }
 
+type EventRewardPoolClosed struct {
+	LaunchID uint64                                   `protobuf:"varint,1,opt,name=launchID,proto3" json:"launchID,omitempty"`
+	Refund   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=refund,proto3,casttype=github.com/cosmos/cosmos-sdk/types.Coin,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"refund"`
+}
+
+func (m *EventRewardPoolClosed) Reset()         { *m = EventRewardPoolClosed{} }
+func (m *EventRewardPoolClosed) String() string { return proto.CompactTextString(m) }
+func (*EventRewardPoolClosed) ProtoMessage()    {}
+func (*EventRewardPoolClosed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aa0ffbf8147a08e, []int{2}
+}
+func (m *EventRewardPoolClosed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRewardPoolClosed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRewardPoolClosed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRewardPoolClosed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRewardPoolClosed.Merge(m, src)
+}
+func (m *EventRewardPoolClosed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRewardPoolClosed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRewardPoolClosed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRewardPoolClosed proto.InternalMessageInfo
+
+func (m *EventRewardPoolClosed) GetLaunchID() uint64 {
+	if m != nil {
+		return m.LaunchID
+	}
+	return 0
+}
+
+func (m *EventRewardPoolClosed) GetRefund() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Refund
+	}
+	return nil
+}
+
+type EventRewardsAdded struct {
+	LaunchID    uint64                                   `protobuf:"varint,1,opt,name=launchID,proto3" json:"launchID,omitempty"`
+	Contributor string                                   `protobuf:"bytes,2,opt,name=contributor,proto3" json:"contributor,omitempty"`
+	Coins       github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=coins,proto3,casttype=github.com/cosmos/cosmos-sdk/types.Coin,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+}
+
+func (m *EventRewardsAdded) Reset()         { *m = EventRewardsAdded{} }
+func (m *EventRewardsAdded) String() string { return proto.CompactTextString(m) }
+func (*EventRewardsAdded) ProtoMessage()    {}
+func (*EventRewardsAdded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aa0ffbf8147a08e, []int{3}
+}
+func (m *EventRewardsAdded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRewardsAdded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRewardsAdded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRewardsAdded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRewardsAdded.Merge(m, src)
+}
+func (m *EventRewardsAdded) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRewardsAdded) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRewardsAdded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRewardsAdded proto.InternalMessageInfo
+
+func (m *EventRewardsAdded) GetLaunchID() uint64 {
+	if m != nil {
+		return m.LaunchID
+	}
+	return 0
+}
+
+func (m *EventRewardsAdded) GetContributor() string {
+	if m != nil {
+		return m.Contributor
+	}
+	return ""
+}
+
+func (m *EventRewardsAdded) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
+
 type EventRewardsDistributed struct {
 	LaunchID uint64                                   `protobuf:"varint,1,opt,name=launchID,proto3" json:"launchID,omitempty"`
 	Receiver string                                   `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
@@ -131,7 +243,7 @@ func (m *EventRewardsDistributed) Reset()         { *m = EventRewardsDistributed
 func (m *EventRewardsDistributed) String() string { return proto.CompactTextString(m) }
 func (*EventRewardsDistributed) ProtoMessage()    {}
 func (*EventRewardsDistributed) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aa0ffbf8147a08e, []int{2}
+	return fileDescriptor_3aa0ffbf8147a08e, []int{4}
 }
 func (m *EventRewardsDistributed) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -184,34 +296,41 @@ func (m *EventRewardsDistributed) GetRewards() github_com_cosmos_cosmos_sdk_type
 func init() {
 	proto.RegisterType((*EventRewardPoolCreated)(nil), "tendermint.spn.reward.EventRewardPoolCreated")
 	proto.RegisterType((*EventRewardPoolRemoved)(nil), "tendermint.spn.reward.EventRewardPoolRemoved")
+	proto.RegisterType((*EventRewardPoolClosed)(nil), "tendermint.spn.reward.EventRewardPoolClosed")
+	proto.RegisterType((*EventRewardsAdded)(nil), "tendermint.spn.reward.EventRewardsAdded")
 	proto.RegisterType((*EventRewardsDistributed)(nil), "tendermint.spn.reward.EventRewardsDistributed")
 }
 
 func init() { proto.RegisterFile("reward/events.proto", fileDescriptor_3aa0ffbf8147a08e) }
 
 var fileDescriptor_3aa0ffbf8147a08e = []byte{
-	// 336 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x51, 0xbb, 0x4e, 0xc3, 0x30,
-	0x14, 0x8d, 0x29, 0xe2, 0x11, 0xb6, 0xf2, 0x0a, 0x1d, 0xd2, 0xaa, 0x0b, 0x59, 0xb0, 0x55, 0xe0,
-	0x0b, 0xda, 0x32, 0xb0, 0x55, 0x19, 0x61, 0x40, 0x79, 0x5c, 0xb5, 0x16, 0x8d, 0x6f, 0x64, 0xbb,
-	0x01, 0xfe, 0xa2, 0xdf, 0xc1, 0x97, 0x74, 0xec, 0xc8, 0x54, 0x50, 0xcb, 0x57, 0x30, 0xa1, 0xc4,
-	0xe9, 0x43, 0x42, 0x42, 0x9d, 0xae, 0xaf, 0xee, 0x39, 0xc7, 0x47, 0xe7, 0xd8, 0xc7, 0x12, 0x5e,
-	0x02, 0x19, 0x33, 0xc8, 0x40, 0x68, 0x45, 0x53, 0x89, 0x1a, 0xab, 0xa7, 0x1a, 0x44, 0x0c, 0x32,
-	0xe1, 0x42, 0x53, 0x95, 0x0a, 0x6a, 0x30, 0xb5, 0x93, 0x3e, 0xf6, 0xb1, 0x40, 0xb0, 0xfc, 0x65,
-	0xc0, 0x35, 0xa7, 0x54, 0x30, 0xe3, 0x29, 0x45, 0x1c, 0x96, 0x17, 0x37, 0x42, 0x95, 0xa0, 0x62,
-	0x61, 0xa0, 0x80, 0x65, 0xad, 0x10, 0x74, 0xd0, 0x62, 0x11, 0x72, 0x61, 0xee, 0xcd, 0x9e, 0x7d,
-	0x76, 0x97, 0x7f, 0xeb, 0x17, 0xcc, 0x1e, 0xe2, 0xb0, 0x23, 0x21, 0xd0, 0x10, 0x57, 0x6b, 0xf6,
-	0xc1, 0x30, 0x18, 0x89, 0x68, 0x70, 0xdf, 0x75, 0x48, 0x83, 0x78, 0xbb, 0xfe, 0x6a, 0xcf, 0x6f,
-	0xa9, 0xc4, 0x8c, 0xc7, 0x20, 0x9d, 0x9d, 0x06, 0xf1, 0x0e, 0xfd, 0xd5, 0xde, 0xbc, 0xfd, 0xa3,
-	0xe8, 0x43, 0x82, 0xd9, 0xff, 0x8a, 0xcd, 0x6f, 0x62, 0x9f, 0x6f, 0xd0, 0x54, 0x97, 0x2b, 0x2d,
-	0x79, 0x38, 0xda, 0xc2, 0x89, 0x84, 0x08, 0x78, 0xb6, 0x76, 0xb2, 0xdc, 0xab, 0x63, 0x62, 0xef,
-	0x9b, 0x44, 0x94, 0x53, 0x69, 0x54, 0xbc, 0xa3, 0xeb, 0x0b, 0x6a, 0xe2, 0xa0, 0x79, 0x1c, 0xb4,
-	0x8c, 0x83, 0x76, 0x90, 0x8b, 0xf6, 0xe3, 0x64, 0x56, 0xb7, 0x7e, 0x66, 0xf5, 0xcb, 0x3e, 0xd7,
-	0x83, 0x51, 0x48, 0x23, 0x4c, 0x58, 0x99, 0x9d, 0x19, 0x57, 0x2a, 0x7e, 0x66, 0xfa, 0x2d, 0x05,
-	0x55, 0x10, 0xde, 0x3f, 0xeb, 0xde, 0x96, 0x50, 0xe5, 0x2f, 0x6d, 0xb4, 0xdb, 0x93, 0xb9, 0x4b,
-	0xa6, 0x73, 0x97, 0x7c, 0xcd, 0x5d, 0x32, 0x5e, 0xb8, 0xd6, 0x74, 0xe1, 0x5a, 0x1f, 0x0b, 0xd7,
-	0x7a, 0xd8, 0x14, 0x5b, 0x57, 0xcf, 0x54, 0x2a, 0xd8, 0x6b, 0xd9, 0xab, 0x91, 0x0c, 0xf7, 0x8a,
-	0xe6, 0x6e, 0x7e, 0x03, 0x00, 0x00, 0xff, 0xff, 0xf2, 0xc4, 0x60, 0x04, 0x37, 0x02, 0x00, 0x00,
+	// 404 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x93, 0xb1, 0x92, 0xd3, 0x30,
+	0x10, 0x86, 0xad, 0x3b, 0x38, 0x40, 0x57, 0x61, 0x38, 0x30, 0x29, 0x1c, 0x8f, 0x1b, 0xdc, 0x20,
+	0xcd, 0x01, 0x2f, 0x40, 0xee, 0x28, 0xe8, 0x32, 0x2e, 0xa1, 0x60, 0x6c, 0x4b, 0x24, 0x1a, 0x6c,
+	0xad, 0x47, 0x92, 0x0d, 0xbc, 0x03, 0xc3, 0xe4, 0x39, 0x78, 0x0e, 0x8a, 0x94, 0x29, 0xa9, 0x42,
+	0x26, 0xe1, 0x29, 0xa8, 0x18, 0x5b, 0x4e, 0xe2, 0x81, 0x19, 0x42, 0x97, 0x4a, 0x5e, 0xef, 0xea,
+	0xf7, 0xff, 0xad, 0x77, 0xf1, 0x3d, 0xc5, 0x3f, 0x24, 0x8a, 0x51, 0x5e, 0x73, 0x69, 0x34, 0x29,
+	0x15, 0x18, 0x70, 0x2f, 0x0c, 0x97, 0x8c, 0xab, 0x42, 0x48, 0x43, 0x74, 0x29, 0x89, 0xad, 0x19,
+	0xdc, 0x9f, 0xc0, 0x04, 0xda, 0x0a, 0xda, 0x3c, 0xd9, 0xe2, 0x81, 0xd7, 0x29, 0xd8, 0xe3, 0x6d,
+	0x09, 0x90, 0x77, 0x19, 0x3f, 0x03, 0x5d, 0x80, 0xa6, 0x69, 0xa2, 0x39, 0xad, 0x2f, 0x53, 0x6e,
+	0x92, 0x4b, 0x9a, 0x81, 0x90, 0x36, 0x1f, 0x8e, 0xf1, 0x83, 0x97, 0xcd, 0x67, 0xe3, 0xf6, 0xe6,
+	0x18, 0x20, 0xbf, 0x52, 0x3c, 0x31, 0x9c, 0xb9, 0x03, 0x7c, 0x3b, 0x4f, 0x2a, 0x99, 0x4d, 0x5f,
+	0x5d, 0x7b, 0x28, 0x40, 0xd1, 0x8d, 0x78, 0x17, 0x37, 0xb9, 0x52, 0x41, 0x2d, 0x18, 0x57, 0xde,
+	0x49, 0x80, 0xa2, 0x3b, 0xf1, 0x2e, 0x0e, 0x9f, 0xff, 0xa5, 0x18, 0xf3, 0x02, 0xea, 0x7f, 0x2b,
+	0x86, 0xdf, 0x10, 0xbe, 0xf8, 0xd3, 0x48, 0x0e, 0xfa, 0x80, 0x8f, 0x2f, 0x08, 0x9f, 0x29, 0xfe,
+	0xae, 0x92, 0xcc, 0x3b, 0x09, 0x4e, 0xa3, 0xf3, 0xa7, 0x8f, 0x88, 0xe5, 0x25, 0x0d, 0x2f, 0xe9,
+	0x78, 0xc9, 0x15, 0x08, 0x39, 0x7a, 0x33, 0x5f, 0x0e, 0x9d, 0x5f, 0xcb, 0xe1, 0xe3, 0x89, 0x30,
+	0xd3, 0x2a, 0x25, 0x19, 0x14, 0xb4, 0x6b, 0x8e, 0x3d, 0x9e, 0x68, 0xf6, 0x9e, 0x9a, 0x4f, 0x25,
+	0xd7, 0xed, 0x85, 0xaf, 0x3f, 0x86, 0xd1, 0x7f, 0x96, 0xea, 0xb8, 0x73, 0x11, 0xae, 0x10, 0xbe,
+	0xdb, 0xc3, 0xd0, 0x2f, 0x18, 0x3b, 0x80, 0x10, 0xe0, 0xf3, 0x0c, 0xa4, 0x51, 0x22, 0xad, 0x0c,
+	0x6c, 0xbb, 0xd9, 0x7f, 0xe5, 0x7e, 0x46, 0xf8, 0x66, 0xf3, 0xc7, 0xb4, 0x77, 0x7a, 0x54, 0x46,
+	0x6b, 0x22, 0xfc, 0x89, 0xf0, 0xc3, 0x3e, 0xe2, 0xb5, 0xd0, 0xd6, 0xea, 0xe1, 0x99, 0x51, 0x3c,
+	0xe3, 0xa2, 0xde, 0xcf, 0xcc, 0x36, 0x76, 0x67, 0x08, 0xdf, 0xb2, 0xb3, 0x7b, 0x6c, 0xc8, 0xad,
+	0x8d, 0xd1, 0x68, 0xbe, 0xf6, 0xd1, 0x62, 0xed, 0xa3, 0xd5, 0xda, 0x47, 0xb3, 0x8d, 0xef, 0x2c,
+	0x36, 0xbe, 0xf3, 0x7d, 0xe3, 0x3b, 0xaf, 0xfb, 0x62, 0xfb, 0x25, 0xa5, 0xba, 0x94, 0xf4, 0x63,
+	0xb7, 0x81, 0x56, 0x32, 0x3d, 0x6b, 0x77, 0xec, 0xd9, 0xef, 0x01, 0x00, 0x05, 0xa7, 0x2f, 0xc9,
+	0xe1, 0x03, 0x00, 0x00,
 }
 
 func (m *EventRewardPoolCreated) Marshal() (dAtA []byte, err error) {
@@ -277,6 +396,97 @@ func (m *EventRewardPoolRemoved) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *EventRewardPoolClosed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRewardPoolClosed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRewardPoolClosed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Refund) > 0 {
+		for iNdEx := len(m.Refund) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Refund[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.LaunchID != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.LaunchID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRewardsAdded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRewardsAdded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRewardsAdded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Contributor) > 0 {
+		i -= len(m.Contributor)
+		copy(dAtA[i:], m.Contributor)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Contributor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.LaunchID != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.LaunchID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventRewardsDistributed) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -365,6 +575,46 @@ func (m *EventRewardPoolRemoved) Size() (n int) {
 	return n
 }
 
+func (m *EventRewardPoolClosed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LaunchID != 0 {
+		n += 1 + sovEvents(uint64(m.LaunchID))
+	}
+	if len(m.Refund) > 0 {
+		for _, e := range m.Refund {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventRewardsAdded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LaunchID != 0 {
+		n += 1 + sovEvents(uint64(m.LaunchID))
+	}
+	l = len(m.Contributor)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *EventRewardsDistributed) Size() (n int) {
 	if m == nil {
 		return 0
@@ -563,6 +813,244 @@ func (m *EventRewardPoolRemoved) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventRewardPoolClosed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRewardPoolClosed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRewardPoolClosed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LaunchID", wireType)
+			}
+			m.LaunchID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LaunchID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refund", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Refund = append(m.Refund, github_com_cosmos_cosmos_sdk_types.Coin{})
+			if err := m.Refund[len(m.Refund)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRewardsAdded) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRewardsAdded: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRewardsAdded: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LaunchID", wireType)
+			}
+			m.LaunchID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LaunchID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contributor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contributor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, github_com_cosmos_cosmos_sdk_types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EventRewardsDistributed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
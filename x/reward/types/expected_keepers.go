@@ -13,6 +13,7 @@ type ProfileKeeper interface {
 	GetValidatorByOperatorAddress(ctx sdk.Context, operatorAddress string) (profiletypes.ValidatorByOperatorAddress, bool)
 	GetCoordinator(ctx sdk.Context, id uint64) (profiletypes.Coordinator, bool)
 	CoordinatorIDFromAddress(ctx sdk.Context, address string) (uint64, error)
+	AddRewardsDistributed(ctx sdk.Context, coordinatorID uint64, rewards sdk.Coins)
 }
 
 type LaunchKeeper interface {
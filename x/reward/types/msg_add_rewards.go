@@ -0,0 +1,54 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgAddRewards = "add_rewards"
+
+var _ sdk.Msg = &MsgAddRewards{}
+
+func NewMsgAddRewards(contributor string, launchID uint64, coins sdk.Coins) *MsgAddRewards {
+	return &MsgAddRewards{
+		Contributor: contributor,
+		LaunchID:    launchID,
+		Coins:       coins,
+	}
+}
+
+func (msg *MsgAddRewards) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAddRewards) Type() string {
+	return TypeMsgAddRewards
+}
+
+func (msg *MsgAddRewards) GetSigners() []sdk.AccAddress {
+	contributor, err := sdk.AccAddressFromBech32(msg.Contributor)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{contributor}
+}
+
+func (msg *MsgAddRewards) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgAddRewards) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Contributor); err != nil {
+		return sdkerrors.Wrapf(sdkerrortypes.ErrInvalidAddress, "invalid contributor address (%s)", err)
+	}
+	if err := msg.Coins.Validate(); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidRewardPoolCoins, "invalid reward coins (%s)", err)
+	}
+	if msg.Coins.Empty() {
+		return sdkerrors.Wrap(ErrInvalidRewardPoolCoins, "no reward coins")
+	}
+
+	return nil
+}
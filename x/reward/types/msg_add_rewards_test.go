@@ -0,0 +1,70 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/reward/types"
+)
+
+func TestMsgAddRewards_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  types.MsgAddRewards
+		err  error
+	}{
+		{
+			name: "should prevent invalid contributor address",
+			msg: types.MsgAddRewards{
+				LaunchID:    1,
+				Contributor: "invalid address",
+				Coins:       sample.Coins(r),
+			},
+			err: sdkerrortypes.ErrInvalidAddress,
+		},
+		{
+			name: "should prevent invalid coins",
+			msg: types.MsgAddRewards{
+				LaunchID:    1,
+				Contributor: sample.Address(r),
+				Coins: sdk.Coins{sdk.Coin{
+					Denom:  "invalid denom",
+					Amount: sdkmath.ZeroInt(),
+				}},
+			},
+			err: types.ErrInvalidRewardPoolCoins,
+		},
+		{
+			name: "should prevent empty coins",
+			msg: types.MsgAddRewards{
+				LaunchID:    1,
+				Contributor: sample.Address(r),
+				Coins:       sdk.NewCoins(),
+			},
+			err: types.ErrInvalidRewardPoolCoins,
+		},
+		{
+			name: "should validate valid message",
+			msg: types.MsgAddRewards{
+				LaunchID:    1,
+				Contributor: sample.Address(r),
+				Coins:       sample.Coins(r),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
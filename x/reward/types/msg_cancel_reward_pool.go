@@ -0,0 +1,47 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgCancelRewardPool = "cancel_reward_pool"
+
+var _ sdk.Msg = &MsgCancelRewardPool{}
+
+func NewMsgCancelRewardPool(provider string, launchID uint64) *MsgCancelRewardPool {
+	return &MsgCancelRewardPool{
+		Provider: provider,
+		LaunchID: launchID,
+	}
+}
+
+func (msg *MsgCancelRewardPool) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCancelRewardPool) Type() string {
+	return TypeMsgCancelRewardPool
+}
+
+func (msg *MsgCancelRewardPool) GetSigners() []sdk.AccAddress {
+	provider, err := sdk.AccAddressFromBech32(msg.Provider)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{provider}
+}
+
+func (msg *MsgCancelRewardPool) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCancelRewardPool) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Provider); err != nil {
+		return sdkerrors.Wrapf(sdkerrortypes.ErrInvalidAddress, "invalid provider address (%s)", err)
+	}
+
+	return nil
+}
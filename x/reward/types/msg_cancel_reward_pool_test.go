@@ -0,0 +1,45 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkerrortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/spn/testutil/sample"
+	"github.com/tendermint/spn/x/reward/types"
+)
+
+func TestMsgCancelRewardPool_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  types.MsgCancelRewardPool
+		err  error
+	}{
+		{
+			name: "should prevent invalid provider address",
+			msg: types.MsgCancelRewardPool{
+				LaunchID: 1,
+				Provider: "invalid address",
+			},
+			err: sdkerrortypes.ErrInvalidAddress,
+		},
+		{
+			name: "should validate valid message",
+			msg: types.MsgCancelRewardPool{
+				LaunchID: 1,
+				Provider: sample.Address(r),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -58,5 +58,141 @@ func (m RewardPool) Validate() error {
 		return fmt.Errorf("invalid provider address: %s", err)
 	}
 
+	if len(m.Contributions) > 0 {
+		contributors := make(map[string]struct{})
+		totalContributions := sdk.NewCoins()
+		for _, contribution := range m.Contributions {
+			if _, err := sdk.AccAddressFromBech32(contribution.Contributor); err != nil {
+				return fmt.Errorf("invalid contributor address: %s", err)
+			}
+			if _, ok := contributors[contribution.Contributor]; ok {
+				return fmt.Errorf("duplicated contributor %s", contribution.Contributor)
+			}
+			contributors[contribution.Contributor] = struct{}{}
+			if contribution.Coins.Empty() {
+				return fmt.Errorf("empty contribution for contributor %s", contribution.Contributor)
+			}
+			if err := contribution.Coins.Validate(); err != nil {
+				return fmt.Errorf("invalid contribution coins: %s", err)
+			}
+			totalContributions = totalContributions.Add(contribution.Coins...)
+		}
+		if !totalContributions.IsEqual(m.InitialCoins) {
+			return fmt.Errorf(
+				"total contributions %s are not equal to the initial coins %s",
+				totalContributions.String(),
+				m.InitialCoins.String(),
+			)
+		}
+	}
+
 	return nil
 }
+
+// AllContributions returns the contributions of the reward pool
+// if no contribution is recorded, the provider is the only contributor of the initial coins
+func (m RewardPool) AllContributions() []RewardContribution {
+	if len(m.Contributions) == 0 {
+		if m.InitialCoins.Empty() {
+			return nil
+		}
+		return []RewardContribution{{
+			Contributor: m.Provider,
+			Coins:       m.InitialCoins,
+		}}
+	}
+	return m.Contributions
+}
+
+// ContributionOf returns the coins provided by a contributor to the reward pool
+func (m RewardPool) ContributionOf(contributor string) sdk.Coins {
+	for _, contribution := range m.AllContributions() {
+		if contribution.Contributor == contributor {
+			return contribution.Coins
+		}
+	}
+	return sdk.NewCoins()
+}
+
+// SetContribution sets the coins provided by a contributor to the reward pool
+// the contribution is removed if the coins are empty
+// the initial coins of the reward pool are updated to the total of the contributions
+func (m *RewardPool) SetContribution(contributor string, coins sdk.Coins) {
+	contributions := make([]RewardContribution, 0, len(m.Contributions)+1)
+	found := false
+	for _, contribution := range m.AllContributions() {
+		if contribution.Contributor == contributor {
+			found = true
+			contribution.Coins = coins
+		}
+		if !contribution.Coins.Empty() {
+			contributions = append(contributions, contribution)
+		}
+	}
+	if !found && !coins.Empty() {
+		contributions = append(contributions, RewardContribution{
+			Contributor: contributor,
+			Coins:       coins,
+		})
+	}
+
+	m.Contributions = contributions
+	m.InitialCoins = sdk.NewCoins()
+	for _, contribution := range contributions {
+		m.InitialCoins = m.InitialCoins.Add(contribution.Coins...)
+	}
+}
+
+// RefundShares splits a refund among the contributors of the reward pool
+// the refund of each denom is split pro-rata to the contributed amount of the denom
+// the remainder of the division is given to the last contributor of the denom
+func (m RewardPool) RefundShares(refund sdk.Coins) []RewardContribution {
+	contributions := m.AllContributions()
+	if len(contributions) == 0 {
+		return nil
+	}
+	shares := make([]sdk.Coins, len(contributions))
+
+	for _, coin := range refund {
+		total := sdk.ZeroInt()
+		last := 0
+		for i, contribution := range contributions {
+			amount := contribution.Coins.AmountOf(coin.Denom)
+			if amount.IsPositive() {
+				total = total.Add(amount)
+				last = i
+			}
+		}
+
+		// no contribution for the denom, the refund is given to the first contributor
+		if total.IsZero() {
+			shares[0] = shares[0].Add(coin)
+			continue
+		}
+
+		distributed := sdk.ZeroInt()
+		for i, contribution := range contributions {
+			amount := contribution.Coins.AmountOf(coin.Denom)
+			if !amount.IsPositive() {
+				continue
+			}
+			share := coin.Amount.Mul(amount).Quo(total)
+			if i == last {
+				share = coin.Amount.Sub(distributed)
+			}
+			distributed = distributed.Add(share)
+			shares[i] = shares[i].Add(sdk.NewCoin(coin.Denom, share))
+		}
+	}
+
+	refunds := make([]RewardContribution, 0, len(contributions))
+	for i, contribution := range contributions {
+		if !shares[i].IsZero() {
+			refunds = append(refunds, RewardContribution{
+				Contributor: contribution.Contributor,
+				Coins:       shares[i],
+			})
+		}
+	}
+	return refunds
+}
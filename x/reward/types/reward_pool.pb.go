@@ -33,6 +33,9 @@ type RewardPool struct {
 	LastRewardHeight    int64                                    `protobuf:"varint,5,opt,name=lastRewardHeight,proto3" json:"lastRewardHeight,omitempty"`
 	CurrentRewardHeight int64                                    `protobuf:"varint,6,opt,name=currentRewardHeight,proto3" json:"currentRewardHeight,omitempty"`
 	Closed              bool                                     `protobuf:"varint,7,opt,name=closed,proto3" json:"closed,omitempty"`
+	// contributions are the coins provided to the reward pool by each contributor
+	// if empty, the provider is considered as the only contributor of the initial coins
+	Contributions []RewardContribution `protobuf:"bytes,8,rep,name=contributions,proto3" json:"contributions"`
 }
 
 func (m *RewardPool) Reset()         { *m = RewardPool{} }
@@ -117,38 +120,103 @@ func (m *RewardPool) GetClosed() bool {
 	return false
 }
 
+func (m *RewardPool) GetContributions() []RewardContribution {
+	if m != nil {
+		return m.Contributions
+	}
+	return nil
+}
+
+// RewardContribution defines the coins provided to a reward pool by a contributor
+type RewardContribution struct {
+	Contributor string                                   `protobuf:"bytes,1,opt,name=contributor,proto3" json:"contributor,omitempty"`
+	Coins       github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=coins,proto3,casttype=github.com/cosmos/cosmos-sdk/types.Coin,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+}
+
+func (m *RewardContribution) Reset()         { *m = RewardContribution{} }
+func (m *RewardContribution) String() string { return proto.CompactTextString(m) }
+func (*RewardContribution) ProtoMessage()    {}
+func (*RewardContribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_609e0d2ccc6b594f, []int{1}
+}
+func (m *RewardContribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardContribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardContribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardContribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardContribution.Merge(m, src)
+}
+func (m *RewardContribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardContribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardContribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardContribution proto.InternalMessageInfo
+
+func (m *RewardContribution) GetContributor() string {
+	if m != nil {
+		return m.Contributor
+	}
+	return ""
+}
+
+func (m *RewardContribution) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*RewardPool)(nil), "tendermint.spn.reward.RewardPool")
+	proto.RegisterType((*RewardContribution)(nil), "tendermint.spn.reward.RewardContribution")
 }
 
 func init() { proto.RegisterFile("reward/reward_pool.proto", fileDescriptor_609e0d2ccc6b594f) }
 
 var fileDescriptor_609e0d2ccc6b594f = []byte{
-	// 371 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x92, 0xbd, 0x4e, 0xe3, 0x40,
-	0x14, 0x85, 0x3d, 0xeb, 0x6c, 0x36, 0x3b, 0xbb, 0x5a, 0xad, 0xbc, 0x3f, 0xf2, 0xa6, 0x70, 0xac,
-	0x6d, 0xb0, 0x90, 0x98, 0x21, 0xf0, 0x06, 0x81, 0x02, 0x3a, 0xe4, 0x12, 0x0a, 0xe4, 0x9f, 0x91,
-	0x33, 0xc2, 0x9e, 0x6b, 0xcd, 0x4c, 0x02, 0xbc, 0x05, 0x35, 0x05, 0x0f, 0xc0, 0x93, 0xa4, 0x4c,
-	0x49, 0x15, 0x50, 0xf2, 0x12, 0x88, 0x0a, 0xd9, 0x63, 0x05, 0x02, 0x14, 0x74, 0x54, 0xd7, 0xd7,
-	0xf7, 0x9b, 0x39, 0xe7, 0x6a, 0x0e, 0x76, 0x25, 0x3b, 0x8d, 0x64, 0x4a, 0x4d, 0x39, 0x2e, 0x01,
-	0x72, 0x52, 0x4a, 0xd0, 0xe0, 0xfc, 0xd1, 0x4c, 0xa4, 0x4c, 0x16, 0x5c, 0x68, 0xa2, 0x4a, 0x41,
-	0x0c, 0xd1, 0xfd, 0x9d, 0x41, 0x06, 0x35, 0x41, 0xab, 0x2f, 0x03, 0x77, 0xbd, 0x04, 0x54, 0x01,
-	0x8a, 0xc6, 0x91, 0x62, 0x74, 0xdc, 0x8f, 0x99, 0x8e, 0xfa, 0x34, 0x01, 0x2e, 0xcc, 0xfc, 0xff,
-	0xbd, 0x8d, 0x71, 0x58, 0x5f, 0x70, 0x00, 0x90, 0x3b, 0x5d, 0xdc, 0xc9, 0xa3, 0x91, 0x48, 0x86,
-	0xfb, 0xbb, 0x2e, 0xf2, 0x51, 0xd0, 0x0a, 0x97, 0x7d, 0x35, 0x2b, 0x25, 0x8c, 0x79, 0xca, 0xa4,
-	0xfb, 0xc9, 0x47, 0xc1, 0xd7, 0x70, 0xd9, 0x3b, 0x97, 0x08, 0x7f, 0xe7, 0x82, 0x6b, 0x1e, 0xe5,
-	0x3b, 0xc0, 0x85, 0x72, 0x6d, 0xdf, 0x0e, 0xbe, 0x6d, 0xfd, 0x23, 0x46, 0x9e, 0x54, 0xf2, 0xa4,
-	0x91, 0x27, 0x15, 0x31, 0x38, 0x9a, 0xcc, 0x7a, 0xd6, 0xc3, 0xac, 0xb7, 0x96, 0x71, 0x3d, 0x1c,
-	0xc5, 0x24, 0x81, 0x82, 0x36, 0x5e, 0x4d, 0xd9, 0x50, 0xe9, 0x09, 0xd5, 0xe7, 0x25, 0x53, 0xf5,
-	0x81, 0xeb, 0xdb, 0x5e, 0xf0, 0x4e, 0x54, 0x85, 0x2b, 0x5e, 0x9c, 0x2b, 0x84, 0x7f, 0x48, 0x56,
-	0x44, 0x5c, 0x70, 0x91, 0x19, 0x7b, 0xad, 0x0f, 0xb5, 0xf7, 0xc2, 0x8d, 0xb3, 0x8e, 0x7f, 0xe6,
-	0x91, 0xd2, 0xe6, 0x1d, 0xf6, 0x18, 0xcf, 0x86, 0xda, 0xfd, 0xec, 0xa3, 0xc0, 0x0e, 0x5f, 0xfd,
-	0x77, 0x36, 0xf1, 0xaf, 0x64, 0x24, 0x25, 0x13, 0xab, 0x78, 0xbb, 0xc6, 0xdf, 0x1a, 0x39, 0x7f,
-	0x71, 0x3b, 0xc9, 0x41, 0xb1, 0xd4, 0xfd, 0xe2, 0xa3, 0xa0, 0x13, 0x36, 0xdd, 0x60, 0x30, 0x99,
-	0x7b, 0x68, 0x3a, 0xf7, 0xd0, 0xdd, 0xdc, 0x43, 0x17, 0x0b, 0xcf, 0x9a, 0x2e, 0x3c, 0xeb, 0x66,
-	0xe1, 0x59, 0x87, 0xcf, 0x37, 0x79, 0x0a, 0x1b, 0x55, 0xa5, 0xa0, 0x67, 0x4d, 0x20, 0xcd, 0x3e,
-	0x71, 0xbb, 0x4e, 0xd1, 0xf6, 0x63, 0x00, 0x00, 0x00, 0xff, 0xff, 0x19, 0xa5, 0x0b, 0xc4, 0xae,
-	0x02, 0x00, 0x00,
+	// 434 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x93, 0xbf, 0x6e, 0x14, 0x31,
+	0x10, 0xc6, 0xcf, 0xb9, 0xcb, 0x71, 0x71, 0x00, 0x21, 0xf3, 0x47, 0xcb, 0x15, 0x7b, 0xab, 0x34,
+	0x2c, 0x48, 0xd8, 0x04, 0xde, 0xe0, 0x42, 0x01, 0x1d, 0xb2, 0x44, 0x03, 0x05, 0xda, 0xf5, 0x5a,
+	0x7b, 0x16, 0xbb, 0x9e, 0x95, 0xed, 0x0d, 0xf0, 0x0e, 0x14, 0xd4, 0x14, 0x3c, 0x00, 0xef, 0x81,
+	0x94, 0x8e, 0x94, 0x54, 0x01, 0xdd, 0xbd, 0x05, 0x15, 0x5a, 0x7b, 0x75, 0xdc, 0x91, 0x14, 0x74,
+	0xa9, 0xec, 0xf1, 0xfc, 0xfc, 0xcd, 0xa7, 0x19, 0x1b, 0x47, 0x46, 0xbe, 0xcb, 0x4c, 0xc1, 0xc2,
+	0xf2, 0xa6, 0x01, 0xa8, 0x68, 0x63, 0xc0, 0x01, 0xb9, 0xed, 0xa4, 0x2e, 0xa4, 0xa9, 0x95, 0x76,
+	0xd4, 0x36, 0x9a, 0x06, 0x62, 0x7a, 0xab, 0x84, 0x12, 0x3c, 0xc1, 0xba, 0x5d, 0x80, 0xa7, 0xb1,
+	0x00, 0x5b, 0x83, 0x65, 0x79, 0x66, 0x25, 0x3b, 0x3e, 0xcc, 0xa5, 0xcb, 0x0e, 0x99, 0x00, 0xa5,
+	0x43, 0xfe, 0xe0, 0xfb, 0x08, 0x63, 0xee, 0x05, 0x5e, 0x00, 0x54, 0x64, 0x8a, 0x27, 0x55, 0xd6,
+	0x6a, 0xb1, 0x78, 0xfe, 0x34, 0x42, 0x09, 0x4a, 0x47, 0x7c, 0x1d, 0x77, 0xb9, 0xc6, 0xc0, 0xb1,
+	0x2a, 0xa4, 0x89, 0x76, 0x12, 0x94, 0xee, 0xf1, 0x75, 0x4c, 0x3e, 0x23, 0x7c, 0x55, 0x69, 0xe5,
+	0x54, 0x56, 0x1d, 0x81, 0xd2, 0x36, 0x1a, 0x26, 0xc3, 0x74, 0xff, 0xf1, 0x5d, 0x1a, 0xca, 0xd3,
+	0xae, 0x3c, 0xed, 0xcb, 0xd3, 0x8e, 0x98, 0xbf, 0x3e, 0x39, 0x9b, 0x0d, 0x7e, 0x9f, 0xcd, 0xee,
+	0x95, 0xca, 0x2d, 0xda, 0x9c, 0x0a, 0xa8, 0x59, 0xef, 0x35, 0x2c, 0x0f, 0x6d, 0xf1, 0x96, 0xb9,
+	0x0f, 0x8d, 0xb4, 0xfe, 0xc2, 0xd7, 0x9f, 0xb3, 0xf4, 0x3f, 0x51, 0xcb, 0xb7, 0xbc, 0x90, 0x2f,
+	0x08, 0x5f, 0x37, 0xb2, 0xce, 0x94, 0x56, 0xba, 0x0c, 0xf6, 0x46, 0x97, 0x6a, 0xef, 0x1f, 0x37,
+	0xe4, 0x01, 0xbe, 0x51, 0x65, 0xd6, 0x85, 0x39, 0x3c, 0x93, 0xaa, 0x5c, 0xb8, 0x68, 0x37, 0x41,
+	0xe9, 0x90, 0x9f, 0x3b, 0x27, 0x8f, 0xf0, 0x4d, 0xd1, 0x1a, 0x23, 0xf5, 0x36, 0x3e, 0xf6, 0xf8,
+	0x45, 0x29, 0x72, 0x07, 0x8f, 0x45, 0x05, 0x56, 0x16, 0xd1, 0x95, 0x04, 0xa5, 0x13, 0xde, 0x47,
+	0xe4, 0x25, 0xbe, 0x26, 0x40, 0x3b, 0xa3, 0xf2, 0xd6, 0x29, 0xd0, 0x36, 0x9a, 0xf8, 0xa6, 0xdc,
+	0xa7, 0x17, 0xbe, 0x2f, 0x1a, 0x34, 0x8f, 0x36, 0x6e, 0xcc, 0x47, 0x5d, 0x93, 0xf8, 0xb6, 0xca,
+	0xc1, 0x37, 0x84, 0xc9, 0x79, 0x96, 0x24, 0x78, 0x7f, 0xcd, 0x81, 0xf1, 0x8f, 0x6b, 0x8f, 0x6f,
+	0x1e, 0x91, 0x8f, 0x08, 0xef, 0x0a, 0x3f, 0x9d, 0x9d, 0x4b, 0x9d, 0x4e, 0x30, 0x31, 0x9f, 0x9f,
+	0x2c, 0x63, 0x74, 0xba, 0x8c, 0xd1, 0xaf, 0x65, 0x8c, 0x3e, 0xad, 0xe2, 0xc1, 0xe9, 0x2a, 0x1e,
+	0xfc, 0x58, 0xc5, 0x83, 0x57, 0x9b, 0x52, 0x7f, 0x7b, 0xc5, 0x6c, 0xa3, 0xd9, 0xfb, 0xfe, 0xbf,
+	0x06, 0xc1, 0x7c, 0xec, 0x3f, 0xd9, 0x93, 0x3f, 0x03, 0x00, 0xd7, 0x13, 0x07, 0x0c, 0xcd, 0x03,
+	0x00, 0x00,
 }
 
 func (m *RewardPool) Marshal() (dAtA []byte, err error) {
@@ -171,6 +239,20 @@ func (m *RewardPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Contributions) > 0 {
+		for iNdEx := len(m.Contributions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Contributions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewardPool(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if m.Closed {
 		i--
 		if m.Closed {
@@ -234,6 +316,50 @@ func (m *RewardPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RewardContribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardContribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardContribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewardPool(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Contributor) > 0 {
+		i -= len(m.Contributor)
+		copy(dAtA[i:], m.Contributor)
+		i = encodeVarintRewardPool(dAtA, i, uint64(len(m.Contributor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintRewardPool(dAtA []byte, offset int, v uint64) int {
 	offset -= sovRewardPool(v)
 	base := offset
@@ -279,6 +405,31 @@ func (m *RewardPool) Size() (n int) {
 	if m.Closed {
 		n += 2
 	}
+	if len(m.Contributions) > 0 {
+		for _, e := range m.Contributions {
+			l = e.Size()
+			n += 1 + l + sovRewardPool(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RewardContribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contributor)
+	if l > 0 {
+		n += 1 + l + sovRewardPool(uint64(l))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovRewardPool(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -494,6 +645,156 @@ func (m *RewardPool) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Closed = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contributions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewardPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contributions = append(m.Contributions, RewardContribution{})
+			if err := m.Contributions[len(m.Contributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewardPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRewardPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardContribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewardPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardContribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardContribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contributor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewardPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contributor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewardPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, github_com_cosmos_cosmos_sdk_types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRewardPool(dAtA[iNdEx:])
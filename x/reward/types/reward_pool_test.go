@@ -7,6 +7,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
+	tc "github.com/tendermint/spn/testutil/constructor"
 	"github.com/tendermint/spn/testutil/sample"
 	"github.com/tendermint/spn/x/reward/types"
 )
@@ -21,6 +22,7 @@ func TestRewardPool_Validate(t *testing.T) {
 		validInitialCoins.GetDenomByIndex(1),
 		validInitialCoins.GetDenomByIndex(2),
 		0, remainingCoinMax)
+	contributor := sample.Address(r)
 
 	tests := []struct {
 		name       string
@@ -197,6 +199,82 @@ func TestRewardPool_Validate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "invalid contributor address",
+			rewardPool: types.RewardPool{
+				LaunchID:            1,
+				Provider:            sample.Address(r),
+				InitialCoins:        validInitialCoins,
+				RemainingCoins:      validRemainingCoins,
+				LastRewardHeight:    50,
+				CurrentRewardHeight: 100,
+				Contributions: []types.RewardContribution{
+					{Contributor: "invalid address", Coins: validInitialCoins},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "duplicated contributor",
+			rewardPool: types.RewardPool{
+				LaunchID:            1,
+				Provider:            sample.Address(r),
+				InitialCoins:        validInitialCoins.Add(validInitialCoins...),
+				RemainingCoins:      validRemainingCoins,
+				LastRewardHeight:    50,
+				CurrentRewardHeight: 100,
+				Contributions: []types.RewardContribution{
+					{Contributor: contributor, Coins: validInitialCoins},
+					{Contributor: contributor, Coins: validInitialCoins},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "empty contribution",
+			rewardPool: types.RewardPool{
+				LaunchID:            1,
+				Provider:            sample.Address(r),
+				InitialCoins:        validInitialCoins,
+				RemainingCoins:      validRemainingCoins,
+				LastRewardHeight:    50,
+				CurrentRewardHeight: 100,
+				Contributions: []types.RewardContribution{
+					{Contributor: contributor, Coins: validInitialCoins},
+					{Contributor: sample.Address(r), Coins: sdk.NewCoins()},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "contributions not equal to initial coins",
+			rewardPool: types.RewardPool{
+				LaunchID:            1,
+				Provider:            sample.Address(r),
+				InitialCoins:        validInitialCoins,
+				RemainingCoins:      validRemainingCoins,
+				LastRewardHeight:    50,
+				CurrentRewardHeight: 100,
+				Contributions: []types.RewardContribution{
+					{Contributor: contributor, Coins: validRemainingCoins},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid reward pool with contributions",
+			rewardPool: types.RewardPool{
+				LaunchID:            1,
+				Provider:            sample.Address(r),
+				InitialCoins:        validInitialCoins,
+				RemainingCoins:      validRemainingCoins,
+				LastRewardHeight:    50,
+				CurrentRewardHeight: 100,
+				Contributions: []types.RewardContribution{
+					{Contributor: contributor, Coins: validInitialCoins},
+				},
+			},
+		},
 		{
 			name: "valid reward pool",
 			rewardPool: types.RewardPool{
@@ -221,3 +299,112 @@ func TestRewardPool_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestRewardPool_SetContribution(t *testing.T) {
+	var (
+		provider    = sample.Address(r)
+		contributor = sample.Address(r)
+	)
+	rewardPool := types.RewardPool{
+		Provider:     provider,
+		InitialCoins: tc.Coins(t, "100foo"),
+	}
+
+	t.Run("should consider the provider as the only contributor if no contribution is recorded", func(t *testing.T) {
+		require.Equal(t, tc.Coins(t, "100foo"), rewardPool.ContributionOf(provider))
+		require.True(t, rewardPool.ContributionOf(contributor).Empty())
+	})
+
+	t.Run("should add a contribution", func(t *testing.T) {
+		rewardPool.SetContribution(contributor, tc.Coins(t, "50foo,10bar"))
+		require.Equal(t, []types.RewardContribution{
+			{Contributor: provider, Coins: tc.Coins(t, "100foo")},
+			{Contributor: contributor, Coins: tc.Coins(t, "50foo,10bar")},
+		}, rewardPool.Contributions)
+		require.Equal(t, tc.Coins(t, "150foo,10bar"), rewardPool.InitialCoins)
+	})
+
+	t.Run("should replace a contribution", func(t *testing.T) {
+		rewardPool.SetContribution(provider, tc.Coins(t, "200foo"))
+		require.Equal(t, tc.Coins(t, "200foo"), rewardPool.ContributionOf(provider))
+		require.Equal(t, tc.Coins(t, "250foo,10bar"), rewardPool.InitialCoins)
+	})
+
+	t.Run("should remove a contribution with empty coins", func(t *testing.T) {
+		rewardPool.SetContribution(provider, sdk.NewCoins())
+		require.Equal(t, []types.RewardContribution{
+			{Contributor: contributor, Coins: tc.Coins(t, "50foo,10bar")},
+		}, rewardPool.Contributions)
+		require.Equal(t, tc.Coins(t, "50foo,10bar"), rewardPool.InitialCoins)
+	})
+}
+
+func TestRewardPool_RefundShares(t *testing.T) {
+	var (
+		provider    = sample.Address(r)
+		contributor = sample.Address(r)
+	)
+
+	tests := []struct {
+		name       string
+		rewardPool types.RewardPool
+		refund     sdk.Coins
+		expected   []types.RewardContribution
+	}{
+		{
+			name: "should refund the provider if no contribution is recorded",
+			rewardPool: types.RewardPool{
+				Provider:     provider,
+				InitialCoins: tc.Coins(t, "100foo"),
+			},
+			refund: tc.Coins(t, "50foo"),
+			expected: []types.RewardContribution{
+				{Contributor: provider, Coins: tc.Coins(t, "50foo")},
+			},
+		},
+		{
+			name: "should split the refund pro-rata to the contributions",
+			rewardPool: types.RewardPool{
+				Provider:     provider,
+				InitialCoins: tc.Coins(t, "100foo,100bar"),
+				Contributions: []types.RewardContribution{
+					{Contributor: provider, Coins: tc.Coins(t, "75foo,100bar")},
+					{Contributor: contributor, Coins: tc.Coins(t, "25foo")},
+				},
+			},
+			refund: tc.Coins(t, "40foo,10bar"),
+			expected: []types.RewardContribution{
+				{Contributor: provider, Coins: tc.Coins(t, "30foo,10bar")},
+				{Contributor: contributor, Coins: tc.Coins(t, "10foo")},
+			},
+		},
+		{
+			name: "should give the remainder of the division to the last contributor",
+			rewardPool: types.RewardPool{
+				Provider:     provider,
+				InitialCoins: tc.Coins(t, "3foo"),
+				Contributions: []types.RewardContribution{
+					{Contributor: provider, Coins: tc.Coins(t, "2foo")},
+					{Contributor: contributor, Coins: tc.Coins(t, "1foo")},
+				},
+			},
+			refund: tc.Coins(t, "2foo"),
+			expected: []types.RewardContribution{
+				{Contributor: provider, Coins: tc.Coins(t, "1foo")},
+				{Contributor: contributor, Coins: tc.Coins(t, "1foo")},
+			},
+		},
+		{
+			name: "should return no share for an empty reward pool",
+			rewardPool: types.RewardPool{
+				Provider: provider,
+			},
+			refund: tc.Coins(t, "2foo"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, tt.rewardPool.RefundShares(tt.refund))
+		})
+	}
+}
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
@@ -166,41 +167,335 @@ func (m *MsgSetRewardsResponse) GetNewLastRewardHeight() int64 {
 	return 0
 }
 
+type MsgUpdateParams struct {
+	// authority is the address of the governance account
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the parameters to update, all the parameters must be provided
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_837cc604acddc9f4, []int{2}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_837cc604acddc9f4, []int{3}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+type MsgAddRewards struct {
+	Contributor string                                   `protobuf:"bytes,1,opt,name=contributor,proto3" json:"contributor,omitempty"`
+	LaunchID    uint64                                   `protobuf:"varint,2,opt,name=launchID,proto3" json:"launchID,omitempty"`
+	Coins       github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=coins,proto3,casttype=github.com/cosmos/cosmos-sdk/types.Coin,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+}
+
+func (m *MsgAddRewards) Reset()         { *m = MsgAddRewards{} }
+func (m *MsgAddRewards) String() string { return proto.CompactTextString(m) }
+func (*MsgAddRewards) ProtoMessage()    {}
+func (*MsgAddRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_837cc604acddc9f4, []int{4}
+}
+func (m *MsgAddRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddRewards.Merge(m, src)
+}
+func (m *MsgAddRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddRewards proto.InternalMessageInfo
+
+func (m *MsgAddRewards) GetContributor() string {
+	if m != nil {
+		return m.Contributor
+	}
+	return ""
+}
+
+func (m *MsgAddRewards) GetLaunchID() uint64 {
+	if m != nil {
+		return m.LaunchID
+	}
+	return 0
+}
+
+func (m *MsgAddRewards) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
+
+type MsgAddRewardsResponse struct {
+}
+
+func (m *MsgAddRewardsResponse) Reset()         { *m = MsgAddRewardsResponse{} }
+func (m *MsgAddRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddRewardsResponse) ProtoMessage()    {}
+func (*MsgAddRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_837cc604acddc9f4, []int{5}
+}
+func (m *MsgAddRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddRewardsResponse.Merge(m, src)
+}
+func (m *MsgAddRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddRewardsResponse proto.InternalMessageInfo
+
+type MsgCancelRewardPool struct {
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	LaunchID uint64 `protobuf:"varint,2,opt,name=launchID,proto3" json:"launchID,omitempty"`
+}
+
+func (m *MsgCancelRewardPool) Reset()         { *m = MsgCancelRewardPool{} }
+func (m *MsgCancelRewardPool) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelRewardPool) ProtoMessage()    {}
+func (*MsgCancelRewardPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_837cc604acddc9f4, []int{6}
+}
+func (m *MsgCancelRewardPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelRewardPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelRewardPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelRewardPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelRewardPool.Merge(m, src)
+}
+func (m *MsgCancelRewardPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelRewardPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelRewardPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelRewardPool proto.InternalMessageInfo
+
+func (m *MsgCancelRewardPool) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *MsgCancelRewardPool) GetLaunchID() uint64 {
+	if m != nil {
+		return m.LaunchID
+	}
+	return 0
+}
+
+type MsgCancelRewardPoolResponse struct {
+}
+
+func (m *MsgCancelRewardPoolResponse) Reset()         { *m = MsgCancelRewardPoolResponse{} }
+func (m *MsgCancelRewardPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelRewardPoolResponse) ProtoMessage()    {}
+func (*MsgCancelRewardPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_837cc604acddc9f4, []int{7}
+}
+func (m *MsgCancelRewardPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelRewardPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelRewardPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelRewardPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelRewardPoolResponse.Merge(m, src)
+}
+func (m *MsgCancelRewardPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelRewardPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelRewardPoolResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelRewardPoolResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSetRewards)(nil), "tendermint.spn.reward.MsgSetRewards")
 	proto.RegisterType((*MsgSetRewardsResponse)(nil), "tendermint.spn.reward.MsgSetRewardsResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "tendermint.spn.reward.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "tendermint.spn.reward.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgAddRewards)(nil), "tendermint.spn.reward.MsgAddRewards")
+	proto.RegisterType((*MsgAddRewardsResponse)(nil), "tendermint.spn.reward.MsgAddRewardsResponse")
+	proto.RegisterType((*MsgCancelRewardPool)(nil), "tendermint.spn.reward.MsgCancelRewardPool")
+	proto.RegisterType((*MsgCancelRewardPoolResponse)(nil), "tendermint.spn.reward.MsgCancelRewardPoolResponse")
 }
 
 func init() { proto.RegisterFile("reward/tx.proto", fileDescriptor_837cc604acddc9f4) }
 
 var fileDescriptor_837cc604acddc9f4 = []byte{
-	// 416 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x53, 0xbd, 0x8e, 0xd3, 0x40,
-	0x10, 0xf6, 0xc6, 0x01, 0x85, 0x45, 0x11, 0x68, 0x21, 0x92, 0x71, 0xe1, 0x58, 0x11, 0x12, 0x16,
-	0x82, 0x5d, 0x12, 0x3a, 0xca, 0x84, 0x02, 0xa4, 0xa4, 0x31, 0x1d, 0x34, 0xf8, 0x67, 0xe5, 0x58,
-	0x24, 0xbb, 0x96, 0x67, 0xf3, 0x43, 0x4b, 0x4d, 0x81, 0xa8, 0x79, 0x02, 0xde, 0xe0, 0xde, 0x20,
-	0x65, 0xca, 0xab, 0x72, 0xa7, 0xe4, 0x2d, 0xae, 0x3a, 0xd9, 0x4e, 0x72, 0xc9, 0x5d, 0x4e, 0x4a,
-	0x77, 0x57, 0x79, 0x66, 0xbe, 0x99, 0xf1, 0xf7, 0xed, 0xcc, 0xe0, 0x27, 0x29, 0x9f, 0x78, 0x69,
-	0xc8, 0xd4, 0x94, 0x26, 0xa9, 0x54, 0x92, 0xd4, 0x14, 0x17, 0x21, 0x4f, 0x87, 0xb1, 0x50, 0x14,
-	0x12, 0x41, 0x0b, 0xdc, 0xb4, 0x02, 0x09, 0x43, 0x09, 0xcc, 0xf7, 0x80, 0xb3, 0x71, 0xd3, 0xe7,
-	0xca, 0x6b, 0xb2, 0x40, 0xc6, 0xa2, 0x28, 0x33, 0x9f, 0x47, 0x32, 0x92, 0xb9, 0xc9, 0x32, 0xab,
-	0x88, 0x36, 0x7e, 0x95, 0x70, 0xb5, 0x07, 0xd1, 0x17, 0xae, 0xdc, 0xbc, 0x0d, 0x10, 0x13, 0x57,
-	0x92, 0x54, 0x8e, 0xe3, 0x90, 0xa7, 0x06, 0xb2, 0x91, 0xf3, 0xc8, 0xdd, 0xfa, 0x19, 0x36, 0xf0,
-	0x46, 0x22, 0xe8, 0x7f, 0xfe, 0x68, 0x94, 0x6c, 0xe4, 0x94, 0xdd, 0xad, 0x4f, 0x7e, 0x23, 0xfc,
-	0x20, 0xfb, 0x1d, 0x18, 0xba, 0xad, 0x3b, 0x8f, 0x5b, 0x2f, 0x68, 0x41, 0x88, 0x66, 0x84, 0xe8,
-	0x9a, 0x10, 0xed, 0xc8, 0x58, 0xb4, 0xbf, 0xcd, 0x16, 0x75, 0xed, 0x62, 0x51, 0x7f, 0x15, 0xc5,
-	0xaa, 0x3f, 0xf2, 0x69, 0x20, 0x87, 0x6c, 0xcd, 0xbe, 0xf8, 0xbc, 0x85, 0xf0, 0x07, 0x53, 0x3f,
-	0x13, 0x0e, 0x79, 0xc1, 0xff, 0xb3, 0xba, 0x73, 0x64, 0x2a, 0xb8, 0x05, 0x09, 0xf2, 0x1a, 0x3f,
-	0x1d, 0x78, 0xb0, 0x56, 0xf5, 0x89, 0xc7, 0x51, 0x5f, 0x19, 0x65, 0x1b, 0x39, 0xba, 0x7b, 0x23,
-	0xde, 0x38, 0xd1, 0x71, 0x6d, 0xef, 0x11, 0x5c, 0x0e, 0x89, 0x14, 0xc0, 0xc9, 0x3f, 0x84, 0xab,
-	0x49, 0xca, 0xc7, 0xb1, 0x1c, 0x41, 0xde, 0xde, 0x40, 0x77, 0x2a, 0x6e, 0x9f, 0x0c, 0xf9, 0x80,
-	0x8d, 0x4d, 0xa0, 0x7b, 0x5d, 0x6c, 0x29, 0x17, 0x7b, 0x2b, 0x4e, 0xfe, 0x22, 0x5c, 0x11, 0x7c,
-	0xd2, 0xb9, 0x07, 0x23, 0xdb, 0xf2, 0x20, 0xef, 0xf0, 0x33, 0xc1, 0x27, 0xdd, 0xc3, 0x83, 0x3b,
-	0x04, 0xb5, 0x22, 0xac, 0xf7, 0x20, 0x22, 0xdf, 0x31, 0xde, 0xd9, 0xe1, 0x97, 0xf4, 0xe0, 0x8d,
-	0xd0, 0xbd, 0x21, 0x9b, 0x6f, 0x8e, 0xc9, 0xda, 0xac, 0x42, 0xbb, 0x3d, 0x5b, 0x5a, 0x68, 0xbe,
-	0xb4, 0xd0, 0xf9, 0xd2, 0x42, 0x7f, 0x56, 0x96, 0x36, 0x5f, 0x59, 0xda, 0xe9, 0xca, 0xd2, 0xbe,
-	0xee, 0x0a, 0xbd, 0xea, 0xc8, 0x20, 0x11, 0x6c, 0xca, 0x36, 0xd7, 0x9b, 0xc9, 0xf5, 0x1f, 0xe6,
-	0x47, 0xf7, 0xfe, 0x32, 0x00, 0x00, 0xff, 0xff, 0x08, 0x89, 0x34, 0xfe, 0xd4, 0x03, 0x00, 0x00,
+	// 625 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xce, 0xd5, 0xa5, 0x6a, 0x5f, 0xa9, 0x5a, 0xb9, 0xad, 0xea, 0x18, 0xd5, 0x89, 0x2c, 0x04,
+	0x51, 0xd5, 0xda, 0x34, 0x48, 0x0c, 0x30, 0x35, 0x61, 0x00, 0xa9, 0x91, 0x2a, 0x57, 0x2c, 0x30,
+	0x50, 0xc7, 0x3e, 0x1c, 0x8b, 0xe4, 0xce, 0xba, 0xbb, 0x24, 0xed, 0xca, 0xc0, 0xc4, 0x80, 0x98,
+	0x59, 0x59, 0x98, 0x59, 0xf8, 0x0f, 0x3a, 0x56, 0x4c, 0x0c, 0xa8, 0xa0, 0xe4, 0xbf, 0x60, 0x42,
+	0xfe, 0x99, 0x1f, 0x4d, 0x50, 0xc4, 0x02, 0x4c, 0xf6, 0xbd, 0xef, 0x7b, 0xf7, 0xde, 0x77, 0xef,
+	0xbd, 0x3b, 0x58, 0x65, 0xb8, 0x6b, 0x33, 0xd7, 0x14, 0xa7, 0x46, 0xc0, 0xa8, 0xa0, 0xf2, 0xa6,
+	0xc0, 0xc4, 0xc5, 0xac, 0xe5, 0x13, 0x61, 0xf0, 0x80, 0x18, 0x31, 0xae, 0x6a, 0x0e, 0xe5, 0x2d,
+	0xca, 0xcd, 0xba, 0xcd, 0xb1, 0xd9, 0xd9, 0xaf, 0x63, 0x61, 0xef, 0x9b, 0x0e, 0xf5, 0x49, 0xec,
+	0xa6, 0x6e, 0x78, 0xd4, 0xa3, 0xd1, 0xaf, 0x19, 0xfe, 0x25, 0xd6, 0x7c, 0xec, 0xf5, 0x3c, 0x06,
+	0xe2, 0x45, 0x02, 0xad, 0x27, 0x81, 0x03, 0x9b, 0xd9, 0xad, 0xc4, 0xa8, 0xbf, 0x9a, 0x83, 0x95,
+	0x1a, 0xf7, 0x8e, 0xb1, 0xb0, 0x22, 0x94, 0xcb, 0x2a, 0x2c, 0x06, 0x8c, 0x76, 0x7c, 0x17, 0x33,
+	0x05, 0x15, 0x51, 0x69, 0xc9, 0xca, 0xd6, 0x21, 0xd6, 0xb4, 0xdb, 0xc4, 0x69, 0x3c, 0x7e, 0xa8,
+	0xcc, 0x15, 0x51, 0x69, 0xde, 0xca, 0xd6, 0xf2, 0x1b, 0x04, 0xd7, 0xc2, 0xf4, 0xb8, 0x22, 0x15,
+	0xa5, 0xd2, 0x72, 0x39, 0x6f, 0x24, 0xd1, 0x43, 0x01, 0x46, 0x22, 0xc0, 0xa8, 0x52, 0x9f, 0x54,
+	0x9e, 0x9d, 0x5f, 0x16, 0x72, 0x3f, 0x2f, 0x0b, 0xb7, 0x3d, 0x5f, 0x34, 0xda, 0x75, 0xc3, 0xa1,
+	0xad, 0x24, 0xd5, 0xe4, 0xb3, 0xc7, 0xdd, 0x97, 0xa6, 0x38, 0x0b, 0x30, 0x8f, 0x1c, 0x3e, 0x7e,
+	0x2f, 0x94, 0x66, 0xa4, 0x72, 0x2b, 0x4e, 0x42, 0xde, 0x81, 0xb5, 0xa6, 0xcd, 0x13, 0x55, 0x8f,
+	0xb0, 0xef, 0x35, 0x84, 0x32, 0x5f, 0x44, 0x25, 0xc9, 0xba, 0x62, 0xd7, 0x3f, 0x4b, 0xb0, 0x39,
+	0x72, 0x08, 0x16, 0xe6, 0x01, 0x25, 0x1c, 0xcb, 0xef, 0x11, 0xac, 0x04, 0x0c, 0x77, 0x7c, 0xda,
+	0xe6, 0xd1, 0xf6, 0x0a, 0xfa, 0xab, 0xe2, 0x46, 0x93, 0x91, 0xef, 0x83, 0x92, 0x1a, 0x0e, 0xc7,
+	0xc5, 0xce, 0x45, 0x62, 0xa7, 0xe2, 0xf2, 0x3b, 0x04, 0x8b, 0x04, 0x77, 0xab, 0xff, 0x40, 0xc9,
+	0xb2, 0x3c, 0xe4, 0x3b, 0xb0, 0x4e, 0x70, 0xf7, 0x70, 0x72, 0xe1, 0x26, 0x41, 0xfa, 0x6b, 0x04,
+	0xab, 0x35, 0xee, 0x3d, 0x09, 0x5c, 0x5b, 0xe0, 0xa3, 0xa8, 0xb5, 0xe5, 0x7b, 0xb0, 0x64, 0xb7,
+	0x45, 0x83, 0x32, 0x5f, 0x9c, 0xc5, 0x3d, 0x5c, 0x51, 0xbe, 0x7c, 0xda, 0xdb, 0x48, 0xd4, 0x1d,
+	0xb8, 0x2e, 0xc3, 0x9c, 0x1f, 0x0b, 0xe6, 0x13, 0xcf, 0x1a, 0x50, 0xe5, 0x07, 0xb0, 0x10, 0x0f,
+	0x47, 0x74, 0x78, 0xcb, 0xe5, 0x6d, 0x63, 0xe2, 0x68, 0x1a, 0x71, 0x98, 0xca, 0x7c, 0x78, 0x26,
+	0x56, 0xe2, 0xa2, 0xe7, 0x61, 0x6b, 0x2c, 0x8f, 0xb4, 0x8b, 0xf4, 0x6f, 0x28, 0x1a, 0xb2, 0x03,
+	0xd7, 0x4d, 0x87, 0xac, 0x08, 0xcb, 0x0e, 0x25, 0x82, 0xf9, 0xf5, 0xb6, 0xa0, 0xe9, 0x9c, 0x0d,
+	0x9b, 0xfe, 0xa3, 0x51, 0xd3, 0xb7, 0x60, 0x73, 0x44, 0x5d, 0xa6, 0xbb, 0x06, 0xeb, 0x35, 0xee,
+	0x55, 0x6d, 0xe2, 0xe0, 0x66, 0x8c, 0x1d, 0x51, 0xda, 0xfc, 0xd3, 0x1b, 0x46, 0xdf, 0x86, 0x1b,
+	0x13, 0xb6, 0x4b, 0xa3, 0x95, 0x3f, 0x48, 0x20, 0xd5, 0xb8, 0x27, 0x9f, 0x00, 0x0c, 0x5d, 0x67,
+	0x37, 0xa7, 0xd4, 0x70, 0x64, 0xde, 0xd5, 0xdd, 0x59, 0x58, 0xd9, 0xad, 0xf0, 0x02, 0xae, 0x8f,
+	0xf4, 0xdb, 0xad, 0xe9, 0xde, 0xc3, 0x3c, 0xd5, 0x98, 0x8d, 0x97, 0xc5, 0x39, 0x01, 0x18, 0xea,
+	0x99, 0xdf, 0x28, 0x19, 0xb0, 0xd4, 0xdd, 0x59, 0x58, 0x59, 0x04, 0x06, 0x6b, 0x57, 0xca, 0xb3,
+	0x33, 0x7d, 0x87, 0x71, 0xae, 0x5a, 0x9e, 0x9d, 0x9b, 0xc6, 0xac, 0x54, 0xce, 0x7b, 0x1a, 0xba,
+	0xe8, 0x69, 0xe8, 0x47, 0x4f, 0x43, 0x6f, 0xfb, 0x5a, 0xee, 0xa2, 0xaf, 0xe5, 0xbe, 0xf6, 0xb5,
+	0xdc, 0xd3, 0xe1, 0xce, 0x1b, 0xec, 0x6b, 0xf2, 0x80, 0x98, 0xa7, 0x66, 0xfa, 0x6c, 0x86, 0xfd,
+	0x57, 0x5f, 0x88, 0x5e, 0xaf, 0xbb, 0xbf, 0x06, 0x00, 0x02, 0x2f, 0xc1, 0x95, 0x4d, 0x07, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -216,6 +511,9 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type MsgClient interface {
 	SetRewards(ctx context.Context, in *MsgSetRewards, opts ...grpc.CallOption) (*MsgSetRewardsResponse, error)
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	AddRewards(ctx context.Context, in *MsgAddRewards, opts ...grpc.CallOption) (*MsgAddRewardsResponse, error)
+	CancelRewardPool(ctx context.Context, in *MsgCancelRewardPool, opts ...grpc.CallOption) (*MsgCancelRewardPoolResponse, error)
 }
 
 type msgClient struct {
@@ -235,9 +533,39 @@ func (c *msgClient) SetRewards(ctx context.Context, in *MsgSetRewards, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.reward.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddRewards(ctx context.Context, in *MsgAddRewards, opts ...grpc.CallOption) (*MsgAddRewardsResponse, error) {
+	out := new(MsgAddRewardsResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.reward.Msg/AddRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelRewardPool(ctx context.Context, in *MsgCancelRewardPool, opts ...grpc.CallOption) (*MsgCancelRewardPoolResponse, error) {
+	out := new(MsgCancelRewardPoolResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.spn.reward.Msg/CancelRewardPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	SetRewards(context.Context, *MsgSetRewards) (*MsgSetRewardsResponse, error)
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	AddRewards(context.Context, *MsgAddRewards) (*MsgAddRewardsResponse, error)
+	CancelRewardPool(context.Context, *MsgCancelRewardPool) (*MsgCancelRewardPoolResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -247,6 +575,15 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) SetRewards(ctx context.Context, req *MsgSetRewards) (*MsgSetRewardsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetRewards not implemented")
 }
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) AddRewards(ctx context.Context, req *MsgAddRewards) (*MsgAddRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddRewards not implemented")
+}
+func (*UnimplementedMsgServer) CancelRewardPool(ctx context.Context, req *MsgCancelRewardPool) (*MsgCancelRewardPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRewardPool not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -270,6 +607,60 @@ func _Msg_SetRewards_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.reward.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.reward.Msg/AddRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddRewards(ctx, req.(*MsgAddRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelRewardPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelRewardPool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelRewardPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.spn.reward.Msg/CancelRewardPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelRewardPool(ctx, req.(*MsgCancelRewardPool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "tendermint.spn.reward.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -278,6 +669,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetRewards",
 			Handler:    _Msg_SetRewards_Handler,
 		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "AddRewards",
+			Handler:    _Msg_AddRewards_Handler,
+		},
+		{
+			MethodName: "CancelRewardPool",
+			Handler:    _Msg_CancelRewardPool_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "reward/tx.proto",
@@ -398,21 +801,214 @@ func (m *MsgSetRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgSetRewards) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.LaunchID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LaunchID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Contributor) > 0 {
+		i -= len(m.Contributor)
+		copy(dAtA[i:], m.Contributor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contributor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelRewardPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRewardPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRewardPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LaunchID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LaunchID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelRewardPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRewardPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRewardPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSetRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	l = len(m.Provider)
@@ -428,46 +1024,601 @@ func (m *MsgSetRewards) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
-	if m.LastRewardHeight != 0 {
-		n += 1 + sovTx(uint64(m.LastRewardHeight))
-	}
-	return n
-}
+	if m.LastRewardHeight != 0 {
+		n += 1 + sovTx(uint64(m.LastRewardHeight))
+	}
+	return n
+}
+
+func (m *MsgSetRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PreviousCoins) > 0 {
+		for _, e := range m.PreviousCoins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.PreviousLastRewardHeight != 0 {
+		n += 1 + sovTx(uint64(m.PreviousLastRewardHeight))
+	}
+	if len(m.NewCoins) > 0 {
+		for _, e := range m.NewCoins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.NewLastRewardHeight != 0 {
+		n += 1 + sovTx(uint64(m.NewLastRewardHeight))
+	}
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contributor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LaunchID != 0 {
+		n += 1 + sovTx(uint64(m.LaunchID))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelRewardPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LaunchID != 0 {
+		n += 1 + sovTx(uint64(m.LaunchID))
+	}
+	return n
+}
+
+func (m *MsgCancelRewardPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSetRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LaunchID", wireType)
+			}
+			m.LaunchID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LaunchID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, github_com_cosmos_cosmos_sdk_types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRewardHeight", wireType)
+			}
+			m.LastRewardHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastRewardHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousCoins = append(m.PreviousCoins, github_com_cosmos_cosmos_sdk_types.Coin{})
+			if err := m.PreviousCoins[len(m.PreviousCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousLastRewardHeight", wireType)
+			}
+			m.PreviousLastRewardHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousLastRewardHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewCoins = append(m.NewCoins, github_com_cosmos_cosmos_sdk_types.Coin{})
+			if err := m.NewCoins[len(m.NewCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewLastRewardHeight", wireType)
+			}
+			m.NewLastRewardHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewLastRewardHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgSetRewardsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	if len(m.PreviousCoins) > 0 {
-		for _, e := range m.PreviousCoins {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
-	}
-	if m.PreviousLastRewardHeight != 0 {
-		n += 1 + sovTx(uint64(m.PreviousLastRewardHeight))
-	}
-	if len(m.NewCoins) > 0 {
-		for _, e := range m.NewCoins {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.NewLastRewardHeight != 0 {
-		n += 1 + sovTx(uint64(m.NewLastRewardHeight))
-	}
-	return n
-}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgSetRewards) Unmarshal(dAtA []byte) error {
+func (m *MsgAddRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -490,15 +1641,15 @@ func (m *MsgSetRewards) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetRewards: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contributor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -526,7 +1677,7 @@ func (m *MsgSetRewards) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provider = string(dAtA[iNdEx:postIndex])
+			m.Contributor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
@@ -581,25 +1732,56 @@ func (m *MsgSetRewards) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastRewardHeight", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.LastRewardHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastRewardHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -621,7 +1803,7 @@ func (m *MsgSetRewards) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetRewardsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelRewardPool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -644,17 +1826,17 @@ func (m *MsgSetRewardsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetRewardsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelRewardPool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelRewardPool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousCoins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -664,50 +1846,29 @@ func (m *MsgSetRewardsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreviousCoins = append(m.PreviousCoins, github_com_cosmos_cosmos_sdk_types.Coin{})
-			if err := m.PreviousCoins[len(m.PreviousCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousLastRewardHeight", wireType)
-			}
-			m.PreviousLastRewardHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PreviousLastRewardHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewCoins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LaunchID", wireType)
 			}
-			var msglen int
+			m.LaunchID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -717,45 +1878,61 @@ func (m *MsgSetRewardsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LaunchID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewCoins = append(m.NewCoins, github_com_cosmos_cosmos_sdk_types.Coin{})
-			if err := m.NewCoins[len(m.NewCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelRewardPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewLastRewardHeight", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.NewLastRewardHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NewLastRewardHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelRewardPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelRewardPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])